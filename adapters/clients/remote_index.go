@@ -400,6 +400,7 @@ func (c *RemoteIndex) MultiGetObjects(ctx context.Context, hostName, indexName,
 func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string,
 	vector []models.Vector,
 	targetVector []string,
+	distance float32,
 	limit int,
 	filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking,
@@ -412,7 +413,7 @@ func (c *RemoteIndex) SearchShard(ctx context.Context, host, index, shard string
 ) ([]*storobj.Object, []float32, error) {
 	// new request
 	body, err := clusterapi.IndicesPayloads.SearchParams.
-		Marshal(vector, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties)
+		Marshal(vector, targetVector, distance, limit, filters, keywordRanking, sort, cursor, groupBy, additional, targetCombination, properties)
 	if err != nil {
 		return nil, nil, fmt.Errorf("marshal request payload: %w", err)
 	}
@@ -716,6 +717,74 @@ func (c *RemoteIndex) UpdateShardStatus(ctx context.Context, hostName, indexName
 	return c.retry(ctx, 9, try)
 }
 
+func (c *RemoteIndex) OpenSnapshot(ctx context.Context, hostName, indexName, shardName,
+	token string, ttl time.Duration,
+) error {
+	paramsBytes, err := clusterapi.IndicesPayloads.OpenSnapshotParams.Marshal(ttl)
+	if err != nil {
+		return errors.Wrap(err, "marshal request payload")
+	}
+	path := fmt.Sprintf("/indices/%s/shards/%s/snapshots/%s", indexName, shardName, token)
+	method := http.MethodPost
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(),
+			bytes.NewReader(paramsBytes))
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+		clusterapi.IndicesPayloads.OpenSnapshotParams.SetContentTypeHeaderReq(req)
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code != http.StatusCreated {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+
+	return c.retry(ctx, 9, try)
+}
+
+func (c *RemoteIndex) ReleaseSnapshot(ctx context.Context, hostName, indexName, shardName,
+	token string,
+) (bool, error) {
+	path := fmt.Sprintf("/indices/%s/shards/%s/snapshots/%s", indexName, shardName, token)
+	method := http.MethodDelete
+	url := url.URL{Scheme: "http", Host: hostName, Path: path}
+
+	found := true
+	try := func(ctx context.Context) (bool, error) {
+		req, err := http.NewRequestWithContext(ctx, method, url.String(), nil)
+		if err != nil {
+			return false, fmt.Errorf("create http request: %w", err)
+		}
+
+		res, err := c.client.Do(req)
+		if err != nil {
+			return ctx.Err() == nil, fmt.Errorf("connect: %w", err)
+		}
+		defer res.Body.Close()
+
+		if code := res.StatusCode; code == http.StatusNotFound {
+			found = false
+			return false, nil
+		} else if code != http.StatusNoContent {
+			body, _ := io.ReadAll(res.Body)
+			return shouldRetry(code), fmt.Errorf("status code: %v body: (%s)", code, body)
+		}
+		return false, nil
+	}
+
+	return found, c.retry(ctx, 9, try)
+}
+
 func (c *RemoteIndex) PutFile(ctx context.Context, hostName, indexName,
 	shardName, fileName string, payload io.ReadSeekCloser,
 ) error {
@@ -138,6 +138,20 @@ func (c *replicationClient) OverwriteObjects(ctx context.Context,
 	return resp, err
 }
 
+func (c *replicationClient) FetchKeyValue(ctx context.Context,
+	host, index, shard, key string,
+) (objects.KeyValue, error) {
+	var resp objects.KeyValue
+	req, err := newHttpReplicaRequest(ctx, http.MethodGet, host, index, shard, "", "_kv", nil, 0)
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+
+	req.URL.RawQuery = url.Values{"key": []string{key}}.Encode()
+	err = c.do(c.timeoutUnit*20, req, nil, &resp, 9)
+	return resp, err
+}
+
 func (c *replicationClient) FetchObjects(ctx context.Context, host,
 	index, shard string, ids []strfmt.UUID,
 ) ([]objects.Replica, error) {
@@ -249,6 +263,25 @@ func (c *replicationClient) AddReferences(ctx context.Context, host, index,
 	return resp, err
 }
 
+func (c *replicationClient) WriteKeyValue(ctx context.Context, host, index,
+	shard, requestID string, write *objects.KeyValueWrite, schemaVersion uint64,
+) (replica.SimpleResponse, error) {
+	var resp replica.SimpleResponse
+	body, err := json.Marshal(write)
+	if err != nil {
+		return resp, fmt.Errorf("encode request: %w", err)
+	}
+	req, err := newHttpReplicaRequest(ctx, http.MethodPut, host, index, shard,
+		requestID, "_kv", nil, schemaVersion)
+	if err != nil {
+		return resp, fmt.Errorf("create http request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	err = c.do(c.timeoutUnit*90, req, body, &resp, 9)
+	return resp, err
+}
+
 func (c *replicationClient) DeleteObjects(ctx context.Context, host, index, shard, requestID string,
 	uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64,
 ) (resp replica.SimpleResponse, err error) {
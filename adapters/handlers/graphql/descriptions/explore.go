@@ -28,4 +28,12 @@ const (
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
 	Target               = "Configure how multi target searches are combined"
+	RescoreMultiplier    = "Number of candidates, relative to the limit, that are rescored with the uncompressed vectors if the vector index is compressed. Must be at least 1"
+	DistanceCursor       = "Cursor of a search by distance. Pass the cursor of the last result of a page to nearVector to get the next page"
+	NearSparseVector     = "Search a sparse target vector by the dot product with the given sparse vector"
+	Highlights           = "Fragments of the text properties with the matched terms of a bm25 or hybrid search surrounded by <em> and </em>, the rest of the text is HTML-escaped"
+	FragmentSize         = "Number of characters a highlighted fragment aims for, defaults to 100"
+	MaxFragments         = "Maximum number of highlighted fragments per property, the fragments with the most matched terms are kept. Defaults to 3"
+	SparseIndices        = "Dimensions of the sparse vector that have a weight"
+	SparseValues         = "Weights of the dimensions given in indices, must not be negative"
 )
@@ -138,7 +138,8 @@ const (
 
 // Cursor API
 const (
-	AfterID = "Show the results after a given ID"
+	AfterID  = "Show the results after a given ID"
+	Snapshot = "Return the objects as they were when the read snapshot with the given token was opened. Can not be combined with filters, sort, grouping or searches"
 )
 
 const (
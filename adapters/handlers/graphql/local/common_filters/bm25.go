@@ -31,6 +31,18 @@ func ExtractBM25(source map[string]interface{}, explainScore bool) searchparams.
 		args.Query = query.(string)
 	}
 
+	if phrase, ok := source["phrase"]; ok {
+		args.Phrase = phrase.(bool)
+	}
+
+	if slop, ok := source["slop"]; ok {
+		args.Slop = slop.(int)
+	}
+
+	if fuzziness, ok := source["fuzziness"]; ok {
+		args.Fuzziness = fuzziness.(int)
+	}
+
 	args.AdditionalExplanations = explainScore
 	args.Type = "bm25"
 
@@ -67,6 +67,15 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 			args.NearVectorParams = &arguments
 
 		}
+
+		if namedSearches["nearSparseVector"] != nil {
+			nearSparseVector := namedSearches["nearSparseVector"].(map[string]interface{})
+			arguments, err := ExtractNearSparseVector(nearSparseVector)
+			if err != nil {
+				return nil, nil, err
+			}
+			args.NearSparseVectorParams = &arguments
+		}
 	}
 
 	var weightedSearchResults []searchparams.WeightedSearchResult
@@ -163,6 +172,18 @@ func ExtractHybridSearch(source map[string]interface{}, explainScore bool) (*sea
 		}
 	}
 
+	if phrase, ok := source["phrase"]; ok {
+		args.Phrase = phrase.(bool)
+	}
+
+	if slop, ok := source["slop"]; ok {
+		args.Slop = slop.(int)
+	}
+
+	if fuzziness, ok := source["fuzziness"]; ok {
+		args.Fuzziness = fuzziness.(int)
+	}
+
 	args.Type = "hybrid"
 
 	if args.NearTextParams != nil && args.NearVectorParams != nil {
@@ -56,6 +56,10 @@ func NearVectorFields(prefix string, addTarget bool) graphql.InputObjectConfigFi
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"rescoreMultiplier": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RescoreMultiplier,
+			Type:        graphql.Float,
+		},
 	}
 	fieldMap = AddTargetArgument(fieldMap, prefix+"nearVector", addTarget)
 	return fieldMap
@@ -95,6 +99,10 @@ func nearObjectFields(prefix string, addTarget bool) graphql.InputObjectConfigFi
 			Description: "Target vectors",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"rescoreMultiplier": &graphql.InputObjectFieldConfig{
+			Description: descriptions.RescoreMultiplier,
+			Type:        graphql.Float,
+		},
 	}
 	fieldMap = AddTargetArgument(fieldMap, prefix+"nearObject", addTarget)
 	return fieldMap
@@ -49,6 +49,13 @@ func ExtractNearObject(source map[string]interface{}) (searchparams.NearObject,
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	if rescoreMultiplier, ok := source["rescoreMultiplier"]; ok {
+		args.RescoreMultiplier = rescoreMultiplier.(float64)
+		if err := searchparams.ValidateRescoreMultiplier(args.RescoreMultiplier); err != nil {
+			return searchparams.NearObject{}, nil, err
+		}
+	}
+
 	targetVectors, combination, err := ExtractTargets(source)
 	if err != nil {
 		return searchparams.NearObject{}, nil, err
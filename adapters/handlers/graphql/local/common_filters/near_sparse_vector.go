@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common_filters
+
+import (
+	"fmt"
+
+	"github.com/tailor-inc/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func NearSparseVectorArgument(argumentPrefix, className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("%s%s", argumentPrefix, className)
+	return &graphql.ArgumentConfig{
+		Description: descriptions.NearSparseVector,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearSparseVectorInpObj", prefix),
+				Fields: NearSparseVectorFields(),
+			},
+		),
+	}
+}
+
+func NearSparseVectorFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"indices": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SparseIndices,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.Int))),
+		},
+		"values": &graphql.InputObjectFieldConfig{
+			Description: descriptions.SparseValues,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.Float))),
+		},
+		"distance": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Distance,
+			Type:        graphql.Float,
+		},
+		"targetVectors": &graphql.InputObjectFieldConfig{
+			Description: "Target vectors",
+			Type:        graphql.NewList(graphql.String),
+		},
+	}
+}
+
+// ExtractNearSparseVector arguments, such as "indices" and "values". The
+// sparse vector is searched as a nearVector of type models.SparseVector.
+func ExtractNearSparseVector(source map[string]interface{}) (searchparams.NearVector, error) {
+	var args searchparams.NearVector
+
+	indices, _ := source["indices"].([]interface{})
+	values, _ := source["values"].([]interface{})
+	if len(indices) != len(values) {
+		return searchparams.NearVector{}, fmt.Errorf("indices and values must have the same length, "+
+			"got %d indices and %d values", len(indices), len(values))
+	}
+	if len(indices) == 0 {
+		return searchparams.NearVector{}, fmt.Errorf("indices and values are required fields")
+	}
+
+	vector := models.SparseVector{
+		Indices: make([]uint32, len(indices)),
+		Values:  make([]float32, len(values)),
+	}
+	for i := range indices {
+		index, ok := indices[i].(int)
+		if !ok || index < 0 {
+			return searchparams.NearVector{}, fmt.Errorf("indices must be non-negative integers, got %v", indices[i])
+		}
+		vector.Indices[i] = uint32(index)
+		value, ok := values[i].(float64)
+		if !ok {
+			return searchparams.NearVector{}, fmt.Errorf("values must be floats, got %v", values[i])
+		}
+		vector.Values[i] = float32(value)
+	}
+
+	if distance, ok := source["distance"]; ok {
+		args.Distance = distance.(float64)
+		args.WithDistance = true
+	}
+
+	targetVectors, _, err := ExtractTargets(source)
+	if err != nil {
+		return searchparams.NearVector{}, err
+	}
+	args.TargetVectors = targetVectors
+
+	if len(targetVectors) == 0 {
+		args.Vectors = []models.Vector{vector}
+	} else {
+		args.Vectors = make([]models.Vector, len(targetVectors))
+		for i := range targetVectors {
+			args.Vectors[i] = vector
+		}
+	}
+
+	return args, nil
+}
@@ -14,6 +14,7 @@ package common_filters
 import (
 	"fmt"
 
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/searchparams"
@@ -46,6 +47,21 @@ func ExtractNearVector(source map[string]interface{}, targetVectorsFromOtherLeve
 			fmt.Errorf("cannot provide distance and certainty")
 	}
 
+	if rescoreMultiplier, ok := source["rescoreMultiplier"]; ok {
+		args.RescoreMultiplier = rescoreMultiplier.(float64)
+		if err := searchparams.ValidateRescoreMultiplier(args.RescoreMultiplier); err != nil {
+			return searchparams.NearVector{}, nil, err
+		}
+	}
+
+	if cursor, ok := source["cursor"]; ok {
+		distanceCursor, err := additional.DecodeDistanceCursor(cursor.(string))
+		if err != nil {
+			return searchparams.NearVector{}, nil, err
+		}
+		args.Cursor = distanceCursor
+	}
+
 	var targetVectors []string
 	var combination *dto.TargetCombination
 	if targetVectorsFromOtherLevel == nil {
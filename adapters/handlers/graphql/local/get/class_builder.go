@@ -172,6 +172,7 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["classification"] = b.additionalClassificationField(class)
 	additionalProperties["certainty"] = b.additionalCertaintyField(class)
 	additionalProperties["distance"] = b.additionalDistanceField(class)
+	additionalProperties["cursor"] = b.additionalCursorField()
 	additionalProperties["vector"] = b.additionalVectorField(class)
 	additionalProperties["vectors"] = b.additionalVectorsField(class)
 	additionalProperties["id"] = b.additionalIDField()
@@ -179,6 +180,7 @@ func (b *classBuilder) additionalFields(classProperties graphql.Fields, class *m
 	additionalProperties["lastUpdateTimeUnix"] = b.additionalLastUpdateTimeUnix()
 	additionalProperties["score"] = b.additionalScoreField()
 	additionalProperties["explainScore"] = b.additionalExplainScoreField()
+	additionalProperties["highlights"] = b.additionalHighlightsField(class)
 	additionalProperties["group"] = b.additionalGroupField(classProperties, class)
 	if replicationEnabled(class) {
 		additionalProperties["isConsistent"] = b.isConsistentField()
@@ -231,6 +233,13 @@ func (b *classBuilder) additionalDistanceField(class *models.Class) *graphql.Fie
 	}
 }
 
+func (b *classBuilder) additionalCursorField() *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.DistanceCursor,
+		Type:        graphql.String,
+	}
+}
+
 func (b *classBuilder) additionalVectorField(class *models.Class) *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.NewList(graphql.Float),
@@ -276,6 +285,29 @@ func (b *classBuilder) additionalExplainScoreField() *graphql.Field {
 	}
 }
 
+func (b *classBuilder) additionalHighlightsField(class *models.Class) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.Highlights,
+		Args: graphql.FieldConfigArgument{
+			"fragmentSize": &graphql.ArgumentConfig{
+				Description: descriptions.FragmentSize,
+				Type:        graphql.Int,
+			},
+			"maxFragments": &graphql.ArgumentConfig{
+				Description: descriptions.MaxFragments,
+				Type:        graphql.Int,
+			},
+		},
+		Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+			Name: fmt.Sprintf("%sAdditionalHighlights", class.Class),
+			Fields: graphql.Fields{
+				"property":  &graphql.Field{Type: graphql.String},
+				"fragments": &graphql.Field{Type: graphql.NewList(graphql.String)},
+			},
+		})),
+	}
+}
+
 func (b *classBuilder) additionalLastUpdateTimeUnix() *graphql.Field {
 	return &graphql.Field{
 		Type: graphql.String,
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/weaviate/weaviate/usecases/auth/authorization"
@@ -215,6 +216,10 @@ func buildGetClassField(classObject *graphql.Object,
 				Description: descriptions.AfterID,
 				Type:        graphql.String,
 			},
+			"snapshot": &graphql.ArgumentConfig{
+				Description: descriptions.Snapshot,
+				Type:        graphql.String,
+			},
 			"limit": &graphql.ArgumentConfig{
 				Description: descriptions.Limit,
 				Type:        graphql.Int,
@@ -228,12 +233,13 @@ func buildGetClassField(classObject *graphql.Object,
 				Type:        graphql.Int,
 			},
 
-			"sort":       sortArgument(class.Class),
-			"nearVector": nearVectorArgument(class.Class),
-			"nearObject": nearObjectArgument(class.Class),
-			"where":      whereArgument(class.Class),
-			"group":      groupArgument(class.Class),
-			"groupBy":    groupByArgument(class.Class),
+			"sort":             sortArgument(class.Class),
+			"nearVector":       nearVectorArgument(class.Class),
+			"nearSparseVector": common_filters.NearSparseVectorArgument("GetObjects", class.Class),
+			"nearObject":       nearObjectArgument(class.Class),
+			"where":            whereArgument(class.Class),
+			"group":            groupArgument(class.Class),
+			"groupBy":          groupByArgument(class.Class),
 		},
 		Resolve: newResolver(authorizer, modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -406,6 +412,18 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		targetVectorCombination = targetCombination
 	}
 
+	if nearSparseVector, ok := p.Args["nearSparseVector"]; ok {
+		if nearVectorParams != nil {
+			return nil, fmt.Errorf("found both 'nearVector' and 'nearSparseVector' parameters " +
+				"which are conflicting, choose one instead")
+		}
+		p, err := common_filters.ExtractNearSparseVector(nearSparseVector.(map[string]interface{}))
+		if err != nil {
+			return nil, fmt.Errorf("failed to extract nearSparseVector params: %s", err)
+		}
+		nearVectorParams = &p
+	}
+
 	var nearObjectParams *searchparams.NearObject
 	if nearObject, ok := p.Args["nearObject"]; ok {
 		p, targetCombination, err := common_filters.ExtractNearObject(nearObject.(map[string]interface{}))
@@ -471,6 +489,13 @@ func (r *resolver) resolveGet(p graphql.ResolveParams, className string) (interf
 		groupByParams = &p
 	}
 
+	if snapshot, ok := p.Args["snapshot"]; ok {
+		if groupByParams != nil {
+			return nil, fmt.Errorf("snapshot is not compatible with groupBy")
+		}
+		addlProps.Snapshot = snapshot.(string)
+	}
+
 	params := dto.GetParams{
 		Filters:                 filters,
 		ClassName:               className,
@@ -598,7 +623,7 @@ func (ac *additionalCheck) isAdditional(parentName, name string) bool {
 			name == "distance" || name == "id" || name == "vector" || name == "vectors" ||
 			name == "creationTimeUnix" || name == "lastUpdateTimeUnix" ||
 			name == "score" || name == "explainScore" || name == "isConsistent" ||
-			name == "group" {
+			name == "group" || name == "cursor" || name == "highlights" {
 			return true
 		}
 		if ac.isModuleAdditional(name) {
@@ -673,6 +698,10 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.Distance = true
 							continue
 						}
+						if additionalProperty == "cursor" {
+							additionalProps.Cursor = true
+							continue
+						}
 						if additionalProperty == "id" {
 							additionalProps.ID = true
 							continue
@@ -709,6 +738,14 @@ func extractProperties(className string, selections *ast.SelectionSet,
 							additionalProps.LastUpdateTimeUnix = true
 							continue
 						}
+						if additionalProperty == "highlights" {
+							highlights, err := extractHighlights(s.Arguments)
+							if err != nil {
+								return nil, additionalProps, nil, err
+							}
+							additionalProps.Highlights = highlights
+							continue
+						}
 						if additionalProperty == "isConsistent" {
 							additionalProps.IsConsistent = true
 							continue
@@ -773,6 +810,30 @@ func extractProperties(className string, selections *ast.SelectionSet,
 	return properties, additionalProps, additionalGroupHitProperties, nil
 }
 
+func extractHighlights(args []*ast.Argument) (*additional.Highlights, error) {
+	highlights := &additional.Highlights{}
+	for _, arg := range args {
+		value, ok := arg.Value.(*ast.IntValue)
+		if !ok {
+			return nil, fmt.Errorf("highlights: %s must be an integer", arg.Name.Value)
+		}
+		n, err := strconv.Atoi(value.Value)
+		if err != nil {
+			return nil, fmt.Errorf("highlights: %s: %w", arg.Name.Value, err)
+		}
+		switch arg.Name.Value {
+		case "fragmentSize":
+			highlights.FragmentSize = n
+		case "maxFragments":
+			highlights.MaxFragments = n
+		}
+	}
+	if err := highlights.Validate(); err != nil {
+		return nil, err
+	}
+	return highlights, nil
+}
+
 func extractGroupHitProperties(
 	className string,
 	additionalProps additional.Properties,
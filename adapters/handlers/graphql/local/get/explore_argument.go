@@ -21,7 +21,21 @@ import (
 )
 
 func nearVectorArgument(className string) *graphql.ArgumentConfig {
-	return common_filters.NearVectorArgument("GetObjects", className, true)
+	prefix := fmt.Sprintf("GetObjects%s", className)
+	fields := common_filters.NearVectorFields(prefix, true)
+	// only Get pages through the results of a search by distance
+	fields["cursor"] = &graphql.InputObjectFieldConfig{
+		Description: descriptions.DistanceCursor,
+		Type:        graphql.String,
+	}
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearVectorInpObj", prefix),
+				Fields: fields,
+			},
+		),
+	}
 }
 
 func nearObjectArgument(className string) *graphql.ArgumentConfig {
@@ -511,6 +511,41 @@ func TestExtractAdditionalFields(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "with _additional highlights",
+			query: "{ Get { SomeAction { _additional { highlights(fragmentSize: 50, maxFragments: 2) { property fragments } } } } }",
+			expectedParams: dto.GetParams{
+				ClassName: "SomeAction",
+				AdditionalProperties: additional.Properties{
+					Highlights: &additional.Highlights{
+						FragmentSize: 50,
+						MaxFragments: 2,
+					},
+				},
+			},
+			resolverReturn: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{
+						"highlights": []additional.Highlight{
+							{
+								Property:  "name",
+								Fragments: []string{"the <em>quick</em> fox"},
+							},
+						},
+					},
+				},
+			},
+			expectedResult: map[string]interface{}{
+				"_additional": map[string]interface{}{
+					"highlights": []interface{}{
+						map[string]interface{}{
+							"property":  "name",
+							"fragments": []interface{}{"the <em>quick</em> fox"},
+						},
+					},
+				},
+			},
+		},
 		{
 			name:  "with _additional interpretation",
 			query: "{ Get { SomeAction { _additional { interpretation { source { concept weight occurrence } }  } } } }",
@@ -1265,6 +1300,75 @@ func TestNearVectorRanker(t *testing.T) {
 		resolver.AssertResolve(t, query)
 	})
 
+	t.Run("for things with rescore multiplier set", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								rescoreMultiplier: 2.5
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vectors:           []models.Vector{[]float32{0.123, 0.984}},
+				RescoreMultiplier: 2.5,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with rescore multiplier below 1", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								rescoreMultiplier: 0.5
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+
+	t.Run("for things with distance cursor set", func(t *testing.T) {
+		cursor := additional.DistanceCursor{
+			Distance: 0.25,
+			ID:       strfmt.UUID("a2f2d1e8-2b3c-4d5e-8f90-123456789abc"),
+		}
+		query := fmt.Sprintf(`{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								distance: 0.4
+								cursor: %q
+							}) { _additional { cursor } } } }`, cursor.Encode())
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			NearVector: &searchparams.NearVector{
+				Vectors:      []models.Vector{[]float32{0.123, 0.984}},
+				Distance:     0.4,
+				WithDistance: true,
+				Cursor:       &cursor,
+			},
+			AdditionalProperties: additional.Properties{
+				Cursor: true,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("for things with invalid distance cursor", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+								vector: [0.123, 0.984]
+								distance: 0.4
+								cursor: "not-a-cursor"
+							}) { intField } } }`
+
+		resolver.AssertFailToResolve(t, query)
+	})
+
 	t.Run("for things with optional certainty set", func(t *testing.T) {
 		query := `{ Get { SomeThing(nearVector: {
 								vector: [0.123, 0.984]
@@ -1608,6 +1712,41 @@ func TestExtractCursor(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractSnapshot(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with cursor", func(t *testing.T) {
+		resolver := newMockResolver()
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Cursor: &filters.Cursor{
+				After: "8ef8d5cc-c101-4fbd-a016-84e766b93ecf",
+				Limit: 2,
+			},
+			Pagination: &filters.Pagination{
+				Offset: 0,
+				Limit:  2,
+			},
+			AdditionalProperties: additional.Properties{Snapshot: "token"},
+		}
+
+		resolver.On("GetClass", expectedParams).
+			Return(test_helper.EmptyList(), nil).Once()
+
+		query := `{ Get { SomeAction(after: "8ef8d5cc-c101-4fbd-a016-84e766b93ecf" limit: 2 snapshot: "token") { intField } } }`
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with groupBy", func(t *testing.T) {
+		resolver := newMockResolver()
+
+		query := `{ Get { SomeAction(snapshot: "token" groupBy: {path: ["intField"] groups: 2 objectsPerGroup: 3}) { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+}
+
 func TestExtractGroupParams(t *testing.T) {
 	t.Parallel()
 
@@ -2266,6 +2405,126 @@ func TestHybridWithVectorDistance(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestBM25WithPhrase(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(bm25:{query:"new york times", properties:["name"], phrase: true, slop: 2}){intField}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      "new york times",
+			Properties: []string{"name"},
+			Phrase:     true,
+			Slop:       2,
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestHybridWithPhrase(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(hybrid:{query:"new york times", phrase: true}){intField}}}`
+
+	var emptySubsearches []searchparams.WeightedSearchResult
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		HybridSearch: &searchparams.HybridSearch{
+			Query:           "new york times",
+			FusionAlgorithm: 1,
+			Alpha:           0.75,
+			Type:            "hybrid",
+			SubSearches:     emptySubsearches,
+			Phrase:          true,
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestBM25WithFuzziness(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(bm25:{query:"wether", properties:["name"], fuzziness: 1}){intField}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:       "bm25",
+			Query:      "wether",
+			Properties: []string{"name"},
+			Fuzziness:  1,
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestHybridWithFuzziness(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(hybrid:{query:"wether", fuzziness: 2}){intField}}}`
+
+	var emptySubsearches []searchparams.WeightedSearchResult
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		HybridSearch: &searchparams.HybridSearch{
+			Query:           "wether",
+			FusionAlgorithm: 1,
+			Alpha:           0.75,
+			Type:            "hybrid",
+			SubSearches:     emptySubsearches,
+			Fuzziness:       2,
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestBM25WithHighlights(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(bm25:{query:"quick"}){intField _additional{highlights{property fragments}}}}}`
+
+	expectedParams := dto.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		KeywordRanking: &searchparams.KeywordRanking{
+			Type:  "bm25",
+			Query: "quick",
+		},
+		AdditionalProperties: additional.Properties{
+			Highlights: &additional.Highlights{},
+		},
+	}
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{}, nil).Once()
+
+	resolver.AssertResolve(t, query)
+}
+
+func TestHighlightsWithNegativeFragmentSize(t *testing.T) {
+	t.Parallel()
+	resolver := newMockResolverWithNoModules()
+	query := `{Get{SomeAction(bm25:{query:"quick"}){_additional{highlights(fragmentSize: -1){property fragments}}}}}`
+	resolver.AssertFailToResolve(t, query, "highlights: fragmentSize must not be negative, got -1")
+}
+
 func TestNearObjectNoModules(t *testing.T) {
 	t.Parallel()
 
@@ -2623,6 +2882,104 @@ func TestNearVectorNoModules(t *testing.T) {
 	})
 }
 
+func TestNearSparseVectorNoModules(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolverWithNoModules()
+	sparse := models.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}}
+
+	t.Run("with target vector", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearSparseVector: {
+								indices: [3, 17]
+								values: [0.5, 1.25]
+								targetVectors: ["splade"]
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &searchparams.NearVector{
+				Vectors:       []models.Vector{sparse},
+				TargetVectors: []string{"splade"},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with optional distance set", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearSparseVector: {
+								indices: [3, 17]
+								values: [0.5, 1.25]
+								distance: -0.4
+							}) { intField } } }`
+
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			Pagination: &filters.Pagination{Limit: filters.LimitFlagSearchByDist},
+			NearVector: &searchparams.NearVector{
+				Vectors:      []models.Vector{sparse},
+				Distance:     -0.4,
+				WithDistance: true,
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
+
+	t.Run("with mismatching indices and values", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearSparseVector: {
+								indices: [3, 17, 20]
+								values: [0.5, 1.25]
+							}) { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+
+	t.Run("combined with nearVector", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {vector: [0.123, 0.984]}, nearSparseVector: {
+								indices: [3]
+								values: [0.5]
+							}) { intField } } }`
+		resolver.AssertFailToResolve(t, query)
+	})
+
+	t.Run("as hybrid subsearch", func(t *testing.T) {
+		query := `{Get{SomeAction(hybrid:{
+					query:"apple",
+					searches: {nearSparseVector:{
+								indices: [3, 17]
+								values: [0.5, 1.25]
+								targetVectors: ["splade"]
+					}}
+					}){intField}}}`
+
+		var emptySubsearches []searchparams.WeightedSearchResult
+		expectedParams := dto.GetParams{
+			ClassName:  "SomeAction",
+			Properties: []search.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			HybridSearch: &searchparams.HybridSearch{
+				Query:           "apple",
+				Alpha:           0.75,
+				Type:            "hybrid",
+				FusionAlgorithm: 1,
+				SubSearches:     emptySubsearches,
+				NearSparseVectorParams: &searchparams.NearVector{
+					Vectors:       []models.Vector{sparse},
+					TargetVectors: []string{"splade"},
+				},
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+		resolver.AssertResolve(t, query)
+	})
+}
+
 func TestSort(t *testing.T) {
 	t.Parallel()
 
@@ -67,6 +67,18 @@ func hybridOperands(classObject *graphql.Object,
 			Description: "Which properties should be included in the sparse search",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"phrase": &graphql.InputObjectFieldConfig{
+			Description: "Only match objects containing the query as an exact phrase in the sparse search, requires properties with indexPositions enabled",
+			Type:        graphql.Boolean,
+		},
+		"slop": &graphql.InputObjectFieldConfig{
+			Description: "Number of extra tokens allowed between the phrase terms in the sparse search, which can then occur in any order, at most 99",
+			Type:        graphql.Int,
+		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "Also match indexed terms within this edit distance (1 or 2) of the query terms in the sparse search",
+			Type:        graphql.Int,
+		},
 		"fusionType": &graphql.InputObjectFieldConfig{
 			Description: "Algorithm used for fusing results from vector and keyword search",
 			Type:        fusionEnum,
@@ -106,6 +118,16 @@ func hybridOperands(classObject *graphql.Object,
 									},
 								),
 							},
+							"nearSparseVector": &graphql.InputObjectFieldConfig{
+								Description: "nearSparseVector element, replaces the keyword search",
+								Type: graphql.NewInputObject(
+									graphql.InputObjectConfig{
+										Name:        fmt.Sprintf("%sNearSparseVectorInpObj", prefixName),
+										Description: descriptions.NearSparseVector,
+										Fields:      common_filters.NearSparseVectorFields(),
+									},
+								),
+							},
 						}
 						for key, fieldConfig := range fieldMap {
 							subSearchFields[key] = fieldConfig
@@ -39,5 +39,17 @@ func bm25Fields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "The properties to search in",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"phrase": &graphql.InputObjectFieldConfig{
+			Description: "Only match objects containing the query as an exact phrase, requires properties with indexPositions enabled",
+			Type:        graphql.Boolean,
+		},
+		"slop": &graphql.InputObjectFieldConfig{
+			Description: "Number of extra tokens allowed between the phrase terms, which can then occur in any order, at most 99",
+			Type:        graphql.Int,
+		},
+		"fuzziness": &graphql.InputObjectFieldConfig{
+			Description: "Also match indexed terms within this edit distance (1 or 2) of the query terms",
+			Type:        graphql.Int,
+		},
 	}
 }
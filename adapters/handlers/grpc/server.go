@@ -85,6 +85,7 @@ func CreateGRPCServer(state *state.State) *GRPCServer {
 		state.ServerConfig.Config.Authentication.AnonymousAccess.Enabled,
 		state.SchemaManager,
 		state.BatchManager,
+		state.ObjectsManager,
 		&state.ServerConfig.Config,
 		state.Authorizer,
 		state.Logger,
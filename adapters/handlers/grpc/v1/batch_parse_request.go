@@ -88,10 +88,14 @@ func BatchFromProto(req *pb.BatchObjectsRequest, authorizedGetClass func(string,
 		var vectors models.Vectors = nil
 		if len(obj.Vectors) > 0 {
 			parsedVectors := make(map[string][][]float32)
+			vectors = make(models.Vectors)
 			for _, vec := range obj.Vectors {
+				if vec.SparseVector != nil {
+					vectors[vec.Name] = models.SparseVector{Indices: vec.SparseVector.Indices, Values: vec.SparseVector.Values}
+					continue
+				}
 				parsedVectors[vec.Name] = append(parsedVectors[vec.Name], byteops.Float32FromByteVector(vec.VectorBytes))
 			}
-			vectors = make(models.Vectors)
 			for targetVector, vector := range parsedVectors {
 				if len(vector) == 1 {
 					vectors[targetVector] = vector[0]
@@ -137,6 +137,26 @@ func TestGRPCBatchRequest(t *testing.T) {
 				},
 			}},
 		},
+		{
+			name: "sparse named vectors",
+			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Vectors: []*pb.Vectors{
+				{
+					Name:        "custom",
+					VectorBytes: byteVector([]float32{0.1, 0.2, 0.3}),
+				},
+				{
+					Name:         "splade",
+					SparseVector: &pb.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}},
+				},
+			}}},
+			out: []*models.Object{{
+				Class: collection, ID: UUID4, Properties: nilMap,
+				Vectors: map[string]models.Vector{
+					"custom": []float32{0.1, 0.2, 0.3},
+					"splade": models.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}},
+				},
+			}},
+		},
 		{
 			name: "only mult ref",
 			req: []*pb.BatchObject{{Collection: collection, Uuid: UUID4, Properties: &pb.BatchObject_Properties{
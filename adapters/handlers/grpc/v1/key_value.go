@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/models"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func (s *Service) keyValueGet(ctx context.Context, principal *models.Principal, req *pb.KeyValueGetRequest) (*pb.KeyValueGetReply, error) {
+	before := time.Now()
+	if _, err := s.classGetterWithAuthzFunc(principal)(req.Collection); err != nil {
+		return nil, err
+	}
+
+	kv, err := s.objectsManager.GetKeyValue(ctx, principal, req.Collection, req.Key,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if err != nil {
+		var errNotFound objects.ErrNotFound
+		if !errors.As(err, &errNotFound) {
+			return nil, err
+		}
+	}
+
+	return &pb.KeyValueGetReply{
+		Took:  float32(time.Since(before).Seconds()),
+		Found: kv != nil,
+		Entry: keyValueEntryToGRPC(kv),
+	}, nil
+}
+
+func (s *Service) keyValuePut(ctx context.Context, principal *models.Principal, req *pb.KeyValuePutRequest) (*pb.KeyValuePutReply, error) {
+	before := time.Now()
+	if _, err := s.classGetterWithAuthzFunc(principal)(req.Collection); err != nil {
+		return nil, err
+	}
+
+	kv, err := s.objectsManager.PutKeyValue(ctx, principal, req.Collection, req.Key, req.Value,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.KeyValuePutReply{
+		Took:  float32(time.Since(before).Seconds()),
+		Entry: keyValueEntryToGRPC(kv),
+	}, nil
+}
+
+func (s *Service) keyValueDelete(ctx context.Context, principal *models.Principal, req *pb.KeyValueDeleteRequest) (*pb.KeyValueDeleteReply, error) {
+	before := time.Now()
+	if _, err := s.classGetterWithAuthzFunc(principal)(req.Collection); err != nil {
+		return nil, err
+	}
+
+	if err := s.objectsManager.DeleteKeyValue(ctx, principal, req.Collection, req.Key,
+		extractReplicationProperties(req.ConsistencyLevel), req.GetTenant()); err != nil {
+		return nil, err
+	}
+
+	return &pb.KeyValueDeleteReply{Took: float32(time.Since(before).Seconds())}, nil
+}
+
+func (s *Service) keyValueCompareAndSwap(ctx context.Context, principal *models.Principal,
+	req *pb.KeyValueCompareAndSwapRequest,
+) (*pb.KeyValueCompareAndSwapReply, error) {
+	before := time.Now()
+	if _, err := s.classGetterWithAuthzFunc(principal)(req.Collection); err != nil {
+		return nil, err
+	}
+
+	cond, err := keyValueConditionFromProto(req)
+	if err != nil {
+		return nil, err
+	}
+
+	kv, err := s.objectsManager.CompareAndSwapKeyValue(ctx, principal, req.Collection, req.Key,
+		cond, req.Value, req.Delete, extractReplicationProperties(req.ConsistencyLevel), req.GetTenant())
+	if err != nil {
+		var errPrecondition objects.ErrPreconditionFailed
+		if !errors.As(err, &errPrecondition) {
+			return nil, err
+		}
+		return &pb.KeyValueCompareAndSwapReply{
+			Took:    float32(time.Since(before).Seconds()),
+			Swapped: false,
+		}, nil
+	}
+
+	return &pb.KeyValueCompareAndSwapReply{
+		Took:    float32(time.Since(before).Seconds()),
+		Swapped: true,
+		Entry:   keyValueEntryToGRPC(kv),
+	}, nil
+}
+
+func keyValueConditionFromProto(req *pb.KeyValueCompareAndSwapRequest) (objects.KeyValueCondition, error) {
+	switch expected := req.GetExpected().(type) {
+	case *pb.KeyValueCompareAndSwapRequest_ExpectedValue:
+		return objects.KeyValueCondition{Value: expected.ExpectedValue}, nil
+	case *pb.KeyValueCompareAndSwapRequest_ExpectAbsent:
+		if !expected.ExpectAbsent {
+			return objects.KeyValueCondition{}, fmt.Errorf("expect_absent must be true if set")
+		}
+		return objects.KeyValueCondition{Absent: true}, nil
+	default:
+		return objects.KeyValueCondition{}, fmt.Errorf("either expected_value or expect_absent must be set")
+	}
+}
+
+func keyValueEntryToGRPC(kv *objects.KeyValue) *pb.KeyValueEntry {
+	if kv == nil {
+		return nil
+	}
+	return &pb.KeyValueEntry{
+		Key:                kv.Key,
+		Value:              kv.Value,
+		LastUpdateTimeUnix: kv.LastUpdateTimeUnixMilli,
+	}
+}
@@ -0,0 +1,87 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	pb "github.com/weaviate/weaviate/grpc/generated/protocol/v1"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestGRPCKeyValueCondition(t *testing.T) {
+	tests := []struct {
+		name      string
+		req       *pb.KeyValueCompareAndSwapRequest
+		expected  objects.KeyValueCondition
+		expectErr bool
+	}{
+		{
+			name: "expected value",
+			req: &pb.KeyValueCompareAndSwapRequest{
+				Expected: &pb.KeyValueCompareAndSwapRequest_ExpectedValue{ExpectedValue: []byte("v1")},
+			},
+			expected: objects.KeyValueCondition{Value: []byte("v1")},
+		},
+		{
+			name: "expected empty value",
+			req: &pb.KeyValueCompareAndSwapRequest{
+				Expected: &pb.KeyValueCompareAndSwapRequest_ExpectedValue{},
+			},
+			expected: objects.KeyValueCondition{},
+		},
+		{
+			name: "expect absent",
+			req: &pb.KeyValueCompareAndSwapRequest{
+				Expected: &pb.KeyValueCompareAndSwapRequest_ExpectAbsent{ExpectAbsent: true},
+			},
+			expected: objects.KeyValueCondition{Absent: true},
+		},
+		{
+			name: "expect absent set to false",
+			req: &pb.KeyValueCompareAndSwapRequest{
+				Expected: &pb.KeyValueCompareAndSwapRequest_ExpectAbsent{ExpectAbsent: false},
+			},
+			expectErr: true,
+		},
+		{
+			name:      "no expectation",
+			req:       &pb.KeyValueCompareAndSwapRequest{},
+			expectErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cond, err := keyValueConditionFromProto(tt.req)
+			if tt.expectErr {
+				require.NotNil(t, err)
+				return
+			}
+			require.Nil(t, err)
+			require.Equal(t, tt.expected, cond)
+		})
+	}
+}
+
+func TestGRPCKeyValueEntry(t *testing.T) {
+	require.Nil(t, keyValueEntryToGRPC(nil))
+
+	entry := keyValueEntryToGRPC(&objects.KeyValue{
+		Key:                     "checkpoint",
+		Value:                   []byte("42"),
+		LastUpdateTimeUnixMilli: 1700000000000,
+	})
+	require.Equal(t, "checkpoint", entry.GetKey())
+	require.Equal(t, []byte("42"), entry.GetValue())
+	require.Equal(t, int64(1700000000000), entry.GetLastUpdateTimeUnix())
+}
@@ -97,7 +97,21 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	}
 
 	if bm25 := req.Bm25Search; bm25 != nil {
-		out.KeywordRanking = &searchparams.KeywordRanking{Query: bm25.Query, Properties: schema.LowercaseFirstLetterOfStrings(bm25.Properties), Type: "bm25", AdditionalExplanations: out.AdditionalProperties.ExplainScore}
+		out.KeywordRanking = &searchparams.KeywordRanking{
+			Query:                  bm25.Query,
+			Properties:             schema.LowercaseFirstLetterOfStrings(bm25.Properties),
+			Type:                   "bm25",
+			AdditionalExplanations: out.AdditionalProperties.ExplainScore,
+			Phrase:                 bm25.Phrase,
+			Slop:                   int(bm25.Slop),
+			Fuzziness:              int(bm25.Fuzziness),
+		}
+		if err := out.KeywordRanking.ValidatePhrase(); err != nil {
+			return dto.GetParams{}, err
+		}
+		if err := out.KeywordRanking.ValidateFuzziness(); err != nil {
+			return dto.GetParams{}, err
+		}
 	}
 
 	if nv := req.NearVector; nv != nil {
@@ -123,13 +137,27 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		}
 	}
 
+	if ns := req.NearSparseVector; ns != nil {
+		if req.NearVector != nil {
+			return dto.GetParams{}, fmt.Errorf("near_sparse_vector: cannot be combined with near_vector")
+		}
+		out.NearVector, err = parseNearSparseVec(ns, targetVectors)
+		if err != nil {
+			return dto.GetParams{}, err
+		}
+	}
+
 	if no := req.NearObject; no != nil {
 		if no.Id == "" {
 			return dto.GetParams{}, fmt.Errorf("near_object: id is required")
 		}
+		if err := searchparams.ValidateRescoreMultiplier(no.GetRescoreMultiplier()); err != nil {
+			return dto.GetParams{}, fmt.Errorf("near_object: %w", err)
+		}
 		out.NearObject = &searchparams.NearObject{
-			ID:            no.Id,
-			TargetVectors: targetVectors,
+			ID:                no.Id,
+			TargetVectors:     targetVectors,
+			RescoreMultiplier: no.GetRescoreMultiplier(),
 		}
 
 		// The following business logic should not sit in the API. However, it is
@@ -271,6 +299,18 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 			TargetVectors:   targetVectors,
 			Distance:        distance,
 			WithDistance:    withDistance,
+			Phrase:          hs.Phrase,
+			Slop:            int(hs.Slop),
+			Fuzziness:       int(hs.Fuzziness),
+		}
+		if hs.Slop > 0 && !hs.Phrase {
+			return dto.GetParams{}, fmt.Errorf("slop can only be set together with phrase")
+		}
+		if hs.Fuzziness > 2 {
+			return dto.GetParams{}, fmt.Errorf("fuzziness must be between 0 and 2, got %d", hs.Fuzziness)
+		}
+		if hs.Fuzziness > 0 && hs.Phrase {
+			return dto.GetParams{}, fmt.Errorf("fuzziness cannot be combined with phrase")
 		}
 
 		if nearVec != nil {
@@ -288,6 +328,21 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 			}
 		}
 
+		if nearSparseVec := hs.NearSparseVector; nearSparseVec != nil {
+			// the sparse vector replaces the keyword leg and therefore has its own
+			// targets, the ones of the hybrid message point to the dense vectors
+			sparseTargets := nearSparseVec.GetTargets().GetTargetVectors()
+			for _, target := range sparseTargets {
+				if _, ok := class.VectorConfig[target]; !ok {
+					return dto.GetParams{}, fmt.Errorf("class %s does not have named vector %v configured", class.Class, target)
+				}
+			}
+			out.HybridSearch.NearSparseVectorParams, err = parseNearSparseVec(nearSparseVec, sparseTargets)
+			if err != nil {
+				return dto.GetParams{}, err
+			}
+		}
+
 		if nearTxt != nil {
 			out.HybridSearch.NearTextParams = &searchparams.NearTextParams{
 				Values:        nearTxt.Values,
@@ -329,6 +384,13 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 		out.Cursor = &filters.Cursor{After: req.After, Limit: out.Pagination.Limit}
 	}
 
+	if req.Snapshot != nil {
+		if req.GroupBy != nil {
+			return dto.GetParams{}, errors.New("snapshot cannot be combined with group by")
+		}
+		out.AdditionalProperties.Snapshot = req.GetSnapshot()
+	}
+
 	if req.Filters != nil {
 		clause, err := ExtractFilters(req.Filters, p.authorizedGetClass, req.Collection)
 		if err != nil {
@@ -342,7 +404,7 @@ func (p *Parser) Search(req *pb.SearchRequest, config *config.Config) (dto.GetPa
 	}
 
 	if len(req.SortBy) > 0 {
-		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
+		if req.NearText != nil || req.NearVideo != nil || req.NearAudio != nil || req.NearImage != nil || req.NearObject != nil || req.NearVector != nil || req.NearSparseVector != nil || req.HybridSearch != nil || req.Bm25Search != nil || req.Generative != nil {
 			return dto.GetParams{}, errors.New("sorting cannot be combined with search")
 		}
 		out.Sort = extractSorting(req.SortBy)
@@ -454,6 +516,9 @@ func extractTargetVectors(req *pb.SearchRequest, class *models.Class) ([]string,
 	if nv := req.NearVideo; nv != nil {
 		targetVectors, targets, vectorSearch = extract(nv.Targets, &nv.TargetVectors)
 	}
+	if ns := req.NearSparseVector; ns != nil {
+		targetVectors, targets, vectorSearch = extract(ns.Targets, &[]string{})
+	}
 
 	var combination *dto.TargetCombination
 	if targets != nil {
@@ -877,6 +942,14 @@ func extractAdditionalPropsFromMetadata(class *models.Class, prop *pb.MetadataRe
 		ExplainScore:       prop.ExplainScore,
 		IsConsistent:       prop.IsConsistent,
 		Vectors:            prop.Vectors,
+		Cursor:             prop.Cursor,
+	}
+
+	if prop.Highlights != nil {
+		props.Highlights = &additional.Highlights{
+			FragmentSize: int(prop.Highlights.FragmentSize),
+			MaxFragments: int(prop.Highlights.MaxFragments),
+		}
 	}
 
 	if vectorSearch && configvalidation.CheckCertaintyCompatibility(class, targetVectors) != nil {
@@ -908,7 +981,9 @@ func isIdOnlyRequest(metadata *pb.MetadataRequest) bool {
 		!metadata.Certainty &&
 		!metadata.Score &&
 		!metadata.ExplainScore &&
-		!metadata.IsConsistent)
+		!metadata.IsConsistent &&
+		!metadata.Cursor &&
+		metadata.Highlights == nil)
 }
 
 func getAllNonRefNonBlobProperties(authorizedGetClass func(string) (*models.Class, error), className string) ([]search.SelectProperty, error) {
@@ -1193,10 +1268,51 @@ func parseNearVec(nv *pb.NearVector, targetVectors []string) (*searchparams.Near
 		return nil, fmt.Errorf("near_vector: vector is required")
 	}
 
+	if err := searchparams.ValidateRescoreMultiplier(nv.GetRescoreMultiplier()); err != nil {
+		return nil, fmt.Errorf("near_vector: %w", err)
+	}
+
+	var cursor *additional.DistanceCursor
+	if nv.Cursor != nil {
+		var err error
+		cursor, err = additional.DecodeDistanceCursor(nv.GetCursor())
+		if err != nil {
+			return nil, fmt.Errorf("near_vector: %w", err)
+		}
+	}
+
 	return &searchparams.NearVector{
+		Vectors:           vectors,
+		TargetVectors:     targetVectors,
+		RescoreMultiplier: nv.GetRescoreMultiplier(),
+		Cursor:            cursor,
+	}, nil
+}
+
+func parseNearSparseVec(ns *pb.NearSparseVector, targetVectors []string) (*searchparams.NearVector, error) {
+	if ns.Vector == nil || len(ns.Vector.Indices) == 0 {
+		return nil, fmt.Errorf("near_sparse_vector: vector is required")
+	}
+	if len(ns.Vector.Indices) != len(ns.Vector.Values) {
+		return nil, fmt.Errorf("near_sparse_vector: vector has %d indices, but %d values",
+			len(ns.Vector.Indices), len(ns.Vector.Values))
+	}
+	vector := models.SparseVector{Indices: ns.Vector.Indices, Values: ns.Vector.Values}
+
+	vectors := make([]models.Vector, max(len(targetVectors), 1))
+	for i := range vectors {
+		vectors[i] = vector
+	}
+
+	out := &searchparams.NearVector{
 		Vectors:       vectors,
 		TargetVectors: targetVectors,
-	}, nil
+	}
+	if ns.Distance != nil {
+		out.Distance = *ns.Distance
+		out.WithDistance = true
+	}
+	return out, nil
 }
 
 func indexOf(slice []string, value string) int {
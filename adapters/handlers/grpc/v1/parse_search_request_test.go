@@ -53,6 +53,12 @@ func TestGRPCRequest(t *testing.T) {
 	multiVecClass := "MultiVecClass"
 	singleNamedVecClass := "SingleNamedVecClass"
 	one := float64(1.0)
+	half := float64(0.5)
+	two := float64(2.0)
+	cursor := additional.DistanceCursor{Distance: 0.5, ID: UUID1}
+	encodedCursor := cursor.Encode()
+	invalidCursor := "not-a-cursor"
+	snapshot := "snapshot-token"
 
 	defaultTestClassProps := search.SelectProperties{{Name: "name", IsPrimitive: true}, {Name: "number", IsPrimitive: true}, {Name: "floats", IsPrimitive: true}, {Name: "uuid", IsPrimitive: true}}
 	defaultNamedVecProps := search.SelectProperties{{Name: "first", IsPrimitive: true}}
@@ -378,6 +384,185 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "Near vector with rescore multiplier",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearVector: &pb.NearVector{
+					Vector:            []float32{1, 2, 3},
+					TargetVectors:     []string{"custom"},
+					RescoreMultiplier: &two,
+				},
+			},
+			out: dto.GetParams{
+				ClassName:            multiVecClass,
+				Pagination:           defaultPagination,
+				Properties:           search.SelectProperties{},
+				AdditionalProperties: additional.Properties{NoProps: true},
+				NearVector: &searchparams.NearVector{
+					TargetVectors:     []string{"custom"},
+					Vectors:           []models.Vector{[]float32{1, 2, 3}},
+					RescoreMultiplier: 2,
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Near vector with rescore multiplier below 1",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearVector: &pb.NearVector{
+					Vector:            []float32{1, 2, 3},
+					TargetVectors:     []string{"custom"},
+					RescoreMultiplier: &half,
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "Near vector with distance cursor",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				Properties: &pb.PropertiesRequest{},
+				Metadata:   &pb.MetadataRequest{Cursor: true},
+				NearVector: &pb.NearVector{
+					Vector:   []float32{1, 2, 3},
+					Distance: &one,
+					Cursor:   &encodedCursor,
+				},
+			},
+			out: dto.GetParams{
+				ClassName:            classname,
+				Pagination:           defaultPagination,
+				Properties:           search.SelectProperties{},
+				AdditionalProperties: additional.Properties{Cursor: true, NoProps: true},
+				NearVector: &searchparams.NearVector{
+					Vectors:      []models.Vector{[]float32{1, 2, 3}},
+					Distance:     1,
+					WithDistance: true,
+					Cursor:       &cursor,
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Near vector with invalid distance cursor",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				Properties: &pb.PropertiesRequest{},
+				NearVector: &pb.NearVector{
+					Vector:   []float32{1, 2, 3},
+					Distance: &one,
+					Cursor:   &invalidCursor,
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "Cursor with snapshot",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				Properties: &pb.PropertiesRequest{},
+				Snapshot:   &snapshot,
+				After:      string(UUID1),
+			},
+			out: dto.GetParams{
+				ClassName:            classname,
+				Pagination:           defaultPagination,
+				Properties:           search.SelectProperties{},
+				AdditionalProperties: additional.Properties{NoProps: true, Snapshot: snapshot},
+				Cursor:               &filters.Cursor{After: string(UUID1), Limit: defaultPagination.Limit},
+			},
+			error: false,
+		},
+		{
+			name: "Snapshot with group by",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				Properties: &pb.PropertiesRequest{},
+				Snapshot:   &snapshot,
+				GroupBy:    &pb.GroupBy{Path: []string{"name"}, NumberOfGroups: 2, ObjectsPerGroup: 3},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "Near sparse vector",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearSparseVector: &pb.NearSparseVector{
+					Vector:   &pb.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}},
+					Distance: &one,
+					Targets:  &pb.Targets{TargetVectors: []string{"custom"}},
+				},
+			},
+			out: dto.GetParams{
+				ClassName:               multiVecClass,
+				Pagination:              defaultPagination,
+				Properties:              search.SelectProperties{},
+				AdditionalProperties:    additional.Properties{NoProps: true},
+				TargetVectorCombination: &dto.TargetCombination{Type: dto.Minimum, Weights: []float32{0}},
+				NearVector: &searchparams.NearVector{
+					TargetVectors: []string{"custom"},
+					Vectors:       []models.Vector{models.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}}},
+					Distance:      1,
+					WithDistance:  true,
+				},
+			},
+			error: false,
+		},
+		{
+			name: "Near sparse vector with mismatching indices and values",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				NearSparseVector: &pb.NearSparseVector{
+					Vector:  &pb.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5}},
+					Targets: &pb.Targets{TargetVectors: []string{"custom"}},
+				},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "Hybrid with near sparse vector",
+			req: &pb.SearchRequest{
+				Collection: multiVecClass,
+				Properties: &pb.PropertiesRequest{},
+				HybridSearch: &pb.Hybrid{
+					Alpha:   0.5,
+					Query:   "query",
+					Targets: &pb.Targets{TargetVectors: []string{"first"}},
+					NearSparseVector: &pb.NearSparseVector{
+						Vector:  &pb.SparseVector{Indices: []uint32{3}, Values: []float32{0.5}},
+						Targets: &pb.Targets{TargetVectors: []string{"custom"}},
+					},
+				},
+			},
+			out: dto.GetParams{
+				ClassName:               multiVecClass,
+				Pagination:              defaultPagination,
+				Properties:              search.SelectProperties{},
+				AdditionalProperties:    additional.Properties{NoProps: true},
+				TargetVectorCombination: &dto.TargetCombination{Type: dto.Minimum, Weights: []float32{0}},
+				HybridSearch: &searchparams.HybridSearch{
+					Alpha:           0.5,
+					Query:           "query",
+					FusionAlgorithm: common_filters.HybridRelativeScoreFusion,
+					TargetVectors:   []string{"first"},
+					NearSparseVectorParams: &searchparams.NearVector{
+						TargetVectors: []string{"custom"},
+						Vectors:       []models.Vector{models.SparseVector{Indices: []uint32{3}, Values: []float32{0.5}}},
+					},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "Vectors throws error if no target vectors are given",
 			req: &pb.SearchRequest{
@@ -645,6 +830,78 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "bm25 phrase",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "query", Properties: []string{"name"}, Phrase: true, Slop: 2},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: "query", Properties: []string{"name"}, Type: "bm25", Phrase: true, Slop: 2},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 slop without phrase",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "query", Properties: []string{"name"}, Slop: 2},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "bm25 slop above the maximum",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "query", Properties: []string{"name"}, Phrase: true, Slop: 100},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
+		{
+			name: "bm25 fuzziness",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "query", Properties: []string{"name"}, Fuzziness: 1},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: "query", Properties: []string{"name"}, Type: "bm25", Fuzziness: 1},
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 highlights",
+			req: &pb.SearchRequest{
+				Collection: classname,
+				Metadata:   &pb.MetadataRequest{Highlights: &pb.HighlightsRequest{FragmentSize: 50, MaxFragments: 2}},
+				Bm25Search: &pb.BM25{Query: "query", Properties: []string{"name"}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				KeywordRanking: &searchparams.KeywordRanking{Query: "query", Properties: []string{"name"}, Type: "bm25"},
+				Properties:     defaultTestClassProps,
+				AdditionalProperties: additional.Properties{
+					Highlights: &additional.Highlights{FragmentSize: 50, MaxFragments: 2},
+				},
+			},
+			error: false,
+		},
+		{
+			name: "bm25 fuzziness too large",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Bm25Search: &pb.BM25{Query: "query", Properties: []string{"name"}, Fuzziness: 3},
+			},
+			out:   dto.GetParams{},
+			error: true,
+		},
 		{
 			name: "bm25 groupby",
 			req: &pb.SearchRequest{
@@ -705,6 +962,26 @@ func TestGRPCRequest(t *testing.T) {
 			},
 			error: false,
 		},
+		{
+			name: "filter fuzzy",
+			req: &pb.SearchRequest{
+				Collection: classname, Metadata: &pb.MetadataRequest{Vector: true},
+				Filters: &pb.Filters{Operator: pb.Filters_OPERATOR_FUZZY, TestValue: &pb.Filters_ValueText{ValueText: "tset"}, Target: &pb.FilterTarget{Target: &pb.FilterTarget_Property{Property: "name"}}},
+			},
+			out: dto.GetParams{
+				ClassName: classname, Pagination: defaultPagination,
+				Properties:           defaultTestClassProps,
+				AdditionalProperties: additional.Properties{Vector: true, NoProps: false},
+				Filters: &filters.LocalFilter{
+					Root: &filters.Clause{
+						On:       &filters.Path{Class: schema.ClassName(classname), Property: "name"},
+						Operator: filters.OperatorFuzzy,
+						Value:    &filters.Value{Value: "tset", Type: schema.DataTypeText},
+					},
+				},
+			},
+			error: false,
+		},
 		{
 			name: "filter uuid",
 			req: &pb.SearchRequest{
@@ -97,10 +97,35 @@ func (r *Replier) Search(res []interface{}, start time.Time, searchParams dto.Ge
 		}
 		out.GenerativeGroupedResult = &generativeGroupResponse
 		out.Results = objects
+
+		if searchParams.AdditionalProperties.Cursor {
+			out.NextCursor = extractNextCursor(res)
+		}
 	}
 	return out, nil
 }
 
+// extractNextCursor returns the cursor of the last result, which continues a
+// distance-threshold search on the next page. Nil means there is no next page.
+func extractNextCursor(res []interface{}) *string {
+	if len(res) == 0 {
+		return nil
+	}
+	asMap, ok := res[len(res)-1].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	additionalProps, ok := asMap["_additional"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	cursor, ok := additionalProps["cursor"].(string)
+	if !ok || cursor == "" {
+		return nil
+	}
+	return &cursor
+}
+
 func (r *Replier) extractObjectsToResults(res []interface{}, searchParams dto.GetParams, scheme schema.Schema, fromGroup bool) ([]*pb.SearchResult, string, error) {
 	results := make([]*pb.SearchResult, len(res))
 	generativeGroupResultsReturn := ""
@@ -263,6 +288,11 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 								Index:       uint64(i),
 							})
 						}
+					case models.SparseVector:
+						metadata.Vectors = append(metadata.Vectors, &pb.Vectors{
+							SparseVector: &pb.SparseVector{Indices: vec.Indices, Values: vec.Values},
+							Name:         name,
+						})
 					default:
 						// do nothing
 					}
@@ -355,6 +385,22 @@ func (r *Replier) extractAdditionalProps(asMap map[string]any, additionalPropsPa
 		}
 	}
 
+	if additionalPropsParams.Highlights != nil {
+		highlights, ok := additionalPropertiesMap["highlights"]
+		if ok {
+			highlightsfmt, ok2 := highlights.([]additional.Highlight)
+			if ok2 {
+				metadata.Highlights = make([]*pb.Highlight, len(highlightsfmt))
+				for i, highlight := range highlightsfmt {
+					metadata.Highlights[i] = &pb.Highlight{
+						Property:  highlight.Property,
+						Fragments: highlight.Fragments,
+					}
+				}
+			}
+		}
+	}
+
 	return metadata, generativeGroupResults, generativeResult, nil
 }
 
@@ -229,6 +229,23 @@ func TestGRPCReply(t *testing.T) {
 			},
 			usesWeaviateStruct: true,
 		},
+		{
+			name: "sparse named vector",
+			res: []interface{}{
+				map[string]interface{}{
+					"_additional": map[string]interface{}{"vectors": map[string]models.Vector{
+						"splade": models.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}},
+					}},
+				},
+			},
+			searchParams: dto.GetParams{AdditionalProperties: additional.Properties{Vectors: []string{"splade"}}},
+			outSearch: []*pb.SearchResult{
+				{Metadata: &pb.MetadataResult{Vectors: []*pb.Vectors{
+					{Name: "splade", SparseVector: &pb.SparseVector{Indices: []uint32{3, 17}, Values: []float32{0.5, 1.25}}},
+				}}, Properties: &pb.PropertiesResult{}},
+			},
+			usesWeaviateStruct: true,
+		},
 		{
 			name: "all additional",
 			res: []interface{}{
@@ -1611,6 +1628,67 @@ func TestGRPCReply(t *testing.T) {
 	}
 }
 
+func TestGRPCReplyNextCursor(t *testing.T) {
+	scheme := schema.Schema{Objects: &models.Schema{}}
+	params := dto.GetParams{AdditionalProperties: additional.Properties{Cursor: true}}
+	replier := NewReplier(true, false, false, fakeGenerativeParams{}, nil)
+
+	t.Run("cursor of last result", func(t *testing.T) {
+		res := []interface{}{
+			map[string]interface{}{"_additional": map[string]interface{}{"cursor": "first"}},
+			map[string]interface{}{"_additional": map[string]interface{}{"cursor": "second"}},
+		}
+		out, err := replier.Search(res, time.Now(), params, scheme)
+		require.Nil(t, err)
+		require.NotNil(t, out.NextCursor)
+		require.Equal(t, "second", *out.NextCursor)
+	})
+
+	t.Run("no results", func(t *testing.T) {
+		out, err := replier.Search([]interface{}{}, time.Now(), params, scheme)
+		require.Nil(t, err)
+		require.Nil(t, out.NextCursor)
+	})
+
+	t.Run("cursor not requested", func(t *testing.T) {
+		res := []interface{}{
+			map[string]interface{}{"_additional": map[string]interface{}{"cursor": "first"}},
+		}
+		out, err := replier.Search(res, time.Now(), dto.GetParams{}, scheme)
+		require.Nil(t, err)
+		require.Nil(t, out.NextCursor)
+	})
+}
+
+func TestGRPCReplyHighlights(t *testing.T) {
+	scheme := schema.Schema{Objects: &models.Schema{}}
+	replier := NewReplier(true, false, false, fakeGenerativeParams{}, nil)
+	res := []interface{}{
+		map[string]interface{}{"_additional": map[string]interface{}{
+			"highlights": []additional.Highlight{
+				{Property: "name", Fragments: []string{"the <em>quick</em> fox"}},
+			},
+		}},
+	}
+
+	t.Run("highlights requested", func(t *testing.T) {
+		params := dto.GetParams{AdditionalProperties: additional.Properties{Highlights: &additional.Highlights{}}}
+		out, err := replier.Search(res, time.Now(), params, scheme)
+		require.Nil(t, err)
+		require.Len(t, out.Results, 1)
+		require.Equal(t, []*pb.Highlight{
+			{Property: "name", Fragments: []string{"the <em>quick</em> fox"}},
+		}, out.Results[0].Metadata.Highlights)
+	})
+
+	t.Run("highlights not requested", func(t *testing.T) {
+		out, err := replier.Search(res, time.Now(), dto.GetParams{}, scheme)
+		require.Nil(t, err)
+		require.Len(t, out.Results, 1)
+		require.Nil(t, out.Results[0].Metadata.Highlights)
+	})
+}
+
 type fakeGenerativeParams struct{}
 
 func (f fakeGenerativeParams) ProviderName() string {
@@ -42,6 +42,7 @@ type Service struct {
 	allowAnonymousAccess bool
 	schemaManager        *schemaManager.Manager
 	batchManager         *objects.BatchManager
+	objectsManager       *objects.Manager
 	config               *config.Config
 	authorizer           authorization.Authorizer
 	logger               logrus.FieldLogger
@@ -49,8 +50,8 @@ type Service struct {
 
 func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 	allowAnonymousAccess bool, schemaManager *schemaManager.Manager,
-	batchManager *objects.BatchManager, config *config.Config, authorization authorization.Authorizer,
-	logger logrus.FieldLogger,
+	batchManager *objects.BatchManager, objectsManager *objects.Manager, config *config.Config,
+	authorization authorization.Authorizer, logger logrus.FieldLogger,
 ) *Service {
 	return &Service{
 		traverser:            traverser,
@@ -58,6 +59,7 @@ func NewService(traverser *traverser.Traverser, authComposer composer.TokenFunc,
 		allowAnonymousAccess: allowAnonymousAccess,
 		schemaManager:        schemaManager,
 		batchManager:         batchManager,
+		objectsManager:       objectsManager,
 		config:               config,
 		logger:               logger,
 		authorizer:           authorization,
@@ -84,6 +86,58 @@ func (s *Service) TenantsGet(ctx context.Context, req *pb.TenantsGetRequest) (*p
 	return result, nil
 }
 
+func (s *Service) KeyValueGet(ctx context.Context, req *pb.KeyValueGetRequest) (*pb.KeyValueGetReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	result, err := s.keyValueGet(ctx, principal, req)
+	if err != nil {
+		return nil, fmt.Errorf("key-value get: %w", err)
+	}
+	return result, nil
+}
+
+func (s *Service) KeyValuePut(ctx context.Context, req *pb.KeyValuePutRequest) (*pb.KeyValuePutReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	result, err := s.keyValuePut(ctx, principal, req)
+	if err != nil {
+		return nil, fmt.Errorf("key-value put: %w", err)
+	}
+	return result, nil
+}
+
+func (s *Service) KeyValueDelete(ctx context.Context, req *pb.KeyValueDeleteRequest) (*pb.KeyValueDeleteReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	result, err := s.keyValueDelete(ctx, principal, req)
+	if err != nil {
+		return nil, fmt.Errorf("key-value delete: %w", err)
+	}
+	return result, nil
+}
+
+func (s *Service) KeyValueCompareAndSwap(ctx context.Context, req *pb.KeyValueCompareAndSwapRequest) (*pb.KeyValueCompareAndSwapReply, error) {
+	principal, err := s.principalFromContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("extract auth: %w", err)
+	}
+
+	result, err := s.keyValueCompareAndSwap(ctx, principal, req)
+	if err != nil {
+		return nil, fmt.Errorf("key-value compare and swap: %w", err)
+	}
+	return result, nil
+}
+
 func (s *Service) BatchDelete(ctx context.Context, req *pb.BatchDeleteRequest) (*pb.BatchDeleteReply, error) {
 	var result *pb.BatchDeleteReply
 	var errInner error
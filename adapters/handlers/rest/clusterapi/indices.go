@@ -65,6 +65,7 @@ type indices struct {
 	regexpShardFiles          *regexp.Regexp
 	regexpShard               *regexp.Regexp
 	regexpShardReinit         *regexp.Regexp
+	regexpShardSnapshot       *regexp.Regexp
 
 	logger logrus.FieldLogger
 }
@@ -105,6 +106,8 @@ const (
 		`\/shards\/(` + sh + `)$`
 	urlPatternShardReinit = `\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `):reinit`
+	urlPatternShardSnapshot = `\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/snapshots\/(` + ob + `)`
 )
 
 type shards interface {
@@ -141,6 +144,9 @@ type shards interface {
 	GetShardStatus(ctx context.Context, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, indexName, shardName,
 		targetStatus string, schemaVersion uint64) error
+	OpenSnapshot(ctx context.Context, indexName, shardName, token string,
+		ttl time.Duration) error
+	ReleaseSnapshot(ctx context.Context, indexName, shardName, token string) error
 
 	// Replication-specific
 	OverwriteObjects(ctx context.Context, indexName, shardName string,
@@ -181,6 +187,7 @@ func NewIndices(shards shards, db db, auth auth, maintenanceModeEnabled func() b
 		regexpShardFiles:          regexp.MustCompile(urlPatternShardFiles),
 		regexpShard:               regexp.MustCompile(urlPatternShard),
 		regexpShardReinit:         regexp.MustCompile(urlPatternShardReinit),
+		regexpShardSnapshot:       regexp.MustCompile(urlPatternShardSnapshot),
 		shards:                    shards,
 		db:                        db,
 		auth:                      auth,
@@ -313,6 +320,18 @@ func (i *indices) indicesHandler() http.HandlerFunc {
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 
+		case i.regexpShardSnapshot.MatchString(path):
+			if r.Method == http.MethodPost {
+				i.postShardSnapshot().ServeHTTP(w, r)
+				return
+			}
+			if r.Method == http.MethodDelete {
+				i.deleteShardSnapshot().ServeHTTP(w, r)
+				return
+			}
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+
 		case i.regexpShardFiles.MatchString(path):
 			if r.Method == http.MethodPost {
 				i.postShardFile().ServeHTTP(w, r)
@@ -1282,6 +1301,81 @@ func (i *indices) postUpdateShardStatus() http.Handler {
 	})
 }
 
+func (i *indices) postShardSnapshot() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardSnapshot.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard, token := args[1], args[2], args[3]
+
+		defer r.Body.Close()
+		reqPayload, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, "read request body: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		ct, ok := IndicesPayloads.OpenSnapshotParams.CheckContentTypeHeaderReq(r)
+		if !ok {
+			http.Error(w, errors.Errorf("unexpected content type: %s", ct).Error(),
+				http.StatusUnsupportedMediaType)
+			return
+		}
+
+		ttl, err := IndicesPayloads.OpenSnapshotParams.Unmarshal(reqPayload)
+		if err != nil {
+			http.Error(w, "unmarshal open snapshot params from json: "+err.Error(),
+				http.StatusBadRequest)
+			return
+		}
+
+		err = i.shards.OpenSnapshot(r.Context(), index, shard, token, ttl)
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusCreated)
+	})
+}
+
+func (i *indices) deleteShardSnapshot() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := i.regexpShardSnapshot.FindStringSubmatch(r.URL.Path)
+		if len(args) != 4 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard, token := args[1], args[2], args[3]
+
+		defer r.Body.Close()
+
+		err := i.shards.ReleaseSnapshot(r.Context(), index, shard, token)
+		if err != nil && errors.Is(err, reposdb.ErrSnapshotNotFound) {
+			http.Error(w, err.Error(), http.StatusNotFound)
+			return
+		}
+		if err != nil && errors.As(err, &enterrors.ErrUnprocessable{}) {
+			http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
 func (i *indices) postShardFile() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		args := i.regexpShardFiles.FindStringSubmatch(r.URL.Path)
@@ -62,6 +62,7 @@ type indicesPayloads struct {
 	GetShardStatusResults     getShardStatusResultsPayload
 	UpdateShardStatusParams   updateShardStatusParamsPayload
 	UpdateShardsStatusResults updateShardsStatusResultsPayload
+	OpenSnapshotParams        openSnapshotParamsPayload
 	ShardFiles                shardFilesPayload
 	IncreaseReplicationFactor increaseReplicationFactorPayload
 }
@@ -494,12 +495,46 @@ func (p *searchParametersPayload) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	return fmt.Errorf("searchVectors: cannot unmarshal into either [][]float32 or [][][]float32: %v", aux.SearchVectors)
+	// Sparse vectors, possibly mixed with dense ones when searching multiple
+	// target vectors, are unmarshaled one by one
+	var rawVectors []json.RawMessage
+	if err := json.Unmarshal(aux.SearchVectors, &rawVectors); err == nil {
+		asVectors := make([]models.Vector, len(rawVectors))
+		for i := range rawVectors {
+			vector, err := unmarshalSearchVector(rawVectors[i])
+			if err != nil {
+				return fmt.Errorf("searchVectors: %w", err)
+			}
+			asVectors[i] = vector
+		}
+		if len(asVectors) > 0 {
+			p.SearchVectors = asVectors
+		}
+		return nil
+	}
+
+	return fmt.Errorf("searchVectors: cannot unmarshal into either [][]float32, [][][]float32 or sparse vectors: %v", aux.SearchVectors)
+}
+
+func unmarshalSearchVector(data json.RawMessage) (models.Vector, error) {
+	var vector []float32
+	if err := json.Unmarshal(data, &vector); err == nil {
+		return vector, nil
+	}
+	var multiVector [][]float32
+	if err := json.Unmarshal(data, &multiVector); err == nil {
+		return multiVector, nil
+	}
+	var sparseVector models.SparseVector
+	if err := json.Unmarshal(data, &sparseVector); err == nil {
+		return sparseVector, nil
+	}
+	return nil, fmt.Errorf("cannot unmarshal vector into either []float32, [][]float32 or a sparse vector: %s", data)
 }
 
 type searchParamsPayload struct{}
 
-func (p searchParamsPayload) Marshal(vectors []models.Vector, targetVectors []string, limit int,
+func (p searchParamsPayload) Marshal(vectors []models.Vector, targetVectors []string, distance float32, limit int,
 	filter *filters.LocalFilter, keywordRanking *searchparams.KeywordRanking,
 	sort []filters.Sort, cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 	addP additional.Properties, targetCombination *dto.TargetCombination, properties []string,
@@ -507,6 +542,7 @@ func (p searchParamsPayload) Marshal(vectors []models.Vector, targetVectors []st
 	type params struct {
 		SearchVector      []float32                    `json:"searchVector"`
 		TargetVector      string                       `json:"targetVector"`
+		Distance          float32                      `json:"distance"`
 		Limit             int                          `json:"limit"`
 		Filters           *filters.LocalFilter         `json:"filters"`
 		KeywordRanking    *searchparams.KeywordRanking `json:"keywordRanking"`
@@ -523,15 +559,19 @@ func (p searchParamsPayload) Marshal(vectors []models.Vector, targetVectors []st
 	var targetVector string
 	// BC with pre 1.26
 	if len(vectors) == 1 {
-		if v, ok := vectors[0].([]float32); ok {
+		switch v := vectors[0].(type) {
+		case []float32:
 			vector = v
 			targetVector = targetVectors[0]
-		} else {
+		case models.SparseVector:
+			// sparse vectors did not exist before 1.26, they are only sent in
+			// searchVectors
+		default:
 			return nil, fmt.Errorf("vector should be of []float32 type but is %T", vectors[0])
 		}
 	}
 
-	par := params{vector, targetVector, limit, filter, keywordRanking, sort, cursor, groupBy, addP, vectors, targetVectors, targetCombination, properties}
+	par := params{vector, targetVector, distance, limit, filter, keywordRanking, sort, cursor, groupBy, addP, vectors, targetVectors, targetCombination, properties}
 	return json.Marshal(par)
 }
 
@@ -969,6 +1009,39 @@ func (p updateShardsStatusResultsPayload) CheckContentTypeHeader(r *http.Respons
 	return ct, ct == p.MIME()
 }
 
+type openSnapshotParamsPayload struct{}
+
+func (p openSnapshotParamsPayload) Marshal(ttl time.Duration) ([]byte, error) {
+	type params struct {
+		TTL time.Duration `json:"ttl"`
+	}
+
+	par := params{ttl}
+	return json.Marshal(par)
+}
+
+func (p openSnapshotParamsPayload) Unmarshal(in []byte) (time.Duration, error) {
+	type params struct {
+		TTL time.Duration `json:"ttl"`
+	}
+	var par params
+	err := json.Unmarshal(in, &par)
+	return par.TTL, err
+}
+
+func (p openSnapshotParamsPayload) MIME() string {
+	return "vnd.weaviate.opensnapshotparams+json"
+}
+
+func (p openSnapshotParamsPayload) CheckContentTypeHeaderReq(r *http.Request) (string, bool) {
+	ct := r.Header.Get("content-type")
+	return ct, ct == p.MIME()
+}
+
+func (p openSnapshotParamsPayload) SetContentTypeHeaderReq(r *http.Request) {
+	r.Header.Set("content-type", p.MIME())
+}
+
 type shardFilesPayload struct{}
 
 func (p shardFilesPayload) MIME() string {
@@ -163,17 +163,34 @@ func TestBackwardCompatibilitySearch(t *testing.T) {
 			Targets:       []string{"target1"},
 			compatible:    true,
 		},
+		{
+			SearchVectors: []models.Vector{
+				models.SparseVector{Indices: []uint32{4, 8}, Values: []float32{0.5, 1.5}},
+			},
+			Targets:    []string{"target1"},
+			compatible: false,
+		},
+		{
+			SearchVectors: []models.Vector{
+				[]float32{1, 2, 3},
+				models.SparseVector{Indices: []uint32{4, 8}, Values: []float32{0.5, 1.5}},
+			},
+			Targets:    []string{"target1", "target2"},
+			compatible: false,
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run("test", func(t *testing.T) {
-			b126, err := payload.Marshal(tt.SearchVectors, tt.Targets, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil)
+			b126, err := payload.Marshal(tt.SearchVectors, tt.Targets, 0.5, 10, nil, nil, nil, nil, nil, additional.Properties{}, nil, nil)
 			require.Nil(t, err)
 
-			vecs, targets, _, _, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b126)
+			vecs, targets, distance, limit, _, _, _, _, _, _, _, _, err := payload.Unmarshal(b126)
 			require.Nil(t, err)
 			assert.Equal(t, tt.SearchVectors, vecs)
 			assert.Equal(t, tt.Targets, targets)
+			assert.Equal(t, float32(0.5), distance)
+			assert.Equal(t, 10, limit)
 
 			if tt.compatible {
 				payloadOld := searchParamsPayloadOld{}
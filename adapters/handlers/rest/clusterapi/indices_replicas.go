@@ -46,6 +46,8 @@ type replicator interface {
 		requestID string, uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) replica.SimpleResponse
 	ReplicateReferences(ctx context.Context, indexName, shardName,
 		requestID string, refs []objects.BatchReference, schemaVersion uint64) replica.SimpleResponse
+	ReplicateKeyValue(ctx context.Context, indexName, shardName,
+		requestID string, write *objects.KeyValueWrite, schemaVersion uint64) replica.SimpleResponse
 	CommitReplication(indexName, shardName, requestID string) interface{}
 	AbortReplication(indexName, shardName, requestID string) interface{}
 	OverwriteObjects(ctx context.Context, index, shard string,
@@ -61,6 +63,8 @@ type replicator interface {
 		initialToken, finalToken uint64, limit int) (result []replica.RepairResponse, lastTokenRead uint64, err error)
 	HashTreeLevel(ctx context.Context, index, shard string,
 		level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+	FetchKeyValue(ctx context.Context, indexName, shardName,
+		key string) (objects.KeyValue, error)
 }
 
 type localScaler interface {
@@ -86,6 +90,8 @@ var (
 		`\/shards\/(` + sh + `)\/objects/_digest`)
 	regexObjectsDigestsInTokenRange = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects/digestsInTokenRange`)
+	regxKeyValue = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
+		`\/shards\/(` + sh + `)\/objects\/_kv`)
 	regxHashTreeLevel = regexp.MustCompile(`\/indices\/(` + cl + `)` +
 		`\/shards\/(` + sh + `)\/objects\/hashtree\/(` + l + `)`)
 	regxObjects = regexp.MustCompile(`\/replicas\/indices\/(` + cl + `)` +
@@ -143,6 +149,19 @@ func (i *replicatedIndices) indicesHandler() http.HandlerFunc {
 				return
 			}
 
+			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
+			return
+		case regxKeyValue.MatchString(path):
+			if r.Method == http.MethodGet {
+				i.getKeyValue().ServeHTTP(w, r)
+				return
+			}
+
+			if r.Method == http.MethodPut {
+				i.putKeyValue().ServeHTTP(w, r)
+				return
+			}
+
 			http.Error(w, "405 Method not Allowed", http.StatusMethodNotAllowed)
 			return
 		case regxOverwriteObjects.MatchString(path):
@@ -874,6 +893,89 @@ func (i *replicatedIndices) postRefs() http.Handler {
 	})
 }
 
+func (i *replicatedIndices) putKeyValue() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxKeyValue.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		requestID := r.URL.Query().Get(replica.RequestKey)
+		if requestID == "" {
+			http.Error(w, "request_id not provided", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+
+		defer r.Body.Close()
+		bodyBytes, err := io.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var write objects.KeyValueWrite
+		if err := json.Unmarshal(bodyBytes, &write); err != nil {
+			http.Error(w, "unmarshal key-value write from json: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		schemaVersion, err := extractSchemaVersionFromUrlQuery(r.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		resp := i.shards.ReplicateKeyValue(r.Context(), index, shard, requestID, &write, schemaVersion)
+		if localIndexNotReady(resp) {
+			http.Error(w, resp.FirstError().Error(), http.StatusServiceUnavailable)
+			return
+		}
+
+		b, err := json.Marshal(resp)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("unmarshal resp: %+v, error: %v", resp, err),
+				http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(b)
+	})
+}
+
+func (i *replicatedIndices) getKeyValue() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		args := regxKeyValue.FindStringSubmatch(r.URL.Path)
+		if len(args) != 3 {
+			http.Error(w, "invalid URI", http.StatusBadRequest)
+			return
+		}
+
+		index, shard := args[1], args[2]
+		key := r.URL.Query().Get("key")
+		if key == "" {
+			http.Error(w, "key not provided", http.StatusBadRequest)
+			return
+		}
+
+		kv, err := i.shards.FetchKeyValue(r.Context(), index, shard, key)
+		if err != nil {
+			http.Error(w, "fetch key-value entry: "+err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		b, err := json.Marshal(kv)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Write(b)
+	})
+}
+
 func localIndexNotReady(resp replica.SimpleResponse) bool {
 	if err := resp.FirstError(); err != nil {
 		re, ok := err.(*replica.Error)
@@ -42,6 +42,8 @@ func TestMaintenanceModeReplicatedIndices(t *testing.T) {
 		{"PATCH", "/objects/deadbeef"},
 		{"GET", "/objects/deadbeef"},
 		{"POST", "/objects/references"},
+		{"GET", "/objects/_kv"},
+		{"PUT", "/objects/_kv"},
 		{"GET", "/objects"},
 		{"POST", "/objects"},
 		{"DELETE", "/objects"},
@@ -51,6 +51,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/classifications"
 	"github.com/weaviate/weaviate/adapters/repos/db"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	modulestorage "github.com/weaviate/weaviate/adapters/repos/modules"
 	schemarepo "github.com/weaviate/weaviate/adapters/repos/schema"
 	rCluster "github.com/weaviate/weaviate/cluster"
@@ -342,30 +343,40 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 	remoteNodesClient := clients.NewRemoteNode(appState.ClusterHttpClient)
 	replicationClient := clients.NewReplicationClient(appState.ClusterHttpClient)
 	repo, err := db.New(appState.Logger, db.Config{
-		ServerVersion:                  config.ServerVersion,
-		GitHash:                        build.Revision,
-		MemtablesFlushDirtyAfter:       appState.ServerConfig.Config.Persistence.MemtablesFlushDirtyAfter,
-		MemtablesInitialSizeMB:         10,
-		MemtablesMaxSizeMB:             appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
-		MemtablesMinActiveSeconds:      appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
-		MemtablesMaxActiveSeconds:      appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
-		SegmentsCleanupIntervalSeconds: appState.ServerConfig.Config.Persistence.LSMSegmentsCleanupIntervalSeconds,
-		SeparateObjectsCompactions:     appState.ServerConfig.Config.Persistence.LSMSeparateObjectsCompactions,
-		MaxSegmentSize:                 appState.ServerConfig.Config.Persistence.LSMMaxSegmentSize,
-		HNSWMaxLogSize:                 appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
-		HNSWWaitForCachePrefill:        appState.ServerConfig.Config.HNSWStartupWaitForVectorCache,
-		HNSWFlatSearchConcurrency:      appState.ServerConfig.Config.HNSWFlatSearchConcurrency,
-		VisitedListPoolMaxSize:         appState.ServerConfig.Config.HNSWVisitedListPoolMaxSize,
-		RootPath:                       appState.ServerConfig.Config.Persistence.DataPath,
-		QueryLimit:                     appState.ServerConfig.Config.QueryDefaults.Limit,
-		QueryMaximumResults:            appState.ServerConfig.Config.QueryMaximumResults,
-		QueryNestedRefLimit:            appState.ServerConfig.Config.QueryNestedCrossReferenceLimit,
-		MaxImportGoroutinesFactor:      appState.ServerConfig.Config.MaxImportGoroutinesFactor,
-		TrackVectorDimensions:          appState.ServerConfig.Config.TrackVectorDimensions,
-		ResourceUsage:                  appState.ServerConfig.Config.ResourceUsage,
-		AvoidMMap:                      appState.ServerConfig.Config.AvoidMmap,
-		DisableLazyLoadShards:          appState.ServerConfig.Config.DisableLazyLoadShards,
-		ForceFullReplicasSearch:        appState.ServerConfig.Config.ForceFullReplicasSearch,
+		ServerVersion:                   config.ServerVersion,
+		GitHash:                         build.Revision,
+		MemtablesFlushDirtyAfter:        appState.ServerConfig.Config.Persistence.MemtablesFlushDirtyAfter,
+		MemtablesInitialSizeMB:          10,
+		MemtablesMaxSizeMB:              appState.ServerConfig.Config.Persistence.MemtablesMaxSizeMB,
+		MemtablesMinActiveSeconds:       appState.ServerConfig.Config.Persistence.MemtablesMinActiveDurationSeconds,
+		MemtablesMaxActiveSeconds:       appState.ServerConfig.Config.Persistence.MemtablesMaxActiveDurationSeconds,
+		SegmentsCleanupIntervalSeconds:  appState.ServerConfig.Config.Persistence.LSMSegmentsCleanupIntervalSeconds,
+		SeparateObjectsCompactions:      appState.ServerConfig.Config.Persistence.LSMSeparateObjectsCompactions,
+		VerifyChecksumsOnLoad:           appState.ServerConfig.Config.Persistence.LSMVerifyChecksumsOnLoad,
+		VerifyChecksumsOnRead:           appState.ServerConfig.Config.Persistence.LSMVerifyChecksumsOnRead,
+		WriteSegmentChecksums:           appState.ServerConfig.Config.Persistence.LSMWriteSegmentChecksums,
+		QuarantineCorruptSegments:       appState.ServerConfig.Config.Persistence.LSMQuarantineCorruptSegments,
+		ObjectsTTLDeleteIntervalSeconds: appState.ServerConfig.Config.Persistence.ObjectsTTLDeleteIntervalSeconds,
+		TieringMinAgeSeconds:            appState.ServerConfig.Config.Persistence.LSMTieringMinAgeSeconds,
+		TieringMinSegmentSize:           appState.ServerConfig.Config.Persistence.LSMTieringMinSegmentSize,
+		TieringCacheMaxSize:             appState.ServerConfig.Config.Persistence.LSMTieringCacheMaxSize,
+		CompactionScheduler:             compactionSchedulerConfig(appState.ServerConfig.Config.Persistence),
+		MaxSegmentSize:                  appState.ServerConfig.Config.Persistence.LSMMaxSegmentSize,
+		HNSWMaxLogSize:                  appState.ServerConfig.Config.Persistence.HNSWMaxLogSize,
+		HNSWWaitForCachePrefill:         appState.ServerConfig.Config.HNSWStartupWaitForVectorCache,
+		HNSWFlatSearchConcurrency:       appState.ServerConfig.Config.HNSWFlatSearchConcurrency,
+		VisitedListPoolMaxSize:          appState.ServerConfig.Config.HNSWVisitedListPoolMaxSize,
+		RootPath:                        appState.ServerConfig.Config.Persistence.DataPath,
+		QueryLimit:                      appState.ServerConfig.Config.QueryDefaults.Limit,
+		QueryMaximumResults:             appState.ServerConfig.Config.QueryMaximumResults,
+		QueryMaximumRadiusResults:       appState.ServerConfig.Config.QueryMaximumRadiusResults,
+		QueryNestedRefLimit:             appState.ServerConfig.Config.QueryNestedCrossReferenceLimit,
+		MaxImportGoroutinesFactor:       appState.ServerConfig.Config.MaxImportGoroutinesFactor,
+		TrackVectorDimensions:           appState.ServerConfig.Config.TrackVectorDimensions,
+		ResourceUsage:                   appState.ServerConfig.Config.ResourceUsage,
+		AvoidMMap:                       appState.ServerConfig.Config.AvoidMmap,
+		DisableLazyLoadShards:           appState.ServerConfig.Config.DisableLazyLoadShards,
+		ForceFullReplicasSearch:         appState.ServerConfig.Config.ForceFullReplicasSearch,
 		// Pass dummy replication config with minimum factor 1. Otherwise the
 		// setting is not backward-compatible. The user may have created a class
 		// with factor=1 before the change was introduced. Now their setup would no
@@ -538,6 +549,14 @@ func MakeAppState(ctx context.Context, options *swag.CommandLineOptionsGroup) *s
 			Fatal("modules didn't initialize")
 	}
 
+	if backend := appState.ServerConfig.Config.Persistence.LSMTieringBackend; backend != "" {
+		if err := initSegmentTiering(appState, repo, backend); err != nil {
+			appState.Logger.
+				WithField("action", "startup").WithError(err).
+				Fatal("could not init lsm tiering backend")
+		}
+	}
+
 	metaStoreReadyErr := fmt.Errorf("meta store ready")
 	metaStoreFailedErr := fmt.Errorf("meta store failed")
 	storeReadyCtx, storeReadyCancel := context.WithCancelCause(context.Background())
@@ -688,8 +707,11 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 		appState.SchemaManager, appState.ServerConfig, appState.Logger,
 		appState.Authorizer, appState.DB, appState.Modules,
 		objects.NewMetrics(appState.Metrics), appState.MemWatch)
+	appState.ObjectsManager = objectsManager
 	setupObjectHandlers(api, objectsManager, appState.ServerConfig.Config, appState.Logger,
 		appState.Modules, appState.Metrics)
+	setupKeyValueHandlers(api, objectsManager, appState.Metrics, appState.Logger)
+	setupSnapshotHandlers(api, objectsManager, appState.Metrics, appState.Logger)
 	setupObjectBatchHandlers(api, appState.BatchManager, appState.Metrics, appState.Logger)
 	setupGraphQLHandlers(api, appState, appState.SchemaManager, appState.ServerConfig.Config.DisableGraphQL,
 		appState.Metrics, appState.Logger)
@@ -1392,6 +1414,37 @@ func registerModules(appState *state.State) error {
 	return nil
 }
 
+func compactionSchedulerConfig(persistence config.Persistence) lsmkv.CompactionSchedulerConfig {
+	return lsmkv.CompactionSchedulerConfig{
+		MaxBytesPerSecond: persistence.LSMCompactionMaxBytesPerSecond,
+		MaxConcurrent:     persistence.LSMCompactionMaxConcurrent,
+		HeavyWindows:      persistence.LSMCompactionHeavyWindows,
+		HeavyMinSize:      persistence.LSMCompactionHeavyMinSize,
+	}
+}
+
+// initSegmentTiering must run before the indexes are loaded, i.e. before the
+// meta store is opened
+func initSegmentTiering(appState *state.State, repo *db.DB, backend string) error {
+	var store lsmkv.SegmentStore
+	if backend == config.LSMTieringBackendFilesystem {
+		fsStore, err := lsmkv.NewFilesystemSegmentStore(
+			appState.ServerConfig.Config.Persistence.LSMTieringFilesystemPath)
+		if err != nil {
+			return err
+		}
+		store = fsStore
+	} else {
+		offload, ok := appState.Modules.SegmentOffloadBackend(backend)
+		if !ok {
+			return fmt.Errorf("backend %q is not enabled or can't store segments", backend)
+		}
+		store = offload
+	}
+
+	return repo.SetSegmentStore(store)
+}
+
 func initModules(ctx context.Context, appState *state.State) error {
 	storageProvider, err := modulestorage.NewRepo(
 		appState.ServerConfig.Config.Persistence.DataPath, appState.Logger)
@@ -1708,19 +1708,41 @@ func init() {
         ]
       }
     },
-    "/meta": {
+    "/kv/{className}/{key}": {
       "get": {
-        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
+        "description": "Get a single entry from the key-value side bucket of a collection. Entries live next to the objects of the collection and share its replication, multi-tenancy and authorization, but are not vectorized, indexed or returned by queries.",
         "tags": [
-          "meta"
+          "kv"
+        ],
+        "summary": "Get a key-value entry",
+        "operationId": "getKeyValue",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The key of the entry",
+            "name": "key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
+          }
         ],
-        "summary": "Returns meta information of the current Weaviate instance.",
-        "operationId": "meta.get",
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Meta"
+              "$ref": "#/definitions/KeyValueEntry"
             }
           },
           "401": {
@@ -1732,6 +1754,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -1739,31 +1770,53 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/nodes": {
-      "get": {
-        "description": "Returns node information for the entire database.",
+      },
+      "put": {
+        "description": "Create or replace a single entry in the key-value side bucket of a collection. The ` + "`" + `key` + "`" + ` of the body is ignored, the key is taken from the path.",
         "tags": [
-          "nodes"
+          "kv"
         ],
-        "summary": "Node information for the database.",
-        "operationId": "nodes.get",
+        "summary": "Write a key-value entry",
+        "operationId": "putKeyValue",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The key of the entry",
+            "name": "key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The entry to write.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/KeyValueEntry"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Nodes status successfully returned",
+            "description": "Successfully written.",
             "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
+              "$ref": "#/definitions/KeyValueEntry"
             }
           },
           "401": {
@@ -1776,13 +1829,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1795,35 +1845,41 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/nodes/{className}": {
-      "get": {
-        "description": "Returns node information for the nodes relevant to the collection.",
+      },
+      "delete": {
+        "description": "Delete a single entry from the key-value side bucket of a collection. Deleting an entry that does not exist succeeds.",
         "tags": [
-          "nodes"
+          "kv"
         ],
-        "summary": "Node information for a collection.",
-        "operationId": "nodes.get.class",
+        "summary": "Delete a key-value entry",
+        "operationId": "deleteKeyValue",
         "parameters": [
           {
             "type": "string",
+            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
+            "type": "string",
+            "description": "The key of the entry",
+            "name": "key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Nodes status successfully returned",
-            "schema": {
-              "$ref": "#/definitions/NodesStatusResponse"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1835,13 +1891,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -1854,39 +1907,44 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.nodes.status.get.class"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/objects": {
-      "get": {
-        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
+    "/kv/{className}/{key}/cas": {
+      "post": {
+        "description": "Write or delete a single entry of the key-value side bucket of a collection, but only if the current entry matches the given condition. The condition is checked on all reachable replicas before anything is written. If it does not hold on any of them, or another conditional write on the same key is in progress, the write is rejected on all replicas.",
         "tags": [
-          "objects"
+          "kv"
         ],
-        "summary": "Get a list of Objects.",
-        "operationId": "objects.list",
+        "summary": "Conditionally write a key-value entry",
+        "operationId": "compareAndSwapKeyValue",
         "parameters": [
           {
-            "$ref": "#/parameters/CommonAfterParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonOffsetParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonLimitParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
           },
           {
-            "$ref": "#/parameters/CommonSortParameterQuery"
+            "type": "string",
+            "description": "The key of the entry",
+            "name": "key",
+            "in": "path",
+            "required": true
           },
           {
-            "$ref": "#/parameters/CommonOrderParameterQuery"
+            "description": "The condition and the write to apply.",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/KeyValueCompareAndSwap"
+            }
           },
           {
-            "$ref": "#/parameters/CommonClassParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
@@ -1894,16 +1952,13 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+            "description": "Successfully written.",
             "schema": {
-              "$ref": "#/definitions/ObjectsListResponse"
+              "$ref": "#/definitions/KeyValueEntry"
             }
           },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -1917,6 +1972,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "412": {
+            "description": "The current entry does not match the condition. Nothing was written.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -1930,43 +1991,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
-      },
+      }
+    },
+    "/snapshots/{className}": {
       "post": {
-        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
+        "description": "Open a point-in-time snapshot of the objects of a collection (or of a tenant). Object listings which pass the returned token as ` + "`" + `snapshot` + "`" + ` return the objects as they were when the snapshot was opened, so that paginating through a collection is not affected by concurrent writes. The snapshot is released automatically once its ttl has passed. Only shards held by the node which serves the request are part of the snapshot.",
         "tags": [
-          "objects"
+          "snapshots"
         ],
-        "summary": "Create a new object.",
-        "operationId": "objects.create",
+        "summary": "Open a read snapshot",
+        "operationId": "openSnapshot",
         "parameters": [
           {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The time-to-live of the snapshot.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/ReadSnapshot"
             }
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Object created.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successfully opened.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/ReadSnapshot"
             }
           },
           "401": {
@@ -1978,6 +2041,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -1991,34 +2057,41 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query"
         ]
       }
     },
-    "/objects/validate": {
-      "post": {
-        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
+    "/snapshots/{className}/{token}": {
+      "delete": {
+        "description": "Release a snapshot opened before its ttl has passed, so that the data it pins can be cleaned up.",
         "tags": [
-          "objects"
+          "snapshots"
         ],
-        "summary": "Validate an Object based on a schema.",
-        "operationId": "objects.validate",
+        "summary": "Release a read snapshot",
+        "operationId": "releaseSnapshot",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The token of the snapshot",
+            "name": "token",
+            "in": "path",
+            "required": true
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully validated."
+          "204": {
+            "description": "Successfully released."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2029,8 +2102,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The snapshot does not exist or has already expired."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2042,60 +2118,24 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query.meta"
+          "weaviate.local.query"
         ]
       }
     },
-    "/objects/{className}/{id}": {
+    "/meta": {
       "get": {
-        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
+        "description": "Returns meta information about the server. Can be used to provide information to another Weaviate instance that wants to interact with the current instance.",
         "tags": [
-          "objects"
-        ],
-        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
-        "operationId": "objects.class.get",
-        "parameters": [
-          {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonNodeNameParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
-          }
+          "meta"
         ],
+        "summary": "Returns meta information of the current Weaviate instance.",
+        "operationId": "meta.get",
         "responses": {
           "200": {
             "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Meta"
             }
           },
           "401": {
@@ -2107,15 +2147,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
-          "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2126,48 +2157,28 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.query.meta"
         ]
-      },
-      "put": {
-        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
+      }
+    },
+    "/nodes": {
+      "get": {
+        "description": "Returns node information for the entire database.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Update a class object based on its uuid",
-        "operationId": "objects.class.put",
+        "summary": "Node information for the database.",
+        "operationId": "nodes.get",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully received.",
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2180,10 +2191,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2195,19 +2209,19 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.status.get"
         ]
-      },
-      "delete": {
-        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
+      }
+    },
+    "/nodes/{className}": {
+      "get": {
+        "description": "Returns node information for the nodes relevant to the collection.",
         "tags": [
-          "objects"
+          "nodes"
         ],
-        "summary": "Delete object based on its class and UUID.",
-        "operationId": "objects.class.delete",
+        "summary": "Node information for a collection.",
+        "operationId": "nodes.get.class",
         "parameters": [
           {
             "type": "string",
@@ -2216,28 +2230,14 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonOutputVerbosityParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Nodes status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/NodesStatusResponse"
             }
           },
           "401": {
@@ -2250,10 +2250,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2265,45 +2268,69 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.nodes.status.get.class"
         ]
-      },
-      "head": {
-        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
+      }
+    },
+    "/objects": {
+      "get": {
+        "description": "Lists all Objects in reverse order of creation, owned by the user that belongs to the used token.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks object's existence based on its class and uuid.",
-        "operationId": "objects.class.head",
+        "summary": "Get a list of Objects.",
+        "operationId": "objects.list",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonAfterParameterQuery"
           },
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object",
-            "name": "id",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonBeforeParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonPrefixParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonReverseParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSnapshotParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOffsetParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonLimitParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonSortParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonOrderParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonClassParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successful response. \u003cbr/\u003e\u003cbr/\u003eIf ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
+            "schema": {
+              "$ref": "#/definitions/ObjectsListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2315,10 +2342,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2330,39 +2357,24 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "patch": {
-        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "post": {
+        "description": "Create a new object. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: Use ` + "`" + `/batch` + "`" + ` for importing many objects**: \u003cbr/\u003eIf you plan on importing a large number of objects, it's much more efficient to use the ` + "`" + `/batch` + "`" + ` endpoint. Otherwise, sending multiple single requests sequentially would incur a large performance penalty. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/objects` + "`" + `**: \u003cbr/\u003ePOST /objects will fail if an id is provided which already exists in the class. To update an existing object with the objects endpoint, use the PUT or PATCH method.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.class.patch",
+        "summary": "Create a new object.",
+        "operationId": "objects.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "The uuid of the data object to update.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
               "$ref": "#/definitions/Object"
             }
@@ -2372,11 +2384,14 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
+          "200": {
+            "description": "Object created.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "400": {
-            "description": "The patch-JSON is malformed.",
+            "description": "Malformed request.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2390,11 +2405,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Successful query result but no resource was found."
-          },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2409,65 +2421,31 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.add"
         ]
       }
     },
-    "/objects/{className}/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace **all** references in cross-reference property of an object.",
+    "/objects/validate": {
+      "post": {
+        "description": "Validate an object's schema and meta-data without creating it. \u003cbr/\u003e\u003cbr/\u003eIf the schema of the object is valid, the request should return nothing with a plain RESTful request. Otherwise, an error object will be returned.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.class.references.put",
+        "summary": "Validate an Object based on a schema.",
+        "operationId": "objects.validate",
         "parameters": [
-          {
-            "type": "string",
-            "description": "The class name as defined in the schema",
-            "name": "className",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Unique ID of the Object.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/Object"
             }
-          },
-          {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully replaced all the references."
-          },
-          "400": {
-            "description": "Malformed request.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successfully validated."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2478,11 +2456,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Source object doesn't exist."
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2497,20 +2472,21 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query.meta"
         ]
-      },
-      "post": {
-        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
+      }
+    },
+    "/objects/{className}/{id}": {
+      "get": {
+        "description": "Get a data object based on its collection and UUID. Also available as Websocket bus.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.class.references.create",
+        "summary": "Get a specific Object based on its class and UUID. Also available as Websocket bus.",
+        "operationId": "objects.class.get",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -2524,22 +2500,13 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonNodeNameParameterQuery"
           },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
@@ -2547,7 +2514,10 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "400": {
             "description": "Malformed request.",
@@ -2565,10 +2535,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Source object doesn't exist."
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2583,20 +2553,19 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      "put": {
+        "description": "Update an object based on its uuid and collection. This (` + "`" + `put` + "`" + `) method replaces the object with the provided object.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.class.references.delete",
+        "summary": "Update a class object based on its uuid",
+        "operationId": "objects.class.put",
         "parameters": [
           {
             "type": "string",
-            "description": "The class name as defined in the schema",
             "name": "className",
             "in": "path",
             "required": true
@@ -2604,41 +2573,28 @@ func init() {
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object to update.",
             "name": "id",
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
-          },
-          "400": {
-            "description": "Malformed request.",
+          "200": {
+            "description": "Successfully received.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/Object"
             }
           },
           "401": {
@@ -2651,13 +2607,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2674,18 +2627,21 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{id}": {
-      "get": {
-        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
+      },
+      "delete": {
+        "description": "Delete an object based on its collection and UUID. \u003cbr/\u003e\u003cbr/\u003eNote: For backward compatibility, beacons also support an older, deprecated format without the collection name. As a result, when deleting a reference, the beacon specified has to match the beacon to be deleted exactly. In other words, if a beacon is present using the old format (without collection name) you also need to specify it the same way. \u003cbr/\u003e\u003cbr/\u003eIn the beacon format, you need to always use ` + "`" + `localhost` + "`" + ` as the host, rather than the actual hostname. ` + "`" + `localhost` + "`" + ` here refers to the fact that the beacon's target is on the same Weaviate instance, as opposed to a foreign instance.",
         "tags": [
           "objects"
         ],
-        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
-        "operationId": "objects.get",
-        "deprecated": true,
+        "summary": "Delete object based on its class and UUID.",
+        "operationId": "objects.class.delete",
         "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2695,15 +2651,15 @@ func init() {
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonIncludeParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful response.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "400": {
             "description": "Malformed request.",
@@ -2723,6 +2679,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2730,47 +2692,45 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.query"
+          "weaviate.local.manipulate"
         ]
       },
-      "put": {
-        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "head": {
+        "description": "Checks if a data object exists based on its collection and uuid without retrieving it. \u003cbr/\u003e\u003cbr/\u003eInternally it skips reading the object from disk other than checking if it is present. Thus it does not use resources on marshalling, parsing, etc., and is faster. Note the resulting HTTP request has no body; the existence of an object is indicated solely by the status code.",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID.",
-        "operationId": "objects.update",
-        "deprecated": true,
+        "summary": "Checks object's existence based on its class and uuid.",
+        "operationId": "objects.class.head",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully received.",
-            "schema": {
-              "$ref": "#/definitions/Object"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2782,10 +2742,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Object doesn't exist."
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2797,39 +2757,56 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
       },
-      "delete": {
-        "description": "Deletes an object from the database based on its UUID.",
+      "patch": {
+        "description": "Update an individual data object based on its class and uuid. This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete an Object based on its UUID.",
-        "operationId": "objects.delete",
-        "deprecated": true,
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.class.patch",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
-            "description": "Unique ID of the Object.",
+            "description": "The uuid of the data object to update.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
           "204": {
-            "description": "Successfully deleted."
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2843,6 +2820,12 @@ func init() {
           "404": {
             "description": "Successful query result but no resource was found."
           },
+          "422": {
+            "description": "The patch-JSON is valid but unprocessable.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -2850,21 +2833,29 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "head": {
-        "description": "Checks if an object exists in the system based on its UUID.",
+      }
+    },
+    "/objects/{className}/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace **all** references in cross-reference property of an object.",
         "tags": [
           "objects"
         ],
-        "summary": "Checks Object's existence based on its UUID.",
-        "operationId": "objects.head",
-        "deprecated": true,
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.class.references.put",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2872,11 +2863,38 @@ func init() {
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Object exists."
+          "200": {
+            "description": "Successfully replaced all the references."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2888,7 +2906,13 @@ func init() {
             }
           },
           "404": {
-            "description": "Object doesn't exist."
+            "description": "Source object doesn't exist."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -2897,21 +2921,27 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": true,
-        "x-available-in-websocket": true,
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.objects.check"
+          "weaviate.local.manipulate"
         ]
       },
-      "patch": {
-        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
+      "post": {
+        "description": "Add a single reference to an object. This adds a reference to the array of cross-references of the given property in the source object specified by its collection name and id",
         "tags": [
           "objects"
         ],
-        "summary": "Update an Object based on its UUID (using patch semantics).",
-        "operationId": "objects.patch",
-        "deprecated": true,
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.class.references.create",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -2921,23 +2951,36 @@ func init() {
             "required": true
           },
           {
-            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/Object"
+              "$ref": "#/definitions/SingleRef"
             }
           },
           {
             "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully applied. No content provided."
+          "200": {
+            "description": "Successfully added the reference."
           },
           "400": {
-            "description": "The patch-JSON is malformed."
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -2949,10 +2992,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found."
+            "description": "Source object doesn't exist."
           },
           "422": {
-            "description": "The patch-JSON is valid but unprocessable.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -2969,18 +3012,22 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/objects/{id}/references/{propertyName}": {
-      "put": {
-        "description": "Replace all references in cross-reference property of an object.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
           "objects"
         ],
-        "summary": "Replace all references to a class-property.",
-        "operationId": "objects.references.update",
-        "deprecated": true,
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.class.references.delete",
         "parameters": [
+          {
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
           {
             "type": "string",
             "format": "uuid",
@@ -3001,16 +3048,25 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/MultipleRef"
+              "$ref": "#/definitions/SingleRef"
             }
           },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
+          },
           {
             "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successfully replaced all the references."
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3021,6 +3077,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
@@ -3039,14 +3101,16 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      },
-      "post": {
-        "description": "Add a cross-reference.",
+      }
+    },
+    "/objects/{id}": {
+      "get": {
+        "description": "Get a specific object based on its UUID. Also available as Websocket bus.",
         "tags": [
           "objects"
         ],
-        "summary": "Add a single reference to a class-property.",
-        "operationId": "objects.references.create",
+        "summary": "Get a specific Object based on its UUID and a Object UUID. Also available as Websocket bus.",
+        "operationId": "objects.get",
         "deprecated": true,
         "parameters": [
           {
@@ -3058,27 +3122,21 @@ func init() {
             "required": true
           },
           {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/SingleRef"
-            }
-          },
-          {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonIncludeParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successfully added the reference."
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3089,11 +3147,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3105,16 +3160,16 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
       },
-      "delete": {
-        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
+      "put": {
+        "description": "Updates an object based on its UUID. Given meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
           "objects"
         ],
-        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
-        "operationId": "objects.references.delete",
+        "summary": "Update an Object based on its UUID.",
+        "operationId": "objects.update",
         "deprecated": true,
         "parameters": [
           {
@@ -3125,28 +3180,24 @@ func init() {
             "in": "path",
             "required": true
           },
-          {
-            "type": "string",
-            "description": "Unique name of the property related to the Object.",
-            "name": "propertyName",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/SingleRef"
+              "$ref": "#/definitions/Object"
             }
           },
           {
-            "$ref": "#/parameters/CommonTenantParameterQuery"
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Successfully received.",
+            "schema": {
+              "$ref": "#/definitions/Object"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3158,7 +3209,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Successful query result but no resource was found.",
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3175,75 +3229,34 @@ func init() {
         "x-serviceIds": [
           "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema": {
-      "get": {
-        "description": "Fetch an array of all collection definitions from the schema.",
+      },
+      "delete": {
+        "description": "Deletes an object from the database based on its UUID.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Dump the current the database schema.",
-        "operationId": "schema.dump",
+        "summary": "Delete an Object based on its UUID.",
+        "operationId": "objects.delete",
+        "deprecated": true,
         "parameters": [
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Successfully dumped the database schema.",
-            "schema": {
-              "$ref": "#/definitions/Schema"
-            }
-          },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+            "type": "string",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
           },
-          "403": {
-            "description": "Forbidden",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.local.query.meta"
-        ]
-      },
-      "post": {
-        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
-        "tags": [
-          "schema"
-        ],
-        "summary": "Create a new Object class in the schema.",
-        "operationId": "schema.objects.create",
-        "parameters": [
           {
-            "name": "objectClass",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Added the new Object class to the schema.",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3254,11 +3267,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Object class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "Successful query result but no resource was found."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3267,39 +3277,33 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.add.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}": {
-      "get": {
+      },
+      "head": {
+        "description": "Checks if an object exists in the system based on its UUID.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get a single class from the schema",
-        "operationId": "schema.objects.get",
+        "summary": "Checks Object's existence based on its UUID.",
+        "operationId": "objects.head",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the Class, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Object exists."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3311,7 +3315,7 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist"
+            "description": "Object doesn't exist."
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3320,39 +3324,47 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": true,
+        "x-available-in-websocket": true,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.objects.check"
         ]
       },
-      "put": {
-        "description": "Add a property to an existing collection.",
+      "patch": {
+        "description": "Update an object based on its UUID (using patch semantics). This method supports json-merge style patch semantics (RFC 7396). Provided meta-data and schema values are validated. LastUpdateTime is set to the time this function is called.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Update settings of an existing schema class",
-        "operationId": "schema.objects.update",
+        "summary": "Update an Object based on its UUID (using patch semantics).",
+        "operationId": "objects.patch",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "objectClass",
+            "description": "RFC 7396-style patch, the body contains the object to merge into the existing object.",
+            "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
-              "$ref": "#/definitions/Class"
+              "$ref": "#/definitions/Object"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonConsistencyLevelParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Class was updated successfully",
-            "schema": {
-              "$ref": "#/definitions/Class"
-            }
+          "204": {
+            "description": "Successfully applied. No content provided."
+          },
+          "400": {
+            "description": "The patch-JSON is malformed."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3364,13 +3376,10 @@ func init() {
             }
           },
           "404": {
-            "description": "Class to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successful query result but no resource was found."
           },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "The patch-JSON is valid but unprocessable.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3382,34 +3391,53 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      },
-      "delete": {
-        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
+      }
+    },
+    "/objects/{id}/references/{propertyName}": {
+      "put": {
+        "description": "Replace all references in cross-reference property of an object.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Remove an Object class (and all data in the instances) from the schema.",
-        "operationId": "schema.objects.delete",
+        "summary": "Replace all references to a class-property.",
+        "operationId": "objects.references.update",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MultipleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Removed the Object class from the schema."
-          },
-          "400": {
-            "description": "Could not delete the Object class.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "Successfully replaced all the references."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3420,6 +3448,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -3427,22 +3461,33 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}/properties": {
+      },
       "post": {
+        "description": "Add a cross-reference.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Add a property to an Object class.",
-        "operationId": "schema.objects.properties.add",
+        "summary": "Add a single reference to a class-property.",
+        "operationId": "objects.references.create",
+        "deprecated": true,
         "parameters": [
           {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
             "in": "path",
             "required": true
           },
@@ -3451,16 +3496,16 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Property"
+              "$ref": "#/definitions/SingleRef"
             }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
           "200": {
-            "description": "Added the property.",
-            "schema": {
-              "$ref": "#/definitions/Property"
-            }
+            "description": "Successfully added the reference."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3472,7 +3517,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid property.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the property exists or that it is a class?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3484,38 +3529,51 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/schema/{className}/shards": {
-      "get": {
-        "description": "Get the status of every shard in the cluster.",
+      },
+      "delete": {
+        "description": "Delete the single reference that is given in the body from the list of references that this property has.",
         "tags": [
-          "schema"
+          "objects"
         ],
-        "summary": "Get the shards status of an Object class",
-        "operationId": "schema.objects.shards.get",
-        "parameters": [
-          {
+        "summary": "Delete the single reference that is given in the body from the list of references that this property has.",
+        "operationId": "objects.references.delete",
+        "deprecated": true,
+        "parameters": [
+          {
             "type": "string",
-            "name": "className",
+            "format": "uuid",
+            "description": "Unique ID of the Object.",
+            "name": "id",
             "in": "path",
             "required": true
           },
           {
             "type": "string",
-            "name": "tenant",
-            "in": "query"
+            "description": "Unique name of the property related to the Object.",
+            "name": "propertyName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SingleRef"
+            }
+          },
+          {
+            "$ref": "#/parameters/CommonTenantParameterQuery"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Found the status of the shards, returned as body",
-            "schema": {
-              "$ref": "#/definitions/ShardStatusList"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3527,7 +3585,7 @@ func init() {
             }
           },
           "404": {
-            "description": "This class does not exist",
+            "description": "Successful query result but no resource was found.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3539,46 +3597,79 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.get.meta"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/schema/{className}/shards/{shardName}": {
-      "put": {
-        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+    "/schema": {
+      "get": {
+        "description": "Fetch an array of all collection definitions from the schema.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a shard status.",
-        "operationId": "schema.objects.shards.update",
+        "summary": "Dump the current the database schema.",
+        "operationId": "schema.dump",
         "parameters": [
           {
-            "type": "string",
-            "name": "className",
-            "in": "path",
-            "required": true
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Successfully dumped the database schema.",
+            "schema": {
+              "$ref": "#/definitions/Schema"
+            }
           },
-          {
-            "type": "string",
-            "name": "shardName",
-            "in": "path",
-            "required": true
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.local.query.meta"
+        ]
+      },
+      "post": {
+        "description": "Create a new data object collection. \u003cbr/\u003e\u003cbr/\u003eIf AutoSchema is enabled, Weaviate will attempt to infer the schema from the data at import time. However, manual schema definition is recommended for production environments.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new Object class in the schema.",
+        "operationId": "schema.objects.create",
+        "parameters": [
           {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Shard status was updated successfully",
+            "description": "Added the new Object class to the schema.",
             "schema": {
-              "$ref": "#/definitions/ShardStatus"
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3590,14 +3681,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Shard to be updated does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid update attempt",
+            "description": "Invalid Object class",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3610,18 +3695,17 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.manipulate.meta"
+          "weaviate.local.add.meta"
         ]
       }
     },
-    "/schema/{className}/tenants": {
+    "/schema/{className}": {
       "get": {
-        "description": "get all tenants from a specific class",
         "tags": [
           "schema"
         ],
-        "summary": "Get the list of tenants.",
-        "operationId": "tenants.get",
+        "summary": "Get a single class from the schema",
+        "operationId": "schema.objects.get",
         "parameters": [
           {
             "type": "string",
@@ -3639,12 +3723,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "tenants from specified class.",
+            "description": "Found the Class, returned as body",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3656,11 +3737,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "This class does not exist"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -3668,15 +3746,18 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
       },
       "put": {
-        "description": "Update tenant of a specific class",
+        "description": "Add a property to an existing collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Update a tenant.",
-        "operationId": "tenants.update",
+        "summary": "Update settings of an existing schema class",
+        "operationId": "schema.objects.update",
         "parameters": [
           {
             "type": "string",
@@ -3685,25 +3766,19 @@ func init() {
             "required": true
           },
           {
-            "name": "body",
+            "name": "objectClass",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Updated tenants of the specified class",
+            "description": "Class was updated successfully",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/Class"
             }
           },
           "401": {
@@ -3715,8 +3790,14 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Class to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3727,42 +3808,34 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       },
-      "post": {
-        "description": "Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.",
+      "delete": {
+        "description": "Remove a collection from the schema. This will also delete all the objects in the collection.",
         "tags": [
           "schema"
         ],
-        "summary": "Create a new tenant",
-        "operationId": "tenants.create",
+        "summary": "Remove an Object class (and all data in the instances) from the schema.",
+        "operationId": "schema.objects.delete",
         "parameters": [
           {
             "type": "string",
             "name": "className",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Added new tenants to the specified class",
+            "description": "Removed the Object class from the schema."
+          },
+          "400": {
+            "description": "Could not delete the Object class.",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/Tenant"
-              }
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -3774,26 +3847,25 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid Tenant class",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "delete": {
-        "description": "delete tenants from a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
+      }
+    },
+    "/schema/{className}/properties": {
+      "post": {
         "tags": [
           "schema"
         ],
-        "operationId": "tenants.delete",
+        "summary": "Add a property to an Object class.",
+        "operationId": "schema.objects.properties.add",
         "parameters": [
           {
             "type": "string",
@@ -3802,20 +3874,20 @@ func init() {
             "required": true
           },
           {
-            "name": "tenants",
+            "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/Property"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Deleted tenants from specified class."
+            "description": "Added the property.",
+            "schema": {
+              "$ref": "#/definitions/Property"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3827,7 +3899,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid property.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3838,17 +3910,20 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "/schema/{className}/tenants/{tenantName}": {
+    "/schema/{className}/shards": {
       "get": {
-        "description": "get a specific tenant for the given class",
+        "description": "Get the status of every shard in the cluster.",
         "tags": [
           "schema"
         ],
-        "summary": "Get a specific tenant",
-        "operationId": "tenants.get.one",
+        "summary": "Get the shards status of an Object class",
+        "operationId": "schema.objects.shards.get",
         "parameters": [
           {
             "type": "string",
@@ -3858,23 +3933,15 @@ func init() {
           },
           {
             "type": "string",
-            "name": "tenantName",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "load the tenant given the specified class",
+            "description": "Found the status of the shards, returned as body",
             "schema": {
-              "$ref": "#/definitions/TenantResponse"
+              "$ref": "#/definitions/ShardStatusList"
             }
           },
           "401": {
@@ -3887,10 +3954,7 @@ func init() {
             }
           },
           "404": {
-            "description": "Tenant not found"
-          },
-          "422": {
-            "description": "Invalid tenant or class",
+            "description": "This class does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3901,15 +3965,20 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      },
-      "head": {
-        "description": "Check if a tenant exists for a specific class",
+        },
+        "x-serviceIds": [
+          "weaviate.local.get.meta"
+        ]
+      }
+    },
+    "/schema/{className}/shards/{shardName}": {
+      "put": {
+        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection. Setting a shard to ` + "`" + `LOADING` + "`" + ` starts a bulk load: objects are written directly to disk segments without a write-ahead log and without being vector indexed, and queries against the shard are rejected. Setting it to ` + "`" + `READY` + "`" + ` afterwards merges the written segments and builds the vector index, the shard reports ` + "`" + `LOADING` + "`" + ` until this has finished.",
         "tags": [
           "schema"
         ],
-        "summary": "Check whether a tenant exists",
-        "operationId": "tenant.exists",
+        "summary": "Update a shard status.",
+        "operationId": "schema.objects.shards.update",
         "parameters": [
           {
             "type": "string",
@@ -3919,21 +3988,25 @@ func init() {
           },
           {
             "type": "string",
-            "name": "tenantName",
+            "name": "shardName",
             "in": "path",
             "required": true
           },
           {
-            "type": "boolean",
-            "default": true,
-            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
-            "name": "consistency",
-            "in": "header"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "The tenant exists in the specified class"
+            "description": "Shard status was updated successfully",
+            "schema": {
+              "$ref": "#/definitions/ShardStatus"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -3945,10 +4018,13 @@ func init() {
             }
           },
           "404": {
-            "description": "The tenant not found"
+            "description": "Shard to be updated does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "422": {
-            "description": "Invalid Tenant class",
+            "description": "Invalid update attempt",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -3959,133 +4035,484 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
-      }
-    }
-  },
-  "definitions": {
-    "AdditionalProperties": {
-      "description": "(Response only) Additional meta information about a single object.",
-      "type": "object",
-      "additionalProperties": {
-        "type": "object"
-      }
-    },
-    "BM25Config": {
-      "description": "tuning parameters for the BM25 algorithm",
-      "type": "object",
-      "properties": {
-        "b": {
-          "description": "Calibrates term-weight scaling based on the document length (default: 0.75).",
-          "type": "number",
-          "format": "float"
-        },
-        "k1": {
-          "description": "Calibrates term-weight scaling based on the term frequency within a document (default: 1.2).",
-          "type": "number",
-          "format": "float"
-        }
-      }
-    },
-    "BackupConfig": {
-      "description": "Backup custom configuration",
-      "type": "object",
-      "properties": {
-        "Bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
-        },
-        "CPUPercentage": {
-          "description": "Desired CPU core utilization ranging from 1%-80%",
-          "type": "integer",
-          "default": 50,
-          "maximum": 80,
-          "minimum": 1,
-          "x-nullable": false
-        },
-        "ChunkSize": {
-          "description": "Aimed chunk size, with a minimum of 2MB, default of 128MB, and a maximum of 512MB. The actual chunk size may vary.",
-          "type": "integer",
-          "default": 128,
-          "maximum": 512,
-          "minimum": 2,
-          "x-nullable": false
-        },
-        "CompressionLevel": {
-          "description": "compression level used by compression algorithm",
-          "type": "string",
-          "default": "DefaultCompression",
-          "enum": [
-            "DefaultCompression",
-            "BestSpeed",
-            "BestCompression"
-          ],
-          "x-nullable": false
-        },
-        "Endpoint": {
-          "description": "name of the endpoint, e.g. s3.amazonaws.com",
-          "type": "string"
         },
-        "Path": {
-          "description": "Path or key within the bucket",
-          "type": "string"
-        }
+        "x-serviceIds": [
+          "weaviate.local.manipulate.meta"
+        ]
       }
     },
-    "BackupCreateRequest": {
-      "description": "Request body for creating a backup of a set of classes",
-      "properties": {
-        "config": {
-          "description": "Custom configuration for the backup creation process",
-          "type": "object",
-          "$ref": "#/definitions/BackupConfig"
-        },
-        "exclude": {
-          "description": "List of collections to exclude from the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `include` + "`" + `.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/schema/{className}/tenants": {
+      "get": {
+        "description": "get all tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get the list of tenants.",
+        "operationId": "tenants.get",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
           }
-        },
-        "id": {
-          "description": "The ID of the backup (required). Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
-        "include": {
-          "description": "List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "tenants from specified class.",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
         }
-      }
-    },
-    "BackupCreateResponse": {
-      "description": "The definition of a backup create response body",
-      "properties": {
-        "backend": {
-          "description": "Backup backend name e.g. filesystem, gcs, s3.",
-          "type": "string"
-        },
-        "bucket": {
-          "description": "Name of the bucket, container, volume, etc",
-          "type": "string"
-        },
-        "classes": {
-          "description": "The list of classes for which the backup creation process was started",
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "put": {
+        "description": "Update tenant of a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Update a tenant.",
+        "operationId": "tenants.update",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
           }
+        ],
+        "responses": {
+          "200": {
+            "description": "Updated tenants of the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a new tenant for a collection. Multi-tenancy must be enabled in the collection definition.",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Create a new tenant",
+        "operationId": "tenants.create",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Added new tenants to the specified class",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Tenant"
+              }
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "delete tenants from a specific class",
+        "tags": [
+          "schema"
+        ],
+        "operationId": "tenants.delete",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "tenants",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Deleted tenants from specified class."
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    },
+    "/schema/{className}/tenants/{tenantName}": {
+      "get": {
+        "description": "get a specific tenant for the given class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Get a specific tenant",
+        "operationId": "tenants.get.one",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "load the tenant given the specified class",
+            "schema": {
+              "$ref": "#/definitions/TenantResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Tenant not found"
+          },
+          "422": {
+            "description": "Invalid tenant or class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      },
+      "head": {
+        "description": "Check if a tenant exists for a specific class",
+        "tags": [
+          "schema"
+        ],
+        "summary": "Check whether a tenant exists",
+        "operationId": "tenant.exists",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenantName",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "default": true,
+            "description": "If consistency is true, the request will be proxied to the leader to ensure strong schema consistency",
+            "name": "consistency",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "The tenant exists in the specified class"
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "The tenant not found"
+          },
+          "422": {
+            "description": "Invalid Tenant class",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "AdditionalProperties": {
+      "description": "(Response only) Additional meta information about a single object.",
+      "type": "object",
+      "additionalProperties": {
+        "type": "object"
+      }
+    },
+    "BM25Config": {
+      "description": "tuning parameters for the BM25 algorithm",
+      "type": "object",
+      "properties": {
+        "b": {
+          "description": "Calibrates term-weight scaling based on the document length (default: 0.75).",
+          "type": "number",
+          "format": "float"
+        },
+        "k1": {
+          "description": "Calibrates term-weight scaling based on the term frequency within a document (default: 1.2).",
+          "type": "number",
+          "format": "float"
+        }
+      }
+    },
+    "BackupConfig": {
+      "description": "Backup custom configuration",
+      "type": "object",
+      "properties": {
+        "Bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "CPUPercentage": {
+          "description": "Desired CPU core utilization ranging from 1%-80%",
+          "type": "integer",
+          "default": 50,
+          "maximum": 80,
+          "minimum": 1,
+          "x-nullable": false
+        },
+        "ChunkSize": {
+          "description": "Aimed chunk size, with a minimum of 2MB, default of 128MB, and a maximum of 512MB. The actual chunk size may vary.",
+          "type": "integer",
+          "default": 128,
+          "maximum": 512,
+          "minimum": 2,
+          "x-nullable": false
+        },
+        "CompressionLevel": {
+          "description": "compression level used by compression algorithm",
+          "type": "string",
+          "default": "DefaultCompression",
+          "enum": [
+            "DefaultCompression",
+            "BestSpeed",
+            "BestCompression"
+          ],
+          "x-nullable": false
+        },
+        "Endpoint": {
+          "description": "name of the endpoint, e.g. s3.amazonaws.com",
+          "type": "string"
+        },
+        "Path": {
+          "description": "Path or key within the bucket",
+          "type": "string"
+        }
+      }
+    },
+    "BackupCreateRequest": {
+      "description": "Request body for creating a backup of a set of classes",
+      "properties": {
+        "config": {
+          "description": "Custom configuration for the backup creation process",
+          "type": "object",
+          "$ref": "#/definitions/BackupConfig"
+        },
+        "exclude": {
+          "description": "List of collections to exclude from the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `include` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "description": "The ID of the backup (required). Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
+        },
+        "include": {
+          "description": "List of collections to include in the backup creation process. If not set, all collections are included. Cannot be used together with ` + "`" + `exclude` + "`" + `.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "BackupCreateResponse": {
+      "description": "The definition of a backup create response body",
+      "properties": {
+        "backend": {
+          "description": "Backup backend name e.g. filesystem, gcs, s3.",
+          "type": "string"
+        },
+        "bucket": {
+          "description": "Name of the bucket, container, volume, etc",
+          "type": "string"
+        },
+        "classes": {
+          "description": "The list of classes for which the backup creation process was started",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "error": {
+          "description": "error message if creation failed",
+          "type": "string"
+        },
+        "id": {
+          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+          "type": "string"
         },
-        "error": {
-          "description": "error message if creation failed",
-          "type": "string"
-        },
-        "id": {
-          "description": "The ID of the backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-          "type": "string"
-        },
         "path": {
           "description": "Path within bucket of backup",
           "type": "string"
@@ -4636,6 +5063,9 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTTLConfig"
+        },
         "properties": {
           "description": "Define properties of the collection.",
           "type": "array",
@@ -4981,6 +5411,13 @@ func init() {
       "description": "Configure the inverted index built into Weaviate (default: 60).",
       "type": "object",
       "properties": {
+        "analyzers": {
+          "description": "Named text analyzers, each combining a tokenizer with a chain of token filters. Properties reference an analyzer by name to use it at index and query time.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerConfig"
+          }
+        },
         "bm25": {
           "$ref": "#/definitions/BM25Config"
         },
@@ -5001,15 +5438,64 @@ func init() {
           "description": "Index each object by its internal timestamps (default: 'false').",
           "type": "boolean"
         },
-        "stopwords": {
-          "$ref": "#/definitions/StopwordConfig"
+        "stopwords": {
+          "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "description": "Synonym sets used to expand the terms of keyword queries and text filters. Synonyms are applied at query time only and can be updated without reindexing.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymSet"
+          }
+        }
+      }
+    },
+    "JsonObject": {
+      "description": "JSON object value.",
+      "type": "object"
+    },
+    "KeyValueCompareAndSwap": {
+      "description": "A conditional write of a single key-value entry. The write is only applied if the current entry matches the condition.",
+      "properties": {
+        "delete": {
+          "description": "If true, the entry is deleted instead of written. Must not be combined with ` + "`" + `value` + "`" + `.",
+          "type": "boolean"
+        },
+        "expectAbsent": {
+          "description": "If true, the write is only applied if no entry exists for the key.",
+          "type": "boolean"
+        },
+        "expectedValue": {
+          "description": "The value the entry must currently hold for the write to be applied, base64 encoded.",
+          "type": "string",
+          "format": "byte"
+        },
+        "value": {
+          "description": "The new value of the entry, base64 encoded.",
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "KeyValueEntry": {
+      "description": "A single entry of a collection's key-value side bucket.",
+      "properties": {
+        "key": {
+          "description": "The key of the entry.",
+          "type": "string"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Time of the last write of this entry in milliseconds since epoch UTC. Set by the server.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "description": "The value of the entry, base64 encoded.",
+          "type": "string",
+          "format": "byte"
         }
       }
     },
-    "JsonObject": {
-      "description": "JSON object value.",
-      "type": "object"
-    },
     "Link": {
       "type": "object",
       "properties": {
@@ -5160,6 +5646,14 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "vectorIndexMigrations": {
+          "description": "Online migrations of the vector indexes of the shard that are in progress or failed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardVectorIndexMigration"
+          },
+          "x-omitempty": true
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -5173,6 +5667,41 @@ func init() {
         }
       }
     },
+    "NodeShardVectorIndexMigration": {
+      "description": "The progress of an online migration of a vector index to a new index type or compression setting",
+      "properties": {
+        "error": {
+          "description": "The reason the migration failed, if it did.",
+          "type": "string"
+        },
+        "objectsProcessed": {
+          "description": "The number of objects that were copied to the new vector index so far.",
+          "type": "number",
+          "format": "int64"
+        },
+        "objectsTotal": {
+          "description": "The number of objects in the shard when the migration started.",
+          "type": "number",
+          "format": "int64"
+        },
+        "sourceType": {
+          "description": "The type of the vector index that serves queries until the migration completes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status of the migration, INDEXING while the new vector index is built or FAILED if it could not be completed.",
+          "type": "string"
+        },
+        "targetType": {
+          "description": "The type of the vector index that is being built.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "The name of the target vector that is migrated, empty for the default vector.",
+          "type": "string"
+        }
+      }
+    },
     "NodeStats": {
       "description": "The summary of Weaviate's statistics.",
       "properties": {
@@ -5292,6 +5821,34 @@ func init() {
         }
       }
     },
+    "ObjectTTLConfig": {
+      "description": "Configure a time-to-live after which objects of a collection are deleted automatically",
+      "properties": {
+        "dateProperty": {
+          "description": "Name of a property of type ` + "`" + `date` + "`" + ` used as the reference time, required if ` + "`" + `deleteOn` + "`" + ` is ` + "`" + `dateProperty` + "`" + `.",
+          "type": "string"
+        },
+        "deleteOn": {
+          "description": "The reference time the time-to-live is based on (default: creationTime). ` + "`" + `creationTime` + "`" + ` and ` + "`" + `updateTime` + "`" + ` require ` + "`" + `invertedIndexConfig.indexTimestamps` + "`" + ` to be enabled.",
+          "type": "string",
+          "enum": [
+            "creationTime",
+            "updateTime",
+            "dateProperty"
+          ]
+        },
+        "enabled": {
+          "description": "Whether or not expired objects are deleted (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "ttlSeconds": {
+          "description": "Number of seconds after the reference time after which an object expires. Can be 0 if ` + "`" + `deleteOn` + "`" + ` is ` + "`" + `dateProperty` + "`" + `, in which case objects expire at the given date.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
@@ -5618,6 +6175,10 @@ func init() {
     "Property": {
       "type": "object",
       "properties": {
+        "analyzer": {
+          "description": "Name of a text analyzer defined in the class' ` + "`" + `invertedIndexConfig.analyzers` + "`" + `, used instead of the plain tokenization at index and query time. Applies to text and text[] data types.",
+          "type": "string"
+        },
         "dataType": {
           "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
           "type": "array",
@@ -5639,6 +6200,11 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexPositions": {
+          "description": "Whether to store token positions for this property in the inverted index. Enables ` + "`" + `phrase` + "`" + ` and ` + "`" + `slop` + "`" + ` (proximity) operators in ` + "`" + `bm25` + "`" + ` and ` + "`" + `hybrid` + "`" + ` search. Applicable only to properties of data type text and text[] with a searchable index. Defaults to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexRangeFilters": {
           "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
           "type": "boolean",
@@ -5745,6 +6311,25 @@ func init() {
         }
       }
     },
+    "ReadSnapshot": {
+      "description": "A point-in-time snapshot of the objects of a collection, referenced by its token in object listings.",
+      "properties": {
+        "expiresAt": {
+          "description": "Time at which the snapshot is released automatically, in milliseconds since epoch UTC. Set by the server.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "token": {
+          "description": "The token of the snapshot, pass it as ` + "`" + `snapshot` + "`" + ` to reference the snapshot. Set by the server.",
+          "type": "string"
+        },
+        "ttlSeconds": {
+          "description": "The time-to-live of the snapshot in seconds.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "ReferenceMetaClassification": {
       "description": "This meta field contains additional info about the classified reference property",
       "properties": {
@@ -6068,7 +6653,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].",
+          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'de', 'fr', 'es', 'it', 'pt', 'nl', 'none'].",
           "type": "string"
         },
         "removals": {
@@ -6080,6 +6665,27 @@ func init() {
         }
       }
     },
+    "SynonymSet": {
+      "description": "A set of synonyms applied to keyword queries and text filters at query time. Entries are tokenized like the searched property, entries which do not result in a single token are ignored",
+      "type": "object",
+      "properties": {
+        "input": {
+          "description": "Term expanded by a ` + "`" + `oneWay` + "`" + ` set. Must be empty for ` + "`" + `equivalent` + "`" + ` sets.",
+          "type": "string"
+        },
+        "synonyms": {
+          "description": "Terms of the set. In an ` + "`" + `equivalent` + "`" + ` set all terms are synonyms of each other, in a ` + "`" + `oneWay` + "`" + ` set they are added to queries containing the input.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "type": {
+          "description": "Type of the set (default: 'equivalent'). Options: ['equivalent', 'oneWay'].",
+          "type": "string"
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -6126,6 +6732,66 @@ func init() {
         }
       ]
     },
+    "TextAnalyzerConfig": {
+      "description": "A named text analysis pipeline",
+      "type": "object",
+      "properties": {
+        "filters": {
+          "description": "Token filters applied in order after tokenization.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerFilter"
+          }
+        },
+        "name": {
+          "description": "Name of the analyzer, referenced by properties.",
+          "type": "string"
+        },
+        "tokenizer": {
+          "description": "Tokenizer splitting the text into tokens. Allowed values are the property tokenizations (default: ` + "`" + `word` + "`" + `).",
+          "type": "string"
+        }
+      }
+    },
+    "TextAnalyzerFilter": {
+      "description": "A token filter of a text analyzer",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "Stopwords removed by the ` + "`" + `stopwords` + "`" + ` filter in addition to the preset.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "language": {
+          "description": "Language of the ` + "`" + `stemmer` + "`" + ` filter, e.g. 'english', 'german', 'french', 'spanish'.",
+          "type": "string"
+        },
+        "preset": {
+          "description": "Stopword preset of the ` + "`" + `stopwords` + "`" + ` filter, e.g. 'en', 'de', 'fr', 'es'.",
+          "type": "string"
+        },
+        "removals": {
+          "description": "Stopwords of the preset kept by the ` + "`" + `stopwords` + "`" + ` filter.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "synonyms": {
+          "description": "Synonym groups of the ` + "`" + `synonyms` + "`" + ` filter, each a comma separated list of equivalent terms. All terms of a group are replaced with the first term of the group.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "type": {
+          "description": "Type of the filter. Options: ['lowercase', 'asciiFolding', 'stemmer', 'stopwords', 'synonyms'].",
+          "type": "string"
+        }
+      }
+    },
     "Vector": {
       "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
       "type": "object"
@@ -6185,7 +6851,8 @@ func init() {
             "WithinGeoRange",
             "IsNull",
             "ContainsAny",
-            "ContainsAll"
+            "ContainsAll",
+            "Fuzzy"
           ],
           "example": "GreaterThanEqual"
         },
@@ -6345,6 +7012,12 @@ func init() {
       "name": "after",
       "in": "query"
     },
+    "CommonBeforeParameterQuery": {
+      "type": "string",
+      "description": "A threshold UUID of the objects to retrieve before, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Can be combined with ` + "`" + `after` + "`" + ` to list a range of UUIDs. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `before` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+      "name": "before",
+      "in": "query"
+    },
     "CommonClassParameterQuery": {
       "type": "string",
       "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
@@ -6397,6 +7070,25 @@ func init() {
       "name": "output",
       "in": "query"
     },
+    "CommonPrefixParameterQuery": {
+      "type": "string",
+      "description": "Only retrieve objects whose UUID starts with the given hexadecimal prefix, e.g. ` + "`" + `0a3f` + "`" + `. Dashes are ignored. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Note ` + "`" + `prefix` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+      "name": "prefix",
+      "in": "query"
+    },
+    "CommonReverseParameterQuery": {
+      "type": "boolean",
+      "default": false,
+      "description": "Retrieve the objects in descending UUID order. To paginate in reverse, pass the UUID of the last object of a page as ` + "`" + `before` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Note ` + "`" + `reverse` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+      "name": "reverse",
+      "in": "query"
+    },
+    "CommonSnapshotParameterQuery": {
+      "type": "string",
+      "description": "Read the objects from the point-in-time snapshot with this token, as returned when opening a snapshot of the class. Objects are returned as they were when the snapshot was opened. Can not be combined with sort. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `.",
+      "name": "snapshot",
+      "in": "query"
+    },
     "CommonSortParameterQuery": {
       "type": "string",
       "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
@@ -6484,72 +7176,360 @@ func init() {
         "summary": "List available endpoints",
         "operationId": "weaviate.root",
         "responses": {
-          "200": {
-            "description": "Weaviate is alive and ready to serve content",
+          "200": {
+            "description": "Weaviate is alive and ready to serve content",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "links": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Link"
+                  }
+                }
+              }
+            }
+          }
+        }
+      }
+    },
+    "/.well-known/live": {
+      "get": {
+        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
+        "summary": "Get application liveness.",
+        "operationId": "weaviate.wellknown.liveness",
+        "responses": {
+          "200": {
+            "description": "The application is able to respond to HTTP requests"
+          }
+        }
+      }
+    },
+    "/.well-known/openid-configuration": {
+      "get": {
+        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+        "tags": [
+          "well-known",
+          "oidc",
+          "discovery"
+        ],
+        "summary": "OIDC discovery information if OIDC auth is enabled",
+        "responses": {
+          "200": {
+            "description": "Successful response, inspect body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "clientId": {
+                  "description": "OAuth Client ID",
+                  "type": "string"
+                },
+                "href": {
+                  "description": "The Location to redirect to",
+                  "type": "string"
+                },
+                "scopes": {
+                  "description": "OAuth Scopes",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  },
+                  "x-omitempty": true
+                }
+              }
+            }
+          },
+          "404": {
+            "description": "Not found, no oidc provider present"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false
+      }
+    },
+    "/.well-known/ready": {
+      "get": {
+        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
+        "summary": "Get application readiness.",
+        "operationId": "weaviate.wellknown.readiness",
+        "responses": {
+          "200": {
+            "description": "The application has completed its start-up routine and is ready to accept traffic."
+          },
+          "503": {
+            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
+          }
+        }
+      }
+    },
+    "/authz/roles": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get all roles",
+        "operationId": "getRoles",
+        "responses": {
+          "200": {
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.roles"
+        ]
+      },
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "create new role",
+        "operationId": "createRole",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Role created successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
             "schema": {
-              "type": "object",
-              "properties": {
-                "links": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Link"
-                  }
-                }
-              }
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "409": {
+            "description": "Role already exists",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.create.role"
+        ]
       }
     },
-    "/.well-known/live": {
+    "/authz/roles/{id}": {
       "get": {
-        "description": "Determines whether the application is alive. Can be used for kubernetes liveness probe",
-        "summary": "Get application liveness.",
-        "operationId": "weaviate.wellknown.liveness",
+        "tags": [
+          "authz"
+        ],
+        "summary": "Get a role",
+        "operationId": "getRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
         "responses": {
           "200": {
-            "description": "The application is able to respond to HTTP requests"
+            "description": "Successful response.",
+            "schema": {
+              "$ref": "#/definitions/Role"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
-        }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.role"
+        ]
+      },
+      "delete": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Delete role",
+        "operationId": "deleteRole",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Successfully deleted."
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.delete.role"
+        ]
       }
     },
-    "/.well-known/openid-configuration": {
-      "get": {
-        "description": "OIDC Discovery page, redirects to the token issuer if one is configured",
+    "/authz/roles/{id}/add-permissions": {
+      "post": {
         "tags": [
-          "well-known",
-          "oidc",
-          "discovery"
+          "authz"
         ],
-        "summary": "OIDC discovery information if OIDC auth is enabled",
-        "responses": {
-          "200": {
-            "description": "Successful response, inspect body",
+        "summary": "Add permission to a given role.",
+        "operationId": "addPermissions",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
               "type": "object",
+              "required": [
+                "name",
+                "permissions"
+              ],
               "properties": {
-                "clientId": {
-                  "description": "OAuth Client ID",
-                  "type": "string"
-                },
-                "href": {
-                  "description": "The Location to redirect to",
-                  "type": "string"
-                },
-                "scopes": {
-                  "description": "OAuth Scopes",
+                "permissions": {
+                  "description": "permissions to be added to the role",
                   "type": "array",
                   "items": {
-                    "type": "string"
-                  },
-                  "x-omitempty": true
+                    "$ref": "#/definitions/Permission"
+                  }
                 }
               }
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Permissions added successfully"
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "no role found"
+          },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
-          "404": {
-            "description": "Not found, no oidc provider present"
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6557,37 +7537,40 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false
-      }
-    },
-    "/.well-known/ready": {
-      "get": {
-        "description": "Determines whether the application is ready to receive traffic. Can be used for kubernetes readiness probe.",
-        "summary": "Get application readiness.",
-        "operationId": "weaviate.wellknown.readiness",
-        "responses": {
-          "200": {
-            "description": "The application has completed its start-up routine and is ready to accept traffic."
-          },
-          "503": {
-            "description": "The application is currently not able to serve traffic. If other horizontal replicas of weaviate are available and they are capable of receiving traffic, all traffic should be redirected there instead."
-          }
-        }
+        "x-serviceIds": [
+          "weaviate.authz.add.role.permissions"
+        ]
       }
     },
-    "/authz/roles": {
-      "get": {
+    "/authz/roles/{id}/has-permission": {
+      "post": {
         "tags": [
           "authz"
         ],
-        "summary": "Get all roles",
-        "operationId": "getRoles",
+        "summary": "Check whether role possesses this permission.",
+        "operationId": "hasPermission",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Permission"
+            }
+          }
+        ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Permission check was successful",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "type": "boolean"
             }
           },
           "400": {
@@ -6605,6 +7588,12 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "422": {
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6613,28 +7602,49 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.roles"
+          "weaviate.authz.has.role.permission"
         ]
-      },
+      }
+    },
+    "/authz/roles/{id}/remove-permissions": {
       "post": {
         "tags": [
           "authz"
         ],
-        "summary": "create new role",
-        "operationId": "createRole",
+        "summary": "Remove permissions from a role. If this results in an empty role, the role will be deleted.",
+        "operationId": "removePermissions",
         "parameters": [
+          {
+            "type": "string",
+            "description": "role name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/Role"
+              "type": "object",
+              "required": [
+                "permissions"
+              ],
+              "properties": {
+                "permissions": {
+                  "description": "permissions to remove from the role",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Permission"
+                  }
+                }
+              }
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Role created successfully"
+          "200": {
+            "description": "Permissions removed successfully"
           },
           "400": {
             "description": "Malformed request.",
@@ -6651,11 +7661,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "409": {
-            "description": "Role already exists",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "404": {
+            "description": "no role found"
           },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
@@ -6671,17 +7678,17 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.create.role"
+          "weaviate.authz.remove.role.permissions"
         ]
       }
     },
-    "/authz/roles/{id}": {
+    "/authz/roles/{id}/users": {
       "get": {
         "tags": [
           "authz"
         ],
-        "summary": "Get a role",
-        "operationId": "getRole",
+        "summary": "get users or a keys assigned to role",
+        "operationId": "getUsersForRole",
         "parameters": [
           {
             "type": "string",
@@ -6693,13 +7700,16 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Successful response.",
+            "description": "Users assigned to this role",
             "schema": {
-              "$ref": "#/definitions/Role"
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
             }
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Bad request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6724,27 +7734,74 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.role"
+          "weaviate.authz.get.roles.users"
         ]
-      },
-      "delete": {
+      }
+    },
+    "/authz/users/own-roles": {
+      "get": {
         "tags": [
           "authz"
         ],
-        "summary": "Delete role",
-        "operationId": "deleteRole",
+        "summary": "get roles assigned to own user",
+        "operationId": "getRolesForOwnUser",
+        "responses": {
+          "200": {
+            "description": "Role assigned to own users",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.get.users.own-roles"
+        ]
+      }
+    },
+    "/authz/users/{id}/assign": {
+      "post": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "Assign a role to a user",
+        "operationId": "assignRole",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
+            "description": "user name",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "properties": {
+                "roles": {
+                  "description": "the roles that assigned to user",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "200": {
+            "description": "Role assigned successfully"
           },
           "400": {
             "description": "Bad request",
@@ -6761,6 +7818,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "role or user is not found."
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -6769,21 +7829,21 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.delete.role"
+          "weaviate.authz.assign.role"
         ]
       }
     },
-    "/authz/roles/{id}/add-permissions": {
+    "/authz/users/{id}/revoke": {
       "post": {
         "tags": [
           "authz"
         ],
-        "summary": "Add permission to a given role.",
-        "operationId": "addPermissions",
+        "summary": "Revoke a role from a user",
+        "operationId": "revokeRole",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
+            "description": "user name",
             "name": "id",
             "in": "path",
             "required": true
@@ -6793,17 +7853,12 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "name",
-                "permissions"
-              ],
               "properties": {
-                "permissions": {
-                  "description": "permissions to be added to the role",
+                "roles": {
+                  "description": "the roles that revoked from the key or user",
                   "type": "array",
                   "items": {
-                    "$ref": "#/definitions/Permission"
+                    "type": "string"
                   }
                 }
               }
@@ -6812,10 +7867,63 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Permissions added successfully"
+            "description": "Role revoked successfully"
+          },
+          "400": {
+            "description": "Bad request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "401": {
+            "description": "Unauthorized or invalid credentials."
+          },
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "role or user is not found."
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          }
+        },
+        "x-serviceIds": [
+          "weaviate.authz.revoke.role"
+        ]
+      }
+    },
+    "/authz/users/{id}/roles": {
+      "get": {
+        "tags": [
+          "authz"
+        ],
+        "summary": "get roles assigned to user",
+        "operationId": "getRolesForUser",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "user name",
+            "name": "id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Role assigned users",
+            "schema": {
+              "$ref": "#/definitions/RolesListResponse"
+            }
           },
           "400": {
-            "description": "Malformed request.",
+            "description": "Bad request",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6830,13 +7938,7 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found"
-          },
-          "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+            "description": "no role found for user"
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -6846,45 +7948,32 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.add.role.permissions"
+          "weaviate.authz.get.users.roles"
         ]
       }
     },
-    "/authz/roles/{id}/has-permission": {
-      "post": {
+    "/backups/{backend}": {
+      "get": {
+        "description": "[Coming soon] List all backups in progress not implemented yet.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Check whether role possesses this permission.",
-        "operationId": "hasPermission",
+        "summary": "List backups in progress",
+        "operationId": "backups.list",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
-            "name": "id",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
             "in": "path",
             "required": true
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Permission"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Permission check was successful",
-            "schema": {
-              "type": "boolean"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Existed backups",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupListResponse"
             }
           },
           "401": {
@@ -6897,7 +7986,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup list.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6910,22 +7999,21 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.has.role.permission"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/roles/{id}/remove-permissions": {
+      },
       "post": {
+        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Remove permissions from a role. If this results in an empty role, the role will be deleted.",
-        "operationId": "removePermissions",
+        "summary": "Start a backup process",
+        "operationId": "backups.create",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
-            "name": "id",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
             "in": "path",
             "required": true
           },
@@ -6934,30 +8022,15 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "permissions"
-              ],
-              "properties": {
-                "permissions": {
-                  "description": "permissions to remove from the role",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Permission"
-                  }
-                }
-              }
+              "$ref": "#/definitions/BackupCreateRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Permissions removed successfully"
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Backup create process successfully started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupCreateResponse"
             }
           },
           "401": {
@@ -6969,11 +8042,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "no role found"
-          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Invalid backup creation attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -6986,40 +8056,51 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.remove.role.permissions"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/roles/{id}/users": {
+    "/backups/{backend}/{id}": {
       "get": {
+        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "get users or a keys assigned to role",
-        "operationId": "getUsersForRole",
+        "summary": "Get backup process status",
+        "operationId": "backups.create.status",
         "parameters": [
           {
             "type": "string",
-            "description": "role name",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Users assigned to this role",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Backup creation status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupCreateStatusResponse"
             }
           },
           "401": {
@@ -7032,37 +8113,17 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found"
-          },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "description": "Not Found - Backup does not exist",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
-          }
-        },
-        "x-serviceIds": [
-          "weaviate.authz.get.roles.users"
-        ]
-      }
-    },
-    "/authz/users/own-roles": {
-      "get": {
-        "tags": [
-          "authz"
-        ],
-        "summary": "get roles assigned to own user",
-        "operationId": "getRolesForOwnUser",
-        "responses": {
-          "200": {
-            "description": "Role assigned to own users",
+          },
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
-              "$ref": "#/definitions/RolesListResponse"
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7071,51 +8132,47 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.users.own-roles"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/users/{id}/assign": {
-      "post": {
+      },
+      "delete": {
+        "description": "Cancel created backup with specified ID",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Assign a role to a user",
-        "operationId": "assignRole",
+        "summary": "Cancel backup",
+        "operationId": "backups.cancel",
         "parameters": [
           {
             "type": "string",
-            "description": "user name",
+            "description": "Backup backend name e.g. filesystem, gcs, s3.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "properties": {
-                "roles": {
-                  "description": "the roles that assigned to user",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
-            }
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Role assigned successfully"
-          },
-          "400": {
-            "description": "Bad request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7126,8 +8183,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "role or user is not found."
+          "422": {
+            "description": "Invalid backup cancellation attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7137,50 +8197,51 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.assign.role"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/authz/users/{id}/revoke": {
-      "post": {
+    "/backups/{backend}/{id}/restore": {
+      "get": {
+        "description": "Returns status of a backup restoration attempt for a set of classes. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using the this endpoint.",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "Revoke a role from a user",
-        "operationId": "revokeRole",
+        "summary": "Get restore process status",
+        "operationId": "backups.restore.status",
         "parameters": [
           {
             "type": "string",
-            "description": "user name",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
           },
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "properties": {
-                "roles": {
-                  "description": "the roles that revoked from the key or user",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
-            }
+            "type": "string",
+            "description": "Name of the bucket, container, volume, etc",
+            "name": "bucket",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "The path within the bucket",
+            "name": "path",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Role revoked successfully"
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Backup restoration status successfully returned",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupRestoreStatusResponse"
             }
           },
           "401": {
@@ -7193,7 +8254,10 @@ func init() {
             }
           },
           "404": {
-            "description": "role or user is not found."
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7203,37 +8267,45 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.revoke.role"
+          "weaviate.local.backup"
         ]
-      }
-    },
-    "/authz/users/{id}/roles": {
-      "get": {
+      },
+      "post": {
+        "description": "Starts a process of restoring a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAny backup can be restored to any machine, as long as the number of nodes between source and target are identical.\u003cbr/\u003e\u003cbr/\u003eRequrements:\u003cbr/\u003e\u003cbr/\u003e- None of the collections to be restored already exist on the target restoration node(s).\u003cbr/\u003e- The node names of the backed-up collections' must match those of the target restoration node(s).",
         "tags": [
-          "authz"
+          "backups"
         ],
-        "summary": "get roles assigned to user",
-        "operationId": "getRolesForUser",
+        "summary": "Start a restoration process",
+        "operationId": "backups.restore",
         "parameters": [
           {
             "type": "string",
-            "description": "user name",
+            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
+            "name": "backend",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
             "name": "id",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/BackupRestoreRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Role assigned users",
-            "schema": {
-              "$ref": "#/definitions/RolesListResponse"
-            }
-          },
-          "400": {
-            "description": "Bad request",
+            "description": "Backup restoration process successfully started.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/BackupRestoreResponse"
             }
           },
           "401": {
@@ -7246,7 +8318,16 @@ func init() {
             }
           },
           "404": {
-            "description": "no role found for user"
+            "description": "Not Found - Backup does not exist",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "422": {
+            "description": "Invalid backup restoration attempt.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
@@ -7256,32 +8337,72 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.authz.get.users.roles"
+          "weaviate.local.backup"
         ]
       }
     },
-    "/backups/{backend}": {
-      "get": {
-        "description": "[Coming soon] List all backups in progress not implemented yet.",
+    "/batch/objects": {
+      "post": {
+        "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
         "tags": [
-          "backups"
+          "batch",
+          "objects"
         ],
-        "summary": "List backups in progress",
-        "operationId": "backups.list",
+        "summary": "Creates new Objects based on a Object template as a batch.",
+        "operationId": "batch.objects.create",
         "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "fields": {
+                  "description": "Define which fields need to be returned. Default value is ALL",
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "default": "ALL",
+                    "enum": [
+                      "ALL",
+                      "class",
+                      "schema",
+                      "id",
+                      "creationTimeUnix"
+                    ]
+                  }
+                },
+                "objects": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/Object"
+                  }
+                }
+              }
+            }
+          },
           {
             "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Existed backups",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/BackupListResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ObjectsGetResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -7294,7 +8415,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup list.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7306,39 +8427,53 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.add"
         ]
       },
-      "post": {
-        "description": "Start creating a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eNotes: \u003cbr/\u003e- Weaviate uses gzip compression by default. \u003cbr/\u003e- Weaviate stays usable while a backup process is ongoing.",
+      "delete": {
+        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
         "tags": [
-          "backups"
+          "batch",
+          "objects"
         ],
-        "summary": "Start a backup process",
-        "operationId": "backups.create",
+        "summary": "Deletes Objects based on a match filter as a batch.",
+        "operationId": "batch.objects.delete",
         "parameters": [
-          {
-            "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupCreateRequest"
+              "$ref": "#/definitions/BatchDelete"
             }
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup create process successfully started.",
+            "description": "Request succeeded, see response body to get detailed information about each batched item.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateResponse"
+              "$ref": "#/definitions/BatchDeleteResponse"
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -7351,7 +8486,7 @@ func init() {
             }
           },
           "422": {
-            "description": "Invalid backup creation attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7363,52 +8498,56 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/backups/{backend}/{id}": {
-      "get": {
-        "description": "Returns status of backup creation attempt for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using this endpoint.",
+    "/batch/references": {
+      "post": {
+        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
         "tags": [
-          "backups"
+          "batch",
+          "references"
         ],
-        "summary": "Get backup process status",
-        "operationId": "backups.create.status",
+        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
+        "operationId": "batch.references.create",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
+            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReference"
+              }
+            }
           },
           {
             "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup creation status successfully returned",
+            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
             "schema": {
-              "$ref": "#/definitions/BackupCreateStatusResponse"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/BatchReferenceResponse"
+              }
+            }
+          },
+          "400": {
+            "description": "Malformed request.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
             }
           },
           "401": {
@@ -7420,14 +8559,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7439,48 +8572,44 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.add"
         ]
-      },
-      "delete": {
-        "description": "Cancel created backup with specified ID",
+      }
+    },
+    "/classifications/": {
+      "post": {
+        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
         "tags": [
-          "backups"
+          "classifications"
         ],
-        "summary": "Cancel backup",
-        "operationId": "backups.cancel",
+        "summary": "Starts a classification.",
+        "operationId": "classifications.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. filesystem, gcs, s3.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
+            "description": "parameters to start a classification",
+            "name": "params",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Successfully deleted."
+          "201": {
+            "description": "Successfully started classification.",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
+          },
+          "400": {
+            "description": "Incorrect request",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7491,12 +8620,6 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "422": {
-            "description": "Invalid backup cancellation attempt.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7505,51 +8628,71 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.classifications.post"
         ]
       }
     },
-    "/backups/{backend}/{id}/restore": {
+    "/classifications/{id}": {
       "get": {
-        "description": "Returns status of a backup restoration attempt for a set of classes. \u003cbr/\u003e\u003cbr/\u003eAll client implementations have a ` + "`" + `wait for completion` + "`" + ` option which will poll the backup status in the background and only return once the backup has completed (successfully or unsuccessfully). If you set the ` + "`" + `wait for completion` + "`" + ` option to false, you can also check the status yourself using the this endpoint.",
+        "description": "Get status, results and metadata of a previously created classification",
         "tags": [
-          "backups"
+          "classifications"
         ],
-        "summary": "Get restore process status",
-        "operationId": "backups.restore.status",
+        "summary": "View previously created classification",
+        "operationId": "classifications.get",
         "parameters": [
           {
             "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
+            "description": "classification id",
             "name": "id",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Found the classification, returned as body",
+            "schema": {
+              "$ref": "#/definitions/Classification"
+            }
           },
-          {
-            "type": "string",
-            "description": "Name of the bucket, container, volume, etc",
-            "name": "bucket",
-            "in": "query"
+          "401": {
+            "description": "Unauthorized or invalid credentials."
           },
-          {
-            "type": "string",
-            "description": "The path within the bucket",
-            "name": "path",
-            "in": "query"
+          "403": {
+            "description": "Forbidden",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
+          "404": {
+            "description": "Not Found - Classification does not exist"
+          },
+          "500": {
+            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
           }
+        },
+        "x-serviceIds": [
+          "weaviate.classifications.get"
+        ]
+      }
+    },
+    "/cluster/statistics": {
+      "get": {
+        "description": "Returns Raft cluster statistics of Weaviate DB.",
+        "tags": [
+          "cluster"
         ],
+        "summary": "See Raft cluster statistics",
+        "operationId": "cluster.get.statistics",
         "responses": {
           "200": {
-            "description": "Backup restoration status successfully returned",
+            "description": "Cluster statistics successfully returned",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreStatusResponse"
+              "$ref": "#/definitions/ClusterStatisticsResponse"
             }
           },
           "401": {
@@ -7561,8 +8704,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
+          "422": {
+            "description": "Invalid backup restoration status attempt.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7575,45 +8718,34 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.cluster.statistics.get"
         ]
-      },
+      }
+    },
+    "/graphql": {
       "post": {
-        "description": "Starts a process of restoring a backup for a set of collections. \u003cbr/\u003e\u003cbr/\u003eAny backup can be restored to any machine, as long as the number of nodes between source and target are identical.\u003cbr/\u003e\u003cbr/\u003eRequrements:\u003cbr/\u003e\u003cbr/\u003e- None of the collections to be restored already exist on the target restoration node(s).\u003cbr/\u003e- The node names of the backed-up collections' must match those of the target restoration node(s).",
+        "description": "Get a response based on a GraphQL query",
         "tags": [
-          "backups"
+          "graphql"
         ],
-        "summary": "Start a restoration process",
-        "operationId": "backups.restore",
+        "summary": "Get a response based on GraphQL",
+        "operationId": "graphql.post",
         "parameters": [
           {
-            "type": "string",
-            "description": "Backup backend name e.g. ` + "`" + `filesystem` + "`" + `, ` + "`" + `gcs` + "`" + `, ` + "`" + `s3` + "`" + `, ` + "`" + `azure` + "`" + `.",
-            "name": "backend",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "The ID of a backup. Must be URL-safe and work as a filesystem path, only lowercase, numbers, underscore, minus characters allowed.",
-            "name": "id",
-            "in": "path",
-            "required": true
-          },
-          {
+            "description": "The GraphQL query request parameters.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/BackupRestoreRequest"
+              "$ref": "#/definitions/GraphQLQuery"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Backup restoration process successfully started.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/BackupRestoreResponse"
+              "$ref": "#/definitions/GraphQLResponse"
             }
           },
           "401": {
@@ -7625,14 +8757,8 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
-          "404": {
-            "description": "Not Found - Backup does not exist",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
-          },
           "422": {
-            "description": "Invalid backup restoration attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7644,73 +8770,40 @@ func init() {
             }
           }
         },
+        "x-available-in-mqtt": false,
+        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.backup"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
       }
     },
-    "/batch/objects": {
+    "/graphql/batch": {
       "post": {
-        "description": "Create new objects in bulk. \u003cbr/\u003e\u003cbr/\u003eMeta-data and schema values are validated. \u003cbr/\u003e\u003cbr/\u003e**Note: idempotence of ` + "`" + `/batch/objects` + "`" + `**: \u003cbr/\u003e` + "`" + `POST /batch/objects` + "`" + ` is idempotent, and will overwrite any existing object given the same id.",
+        "description": "Perform a batched GraphQL query",
         "tags": [
-          "batch",
-          "objects"
+          "graphql"
         ],
-        "summary": "Creates new Objects based on a Object template as a batch.",
-        "operationId": "batch.objects.create",
+        "summary": "Get a response based on GraphQL.",
+        "operationId": "graphql.batch",
         "parameters": [
           {
+            "description": "The GraphQL queries.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "properties": {
-                "fields": {
-                  "description": "Define which fields need to be returned. Default value is ALL",
-                  "type": "array",
-                  "items": {
-                    "type": "string",
-                    "default": "ALL",
-                    "enum": [
-                      "ALL",
-                      "class",
-                      "schema",
-                      "id",
-                      "creationTimeUnix"
-                    ]
-                  }
-                },
-                "objects": {
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/Object"
-                  }
-                }
-              }
-            }
-          },
-          {
-            "type": "string",
-            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
-            "name": "consistency_level",
-            "in": "query"
+              "$ref": "#/definitions/GraphQLQueries"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/ObjectsGetResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful query (with select).",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/GraphQLResponses"
             }
           },
           "401": {
@@ -7738,25 +8831,35 @@ func init() {
         "x-available-in-mqtt": false,
         "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.query",
+          "weaviate.local.query.meta",
+          "weaviate.network.query",
+          "weaviate.network.query.meta"
         ]
-      },
-      "delete": {
-        "description": "Batch delete objects that match a particular filter. \u003cbr/\u003e\u003cbr/\u003eThe request body takes a single ` + "`" + `where` + "`" + ` filter and will delete all objects matched. \u003cbr/\u003e\u003cbr/\u003eNote that there is a limit to the number of objects to be deleted at once using this filter, in order to protect against unexpected memory surges and very-long-running requests. The default limit is 10,000 and may be configured by setting the ` + "`" + `QUERY_MAXIMUM_RESULTS` + "`" + ` environment variable. \u003cbr/\u003e\u003cbr/\u003eObjects are deleted in the same order that they would be returned in an equivalent Get query. To delete more objects than the limit, run the same query multiple times.",
+      }
+    },
+    "/kv/{className}/{key}": {
+      "get": {
+        "description": "Get a single entry from the key-value side bucket of a collection. Entries live next to the objects of the collection and share its replication, multi-tenancy and authorization, but are not vectorized, indexed or returned by queries.",
         "tags": [
-          "batch",
-          "objects"
+          "kv"
         ],
-        "summary": "Deletes Objects based on a match filter as a batch.",
-        "operationId": "batch.objects.delete",
+        "summary": "Get a key-value entry",
+        "operationId": "getKeyValue",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/BatchDelete"
-            }
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The key of the entry",
+            "name": "key",
+            "in": "path",
+            "required": true
           },
           {
             "type": "string",
@@ -7773,15 +8876,9 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Request succeeded, see response body to get detailed information about each batched item.",
-            "schema": {
-              "$ref": "#/definitions/BatchDeleteResponse"
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successful response.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/KeyValueEntry"
             }
           },
           "401": {
@@ -7793,8 +8890,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -7806,33 +8906,39 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.manipulate"
+          "weaviate.local.query"
         ]
-      }
-    },
-    "/batch/references": {
-      "post": {
-        "description": "Batch create cross-references between collections items (objects or objects) in bulk.",
+      },
+      "put": {
+        "description": "Create or replace a single entry in the key-value side bucket of a collection. The ` + "`" + `key` + "`" + ` of the body is ignored, the key is taken from the path.",
         "tags": [
-          "batch",
-          "references"
+          "kv"
         ],
-        "summary": "Creates new Cross-References between arbitrary classes in bulk.",
-        "operationId": "batch.references.create",
+        "summary": "Write a key-value entry",
+        "operationId": "putKeyValue",
         "parameters": [
           {
-            "description": "A list of references to be batched. The ideal size depends on the used database connector. Please see the documentation of the used connector for help",
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The key of the entry",
+            "name": "key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The entry to write.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReference"
-              }
+              "$ref": "#/definitions/KeyValueEntry"
             }
           },
           {
@@ -7840,22 +8946,19 @@ func init() {
             "description": "Determines how many replicas must acknowledge a request before it is considered successful",
             "name": "consistency_level",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Request Successful. Warning: A successful request does not guarantee that every batched reference was successfully created. Inspect the response body to see which references succeeded and which failed.",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/BatchReferenceResponse"
-              }
-            }
-          },
-          "400": {
-            "description": "Malformed request.",
+            "description": "Successfully written.",
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/KeyValueEntry"
             }
           },
           "401": {
@@ -7867,6 +8970,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -7880,44 +8986,48 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.add"
+          "weaviate.local.manipulate"
         ]
-      }
-    },
-    "/classifications/": {
-      "post": {
-        "description": "Trigger a classification based on the specified params. Classifications will run in the background, use GET /classifications/\u003cid\u003e to retrieve the status of your classification.",
+      },
+      "delete": {
+        "description": "Delete a single entry from the key-value side bucket of a collection. Deleting an entry that does not exist succeeds.",
         "tags": [
-          "classifications"
+          "kv"
         ],
-        "summary": "Starts a classification.",
-        "operationId": "classifications.post",
+        "summary": "Delete a key-value entry",
+        "operationId": "deleteKeyValue",
         "parameters": [
           {
-            "description": "parameters to start a classification",
-            "name": "params",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The key of the entry",
+            "name": "key",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
-          "201": {
-            "description": "Successfully started classification.",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
-          },
-          "400": {
-            "description": "Incorrect request",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          "204": {
+            "description": "Successfully deleted."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -7928,6 +9038,15 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "422": {
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "500": {
             "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
             "schema": {
@@ -7936,73 +9055,65 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.classifications.post"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/classifications/{id}": {
-      "get": {
-        "description": "Get status, results and metadata of a previously created classification",
+    "/kv/{className}/{key}/cas": {
+      "post": {
+        "description": "Write or delete a single entry of the key-value side bucket of a collection, but only if the current entry matches the given condition. The condition is checked on all reachable replicas before anything is written. If it does not hold on any of them, or another conditional write on the same key is in progress, the write is rejected on all replicas.",
         "tags": [
-          "classifications"
+          "kv"
         ],
-        "summary": "View previously created classification",
-        "operationId": "classifications.get",
+        "summary": "Conditionally write a key-value entry",
+        "operationId": "compareAndSwapKeyValue",
         "parameters": [
           {
             "type": "string",
-            "description": "classification id",
-            "name": "id",
+            "description": "The class name as defined in the schema",
+            "name": "className",
             "in": "path",
             "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "Found the classification, returned as body",
-            "schema": {
-              "$ref": "#/definitions/Classification"
-            }
           },
-          "401": {
-            "description": "Unauthorized or invalid credentials."
+          {
+            "type": "string",
+            "description": "The key of the entry",
+            "name": "key",
+            "in": "path",
+            "required": true
           },
-          "403": {
-            "description": "Forbidden",
+          {
+            "description": "The condition and the write to apply.",
+            "name": "body",
+            "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ErrorResponse"
+              "$ref": "#/definitions/KeyValueCompareAndSwap"
             }
           },
-          "404": {
-            "description": "Not Found - Classification does not exist"
+          {
+            "type": "string",
+            "description": "Determines how many replicas must acknowledge a request before it is considered successful",
+            "name": "consistency_level",
+            "in": "query"
           },
-          "500": {
-            "description": "An error has occurred while trying to fulfill the request. Most likely the ErrorResponse will contain more information about the error.",
-            "schema": {
-              "$ref": "#/definitions/ErrorResponse"
-            }
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
-        },
-        "x-serviceIds": [
-          "weaviate.classifications.get"
-        ]
-      }
-    },
-    "/cluster/statistics": {
-      "get": {
-        "description": "Returns Raft cluster statistics of Weaviate DB.",
-        "tags": [
-          "cluster"
         ],
-        "summary": "See Raft cluster statistics",
-        "operationId": "cluster.get.statistics",
         "responses": {
           "200": {
-            "description": "Cluster statistics successfully returned",
+            "description": "Successfully written.",
             "schema": {
-              "$ref": "#/definitions/ClusterStatisticsResponse"
+              "$ref": "#/definitions/KeyValueEntry"
             }
           },
+          "204": {
+            "description": "Successfully deleted."
+          },
           "401": {
             "description": "Unauthorized or invalid credentials."
           },
@@ -8012,8 +9123,17 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
+          "412": {
+            "description": "The current entry does not match the condition. Nothing was written.",
+            "schema": {
+              "$ref": "#/definitions/ErrorResponse"
+            }
+          },
           "422": {
-            "description": "Invalid backup restoration status attempt.",
+            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8026,34 +9146,47 @@ func init() {
           }
         },
         "x-serviceIds": [
-          "weaviate.cluster.statistics.get"
+          "weaviate.local.manipulate"
         ]
       }
     },
-    "/graphql": {
+    "/snapshots/{className}": {
       "post": {
-        "description": "Get a response based on a GraphQL query",
+        "description": "Open a point-in-time snapshot of the objects of a collection (or of a tenant). Object listings which pass the returned token as ` + "`" + `snapshot` + "`" + ` return the objects as they were when the snapshot was opened, so that paginating through a collection is not affected by concurrent writes. The snapshot is released automatically once its ttl has passed. Only shards held by the node which serves the request are part of the snapshot.",
         "tags": [
-          "graphql"
+          "snapshots"
         ],
-        "summary": "Get a response based on GraphQL",
-        "operationId": "graphql.post",
+        "summary": "Open a read snapshot",
+        "operationId": "openSnapshot",
         "parameters": [
           {
-            "description": "The GraphQL query request parameters.",
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "The time-to-live of the snapshot.",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/GraphQLQuery"
+              "$ref": "#/definitions/ReadSnapshot"
             }
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Successful query (with select).",
+            "description": "Successfully opened.",
             "schema": {
-              "$ref": "#/definitions/GraphQLResponse"
+              "$ref": "#/definitions/ReadSnapshot"
             }
           },
           "401": {
@@ -8065,6 +9198,9 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "Successful query result but no resource was found."
+          },
           "422": {
             "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
             "schema": {
@@ -8078,41 +9214,44 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.query"
         ]
       }
     },
-    "/graphql/batch": {
-      "post": {
-        "description": "Perform a batched GraphQL query",
+    "/snapshots/{className}/{token}": {
+      "delete": {
+        "description": "Release a snapshot opened before its ttl has passed, so that the data it pins can be cleaned up.",
         "tags": [
-          "graphql"
+          "snapshots"
         ],
-        "summary": "Get a response based on GraphQL.",
-        "operationId": "graphql.batch",
+        "summary": "Release a read snapshot",
+        "operationId": "releaseSnapshot",
         "parameters": [
           {
-            "description": "The GraphQL queries.",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/GraphQLQueries"
-            }
+            "type": "string",
+            "description": "The class name as defined in the schema",
+            "name": "className",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "The token of the snapshot",
+            "name": "token",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Specifies the tenant in a request targeting a multi-tenant class",
+            "name": "tenant",
+            "in": "query"
           }
         ],
         "responses": {
-          "200": {
-            "description": "Successful query (with select).",
-            "schema": {
-              "$ref": "#/definitions/GraphQLResponses"
-            }
+          "204": {
+            "description": "Successfully released."
           },
           "401": {
             "description": "Unauthorized or invalid credentials."
@@ -8123,8 +9262,11 @@ func init() {
               "$ref": "#/definitions/ErrorResponse"
             }
           },
+          "404": {
+            "description": "The snapshot does not exist or has already expired."
+          },
           "422": {
-            "description": "Request body is well-formed (i.e., syntactically correct), but semantically erroneous. Are you sure the class is defined in the configuration file?",
+            "description": "Request is well-formed (i.e., syntactically correct), but erroneous.",
             "schema": {
               "$ref": "#/definitions/ErrorResponse"
             }
@@ -8136,13 +9278,8 @@ func init() {
             }
           }
         },
-        "x-available-in-mqtt": false,
-        "x-available-in-websocket": false,
         "x-serviceIds": [
-          "weaviate.local.query",
-          "weaviate.local.query.meta",
-          "weaviate.network.query",
-          "weaviate.network.query.meta"
+          "weaviate.local.query"
         ]
       }
     },
@@ -8319,6 +9456,31 @@ func init() {
             "name": "after",
             "in": "query"
           },
+          {
+            "type": "string",
+            "description": "A threshold UUID of the objects to retrieve before, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Can be combined with ` + "`" + `after` + "`" + ` to list a range of UUIDs. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `before` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+            "name": "before",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only retrieve objects whose UUID starts with the given hexadecimal prefix, e.g. ` + "`" + `0a3f` + "`" + `. Dashes are ignored. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Note ` + "`" + `prefix` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Retrieve the objects in descending UUID order. To paginate in reverse, pass the UUID of the last object of a page as ` + "`" + `before` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Note ` + "`" + `reverse` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+            "name": "reverse",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Read the objects from the point-in-time snapshot with this token, as returned when opening a snapshot of the class. Objects are returned as they were when the snapshot was opened. Can not be combined with sort. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `.",
+            "name": "snapshot",
+            "in": "query"
+          },
           {
             "type": "integer",
             "format": "int64",
@@ -10094,7 +11256,7 @@ func init() {
     },
     "/schema/{className}/shards/{shardName}": {
       "put": {
-        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection.",
+        "description": "Update a shard status for a collection. For example, a shard may have been marked as ` + "`" + `READONLY` + "`" + ` because its disk was full. After providing more disk space, use this endpoint to set the shard status to ` + "`" + `READY` + "`" + ` again. There is also a convenience function in each client to set the status of all shards of a collection. Setting a shard to ` + "`" + `LOADING` + "`" + ` starts a bulk load: objects are written directly to disk segments without a write-ahead log and without being vector indexed, and queries against the shard are rejected. Setting it to ` + "`" + `READY` + "`" + ` afterwards merges the written segments and builds the vector index, the shard reports ` + "`" + `LOADING` + "`" + ` until this has finished.",
         "tags": [
           "schema"
         ],
@@ -11319,6 +12481,9 @@ func init() {
         "multiTenancyConfig": {
           "$ref": "#/definitions/MultiTenancyConfig"
         },
+        "objectTtlConfig": {
+          "$ref": "#/definitions/ObjectTTLConfig"
+        },
         "properties": {
           "description": "Define properties of the collection.",
           "type": "array",
@@ -11690,6 +12855,13 @@ func init() {
       "description": "Configure the inverted index built into Weaviate (default: 60).",
       "type": "object",
       "properties": {
+        "analyzers": {
+          "description": "Named text analyzers, each combining a tokenizer with a chain of token filters. Properties reference an analyzer by name to use it at index and query time.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerConfig"
+          }
+        },
         "bm25": {
           "$ref": "#/definitions/BM25Config"
         },
@@ -11712,6 +12884,13 @@ func init() {
         },
         "stopwords": {
           "$ref": "#/definitions/StopwordConfig"
+        },
+        "synonyms": {
+          "description": "Synonym sets used to expand the terms of keyword queries and text filters. Synonyms are applied at query time only and can be updated without reindexing.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SynonymSet"
+          }
         }
       }
     },
@@ -11719,6 +12898,48 @@ func init() {
       "description": "JSON object value.",
       "type": "object"
     },
+    "KeyValueCompareAndSwap": {
+      "description": "A conditional write of a single key-value entry. The write is only applied if the current entry matches the condition.",
+      "properties": {
+        "delete": {
+          "description": "If true, the entry is deleted instead of written. Must not be combined with ` + "`" + `value` + "`" + `.",
+          "type": "boolean"
+        },
+        "expectAbsent": {
+          "description": "If true, the write is only applied if no entry exists for the key.",
+          "type": "boolean"
+        },
+        "expectedValue": {
+          "description": "The value the entry must currently hold for the write to be applied, base64 encoded.",
+          "type": "string",
+          "format": "byte"
+        },
+        "value": {
+          "description": "The new value of the entry, base64 encoded.",
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "KeyValueEntry": {
+      "description": "A single entry of a collection's key-value side bucket.",
+      "properties": {
+        "key": {
+          "description": "The key of the entry.",
+          "type": "string"
+        },
+        "lastUpdateTimeUnix": {
+          "description": "Time of the last write of this entry in milliseconds since epoch UTC. Set by the server.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "value": {
+          "description": "The value of the entry, base64 encoded.",
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
     "Link": {
       "type": "object",
       "properties": {
@@ -11869,6 +13090,14 @@ func init() {
           "format": "int64",
           "x-omitempty": false
         },
+        "vectorIndexMigrations": {
+          "description": "Online migrations of the vector indexes of the shard that are in progress or failed.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/NodeShardVectorIndexMigration"
+          },
+          "x-omitempty": true
+        },
         "vectorIndexingStatus": {
           "description": "The status of the vector indexing process.",
           "format": "string",
@@ -11882,6 +13111,41 @@ func init() {
         }
       }
     },
+    "NodeShardVectorIndexMigration": {
+      "description": "The progress of an online migration of a vector index to a new index type or compression setting",
+      "properties": {
+        "error": {
+          "description": "The reason the migration failed, if it did.",
+          "type": "string"
+        },
+        "objectsProcessed": {
+          "description": "The number of objects that were copied to the new vector index so far.",
+          "type": "number",
+          "format": "int64"
+        },
+        "objectsTotal": {
+          "description": "The number of objects in the shard when the migration started.",
+          "type": "number",
+          "format": "int64"
+        },
+        "sourceType": {
+          "description": "The type of the vector index that serves queries until the migration completes.",
+          "type": "string"
+        },
+        "status": {
+          "description": "The status of the migration, INDEXING while the new vector index is built or FAILED if it could not be completed.",
+          "type": "string"
+        },
+        "targetType": {
+          "description": "The type of the vector index that is being built.",
+          "type": "string"
+        },
+        "targetVector": {
+          "description": "The name of the target vector that is migrated, empty for the default vector.",
+          "type": "string"
+        }
+      }
+    },
     "NodeStats": {
       "description": "The summary of Weaviate's statistics.",
       "properties": {
@@ -12001,6 +13265,34 @@ func init() {
         }
       }
     },
+    "ObjectTTLConfig": {
+      "description": "Configure a time-to-live after which objects of a collection are deleted automatically",
+      "properties": {
+        "dateProperty": {
+          "description": "Name of a property of type ` + "`" + `date` + "`" + ` used as the reference time, required if ` + "`" + `deleteOn` + "`" + ` is ` + "`" + `dateProperty` + "`" + `.",
+          "type": "string"
+        },
+        "deleteOn": {
+          "description": "The reference time the time-to-live is based on (default: creationTime). ` + "`" + `creationTime` + "`" + ` and ` + "`" + `updateTime` + "`" + ` require ` + "`" + `invertedIndexConfig.indexTimestamps` + "`" + ` to be enabled.",
+          "type": "string",
+          "enum": [
+            "creationTime",
+            "updateTime",
+            "dateProperty"
+          ]
+        },
+        "enabled": {
+          "description": "Whether or not expired objects are deleted (default: false).",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "ttlSeconds": {
+          "description": "Number of seconds after the reference time after which an object expires. Can be 0 if ` + "`" + `deleteOn` + "`" + ` is ` + "`" + `dateProperty` + "`" + `, in which case objects expire at the given date.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "ObjectsGetResponse": {
       "type": "object",
       "allOf": [
@@ -12423,6 +13715,10 @@ func init() {
     "Property": {
       "type": "object",
       "properties": {
+        "analyzer": {
+          "description": "Name of a text analyzer defined in the class' ` + "`" + `invertedIndexConfig.analyzers` + "`" + `, used instead of the plain tokenization at index and query time. Applies to text and text[] data types.",
+          "type": "string"
+        },
         "dataType": {
           "description": "Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.",
           "type": "array",
@@ -12444,6 +13740,11 @@ func init() {
           "type": "boolean",
           "x-nullable": true
         },
+        "indexPositions": {
+          "description": "Whether to store token positions for this property in the inverted index. Enables ` + "`" + `phrase` + "`" + ` and ` + "`" + `slop` + "`" + ` (proximity) operators in ` + "`" + `bm25` + "`" + ` and ` + "`" + `hybrid` + "`" + ` search. Applicable only to properties of data type text and text[] with a searchable index. Defaults to false.",
+          "type": "boolean",
+          "x-nullable": true
+        },
         "indexRangeFilters": {
           "description": "Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.",
           "type": "boolean",
@@ -12550,6 +13851,25 @@ func init() {
         }
       }
     },
+    "ReadSnapshot": {
+      "description": "A point-in-time snapshot of the objects of a collection, referenced by its token in object listings.",
+      "properties": {
+        "expiresAt": {
+          "description": "Time at which the snapshot is released automatically, in milliseconds since epoch UTC. Set by the server.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "token": {
+          "description": "The token of the snapshot, pass it as ` + "`" + `snapshot` + "`" + ` to reference the snapshot. Set by the server.",
+          "type": "string"
+        },
+        "ttlSeconds": {
+          "description": "The time-to-live of the snapshot in seconds.",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
     "ReferenceMetaClassification": {
       "description": "This meta field contains additional info about the classified reference property",
       "properties": {
@@ -12873,7 +14193,7 @@ func init() {
           }
         },
         "preset": {
-          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].",
+          "description": "Pre-existing list of common words by language (default: 'en'). Options: ['en', 'de', 'fr', 'es', 'it', 'pt', 'nl', 'none'].",
           "type": "string"
         },
         "removals": {
@@ -12885,6 +14205,27 @@ func init() {
         }
       }
     },
+    "SynonymSet": {
+      "description": "A set of synonyms applied to keyword queries and text filters at query time. Entries are tokenized like the searched property, entries which do not result in a single token are ignored",
+      "type": "object",
+      "properties": {
+        "input": {
+          "description": "Term expanded by a ` + "`" + `oneWay` + "`" + ` set. Must be empty for ` + "`" + `equivalent` + "`" + ` sets.",
+          "type": "string"
+        },
+        "synonyms": {
+          "description": "Terms of the set. In an ` + "`" + `equivalent` + "`" + ` set all terms are synonyms of each other, in a ` + "`" + `oneWay` + "`" + ` set they are added to queries containing the input.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "type": {
+          "description": "Type of the set (default: 'equivalent'). Options: ['equivalent', 'oneWay'].",
+          "type": "string"
+        }
+      }
+    },
     "Tenant": {
       "description": "attributes representing a single tenant within weaviate",
       "type": "object",
@@ -12931,6 +14272,66 @@ func init() {
         }
       ]
     },
+    "TextAnalyzerConfig": {
+      "description": "A named text analysis pipeline",
+      "type": "object",
+      "properties": {
+        "filters": {
+          "description": "Token filters applied in order after tokenization.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TextAnalyzerFilter"
+          }
+        },
+        "name": {
+          "description": "Name of the analyzer, referenced by properties.",
+          "type": "string"
+        },
+        "tokenizer": {
+          "description": "Tokenizer splitting the text into tokens. Allowed values are the property tokenizations (default: ` + "`" + `word` + "`" + `).",
+          "type": "string"
+        }
+      }
+    },
+    "TextAnalyzerFilter": {
+      "description": "A token filter of a text analyzer",
+      "type": "object",
+      "properties": {
+        "additions": {
+          "description": "Stopwords removed by the ` + "`" + `stopwords` + "`" + ` filter in addition to the preset.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "language": {
+          "description": "Language of the ` + "`" + `stemmer` + "`" + ` filter, e.g. 'english', 'german', 'french', 'spanish'.",
+          "type": "string"
+        },
+        "preset": {
+          "description": "Stopword preset of the ` + "`" + `stopwords` + "`" + ` filter, e.g. 'en', 'de', 'fr', 'es'.",
+          "type": "string"
+        },
+        "removals": {
+          "description": "Stopwords of the preset kept by the ` + "`" + `stopwords` + "`" + ` filter.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "synonyms": {
+          "description": "Synonym groups of the ` + "`" + `synonyms` + "`" + ` filter, each a comma separated list of equivalent terms. All terms of a group are replaced with the first term of the group.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "type": {
+          "description": "Type of the filter. Options: ['lowercase', 'asciiFolding', 'stemmer', 'stopwords', 'synonyms'].",
+          "type": "string"
+        }
+      }
+    },
     "Vector": {
       "description": "A vector representation of the object. If provided at object creation, this wil take precedence over any vectorizer setting.",
       "type": "object"
@@ -12990,7 +14391,8 @@ func init() {
             "WithinGeoRange",
             "IsNull",
             "ContainsAny",
-            "ContainsAll"
+            "ContainsAll",
+            "Fuzzy"
           ],
           "example": "GreaterThanEqual"
         },
@@ -13159,6 +14561,12 @@ func init() {
       "name": "after",
       "in": "query"
     },
+    "CommonBeforeParameterQuery": {
+      "type": "string",
+      "description": "A threshold UUID of the objects to retrieve before, using an UUID-based ordering. This object is not part of the set. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Can be combined with ` + "`" + `after` + "`" + ` to list a range of UUIDs. \u003cbr/\u003e\u003cbr/\u003eNote ` + "`" + `before` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+      "name": "before",
+      "in": "query"
+    },
     "CommonClassParameterQuery": {
       "type": "string",
       "description": "The collection from which to query objects.  \u003cbr/\u003e\u003cbr/\u003eNote that if ` + "`" + `class` + "`" + ` is not provided, the response will not include any objects.",
@@ -13211,6 +14619,25 @@ func init() {
       "name": "output",
       "in": "query"
     },
+    "CommonPrefixParameterQuery": {
+      "type": "string",
+      "description": "Only retrieve objects whose UUID starts with the given hexadecimal prefix, e.g. ` + "`" + `0a3f` + "`" + `. Dashes are ignored. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Note ` + "`" + `prefix` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+      "name": "prefix",
+      "in": "query"
+    },
+    "CommonReverseParameterQuery": {
+      "type": "boolean",
+      "default": false,
+      "description": "Retrieve the objects in descending UUID order. To paginate in reverse, pass the UUID of the last object of a page as ` + "`" + `before` + "`" + `. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `. Note ` + "`" + `reverse` + "`" + ` cannot be used with ` + "`" + `offset` + "`" + ` or ` + "`" + `sort` + "`" + `.",
+      "name": "reverse",
+      "in": "query"
+    },
+    "CommonSnapshotParameterQuery": {
+      "type": "string",
+      "description": "Read the objects from the point-in-time snapshot with this token, as returned when opening a snapshot of the class. Objects are returned as they were when the snapshot was opened. Can not be combined with sort. \u003cbr/\u003e\u003cbr/\u003eMust be used with ` + "`" + `class` + "`" + `.",
+      "name": "snapshot",
+      "in": "query"
+    },
     "CommonSortParameterQuery": {
       "type": "string",
       "description": "Name(s) of the property to sort by - e.g. ` + "`" + `city` + "`" + `, or ` + "`" + `country,city` + "`" + `.",
@@ -16,6 +16,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -171,6 +172,65 @@ func setupDebugHandlers(appState *state.State) {
 		w.WriteHeader(http.StatusAccepted)
 	}))
 
+	// Reports the connectivity of an hnsw graph: per-layer unreachable nodes,
+	// average degree, tombstone ratio and entrypoint health. POST repairs the
+	// graph first by reconnecting unreachable nodes and replacing an unhealthy
+	// entrypoint, the report then reflects the graph after the repair.
+	http.HandleFunc("/debug/index/health/vector", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var repair bool
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			repair = true
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		colName := r.URL.Query().Get("collection")
+		shardName := r.URL.Query().Get("shard")
+		targetVector := r.URL.Query().Get("vector")
+
+		if colName == "" || shardName == "" {
+			http.Error(w, "collection and shard are required", http.StatusBadRequest)
+			return
+		}
+
+		idx := appState.DB.GetIndex(schema.ClassName(colName))
+		if idx == nil {
+			logger.WithField("collection", colName).Error("collection not found")
+			http.Error(w, "collection not found", http.StatusNotFound)
+			return
+		}
+
+		report, err := idx.DebugGraphHealth(r.Context(), shardName, targetVector, repair)
+		if err != nil {
+			logger.
+				WithField("shard", shardName).
+				WithField("targetVector", targetVector).
+				WithError(err).
+				Error("failed to analyze vector index")
+			if errTxt := err.Error(); strings.Contains(errTxt, "not found") {
+				http.Error(w, errTxt, http.StatusNotFound)
+				return
+			}
+
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		jsonBytes, err := json.Marshal(report)
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on graph health")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
+
 	http.HandleFunc("/debug/stats/collection/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		path := strings.TrimSpace(strings.TrimPrefix(r.URL.Path, "/debug/stats/collection/"))
 		parts := strings.Split(path, "/")
@@ -240,6 +300,39 @@ func setupDebugHandlers(appState *state.State) {
 		w.Write(jsonBytes)
 	}))
 
+	// Lists running and waiting lsm compactions of this node. POST with
+	// ?maxBytesPerSecond=N changes the compaction IO budget until the next
+	// restart, 0 removes the limit.
+	http.HandleFunc("/debug/lsm/compactions", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		scheduler := appState.DB.CompactionScheduler()
+
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPost:
+			budget, err := strconv.ParseInt(r.URL.Query().Get("maxBytesPerSecond"), 10, 64)
+			if err != nil || budget < 0 {
+				http.Error(w, "maxBytesPerSecond must be a non-negative integer", http.StatusBadRequest)
+				return
+			}
+			scheduler.SetMaxBytesPerSecond(budget)
+			logger.WithField("maxBytesPerSecond", budget).Info("changed lsm compaction IO budget")
+		default:
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		jsonBytes, err := json.Marshal(scheduler.Status())
+		if err != nil {
+			logger.WithError(err).Error("marshal failed on compaction status")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(jsonBytes)
+	}))
+
 	// Call via something like: curl -X GET localhost:6060/debug/config/maintenance_mode (can replace GET w/ POST or DELETE)
 	// The port is Weaviate's configured Go profiling port (defaults to 6060)
 	http.HandleFunc("/debug/config/maintenance_mode", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
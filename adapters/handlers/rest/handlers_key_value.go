@@ -0,0 +1,207 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/kv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type keyValueHandlers struct {
+	manager             keyValueManager
+	logger              logrus.FieldLogger
+	metricRequestsTotal restApiRequestsTotal
+}
+
+type keyValueManager interface {
+	GetKeyValue(ctx context.Context, principal *models.Principal, class, key string,
+		repl *additional.ReplicationProperties, tenant string) (*uco.KeyValue, error)
+	PutKeyValue(ctx context.Context, principal *models.Principal, class, key string, value []byte,
+		repl *additional.ReplicationProperties, tenant string) (*uco.KeyValue, error)
+	DeleteKeyValue(ctx context.Context, principal *models.Principal, class, key string,
+		repl *additional.ReplicationProperties, tenant string) error
+	CompareAndSwapKeyValue(ctx context.Context, principal *models.Principal, class, key string,
+		cond uco.KeyValueCondition, value []byte, del bool,
+		repl *additional.ReplicationProperties, tenant string) (*uco.KeyValue, error)
+}
+
+func setupKeyValueHandlers(api *operations.WeaviateAPI, manager keyValueManager,
+	metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &keyValueHandlers{manager, logger, newObjectsRequestsTotal(metrics, logger)}
+	api.KvGetKeyValueHandler = kv.GetKeyValueHandlerFunc(h.getKeyValue)
+	api.KvPutKeyValueHandler = kv.PutKeyValueHandlerFunc(h.putKeyValue)
+	api.KvDeleteKeyValueHandler = kv.DeleteKeyValueHandlerFunc(h.deleteKeyValue)
+	api.KvCompareAndSwapKeyValueHandler = kv.CompareAndSwapKeyValueHandlerFunc(h.compareAndSwapKeyValue)
+}
+
+func (h *keyValueHandlers) getKeyValue(params kv.GetKeyValueParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return kv.NewGetKeyValueUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	entry, err := h.manager.GetKeyValue(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Key, repl, getTenant(params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &uco.ErrNotFound{}):
+			return kv.NewGetKeyValueNotFound()
+		case errors.As(err, &autherrs.Forbidden{}):
+			return kv.NewGetKeyValueForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return kv.NewGetKeyValueUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return kv.NewGetKeyValueInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return kv.NewGetKeyValueOK().WithPayload(keyValueEntry(entry))
+}
+
+func (h *keyValueHandlers) putKeyValue(params kv.PutKeyValueParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return kv.NewPutKeyValueUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	entry, err := h.manager.PutKeyValue(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Key, params.Body.Value, repl, getTenant(params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &uco.ErrNotFound{}):
+			return kv.NewPutKeyValueNotFound()
+		case errors.As(err, &autherrs.Forbidden{}):
+			return kv.NewPutKeyValueForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return kv.NewPutKeyValueUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return kv.NewPutKeyValueInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return kv.NewPutKeyValueOK().WithPayload(keyValueEntry(entry))
+}
+
+func (h *keyValueHandlers) deleteKeyValue(params kv.DeleteKeyValueParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return kv.NewDeleteKeyValueUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	err = h.manager.DeleteKeyValue(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Key, repl, getTenant(params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &uco.ErrNotFound{}):
+			return kv.NewDeleteKeyValueNotFound()
+		case errors.As(err, &autherrs.Forbidden{}):
+			return kv.NewDeleteKeyValueForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return kv.NewDeleteKeyValueUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return kv.NewDeleteKeyValueInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return kv.NewDeleteKeyValueNoContent()
+}
+
+func (h *keyValueHandlers) compareAndSwapKeyValue(params kv.CompareAndSwapKeyValueParams,
+	principal *models.Principal,
+) middleware.Responder {
+	repl, err := getReplicationProperties(params.ConsistencyLevel, nil)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		return kv.NewCompareAndSwapKeyValueUnprocessableEntity().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
+
+	cond := uco.KeyValueCondition{
+		Absent: params.Body.ExpectAbsent,
+		Value:  params.Body.ExpectedValue,
+	}
+	entry, err := h.manager.CompareAndSwapKeyValue(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Key, cond, params.Body.Value, params.Body.Delete,
+		repl, getTenant(params.Tenant))
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &uco.ErrPreconditionFailed{}):
+			return kv.NewCompareAndSwapKeyValuePreconditionFailed().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrNotFound{}):
+			return kv.NewCompareAndSwapKeyValueNotFound()
+		case errors.As(err, &autherrs.Forbidden{}):
+			return kv.NewCompareAndSwapKeyValueForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return kv.NewCompareAndSwapKeyValueUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return kv.NewCompareAndSwapKeyValueInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	if entry == nil {
+		return kv.NewCompareAndSwapKeyValueNoContent()
+	}
+	return kv.NewCompareAndSwapKeyValueOK().WithPayload(keyValueEntry(entry))
+}
+
+func keyValueEntry(entry *uco.KeyValue) *models.KeyValueEntry {
+	return &models.KeyValueEntry{
+		Key:                entry.Key,
+		Value:              entry.Value,
+		LastUpdateTimeUnix: entry.LastUpdateTimeUnixMilli,
+	}
+}
@@ -218,6 +218,12 @@ func (h *objectHandlers) getObjects(params objects.ObjectsListParams,
 	if params.Class != nil && *params.Class != "" {
 		return h.query(params, principal)
 	}
+	if params.Before != nil || params.Prefix != nil || (params.Reverse != nil && *params.Reverse) || params.Snapshot != nil {
+		err := fmt.Errorf("before, prefix, reverse and snapshot parameters are specific to one class, set class query param")
+		h.metricRequestsTotal.logUserError("")
+		return objects.NewObjectsListBadRequest().
+			WithPayload(errPayloadFromSingleErr(err))
+	}
 	additional, err := parseIncludeParam(params.Include, h.modulesProvider, h.shouldIncludeGetObjectsModuleParams(), nil)
 	if err != nil {
 		h.metricRequestsTotal.logError("", err)
@@ -275,6 +281,10 @@ func (h *objectHandlers) query(params objects.ObjectsListParams,
 		Offset:     params.Offset,
 		Limit:      params.Limit,
 		After:      params.After,
+		Before:     params.Before,
+		Prefix:     params.Prefix,
+		Reverse:    params.Reverse,
+		Snapshot:   params.Snapshot,
 		Sort:       params.Sort,
 		Order:      params.Order,
 		Tenant:     params.Tenant,
@@ -807,7 +817,7 @@ func (e *objectsRequestsTotal) logError(className string, err error) {
 		e.logUserError(className)
 	case autherrs.Forbidden:
 		e.logUserError(className)
-	case uco.ErrInvalidUserInput, uco.ErrNotFound:
+	case uco.ErrInvalidUserInput, uco.ErrNotFound, uco.ErrPreconditionFailed:
 		e.logUserError(className)
 	case *uco.Error:
 		switch err.Code {
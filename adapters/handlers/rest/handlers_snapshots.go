@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package rest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	middleware "github.com/go-openapi/runtime/middleware"
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/snapshots"
+	"github.com/weaviate/weaviate/entities/models"
+	autherrs "github.com/weaviate/weaviate/usecases/auth/authorization/errors"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	uco "github.com/weaviate/weaviate/usecases/objects"
+)
+
+type snapshotHandlers struct {
+	manager             snapshotManager
+	logger              logrus.FieldLogger
+	metricRequestsTotal restApiRequestsTotal
+}
+
+type snapshotManager interface {
+	OpenSnapshot(ctx context.Context, principal *models.Principal,
+		class, tenant string, ttl time.Duration) (string, time.Time, error)
+	ReleaseSnapshot(ctx context.Context, principal *models.Principal,
+		class, tenant, token string) error
+}
+
+func setupSnapshotHandlers(api *operations.WeaviateAPI, manager snapshotManager,
+	metrics *monitoring.PrometheusMetrics, logger logrus.FieldLogger,
+) {
+	h := &snapshotHandlers{manager, logger, newObjectsRequestsTotal(metrics, logger)}
+	api.SnapshotsOpenSnapshotHandler = snapshots.OpenSnapshotHandlerFunc(h.openSnapshot)
+	api.SnapshotsReleaseSnapshotHandler = snapshots.ReleaseSnapshotHandlerFunc(h.releaseSnapshot)
+}
+
+func (h *snapshotHandlers) openSnapshot(params snapshots.OpenSnapshotParams,
+	principal *models.Principal,
+) middleware.Responder {
+	ttl := time.Duration(params.Body.TTLSeconds) * time.Second
+	token, expiresAt, err := h.manager.OpenSnapshot(params.HTTPRequest.Context(), principal,
+		params.ClassName, getTenant(params.Tenant), ttl)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &uco.ErrNotFound{}):
+			return snapshots.NewOpenSnapshotNotFound()
+		case errors.As(err, &autherrs.Forbidden{}):
+			return snapshots.NewOpenSnapshotForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return snapshots.NewOpenSnapshotUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return snapshots.NewOpenSnapshotInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	if ttl == 0 {
+		ttl = uco.DefaultSnapshotTTL
+	}
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return snapshots.NewOpenSnapshotOK().WithPayload(&models.ReadSnapshot{
+		Token:      token,
+		TTLSeconds: int64(ttl / time.Second),
+		ExpiresAt:  expiresAt.UnixMilli(),
+	})
+}
+
+func (h *snapshotHandlers) releaseSnapshot(params snapshots.ReleaseSnapshotParams,
+	principal *models.Principal,
+) middleware.Responder {
+	err := h.manager.ReleaseSnapshot(params.HTTPRequest.Context(), principal,
+		params.ClassName, getTenant(params.Tenant), params.Token)
+	if err != nil {
+		h.metricRequestsTotal.logError(params.ClassName, err)
+		switch {
+		case errors.As(err, &uco.ErrNotFound{}):
+			return snapshots.NewReleaseSnapshotNotFound()
+		case errors.As(err, &autherrs.Forbidden{}):
+			return snapshots.NewReleaseSnapshotForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		case errors.As(err, &uco.ErrInvalidUserInput{}), errors.As(err, &uco.ErrMultiTenancy{}):
+			return snapshots.NewReleaseSnapshotUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return snapshots.NewReleaseSnapshotInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	h.metricRequestsTotal.logOk(params.ClassName)
+	return snapshots.NewReleaseSnapshotNoContent()
+}
@@ -34,10 +34,14 @@ func NewObjectsListParams() ObjectsListParams {
 		// initialize parameters with default values
 
 		offsetDefault = int64(0)
+
+		reverseDefault = bool(false)
 	)
 
 	return ObjectsListParams{
 		Offset: &offsetDefault,
+
+		Reverse: &reverseDefault,
 	}
 }
 
@@ -54,6 +58,10 @@ type ObjectsListParams struct {
 	  In: query
 	*/
 	After *string
+	/*A threshold UUID of the objects to retrieve before, using an UUID-based ordering. This object is not part of the set. <br/><br/>Must be used with `class`. Can be combined with `after` to list a range of UUIDs. <br/><br/>Note `before` cannot be used with `offset` or `sort`.
+	  In: query
+	*/
+	Before *string
 	/*The collection from which to query objects.  <br/><br/>Note that if `class` is not provided, the response will not include any objects.
 	  In: query
 	*/
@@ -75,6 +83,19 @@ type ObjectsListParams struct {
 	  In: query
 	*/
 	Order *string
+	/*Only retrieve objects whose UUID starts with the given hexadecimal prefix, e.g. `0a3f`. Dashes are ignored. <br/><br/>Must be used with `class`. Note `prefix` cannot be used with `offset` or `sort`.
+	  In: query
+	*/
+	Prefix *string
+	/*Retrieve the objects in descending UUID order. To paginate in reverse, pass the UUID of the last object of a page as `before`. <br/><br/>Must be used with `class`. Note `reverse` cannot be used with `offset` or `sort`.
+	  In: query
+	  Default: false
+	*/
+	Reverse *bool
+	/*Read the objects from the point-in-time snapshot with this token, as returned when opening a snapshot of the class. Objects are returned as they were when the snapshot was opened. Can not be combined with sort. <br/><br/>Must be used with `class`.
+	  In: query
+	*/
+	Snapshot *string
 	/*Name(s) of the property to sort by - e.g. `city`, or `country,city`.
 	  In: query
 	*/
@@ -101,6 +122,11 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qBefore, qhkBefore, _ := qs.GetOK("before")
+	if err := o.bindBefore(qBefore, qhkBefore, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qClass, qhkClass, _ := qs.GetOK("class")
 	if err := o.bindClass(qClass, qhkClass, route.Formats); err != nil {
 		res = append(res, err)
@@ -126,6 +152,21 @@ func (o *ObjectsListParams) BindRequest(r *http.Request, route *middleware.Match
 		res = append(res, err)
 	}
 
+	qPrefix, qhkPrefix, _ := qs.GetOK("prefix")
+	if err := o.bindPrefix(qPrefix, qhkPrefix, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qReverse, qhkReverse, _ := qs.GetOK("reverse")
+	if err := o.bindReverse(qReverse, qhkReverse, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSnapshot, qhkSnapshot, _ := qs.GetOK("snapshot")
+	if err := o.bindSnapshot(qSnapshot, qhkSnapshot, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qSort, qhkSort, _ := qs.GetOK("sort")
 	if err := o.bindSort(qSort, qhkSort, route.Formats); err != nil {
 		res = append(res, err)
@@ -159,6 +200,24 @@ func (o *ObjectsListParams) bindAfter(rawData []string, hasKey bool, formats str
 	return nil
 }
 
+// bindBefore binds and validates parameter Before from query.
+func (o *ObjectsListParams) bindBefore(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Before = &raw
+
+	return nil
+}
+
 // bindClass binds and validates parameter Class from query.
 func (o *ObjectsListParams) bindClass(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -260,6 +319,66 @@ func (o *ObjectsListParams) bindOrder(rawData []string, hasKey bool, formats str
 	return nil
 }
 
+// bindPrefix binds and validates parameter Prefix from query.
+func (o *ObjectsListParams) bindPrefix(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Prefix = &raw
+
+	return nil
+}
+
+// bindReverse binds and validates parameter Reverse from query.
+func (o *ObjectsListParams) bindReverse(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewObjectsListParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("reverse", "query", "bool", raw)
+	}
+	o.Reverse = &value
+
+	return nil
+}
+
+// bindSnapshot binds and validates parameter Snapshot from query.
+func (o *ObjectsListParams) bindSnapshot(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Snapshot = &raw
+
+	return nil
+}
+
 // bindSort binds and validates parameter Sort from query.
 func (o *ObjectsListParams) bindSort(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
@@ -26,14 +26,18 @@ import (
 
 // ObjectsListURL generates an URL for the objects list operation
 type ObjectsListURL struct {
-	After   *string
-	Class   *string
-	Include *string
-	Limit   *int64
-	Offset  *int64
-	Order   *string
-	Sort    *string
-	Tenant  *string
+	After    *string
+	Before   *string
+	Class    *string
+	Include  *string
+	Limit    *int64
+	Offset   *int64
+	Order    *string
+	Prefix   *string
+	Reverse  *bool
+	Snapshot *string
+	Sort     *string
+	Tenant   *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -77,6 +81,14 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("after", afterQ)
 	}
 
+	var beforeQ string
+	if o.Before != nil {
+		beforeQ = *o.Before
+	}
+	if beforeQ != "" {
+		qs.Set("before", beforeQ)
+	}
+
 	var classQ string
 	if o.Class != nil {
 		classQ = *o.Class
@@ -117,6 +129,30 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("order", orderQ)
 	}
 
+	var prefixQ string
+	if o.Prefix != nil {
+		prefixQ = *o.Prefix
+	}
+	if prefixQ != "" {
+		qs.Set("prefix", prefixQ)
+	}
+
+	var reverseQ string
+	if o.Reverse != nil {
+		reverseQ = swag.FormatBool(*o.Reverse)
+	}
+	if reverseQ != "" {
+		qs.Set("reverse", reverseQ)
+	}
+
+	var snapshotQ string
+	if o.Snapshot != nil {
+		snapshotQ = *o.Snapshot
+	}
+	if snapshotQ != "" {
+		qs.Set("snapshot", snapshotQ)
+	}
+
 	var sortQ string
 	if o.Sort != nil {
 		sortQ = *o.Sort
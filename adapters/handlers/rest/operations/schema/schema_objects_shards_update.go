@@ -47,7 +47,7 @@ func NewSchemaObjectsShardsUpdate(ctx *middleware.Context, handler SchemaObjects
 
 Update a shard status.
 
-Update a shard status for a collection. For example, a shard may have been marked as `READONLY` because its disk was full. After providing more disk space, use this endpoint to set the shard status to `READY` again. There is also a convenience function in each client to set the status of all shards of a collection.
+Update a shard status for a collection. For example, a shard may have been marked as `READONLY` because its disk was full. After providing more disk space, use this endpoint to set the shard status to `READY` again. There is also a convenience function in each client to set the status of all shards of a collection. Setting a shard to `LOADING` starts a bulk load: objects are written directly to disk segments without a write-ahead log and without being vector indexed, and queries against the shard are rejected. Setting it to `READY` afterwards merges the written segments and builds the vector index, the shard reports `LOADING` until this has finished.
 */
 type SchemaObjectsShardsUpdate struct {
 	Context *middleware.Context
@@ -37,10 +37,12 @@ import (
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/classifications"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/cluster"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/graphql"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/kv"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/meta"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/nodes"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/objects"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/schema"
+	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/snapshots"
 	"github.com/weaviate/weaviate/adapters/handlers/rest/operations/well_known"
 	"github.com/weaviate/weaviate/entities/models"
 )
@@ -68,6 +70,15 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		KvCompareAndSwapKeyValueHandler: kv.CompareAndSwapKeyValueHandlerFunc(func(params kv.CompareAndSwapKeyValueParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation kv.CompareAndSwapKeyValue has not yet been implemented")
+		}),
+		KvDeleteKeyValueHandler: kv.DeleteKeyValueHandlerFunc(func(params kv.DeleteKeyValueParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation kv.DeleteKeyValue has not yet been implemented")
+		}),
+		KvGetKeyValueHandler: kv.GetKeyValueHandlerFunc(func(params kv.GetKeyValueParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation kv.GetKeyValue has not yet been implemented")
+		}),
 		WellKnownGetWellKnownOpenidConfigurationHandler: well_known.GetWellKnownOpenidConfigurationHandlerFunc(func(params well_known.GetWellKnownOpenidConfigurationParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation well_known.GetWellKnownOpenidConfiguration has not yet been implemented")
 		}),
@@ -209,6 +220,9 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsValidateHandler: objects.ObjectsValidateHandlerFunc(func(params objects.ObjectsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsValidate has not yet been implemented")
 		}),
+		KvPutKeyValueHandler: kv.PutKeyValueHandlerFunc(func(params kv.PutKeyValueParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation kv.PutKeyValue has not yet been implemented")
+		}),
 		AuthzRemovePermissionsHandler: authz.RemovePermissionsHandlerFunc(func(params authz.RemovePermissionsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation authz.RemovePermissions has not yet been implemented")
 		}),
@@ -257,6 +271,12 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaTenantsUpdateHandler: schema.TenantsUpdateHandlerFunc(func(params schema.TenantsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.TenantsUpdate has not yet been implemented")
 		}),
+		SnapshotsOpenSnapshotHandler: snapshots.OpenSnapshotHandlerFunc(func(params snapshots.OpenSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation snapshots.OpenSnapshot has not yet been implemented")
+		}),
+		SnapshotsReleaseSnapshotHandler: snapshots.ReleaseSnapshotHandlerFunc(func(params snapshots.ReleaseSnapshotParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation snapshots.ReleaseSnapshot has not yet been implemented")
+		}),
 		WeaviateRootHandler: WeaviateRootHandlerFunc(func(params WeaviateRootParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation WeaviateRoot has not yet been implemented")
 		}),
@@ -333,6 +353,12 @@ type WeaviateAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// KvCompareAndSwapKeyValueHandler sets the operation handler for the compare and swap key value operation
+	KvCompareAndSwapKeyValueHandler kv.CompareAndSwapKeyValueHandler
+	// KvDeleteKeyValueHandler sets the operation handler for the delete key value operation
+	KvDeleteKeyValueHandler kv.DeleteKeyValueHandler
+	// KvGetKeyValueHandler sets the operation handler for the get key value operation
+	KvGetKeyValueHandler kv.GetKeyValueHandler
 	// WellKnownGetWellKnownOpenidConfigurationHandler sets the operation handler for the get well known openid configuration operation
 	WellKnownGetWellKnownOpenidConfigurationHandler well_known.GetWellKnownOpenidConfigurationHandler
 	// AuthzAddPermissionsHandler sets the operation handler for the add permissions operation
@@ -427,6 +453,8 @@ type WeaviateAPI struct {
 	ObjectsObjectsUpdateHandler objects.ObjectsUpdateHandler
 	// ObjectsObjectsValidateHandler sets the operation handler for the objects validate operation
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
+	// KvPutKeyValueHandler sets the operation handler for the put key value operation
+	KvPutKeyValueHandler kv.PutKeyValueHandler
 	// AuthzRemovePermissionsHandler sets the operation handler for the remove permissions operation
 	AuthzRemovePermissionsHandler authz.RemovePermissionsHandler
 	// AuthzRevokeRoleHandler sets the operation handler for the revoke role operation
@@ -459,6 +487,10 @@ type WeaviateAPI struct {
 	SchemaTenantsGetOneHandler schema.TenantsGetOneHandler
 	// SchemaTenantsUpdateHandler sets the operation handler for the tenants update operation
 	SchemaTenantsUpdateHandler schema.TenantsUpdateHandler
+	// SnapshotsOpenSnapshotHandler sets the operation handler for the open snapshot operation
+	SnapshotsOpenSnapshotHandler snapshots.OpenSnapshotHandler
+	// SnapshotsReleaseSnapshotHandler sets the operation handler for the release snapshot operation
+	SnapshotsReleaseSnapshotHandler snapshots.ReleaseSnapshotHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
 	WeaviateRootHandler WeaviateRootHandler
 	// WeaviateWellknownLivenessHandler sets the operation handler for the weaviate wellknown liveness operation
@@ -549,6 +581,15 @@ func (o *WeaviateAPI) Validate() error {
 		unregistered = append(unregistered, "OidcAuth")
 	}
 
+	if o.KvCompareAndSwapKeyValueHandler == nil {
+		unregistered = append(unregistered, "kv.CompareAndSwapKeyValueHandler")
+	}
+	if o.KvDeleteKeyValueHandler == nil {
+		unregistered = append(unregistered, "kv.DeleteKeyValueHandler")
+	}
+	if o.KvGetKeyValueHandler == nil {
+		unregistered = append(unregistered, "kv.GetKeyValueHandler")
+	}
 	if o.WellKnownGetWellKnownOpenidConfigurationHandler == nil {
 		unregistered = append(unregistered, "well_known.GetWellKnownOpenidConfigurationHandler")
 	}
@@ -690,6 +731,9 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsValidateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsValidateHandler")
 	}
+	if o.KvPutKeyValueHandler == nil {
+		unregistered = append(unregistered, "kv.PutKeyValueHandler")
+	}
 	if o.AuthzRemovePermissionsHandler == nil {
 		unregistered = append(unregistered, "authz.RemovePermissionsHandler")
 	}
@@ -738,6 +782,12 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaTenantsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.TenantsUpdateHandler")
 	}
+	if o.SnapshotsOpenSnapshotHandler == nil {
+		unregistered = append(unregistered, "snapshots.OpenSnapshotHandler")
+	}
+	if o.SnapshotsReleaseSnapshotHandler == nil {
+		unregistered = append(unregistered, "snapshots.ReleaseSnapshotHandler")
+	}
 	if o.WeaviateRootHandler == nil {
 		unregistered = append(unregistered, "WeaviateRootHandler")
 	}
@@ -847,6 +897,18 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/kv/{className}/{key}/cas"] = kv.NewCompareAndSwapKeyValue(o.context, o.KvCompareAndSwapKeyValueHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/kv/{className}/{key}"] = kv.NewDeleteKeyValue(o.context, o.KvDeleteKeyValueHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/kv/{className}/{key}"] = kv.NewGetKeyValue(o.context, o.KvGetKeyValueHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -1035,6 +1097,10 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/objects/validate"] = objects.NewObjectsValidate(o.context, o.ObjectsObjectsValidateHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/kv/{className}/{key}"] = kv.NewPutKeyValue(o.context, o.KvPutKeyValueHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -1099,6 +1165,14 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}/tenants"] = schema.NewTenantsUpdate(o.context, o.SchemaTenantsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/snapshots/{className}"] = snapshots.NewOpenSnapshot(o.context, o.SnapshotsOpenSnapshotHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/snapshots/{className}/{token}"] = snapshots.NewReleaseSnapshot(o.context, o.SnapshotsReleaseSnapshotHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
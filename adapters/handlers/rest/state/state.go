@@ -71,6 +71,7 @@ type State struct {
 	BackupManager      *backup.Handler
 	DB                 *db.DB
 	BatchManager       *objects.BatchManager
+	ObjectsManager     *objects.Manager
 	ClusterHttpClient  *http.Client
 	ReindexCtxCancel   context.CancelFunc
 	MemWatch           *memwatch.Monitor
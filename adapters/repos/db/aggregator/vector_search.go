@@ -17,12 +17,19 @@ import (
 
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 )
 
 func (a *Aggregator) vectorSearch(ctx context.Context, allow helpers.AllowList, vec models.Vector) ([]uint64, []float32, error) {
+	if a.params.NearVector != nil {
+		ctx = common.ContextWithRescoreMultiplier(ctx, a.params.NearVector.RescoreMultiplier)
+	} else if a.params.NearObject != nil {
+		ctx = common.ContextWithRescoreMultiplier(ctx, a.params.NearObject.RescoreMultiplier)
+	}
+
 	if a.params.ObjectLimit != nil {
 		return a.searchByVector(ctx, vec, a.params.ObjectLimit, allow)
 	}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25FTextAnalyzers(t *testing.T) {
+	vTrue := true
+	className := "AnalyzedClass"
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "en")
+	invertedConfig.Analyzers = []*models.TextAnalyzerConfig{
+		{
+			Name: "german",
+			Filters: []*models.TextAnalyzerFilter{
+				{Type: "asciiFolding"},
+				{Type: "stopwords", Preset: "de"},
+				{Type: "stemmer", Language: "german"},
+			},
+		},
+		{
+			Name: "products",
+			Filters: []*models.TextAnalyzerFilter{
+				{Type: "synonyms", Synonyms: []string{"tv, television"}},
+			},
+		},
+	}
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:           "body",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				Analyzer:       "german",
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "product",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+				Analyzer:     "products",
+			},
+		},
+	}
+	repo, _ := SetupBM25FRepo(t, class)
+
+	docs := []map[string]interface{}{
+		{"body": "Die Katzen spielen im Garten", "product": "television"},
+		{"body": "Der Hund schläft", "product": "radio"},
+		{"body": "Eine Katze und ein Hund", "product": "tv stand"},
+	}
+	for i, doc := range docs {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: className, ID: id, Properties: doc}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, nil, 0))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, filter *filters.LocalFilter, kwr *searchparams.KeywordRanking) []uint64 {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, filter, kwr, nil, nil, additional.Properties{}, nil, "", 0, nil)
+		require.Nil(t, err)
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID
+		}
+		return ids
+	}
+	textFilter := func(operator filters.Operator, prop, value string) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: operator,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}}
+	}
+
+	t.Run("stemmed query", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "Katze"})
+		assert.ElementsMatch(t, []uint64{0, 2}, ids)
+
+		ids = search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "Hunde"})
+		assert.ElementsMatch(t, []uint64{1, 2}, ids)
+	})
+
+	t.Run("folded query", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "schlaft"})
+		assert.ElementsMatch(t, []uint64{1}, ids)
+	})
+
+	t.Run("stopwords of the analyzer", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "die und der"})
+		assert.Empty(t, ids)
+	})
+
+	t.Run("synonym query", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"product"}, Query: "TV"})
+		assert.ElementsMatch(t, []uint64{0, 2}, ids)
+	})
+
+	t.Run("properties with different analyzers", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body", "product"}, Query: "Hunden radio"})
+		assert.ElementsMatch(t, []uint64{1, 2}, ids)
+	})
+
+	t.Run("phrase query", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "katzen spielen", Phrase: true})
+		assert.ElementsMatch(t, []uint64{0}, ids)
+	})
+
+	t.Run("equal filter", func(t *testing.T) {
+		ids := search(t, textFilter(filters.OperatorEqual, "product", "tv"), nil)
+		assert.ElementsMatch(t, []uint64{0, 2}, ids)
+	})
+
+	t.Run("like filter", func(t *testing.T) {
+		ids := search(t, textFilter(filters.OperatorLike, "body", "Kat*"), nil)
+		assert.ElementsMatch(t, []uint64{0, 2}, ids)
+	})
+}
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/fuzzy"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25FFuzzy(t *testing.T) {
+	vFalse := false
+	className := "FuzzyClass"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "en"),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:            "code",
+				DataType:        schema.DataTypeText.PropString(),
+				Tokenization:    models.PropertyTokenizationField,
+				IndexSearchable: &vFalse,
+			},
+		},
+	}
+	repo, migrator := SetupBM25FRepo(t, class)
+
+	putObject := func(t *testing.T, i int, doc map[string]interface{}) {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: className, ID: id, Properties: doc}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, nil, 0))
+	}
+
+	docs := []map[string]interface{}{
+		{"name": "Sunny weather", "code": "abc-123"},
+		{"name": "Whether or not", "code": "abd-123"},
+		{"name": "Leather jacket", "code": "xyz-999"},
+		{"name": "Water bottle", "code": "xyz-998"},
+		{"name": "Rainy day", "code": "rain"},
+	}
+	for i, doc := range docs {
+		putObject(t, i, doc)
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, filter *filters.LocalFilter, kwr *searchparams.KeywordRanking) ([]uint64, error) {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, filter, kwr, nil, nil, additional.Properties{}, nil, "", 0, nil)
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID
+		}
+		return ids, err
+	}
+	fuzzyFilter := func(prop string, value string) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorFuzzy,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}}
+	}
+
+	t.Run("bm25 without fuzziness", func(t *testing.T) {
+		ids, err := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "wether"})
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+	})
+
+	t.Run("bm25 with fuzziness", func(t *testing.T) {
+		ids, err := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "wether", Fuzziness: 1})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1}, ids)
+
+		ids, err = search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "wether", Fuzziness: 2})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1, 2, 3}, ids)
+	})
+
+	t.Run("bm25 invalid fuzziness", func(t *testing.T) {
+		_, err := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "wether", Fuzziness: 3})
+		require.ErrorContains(t, err, "fuzziness must be between 0 and 2")
+
+		_, err = search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "wether", Fuzziness: 1, Phrase: true})
+		require.ErrorContains(t, err, "fuzziness cannot be combined with phrase")
+	})
+
+	t.Run("fuzzy filter", func(t *testing.T) {
+		ids, err := search(t, fuzzyFilter("name", "wether"), nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1, 2, 3}, ids)
+
+		// all terms have to match
+		ids, err = search(t, fuzzyFilter("name", "rainy dey"), nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{4}, ids)
+	})
+
+	t.Run("fuzzy filter on filterable index", func(t *testing.T) {
+		ids, err := search(t, fuzzyFilter("code", "abc-124"), nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{0, 1}, ids)
+	})
+
+	t.Run("terms added later are found", func(t *testing.T) {
+		putObject(t, 5, map[string]interface{}{"name": "Foggy morning", "code": "fog"})
+
+		ids, err := search(t, fuzzyFilter("name", "mornin"), nil)
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{5}, ids)
+
+		ids, err = search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "fogy", Fuzziness: 1})
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []uint64{5}, ids)
+	})
+
+	t.Run("dropped dictionaries are rebuilt without deleted terms", func(t *testing.T) {
+		var shard *Shard
+		idx.ForEachLoadedShard(func(name string, shd ShardLike) error {
+			shard = shd.(*LazyLoadShard).shard
+			return nil
+		})
+		require.NotNil(t, shard)
+		dictionary := func() *fuzzy.Dictionary {
+			dict, err := shard.termDictionaries.Get("name", nil)
+			require.Nil(t, err)
+			return dict
+		}
+
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", 5)).String())
+		require.Nil(t, repo.DeleteObject(context.Background(), className, id, time.Now(), nil, "", 0))
+		// deletes don't remove terms from the dictionary
+		assert.NotEmpty(t, dictionary().Search("foggy", 0))
+
+		require.Nil(t, migrator.DropProperty(context.Background(), className, "name"))
+		ids, err := search(t, fuzzyFilter("name", "fogy"), nil)
+		require.Nil(t, err)
+		assert.Empty(t, ids)
+		assert.Empty(t, dictionary().Search("foggy", 0))
+		assert.NotEmpty(t, dictionary().Search("weather", 0))
+
+		require.Nil(t, shard.Shutdown(context.Background()))
+		_, err = shard.termDictionaries.Get("name", func(func([]byte) error) error {
+			return errors.New("not loaded again")
+		})
+		require.EqualError(t, err, "not loaded again")
+	})
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25FHighlights(t *testing.T) {
+	className := "HighlightClass"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "en"),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "description",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "tags",
+				DataType:     schema.DataTypeTextArray.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	repo, _ := SetupBM25FRepo(t, class)
+
+	docs := []map[string]interface{}{
+		{
+			"title":       "The quick brown fox",
+			"description": "A fox jumps over the lazy dog and runs into the forest.",
+			"tags":        []string{"animals", "fox"},
+		},
+		{
+			"title":       "Slow turtles",
+			"description": "Turtles are slow, but they live for a very long time.",
+			"tags":        []string{"animals", "reptiles"},
+		},
+	}
+	for i, doc := range docs {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: className, ID: id, Properties: doc}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, nil, 0))
+	}
+
+	searchHighlights := func(t *testing.T, kwr *searchparams.KeywordRanking, props search.SelectProperties,
+		highlights *additional.Highlights, filter *filters.LocalFilter,
+	) []search.Result {
+		res, err := repo.Search(context.Background(), dto.GetParams{
+			ClassName:            className,
+			Pagination:           &filters.Pagination{Limit: 10},
+			KeywordRanking:       kwr,
+			Filters:              filter,
+			Properties:           props,
+			AdditionalProperties: additional.Properties{Highlights: highlights},
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	t.Run("highlights of matching properties", func(t *testing.T) {
+		res := searchHighlights(t, &searchparams.KeywordRanking{Type: "bm25", Query: "fox"},
+			search.SelectProperties{{Name: "title", IsPrimitive: true}}, &additional.Highlights{}, nil)
+		require.Len(t, res, 1)
+
+		assert.Equal(t, []additional.Highlight{
+			{Property: "title", Fragments: []string{"The quick brown <em>fox</em>"}},
+			{Property: "description", Fragments: []string{"A <em>fox</em> jumps over the lazy dog and runs into the forest."}},
+			{Property: "tags", Fragments: []string{"<em>fox</em>"}},
+		}, res[0].AdditionalProperties["highlights"])
+	})
+
+	t.Run("highlights without selected properties", func(t *testing.T) {
+		res := searchHighlights(t, &searchparams.KeywordRanking{Type: "bm25", Query: "turtles", Properties: []string{"description"}},
+			nil, &additional.Highlights{FragmentSize: 20}, nil)
+		require.Len(t, res, 1)
+
+		assert.Equal(t, []additional.Highlight{
+			{Property: "description", Fragments: []string{"<em>Turtles</em> are slow,"}},
+		}, res[0].AdditionalProperties["highlights"])
+	})
+
+	t.Run("highlights not requested", func(t *testing.T) {
+		res := searchHighlights(t, &searchparams.KeywordRanking{Type: "bm25", Query: "fox"}, nil, nil, nil)
+		require.Len(t, res, 1)
+		assert.NotContains(t, res[0].AdditionalProperties, "highlights")
+	})
+
+	t.Run("highlights without keyword ranking", func(t *testing.T) {
+		res := searchHighlights(t, nil, nil, &additional.Highlights{}, nil)
+		require.Len(t, res, 2)
+		for _, r := range res {
+			assert.NotContains(t, r.AdditionalProperties, "highlights")
+		}
+	})
+}
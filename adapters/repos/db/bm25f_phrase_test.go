@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25FPhrase(t *testing.T) {
+	vTrue := true
+	className := "PhraseClass"
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: BM25FinvertedConfig(1.2, 0.75, "en"),
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:           "body",
+				DataType:       schema.DataTypeText.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:           "headlines",
+				DataType:       schema.DataTypeTextArray.PropString(),
+				Tokenization:   models.PropertyTokenizationWord,
+				IndexPositions: &vTrue,
+			},
+			{
+				Name:         "title",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+		},
+	}
+	repo, _ := SetupBM25FRepo(t, class)
+
+	docs := []map[string]interface{}{
+		{"body": "The New York Times reported on the case", "headlines": []interface{}{"court rules", "appeal filed"}},
+		{"body": "In New York the times are changing", "headlines": []interface{}{"court", "rules of the appeal"}},
+		{"body": "Times of New York, the city", "headlines": []interface{}{"rules court", "appeal"}},
+		{"body": "york new", "headlines": []interface{}{"nothing here"}},
+	}
+	putDoc := func(i int, props map[string]interface{}) {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: className, ID: id, Properties: props}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, nil, 0))
+	}
+	for i, doc := range docs {
+		putDoc(i, doc)
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, kwr *searchparams.KeywordRanking) []uint64 {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, nil, kwr, nil, nil, additional.Properties{}, nil, "", 0, nil)
+		require.Nil(t, err)
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID
+		}
+		return ids
+	}
+
+	t.Run("bag of words", func(t *testing.T) {
+		ids := search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "new york times"})
+		assert.ElementsMatch(t, []uint64{0, 1, 2, 3}, ids)
+	})
+
+	t.Run("exact phrase", func(t *testing.T) {
+		ids := search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "new york times", Phrase: true})
+		assert.ElementsMatch(t, []uint64{0}, ids)
+	})
+
+	t.Run("phrase with stopwords", func(t *testing.T) {
+		ids := search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "york, the", Phrase: true})
+		assert.ElementsMatch(t, []uint64{1, 2}, ids)
+	})
+
+	t.Run("proximity", func(t *testing.T) {
+		ids := search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "new times", Phrase: true, Slop: 1})
+		assert.ElementsMatch(t, []uint64{0, 2}, ids)
+
+		ids = search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "new times", Phrase: true, Slop: 2})
+		assert.ElementsMatch(t, []uint64{0, 1, 2}, ids)
+	})
+
+	t.Run("phrase does not cross array elements", func(t *testing.T) {
+		ids := search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"headlines"}, Query: "court rules", Phrase: true})
+		assert.ElementsMatch(t, []uint64{0}, ids)
+
+		ids = search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"headlines"}, Query: "court rules", Phrase: true, Slop: 1})
+		assert.ElementsMatch(t, []uint64{0, 2}, ids)
+	})
+
+	t.Run("multiple properties", func(t *testing.T) {
+		ids := search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body", "headlines^2"}, Query: "the appeal", Phrase: true})
+		assert.ElementsMatch(t, []uint64{1}, ids)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		ids := search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "times new york", Phrase: true})
+		assert.Empty(t, ids)
+	})
+
+	t.Run("updated object", func(t *testing.T) {
+		putDoc(3, map[string]interface{}{"body": "new york times", "headlines": []interface{}{"nothing here"}})
+		ids := search(t, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"body"}, Query: "new york times", Phrase: true})
+		assert.ElementsMatch(t, []uint64{0, 3}, ids)
+	})
+
+	t.Run("property without position index", func(t *testing.T) {
+		_, _, err := idx.objectSearch(context.TODO(), 1000, nil, &searchparams.KeywordRanking{
+			Type: "bm25", Properties: []string{"title"}, Query: "new york", Phrase: true,
+		}, nil, nil, additional.Properties{}, nil, "", 0, nil)
+		require.ErrorContains(t, err, "does not have indexPositions enabled")
+	})
+
+	t.Run("slop without phrase", func(t *testing.T) {
+		_, _, err := idx.objectSearch(context.TODO(), 1000, nil, &searchparams.KeywordRanking{
+			Type: "bm25", Properties: []string{"body"}, Query: "new york", Slop: 2,
+		}, nil, nil, additional.Properties{}, nil, "", 0, nil)
+		require.ErrorContains(t, err, "slop can only be set together with phrase")
+	})
+}
@@ -0,0 +1,144 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestBM25FSynonyms(t *testing.T) {
+	className := "SynonymClass"
+	invertedConfig := BM25FinvertedConfig(1.2, 0.75, "en")
+	invertedConfig.Synonyms = []*models.SynonymSet{
+		{Synonyms: []string{"sofa", "couch"}},
+		{Type: "oneWay", Input: "notebook", Synonyms: []string{"laptop"}},
+	}
+	class := &models.Class{
+		VectorIndexConfig:   enthnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig,
+		Class:               className,
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationWord,
+			},
+			{
+				Name:         "category",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	repo, migrator := SetupBM25FRepo(t, class)
+
+	docs := []map[string]interface{}{
+		{"name": "Blue sofa", "category": "couch"},
+		{"name": "Red couch", "category": "sofa"},
+		{"name": "Green settee", "category": "settee"},
+		{"name": "Laptop bag", "category": "laptop"},
+		{"name": "Notebook cover", "category": "notebook"},
+	}
+	for i, doc := range docs {
+		id := strfmt.UUID(uuid.MustParse(fmt.Sprintf("%032d", i)).String())
+		obj := &models.Object{Class: className, ID: id, Properties: doc}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 2, 3}, nil, nil, nil, 0))
+	}
+
+	idx := repo.GetIndex(schema.ClassName(className))
+	require.NotNil(t, idx)
+
+	search := func(t *testing.T, filter *filters.LocalFilter, kwr *searchparams.KeywordRanking) []uint64 {
+		res, _, err := idx.objectSearch(context.TODO(), 1000, filter, kwr, nil, nil, additional.Properties{}, nil, "", 0, nil)
+		require.Nil(t, err)
+		ids := make([]uint64, len(res))
+		for i := range res {
+			ids[i] = res[i].DocID
+		}
+		return ids
+	}
+	textFilter := func(operator filters.Operator, prop string, value interface{}) *filters.LocalFilter {
+		return &filters.LocalFilter{Root: &filters.Clause{
+			Operator: operator,
+			On:       &filters.Path{Class: schema.ClassName(className), Property: schema.PropertyName(prop)},
+			Value:    &filters.Value{Value: value, Type: schema.DataTypeText},
+		}}
+	}
+
+	t.Run("equivalent synonyms in bm25", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "sofa"})
+		assert.ElementsMatch(t, []uint64{0, 1}, ids)
+
+		ids = search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "couch"})
+		assert.ElementsMatch(t, []uint64{0, 1}, ids)
+	})
+
+	t.Run("one way synonyms in bm25", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "notebook"})
+		assert.ElementsMatch(t, []uint64{3, 4}, ids)
+
+		ids = search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "laptop"})
+		assert.ElementsMatch(t, []uint64{3}, ids)
+	})
+
+	t.Run("equal filter", func(t *testing.T) {
+		ids := search(t, textFilter(filters.OperatorEqual, "name", "couch"), nil)
+		assert.ElementsMatch(t, []uint64{0, 1}, ids)
+
+		ids = search(t, textFilter(filters.OperatorEqual, "category", "notebook"), nil)
+		assert.ElementsMatch(t, []uint64{3, 4}, ids)
+	})
+
+	t.Run("contains any filter", func(t *testing.T) {
+		ids := search(t, textFilter(filters.ContainsAny, "category", []string{"sofa", "notebook"}), nil)
+		assert.ElementsMatch(t, []uint64{0, 1, 3, 4}, ids)
+	})
+
+	t.Run("like filter is not expanded", func(t *testing.T) {
+		ids := search(t, textFilter(filters.OperatorLike, "name", "sofa"), nil)
+		assert.ElementsMatch(t, []uint64{0}, ids)
+	})
+
+	t.Run("updated synonyms apply without reindexing", func(t *testing.T) {
+		ids := search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "settee"})
+		assert.ElementsMatch(t, []uint64{2}, ids)
+
+		class.InvertedIndexConfig.Synonyms = []*models.SynonymSet{
+			{Synonyms: []string{"sofa", "couch", "settee"}},
+		}
+		require.Nil(t, migrator.UpdateInvertedIndexConfig(context.Background(), className, class.InvertedIndexConfig))
+
+		ids = search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "settee"})
+		assert.ElementsMatch(t, []uint64{0, 1, 2}, ids)
+
+		ids = search(t, textFilter(filters.OperatorEqual, "category", "sofa"), nil)
+		assert.ElementsMatch(t, []uint64{0, 1, 2}, ids)
+
+		ids = search(t, nil, &searchparams.KeywordRanking{Type: "bm25", Properties: []string{"name"}, Query: "notebook"})
+		assert.ElementsMatch(t, []uint64{4}, ids)
+	})
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+// +build integrationTest
+
+package clusterintegrationtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/search"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// TestDistributedSnapshot opens a snapshot on one node and reads it from
+// another one, so that it covers the shards of all nodes.
+func TestDistributedSnapshot(t *testing.T) {
+	ctx := context.Background()
+	dirName := setupDirectory(t)
+	nodeCount := 3
+	var nodes []*node
+
+	t.Run("setup", func(t *testing.T) {
+		shardStateSerialized, err := json.Marshal(multiShardState(nodeCount))
+		require.Nil(t, err)
+
+		for i := 0; i < nodeCount; i++ {
+			node := &node{
+				name: fmt.Sprintf("node-%d", i),
+			}
+			node.init(dirName, shardStateSerialized, &nodes)
+			nodes = append(nodes, node)
+		}
+
+		for i := range nodes {
+			err := nodes[i].migrator.AddClass(ctx, class(), nodes[i].schemaManager.shardState)
+			require.Nil(t, err)
+			nodes[i].schemaManager.schema.Objects.Classes = append(nodes[i].schemaManager.schema.Objects.Classes,
+				class())
+		}
+	})
+
+	data := exampleData(60)
+	t.Run("import", func(t *testing.T) {
+		res, err := nodes[0].repo.BatchPutObjects(ctx, dataAsBatch(data), nil, 0)
+		require.Nil(t, err)
+		for _, ind := range res {
+			require.Nil(t, ind.Err)
+		}
+	})
+
+	list := func(t *testing.T, n *node, token string) ([]search.Result, error) {
+		return n.repo.Search(ctx, dto.GetParams{
+			ClassName:            distributedClass,
+			Pagination:           &filters.Pagination{Limit: 1000},
+			AdditionalProperties: additional.Properties{Snapshot: token},
+		})
+	}
+
+	var token string
+	t.Run("open snapshot", func(t *testing.T) {
+		var err error
+		token, err = nodes[0].repo.OpenSnapshot(ctx, distributedClass, "", time.Minute)
+		require.Nil(t, err)
+	})
+
+	t.Run("change data after opening the snapshot", func(t *testing.T) {
+		for i, obj := range data {
+			switch i % 3 {
+			case 0:
+				err := nodes[1].repo.DeleteObject(ctx, distributedClass, obj.ID, time.Now(), nil, "", 0)
+				require.Nil(t, err)
+			case 1:
+				changed := *obj
+				changed.Properties = map[string]interface{}{"description": "changed"}
+				err := nodes[2].repo.PutObject(ctx, &changed, changed.Vector, nil, nil, nil, 0)
+				require.Nil(t, err)
+			}
+		}
+		res, err := nodes[1].repo.BatchPutObjects(ctx, dataAsBatch(exampleData(10)), nil, 0)
+		require.Nil(t, err)
+		for _, ind := range res {
+			require.Nil(t, ind.Err)
+		}
+	})
+
+	t.Run("read snapshot from every node", func(t *testing.T) {
+		expected := map[string]string{}
+		for _, obj := range data {
+			expected[obj.ID.String()] = obj.Properties.(map[string]interface{})["description"].(string)
+		}
+
+		for _, n := range nodes {
+			res, err := list(t, n, token)
+			require.Nil(t, err)
+
+			actual := map[string]string{}
+			for _, r := range res {
+				actual[r.ID.String()] = r.Schema.(map[string]interface{})["description"].(string)
+			}
+			assert.Equal(t, expected, actual, "node %s", n.name)
+		}
+	})
+
+	t.Run("release snapshot from another node", func(t *testing.T) {
+		require.Nil(t, nodes[2].repo.ReleaseSnapshot(ctx, distributedClass, "", token))
+
+		for _, n := range nodes {
+			_, err := list(t, n, token)
+			require.NotNil(t, err, "node %s", n.name)
+		}
+
+		err := nodes[0].repo.ReleaseSnapshot(ctx, distributedClass, "", token)
+		var notFound objects.ErrNotFound
+		assert.ErrorAs(t, err, &notFound)
+	})
+
+	t.Run("shutdown", func(t *testing.T) {
+		for _, node := range nodes {
+			node.repo.Shutdown(ctx)
+		}
+	})
+}
@@ -0,0 +1,161 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"sort"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// radiusPageSize is the maximum number of results of a search by distance:
+// the limit if one was set and the server-side maximum otherwise
+func radiusPageSize(limit int, maximum int64) int {
+	if limit > 0 && (maximum <= 0 || int64(limit) < maximum) {
+		return limit
+	}
+	return int(maximum)
+}
+
+// searchAfterDistanceCursor bounds a search by distance to the results that
+// can be on the page after the cursor, or on the first page if there is no
+// cursor. The search has to return the results within the distance it is
+// given, ordered by distance and cut after maxLimit of them unless that is
+// negative. The results up to the cursor are counted first, so the search up
+// to the target distance only needs to return those and the page after them.
+// A limited search might cut through results with equal distances, so the
+// results are completed by searching up to the distance of the last one they
+// contain. pageSize caps a single page, not the results a cursor can page
+// through.
+func searchAfterDistanceCursor(cursor *additional.DistanceCursor, targetDist float32, pageSize int,
+	search func(dist float32, maxLimit int64) ([]uint64, []float32, error),
+) ([]uint64, []float32, error) {
+	if pageSize <= 0 {
+		return search(targetDist, -1)
+	}
+
+	before := 0
+	if cursor != nil {
+		ids, _, err := search(min(cursor.Distance, targetDist), -1)
+		if err != nil {
+			return nil, nil, err
+		}
+		before = len(ids)
+	}
+
+	limit := int64(before + pageSize)
+	ids, dists, err := search(targetDist, limit)
+	if err != nil || int64(len(ids)) < limit {
+		return ids, dists, err
+	}
+	return search(dists[len(dists)-1], -1)
+}
+
+// distanceCursorCandidates narrows the doc ids found by a search by distance
+// down to those that can be on the page after the cursor. Ties between equal
+// distances are broken by object id, which is only known once the objects are
+// loaded, so all candidates sharing a distance with the page boundaries are
+// kept. The candidates are returned ordered by distance.
+func distanceCursorCandidates(ids []uint64, dists []float32,
+	cursor *additional.DistanceCursor, pageSize int,
+) ([]uint64, []float32) {
+	sort.Stable(&docIDsByDistance{ids: ids, dists: dists})
+
+	if cursor != nil {
+		start := sort.Search(len(dists), func(i int) bool {
+			return dists[i] >= cursor.Distance
+		})
+		ids, dists = ids[start:], dists[start:]
+	}
+
+	if pageSize <= 0 || len(ids) <= pageSize {
+		return ids, dists
+	}
+
+	end := pageSize
+	if cursor != nil {
+		// results at the distance of the cursor might come before it
+		for i := 0; i < len(dists) && dists[i] == cursor.Distance; i++ {
+			end++
+		}
+	}
+	for end < len(dists) && end > 0 && dists[end] == dists[end-1] {
+		end++
+	}
+	if end > len(ids) {
+		end = len(ids)
+	}
+	return ids[:end], dists[:end]
+}
+
+// distanceCursorPage orders the results of a search by distance by distance
+// and id and returns at most pageSize of them that come after the cursor
+func distanceCursorPage(objs []*storobj.Object, dists []float32,
+	cursor *additional.DistanceCursor, pageSize int,
+) ([]*storobj.Object, []float32) {
+	if len(dists) > len(objs) {
+		dists = dists[:len(objs)]
+	}
+	sort.Sort(&objectsByDistanceAndID{objects: objs, dists: dists})
+
+	if cursor != nil {
+		start := sort.Search(len(objs), func(i int) bool {
+			return cursor.Precedes(dists[i], objs[i].ID())
+		})
+		objs, dists = objs[start:], dists[start:]
+	}
+
+	if pageSize > 0 && len(objs) > pageSize {
+		objs, dists = objs[:pageSize], dists[:pageSize]
+	}
+	return objs, dists
+}
+
+type docIDsByDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (s *docIDsByDistance) Len() int {
+	return len(s.ids)
+}
+
+func (s *docIDsByDistance) Less(i, j int) bool {
+	return s.dists[i] < s.dists[j]
+}
+
+func (s *docIDsByDistance) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
+
+type objectsByDistanceAndID struct {
+	objects []*storobj.Object
+	dists   []float32
+}
+
+func (s *objectsByDistanceAndID) Len() int {
+	return len(s.objects)
+}
+
+func (s *objectsByDistanceAndID) Less(i, j int) bool {
+	if s.dists[i] != s.dists[j] {
+		return s.dists[i] < s.dists[j]
+	}
+	return additional.CompareIDs(s.objects[i].ID(), s.objects[j].ID()) < 0
+}
+
+func (s *objectsByDistanceAndID) Swap(i, j int) {
+	s.objects[i], s.objects[j] = s.objects[j], s.objects[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"fmt"
+	"sort"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+func TestRadiusPageSize(t *testing.T) {
+	assert.Equal(t, 10, radiusPageSize(10, 100))
+	assert.Equal(t, 100, radiusPageSize(1000, 100))
+	assert.Equal(t, 100, radiusPageSize(-1, 100))
+	assert.Equal(t, 10, radiusPageSize(10, 0))
+}
+
+func TestDistanceCursorPaging(t *testing.T) {
+	// distances with plenty of ties to make sure pages are split by id
+	const count = 25
+	objects := make(map[uint64]*storobj.Object, count)
+	ids := make([]uint64, count)
+	dists := make([]float32, count)
+	for i := 0; i < count; i++ {
+		docID := uint64(count - i)
+		obj := storobj.FromObject(&models.Object{
+			Class: "Test",
+			ID:    strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", (i*7)%count)),
+		}, nil, nil, nil)
+		obj.DocID = docID
+		objects[docID] = obj
+		ids[i] = docID
+		dists[i] = float32(i%5) * 0.1
+	}
+
+	var all []strfmt.UUID
+	allObjs, _ := distanceCursorPage(loadObjects(objects, ids), append([]float32{}, dists...), nil, -1)
+	for _, obj := range allObjs {
+		all = append(all, obj.ID())
+	}
+	require.Len(t, all, count)
+
+	for _, pageSize := range []int{1, 3, 5, 7, count} {
+		t.Run(fmt.Sprintf("page size %d", pageSize), func(t *testing.T) {
+			var (
+				cursor *additional.DistanceCursor
+				paged  []strfmt.UUID
+			)
+			for {
+				foundIDs, foundDists := append([]uint64{}, ids...), append([]float32{}, dists...)
+				if cursor != nil {
+					var err error
+					foundIDs, foundDists, err = searchAfterDistanceCursor(cursor, 1, pageSize,
+						searchSorted(ids, dists))
+					require.Nil(t, err)
+					// everything up to the cursor, the page and the ties of its last result
+					before := 0
+					for _, dist := range dists {
+						if dist <= cursor.Distance {
+							before++
+						}
+					}
+					assert.LessOrEqual(t, len(foundIDs), before+pageSize+count/5)
+				}
+				candIDs, candDists := distanceCursorCandidates(foundIDs, foundDists, cursor, pageSize)
+				page, pageDists := distanceCursorPage(loadObjects(objects, candIDs), candDists, cursor, pageSize)
+				if len(page) == 0 {
+					break
+				}
+				require.LessOrEqual(t, len(page), pageSize)
+				for _, obj := range page {
+					paged = append(paged, obj.ID())
+				}
+				last := len(page) - 1
+				cursor = &additional.DistanceCursor{Distance: pageDists[last], ID: page[last].ID()}
+			}
+			assert.Equal(t, all, paged)
+		})
+	}
+}
+
+func TestDistanceCursorPagingMaxLimit(t *testing.T) {
+	const (
+		count    = 40
+		maxLimit = 12
+	)
+	objects := make(map[uint64]*storobj.Object, count)
+	ids := make([]uint64, count)
+	dists := make([]float32, count)
+	for i := 0; i < count; i++ {
+		docID := uint64(i)
+		obj := storobj.FromObject(&models.Object{
+			Class: "Test",
+			ID:    strfmt.UUID(fmt.Sprintf("00000000-0000-0000-0000-%012d", i)),
+		}, nil, nil, nil)
+		obj.DocID = docID
+		objects[docID] = obj
+		ids[i] = docID
+		dists[i] = float32(i) * 0.01
+	}
+
+	// without a limit, each page holds up to the maximum number of results
+	pageSize := radiusPageSize(-1, maxLimit)
+	sorted := searchSorted(ids, dists)
+
+	var (
+		cursor *additional.DistanceCursor
+		paged  = map[strfmt.UUID]struct{}{}
+	)
+	for pages := 0; ; pages++ {
+		require.Less(t, pages, count, "paging must end")
+
+		search := func(dist float32, limit int64) ([]uint64, []float32, error) {
+			found, foundDists, err := sorted(dist, limit)
+			// there are no ties, so no search returns more than the results
+			// up to the cursor and a page
+			require.LessOrEqual(t, len(found), len(paged)+maxLimit)
+			return found, foundDists, err
+		}
+		foundIDs, foundDists, err := searchAfterDistanceCursor(cursor, 1, pageSize, search)
+		require.Nil(t, err)
+
+		candIDs, candDists := distanceCursorCandidates(foundIDs, foundDists, cursor, pageSize)
+		page, pageDists := distanceCursorPage(loadObjects(objects, candIDs), candDists, cursor, pageSize)
+		if len(page) == 0 {
+			break
+		}
+		require.LessOrEqual(t, len(page), maxLimit)
+		for _, obj := range page {
+			require.NotContains(t, paged, obj.ID())
+			paged[obj.ID()] = struct{}{}
+		}
+		last := len(page) - 1
+		cursor = &additional.DistanceCursor{Distance: pageDists[last], ID: page[last].ID()}
+	}
+	assert.Len(t, paged, count)
+}
+
+// searchSorted returns a search by distance over the results, which returns
+// them ordered by distance like a vector index does
+func searchSorted(ids []uint64, dists []float32,
+) func(dist float32, maxLimit int64) ([]uint64, []float32, error) {
+	ids, dists = append([]uint64{}, ids...), append([]float32{}, dists...)
+	sort.Stable(&docIDsByDistance{ids: ids, dists: dists})
+	return func(dist float32, maxLimit int64) ([]uint64, []float32, error) {
+		end := 0
+		for end < len(dists) && dists[end] <= dist && (maxLimit < 0 || int64(end) < maxLimit) {
+			end++
+		}
+		return append([]uint64{}, ids[:end]...), append([]float32{}, dists[:end]...), nil
+	}
+}
+
+func loadObjects(objects map[uint64]*storobj.Object, ids []uint64) []*storobj.Object {
+	out := make([]*storobj.Object, len(ids))
+	for i, id := range ids {
+		out[i] = objects[id]
+	}
+	return out
+}
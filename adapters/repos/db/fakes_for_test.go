@@ -209,7 +209,7 @@ func (f *fakeRemoteClient) MultiGetObjects(ctx context.Context, hostName, indexN
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []models.Vector, targetVector []string, limit int,
+	shardName string, vector []models.Vector, targetVector []string, distance float32, limit int,
 	filters *filters.LocalFilter, _ *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination,
 	properties []string,
@@ -259,6 +259,18 @@ func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) OpenSnapshot(ctx context.Context, hostName, indexName, shardName,
+	token string, ttl time.Duration,
+) error {
+	return nil
+}
+
+func (f *fakeRemoteClient) ReleaseSnapshot(ctx context.Context, hostName, indexName, shardName,
+	token string,
+) (bool, error) {
+	return false, nil
+}
+
 func (f *fakeRemoteClient) PutFile(ctx context.Context, hostName, indexName, shardName,
 	fileName string, payload io.ReadSeekCloser,
 ) error {
@@ -325,6 +337,12 @@ func (f *fakeReplicationClient) AddReferences(ctx context.Context, host, index,
 	return replica.SimpleResponse{}, nil
 }
 
+func (f *fakeReplicationClient) WriteKeyValue(ctx context.Context, host, index, shard, requestID string,
+	write *objects.KeyValueWrite, schemaVersion uint64,
+) (replica.SimpleResponse, error) {
+	return replica.SimpleResponse{}, nil
+}
+
 func (f *fakeReplicationClient) Commit(ctx context.Context, host, index, shard, requestID string, resp interface{}) error {
 	return nil
 }
@@ -381,3 +399,8 @@ func (c *fakeReplicationClient) HashTreeLevel(ctx context.Context, host, index,
 ) (digests []hashtree.Digest, err error) {
 	return nil, nil
 }
+
+func (c *fakeReplicationClient) FetchKeyValue(ctx context.Context, host, index, shard, key string,
+) (objects.KeyValue, error) {
+	return objects.KeyValue{}, nil
+}
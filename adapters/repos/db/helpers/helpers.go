@@ -23,6 +23,16 @@ var (
 	VectorsCompressedBucketLSM = "vectors_compressed"
 	VectorsBucketLSM           = "vectors"
 	DimensionsBucketLSM        = "dimensions"
+	KeyValueBucketLSM          = "key_value"
+
+	VectorsDiskANNBucketLSM           = "vectors_diskann"
+	VectorsDiskANNCompressedBucketLSM = "vectors_diskann_compressed"
+	VectorsDiskANNTombstonesBucketLSM = "vectors_diskann_tombstones"
+
+	VectorsIVFCodesBucketLSM = "vectors_ivf_codes"
+
+	VectorsSparseBucketLSM     = "vectors_sparse"
+	VectorsSparseDocsBucketLSM = "vectors_sparse_docs"
 )
 
 const (
@@ -74,3 +84,7 @@ func BucketSearchableFromPropNameLSM(propName string) string {
 func BucketRangeableFromPropNameLSM(propName string) string {
 	return BucketFromPropNameLSM(propName + "_rangeable")
 }
+
+func BucketPositionsFromPropNameLSM(propName string) string {
+	return BucketFromPropNameLSM(propName + "_positions")
+}
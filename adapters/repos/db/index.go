@@ -40,6 +40,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -58,6 +59,8 @@ import (
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
 	esync "github.com/weaviate/weaviate/entities/sync"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/usecases/config"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 	"github.com/weaviate/weaviate/usecases/modules"
@@ -169,6 +172,7 @@ type Index struct {
 	logger                    logrus.FieldLogger
 	remote                    *sharding.RemoteIndex
 	stopwords                 *stopwords.Detector
+	synonyms                  *synonyms.Cache
 	replicator                *replica.Replicator
 
 	partitioningEnabled bool
@@ -265,6 +269,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 		invertedIndexConfig:    invertedIndexConfig,
 		vectorIndexUserConfigs: vectorIndexUserConfigs,
 		stopwords:              sd,
+		synonyms:               synonyms.NewCache(invertedIndexConfig.Synonyms),
 		replicator:             repl,
 		partitioningEnabled:    shardState.PartitioningEnabled,
 		remote:                 sharding.NewRemoteIndex(cfg.ClassName.String(), sg, nodeResolver, remoteClient),
@@ -295,6 +300,7 @@ func NewIndex(ctx context.Context, cfg IndexConfig,
 	index.cycleCallbacks.compactionCycle.Start()
 	index.cycleCallbacks.compactionAuxCycle.Start()
 	index.cycleCallbacks.flushCycle.Start()
+	index.cycleCallbacks.objectTTLCycle.Start()
 
 	return index, nil
 }
@@ -496,6 +502,15 @@ func (i *Index) addProperty(ctx context.Context, props ...*models.Property) erro
 	return nil
 }
 
+// dropProperty releases what the shards keep in memory for a property that
+// was removed from the schema
+func (i *Index) dropProperty(propName string) {
+	i.ForEachLoadedShard(func(name string, shard ShardLike) error {
+		shard.dropTermDictionary(propName)
+		return nil
+	})
+}
+
 func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	updated schemaConfig.VectorIndexConfig,
 ) error {
@@ -544,6 +559,24 @@ func (i *Index) updateVectorIndexConfigs(ctx context.Context,
 	return nil
 }
 
+// vectorDataTypes returns the data types of the target vectors that are not
+// stored as float32, nil if there are none
+func (i *Index) vectorDataTypes() map[string]string {
+	i.vectorIndexUserConfigLock.Lock()
+	defer i.vectorIndexUserConfigLock.Unlock()
+
+	var dataTypes map[string]string
+	for targetVector, cfg := range i.vectorIndexUserConfigs {
+		if dataType := vectorindex.DataType(cfg); dataType != vectorIndexCommon.DataTypeFloat32 {
+			if dataTypes == nil {
+				dataTypes = map[string]string{}
+			}
+			dataTypes[targetVector] = dataType
+		}
+	}
+	return dataTypes
+}
+
 func (i *Index) getInvertedIndexConfig() schema.InvertedIndexConfig {
 	i.invertedIndexConfigLock.Lock()
 	defer i.invertedIndexConfigLock.Unlock()
@@ -558,6 +591,7 @@ func (i *Index) updateInvertedIndexConfig(ctx context.Context,
 	defer i.invertedIndexConfigLock.Unlock()
 
 	i.invertedIndexConfig = updated
+	i.synonyms.Update(updated.Synonyms)
 
 	return nil
 }
@@ -582,29 +616,37 @@ func (i *Index) updateAsyncReplication(ctx context.Context, enabled bool) error
 }
 
 type IndexConfig struct {
-	RootPath                       string
-	ClassName                      schema.ClassName
-	QueryMaximumResults            int64
-	QueryNestedRefLimit            int64
-	ResourceUsage                  config.ResourceUsage
-	MemtablesFlushDirtyAfter       int
-	MemtablesInitialSizeMB         int
-	MemtablesMaxSizeMB             int
-	MemtablesMinActiveSeconds      int
-	MemtablesMaxActiveSeconds      int
-	SegmentsCleanupIntervalSeconds int
-	SeparateObjectsCompactions     bool
-	MaxSegmentSize                 int64
-	HNSWMaxLogSize                 int64
-	HNSWWaitForCachePrefill        bool
-	HNSWFlatSearchConcurrency      int
-	VisitedListPoolMaxSize         int
-	ReplicationFactor              *atomic.Int64
-	DeletionStrategy               string
-	AsyncReplicationEnabled        bool
-	AvoidMMap                      bool
-	DisableLazyLoadShards          bool
-	ForceFullReplicasSearch        bool
+	RootPath                        string
+	ClassName                       schema.ClassName
+	QueryMaximumResults             int64
+	QueryMaximumRadiusResults       int64
+	QueryNestedRefLimit             int64
+	ResourceUsage                   config.ResourceUsage
+	MemtablesFlushDirtyAfter        int
+	MemtablesInitialSizeMB          int
+	MemtablesMaxSizeMB              int
+	MemtablesMinActiveSeconds       int
+	MemtablesMaxActiveSeconds       int
+	SegmentsCleanupIntervalSeconds  int
+	SeparateObjectsCompactions      bool
+	VerifyChecksumsOnLoad           bool
+	VerifyChecksumsOnRead           bool
+	WriteSegmentChecksums           bool
+	QuarantineCorruptSegments       bool
+	ObjectsTTLDeleteIntervalSeconds int
+	Tiering                         *lsmkv.TieringConfig
+	CompactionScheduler             *lsmkv.CompactionScheduler
+	MaxSegmentSize                  int64
+	HNSWMaxLogSize                  int64
+	HNSWWaitForCachePrefill         bool
+	HNSWFlatSearchConcurrency       int
+	VisitedListPoolMaxSize          int
+	ReplicationFactor               *atomic.Int64
+	DeletionStrategy                string
+	AsyncReplicationEnabled         bool
+	AvoidMMap                       bool
+	DisableLazyLoadShards           bool
+	ForceFullReplicasSearch         bool
 
 	TrackVectorDimensions bool
 }
@@ -1346,7 +1388,7 @@ func (i *Index) objectSearch(ctx context.Context, limit int, filters *filters.Lo
 	} else if len(shardNames) > 1 && !addlProps.ReferenceQuery {
 		// sort only for multiple shards (already sorted for single)
 		// and for not reference nested query (sort is applied for root query)
-		outObjects, outScores = i.sortByID(outObjects, outScores)
+		outObjects, outScores = i.sortByID(outObjects, outScores, cursor != nil && cursor.Reverse)
 	}
 
 	if autoCut > 0 {
@@ -1427,7 +1469,7 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 				i.logger.WithField("shardName", shardName).Debug("shard was not found locally, search for object remotely")
 
 				objs, scores, nodeName, err = i.remote.SearchShard(
-					ctx, shardName, nil, nil, limit, filters, keywordRanking,
+					ctx, shardName, nil, nil, 0, limit, filters, keywordRanking,
 					sort, cursor, nil, addlProps, i.replicationEnabled(), nil, properties)
 				if err != nil {
 					return fmt.Errorf(
@@ -1491,9 +1533,9 @@ func (i *Index) objectSearchByShard(ctx context.Context, limit int, filters *fil
 	return resultObjects, resultScores, nil
 }
 
-func (i *Index) sortByID(objects []*storobj.Object, scores []float32,
+func (i *Index) sortByID(objects []*storobj.Object, scores []float32, desc bool,
 ) ([]*storobj.Object, []float32) {
-	return newIDSorter().sort(objects, scores)
+	return newIDSorter(desc).sort(objects, scores)
 }
 
 func (i *Index) sortKeywordRanking(objects []*storobj.Object,
@@ -1633,7 +1675,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors []models.V
 				if i.Config.ForceFullReplicasSearch {
 					// Force a search on all the replicas for the shard
 					remoteSearchResults, err := i.remote.SearchAllReplicas(ctx,
-						i.logger, shardName, searchVectors, targetVectors, limit, filters,
+						i.logger, shardName, searchVectors, targetVectors, dist, limit, filters,
 						nil, sort, nil, groupBy, additional, i.replicationEnabled(), i.getSchema.NodeName(), targetCombination, properties)
 					// Only return an error if we failed to query remote shards AND we had no local shard to query
 					if err != nil && shard == nil {
@@ -1652,7 +1694,7 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors []models.V
 				} else {
 					// Search only what is necessary
 					remoteResult, remoteDists, nodeName, err := i.remote.SearchShard(ctx,
-						shardName, searchVectors, targetVectors, limit, filters,
+						shardName, searchVectors, targetVectors, dist, limit, filters,
 						nil, sort, nil, groupBy, additional, i.replicationEnabled(), targetCombination, properties)
 					if err != nil {
 						return errors.Wrapf(err, "remote shard %s", shardName)
@@ -1696,10 +1738,16 @@ func (i *Index) objectVectorSearch(ctx context.Context, searchVectors []models.V
 		return i.sort(out, dists, sort, limit)
 	}
 
-	out, dists = newDistancesSorter().sort(out, dists)
-	if limit > 0 && len(out) > limit {
-		out = out[:limit]
-		dists = dists[:limit]
+	if limit < 0 || additional.DistanceCursor != nil {
+		// the shards have already skipped everything up to the cursor
+		out, dists = distanceCursorPage(out, dists, nil,
+			radiusPageSize(limit, i.Config.QueryMaximumRadiusResults))
+	} else {
+		out, dists = newDistancesSorter().sort(out, dists)
+		if limit > 0 && len(out) > limit {
+			out = out[:limit]
+			dists = dists[:limit]
+		}
 	}
 
 	if i.replicationEnabled() {
@@ -2237,6 +2285,9 @@ func (i *Index) stopCycleManagers(ctx context.Context, usecase string) error {
 	if err := i.cycleCallbacks.geoPropsTombstoneCleanupCycle.StopAndWait(ctx); err != nil {
 		return fmt.Errorf("%s: stop geo props tombstone cleanup cycle: %w", usecase, err)
 	}
+	if err := i.cycleCallbacks.objectTTLCycle.StopAndWait(ctx); err != nil {
+		return fmt.Errorf("%s: stop object ttl cycle: %w", usecase, err)
+	}
 	return nil
 }
 
@@ -2643,6 +2694,22 @@ func (i *Index) DebugResetVectorIndex(ctx context.Context, shardName, targetVect
 	return nil
 }
 
+// DebugGraphHealth analyzes and optionally repairs the hnsw graph of a local
+// shard. Unlike DebugRepairIndex it runs synchronously, as the caller needs
+// the report.
+func (i *Index) DebugGraphHealth(ctx context.Context, shardName, targetVector string, repair bool) (*hnsw.GraphHealth, error) {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	if shard == nil {
+		return nil, errors.New("shard not found")
+	}
+	defer release()
+
+	return shard.DebugGraphHealth(ctx, targetVector, repair)
+}
+
 func (i *Index) DebugRepairIndex(ctx context.Context, shardName, targetVector string) error {
 	shard, release, err := i.GetShard(ctx, shardName)
 	if err != nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/config"
 )
 
 type indexCycleCallbacks struct {
@@ -38,6 +39,9 @@ type indexCycleCallbacks struct {
 	geoPropsCommitLoggerCycle         cyclemanager.CycleManager
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCycle     cyclemanager.CycleManager
+
+	objectTTLCallbacks cyclemanager.CycleCallbackGroup
+	objectTTLCycle     cyclemanager.CycleManager
 }
 
 func (index *Index) initCycleCallbacks() {
@@ -114,6 +118,15 @@ func (index *Index) initCycleCallbacks() {
 		cyclemanager.NewFixedTicker(enthnsw.DefaultCleanupIntervalSeconds*time.Second),
 		geoPropsTombstoneCleanupCallbacks.CycleCallback, index.logger)
 
+	objectTTLInterval := time.Duration(index.Config.ObjectsTTLDeleteIntervalSeconds) * time.Second
+	if objectTTLInterval <= 0 {
+		objectTTLInterval = time.Duration(config.DefaultPersistenceObjectsTTLDeleteIntervalSeconds) * time.Second
+	}
+	objectTTLCallbacks := cyclemanager.NewCallbackGroup(id("object_ttl"), index.logger, _NUMCPU)
+	objectTTLCycle := cyclemanager.NewManager(
+		cyclemanager.NewFixedTicker(objectTTLInterval),
+		objectTTLCallbacks.CycleCallback, index.logger)
+
 	index.cycleCallbacks = &indexCycleCallbacks{
 		compactionCallbacks:    compactionCallbacks,
 		compactionCycle:        compactionCycle,
@@ -131,6 +144,9 @@ func (index *Index) initCycleCallbacks() {
 		geoPropsCommitLoggerCycle:         geoPropsCommitLoggerCycle,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsTombstoneCleanupCycle:     geoPropsTombstoneCleanupCycle,
+
+		objectTTLCallbacks: objectTTLCallbacks,
+		objectTTLCycle:     objectTTLCycle,
 	}
 }
 
@@ -152,5 +168,8 @@ func (index *Index) initCycleCallbacksNoop() {
 		geoPropsCommitLoggerCycle:         cyclemanager.NewManagerNoop(),
 		geoPropsTombstoneCleanupCallbacks: cyclemanager.NewCallbackGroupNoop(),
 		geoPropsTombstoneCleanupCycle:     cyclemanager.NewManagerNoop(),
+
+		objectTTLCallbacks: cyclemanager.NewCallbackGroupNoop(),
+		objectTTLCycle:     cyclemanager.NewManagerNoop(),
 	}
 }
@@ -0,0 +1,153 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+// GetKeyValue returns an entry of the key-value store of a collection, nil
+// if the key has never been written
+func (db *DB) GetKeyValue(ctx context.Context, class, key string,
+	repl *additional.ReplicationProperties, tenant string,
+) (*objects.KeyValue, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, fmt.Errorf("get key-value entry from non-existing index for %s", class)
+	}
+	return idx.getKeyValue(ctx, key, repl, tenant)
+}
+
+// WriteKeyValue sets or deletes an entry of the key-value store of a
+// collection
+func (db *DB) WriteKeyValue(ctx context.Context, class string, write *objects.KeyValueWrite,
+	repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+) (*objects.KeyValue, error) {
+	idx := db.GetIndex(schema.ClassName(class))
+	if idx == nil {
+		return nil, fmt.Errorf("write key-value entry to non-existing index for %s", class)
+	}
+	return idx.writeKeyValue(ctx, write, repl, tenant, schemaVersion)
+}
+
+// determineKeyValueShard places keys without a tenant on the shards of the
+// collection the same way as object ids, keys of a tenant belong to the
+// tenant's shard
+func (i *Index) determineKeyValueShard(ctx context.Context, key, tenant string) (string, error) {
+	if tenant == "" {
+		return i.getSchema.ShardFromUUID(i.Config.ClassName.String(), []byte(key)), nil
+	}
+	return i.determineObjectShard(ctx, "", tenant)
+}
+
+func (i *Index) getKeyValue(ctx context.Context, key string,
+	replProps *additional.ReplicationProperties, tenant string,
+) (*objects.KeyValue, error) {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+
+	shardName, err := i.determineKeyValueShard(ctx, key, tenant)
+	if err != nil {
+		switch err.(type) {
+		case objects.ErrMultiTenancy:
+			return nil, objects.NewErrMultiTenancy(fmt.Errorf("determine shard: %w", err))
+		default:
+			return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
+		}
+	}
+
+	if i.replicationEnabled() {
+		if replProps == nil {
+			replProps = defaultConsistency()
+		}
+		cl := replica.ConsistencyLevel(replProps.ConsistencyLevel)
+		return i.replicator.GetKeyValue(ctx, cl, shardName, key)
+	}
+
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return nil, err
+	}
+	if shard == nil {
+		// remote shards are read through the replication api, which serves
+		// single replicas just as well
+		return i.replicator.GetKeyValue(ctx, replica.One, shardName, key)
+	}
+	defer release()
+
+	kv, err := shard.GetKeyValue(ctx, key)
+	if err != nil {
+		return nil, fmt.Errorf("get local key-value entry: shard=%q: %w", shardName, err)
+	}
+	return kv, nil
+}
+
+func (i *Index) writeKeyValue(ctx context.Context, write *objects.KeyValueWrite,
+	replProps *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+) (*objects.KeyValue, error) {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return nil, err
+	}
+
+	shardName, err := i.determineKeyValueShard(ctx, write.Key, tenant)
+	if err != nil {
+		switch err.(type) {
+		case objects.ErrMultiTenancy:
+			return nil, objects.NewErrMultiTenancy(fmt.Errorf("determine shard: %w", err))
+		default:
+			return nil, objects.NewErrInvalidUserInput("determine shard: %v", err)
+		}
+	}
+
+	cl := replica.One
+	if i.replicationEnabled() {
+		if replProps == nil {
+			replProps = defaultConsistency()
+		}
+		cl = replica.ConsistencyLevel(replProps.ConsistencyLevel)
+	} else {
+		shard, release, err := i.GetShard(ctx, shardName)
+		if err != nil {
+			return nil, err
+		}
+		if shard != nil {
+			defer release()
+
+			i.shardTransferMutex.RLock()
+			defer i.shardTransferMutex.RUnlock()
+			kv, err := shard.WriteKeyValue(ctx, write)
+			if err != nil {
+				return nil, fmt.Errorf("write local key-value entry: shard=%q: %w", shardName, err)
+			}
+			return kv, nil
+		}
+	}
+
+	// replicated or remote shard, replicas don't report back the entry they
+	// have written, it is the same on all of them
+	if err := i.replicator.WriteKeyValue(ctx, shardName, write, cl, schemaVersion); err != nil {
+		return nil, fmt.Errorf("replicate key-value entry: %w", err)
+	}
+	return &objects.KeyValue{
+		Key:                     write.Key,
+		Value:                   write.Value,
+		LastUpdateTimeUnixMilli: write.UpdateTime,
+		Deleted:                 write.Delete,
+	}, nil
+}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// openSnapshot opens a snapshot with a newly generated token on all shards
+// targeted by the tenant. Each shard is pinned on every node holding a
+// replica of it, so that reads served by any of them see the same state.
+func (i *Index) openSnapshot(ctx context.Context, tenant string, ttl time.Duration) (string, error) {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return "", err
+	}
+
+	shardNames, err := i.targetShardNames(ctx, tenant)
+	if err != nil {
+		return "", err
+	}
+	if len(shardNames) == 0 {
+		return "", fmt.Errorf("no shards found for class %s", i.Config.ClassName)
+	}
+
+	token := uuid.NewString()
+	opened := make([]string, 0, len(shardNames))
+	for _, shardName := range shardNames {
+		if err := i.openShardSnapshot(ctx, shardName, token, ttl); err != nil {
+			i.releaseSnapshotOnShards(ctx, opened, token)
+			return "", err
+		}
+		opened = append(opened, shardName)
+	}
+
+	return token, nil
+}
+
+// openShardSnapshot opens the snapshot on the local replica of the shard, if
+// there is one, and on all remote replicas.
+func (i *Index) openShardSnapshot(ctx context.Context, shardName, token string, ttl time.Duration) error {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	if shard != nil {
+		err = shard.OpenSnapshot(token, ttl)
+		release()
+		if err != nil {
+			return fmt.Errorf("open snapshot on shard %s: %w", shardName, err)
+		}
+	}
+
+	if err := i.remote.OpenSnapshot(ctx, shardName, token, ttl, i.getSchema.NodeName()); err != nil {
+		if shard != nil {
+			i.releaseLocalSnapshot(ctx, shardName, token)
+		}
+		return err
+	}
+	return nil
+}
+
+// releaseSnapshot releases the snapshot on all replicas of the shards
+// targeted by the tenant. It returns ErrSnapshotNotFound if none of them knew
+// the token.
+func (i *Index) releaseSnapshot(ctx context.Context, tenant, token string) error {
+	if err := i.validateMultiTenancy(tenant); err != nil {
+		return err
+	}
+
+	shardNames, err := i.targetShardNames(ctx, tenant)
+	if err != nil {
+		return err
+	}
+
+	found := false
+	var errs []error
+	for _, shardName := range shardNames {
+		ok, err := i.releaseShardSnapshot(ctx, shardName, token)
+		if err != nil {
+			errs = append(errs, err)
+		}
+		found = found || ok
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if !found {
+		return ErrSnapshotNotFound
+	}
+	return nil
+}
+
+// releaseShardSnapshot releases the snapshot on all replicas of the shard
+// and reports whether any of them knew the token.
+func (i *Index) releaseShardSnapshot(ctx context.Context, shardName, token string) (bool, error) {
+	found := false
+	var errs []error
+
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		errs = append(errs, err)
+	} else if shard != nil {
+		err = shard.ReleaseSnapshot(token)
+		release()
+		if err == nil {
+			found = true
+		} else if !errors.Is(err, ErrSnapshotNotFound) {
+			errs = append(errs, fmt.Errorf("release snapshot on shard %s: %w", shardName, err))
+		}
+	}
+
+	ok, err := i.remote.ReleaseSnapshot(ctx, shardName, token, i.getSchema.NodeName())
+	if err != nil {
+		errs = append(errs, err)
+	}
+
+	return found || ok, errors.Join(errs...)
+}
+
+func (i *Index) releaseSnapshotOnShards(ctx context.Context, shardNames []string, token string) {
+	for _, shardName := range shardNames {
+		if _, err := i.releaseShardSnapshot(ctx, shardName, token); err != nil {
+			i.logger.WithField("action", "release_snapshot").
+				WithField("shard", shardName).
+				WithError(err).Warn("failed to release snapshot")
+		}
+	}
+}
+
+func (i *Index) releaseLocalSnapshot(ctx context.Context, shardName, token string) {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err == nil && shard != nil {
+		err = shard.ReleaseSnapshot(token)
+		release()
+	}
+	if err != nil {
+		i.logger.WithField("action", "release_snapshot").
+			WithField("shard", shardName).
+			WithError(err).Warn("failed to release snapshot")
+	}
+}
+
+// IncomingOpenSnapshot opens a snapshot requested by the node which serves
+// the snapshot to the client, the token is generated by that node.
+func (i *Index) IncomingOpenSnapshot(ctx context.Context, shardName, token string, ttl time.Duration) error {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return shard.OpenSnapshot(token, ttl)
+}
+
+func (i *Index) IncomingReleaseSnapshot(ctx context.Context, shardName, token string) error {
+	shard, release, err := i.GetShard(ctx, shardName)
+	if err != nil {
+		return err
+	}
+	if shard == nil {
+		return ErrSnapshotNotFound
+	}
+	defer release()
+
+	return shard.ReleaseSnapshot(token)
+}
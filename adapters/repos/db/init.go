@@ -80,30 +80,38 @@ func (db *DB) init(ctx context.Context) error {
 			}
 
 			idx, err := NewIndex(ctx, IndexConfig{
-				ClassName:                      schema.ClassName(class.Class),
-				RootPath:                       db.config.RootPath,
-				ResourceUsage:                  db.config.ResourceUsage,
-				QueryMaximumResults:            db.config.QueryMaximumResults,
-				QueryNestedRefLimit:            db.config.QueryNestedRefLimit,
-				MemtablesFlushDirtyAfter:       db.config.MemtablesFlushDirtyAfter,
-				MemtablesInitialSizeMB:         db.config.MemtablesInitialSizeMB,
-				MemtablesMaxSizeMB:             db.config.MemtablesMaxSizeMB,
-				MemtablesMinActiveSeconds:      db.config.MemtablesMinActiveSeconds,
-				MemtablesMaxActiveSeconds:      db.config.MemtablesMaxActiveSeconds,
-				SegmentsCleanupIntervalSeconds: db.config.SegmentsCleanupIntervalSeconds,
-				SeparateObjectsCompactions:     db.config.SeparateObjectsCompactions,
-				MaxSegmentSize:                 db.config.MaxSegmentSize,
-				HNSWMaxLogSize:                 db.config.HNSWMaxLogSize,
-				HNSWWaitForCachePrefill:        db.config.HNSWWaitForCachePrefill,
-				HNSWFlatSearchConcurrency:      db.config.HNSWFlatSearchConcurrency,
-				VisitedListPoolMaxSize:         db.config.VisitedListPoolMaxSize,
-				TrackVectorDimensions:          db.config.TrackVectorDimensions,
-				AvoidMMap:                      db.config.AvoidMMap,
-				DisableLazyLoadShards:          db.config.DisableLazyLoadShards,
-				ForceFullReplicasSearch:        db.config.ForceFullReplicasSearch,
-				ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
-				AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
-				DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
+				ClassName:                       schema.ClassName(class.Class),
+				RootPath:                        db.config.RootPath,
+				ResourceUsage:                   db.config.ResourceUsage,
+				QueryMaximumResults:             db.config.QueryMaximumResults,
+				QueryMaximumRadiusResults:       db.config.QueryMaximumRadiusResults,
+				QueryNestedRefLimit:             db.config.QueryNestedRefLimit,
+				MemtablesFlushDirtyAfter:        db.config.MemtablesFlushDirtyAfter,
+				MemtablesInitialSizeMB:          db.config.MemtablesInitialSizeMB,
+				MemtablesMaxSizeMB:              db.config.MemtablesMaxSizeMB,
+				MemtablesMinActiveSeconds:       db.config.MemtablesMinActiveSeconds,
+				MemtablesMaxActiveSeconds:       db.config.MemtablesMaxActiveSeconds,
+				SegmentsCleanupIntervalSeconds:  db.config.SegmentsCleanupIntervalSeconds,
+				SeparateObjectsCompactions:      db.config.SeparateObjectsCompactions,
+				VerifyChecksumsOnLoad:           db.config.VerifyChecksumsOnLoad,
+				VerifyChecksumsOnRead:           db.config.VerifyChecksumsOnRead,
+				WriteSegmentChecksums:           db.config.WriteSegmentChecksums,
+				QuarantineCorruptSegments:       db.config.QuarantineCorruptSegments,
+				ObjectsTTLDeleteIntervalSeconds: db.config.ObjectsTTLDeleteIntervalSeconds,
+				Tiering:                         db.tiering,
+				CompactionScheduler:             db.compactionScheduler,
+				MaxSegmentSize:                  db.config.MaxSegmentSize,
+				HNSWMaxLogSize:                  db.config.HNSWMaxLogSize,
+				HNSWWaitForCachePrefill:         db.config.HNSWWaitForCachePrefill,
+				HNSWFlatSearchConcurrency:       db.config.HNSWFlatSearchConcurrency,
+				VisitedListPoolMaxSize:          db.config.VisitedListPoolMaxSize,
+				TrackVectorDimensions:           db.config.TrackVectorDimensions,
+				AvoidMMap:                       db.config.AvoidMMap,
+				DisableLazyLoadShards:           db.config.DisableLazyLoadShards,
+				ForceFullReplicasSearch:         db.config.ForceFullReplicasSearch,
+				ReplicationFactor:               NewAtomicInt64(class.ReplicationConfig.Factor),
+				AsyncReplicationEnabled:         class.ReplicationConfig.AsyncEnabled,
+				DeletionStrategy:                class.ReplicationConfig.DeletionStrategy,
 			}, db.schemaGetter.CopyShardingState(class.Class),
 				inverted.ConfigFromModel(invertedConfig),
 				convertToVectorIndexConfig(class.VectorIndexConfig),
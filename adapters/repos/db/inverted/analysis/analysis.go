@@ -0,0 +1,325 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package analysis builds the configurable text analysis pipelines of a
+// class. A pipeline tokenizes text with one of the property tokenizations
+// and then applies a chain of token filters. The same pipeline is used when
+// indexing a property and when analyzing queries on it, so that both sides
+// produce identical terms.
+package analysis
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	FilterLowercase    = "lowercase"
+	FilterASCIIFolding = "asciiFolding"
+	FilterStemmer      = "stemmer"
+	FilterStopwords    = "stopwords"
+	FilterSynonyms     = "synonyms"
+)
+
+// Pipeline is a compiled text analyzer
+type Pipeline struct {
+	tokenizer string
+	filters   []tokenFilter
+}
+
+// tokenFilter transforms a single token, returning false drops the token.
+// Only pattern safe filters are applied to tokens with wildcards.
+type tokenFilter struct {
+	apply       func(token string) (string, bool)
+	patternSafe bool
+}
+
+// pipelines caches compiled pipelines by their configuration, the number of
+// distinct analyzer configurations is small
+var pipelines sync.Map
+
+// New compiles the given analyzer configuration
+func New(cfg *models.TextAnalyzerConfig) (*Pipeline, error) {
+	if cfg == nil {
+		return nil, fmt.Errorf("analyzer config is nil")
+	}
+
+	tokenizer := cfg.Tokenizer
+	if tokenizer == "" {
+		tokenizer = models.PropertyTokenizationWord
+	}
+	if !isTokenizer(tokenizer) {
+		return nil, fmt.Errorf("analyzer %q: tokenizer %q does not exist or is not enabled", cfg.Name, tokenizer)
+	}
+
+	p := &Pipeline{tokenizer: tokenizer}
+	for i, filterCfg := range cfg.Filters {
+		if filterCfg == nil {
+			return nil, fmt.Errorf("analyzer %q: filter %d is empty", cfg.Name, i)
+		}
+		filter, err := newFilter(filterCfg)
+		if err != nil {
+			return nil, fmt.Errorf("analyzer %q: filter %d: %w", cfg.Name, i, err)
+		}
+		p.filters = append(p.filters, filter)
+	}
+
+	return p, nil
+}
+
+// Validate checks the analyzer definitions of a class
+func Validate(cfgs []*models.TextAnalyzerConfig) error {
+	names := map[string]struct{}{}
+	for _, cfg := range cfgs {
+		if cfg == nil {
+			return fmt.Errorf("analyzer config is nil")
+		}
+		if strings.TrimSpace(cfg.Name) == "" {
+			return fmt.Errorf("analyzer name is required")
+		}
+		if _, ok := names[cfg.Name]; ok {
+			return fmt.Errorf("analyzer %q is defined more than once", cfg.Name)
+		}
+		names[cfg.Name] = struct{}{}
+
+		if _, err := New(cfg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Find returns the analyzer definition with the given name
+func Find(cfgs []*models.TextAnalyzerConfig, name string) *models.TextAnalyzerConfig {
+	for _, cfg := range cfgs {
+		if cfg != nil && cfg.Name == name {
+			return cfg
+		}
+	}
+	return nil
+}
+
+// ForProperty returns the pipeline of a property, it is nil for properties
+// without an analyzer
+func ForProperty(cfgs []*models.TextAnalyzerConfig, prop *models.Property) (*Pipeline, error) {
+	if prop == nil || prop.Analyzer == "" {
+		return nil, nil
+	}
+
+	cfg := Find(cfgs, prop.Analyzer)
+	if cfg == nil {
+		return nil, fmt.Errorf("analyzer %q of property %q does not exist", prop.Analyzer, prop.Name)
+	}
+
+	key, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("analyzer %q: %w", cfg.Name, err)
+	}
+	if p, ok := pipelines.Load(string(key)); ok {
+		return p.(*Pipeline), nil
+	}
+
+	p, err := New(cfg)
+	if err != nil {
+		return nil, err
+	}
+	pipelines.Store(string(key), p)
+	return p, nil
+}
+
+// Tokenizer returns the tokenization the pipeline starts with
+func (p *Pipeline) Tokenizer() string {
+	return p.tokenizer
+}
+
+// Analyze tokenizes the input and applies all filters
+func (p *Pipeline) Analyze(in string) []string {
+	return p.filter(helpers.Tokenize(p.tokenizer, in), false)
+}
+
+// AnalyzeWithWildcards is used for like filters. Wildcard symbols are kept
+// and tokens containing them are only lowercased and folded, as stemming or
+// replacing them by synonyms would change the pattern.
+func (p *Pipeline) AnalyzeWithWildcards(in string) []string {
+	return p.filter(helpers.TokenizeWithWildcards(p.tokenizer, in), true)
+}
+
+// AnalyzeAndCountDuplicates is the analyzer counterpart of
+// helpers.TokenizeAndCountDuplicates
+func (p *Pipeline) AnalyzeAndCountDuplicates(in string) ([]string, []int) {
+	counts := map[string]int{}
+	var unique []string
+	for _, term := range p.Analyze(in) {
+		if _, ok := counts[term]; !ok {
+			unique = append(unique, term)
+		}
+		counts[term]++
+	}
+
+	boosts := make([]int, len(unique))
+	for i, term := range unique {
+		boosts[i] = counts[term]
+	}
+	return unique, boosts
+}
+
+func (p *Pipeline) filter(tokens []string, wildcards bool) []string {
+	if len(p.filters) == 0 {
+		return tokens
+	}
+
+	out := tokens[:0]
+tokens:
+	for _, token := range tokens {
+		isPattern := wildcards && strings.ContainsAny(token, "*?")
+		for _, f := range p.filters {
+			if isPattern && !f.patternSafe {
+				continue
+			}
+			var keep bool
+			token, keep = f.apply(token)
+			if !keep {
+				continue tokens
+			}
+		}
+		if token != "" {
+			out = append(out, token)
+		}
+	}
+	return out
+}
+
+func isTokenizer(tokenizer string) bool {
+	for _, t := range helpers.Tokenizations {
+		if t == tokenizer {
+			return true
+		}
+	}
+	return false
+}
+
+func newFilter(cfg *models.TextAnalyzerFilter) (tokenFilter, error) {
+	switch cfg.Type {
+	case FilterLowercase:
+		return tokenFilter{apply: lowercase, patternSafe: true}, nil
+	case FilterASCIIFolding:
+		return tokenFilter{apply: asciiFolding, patternSafe: true}, nil
+	case FilterStemmer:
+		return newStemmerFilter(cfg.Language)
+	case FilterStopwords:
+		return newStopwordsFilter(cfg)
+	case FilterSynonyms:
+		return newSynonymsFilter(cfg.Synonyms)
+	default:
+		return tokenFilter{}, fmt.Errorf("unknown filter type %q, options are %q, %q, %q, %q, %q", cfg.Type,
+			FilterLowercase, FilterASCIIFolding, FilterStemmer, FilterStopwords, FilterSynonyms)
+	}
+}
+
+func lowercase(token string) (string, bool) {
+	return strings.ToLower(token), true
+}
+
+// foldings covers letters which do not decompose into a base letter and a
+// combining mark
+var foldings = strings.NewReplacer(
+	"ß", "ss", "æ", "ae", "Æ", "AE", "œ", "oe", "Œ", "OE", "ø", "o", "Ø", "O",
+	"ł", "l", "Ł", "L", "đ", "d", "Đ", "D", "ð", "d", "Ð", "D", "þ", "th", "Þ", "TH",
+	"ı", "i",
+)
+
+func asciiFolding(token string) (string, bool) {
+	if isASCII(token) {
+		return token, true
+	}
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, token)
+	if err != nil {
+		return token, true
+	}
+	return foldings.Replace(folded), true
+}
+
+func isASCII(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] >= utf8.RuneSelf {
+			return false
+		}
+	}
+	return true
+}
+
+func newStopwordsFilter(cfg *models.TextAnalyzerFilter) (tokenFilter, error) {
+	preset := cfg.Preset
+	if preset == "" {
+		preset = stopwords.EnglishPreset
+	}
+	detector, err := stopwords.NewDetectorFromConfig(models.StopwordConfig{
+		Preset:    preset,
+		Additions: cfg.Additions,
+		Removals:  cfg.Removals,
+	})
+	if err != nil {
+		return tokenFilter{}, err
+	}
+	return tokenFilter{apply: func(token string) (string, bool) {
+		return token, !detector.IsStopword(token)
+	}}, nil
+}
+
+// newSynonymsFilter replaces every term of a synonym group by the first term
+// of the group. Applied at index and query time this makes all terms of a
+// group match each other without inflating term frequencies.
+func newSynonymsFilter(groups []string) (tokenFilter, error) {
+	if len(groups) == 0 {
+		return tokenFilter{}, fmt.Errorf("synonyms filter requires at least one synonym group")
+	}
+
+	canonical := map[string]string{}
+	for _, group := range groups {
+		var first string
+		for _, term := range strings.Split(group, ",") {
+			term = strings.TrimSpace(term)
+			if term == "" {
+				continue
+			}
+			if first == "" {
+				first = term
+			}
+			if existing, ok := canonical[term]; ok && existing != first {
+				return tokenFilter{}, fmt.Errorf("synonym %q is part of more than one group", term)
+			}
+			canonical[term] = first
+		}
+		if first == "" {
+			return tokenFilter{}, fmt.Errorf("synonym group %q is empty", group)
+		}
+	}
+
+	return tokenFilter{apply: func(token string) (string, bool) {
+		if replacement, ok := canonical[token]; ok {
+			return replacement, true
+		}
+		return token, true
+	}}, nil
+}
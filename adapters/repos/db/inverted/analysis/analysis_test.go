@@ -0,0 +1,230 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestPipeline(t *testing.T) {
+	type testCase struct {
+		name     string
+		cfg      *models.TextAnalyzerConfig
+		input    string
+		expected []string
+	}
+
+	testCases := []testCase{
+		{
+			name:     "no filters",
+			cfg:      &models.TextAnalyzerConfig{Name: "plain"},
+			input:    "The Running Dogs",
+			expected: []string{"the", "running", "dogs"},
+		},
+		{
+			name: "lowercase after whitespace tokenizer",
+			cfg: &models.TextAnalyzerConfig{
+				Name:      "lower",
+				Tokenizer: models.PropertyTokenizationWhitespace,
+				Filters:   []*models.TextAnalyzerFilter{{Type: FilterLowercase}},
+			},
+			input:    "Hello, World!",
+			expected: []string{"hello,", "world!"},
+		},
+		{
+			name: "ascii folding",
+			cfg: &models.TextAnalyzerConfig{
+				Name:    "folding",
+				Filters: []*models.TextAnalyzerFilter{{Type: FilterASCIIFolding}},
+			},
+			input:    "Crème brûlée Straße Øresund",
+			expected: []string{"creme", "brulee", "strasse", "oresund"},
+		},
+		{
+			name: "english stemmer",
+			cfg: &models.TextAnalyzerConfig{
+				Name:    "english",
+				Filters: []*models.TextAnalyzerFilter{{Type: FilterStemmer}},
+			},
+			input:    "running runs connected connections",
+			expected: []string{"run", "run", "connect", "connect"},
+		},
+		{
+			name: "german stopwords and stemmer",
+			cfg: &models.TextAnalyzerConfig{
+				Name: "german",
+				Filters: []*models.TextAnalyzerFilter{
+					{Type: FilterStopwords, Preset: "de"},
+					{Type: FilterStemmer, Language: "german"},
+				},
+			},
+			input:    "Die Katzen und der Hund",
+			expected: []string{"katz", "hund"},
+		},
+		{
+			name: "stopwords with additions and removals",
+			cfg: &models.TextAnalyzerConfig{
+				Name: "custom",
+				Filters: []*models.TextAnalyzerFilter{
+					{Type: FilterStopwords, Additions: []string{"quick"}, Removals: []string{"the"}},
+				},
+			},
+			input:    "the quick brown fox",
+			expected: []string{"the", "brown", "fox"},
+		},
+		{
+			name: "synonyms",
+			cfg: &models.TextAnalyzerConfig{
+				Name: "synonyms",
+				Filters: []*models.TextAnalyzerFilter{
+					{Type: FilterSynonyms, Synonyms: []string{"car, automobile, auto", "tv,television"}},
+				},
+			},
+			input:    "automobile television radio",
+			expected: []string{"car", "tv", "radio"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, err := New(tc.cfg)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, p.Analyze(tc.input))
+		})
+	}
+}
+
+func TestPipelineWithWildcards(t *testing.T) {
+	p, err := New(&models.TextAnalyzerConfig{
+		Name: "stemmed",
+		Filters: []*models.TextAnalyzerFilter{
+			{Type: FilterASCIIFolding},
+			{Type: FilterStemmer},
+		},
+	})
+	require.NoError(t, err)
+
+	// tokens with wildcards are folded but not stemmed
+	assert.Equal(t, []string{"cafe*", "run"}, p.AnalyzeWithWildcards("café* running"))
+}
+
+func TestPipelineAnalyzeAndCountDuplicates(t *testing.T) {
+	p, err := New(&models.TextAnalyzerConfig{
+		Name:    "stemmed",
+		Filters: []*models.TextAnalyzerFilter{{Type: FilterStemmer}},
+	})
+	require.NoError(t, err)
+
+	terms, boosts := p.AnalyzeAndCountDuplicates("runs dog running dogs run")
+	assert.Equal(t, []string{"run", "dog"}, terms)
+	assert.Equal(t, []int{3, 2}, boosts)
+}
+
+func TestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		cfgs        []*models.TextAnalyzerConfig
+		expectedErr string
+	}
+
+	testCases := []testCase{
+		{
+			name: "valid",
+			cfgs: []*models.TextAnalyzerConfig{
+				{Name: "a", Filters: []*models.TextAnalyzerFilter{{Type: FilterLowercase}}},
+				{Name: "b", Tokenizer: models.PropertyTokenizationField},
+			},
+		},
+		{
+			name:        "missing name",
+			cfgs:        []*models.TextAnalyzerConfig{{}},
+			expectedErr: "analyzer name is required",
+		},
+		{
+			name:        "duplicate name",
+			cfgs:        []*models.TextAnalyzerConfig{{Name: "a"}, {Name: "a"}},
+			expectedErr: `analyzer "a" is defined more than once`,
+		},
+		{
+			name:        "unknown tokenizer",
+			cfgs:        []*models.TextAnalyzerConfig{{Name: "a", Tokenizer: "sentences"}},
+			expectedErr: `analyzer "a": tokenizer "sentences" does not exist or is not enabled`,
+		},
+		{
+			name:        "unknown filter",
+			cfgs:        []*models.TextAnalyzerConfig{{Name: "a", Filters: []*models.TextAnalyzerFilter{{Type: "phonetic"}}}},
+			expectedErr: `unknown filter type "phonetic"`,
+		},
+		{
+			name:        "unknown stemmer language",
+			cfgs:        []*models.TextAnalyzerConfig{{Name: "a", Filters: []*models.TextAnalyzerFilter{{Type: FilterStemmer, Language: "klingon"}}}},
+			expectedErr: `stemmer language "klingon"`,
+		},
+		{
+			name:        "unknown stopwords preset",
+			cfgs:        []*models.TextAnalyzerConfig{{Name: "a", Filters: []*models.TextAnalyzerFilter{{Type: FilterStopwords, Preset: "tlh"}}}},
+			expectedErr: `preset "tlh" not known to stopword detector`,
+		},
+		{
+			name:        "empty synonyms",
+			cfgs:        []*models.TextAnalyzerConfig{{Name: "a", Filters: []*models.TextAnalyzerFilter{{Type: FilterSynonyms}}}},
+			expectedErr: "synonyms filter requires at least one synonym group",
+		},
+		{
+			name: "synonym in two groups",
+			cfgs: []*models.TextAnalyzerConfig{{Name: "a", Filters: []*models.TextAnalyzerFilter{
+				{Type: FilterSynonyms, Synonyms: []string{"car,auto", "auto,vehicle"}},
+			}}},
+			expectedErr: `synonym "auto" is part of more than one group`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.cfgs)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestIsASCII(t *testing.T) {
+	assert.True(t, isASCII(""))
+	assert.True(t, isASCII("abc~\x7f"))
+	assert.False(t, isASCII("\u0080"))
+	assert.False(t, isASCII("café"))
+}
+
+func TestForProperty(t *testing.T) {
+	cfgs := []*models.TextAnalyzerConfig{
+		{Name: "english", Filters: []*models.TextAnalyzerFilter{{Type: FilterStemmer}}},
+	}
+
+	p, err := ForProperty(cfgs, &models.Property{Name: "plain"})
+	require.NoError(t, err)
+	assert.Nil(t, p)
+
+	p, err = ForProperty(cfgs, &models.Property{Name: "stemmed", Analyzer: "english"})
+	require.NoError(t, err)
+	require.NotNil(t, p)
+	assert.Equal(t, models.PropertyTokenizationWord, p.Tokenizer())
+
+	_, err = ForProperty(cfgs, &models.Property{Name: "missing", Analyzer: "german"})
+	assert.ErrorContains(t, err, `analyzer "german" of property "missing" does not exist`)
+}
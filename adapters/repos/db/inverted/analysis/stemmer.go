@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package analysis
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blevesearch/snowballstem"
+	"github.com/blevesearch/snowballstem/danish"
+	"github.com/blevesearch/snowballstem/dutch"
+	"github.com/blevesearch/snowballstem/english"
+	"github.com/blevesearch/snowballstem/finnish"
+	"github.com/blevesearch/snowballstem/french"
+	"github.com/blevesearch/snowballstem/german"
+	"github.com/blevesearch/snowballstem/hungarian"
+	"github.com/blevesearch/snowballstem/italian"
+	"github.com/blevesearch/snowballstem/norwegian"
+	"github.com/blevesearch/snowballstem/portuguese"
+	"github.com/blevesearch/snowballstem/romanian"
+	"github.com/blevesearch/snowballstem/russian"
+	"github.com/blevesearch/snowballstem/spanish"
+	"github.com/blevesearch/snowballstem/swedish"
+	"github.com/blevesearch/snowballstem/turkish"
+)
+
+// stemmers are the Snowball stemmers by language
+var stemmers = map[string]func(env *snowballstem.Env) bool{
+	"danish":     danish.Stem,
+	"dutch":      dutch.Stem,
+	"english":    english.Stem,
+	"finnish":    finnish.Stem,
+	"french":     french.Stem,
+	"german":     german.Stem,
+	"hungarian":  hungarian.Stem,
+	"italian":    italian.Stem,
+	"norwegian":  norwegian.Stem,
+	"portuguese": portuguese.Stem,
+	"romanian":   romanian.Stem,
+	"russian":    russian.Stem,
+	"spanish":    spanish.Stem,
+	"swedish":    swedish.Stem,
+	"turkish":    turkish.Stem,
+}
+
+// StemmerLanguages lists the languages supported by the stemmer filter
+func StemmerLanguages() []string {
+	languages := make([]string, 0, len(stemmers))
+	for language := range stemmers {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+	return languages
+}
+
+func newStemmerFilter(language string) (tokenFilter, error) {
+	if language == "" {
+		language = "english"
+	}
+	stem, ok := stemmers[strings.ToLower(language)]
+	if !ok {
+		return tokenFilter{}, fmt.Errorf("stemmer language %q is not supported, options are %v",
+			language, StemmerLanguages())
+	}
+
+	return tokenFilter{apply: func(token string) (string, bool) {
+		env := snowballstem.NewEnv(token)
+		stem(env)
+		return env.Current(), true
+	}}, nil
+}
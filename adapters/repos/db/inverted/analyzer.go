@@ -17,7 +17,10 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
 )
 
 type IsFallbackToSearchable func() bool
@@ -25,6 +28,9 @@ type IsFallbackToSearchable func() bool
 type Countable struct {
 	Data          []byte
 	TermFrequency float32
+	// Positions holds the token positions of the term within the property,
+	// only set for properties with a position index
+	Positions []uint32
 }
 
 type Property struct {
@@ -34,6 +40,7 @@ type Property struct {
 	HasFilterableIndex bool // roaring set index
 	HasSearchableIndex bool // map index (with frequencies)
 	HasRangeableIndex  bool // roaring set index for ranged queries
+	HasPositionIndex   bool // map index (with token positions)
 }
 
 type NilProperty struct {
@@ -63,6 +70,74 @@ func DedupItems(props []Property) []Property {
 
 type Analyzer struct {
 	isFallbackToSearchable IsFallbackToSearchable
+	textAnalyzers          []*models.TextAnalyzerConfig
+}
+
+// WithTextAnalyzers sets the analyzer definitions of the class, they are
+// used instead of the plain tokenization for properties with an analyzer
+func (a *Analyzer) WithTextAnalyzers(cfgs []*models.TextAnalyzerConfig) *Analyzer {
+	a.textAnalyzers = cfgs
+	return a
+}
+
+// tokenizerFor returns the function turning the text of the given property
+// into terms
+func (a *Analyzer) tokenizerFor(prop *models.Property) (func(string) []string, error) {
+	pipeline, err := analysis.ForProperty(a.textAnalyzers, prop)
+	if err != nil {
+		return nil, err
+	}
+	if pipeline != nil {
+		return pipeline.Analyze, nil
+	}
+	return tokenizerFunc(prop.Tokenization), nil
+}
+
+// classTextAnalyzers returns the analyzer definitions of the class
+func classTextAnalyzers(class *models.Class) []*models.TextAnalyzerConfig {
+	if class == nil || class.InvertedIndexConfig == nil {
+		return nil
+	}
+	return class.InvertedIndexConfig.Analyzers
+}
+
+// classSynonyms returns the synonym sets of the class
+func classSynonyms(class *models.Class) []*models.SynonymSet {
+	if class == nil || class.InvertedIndexConfig == nil {
+		return nil
+	}
+	return class.InvertedIndexConfig.Synonyms
+}
+
+// synonymExpander returns the expander of the synonyms of the class for the
+// terms produced by tokenize, which group identifies. The expanders of the
+// cache are only built once, without a cache the expander is built from the
+// class.
+func synonymExpander(cache *synonyms.Cache, class *models.Class, group string,
+	tokenize func(string) []string,
+) *synonyms.Expander {
+	if cache != nil {
+		return cache.Expander(group, tokenize)
+	}
+	if sets := classSynonyms(class); len(sets) > 0 {
+		return synonyms.NewExpander(sets, tokenize)
+	}
+	return nil
+}
+
+// termGroup identifies how the terms of a property are produced, properties
+// of the same group are searched with the same query terms
+func termGroup(prop *models.Property, pipeline *analysis.Pipeline) string {
+	if pipeline != nil {
+		return analyzerGroupPrefix + prop.Analyzer
+	}
+	return prop.Tokenization
+}
+
+func tokenizerFunc(tokenization string) func(string) []string {
+	return func(in string) []string {
+		return helpers.Tokenize(tokenization, in)
+	}
 }
 
 // Text tokenizes given input according to selected tokenization,
@@ -74,9 +149,13 @@ func (a *Analyzer) Text(tokenization, in string) []Countable {
 // TextArray tokenizes given input according to selected tokenization,
 // then aggregates duplicates
 func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
+	return a.textArray(tokenizerFunc(tokenization), inArr)
+}
+
+func (a *Analyzer) textArray(tokenize func(string) []string, inArr []string) []Countable {
 	var terms []string
 	for _, in := range inArr {
-		terms = append(terms, helpers.Tokenize(tokenization, in)...)
+		terms = append(terms, tokenize(in)...)
 	}
 
 	counts := map[string]uint64{}
@@ -96,6 +175,53 @@ func (a *Analyzer) TextArray(tokenization string, inArr []string) []Countable {
 	return countable
 }
 
+// PositionGap is added to the token position between the elements of a
+// text[] property, so that phrase and proximity queries do not match across
+// array elements. It exceeds the largest slop a query may use.
+const PositionGap = searchparams.MaxSlop + 1
+
+// TextPositions tokenizes given input according to selected tokenization,
+// then aggregates duplicates keeping track of their token positions
+func (a *Analyzer) TextPositions(tokenization, in string) []Countable {
+	return a.TextArrayPositions(tokenization, []string{in})
+}
+
+// TextArrayPositions tokenizes given input according to selected
+// tokenization, then aggregates duplicates keeping track of their token
+// positions. Positions of consecutive array elements are separated by
+// PositionGap
+func (a *Analyzer) TextArrayPositions(tokenization string, inArr []string) []Countable {
+	return a.textArrayPositions(tokenizerFunc(tokenization), inArr)
+}
+
+func (a *Analyzer) textArrayPositions(tokenize func(string) []string, inArr []string) []Countable {
+	positions := map[string][]uint32{}
+	var order []string
+	pos := uint32(0)
+	for i, in := range inArr {
+		if i > 0 {
+			pos += PositionGap
+		}
+		for _, term := range tokenize(in) {
+			if _, ok := positions[term]; !ok {
+				order = append(order, term)
+			}
+			positions[term] = append(positions[term], pos)
+			pos++
+		}
+	}
+
+	countable := make([]Countable, len(order))
+	for i, term := range order {
+		countable[i] = Countable{
+			Data:          []byte(term),
+			TermFrequency: float32(len(positions[term])),
+			Positions:     positions[term],
+		}
+	}
+	return countable
+}
+
 // Int requires no analysis, so it's actually just a simple conversion to a
 // string-formatted byte slice of the int
 func (a *Analyzer) Int(in int64) ([]Countable, error) {
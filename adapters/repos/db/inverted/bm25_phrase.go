@@ -0,0 +1,243 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// PositionsDocIDKey is the map key of a doc in the positions bucket. The
+// bucket was introduced after shard index version 2, so the doc id is always
+// stored BigEndian
+func PositionsDocIDKey(docID uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, docID)
+	return key
+}
+
+// EncodePositions serializes the token positions of a term for the
+// positions bucket
+func EncodePositions(positions []uint32) []byte {
+	buf := make([]byte, 4*len(positions))
+	for i, pos := range positions {
+		binary.LittleEndian.PutUint32(buf[i*4:], pos)
+	}
+	return buf
+}
+
+// DecodePositions is the inverse of EncodePositions
+func DecodePositions(in []byte) []uint32 {
+	positions := make([]uint32, len(in)/4)
+	for i := range positions {
+		positions[i] = binary.LittleEndian.Uint32(in[i*4:])
+	}
+	return positions
+}
+
+// phraseAllowList returns the docs in which the query terms occur as a
+// phrase in at least one of the searched properties. The result is
+// restricted to filterDocIds if set.
+func (b *BM25Searcher) phraseAllowList(ctx context.Context, filterDocIds helpers.AllowList,
+	class *models.Class, params searchparams.KeywordRanking,
+) (helpers.AllowList, error) {
+	if err := params.ValidatePhrase(); err != nil {
+		return nil, err
+	}
+
+	allow := helpers.NewAllowList()
+	for _, propertyWithBoost := range params.Properties {
+		propName := strings.Split(propertyWithBoost, "^")[0]
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, err
+		}
+		if !HasPositionIndex(prop) {
+			return nil, fmt.Errorf("phrase search requires a position index, "+
+				"property %q does not have indexPositions enabled", prop.Name)
+		}
+
+		bucket := b.store.Bucket(helpers.BucketPositionsFromPropNameLSM(prop.Name))
+		if bucket == nil {
+			return nil, fmt.Errorf("no bucket positions for prop %q found", prop.Name)
+		}
+
+		pipeline, err := analysis.ForProperty(classTextAnalyzers(class), prop)
+		if err != nil {
+			return nil, err
+		}
+		var queryTerms []string
+		if pipeline != nil {
+			queryTerms = pipeline.Analyze(params.Query)
+		} else {
+			queryTerms = helpers.Tokenize(prop.Tokenization, params.Query)
+		}
+		if len(queryTerms) == 0 {
+			continue
+		}
+
+		// unique terms in order of their first occurrence in the query, the
+		// phrase is stored as indexes into the unique terms
+		uniqueIdx := map[string]int{}
+		phrase := make([]int, len(queryTerms))
+		for i, term := range queryTerms {
+			idx, ok := uniqueIdx[term]
+			if !ok {
+				idx = len(uniqueIdx)
+				uniqueIdx[term] = idx
+			}
+			phrase[i] = idx
+		}
+
+		termDocs := make([]map[uint64][]uint32, len(uniqueIdx))
+		empty := false
+		for term, idx := range uniqueIdx {
+			pairs, err := bucket.MapList(ctx, []byte(term))
+			if err != nil {
+				return nil, fmt.Errorf("read positions of term %q: %w", term, err)
+			}
+			docs := make(map[uint64][]uint32, len(pairs))
+			for _, pair := range pairs {
+				docID := binary.BigEndian.Uint64(pair.Key)
+				if filterDocIds != nil && !filterDocIds.Contains(docID) {
+					continue
+				}
+				docs[docID] = DecodePositions(pair.Value)
+			}
+			if len(docs) == 0 {
+				empty = true
+				break
+			}
+			termDocs[idx] = docs
+		}
+		if empty {
+			continue
+		}
+
+		// iterate over the docs of the rarest term, all other terms need to
+		// be present in the doc as well
+		rarest := 0
+		for i := range termDocs {
+			if len(termDocs[i]) < len(termDocs[rarest]) {
+				rarest = i
+			}
+		}
+
+		positions := make([][]uint32, len(termDocs))
+	docs:
+		for docID := range termDocs[rarest] {
+			if allow.Contains(docID) {
+				continue
+			}
+			for i := range termDocs {
+				pos, ok := termDocs[i][docID]
+				if !ok {
+					continue docs
+				}
+				positions[i] = pos
+			}
+			if matchPhrase(phrase, positions, params.Slop) {
+				allow.Insert(docID)
+			}
+		}
+	}
+
+	return allow, nil
+}
+
+// matchPhrase checks whether the phrase, given as indexes into the positions
+// of its unique terms, occurs in a doc. With a slop of 0 the terms need to be
+// adjacent and in order, otherwise all terms need to occur within a window of
+// len(phrase)+slop tokens in any order.
+func matchPhrase(phrase []int, positions [][]uint32, slop int) bool {
+	if slop == 0 {
+		return matchExactPhrase(phrase, positions)
+	}
+	return matchProximity(phrase, positions, slop)
+}
+
+func matchExactPhrase(phrase []int, positions [][]uint32) bool {
+	sets := make([]map[uint32]struct{}, len(positions))
+	for i := range positions {
+		sets[i] = make(map[uint32]struct{}, len(positions[i]))
+		for _, pos := range positions[i] {
+			sets[i][pos] = struct{}{}
+		}
+	}
+
+	first := phrase[0]
+start:
+	for _, start := range positions[first] {
+		for offset, idx := range phrase[1:] {
+			if _, ok := sets[idx][start+uint32(offset)+1]; !ok {
+				continue start
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func matchProximity(phrase []int, positions [][]uint32, slop int) bool {
+	required := make([]int, len(positions))
+	for _, idx := range phrase {
+		required[idx]++
+	}
+
+	type occurrence struct {
+		pos uint32
+		idx int
+	}
+	var occurrences []occurrence
+	for idx := range positions {
+		for _, pos := range positions[idx] {
+			occurrences = append(occurrences, occurrence{pos: pos, idx: idx})
+		}
+	}
+	sort.Slice(occurrences, func(i, j int) bool {
+		return occurrences[i].pos < occurrences[j].pos
+	})
+
+	// sliding window over the occurrences covering every term as often as it
+	// is contained in the phrase
+	maxSpan := uint32(len(phrase) - 1 + slop)
+	counts := make([]int, len(positions))
+	missing := len(phrase)
+	left := 0
+	for _, occ := range occurrences {
+		counts[occ.idx]++
+		if counts[occ.idx] <= required[occ.idx] {
+			missing--
+		}
+		for missing == 0 {
+			if occ.pos-occurrences[left].pos <= maxSpan {
+				return true
+			}
+			l := occurrences[left]
+			counts[l.idx]--
+			if counts[l.idx] < required[l.idx] {
+				missing++
+			}
+			left++
+		}
+	}
+	return false
+}
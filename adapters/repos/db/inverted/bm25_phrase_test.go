@@ -0,0 +1,112 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestAnalyzerTextPositions(t *testing.T) {
+	a := NewAnalyzer(nil)
+
+	t.Run("text", func(t *testing.T) {
+		res := a.TextPositions(models.PropertyTokenizationWord, "the new york times, new york")
+		require.Len(t, res, 4)
+		assert.Equal(t, Countable{Data: []byte("the"), TermFrequency: 1, Positions: []uint32{0}}, res[0])
+		assert.Equal(t, Countable{Data: []byte("new"), TermFrequency: 2, Positions: []uint32{1, 4}}, res[1])
+		assert.Equal(t, Countable{Data: []byte("york"), TermFrequency: 2, Positions: []uint32{2, 5}}, res[2])
+		assert.Equal(t, Countable{Data: []byte("times"), TermFrequency: 1, Positions: []uint32{3}}, res[3])
+	})
+
+	t.Run("text array", func(t *testing.T) {
+		res := a.TextArrayPositions(models.PropertyTokenizationWord, []string{"new york", "york times"})
+		require.Len(t, res, 3)
+		assert.Equal(t, Countable{Data: []byte("new"), TermFrequency: 1, Positions: []uint32{0}}, res[0])
+		assert.Equal(t, Countable{Data: []byte("york"), TermFrequency: 2, Positions: []uint32{1, 2 + PositionGap}}, res[1])
+		assert.Equal(t, Countable{Data: []byte("times"), TermFrequency: 1, Positions: []uint32{3 + PositionGap}}, res[2])
+	})
+}
+
+func TestPositionsEncoding(t *testing.T) {
+	positions := []uint32{0, 3, 17, 1 << 30}
+	assert.Equal(t, positions, DecodePositions(EncodePositions(positions)))
+	assert.Empty(t, DecodePositions(EncodePositions(nil)))
+}
+
+func TestMatchPhrase(t *testing.T) {
+	// doc: "the new york times is a new paper in york"
+	//       0   1   2    3     4  5 6   7     8  9
+	docPositions := map[string][]uint32{
+		"the": {0}, "new": {1, 6}, "york": {2, 9}, "times": {3},
+		"is": {4}, "a": {5}, "paper": {7}, "in": {8},
+	}
+
+	match := func(query []string, slop int) bool {
+		uniqueIdx := map[string]int{}
+		phrase := make([]int, len(query))
+		var positions [][]uint32
+		for i, term := range query {
+			idx, ok := uniqueIdx[term]
+			if !ok {
+				idx = len(uniqueIdx)
+				uniqueIdx[term] = idx
+				positions = append(positions, docPositions[term])
+			}
+			phrase[i] = idx
+		}
+		return matchPhrase(phrase, positions, slop)
+	}
+
+	tests := []struct {
+		query    []string
+		slop     int
+		expected bool
+	}{
+		{query: []string{"new"}, expected: true},
+		{query: []string{"new", "york"}, expected: true},
+		{query: []string{"new", "york", "times"}, expected: true},
+		{query: []string{"york", "new"}, expected: false},
+		{query: []string{"new", "paper"}, expected: true},
+		{query: []string{"new", "times"}, expected: false},
+		{query: []string{"new", "times"}, slop: 1, expected: true},
+		{query: []string{"times", "new"}, slop: 1, expected: true},
+		{query: []string{"the", "paper"}, slop: 5, expected: false},
+		{query: []string{"the", "paper"}, slop: 6, expected: true},
+		{query: []string{"new", "new"}, expected: false},
+		{query: []string{"new", "new"}, slop: 4, expected: true},
+		{query: []string{"new", "new"}, slop: 3, expected: false},
+		{query: []string{"york", "york", "york"}, slop: 100, expected: false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.expected, match(tt.query, tt.slop), "query %v slop %d", tt.query, tt.slop)
+	}
+}
+
+func TestMatchPhraseMaxSlopAcrossArrayElements(t *testing.T) {
+	res := NewAnalyzer(nil).TextArrayPositions(models.PropertyTokenizationWord, []string{"new", "york"})
+	require.Len(t, res, 2)
+
+	positions := [][]uint32{res[0].Positions, res[1].Positions}
+	assert.False(t, matchPhrase([]int{0, 1}, positions, searchparams.MaxSlop))
+	assert.False(t, matchPhrase([]int{1, 0}, positions, searchparams.MaxSlop))
+
+	k := searchparams.KeywordRanking{Phrase: true, Slop: searchparams.MaxSlop}
+	require.Nil(t, k.ValidatePhrase())
+	k.Slop = PositionGap
+	require.ErrorContains(t, k.ValidatePhrase(), "slop must be between 0 and 99")
+}
@@ -17,6 +17,7 @@ import (
 	"math"
 	"os"
 	"runtime/debug"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -27,7 +28,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/fuzzy"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
@@ -48,6 +52,10 @@ type BM25Searcher struct {
 	propLenTracker propLengthRetriever
 	logger         logrus.FieldLogger
 	shardVersion   uint16
+	// termDictionaries serve fuzzy matching, optional
+	termDictionaries *fuzzy.Dictionaries
+	// synonyms caches the synonym expanders of the class, optional
+	synonyms *synonyms.Cache
 }
 
 type propLengthRetriever interface {
@@ -79,6 +87,20 @@ func NewBM25Searcher(config schema.BM25Config, store *lsmkv.Store,
 	}
 }
 
+// WithTermDictionaries sets the cached term dictionaries of the shard used
+// for fuzzy matching. Without them each fuzzy query builds its dictionaries.
+func (b *BM25Searcher) WithTermDictionaries(dicts *fuzzy.Dictionaries) *BM25Searcher {
+	b.termDictionaries = dicts
+	return b
+}
+
+// WithSynonyms sets the synonym expanders of the index. Without them each
+// query builds its expanders from the synonym sets of the class.
+func (b *BM25Searcher) WithSynonyms(cache *synonyms.Cache) *BM25Searcher {
+	b.synonyms = cache
+	return b
+}
+
 func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList,
 	className schema.ClassName, limit int, keywordRanking searchparams.KeywordRanking, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -98,6 +120,24 @@ func (b *BM25Searcher) BM25F(ctx context.Context, filterDocIds helpers.AllowList
 	var scores []float32
 	var err error
 
+	if err = keywordRanking.ValidateFuzziness(); err != nil {
+		return nil, nil, err
+	}
+
+	if keywordRanking.Phrase {
+		// phrase and proximity operators restrict the candidates, which are
+		// then scored as regular bm25 results
+		filterDocIds, err = b.phraseAllowList(ctx, filterDocIds, class, keywordRanking)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "phrase")
+		}
+		if filterDocIds.IsEmpty() {
+			return []*storobj.Object{}, []float32{}, nil
+		}
+	} else if err = keywordRanking.ValidatePhrase(); err != nil {
+		return nil, nil, err
+	}
+
 	if os.Getenv("USE_BLOCKMAX_WAND") == "true" {
 		objs, scores, err = b.wandBlock(ctx, filterDocIds, class, keywordRanking, limit, additional)
 	} else {
@@ -133,6 +173,7 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	queryTermsByTokenization := map[string][]string{}
 	duplicateBoostsByTokenization := map[string][]int{}
 	propNamesByTokenization := map[string][]string{}
+	tokenizersByTokenization := map[string]func(string) []string{}
 	propertyBoosts := make(map[string]float32, len(params.Properties))
 
 	for _, tokenization := range helpers.Tokenizations {
@@ -149,6 +190,7 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 		}
 
 		propNamesByTokenization[tokenization] = make([]string, 0)
+		tokenizersByTokenization[tokenization] = tokenizerFunc(tokenization)
 	}
 
 	averagePropLength := 0.
@@ -184,6 +226,23 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 
 		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
 		case schema.DataTypeText, schema.DataTypeTextArray:
+			pipeline, err := analysis.ForProperty(classTextAnalyzers(class), prop)
+			if err != nil {
+				return 0, nil, nil, nil, nil, 0, err
+			}
+			if pipeline != nil {
+				// properties with an analyzer are searched with the terms of
+				// their own pipeline, class stopwords do not apply to them
+				group := analyzerGroupPrefix + prop.Analyzer
+				if _, exists := queryTermsByTokenization[group]; !exists {
+					queryTerms, dupBoosts := pipeline.AnalyzeAndCountDuplicates(params.Query)
+					queryTermsByTokenization[group] = queryTerms
+					duplicateBoostsByTokenization[group] = dupBoosts
+					tokenizersByTokenization[group] = pipeline.Analyze
+				}
+				propNamesByTokenization[group] = append(propNamesByTokenization[group], property)
+				break
+			}
 			if _, exists := propNamesByTokenization[prop.Tokenization]; !exists {
 				return 0, nil, nil, nil, nil, 0, fmt.Errorf("cannot handle tokenization '%v' of property '%s'",
 					prop.Tokenization, prop.Name)
@@ -194,6 +253,31 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 		}
 	}
 
+	// synonyms are tokenized like the query terms of each searched group
+	for group, propNames := range propNamesByTokenization {
+		if len(propNames) == 0 {
+			continue
+		}
+		expander := synonymExpander(b.synonyms, class, group, tokenizersByTokenization[group])
+		queryTermsByTokenization[group], duplicateBoostsByTokenization[group] = expander.Expand(
+			queryTermsByTokenization[group], duplicateBoostsByTokenization[group])
+	}
+
+	if params.Fuzziness > 0 {
+		for group, propNames := range propNamesByTokenization {
+			if len(propNames) == 0 {
+				continue
+			}
+			queryTerms, dupBoosts, err := b.expandFuzzy(class, propNames,
+				queryTermsByTokenization[group], duplicateBoostsByTokenization[group], params.Fuzziness)
+			if err != nil {
+				return 0, nil, nil, nil, nil, 0, err
+			}
+			queryTermsByTokenization[group] = queryTerms
+			duplicateBoostsByTokenization[group] = dupBoosts
+		}
+	}
+
 	averagePropLength = averagePropLength / float64(averagePropLengthCount)
 
 	// If this value is zero or NaN, the prop length tracker is fully corrupted.
@@ -206,6 +290,60 @@ func (b *BM25Searcher) generateQueryTermsAndStats(class *models.Class, params se
 	return N, propNamesByTokenization, queryTermsByTokenization, duplicateBoostsByTokenization, propertyBoosts, averagePropLength, nil
 }
 
+// expandFuzzy adds the indexed terms of the properties within fuzziness
+// edits of the query terms. An added term gets the duplicate boost of the
+// query term it was found for.
+func (b *BM25Searcher) expandFuzzy(class *models.Class, propNames []string,
+	queryTerms []string, dupBoosts []int, fuzziness int,
+) ([]string, []int, error) {
+	seen := make(map[string]struct{}, len(queryTerms))
+	for _, term := range queryTerms {
+		seen[term] = struct{}{}
+	}
+
+	expandedTerms := append([]string{}, queryTerms...)
+	expandedBoosts := append([]int{}, dupBoosts...)
+	for _, propName := range propNames {
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i, term := range queryTerms {
+			matches, err := fuzzyTerms(b.store, b.termDictionaries, prop, term, fuzziness)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, match := range matches {
+				if _, ok := seen[match]; ok {
+					continue
+				}
+				seen[match] = struct{}{}
+				expandedTerms = append(expandedTerms, match)
+				expandedBoosts = append(expandedBoosts, dupBoosts[i])
+			}
+		}
+	}
+	return expandedTerms, expandedBoosts, nil
+}
+
+// analyzerGroupPrefix marks the query term groups of properties which are
+// analyzed by a text analyzer instead of a plain tokenization
+const analyzerGroupPrefix = "analyzer:"
+
+// queryTermGroups returns the keys of the query term groups in a stable
+// order, tokenizations first followed by the analyzers
+func queryTermGroups(propNamesByGroup map[string][]string) []string {
+	groups := append([]string{}, helpers.Tokenizations...)
+	var analyzers []string
+	for group := range propNamesByGroup {
+		if strings.HasPrefix(group, analyzerGroupPrefix) {
+			analyzers = append(analyzers, group)
+		}
+	}
+	sort.Strings(analyzers)
+	return append(groups, analyzers...)
+}
+
 func (b *BM25Searcher) wand(
 	ctx context.Context, filterDocIds helpers.AllowList, class *models.Class, params searchparams.KeywordRanking, limit int, additional additional.Properties,
 ) ([]*storobj.Object, []float32, error) {
@@ -217,7 +355,7 @@ func (b *BM25Searcher) wand(
 	allRequests := make([]termListRequest, 0, 1000)
 	allQueryTerms := make([]string, 0, 1000)
 
-	for _, tokenization := range helpers.Tokenizations {
+	for _, tokenization := range queryTermGroups(propNamesByTokenization) {
 		propNames := propNamesByTokenization[tokenization]
 		if len(propNames) > 0 {
 			queryTerms, duplicateBoosts := queryTermsByTokenization[tokenization], duplicateBoostsByTokenization[tokenization]
@@ -16,7 +16,9 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/usecases/config"
@@ -39,6 +41,16 @@ func ValidateConfig(conf *models.InvertedIndexConfig) error {
 		return err
 	}
 
+	err = analysis.Validate(conf.Analyzers)
+	if err != nil {
+		return err
+	}
+
+	err = synonyms.Validate(conf.Synonyms)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -48,6 +60,8 @@ func ConfigFromModel(iicm *models.InvertedIndexConfig) schema.InvertedIndexConfi
 	conf.IndexTimestamps = iicm.IndexTimestamps
 	conf.IndexNullState = iicm.IndexNullState
 	conf.IndexPropertyLength = iicm.IndexPropertyLength
+	conf.Analyzers = iicm.Analyzers
+	conf.Synonyms = iicm.Synonyms
 
 	if iicm.Bm25 == nil {
 		conf.BM25.K1 = float64(config.DefaultBM25k1)
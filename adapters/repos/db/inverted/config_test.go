@@ -69,6 +69,29 @@ func TestValidateConfig(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("with language stopword preset", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Stopwords: &models.StopwordConfig{
+				Preset: "de",
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.Nil(t, err)
+	})
+
+	t.Run("with duplicate analyzer", func(t *testing.T) {
+		in := &models.InvertedIndexConfig{
+			Analyzers: []*models.TextAnalyzerConfig{
+				{Name: "french", Filters: []*models.TextAnalyzerFilter{{Type: "stemmer", Language: "french"}}},
+				{Name: "french"},
+			},
+		}
+
+		err := ValidateConfig(in)
+		assert.EqualError(t, err, `analyzer "french" is defined more than once`)
+	})
+
 	t.Run("with nonexistent stopword preset", func(t *testing.T) {
 		in := &models.InvertedIndexConfig{
 			Stopwords: &models.StopwordConfig{
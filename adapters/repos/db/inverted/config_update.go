@@ -12,7 +12,11 @@
 package inverted
 
 import (
+	"reflect"
+
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/entities/models"
 )
 
@@ -36,6 +40,16 @@ func ValidateUserConfigUpdate(initial, updated *models.InvertedIndexConfig) erro
 		return err
 	}
 
+	err = validateAnalyzersConfigUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
+	err = validateSynonymsConfigUpdate(initial, updated)
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -85,3 +99,39 @@ func validateStopwordsConfigUpdate(initial, updated *models.InvertedIndexConfig)
 
 	return nil
 }
+
+// validateAnalyzersConfigUpdate allows adding analyzers. Existing analyzers
+// cannot be changed or removed, as the terms already indexed with them would
+// no longer match the analyzed queries.
+func validateAnalyzersConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Analyzers == nil {
+		updated.Analyzers = initial.Analyzers
+		return nil
+	}
+
+	for _, existing := range initial.Analyzers {
+		if existing == nil {
+			continue
+		}
+		analyzer := analysis.Find(updated.Analyzers, existing.Name)
+		if analyzer == nil {
+			return errors.Errorf("analyzer %q cannot be removed when updating a schema", existing.Name)
+		}
+		if !reflect.DeepEqual(existing, analyzer) {
+			return errors.Errorf("analyzer %q cannot be changed when updating a schema", existing.Name)
+		}
+	}
+
+	return analysis.Validate(updated.Analyzers)
+}
+
+// validateSynonymsConfigUpdate allows any change of the synonym sets, they
+// are only applied at query time and do not require reindexing
+func validateSynonymsConfigUpdate(initial, updated *models.InvertedIndexConfig) error {
+	if updated.Synonyms == nil {
+		updated.Synonyms = initial.Synonyms
+		return nil
+	}
+
+	return synonyms.Validate(updated.Synonyms)
+}
@@ -138,4 +138,118 @@ func TestValidateUserConfigUpdate(t *testing.T) {
 		err := ValidateUserConfigUpdate(validInitial, updated)
 		require.EqualError(t, err, "IndexPropertyLength cannot be changed when updating a schema")
 	})
+
+	t.Run("with analyzers", func(t *testing.T) {
+		german := &models.TextAnalyzerConfig{
+			Name: "german",
+			Filters: []*models.TextAnalyzerFilter{
+				{Type: "lowercase"},
+				{Type: "stemmer", Language: "german"},
+			},
+		}
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: validInitial.Stopwords,
+			Analyzers: []*models.TextAnalyzerConfig{german},
+		}
+
+		t.Run("missing analyzers keep the initial ones", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Equal(t, initial.Analyzers, updated.Analyzers)
+		})
+
+		t.Run("adding an analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: []*models.TextAnalyzerConfig{
+					german,
+					{Name: "folded", Filters: []*models.TextAnalyzerFilter{{Type: "asciiFolding"}}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+		})
+
+		t.Run("removing an analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: []*models.TextAnalyzerConfig{},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `analyzer "german" cannot be removed when updating a schema`)
+		})
+
+		t.Run("changing an analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: []*models.TextAnalyzerConfig{
+					{Name: "german", Filters: []*models.TextAnalyzerFilter{{Type: "lowercase"}}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `analyzer "german" cannot be changed when updating a schema`)
+		})
+
+		t.Run("adding an invalid analyzer", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Analyzers: []*models.TextAnalyzerConfig{
+					german,
+					{Name: "klingon", Filters: []*models.TextAnalyzerFilter{{Type: "stemmer", Language: "klingon"}}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.ErrorContains(t, err, `analyzer "klingon"`)
+		})
+	})
+
+	t.Run("with synonyms", func(t *testing.T) {
+		initial := &models.InvertedIndexConfig{
+			Bm25:      validInitial.Bm25,
+			Stopwords: validInitial.Stopwords,
+			Synonyms:  []*models.SynonymSet{{Synonyms: []string{"sofa", "couch"}}},
+		}
+
+		t.Run("missing synonyms keep the initial ones", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Equal(t, initial.Synonyms, updated.Synonyms)
+		})
+
+		t.Run("changing synonyms", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Synonyms: []*models.SynonymSet{
+					{Synonyms: []string{"sofa", "couch", "settee"}},
+					{Type: "oneWay", Input: "notebook", Synonyms: []string{"laptop"}},
+				},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+		})
+
+		t.Run("removing synonyms", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Synonyms: []*models.SynonymSet{},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.Nil(t, err)
+			assert.Empty(t, updated.Synonyms)
+		})
+
+		t.Run("invalid synonyms", func(t *testing.T) {
+			updated := &models.InvertedIndexConfig{
+				Synonyms: []*models.SynonymSet{{Type: "oneWay", Synonyms: []string{"laptop"}}},
+			}
+
+			err := ValidateUserConfigUpdate(initial, updated)
+			require.EqualError(t, err, `synonyms.0: "oneWay" synonym sets require an input`)
+		})
+	})
 }
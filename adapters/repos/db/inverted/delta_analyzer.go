@@ -60,6 +60,7 @@ func Delta(previous, next []Property) DeltaResults {
 				HasFilterableIndex: nextProp.HasFilterableIndex,
 				HasSearchableIndex: nextProp.HasSearchableIndex,
 				HasRangeableIndex:  nextProp.HasRangeableIndex,
+				HasPositionIndex:   nextProp.HasPositionIndex,
 			})
 		}
 		if len(toDelete) > 0 {
@@ -70,6 +71,7 @@ func Delta(previous, next []Property) DeltaResults {
 				HasFilterableIndex: nextProp.HasFilterableIndex,
 				HasSearchableIndex: nextProp.HasSearchableIndex,
 				HasRangeableIndex:  nextProp.HasRangeableIndex,
+				HasPositionIndex:   nextProp.HasPositionIndex,
 			})
 		}
 		// special case to update optional length/nil indexes on
@@ -83,6 +85,7 @@ func Delta(previous, next []Property) DeltaResults {
 				HasFilterableIndex: nextProp.HasFilterableIndex,
 				HasSearchableIndex: nextProp.HasSearchableIndex,
 				HasRangeableIndex:  nextProp.HasRangeableIndex,
+				HasPositionIndex:   nextProp.HasPositionIndex,
 			})
 		}
 	}
@@ -111,7 +114,8 @@ func countableDelta(prev, next []Countable) ([]Countable, []Countable) {
 
 	for _, nextItem := range next {
 		prev, ok := seenInPrev[string(nextItem.Data)]
-		if ok && prev.TermFrequency == nextItem.TermFrequency {
+		if ok && prev.TermFrequency == nextItem.TermFrequency &&
+			positionsIdentical(prev.Positions, nextItem.Positions) {
 			// we have an identical overlap, delete from old list
 			delete(seenInPrev, string(nextItem.Data))
 			// don't add to new list
@@ -142,7 +146,8 @@ func listsIdentical(a []Countable, b []Countable) bool {
 
 	for i := range a {
 		if !bytes.Equal(a[i].Data, b[i].Data) ||
-			a[i].TermFrequency != b[i].TermFrequency {
+			a[i].TermFrequency != b[i].TermFrequency ||
+			!positionsIdentical(a[i].Positions, b[i].Positions) {
 			// return as soon as an item didn't match
 			return false
 		}
@@ -154,6 +159,18 @@ func listsIdentical(a []Countable, b []Countable) bool {
 	return true
 }
 
+func positionsIdentical(a, b []uint32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 type DeltaNilResults struct {
 	ToDelete []NilProperty
 	ToAdd    []NilProperty
@@ -117,6 +117,39 @@ func TestDeltaAnalyzer(t *testing.T) {
 		assert.Len(t, res.ToAdd, 0)
 	})
 
+	t.Run("with previous indexing - changed positions only", func(t *testing.T) {
+		previous := []Property{
+			{
+				Name: "prop1",
+				Items: []Countable{
+					{Data: []byte("new"), TermFrequency: 1, Positions: []uint32{0}},
+					{Data: []byte("york"), TermFrequency: 1, Positions: []uint32{1}},
+				},
+				HasSearchableIndex: true,
+				HasPositionIndex:   true,
+			},
+		}
+		next := []Property{
+			{
+				Name: "prop1",
+				Items: []Countable{
+					{Data: []byte("york"), TermFrequency: 1, Positions: []uint32{0}},
+					{Data: []byte("new"), TermFrequency: 1, Positions: []uint32{1}},
+				},
+				HasSearchableIndex: true,
+				HasPositionIndex:   true,
+			},
+		}
+
+		res := Delta(previous, next)
+		assert.Len(t, res.ToAdd, 1)
+		assert.Len(t, res.ToDelete, 1)
+		assert.True(t, res.ToAdd[0].HasPositionIndex)
+		assert.True(t, res.ToDelete[0].HasPositionIndex)
+		assert.ElementsMatch(t, next[0].Items, res.ToAdd[0].Items)
+		assert.ElementsMatch(t, previous[0].Items, res.ToDelete[0].Items)
+	})
+
 	t.Run("with previous indexing - only additions", func(t *testing.T) {
 		previous := []Property{
 			{
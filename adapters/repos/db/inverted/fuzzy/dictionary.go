@@ -0,0 +1,417 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package fuzzy finds the terms of a property within a Levenshtein edit
+// distance of a query term. The terms of a property are kept in a trie, the
+// search walks the trie computing one row of the edit distance matrix per
+// node and skips every branch which can no longer be within the distance.
+// This avoids comparing the query term with every term of the property.
+package fuzzy
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+const (
+	// MaxEdits is the largest supported edit distance
+	MaxEdits = 2
+	// MaxExpansions limits the number of terms a single query term is
+	// expanded to, the closest terms are kept
+	MaxExpansions = 50
+	// DefaultMaxTerms limits the terms of the dictionaries of a shard
+	DefaultMaxTerms = 1_000_000
+
+	// a dictionary that exceeded the bounds is not loaded again for this
+	// long, the wait doubles with every further failure up to maxRetryAfter
+	minRetryAfter = time.Minute
+	maxRetryAfter = 30 * time.Minute
+)
+
+// AutoEdits returns the edit distance used for a term when none is given.
+// Short terms are matched exactly, as a single edit would match too many
+// unrelated terms.
+func AutoEdits(term string) int {
+	switch n := utf8.RuneCountInString(term); {
+	case n < 3:
+		return 0
+	case n < 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// Match is a term of the dictionary and its distance to the query term
+type Match struct {
+	Term     string
+	Distance int
+}
+
+// Dictionary is the term dictionary of a single property
+type Dictionary struct {
+	sync.RWMutex
+	root *node
+	size int
+}
+
+type node struct {
+	label    rune
+	terminal bool
+	// children are sorted by their label
+	children []*node
+}
+
+func NewDictionary() *Dictionary {
+	return &Dictionary{root: &node{}}
+}
+
+// Add inserts terms into the dictionary and returns how many of them were
+// new, existing terms are ignored
+func (d *Dictionary) Add(terms ...[]byte) int {
+	d.Lock()
+	defer d.Unlock()
+
+	added := 0
+	for _, term := range terms {
+		n := d.root
+		for _, r := range string(term) {
+			n = n.child(r)
+		}
+		if !n.terminal {
+			n.terminal = true
+			d.size++
+			added++
+		}
+	}
+	return added
+}
+
+// Len returns the number of terms
+func (d *Dictionary) Len() int {
+	d.RLock()
+	defer d.RUnlock()
+
+	return d.size
+}
+
+func (n *node) child(r rune) *node {
+	i := sort.Search(len(n.children), func(i int) bool { return n.children[i].label >= r })
+	if i < len(n.children) && n.children[i].label == r {
+		return n.children[i]
+	}
+	c := &node{label: r}
+	n.children = append(n.children, nil)
+	copy(n.children[i+1:], n.children[i:])
+	n.children[i] = c
+	return c
+}
+
+// Search returns the terms within maxEdits of term, closest first. The
+// term itself is part of the result if it exists.
+func (d *Dictionary) Search(term string, maxEdits int) []Match {
+	if maxEdits > MaxEdits {
+		maxEdits = MaxEdits
+	}
+	if maxEdits < 0 {
+		maxEdits = 0
+	}
+
+	d.RLock()
+	defer d.RUnlock()
+
+	query := []rune(term)
+	row := make([]int, len(query)+1)
+	for i := range row {
+		row[i] = i
+	}
+
+	s := &search{query: query, maxEdits: maxEdits}
+	for _, c := range d.root.children {
+		s.walk(c, row)
+	}
+
+	sort.Slice(s.matches, func(i, j int) bool {
+		if s.matches[i].Distance != s.matches[j].Distance {
+			return s.matches[i].Distance < s.matches[j].Distance
+		}
+		return s.matches[i].Term < s.matches[j].Term
+	})
+	if len(s.matches) > MaxExpansions {
+		s.matches = s.matches[:MaxExpansions]
+	}
+	return s.matches
+}
+
+type search struct {
+	query    []rune
+	maxEdits int
+	prefix   []rune
+	matches  []Match
+}
+
+// walk computes the edit distance row of n from the row of its parent
+func (s *search) walk(n *node, prevRow []int) {
+	s.prefix = append(s.prefix, n.label)
+	defer func() { s.prefix = s.prefix[:len(s.prefix)-1] }()
+
+	row := make([]int, len(prevRow))
+	row[0] = prevRow[0] + 1
+	rowMin := row[0]
+	for i := 1; i < len(row); i++ {
+		cost := 1
+		if s.query[i-1] == n.label {
+			cost = 0
+		}
+		row[i] = min(row[i-1]+1, prevRow[i]+1, prevRow[i-1]+cost)
+		rowMin = min(rowMin, row[i])
+	}
+
+	if n.terminal && row[len(row)-1] <= s.maxEdits {
+		s.matches = append(s.matches, Match{Term: string(s.prefix), Distance: row[len(row)-1]})
+	}
+
+	// no term below this node can be within the distance
+	if rowMin > s.maxEdits {
+		return
+	}
+	for _, c := range n.children {
+		s.walk(c, row)
+	}
+}
+
+// Dictionaries holds the dictionaries of the properties of a shard. A
+// dictionary is built from the bucket of its property when it is first
+// needed and kept up to date with the terms added afterwards. The terms of
+// all dictionaries are bound by maxTerms, and the memory they take is checked
+// before it is allocated.
+//
+// A dictionary that exceeds the bounds is dropped and remembered as failed,
+// so that queries don't read the whole bucket again only to fail the same
+// way. It is retried after a backoff, or once the property is dropped.
+type Dictionaries struct {
+	sync.Mutex
+	entries      map[string]*entry
+	failures     map[string]*failure
+	terms        int
+	maxTerms     int
+	allocChecker memwatch.AllocChecker
+	now          func() time.Time
+}
+
+type failure struct {
+	err        error
+	retryAfter time.Duration
+	retryAt    time.Time
+}
+
+type entry struct {
+	propName string
+	once     sync.Once
+	dict     *Dictionary
+	err      error
+	// terms counts the terms of dict towards the terms of all dictionaries
+	terms int
+	// reserved is the memory left from the last allocation check
+	reserved int64
+}
+
+// NewDictionaries bounds the dictionaries to maxTerms terms, allocChecker is
+// optional
+func NewDictionaries(maxTerms int, allocChecker memwatch.AllocChecker) *Dictionaries {
+	return &Dictionaries{
+		entries:      map[string]*entry{},
+		failures:     map[string]*failure{},
+		maxTerms:     maxTerms,
+		allocChecker: allocChecker,
+		now:          time.Now,
+	}
+}
+
+// Get returns the dictionary of a property, load fills a new dictionary with
+// the terms already indexed by passing them to add. Loading fails if the
+// dictionaries would exceed their bounds, the error is returned without
+// loading again until the retry backoff has passed.
+func (d *Dictionaries) Get(propName string,
+	load func(add func(term []byte) error) error,
+) (*Dictionary, error) {
+	d.Lock()
+	if f, ok := d.failures[propName]; ok && d.now().Before(f.retryAt) {
+		d.Unlock()
+		return nil, f.err
+	}
+	e, ok := d.entries[propName]
+	if !ok {
+		// the dictionary is registered before it is loaded, so terms added
+		// concurrently are not missed
+		e = &entry{propName: propName, dict: NewDictionary()}
+		d.entries[propName] = e
+	}
+	d.Unlock()
+
+	e.once.Do(func() {
+		e.err = load(func(term []byte) error {
+			return d.add(e, term)
+		})
+	})
+	if e.err != nil {
+		d.fail(e, e.err)
+		return nil, e.err
+	}
+
+	d.Lock()
+	delete(d.failures, propName)
+	d.Unlock()
+	return e.dict, nil
+}
+
+// Add adds terms to the dictionary of a property if it exists. A dictionary
+// that would exceed the bounds is dropped instead, Get reports why it can't
+// be loaded again until the retry backoff has passed.
+func (d *Dictionaries) Add(propName string, terms ...[]byte) {
+	if d == nil {
+		return
+	}
+
+	d.Lock()
+	e, ok := d.entries[propName]
+	d.Unlock()
+	if !ok {
+		return
+	}
+	for _, term := range terms {
+		if err := d.add(e, term); err != nil {
+			d.fail(e, err)
+			return
+		}
+	}
+}
+
+// Drop removes the dictionary of a property and forgets whether it failed
+func (d *Dictionaries) Drop(propName string) {
+	if d == nil {
+		return
+	}
+
+	d.Lock()
+	e, ok := d.entries[propName]
+	delete(d.failures, propName)
+	d.Unlock()
+	if ok {
+		d.drop(e)
+	}
+}
+
+// Clear removes all dictionaries
+func (d *Dictionaries) Clear() {
+	if d == nil {
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	d.entries = map[string]*entry{}
+	d.failures = map[string]*failure{}
+	d.terms = 0
+}
+
+// nodeMemory estimates the memory of a node of the trie. A term needs at most
+// one node per byte.
+const nodeMemory = 64
+
+// allocationChunk is the memory that is checked for at once, so the checker
+// isn't consulted for every term
+const allocationChunk = 1024 * 1024
+
+func (d *Dictionaries) add(e *entry, term []byte) error {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.entries[e.propName] != e {
+		// dropped while it was being loaded or written to
+		return errDropped
+	}
+	if size := int64(len(term)) * nodeMemory; size > e.reserved {
+		chunk := max(size, allocationChunk)
+		if d.allocChecker != nil {
+			if err := d.allocChecker.CheckAlloc(chunk); err != nil {
+				return &boundsError{fmt.Errorf("fuzzy term dictionary: %w", err)}
+			}
+		}
+		e.reserved += chunk
+	}
+	e.reserved -= int64(len(term)) * nodeMemory
+
+	added := e.dict.Add(term)
+	e.terms += added
+	d.terms += added
+	if d.terms > d.maxTerms {
+		return &boundsError{fmt.Errorf("fuzzy term dictionaries exceed the maximum of %d terms per shard", d.maxTerms)}
+	}
+	return nil
+}
+
+var errDropped = errors.New("fuzzy term dictionary was dropped while it was loaded")
+
+// boundsError is returned if a dictionary exceeds the maximum terms or the
+// available memory
+type boundsError struct {
+	err error
+}
+
+func (e *boundsError) Error() string {
+	return e.err.Error()
+}
+
+func (e *boundsError) Unwrap() error {
+	return e.err
+}
+
+// fail drops a dictionary that could not be loaded or written to. Exceeding
+// the bounds is remembered, other errors such as a missing bucket are not.
+func (d *Dictionaries) fail(e *entry, err error) {
+	d.drop(e)
+
+	var be *boundsError
+	if !errors.As(err, &be) {
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	retryAfter := minRetryAfter
+	if f, ok := d.failures[e.propName]; ok {
+		retryAfter = min(2*f.retryAfter, maxRetryAfter)
+	}
+	d.failures[e.propName] = &failure{
+		err:        err,
+		retryAfter: retryAfter,
+		retryAt:    d.now().Add(retryAfter),
+	}
+}
+
+func (d *Dictionaries) drop(e *entry) {
+	d.Lock()
+	defer d.Unlock()
+
+	if d.entries[e.propName] == e {
+		delete(d.entries, e.propName)
+		d.terms -= e.terms
+	}
+}
@@ -0,0 +1,363 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package fuzzy
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+func bytesOf(terms ...string) [][]byte {
+	out := make([][]byte, len(terms))
+	for i, term := range terms {
+		out[i] = []byte(term)
+	}
+	return out
+}
+
+func TestDictionarySearch(t *testing.T) {
+	dict := NewDictionary()
+	dict.Add(bytesOf("weather", "whether", "feather", "leather", "heater",
+		"wetter", "water", "weathers", "sun", "über", "uber")...)
+	dict.Add([]byte("weather"))
+	assert.Equal(t, 11, dict.Len())
+
+	type testCase struct {
+		name     string
+		term     string
+		maxEdits int
+		expected []Match
+	}
+
+	testCases := []testCase{
+		{
+			name:     "exact",
+			term:     "weather",
+			maxEdits: 0,
+			expected: []Match{{Term: "weather", Distance: 0}},
+		},
+		{
+			name:     "missing term without edits",
+			term:     "wether",
+			maxEdits: 0,
+			expected: []Match{},
+		},
+		{
+			name:     "deletion",
+			term:     "wether",
+			maxEdits: 1,
+			expected: []Match{
+				{Term: "weather", Distance: 1},
+				{Term: "wetter", Distance: 1},
+				{Term: "whether", Distance: 1},
+			},
+		},
+		{
+			name:     "two edits",
+			term:     "wether",
+			maxEdits: 2,
+			expected: []Match{
+				{Term: "weather", Distance: 1},
+				{Term: "wetter", Distance: 1},
+				{Term: "whether", Distance: 1},
+				{Term: "feather", Distance: 2},
+				{Term: "leather", Distance: 2},
+				{Term: "water", Distance: 2},
+				{Term: "weathers", Distance: 2},
+			},
+		},
+		{
+			name:     "distance is capped",
+			term:     "wether",
+			maxEdits: 5,
+			expected: []Match{
+				{Term: "weather", Distance: 1},
+				{Term: "wetter", Distance: 1},
+				{Term: "whether", Distance: 1},
+				{Term: "feather", Distance: 2},
+				{Term: "leather", Distance: 2},
+				{Term: "water", Distance: 2},
+				{Term: "weathers", Distance: 2},
+			},
+		},
+		{
+			name:     "multi byte runes count as one edit",
+			term:     "uber",
+			maxEdits: 1,
+			expected: []Match{
+				{Term: "uber", Distance: 0},
+				{Term: "über", Distance: 1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			matches := dict.Search(tc.term, tc.maxEdits)
+			assert.ElementsMatch(t, tc.expected, matches)
+			// closest first
+			for i := 1; i < len(matches); i++ {
+				assert.LessOrEqual(t, matches[i-1].Distance, matches[i].Distance)
+			}
+		})
+	}
+}
+
+func TestDictionarySearchMaxExpansions(t *testing.T) {
+	dict := NewDictionary()
+	for i := 0; i < 2*MaxExpansions; i++ {
+		dict.Add([]byte(fmt.Sprintf("term%c", rune('a'+i%26)+rune(i/26)*26)))
+	}
+	dict.Add([]byte("term"))
+
+	matches := dict.Search("term", 1)
+	require.Len(t, matches, MaxExpansions)
+	assert.Equal(t, Match{Term: "term", Distance: 0}, matches[0])
+}
+
+func TestAutoEdits(t *testing.T) {
+	assert.Equal(t, 0, AutoEdits("tv"))
+	assert.Equal(t, 1, AutoEdits("sun"))
+	assert.Equal(t, 1, AutoEdits("water"))
+	assert.Equal(t, 2, AutoEdits("wether"))
+	assert.Equal(t, 1, AutoEdits("über"))
+}
+
+func TestDictionaries(t *testing.T) {
+	dicts := NewDictionaries(DefaultMaxTerms, nil)
+
+	t.Run("add before the dictionary is built", func(t *testing.T) {
+		dicts.Add("text", []byte("ignored"))
+	})
+
+	t.Run("dictionary is loaded once", func(t *testing.T) {
+		loads := 0
+		load := func(add func([]byte) error) error {
+			loads++
+			return addAll(add, "weather", "sun")
+		}
+
+		dict, err := dicts.Get("text", load)
+		require.NoError(t, err)
+		assert.Equal(t, 2, dict.Len())
+
+		again, err := dicts.Get("text", load)
+		require.NoError(t, err)
+		assert.Same(t, dict, again)
+		assert.Equal(t, 1, loads)
+	})
+
+	t.Run("add after the dictionary is built", func(t *testing.T) {
+		dicts.Add("text", []byte("rain"))
+
+		dict, err := dicts.Get("text", nil)
+		require.NoError(t, err)
+		assert.Equal(t, []Match{{Term: "rain", Distance: 1}}, dict.Search("rainn", 1))
+		assert.Empty(t, dict.Search("ignored", 0))
+	})
+
+	t.Run("failed load is retried", func(t *testing.T) {
+		_, err := dicts.Get("other", func(func([]byte) error) error { return errors.New("no bucket") })
+		require.EqualError(t, err, "no bucket")
+
+		dict, err := dicts.Get("other", func(add func([]byte) error) error {
+			return add([]byte("snow"))
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, dict.Len())
+	})
+
+	t.Run("drop", func(t *testing.T) {
+		dicts.Drop("other")
+		dicts.Drop("missing")
+
+		loaded := false
+		_, err := dicts.Get("other", func(add func([]byte) error) error {
+			loaded = true
+			return nil
+		})
+		require.NoError(t, err)
+		assert.True(t, loaded)
+	})
+
+	t.Run("nil dictionaries", func(t *testing.T) {
+		var nilDicts *Dictionaries
+		nilDicts.Add("text", []byte("rain"))
+		nilDicts.Drop("text")
+		nilDicts.Clear()
+	})
+}
+
+func TestDictionariesBounds(t *testing.T) {
+	t.Run("load exceeding the maximum terms fails", func(t *testing.T) {
+		dicts := NewDictionaries(3, nil)
+		_, err := dicts.Get("a", func(add func([]byte) error) error {
+			return addAll(add, "one", "two")
+		})
+		require.NoError(t, err)
+
+		_, err = dicts.Get("b", func(add func([]byte) error) error {
+			return addAll(add, "three", "four")
+		})
+		require.ErrorContains(t, err, "maximum of 3 terms")
+
+		// the failure is remembered
+		_, err = dicts.Get("b", func(add func([]byte) error) error {
+			t.Fatal("failed dictionary must not be loaded again")
+			return nil
+		})
+		require.ErrorContains(t, err, "maximum of 3 terms")
+
+		// the terms of the failed dictionary are released
+		dicts.now = func() time.Time { return time.Now().Add(minRetryAfter) }
+		_, err = dicts.Get("b", func(add func([]byte) error) error {
+			return addAll(add, "three")
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("duplicate terms are counted once", func(t *testing.T) {
+		dicts := NewDictionaries(2, nil)
+		_, err := dicts.Get("a", func(add func([]byte) error) error {
+			return addAll(add, "one", "two", "one", "two")
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("writes exceeding the maximum terms drop the dictionary", func(t *testing.T) {
+		dicts := NewDictionaries(2, nil)
+		first, err := dicts.Get("a", func(add func([]byte) error) error {
+			return addAll(add, "one", "two")
+		})
+		require.NoError(t, err)
+
+		dicts.Add("a", []byte("three"))
+
+		_, err = dicts.Get("a", func(add func([]byte) error) error {
+			t.Fatal("failed dictionary must not be loaded again")
+			return nil
+		})
+		require.ErrorContains(t, err, "maximum of 2 terms")
+
+		dicts.now = func() time.Time { return time.Now().Add(minRetryAfter) }
+		second, err := dicts.Get("a", func(add func([]byte) error) error {
+			return addAll(add, "one")
+		})
+		require.NoError(t, err)
+		assert.NotSame(t, first, second)
+	})
+
+	t.Run("memory is checked before loading", func(t *testing.T) {
+		checker := &fakeAllocChecker{available: 2 * allocationChunk}
+		dicts := NewDictionaries(DefaultMaxTerms, checker)
+
+		_, err := dicts.Get("a", func(add func([]byte) error) error {
+			return addAll(add, "one", "two")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, checker.checks)
+
+		_, err = dicts.Get("b", func(add func([]byte) error) error {
+			for i := 0; ; i++ {
+				if err := add([]byte(fmt.Sprintf("term-%d", i))); err != nil {
+					return err
+				}
+			}
+		})
+		require.ErrorIs(t, err, memwatch.ErrNotEnoughMemory)
+
+		_, err = dicts.Get("b", nil)
+		require.ErrorIs(t, err, memwatch.ErrNotEnoughMemory)
+	})
+
+	t.Run("failures are retried with a growing backoff", func(t *testing.T) {
+		now := time.Now()
+		dicts := NewDictionaries(1, nil)
+		dicts.now = func() time.Time { return now }
+
+		loads := 0
+		tooLarge := func(add func([]byte) error) error {
+			loads++
+			return addAll(add, "one", "two")
+		}
+
+		for _, wait := range []time.Duration{minRetryAfter, 2 * minRetryAfter, 4 * minRetryAfter} {
+			_, err := dicts.Get("a", tooLarge)
+			require.ErrorContains(t, err, "maximum of 1 terms")
+			loads = 0
+
+			now = now.Add(wait - time.Second)
+			_, err = dicts.Get("a", tooLarge)
+			require.ErrorContains(t, err, "maximum of 1 terms")
+			assert.Equal(t, 0, loads)
+			now = now.Add(time.Second)
+		}
+
+		// dropping the property forgets the failure
+		_, err := dicts.Get("a", tooLarge)
+		require.Error(t, err)
+		dicts.Drop("a")
+		_, err = dicts.Get("a", func(add func([]byte) error) error {
+			return addAll(add, "one")
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		dicts := NewDictionaries(2, nil)
+		_, err := dicts.Get("a", func(add func([]byte) error) error {
+			return addAll(add, "one", "two")
+		})
+		require.NoError(t, err)
+
+		dicts.Clear()
+		_, err = dicts.Get("b", func(add func([]byte) error) error {
+			return addAll(add, "three", "four")
+		})
+		require.NoError(t, err)
+	})
+}
+
+func addAll(add func([]byte) error, terms ...string) error {
+	for _, term := range terms {
+		if err := add([]byte(term)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type fakeAllocChecker struct {
+	available int64
+	checks    int
+}
+
+func (c *fakeAllocChecker) CheckAlloc(sizeInBytes int64) error {
+	c.checks++
+	if sizeInBytes > c.available {
+		return memwatch.ErrNotEnoughMemory
+	}
+	c.available -= sizeInBytes
+	return nil
+}
+
+func (c *fakeAllocChecker) CheckMappingAndReserve(numberMappings int64, reservationTimeInS int) error {
+	return nil
+}
+
+func (c *fakeAllocChecker) Refresh(updateMappings bool) {}
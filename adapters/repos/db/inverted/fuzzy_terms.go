@@ -0,0 +1,104 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/fuzzy"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+// termDictionary returns the fuzzy term dictionary of a text property. The
+// dictionary is cached in dicts, without dicts it is built for the request.
+func termDictionary(store *lsmkv.Store, dicts *fuzzy.Dictionaries,
+	prop *models.Property,
+) (*fuzzy.Dictionary, error) {
+	load := func(add func(term []byte) error) error {
+		return loadTermDictionary(store, prop, add)
+	}
+	if dicts == nil {
+		dict := fuzzy.NewDictionary()
+		return dict, load(func(term []byte) error {
+			dict.Add(term)
+			return nil
+		})
+	}
+	return dicts.Get(prop.Name, load)
+}
+
+// loadTermDictionary passes the keys of the searchable or filterable bucket
+// of the property to add. The dictionary is shared between requests, so
+// loading is not bound to the context of a single request.
+func loadTermDictionary(store *lsmkv.Store, prop *models.Property, add func(term []byte) error) error {
+	ctx := context.Background()
+
+	if HasSearchableIndex(prop) {
+		bucket := store.Bucket(helpers.BucketSearchableFromPropNameLSM(prop.Name))
+		if bucket == nil {
+			return fmt.Errorf("no bucket searchable for prop %q found", prop.Name)
+		}
+		// the values are needed to skip terms whose objects were all deleted,
+		// sorting them makes this independent of the shard version
+		c := bucket.MapCursor(lsmkv.MapListLegacySortingRequired())
+		defer c.Close()
+		for k, v := c.First(ctx); k != nil; k, v = c.Next(ctx) {
+			if len(v) == 0 {
+				continue
+			}
+			if err := add(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if HasFilterableIndex(prop) {
+		bucket := store.Bucket(helpers.BucketFromPropNameLSM(prop.Name))
+		if bucket == nil {
+			return fmt.Errorf("no bucket for prop %q found", prop.Name)
+		}
+		c := bucket.CursorRoaringSetKeyOnly()
+		defer c.Close()
+		// keys whose bitmaps are empty are skipped by the cursor
+		for k, _ := c.First(); k != nil; k, _ = c.Next() {
+			if err := add(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return fmt.Errorf("fuzzy matching requires a searchable or filterable index, "+
+		"property %q has neither", prop.Name)
+}
+
+// fuzzyTerms returns the terms of the property within maxEdits of term,
+// including term itself if it is indexed
+func fuzzyTerms(store *lsmkv.Store, dicts *fuzzy.Dictionaries, prop *models.Property,
+	term string, maxEdits int,
+) ([]string, error) {
+	dict, err := termDictionary(store, dicts, prop)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := dict.Search(term, maxEdits)
+	terms := make([]string, len(matches))
+	for i, m := range matches {
+		terms[i] = m.Term
+	}
+	return terms, nil
+}
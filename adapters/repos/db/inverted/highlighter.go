@@ -0,0 +1,452 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/fuzzy"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+// Highlighter marks the query terms of a keyword search in the text
+// properties of its results. The text is split into spans at the same
+// boundaries the tokenizer of a property uses, a span is marked if it
+// analyzes to a query term of the property. Query terms are obtained as in
+// the bm25 search, including synonyms and fuzzy matches.
+type Highlighter struct {
+	opts  additional.Highlights
+	props []*highlightProp
+}
+
+type highlightProp struct {
+	name     string
+	split    func(string) []span
+	tokenize func(string) []string
+	// approximated is set if the spans are split at whitespace rather than
+	// at the boundaries of the tokenizer
+	approximated bool
+	terms        map[string]struct{}
+	// fuzzy matches the terms of the text against the query terms, nil if
+	// the query is not fuzzy
+	fuzzy     *fuzzy.Dictionary
+	fuzziness int
+}
+
+// span is a part of a text which is tokenized on its own, offsets are in
+// bytes and runes
+type span struct {
+	start, end         int
+	runeStart, runeEnd int
+	hit                bool
+}
+
+func NewHighlighter(class *models.Class, stopwordDetector stopwords.StopwordDetector,
+	synonymCache *synonyms.Cache, keywordRanking *searchparams.KeywordRanking,
+	opts additional.Highlights,
+) (*Highlighter, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	h := &Highlighter{opts: opts.WithDefaults()}
+	for _, propertyWithBoost := range keywordRanking.Properties {
+		propName := strings.Split(propertyWithBoost, "^")[0]
+		prop, err := schema.GetPropertyByName(class, propName)
+		if err != nil {
+			return nil, err
+		}
+		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+		default:
+			continue
+		}
+
+		hp, err := newHighlightProp(class, prop, stopwordDetector, synonymCache, keywordRanking)
+		if err != nil {
+			return nil, fmt.Errorf("highlights: %w", err)
+		}
+		h.props = append(h.props, hp)
+	}
+	return h, nil
+}
+
+func newHighlightProp(class *models.Class, prop *models.Property,
+	stopwordDetector stopwords.StopwordDetector, synonymCache *synonyms.Cache,
+	keywordRanking *searchparams.KeywordRanking,
+) (*highlightProp, error) {
+	pipeline, err := analysis.ForProperty(classTextAnalyzers(class), prop)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenization := prop.Tokenization
+	tokenize := tokenizerFunc(prop.Tokenization)
+	if pipeline != nil {
+		tokenization = pipeline.Tokenizer()
+		tokenize = pipeline.Analyze
+	}
+
+	queryTerms := tokenize(keywordRanking.Query)
+	// the bm25 search ignores class stopwords for word tokenized properties
+	// without an analyzer
+	if pipeline == nil && tokenization == models.PropertyTokenizationWord && stopwordDetector != nil {
+		filtered := queryTerms[:0]
+		for _, term := range queryTerms {
+			if !stopwordDetector.IsStopword(term) {
+				filtered = append(filtered, term)
+			}
+		}
+		queryTerms = filtered
+	}
+	queryTerms, _ = synonymExpander(synonymCache, class, termGroup(prop, pipeline), tokenize).
+		Expand(queryTerms, make([]int, len(queryTerms)))
+
+	split, approximated := spanSplitter(tokenization)
+	hp := &highlightProp{
+		name:         prop.Name,
+		split:        split,
+		tokenize:     tokenize,
+		approximated: approximated,
+		terms:        make(map[string]struct{}, len(queryTerms)),
+	}
+	for _, term := range queryTerms {
+		hp.terms[term] = struct{}{}
+	}
+	if keywordRanking.Fuzziness > 0 {
+		hp.fuzzy = fuzzy.NewDictionary()
+		for term := range hp.terms {
+			hp.fuzzy.Add([]byte(term))
+		}
+		hp.fuzziness = keywordRanking.Fuzziness
+	}
+	return hp, nil
+}
+
+// spanSplitter returns the function splitting a text into spans that are
+// tokenized on their own. Tokenizations which do not split at fixed
+// characters are approximated by splitting at whitespace, which is reported
+// by the second return value.
+func spanSplitter(tokenization string) (func(string) []span, bool) {
+	switch tokenization {
+	case models.PropertyTokenizationField:
+		return func(in string) []span {
+			trimmed := strings.TrimSpace(in)
+			if trimmed == "" {
+				return nil
+			}
+			start := len(in) - len(strings.TrimLeftFunc(in, unicode.IsSpace))
+			runeStart := utf8.RuneCountInString(in[:start])
+			return []span{{
+				start: start, end: start + len(trimmed),
+				runeStart: runeStart, runeEnd: runeStart + utf8.RuneCountInString(trimmed),
+			}}
+		}, false
+	case models.PropertyTokenizationWord, models.PropertyTokenizationTrigram:
+		return func(in string) []span {
+			return splitSpans(in, func(r rune) bool {
+				return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+			})
+		}, false
+	default:
+		return func(in string) []span {
+			return splitSpans(in, unicode.IsSpace)
+		}, true
+	}
+}
+
+// splitSpans works like strings.FieldsFunc, but returns the offsets of the
+// fields
+func splitSpans(in string, isSeparator func(rune) bool) []span {
+	var spans []span
+	current := -1
+	runes := 0
+	for i, r := range in {
+		if isSeparator(r) {
+			if current >= 0 {
+				spans[current].end = i
+				spans[current].runeEnd = runes
+				current = -1
+			}
+		} else if current < 0 {
+			spans = append(spans, span{start: i, runeStart: runes})
+			current = len(spans) - 1
+		}
+		runes++
+	}
+	if current >= 0 {
+		spans[current].end = len(in)
+		spans[current].runeEnd = runes
+	}
+	return spans
+}
+
+func (hp *highlightProp) matches(text string) bool {
+	for _, term := range hp.tokenize(text) {
+		if hp.matchesTerm(term) {
+			return true
+		}
+	}
+	return false
+}
+
+func (hp *highlightProp) matchesTerm(term string) bool {
+	if _, ok := hp.terms[term]; ok {
+		return true
+	}
+	return hp.fuzzy != nil && len(hp.fuzzy.Search(term, hp.fuzziness)) > 0
+}
+
+// hitSpans splits a span with a hit into the parts which match a query term
+// and the parts between them. A whitespace separated span of a tokenization
+// such as gse or kagome can hold a whole sentence of which only a few terms
+// match. Spans of a single term, and spans with a matched term that does not
+// occur in the text as it is, e.g. because it is stemmed, are kept whole.
+func (hp *highlightProp) hitSpans(text string, s span) []span {
+	sub := text[s.start:s.end]
+	distinct := map[string]struct{}{}
+	for _, term := range hp.tokenize(sub) {
+		distinct[term] = struct{}{}
+	}
+	if len(distinct) < 2 {
+		return []span{s}
+	}
+
+	var hits [][2]int
+	for term := range distinct {
+		if !hp.matchesTerm(term) {
+			continue
+		}
+		found := false
+		for offset := 0; offset < len(sub); {
+			start, end := indexFold(sub[offset:], term)
+			if start < 0 {
+				break
+			}
+			hits = append(hits, [2]int{offset + start, offset + end})
+			offset += end
+			found = true
+		}
+		if !found {
+			return []span{s}
+		}
+	}
+	if len(hits) == 0 {
+		return []span{s}
+	}
+	sort.Slice(hits, func(i, j int) bool { return hits[i][0] < hits[j][0] })
+	// overlapping terms, e.g. of gse, are marked as one
+	merged := hits[:1]
+	for _, h := range hits[1:] {
+		if last := &merged[len(merged)-1]; h[0] < last[1] {
+			last[1] = max(last[1], h[1])
+		} else {
+			merged = append(merged, h)
+		}
+	}
+
+	var spans []span
+	add := func(start, end int, hit bool) {
+		runeStart := s.runeStart + utf8.RuneCountInString(sub[:start])
+		spans = append(spans, span{
+			start: s.start + start, end: s.start + end,
+			runeStart: runeStart, runeEnd: runeStart + utf8.RuneCountInString(sub[start:end]),
+			hit: hit,
+		})
+	}
+	offset := 0
+	for _, h := range merged {
+		if h[0] > offset {
+			add(offset, h[0], false)
+		}
+		add(h[0], h[1], true)
+		offset = h[1]
+	}
+	if offset < len(sub) {
+		add(offset, len(sub), false)
+	}
+	return spans
+}
+
+// indexFold returns the byte offsets of the first occurrence of substr in s
+// under Unicode case folding, or -1 if there is none
+func indexFold(s, substr string) (int, int) {
+	runes := utf8.RuneCountInString(substr)
+	for start := range s {
+		end := start
+		for i := 0; i < runes && end < len(s); i++ {
+			_, size := utf8.DecodeRuneInString(s[end:])
+			end += size
+		}
+		if strings.EqualFold(s[start:end], substr) {
+			return start, end
+		}
+	}
+	return -1, -1
+}
+
+// Highlight returns the highlights of the searched properties of an object,
+// properties without a matched term are left out
+func (h *Highlighter) Highlight(properties map[string]interface{}) []additional.Highlight {
+	highlights := []additional.Highlight{}
+	for _, hp := range h.props {
+		var values []string
+		switch v := properties[hp.name].(type) {
+		case string:
+			values = []string{v}
+		case []string:
+			values = v
+		case []interface{}:
+			for _, elem := range v {
+				if s, ok := elem.(string); ok {
+					values = append(values, s)
+				}
+			}
+		}
+
+		var fragments []fragment
+		for _, value := range values {
+			fragments = append(fragments, h.fragments(hp, value)...)
+		}
+		if len(fragments) == 0 {
+			continue
+		}
+		for i := range fragments {
+			fragments[i].position = i
+		}
+
+		// keep the fragments with the most hits in the order of the text
+		sort.SliceStable(fragments, func(i, j int) bool {
+			return fragments[i].hits > fragments[j].hits
+		})
+		if len(fragments) > h.opts.MaxFragments {
+			fragments = fragments[:h.opts.MaxFragments]
+		}
+		sort.SliceStable(fragments, func(i, j int) bool {
+			return fragments[i].position < fragments[j].position
+		})
+
+		highlight := additional.Highlight{Property: hp.name, Fragments: make([]string, len(fragments))}
+		for i := range fragments {
+			highlight.Fragments[i] = fragments[i].text
+		}
+		highlights = append(highlights, highlight)
+	}
+	return highlights
+}
+
+type fragment struct {
+	text string
+	hits int
+	// position is the index of the fragment in the text of the property
+	position int
+}
+
+// fragments cuts the text into fragments around the spans with hits. A
+// fragment starts with some context before its first hit and is extended
+// with the following spans as long as it fits the fragment size. A span
+// longer than the fragment size, such as the value of a field tokenized
+// property, is cut at the fragment size.
+func (h *Highlighter) fragments(hp *highlightProp, text string) []fragment {
+	var spans []span
+	hasHits := false
+	for _, s := range hp.split(text) {
+		s.hit = hp.matches(text[s.start:s.end])
+		if s.hit && hp.approximated {
+			spans = append(spans, hp.hitSpans(text, s)...)
+		} else {
+			spans = append(spans, s)
+		}
+		hasHits = hasHits || s.hit
+	}
+	if !hasHits {
+		return nil
+	}
+
+	size := h.opts.FragmentSize
+	var fragments []fragment
+	next := 0 // the first span not part of a fragment yet
+	for i := 0; i < len(spans); i++ {
+		if !spans[i].hit {
+			continue
+		}
+
+		first := i
+		context := (size - (spans[i].runeEnd - spans[i].runeStart)) / 2
+		for first > next && spans[i].runeStart-spans[first-1].runeStart <= context {
+			first--
+		}
+		last := i
+		for last+1 < len(spans) && spans[last+1].runeEnd-spans[first].runeStart <= size {
+			last++
+		}
+
+		// separators following the spans, such as punctuation, are part of
+		// the fragment, as is the beginning of the text
+		start, end := 0, len(text)
+		if first > 0 {
+			start = spans[first].start
+		}
+		if last+1 < len(spans) {
+			end = spans[last+1].start
+		}
+		if limit := runeOffset(text, spans[first].start, size); spans[last].end > limit {
+			end = limit
+		}
+		fragments = append(fragments, markFragment(text, start, end, spans[first:last+1]))
+		next = last + 1
+		i = last
+	}
+	return fragments
+}
+
+func markFragment(text string, start, end int, spans []span) fragment {
+	var sb strings.Builder
+	hits := 0
+	offset := start
+	for _, s := range spans {
+		if !s.hit || s.start >= end {
+			continue
+		}
+		sb.WriteString(html.EscapeString(text[offset:s.start]))
+		sb.WriteString(additional.HighlightPreTag)
+		sb.WriteString(html.EscapeString(text[s.start:min(s.end, end)]))
+		sb.WriteString(additional.HighlightPostTag)
+		offset = min(s.end, end)
+		hits++
+	}
+	sb.WriteString(html.EscapeString(text[offset:end]))
+	return fragment{text: strings.TrimSpace(sb.String()), hits: hits}
+}
+
+// runeOffset returns the byte offset n runes after start, or the length of
+// the text if it is shorter
+func runeOffset(text string, start, n int) int {
+	for i := range text[start:] {
+		if n == 0 {
+			return start + i
+		}
+		n--
+	}
+	return len(text)
+}
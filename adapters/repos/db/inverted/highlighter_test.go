@@ -0,0 +1,205 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package inverted
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestHighlighter(t *testing.T) {
+	class := &models.Class{
+		Class: "Article",
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			Analyzers: []*models.TextAnalyzerConfig{{
+				Name:    "english",
+				Filters: []*models.TextAnalyzerFilter{{Type: analysis.FilterStemmer}},
+			}},
+			Synonyms: []*models.SynonymSet{{Synonyms: []string{"sofa", "couch"}}},
+		},
+		Properties: []*models.Property{
+			{Name: "title", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord},
+			{Name: "tags", DataType: schema.DataTypeTextArray.PropString(), Tokenization: models.PropertyTokenizationWord},
+			{Name: "code", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationField},
+			{Name: "raw", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWhitespace},
+			{Name: "body", DataType: schema.DataTypeText.PropString(), Tokenization: models.PropertyTokenizationWord, Analyzer: "english"},
+			{Name: "count", DataType: schema.DataTypeInt.PropString()},
+		},
+	}
+	detector, err := stopwords.NewDetectorFromPreset(stopwords.EnglishPreset)
+	require.Nil(t, err)
+
+	highlight := func(t *testing.T, kwr searchparams.KeywordRanking, opts additional.Highlights,
+		props map[string]interface{},
+	) []additional.Highlight {
+		h, err := NewHighlighter(class, detector, nil, &kwr, opts)
+		require.Nil(t, err)
+		return h.Highlight(props)
+	}
+
+	t.Run("word tokenization", func(t *testing.T) {
+		res := highlight(t, searchparams.KeywordRanking{Query: "the weather forecast", Properties: []string{"title^2"}},
+			additional.Highlights{}, map[string]interface{}{"title": "The weather today: sunny. Forecast: Weather-proof!"})
+		assert.Equal(t, []additional.Highlight{{
+			Property:  "title",
+			Fragments: []string{"The <em>weather</em> today: sunny. <em>Forecast</em>: <em>Weather</em>-proof!"},
+		}}, res)
+	})
+
+	t.Run("whitespace tokenization keeps punctuation", func(t *testing.T) {
+		res := highlight(t, searchparams.KeywordRanking{Query: "Weather", Properties: []string{"raw"}},
+			additional.Highlights{}, map[string]interface{}{"raw": "Weather, weather and Weather"})
+		assert.Equal(t, []additional.Highlight{{
+			Property:  "raw",
+			Fragments: []string{"Weather, weather and <em>Weather</em>"},
+		}}, res)
+	})
+
+	t.Run("field tokenization", func(t *testing.T) {
+		kwr := searchparams.KeywordRanking{Query: "ABC-123", Properties: []string{"code"}}
+		res := highlight(t, kwr, additional.Highlights{}, map[string]interface{}{"code": " ABC-123 "})
+		assert.Equal(t, []additional.Highlight{{Property: "code", Fragments: []string{"<em>ABC-123</em>"}}}, res)
+
+		res = highlight(t, kwr, additional.Highlights{}, map[string]interface{}{"code": "ABC-1234"})
+		assert.Empty(t, res)
+
+		// values longer than the fragment size are cut
+		res = highlight(t, kwr, additional.Highlights{FragmentSize: 4}, map[string]interface{}{"code": "ABC-123"})
+		assert.Equal(t, []additional.Highlight{{Property: "code", Fragments: []string{"<em>ABC-</em>"}}}, res)
+	})
+
+	t.Run("analyzer", func(t *testing.T) {
+		res := highlight(t, searchparams.KeywordRanking{Query: "running", Properties: []string{"body"}},
+			additional.Highlights{}, map[string]interface{}{"body": "She runs daily, running is her hobby"})
+		assert.Equal(t, []additional.Highlight{{
+			Property:  "body",
+			Fragments: []string{"She <em>runs</em> daily, <em>running</em> is her hobby"},
+		}}, res)
+	})
+
+	t.Run("synonyms", func(t *testing.T) {
+		res := highlight(t, searchparams.KeywordRanking{Query: "sofa", Properties: []string{"title"}},
+			additional.Highlights{}, map[string]interface{}{"title": "A red couch"})
+		assert.Equal(t, []additional.Highlight{{Property: "title", Fragments: []string{"A red <em>couch</em>"}}}, res)
+	})
+
+	t.Run("fuzzy", func(t *testing.T) {
+		res := highlight(t, searchparams.KeywordRanking{Query: "wether", Properties: []string{"title"}, Fuzziness: 1},
+			additional.Highlights{}, map[string]interface{}{"title": "Sunny weather, whatever"})
+		assert.Equal(t, []additional.Highlight{{Property: "title", Fragments: []string{"Sunny <em>weather</em>, whatever"}}}, res)
+	})
+
+	t.Run("stored text is escaped", func(t *testing.T) {
+		res := highlight(t, searchparams.KeywordRanking{Query: "weather", Properties: []string{"title"}},
+			additional.Highlights{}, map[string]interface{}{"title": `<b>Weather</b> & "sun"`})
+		assert.Equal(t, []additional.Highlight{{
+			Property:  "title",
+			Fragments: []string{"&lt;b&gt;<em>Weather</em>&lt;/b&gt; &amp; &#34;sun&#34;"},
+		}}, res)
+	})
+
+	t.Run("text array and properties without hits", func(t *testing.T) {
+		res := highlight(t, searchparams.KeywordRanking{Query: "go", Properties: []string{"title", "tags", "count"}},
+			additional.Highlights{}, map[string]interface{}{
+				"title": "Rust",
+				"tags":  []interface{}{"go", "rust", "golang go"},
+				"count": 3,
+			})
+		assert.Equal(t, []additional.Highlight{{
+			Property:  "tags",
+			Fragments: []string{"<em>go</em>", "golang <em>go</em>"},
+		}}, res)
+	})
+
+	t.Run("fragments", func(t *testing.T) {
+		text := "alpha beta gamma delta epsilon zeta eta theta iota kappa lambda mu nu xi omicron pi rho sigma tau"
+		kwr := searchparams.KeywordRanking{Query: "delta kappa tau rho", Properties: []string{"title"}}
+
+		res := highlight(t, kwr, additional.Highlights{FragmentSize: 20}, map[string]interface{}{"title": text})
+		assert.Equal(t, []additional.Highlight{{
+			Property: "title",
+			Fragments: []string{
+				"gamma <em>delta</em> epsilon",
+				"iota <em>kappa</em> lambda mu",
+				"pi <em>rho</em> sigma <em>tau</em>",
+			},
+		}}, res)
+
+		// punctuation following the spans and at the beginning of the text is kept
+		res = highlight(t, kwr, additional.Highlights{FragmentSize: 20},
+			map[string]interface{}{"title": "(delta), epsilon! zeta eta theta iota kappa."})
+		assert.Equal(t, []additional.Highlight{{
+			Property:  "title",
+			Fragments: []string{"(<em>delta</em>), epsilon!", "iota <em>kappa</em>."},
+		}}, res)
+
+		// the fragments with the most hits are kept in the order of the text
+		res = highlight(t, kwr, additional.Highlights{FragmentSize: 20, MaxFragments: 2}, map[string]interface{}{"title": text})
+		assert.Equal(t, []additional.Highlight{{
+			Property: "title",
+			Fragments: []string{
+				"gamma <em>delta</em> epsilon",
+				"pi <em>rho</em> sigma <em>tau</em>",
+			},
+		}}, res)
+	})
+
+	t.Run("tokenization approximated by whitespace", func(t *testing.T) {
+		// cuts a text into all words of a dictionary it contains, like gse
+		dictionary := []string{"我", "喜欢", "北京", "天安", "天安门", "安门"}
+		split, approximated := spanSplitter(models.PropertyTokenizationGse)
+		require.True(t, approximated)
+		hp := &highlightProp{
+			split:        split,
+			approximated: approximated,
+			tokenize: func(in string) []string {
+				var terms []string
+				for _, word := range dictionary {
+					if strings.Contains(in, word) {
+						terms = append(terms, word)
+					}
+				}
+				return terms
+			},
+			terms: map[string]struct{}{"北京": {}, "天安": {}, "天安门": {}},
+		}
+		fragmentTexts := func(size int, text string) []string {
+			h := &Highlighter{opts: additional.Highlights{FragmentSize: size}.WithDefaults()}
+			var texts []string
+			for _, f := range h.fragments(hp, text) {
+				texts = append(texts, f.text)
+			}
+			return texts
+		}
+
+		// only the matched terms of a span are marked, overlapping ones as one
+		assert.Equal(t, []string{"我喜欢<em>北京</em><em>天安门</em>。 你呢"}, fragmentTexts(100, "我喜欢北京天安门。 你呢"))
+
+		hp.terms = map[string]struct{}{"北京": {}}
+		assert.Equal(t, []string{"<em>北京</em>天安门"}, fragmentTexts(6, "我喜欢北京天安门"))
+	})
+
+	t.Run("invalid options", func(t *testing.T) {
+		_, err := NewHighlighter(class, detector, nil, &searchparams.KeywordRanking{Query: "go"},
+			additional.Highlights{FragmentSize: -1})
+		require.ErrorContains(t, err, "fragmentSize must not be negative")
+	})
+}
@@ -208,6 +208,7 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 	hasFilterableIndex := HasFilterableIndex(prop)
 	hasSearchableIndex := HasSearchableIndex(prop)
 	hasRangeableIndex := HasRangeableIndex(prop)
+	hasPositionIndex := HasPositionIndex(prop)
 
 	switch dt := schema.DataType(prop.DataType[0]); dt {
 	case schema.DataTypeTextArray:
@@ -216,7 +217,15 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		if err != nil {
 			return nil, err
 		}
-		items = a.TextArray(prop.Tokenization, in)
+		tokenize, err := a.tokenizerFor(prop)
+		if err != nil {
+			return nil, err
+		}
+		if hasPositionIndex {
+			items = a.textArrayPositions(tokenize, in)
+		} else {
+			items = a.textArray(tokenize, in)
+		}
 	case schema.DataTypeIntArray:
 		in := make([]int64, len(values))
 		for i, value := range values {
@@ -329,6 +338,7 @@ func (a *Analyzer) analyzeArrayProp(prop *models.Property, values []any) (*Prope
 		HasFilterableIndex: hasFilterableIndex,
 		HasSearchableIndex: hasSearchableIndex,
 		HasRangeableIndex:  hasRangeableIndex,
+		HasPositionIndex:   hasPositionIndex,
 	}, nil
 }
 
@@ -350,6 +360,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 	hasFilterableIndex := HasFilterableIndex(prop)
 	hasSearchableIndex := HasSearchableIndex(prop)
 	hasRangeableIndex := HasRangeableIndex(prop)
+	hasPositionIndex := HasPositionIndex(prop)
 
 	switch dt := schema.DataType(prop.DataType[0]); dt {
 	case schema.DataTypeText:
@@ -358,7 +369,15 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(prop.Tokenization, asString)
+		tokenize, err := a.tokenizerFor(prop)
+		if err != nil {
+			return nil, err
+		}
+		if hasPositionIndex {
+			items = a.textArrayPositions(tokenize, []string{asString})
+		} else {
+			items = a.textArray(tokenize, []string{asString})
+		}
 		propertyLength = utf8.RuneCountInString(asString)
 	case schema.DataTypeInt:
 		if asFloat, ok := value.(float64); ok {
@@ -454,6 +473,7 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value any) (*Prop
 		HasFilterableIndex: hasFilterableIndex,
 		HasSearchableIndex: hasSearchableIndex,
 		HasRangeableIndex:  hasRangeableIndex,
+		HasPositionIndex:   hasPositionIndex,
 	}, nil
 }
 
@@ -612,6 +632,17 @@ func HasRangeableIndex(prop *models.Property) bool {
 	}
 }
 
+// Indicates whether property should store token positions
+// Index holds document ids with property containing particular value
+// and positions of its occurrences in that property
+// (index created using bucket of StrategyMapCollection)
+func HasPositionIndex(prop *models.Property) bool {
+	if prop.IndexPositions == nil || !*prop.IndexPositions {
+		return false
+	}
+	return HasSearchableIndex(prop)
+}
+
 func HasAnyInvertedIndex(prop *models.Property) bool {
 	return HasFilterableIndex(prop) || HasSearchableIndex(prop) || HasRangeableIndex(prop)
 }
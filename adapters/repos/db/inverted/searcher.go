@@ -24,7 +24,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/sroar"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/fuzzy"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/synonyms"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
@@ -51,6 +54,10 @@ type Searcher struct {
 	// nestedCrossRefLimit limits the number of nested cross refs returned for a query
 	nestedCrossRefLimit int64
 	bitmapFactory       *roaringset.BitmapFactory
+	// termDictionaries serve fuzzy filters, optional
+	termDictionaries *fuzzy.Dictionaries
+	// synonyms caches the synonym expanders of the class, optional
+	synonyms *synonyms.Cache
 }
 
 func NewSearcher(logger logrus.FieldLogger, store *lsmkv.Store,
@@ -74,6 +81,20 @@ func NewSearcher(logger logrus.FieldLogger, store *lsmkv.Store,
 	}
 }
 
+// WithTermDictionaries sets the cached term dictionaries of the shard used
+// by fuzzy filters. Without them each fuzzy filter builds its dictionaries.
+func (s *Searcher) WithTermDictionaries(dicts *fuzzy.Dictionaries) *Searcher {
+	s.termDictionaries = dicts
+	return s
+}
+
+// WithSynonyms sets the synonym expanders of the index. Without them each
+// filter builds its expander from the synonym sets of the class.
+func (s *Searcher) WithSynonyms(cache *synonyms.Cache) *Searcher {
+	s.synonyms = cache
+	return s
+}
+
 // Objects returns a list of full objects
 func (s *Searcher) Objects(ctx context.Context, limit int,
 	filter *filters.LocalFilter, sort []filters.Sort, additional additional.Properties,
@@ -570,13 +591,23 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 		return nil, fmt.Errorf("expected value to be string, got '%T'", value)
 	}
 
+	pipeline, err := analysis.ForProperty(classTextAnalyzers(class), prop)
+	if err != nil {
+		return nil, err
+	}
+
 	switch propType {
 	case schema.DataTypeText:
 		// if the operator is like, we cannot apply the regular text-splitting
 		// logic as it would remove all wildcard symbols
-		if operator == filters.OperatorLike {
+		switch {
+		case pipeline != nil && operator == filters.OperatorLike:
+			terms = pipeline.AnalyzeWithWildcards(valueString)
+		case pipeline != nil:
+			terms = pipeline.Analyze(valueString)
+		case operator == filters.OperatorLike:
 			terms = helpers.TokenizeWithWildcards(prop.Tokenization, valueString)
-		} else {
+		default:
 			terms = helpers.Tokenize(prop.Tokenization, valueString)
 		}
 	default:
@@ -591,19 +622,61 @@ func (s *Searcher) extractTokenizableProp(prop *models.Property, propType schema
 		return nil, inverted.NewMissingFilterableIndexError(prop.Name)
 	}
 
-	propValuePairs := make([]*propValuePair, 0, len(terms))
-	for _, term := range terms {
-		if s.stopwords.IsStopword(term) {
-			continue
+	// equal filters also match the synonyms of their terms
+	var expander *synonyms.Expander
+	if operator == filters.OperatorEqual {
+		tokenize := tokenizerFunc(prop.Tokenization)
+		if pipeline != nil {
+			tokenize = pipeline.Analyze
 		}
-		propValuePairs = append(propValuePairs, &propValuePair{
+		expander = synonymExpander(s.synonyms, class, termGroup(prop, pipeline), tokenize)
+	}
+
+	// fuzzy filters match each term as an equal filter on its indexed
+	// variants within the edit distance allowed for the term
+	pairOperator := operator
+	if operator == filters.OperatorFuzzy {
+		pairOperator = filters.OperatorEqual
+	}
+
+	newPair := func(term string) *propValuePair {
+		return &propValuePair{
 			value:              []byte(term),
 			prop:               prop.Name,
-			operator:           operator,
+			operator:           pairOperator,
 			hasFilterableIndex: hasFilterableIndex,
 			hasSearchableIndex: hasSearchableIndex,
 			hasRangeableIndex:  hasRangeableIndex,
 			Class:              class,
+		}
+	}
+
+	propValuePairs := make([]*propValuePair, 0, len(terms))
+	for _, term := range terms {
+		// analyzers remove their own stopwords
+		if pipeline == nil && s.stopwords.IsStopword(term) {
+			continue
+		}
+		alternatives := expander.Synonyms(term)
+		if operator == filters.OperatorFuzzy {
+			alternatives, err = fuzzyTerms(s.store, s.termDictionaries, prop, term, fuzzy.AutoEdits(term))
+			if err != nil {
+				return nil, err
+			}
+		}
+		children := make([]*propValuePair, 0, len(alternatives)+1)
+		children = append(children, newPair(term))
+		for _, alternative := range alternatives {
+			if alternative != term {
+				children = append(children, newPair(alternative))
+			}
+		}
+		if len(children) == 1 {
+			propValuePairs = append(propValuePairs, children[0])
+			continue
+		}
+		propValuePairs = append(propValuePairs, &propValuePair{
+			operator: filters.OperatorOr, children: children, Class: class,
 		})
 	}
 
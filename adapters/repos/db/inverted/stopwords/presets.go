@@ -12,8 +12,14 @@
 package stopwords
 
 const (
-	EnglishPreset = "en"
-	NoPreset      = "none"
+	EnglishPreset    = "en"
+	GermanPreset     = "de"
+	FrenchPreset     = "fr"
+	SpanishPreset    = "es"
+	ItalianPreset    = "it"
+	PortuguesePreset = "pt"
+	DutchPreset      = "nl"
+	NoPreset         = "none"
 )
 
 var Presets = map[string][]string{
@@ -23,5 +29,78 @@ var Presets = map[string][]string{
 		"the", "their", "then", "there", "these", "they", "this", "to", "was", "will",
 		"with",
 	},
+	GermanPreset: {
+		"aber", "als", "am", "an", "auch", "auf", "aus", "bei", "bin", "bis",
+		"bist", "da", "dadurch", "daher", "darum", "das", "dass", "daß", "dein", "deine",
+		"dem", "den", "der", "des", "dessen", "deshalb", "die", "dies", "dieser", "dieses",
+		"doch", "dort", "du", "durch", "ein", "eine", "einem", "einen", "einer", "eines",
+		"er", "es", "euer", "eure", "für", "hatte", "hatten", "hattest", "hattet", "hier",
+		"hinter", "ich", "ihr", "ihre", "im", "in", "ist", "ja", "jede", "jedem",
+		"jeden", "jeder", "jedes", "jener", "jenes", "jetzt", "kann", "kannst", "können", "könnt",
+		"machen", "mein", "meine", "mit", "muß", "mußt", "musst", "müssen", "müßt", "nach",
+		"nachdem", "nein", "nicht", "nun", "oder", "seid", "sein", "seine", "sich", "sie",
+		"sind", "soll", "sollen", "sollst", "sollt", "sonst", "soweit", "sowie", "und", "unser",
+		"unsere", "unter", "vom", "von", "vor", "wann", "warum", "was", "weiter", "weitere",
+		"wenn", "wer", "werde", "werden", "werdet", "weshalb", "wie", "wieder", "wieso", "wir",
+		"wird", "wirst", "wo", "woher", "wohin", "zu", "zum", "zur", "über",
+	},
+	FrenchPreset: {
+		"a", "au", "aux", "avec", "ce", "ces", "dans", "de", "des", "du",
+		"elle", "en", "et", "eux", "il", "je", "la", "le", "les", "leur",
+		"lui", "ma", "mais", "me", "même", "mes", "moi", "mon", "ne", "nos",
+		"notre", "nous", "on", "ou", "par", "pas", "pour", "qu", "que", "qui",
+		"sa", "se", "ses", "son", "sur", "ta", "te", "tes", "toi", "ton",
+		"tu", "un", "une", "vos", "votre", "vous", "c", "d", "j", "l",
+		"à", "m", "n", "s", "t", "y", "été", "étée", "étées", "étés",
+		"est", "sont", "ai", "as", "avons", "avez", "ont", "suis", "es", "sommes",
+		"êtes",
+	},
+	SpanishPreset: {
+		"de", "la", "que", "el", "en", "y", "a", "los", "del", "se",
+		"las", "por", "un", "para", "con", "no", "una", "su", "al", "lo",
+		"como", "más", "pero", "sus", "le", "ya", "o", "este", "sí", "porque",
+		"esta", "entre", "cuando", "muy", "sin", "sobre", "también", "me", "hasta", "hay",
+		"donde", "quien", "desde", "todo", "nos", "durante", "todos", "uno", "les", "ni",
+		"contra", "otros", "ese", "eso", "ante", "ellos", "e", "esto", "mí", "antes",
+		"algunos", "qué", "unos", "yo", "otro", "otras", "otra", "él", "tanto", "esa",
+		"estos", "mucho", "quienes", "nada", "muchos", "cual", "poco", "ella", "estar", "estas",
+		"es", "son", "fue", "era", "ser",
+	},
+	ItalianPreset: {
+		"a", "ad", "al", "allo", "ai", "agli", "all", "alla", "alle", "con",
+		"col", "coi", "da", "dal", "dallo", "dai", "dagli", "dall", "dalla", "dalle",
+		"di", "del", "dello", "dei", "degli", "dell", "della", "delle", "in", "nel",
+		"nello", "nei", "negli", "nell", "nella", "nelle", "su", "sul", "sullo", "sui",
+		"sugli", "sull", "sulla", "sulle", "per", "tra", "contro", "io", "tu", "lui",
+		"lei", "noi", "voi", "loro", "mio", "mia", "miei", "mie", "tuo", "tua",
+		"suo", "sua", "il", "lo", "la", "i", "gli", "le", "un", "uno",
+		"una", "ma", "ed", "se", "perché", "anche", "come", "dov", "dove", "che",
+		"chi", "cui", "non", "più", "quale", "quanto", "quello", "questo", "e", "è",
+		"sono", "era", "o",
+	},
+	PortuguesePreset: {
+		"a", "à", "ao", "aos", "as", "às", "com", "como", "da", "das",
+		"de", "dela", "delas", "dele", "deles", "do", "dos", "e", "é", "ela",
+		"elas", "ele", "eles", "em", "entre", "era", "essa", "essas", "esse", "esses",
+		"esta", "estas", "este", "estes", "eu", "foi", "há", "isso", "isto", "já",
+		"lhe", "mais", "mas", "me", "mesmo", "meu", "minha", "muito", "na", "nas",
+		"não", "nem", "no", "nos", "nós", "num", "numa", "o", "os", "ou",
+		"para", "pela", "pelas", "pelo", "pelos", "por", "qual", "quando", "que", "quem",
+		"se", "sem", "ser", "seu", "sua", "são", "só", "também", "te", "tem",
+		"um", "uma", "você",
+	},
+	DutchPreset: {
+		"aan", "al", "alles", "als", "altijd", "andere", "ben", "bij", "daar", "dan",
+		"dat", "de", "der", "deze", "die", "dit", "doch", "doen", "door", "dus",
+		"een", "eens", "en", "er", "ge", "geen", "geweest", "haar", "had", "heb",
+		"hebben", "heeft", "hem", "het", "hier", "hij", "hoe", "hun", "iemand", "iets",
+		"ik", "in", "is", "ja", "je", "kan", "kon", "kunnen", "maar", "me",
+		"meer", "men", "met", "mij", "mijn", "moet", "na", "naar", "niet", "niets",
+		"nog", "nu", "of", "om", "omdat", "onder", "ons", "ook", "op", "over",
+		"reeds", "te", "tegen", "toch", "toen", "tot", "u", "uit", "uw", "van",
+		"veel", "voor", "want", "waren", "was", "wat", "werd", "wezen", "wie", "wil",
+		"worden", "wordt", "zal", "ze", "zelf", "zich", "zij", "zijn", "zo", "zonder",
+		"zou",
+	},
 	NoPreset: {},
 }
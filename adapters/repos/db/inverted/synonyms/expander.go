@@ -0,0 +1,219 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// Package synonyms expands the terms of keyword queries and text filters with
+// the synonym sets of a class. Synonyms are applied at query time only, so
+// they can be changed without reindexing.
+package synonyms
+
+import (
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+const (
+	TypeEquivalent = "equivalent"
+	TypeOneWay     = "oneWay"
+)
+
+// Validate checks the synonym sets of a class
+func Validate(sets []*models.SynonymSet) error {
+	for i, set := range sets {
+		if set == nil {
+			return errors.Errorf("synonyms.%d: synonym set is empty", i)
+		}
+		for _, term := range set.Synonyms {
+			if strings.TrimSpace(term) == "" {
+				return errors.Errorf("synonyms.%d: synonyms cannot be empty", i)
+			}
+			if err := validateSingleTerm(term); err != nil {
+				return errors.Wrapf(err, "synonyms.%d", i)
+			}
+		}
+
+		switch set.Type {
+		case "", TypeEquivalent:
+			if set.Input != "" {
+				return errors.Errorf("synonyms.%d: input can only be set for %q synonym sets", i, TypeOneWay)
+			}
+			if len(set.Synonyms) < 2 {
+				return errors.Errorf("synonyms.%d: %q synonym sets require at least two synonyms", i, TypeEquivalent)
+			}
+		case TypeOneWay:
+			if strings.TrimSpace(set.Input) == "" {
+				return errors.Errorf("synonyms.%d: %q synonym sets require an input", i, TypeOneWay)
+			}
+			if err := validateSingleTerm(set.Input); err != nil {
+				return errors.Wrapf(err, "synonyms.%d", i)
+			}
+			if len(set.Synonyms) < 1 {
+				return errors.Errorf("synonyms.%d: %q synonym sets require at least one synonym", i, TypeOneWay)
+			}
+		default:
+			return errors.Errorf("synonyms.%d: unknown synonym set type %q, options are %q, %q",
+				i, set.Type, TypeEquivalent, TypeOneWay)
+		}
+	}
+	return nil
+}
+
+// validateSingleTerm rejects entries of several words. Synonyms replace a
+// single query term, so "sofa bed" could never match.
+func validateSingleTerm(term string) error {
+	if strings.ContainsFunc(strings.TrimSpace(term), unicode.IsSpace) {
+		return errors.Errorf("%q consists of several words, synonyms can only be single terms", term)
+	}
+	return nil
+}
+
+// Cache holds the expanders built from the synonym sets of a class, one per
+// tokenizer the sets are applied with, so that they are not built for every
+// query. Update replaces the sets and drops the expanders built so far.
+type Cache struct {
+	sync.RWMutex
+	sets      []*models.SynonymSet
+	expanders map[string]*Expander
+}
+
+func NewCache(sets []*models.SynonymSet) *Cache {
+	return &Cache{sets: sets, expanders: map[string]*Expander{}}
+}
+
+// Update is called when the synonym sets of the class change
+func (c *Cache) Update(sets []*models.SynonymSet) {
+	c.Lock()
+	defer c.Unlock()
+	c.sets = sets
+	c.expanders = map[string]*Expander{}
+}
+
+// Expander returns the expander of the cached sets tokenized with tokenize,
+// which key identifies, e.g. the tokenization or the analyzer of a property
+func (c *Cache) Expander(key string, tokenize func(string) []string) *Expander {
+	c.RLock()
+	e, ok := c.expanders[key]
+	c.RUnlock()
+	if ok {
+		return e
+	}
+
+	c.Lock()
+	defer c.Unlock()
+	if e, ok := c.expanders[key]; ok {
+		return e
+	}
+	// nil, the result for sets without synonyms, is cached as well
+	e = NewExpander(c.sets, tokenize)
+	c.expanders[key] = e
+	return e
+}
+
+// Expander maps query terms to their synonyms
+type Expander struct {
+	synonyms map[string][]string
+}
+
+// NewExpander builds the synonyms of the given sets. Every entry of a set is
+// passed through tokenize, so that it matches the terms of the query. Entries
+// which do not result in exactly one term cannot match a single query term
+// and are skipped. Nil is returned if there are no synonyms.
+func NewExpander(sets []*models.SynonymSet, tokenize func(string) []string) *Expander {
+	term := func(in string) (string, bool) {
+		terms := tokenize(in)
+		if len(terms) != 1 {
+			return "", false
+		}
+		return terms[0], true
+	}
+
+	e := &Expander{synonyms: map[string][]string{}}
+	for _, set := range sets {
+		if set == nil {
+			continue
+		}
+		var terms []string
+		for _, synonym := range set.Synonyms {
+			if t, ok := term(synonym); ok {
+				terms = append(terms, t)
+			}
+		}
+
+		if set.Type == TypeOneWay {
+			if input, ok := term(set.Input); ok {
+				for _, t := range terms {
+					e.add(input, t)
+				}
+			}
+			continue
+		}
+		for _, from := range terms {
+			for _, to := range terms {
+				e.add(from, to)
+			}
+		}
+	}
+
+	if len(e.synonyms) == 0 {
+		return nil
+	}
+	return e
+}
+
+func (e *Expander) add(from, to string) {
+	if from == to {
+		return
+	}
+	for _, existing := range e.synonyms[from] {
+		if existing == to {
+			return
+		}
+	}
+	e.synonyms[from] = append(e.synonyms[from], to)
+}
+
+// Synonyms returns the synonyms of a term, not including the term itself
+func (e *Expander) Synonyms(term string) []string {
+	if e == nil {
+		return nil
+	}
+	return e.synonyms[term]
+}
+
+// Expand appends the synonyms of the query terms which are not part of the
+// query yet. A synonym gets the duplicate boost of the term it was added for.
+func (e *Expander) Expand(terms []string, boosts []int) ([]string, []int) {
+	if e == nil {
+		return terms, boosts
+	}
+
+	seen := make(map[string]struct{}, len(terms))
+	for _, term := range terms {
+		seen[term] = struct{}{}
+	}
+
+	expandedTerms := append([]string{}, terms...)
+	expandedBoosts := append([]int{}, boosts...)
+	for i, term := range terms {
+		for _, synonym := range e.synonyms[term] {
+			if _, ok := seen[synonym]; ok {
+				continue
+			}
+			seen[synonym] = struct{}{}
+			expandedTerms = append(expandedTerms, synonym)
+			expandedBoosts = append(expandedBoosts, boosts[i])
+		}
+	}
+	return expandedTerms, expandedBoosts
+}
@@ -0,0 +1,175 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package synonyms
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+)
+
+func TestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		sets        []*models.SynonymSet
+		expectedErr string
+	}
+
+	testCases := []testCase{
+		{
+			name: "valid",
+			sets: []*models.SynonymSet{
+				{Synonyms: []string{"sofa", "couch"}},
+				{Type: TypeEquivalent, Synonyms: []string{"tv", "television"}},
+				{Type: TypeOneWay, Input: "notebook", Synonyms: []string{"laptop"}},
+				{Synonyms: []string{" e-mail ", "email"}},
+			},
+		},
+		{
+			name:        "equivalent with a single synonym",
+			sets:        []*models.SynonymSet{{Synonyms: []string{"sofa"}}},
+			expectedErr: `synonyms.0: "equivalent" synonym sets require at least two synonyms`,
+		},
+		{
+			name:        "equivalent with input",
+			sets:        []*models.SynonymSet{{Input: "sofa", Synonyms: []string{"sofa", "couch"}}},
+			expectedErr: `synonyms.0: input can only be set for "oneWay" synonym sets`,
+		},
+		{
+			name:        "one way without input",
+			sets:        []*models.SynonymSet{{Type: TypeOneWay, Synonyms: []string{"laptop"}}},
+			expectedErr: `synonyms.0: "oneWay" synonym sets require an input`,
+		},
+		{
+			name:        "one way without synonyms",
+			sets:        []*models.SynonymSet{{Type: TypeOneWay, Input: "notebook"}},
+			expectedErr: `synonyms.0: "oneWay" synonym sets require at least one synonym`,
+		},
+		{
+			name:        "empty synonym",
+			sets:        []*models.SynonymSet{{Synonyms: []string{"sofa", "couch"}}, {Synonyms: []string{"tv", " "}}},
+			expectedErr: "synonyms.1: synonyms cannot be empty",
+		},
+		{
+			name:        "synonym of several words",
+			sets:        []*models.SynonymSet{{Synonyms: []string{"sofa bed", "daybed"}}},
+			expectedErr: `synonyms.0: "sofa bed" consists of several words`,
+		},
+		{
+			name:        "input of several words",
+			sets:        []*models.SynonymSet{{Type: TypeOneWay, Input: "note book", Synonyms: []string{"laptop"}}},
+			expectedErr: `synonyms.0: "note book" consists of several words`,
+		},
+		{
+			name:        "unknown type",
+			sets:        []*models.SynonymSet{{Type: "twoWay", Synonyms: []string{"sofa", "couch"}}},
+			expectedErr: `synonyms.0: unknown synonym set type "twoWay"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.sets)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestExpander(t *testing.T) {
+	tokenize := func(in string) []string {
+		return helpers.Tokenize(models.PropertyTokenizationWord, in)
+	}
+	sets := []*models.SynonymSet{
+		{Synonyms: []string{"Sofa", "couch", "settee"}},
+		{Type: TypeOneWay, Input: "notebook", Synonyms: []string{"laptop", "ultrabook"}},
+		{Synonyms: []string{"tv", "television", "flat-screen"}},
+	}
+
+	t.Run("no synonyms", func(t *testing.T) {
+		assert.Nil(t, NewExpander(nil, tokenize))
+
+		var e *Expander
+		terms, boosts := e.Expand([]string{"sofa"}, []int{1})
+		assert.Equal(t, []string{"sofa"}, terms)
+		assert.Equal(t, []int{1}, boosts)
+		assert.Empty(t, e.Synonyms("sofa"))
+	})
+
+	e := NewExpander(sets, tokenize)
+
+	t.Run("equivalent", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"couch", "settee"}, e.Synonyms("sofa"))
+		assert.ElementsMatch(t, []string{"sofa", "settee"}, e.Synonyms("couch"))
+	})
+
+	t.Run("one way", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"laptop", "ultrabook"}, e.Synonyms("notebook"))
+		assert.Empty(t, e.Synonyms("laptop"))
+	})
+
+	t.Run("entries with multiple tokens are skipped", func(t *testing.T) {
+		assert.ElementsMatch(t, []string{"television"}, e.Synonyms("tv"))
+		assert.Empty(t, e.Synonyms("flat"))
+	})
+
+	t.Run("expand query terms", func(t *testing.T) {
+		terms, boosts := e.Expand([]string{"red", "couch", "sofa"}, []int{1, 2, 1})
+		assert.Equal(t, []string{"red", "couch", "sofa", "settee"}, terms)
+		assert.Equal(t, []int{1, 2, 1, 2}, boosts)
+	})
+
+	t.Run("field tokenization", func(t *testing.T) {
+		e := NewExpander(sets, func(in string) []string {
+			return helpers.Tokenize(models.PropertyTokenizationField, in)
+		})
+		assert.ElementsMatch(t, []string{"tv", "television"}, e.Synonyms("flat-screen"))
+		assert.ElementsMatch(t, []string{"couch", "settee"}, e.Synonyms("Sofa"))
+		assert.Empty(t, e.Synonyms(strings.ToLower("Sofa")))
+	})
+}
+
+func TestCache(t *testing.T) {
+	built := 0
+	tokenize := func(in string) []string {
+		built++
+		return helpers.Tokenize(models.PropertyTokenizationWord, in)
+	}
+
+	c := NewCache([]*models.SynonymSet{{Synonyms: []string{"sofa", "couch"}}})
+	e := c.Expander("word", tokenize)
+	assert.Equal(t, []string{"couch"}, e.Synonyms("sofa"))
+	assert.Same(t, e, c.Expander("word", tokenize))
+	assert.Equal(t, 2, built, "the synonyms are only tokenized once")
+
+	t.Run("expanders are built per tokenizer", func(t *testing.T) {
+		field := c.Expander("field", func(in string) []string {
+			return helpers.Tokenize(models.PropertyTokenizationField, in)
+		})
+		assert.NotSame(t, e, field)
+		assert.Equal(t, []string{"couch"}, field.Synonyms("sofa"))
+	})
+
+	t.Run("update drops the expanders", func(t *testing.T) {
+		c.Update([]*models.SynonymSet{{Synonyms: []string{"sofa", "settee"}}})
+		assert.Equal(t, []string{"settee"}, c.Expander("word", tokenize).Synonyms("sofa"))
+
+		c.Update(nil)
+		assert.Nil(t, c.Expander("word", tokenize))
+	})
+}
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package terms
+
+import "math"
+
+// DotProductTerm is a posting list held in memory that scores documents by
+// the product of the query weight and the stored frequency instead of BM25.
+// It is used for sparse vectors, where the frequency is the quantized weight
+// of the dimension in the document.
+type DotProductTerm struct {
+	*Term
+	weight    float64
+	maxImpact float32
+}
+
+func NewDotProductTerm(queryTermIndex int, weight float32, data []DocPointerWithScore) *DotProductTerm {
+	maxFreq := float32(0)
+	for i := range data {
+		maxFreq = max(maxFreq, data[i].Frequency)
+	}
+
+	t := &DotProductTerm{
+		Term: &Term{
+			queryTermIndex: queryTermIndex,
+			propertyBoost:  1,
+			Data:           data,
+		},
+		weight:    float64(weight),
+		maxImpact: weight * maxFreq,
+	}
+	if len(data) == 0 {
+		t.exhausted = true
+		t.idPointer = math.MaxUint64
+	} else {
+		t.idPointer = data[0].Id
+	}
+	return t
+}
+
+func (t *DotProductTerm) Score(averagePropLength float64, additionalExplanations bool) (uint64, float64, *DocPointerWithScore) {
+	pair := t.Data[t.posPointer]
+	score := float64(pair.Frequency) * t.weight
+	if !additionalExplanations {
+		return t.idPointer, score, nil
+	}
+	return t.idPointer, score, &pair
+}
+
+func (t *DotProductTerm) Idf() float64 {
+	return float64(t.maxImpact)
+}
+
+func (t *DotProductTerm) CurrentBlockImpact() float32 {
+	return t.maxImpact
+}
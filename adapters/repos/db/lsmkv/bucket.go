@@ -87,6 +87,9 @@ type Bucket struct {
 	status     storagestate.Status
 	statusLock sync.RWMutex
 
+	// set while the parent shard is bulk loading, see startBulkLoad
+	bulkLoading bool
+
 	metrics *Metrics
 
 	// all "replace" buckets support counting through net additions, but not all
@@ -134,6 +137,28 @@ type Bucket struct {
 	// redundant obsolete data, that was deleted or updated in newer segments
 	// (currently supported only in buckets of REPLACE strategy)
 	segmentsCleanupInterval time.Duration
+
+	// optional block compression of segments on flush and compaction.
+	// Segments written before compression was enabled remain readable and are
+	// migrated gradually through compactions.
+	compression segmentindex.Compression
+
+	// which segment checksums are verified in addition to header and index,
+	// see WithSegmentChecksumVerification
+	checksumVerification checksumVerification
+
+	// new segments carry a checksum footer if set, see WithSegmentChecksums
+	writeChecksums bool
+
+	// corrupt segments may be moved aside on load, see WithSegmentQuarantine
+	quarantineSegments bool
+
+	// optional tiered storage for old segments, see WithTiering
+	tiering *TieringConfig
+
+	// optional node-wide coordination of compactions, see
+	// WithCompactionScheduler
+	compactionScheduler *CompactionScheduler
 }
 
 func NewBucketCreator() *Bucket { return &Bucket{} }
@@ -196,6 +221,12 @@ func (*Bucket) NewBucket(ctx context.Context, dir, rootDir string, logger logrus
 			calcCountNetAdditions: b.calcCountNetAdditions,
 			maxSegmentSize:        b.maxSegmentSize,
 			cleanupInterval:       b.segmentsCleanupInterval,
+			compression:           b.compression,
+			checksumVerification:  b.checksumVerification,
+			writeChecksums:        b.writeChecksums,
+			quarantineSegments:    b.quarantineSegments,
+			tiering:               b.tiering,
+			compactionScheduler:   b.compactionScheduler,
 		}, b.allocChecker)
 	if err != nil {
 		return nil, fmt.Errorf("init disk segments: %w", err)
@@ -993,6 +1024,11 @@ func (b *Bucket) setNewActiveMemtable() error {
 	if err != nil {
 		return err
 	}
+	mt.compression = b.compression
+	mt.writeChecksums = b.writeChecksums
+	if b.isBulkLoading() {
+		cl.pause()
+	}
 
 	b.active = mt
 	return nil
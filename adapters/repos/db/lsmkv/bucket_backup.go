@@ -68,13 +68,18 @@ func (b *Bucket) FlushMemtable() error {
 
 // ListFiles lists all files that currently exist in the Bucket. The files are only
 // in a stable state if the memtable is empty, and if compactions are paused. If one
-// of those conditions is not given, it errors
+// of those conditions is not given, it errors. Cold segments are listed as stubs,
+// their data is retained in the segment store from then on.
 func (b *Bucket) ListFiles(ctx context.Context, basePath string) ([]string, error) {
 	var (
 		bucketRoot = b.disk.dir
 		files      []string
 	)
 
+	if err := b.disk.retainColdSegments(); err != nil {
+		return nil, errors.Wrap(err, "retain cold segments")
+	}
+
 	err := filepath.WalkDir(bucketRoot, func(currPath string, d fs.DirEntry, err error) error {
 		if d.IsDir() {
 			return nil
@@ -83,6 +88,10 @@ func (b *Bucket) ListFiles(ctx context.Context, basePath string) ([]string, erro
 		if filepath.Ext(currPath) == ".wal" {
 			return nil
 		}
+		// corrupt files are not part of the bucket anymore
+		if filepath.Ext(currPath) == QuarantineSuffix {
+			return nil
+		}
 		files = append(files, path.Join(basePath, path.Base(currPath)))
 		return nil
 	})
@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// bulkLoadMergeRetryInterval is how long the merge of bulk loaded runs waits
+// before asking the compaction scheduler again
+var bulkLoadMergeRetryInterval = time.Second
+
+// startBulkLoad puts the bucket into bulk load mode. Writes are no longer
+// recorded in the WAL, the memtables act as sorted runs that are written
+// directly as segments once they reach the flush threshold, and compactions
+// are halted so that the runs are only merged once the load has finished,
+// see mergeBulkLoadRuns.
+//
+// Anything that is in the active memtable when the load is interrupted is
+// lost, it is the responsibility of the caller to restart the load in that
+// case.
+func (b *Bucket) startBulkLoad() error {
+	b.statusLock.Lock()
+	if b.bulkLoading {
+		b.statusLock.Unlock()
+		return nil
+	}
+	b.bulkLoading = true
+	b.statusLock.Unlock()
+
+	b.disk.startBulkLoadRuns()
+
+	b.flushLock.Lock()
+	defer b.flushLock.Unlock()
+
+	// whatever was written so far must still be recoverable
+	if err := b.active.commitlog.flushBuffers(); err != nil {
+		return err
+	}
+	b.active.commitlog.pause()
+	return nil
+}
+
+// finishBulkLoad leaves bulk load mode. The active memtable has no WAL and is
+// therefore flushed into a segment right away. Its replacement, as well as
+// every later memtable, is backed by a WAL again. The segments written during
+// the load are merged by mergeBulkLoad afterwards, regular compactions stay
+// halted until then.
+func (b *Bucket) finishBulkLoad() error {
+	b.statusLock.Lock()
+	if !b.bulkLoading {
+		b.statusLock.Unlock()
+		return nil
+	}
+	b.bulkLoading = false
+	b.statusLock.Unlock()
+
+	b.flushLock.RLock()
+	flush := b.active.Size() > 0
+	b.flushLock.RUnlock()
+
+	if flush {
+		if err := b.FlushAndSwitch(); err != nil {
+			return fmt.Errorf("flush bulk loaded memtable: %w", err)
+		}
+	} else {
+		b.flushLock.Lock()
+		b.active.commitlog.unpause()
+		b.flushLock.Unlock()
+	}
+
+	b.disk.endBulkLoadRuns()
+	return nil
+}
+
+// mergeBulkLoad merges the segments written during the bulk load and resumes
+// regular compactions. Memtables keep being flushed in the meantime, the
+// resulting segments are left to regular compactions.
+func (b *Bucket) mergeBulkLoad(ctx context.Context) error {
+	if err := b.disk.mergeBulkLoadRuns(ctx); err != nil {
+		return fmt.Errorf("merge bulk loaded segments: %w", err)
+	}
+
+	b.disk.updateBulkLoading(false)
+	return nil
+}
+
+// endBulkLoadRuns marks the segments that exist at this point as the last
+// run of the bulk load, anything flushed later is not part of the merge
+func (sg *SegmentGroup) endBulkLoadRuns() {
+	end := sg.Len()
+
+	sg.statusLock.Lock()
+	defer sg.statusLock.Unlock()
+
+	sg.bulkLoadEnd = end
+}
+
+// mergeBulkLoadRuns merges the segments that were written during the bulk
+// load. This is the merge phase of an external sort, the runs being the
+// memtables that were flushed during the load. Segments that existed before
+// the load are not touched. Adjacent runs are merged pairwise in rounds, so
+// every entry is rewritten about log2(runs) times, rather than once per run
+// as a sequence of merges into the same segment would. Pairs that would
+// exceed the max segment size are left as they are, so the load may end up in
+// more than one segment.
+//
+// Regular compactions must be halted, as positions of segments are assumed to
+// be stable other than new segments being appended.
+func (sg *SegmentGroup) mergeBulkLoadRuns(ctx context.Context) error {
+	sg.statusLock.Lock()
+	bulkLoading, first, end := sg.bulkLoading, sg.bulkLoadFirst, sg.bulkLoadEnd
+	sg.statusLock.Unlock()
+
+	if !bulkLoading {
+		return nil
+	}
+
+	for {
+		merged := false
+		for pos := first; pos+1 < end; pos++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			left, right := sg.segmentAtPos(pos), sg.segmentAtPos(pos+1)
+			if !sg.compactionFitsSizeLimit(left, right) {
+				continue
+			}
+
+			level := left.level
+			if right.level > level {
+				level = right.level
+			}
+			if err := sg.mergeBulkLoadPair(ctx, []int{pos, pos + 1}, level+1); err != nil {
+				return fmt.Errorf("merge segments at %d and %d: %w", pos, pos+1, err)
+			}
+			end--
+			merged = true
+		}
+		if !merged {
+			return nil
+		}
+	}
+}
+
+// mergeBulkLoadPair merges a pair of runs once the compaction scheduler, if
+// any, admits it. Unlike regular compactions, which simply try again on their
+// next cycle, the merge waits for its turn.
+func (sg *SegmentGroup) mergeBulkLoadPair(ctx context.Context, pair []int, level uint16) error {
+	var ticket *compactionTicket
+	if sg.compactionScheduler != nil {
+		left, right := sg.segmentAtPos(pair[0]), sg.segmentAtPos(pair[1])
+		req := compactionRequest{
+			dir:      sg.dir,
+			strategy: sg.strategy,
+			segments: sg.Len(),
+			size:     left.size + right.size,
+		}
+		for ticket = sg.compactionScheduler.tryAcquire(sg, req); ticket == nil; ticket = sg.compactionScheduler.tryAcquire(sg, req) {
+			select {
+			case <-ctx.Done():
+				sg.compactionScheduler.withdraw(sg)
+				return ctx.Err()
+			case <-time.After(bulkLoadMergeRetryInterval):
+			}
+		}
+		defer ticket.done()
+	}
+
+	_, err := sg.compactPair(pair, level, ticket)
+	return err
+}
+
+func (b *Bucket) isBulkLoading() bool {
+	b.statusLock.RLock()
+	defer b.statusLock.RUnlock()
+
+	return b.bulkLoading
+}
@@ -0,0 +1,128 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+// CreateDotProductTerms returns the posting lists of the given keys, grouped
+// by segment and followed by the flushing and the active memtable. The
+// documents of the lists are scored by the product of the weight of the key
+// and the frequency stored with the document, so that a block-max WAND search
+// over the terms of a group finds the documents with the highest dot product.
+//
+// Unlike CreateDiskTerm, documents deleted in a later segment or memtable are
+// already skipped by the terms. This requires all postings of a document to be
+// written together, which is the case for sparse vectors. Like CreateDiskTerm,
+// the maintenance lock is held for reading when this returns and must be
+// released by the caller once the search is done.
+func (b *Bucket) CreateDotProductTerms(keys [][]byte, weights []float32,
+	filterDocIds helpers.AllowList,
+) ([][]terms.TermInterface, *sync.RWMutex, error) {
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	lock := &b.disk.maintenanceLock
+	lock.RLock()
+
+	defer func() {
+		if r := recover(); r != nil {
+			b.logger.Errorf("Recovered from panic in CreateDotProductTerms: %v", r)
+			lock.RUnlock()
+		}
+	}()
+
+	segmentsDisk := b.disk.segments
+	memtables := []*Memtable{b.flushing, b.active}
+	output := make([][]terms.TermInterface, len(segmentsDisk)+len(memtables))
+
+	// tombstones[i] holds the documents deleted in layer i or any later one
+	tombstones := make([]*sroar.Bitmap, len(output))
+	var deleted *sroar.Bitmap
+	for i := len(output) - 1; i >= 0; i-- {
+		var layerTombstones *sroar.Bitmap
+		var err error
+		if i < len(segmentsDisk) {
+			layerTombstones, err = segmentsDisk[i].GetTombstones()
+		} else if mt := memtables[i-len(segmentsDisk)]; mt != nil {
+			layerTombstones, err = mt.GetTombstones()
+		}
+		if err != nil && !errors.Is(err, lsmkv.NotFound) {
+			return nil, lock, err
+		}
+		if layerTombstones != nil && !layerTombstones.IsEmpty() {
+			if deleted == nil {
+				deleted = layerTombstones.Clone()
+			} else {
+				deleted = sroar.Or(deleted, layerTombstones)
+			}
+		}
+		tombstones[i] = deleted
+	}
+
+	for j, segment := range segmentsDisk {
+		output[j] = make([]terms.TermInterface, 0, len(keys))
+		if segment.strategy != segmentindex.StrategyInverted {
+			continue
+		}
+		for i, key := range keys {
+			term := NewSegmentBlockMaxDotProduct(segment, key, i, weights[i], tombstones[j], filterDocIds)
+			if term != nil && !term.Exhausted() {
+				output[j] = append(output[j], term)
+			}
+		}
+	}
+
+	for m, mt := range memtables {
+		j := len(segmentsDisk) + m
+		output[j] = make([]terms.TermInterface, 0, len(keys))
+		if mt == nil {
+			continue
+		}
+		for i, key := range keys {
+			pairs, err := mt.getMap(key)
+			if err != nil && !errors.Is(err, lsmkv.NotFound) {
+				return nil, lock, err
+			}
+			data := make([]terms.DocPointerWithScore, 0, len(pairs))
+			for _, pair := range pairs {
+				if pair.Tombstone || len(pair.Value) < 8 {
+					continue
+				}
+				d := terms.DocPointerWithScore{}
+				if err := d.FromKeyVal(pair.Key, pair.Value, false, 1.0); err != nil {
+					return nil, lock, err
+				}
+				if tombstones[j] != nil && tombstones[j].Contains(d.Id) {
+					continue
+				}
+				if filterDocIds != nil && !filterDocIds.Contains(d.Id) {
+					continue
+				}
+				data = append(data, d)
+			}
+			if len(data) > 0 {
+				output[j] = append(output[j], terms.NewDotProductTerm(i, weights[i], data))
+			}
+		}
+	}
+
+	return output, lock, nil
+}
@@ -159,6 +159,88 @@ func WithSegmentsCleanupInterval(interval time.Duration) BucketOption {
 	}
 }
 
+// WithCompression enables block compression ("snappy" or "zstd") of the
+// segments written on flush and compaction. It only applies to the replace,
+// set and map strategies. Uncompressed segments remain readable, so
+// compression can be enabled on existing buckets.
+func WithCompression(compression string) BucketOption {
+	return func(b *Bucket) error {
+		c, err := SegmentCompressionFromString(compression)
+		if err != nil {
+			return err
+		}
+
+		b.compression = c
+		return nil
+	}
+}
+
+// WithSegmentChecksumVerification controls how much of the segment
+// checksums is verified. Header and index are always verified when a segment
+// is loaded. With onLoad, all data blocks are verified as well, which requires
+// reading the entire segment. With onRead, point reads verify the blocks they
+// touch before using them. Segments written before checksums were introduced
+// are never verified.
+func WithSegmentChecksumVerification(onLoad, onRead bool) BucketOption {
+	return func(b *Bucket) error {
+		b.checksumVerification = checksumVerification{onLoad: onLoad, onRead: onRead}
+		return nil
+	}
+}
+
+// WithSegmentChecksums adds a checksum footer to segments written by flush,
+// compaction and cleanup. Segments with checksums set a flag in their header
+// version, which versions without checksum support refuse to load, so
+// enabling this prevents a downgrade until all segments have been rewritten.
+func WithSegmentChecksums(enabled bool) BucketOption {
+	return func(b *Bucket) error {
+		b.writeChecksums = enabled
+		return nil
+	}
+}
+
+// WithSegmentQuarantine allows the oldest segment of a bucket to be moved
+// aside on load if it fails checksum validation, instead of failing the
+// bucket. The objects of the segment are missing afterwards, while the
+// inverted and vector indexes may still reference them, so this is meant as
+// an explicit operator decision until the data is repaired.
+func WithSegmentQuarantine(enabled bool) BucketOption {
+	return func(b *Bucket) error {
+		b.quarantineSegments = enabled
+		return nil
+	}
+}
+
+// WithTiering moves the data of old segments to the configured
+// SegmentStore. The local file is replaced by a stub holding only the header
+// and the index, bloom filters and net addition counters stay local as well.
+// Reads that miss the bloom filter or index never leave the node, reads of
+// data fetch the whole segment into the SegmentCache.
+//
+// Only uncompressed segments of the replace, set and map strategies are
+// eligible. Segments in tiered storage are no longer compacted or cleaned up.
+// Backups contain the stubs and rely on the store to still hold the data on
+// restore.
+func WithTiering(cfg TieringConfig) BucketOption {
+	return func(b *Bucket) error {
+		if cfg.Store == nil || cfg.Cache == nil {
+			return errors.New("tiering requires a segment store and a cache")
+		}
+
+		b.tiering = &cfg
+		return nil
+	}
+}
+
+// WithCompactionScheduler makes the compactions of this bucket subject to
+// the limits of the node-wide scheduler
+func WithCompactionScheduler(scheduler *CompactionScheduler) BucketOption {
+	return func(b *Bucket) error {
+		b.compactionScheduler = scheduler
+		return nil
+	}
+}
+
 /*
 Background for this option:
 
@@ -84,6 +84,8 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		mt.compression = b.compression
+		mt.writeChecksums = b.writeChecksums
 
 		logOnceWhenRecoveringFromWAL.Do(func() {
 			b.logger.WithField("action", "lsm_recover_from_active_wal").
@@ -101,6 +103,14 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 			b.logger.WithField("action", "lsm_recover_from_active_wal_corruption").
 				WithField("path", filepath.Join(b.dir, fname)).
 				Error(errors.Wrap(err, "write-ahead-log ended abruptly, some elements may not have been recovered"))
+
+			// a truncated last record is expected after a crash, a checksum
+			// mismatch is not. The log is deleted once the recovered part is
+			// flushed, so keep a copy of it for inspection.
+			if errors.Is(err, ErrInvalidChecksum) {
+				b.metrics.ChecksumFailure(b.strategy, "wal")
+				b.quarantineWAL(filepath.Join(b.dir, fname))
+			}
 		}
 
 		if strings.Contains(mt.path, "_searchable") && os.Getenv("USE_INVERTED_SEARCHABLE") == "true" {
@@ -132,3 +142,17 @@ func (b *Bucket) mayRecoverFromCommitLogs(ctx context.Context) error {
 
 	return nil
 }
+
+// quarantineWAL keeps a copy of a corrupt write-ahead-log next to it. Failing
+// to do so must not prevent the recovery, so errors are only logged.
+func (b *Bucket) quarantineWAL(path string) {
+	if err := copyFile(path, path+QuarantineSuffix); err != nil {
+		b.logger.WithField("action", "lsm_recover_from_active_wal_quarantine").
+			WithField("path", path).
+			WithError(err).
+			Warn("failed to keep a copy of corrupt write-ahead-log")
+		return
+	}
+
+	b.metrics.QuarantinedFile(b.strategy, "wal")
+}
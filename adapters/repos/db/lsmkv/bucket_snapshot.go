@@ -0,0 +1,299 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+var ErrSnapshotReleased = errors.New("snapshot was released")
+
+// BucketSnapshot is a read-only, point-in-time view of a bucket with the
+// "replace" strategy. It pins the disk segments which existed when the
+// snapshot was taken and holds a frozen copy of the memtable(s), so that
+// writes, flushes, compactions and cleanups happening afterwards are not
+// visible through the snapshot.
+//
+// A snapshot needs to be released using [BucketSnapshot.Release], otherwise
+// the pinned segments are kept open and on disk until the bucket is shut
+// down.
+type BucketSnapshot struct {
+	sg *SegmentGroup
+
+	// RLock() for reads, Lock() to release the snapshot. Cursors hold the
+	// RLock() until they are closed.
+	lock     sync.RWMutex
+	released bool
+
+	// both ordered from oldest to newest
+	segments  []*segment
+	memtables []*snapshotMemtable
+}
+
+// Snapshot opens a point-in-time view of the bucket. It is only supported on
+// buckets with the "replace" strategy.
+func (b *Bucket) Snapshot() (*BucketSnapshot, error) {
+	if b.strategy != StrategyReplace {
+		return nil, fmt.Errorf("snapshot not supported on strategy %q", b.strategy)
+	}
+
+	// we have a flush-RLock, so the flushing state does not change while the
+	// snapshot is taken. The segments and memtables therefore represent the
+	// same moment in time.
+	b.flushLock.RLock()
+	defer b.flushLock.RUnlock()
+
+	segments, err := b.disk.pinSegments()
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &BucketSnapshot{
+		sg:       b.disk,
+		segments: segments,
+	}
+
+	if b.flushing != nil {
+		snap.memtables = append(snap.memtables, newSnapshotMemtable(b.flushing))
+	}
+	snap.memtables = append(snap.memtables, newSnapshotMemtable(b.active))
+
+	if err := b.disk.registerSnapshot(snap); err != nil {
+		b.disk.unpinSegments(segments)
+		return nil, err
+	}
+
+	return snap, nil
+}
+
+// Release unpins the segments of the snapshot. It waits for open cursors to
+// be closed. Release is idempotent.
+func (s *BucketSnapshot) Release() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.releaseUnlocked()
+}
+
+func (s *BucketSnapshot) releaseUnlocked() error {
+	if s.released {
+		return nil
+	}
+
+	s.released = true
+	s.memtables = nil
+	s.sg.unregisterSnapshot(s)
+	return s.sg.unpinSegments(s.segments)
+}
+
+// Get retrieves the value for the given key as it was when the snapshot was
+// taken. Similar to [Bucket.Get], a nil value indicates that the key did not
+// exist.
+func (s *BucketSnapshot) Get(key []byte) ([]byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.released {
+		return nil, ErrSnapshotReleased
+	}
+
+	return s.get(key)
+}
+
+func (s *BucketSnapshot) get(key []byte) ([]byte, error) {
+	for i := len(s.memtables) - 1; i >= 0; i-- {
+		v, err := s.memtables[i].get(key)
+		if err == nil {
+			return v, nil
+		}
+		if errors.Is(err, lsmkv.Deleted) {
+			return nil, nil
+		}
+	}
+
+	for i := len(s.segments) - 1; i >= 0; i-- {
+		v, err := s.segments[i].get(key)
+		if err != nil {
+			if errors.Is(err, lsmkv.NotFound) {
+				continue
+			}
+			if errors.Is(err, lsmkv.Deleted) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return v, nil
+	}
+
+	return nil, nil
+}
+
+// GetBySecondary is the snapshot equivalent of [Bucket.GetBySecondary]
+func (s *BucketSnapshot) GetBySecondary(pos int, key []byte) ([]byte, error) {
+	v, _, err := s.GetBySecondaryWithBuffer(pos, key, nil)
+	return v, err
+}
+
+// GetBySecondaryWithBuffer is the snapshot equivalent of
+// [Bucket.GetBySecondaryWithBuffer]
+func (s *BucketSnapshot) GetBySecondaryWithBuffer(pos int, key []byte, buf []byte) ([]byte, []byte, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if s.released {
+		return nil, buf, ErrSnapshotReleased
+	}
+
+	for i := len(s.memtables) - 1; i >= 0; i-- {
+		v, err := s.memtables[i].getBySecondary(pos, key)
+		if err == nil {
+			return v, buf, nil
+		}
+		if errors.Is(err, lsmkv.Deleted) {
+			return nil, buf, nil
+		}
+	}
+
+	for i := len(s.segments) - 1; i >= 0; i-- {
+		k, v, allocatedBuf, err := s.segments[i].getBySecondaryIntoMemory(pos, key, buf)
+		if err != nil {
+			if errors.Is(err, lsmkv.NotFound) {
+				continue
+			}
+			if errors.Is(err, lsmkv.Deleted) {
+				return nil, buf, nil
+			}
+			return nil, buf, err
+		}
+
+		// same as the bucket, ensure the primary key has not been marked as
+		// deleted in a more recent segment or memtable
+		pkv, err := s.get(k)
+		if err != nil {
+			return nil, buf, err
+		} else if pkv == nil {
+			return nil, allocatedBuf, nil
+		}
+		return v, allocatedBuf, nil
+	}
+
+	return nil, buf, nil
+}
+
+// Cursor returns a cursor over the snapshot. It holds a read lock on the
+// snapshot and needs to be closed using .Close(), otherwise the snapshot can
+// never be released.
+func (s *BucketSnapshot) Cursor() (*CursorReplace, error) {
+	s.lock.RLock()
+
+	if s.released {
+		s.lock.RUnlock()
+		return nil, ErrSnapshotReleased
+	}
+
+	innerCursors := make([]innerCursorReplace, 0, len(s.segments)+len(s.memtables))
+	for _, segment := range s.segments {
+		innerCursors = append(innerCursors, segment.newCursor())
+	}
+	for _, m := range s.memtables {
+		innerCursors = append(innerCursors, m.newCursor())
+	}
+
+	return &CursorReplace{
+		// cursor are in order from oldest to newest, with the memtable cursor
+		// being at the very top
+		innerCursors: innerCursors,
+		unlock:       s.lock.RUnlock,
+	}, nil
+}
+
+// snapshotMemtable is a frozen copy of a memtable with the "replace"
+// strategy. Only the nodes are copied, not the keys and values they point
+// to: the memtable replaces those on updates, but never modifies them in
+// place.
+type snapshotMemtable struct {
+	nodes              []*binarySearchNode
+	secondaryToPrimary []map[string][]byte
+}
+
+func newSnapshotMemtable(m *Memtable) *snapshotMemtable {
+	m.RLock()
+	defer m.RUnlock()
+
+	flat := m.key.flattenInOrder()
+	nodes := make([]*binarySearchNode, len(flat))
+	for i, n := range flat {
+		nodes[i] = &binarySearchNode{
+			key:           n.key,
+			value:         n.value,
+			secondaryKeys: n.secondaryKeys,
+			tombstone:     n.tombstone,
+		}
+	}
+
+	secondaryToPrimary := make([]map[string][]byte, len(m.secondaryToPrimary))
+	for pos, mapping := range m.secondaryToPrimary {
+		secondaryToPrimary[pos] = make(map[string][]byte, len(mapping))
+		for secondary, primary := range mapping {
+			secondaryToPrimary[pos][secondary] = primary
+		}
+	}
+
+	return &snapshotMemtable{
+		nodes:              nodes,
+		secondaryToPrimary: secondaryToPrimary,
+	}
+}
+
+func (m *snapshotMemtable) get(key []byte) ([]byte, error) {
+	i := sort.Search(len(m.nodes), func(i int) bool {
+		return bytes.Compare(m.nodes[i].key, key) >= 0
+	})
+	if i >= len(m.nodes) || !bytes.Equal(m.nodes[i].key, key) {
+		return nil, lsmkv.NotFound
+	}
+	if m.nodes[i].tombstone {
+		return nil, lsmkv.Deleted
+	}
+	return m.nodes[i].value, nil
+}
+
+func (m *snapshotMemtable) getBySecondary(pos int, key []byte) ([]byte, error) {
+	if pos >= len(m.secondaryToPrimary) {
+		return nil, lsmkv.NotFound
+	}
+
+	primary := m.secondaryToPrimary[pos][string(key)]
+	if primary == nil {
+		return nil, lsmkv.NotFound
+	}
+
+	return m.get(primary)
+}
+
+func (m *snapshotMemtable) newCursor() innerCursorReplace {
+	return &memtableCursor{
+		data: m.nodes,
+		keyFn: func(n *binarySearchNode) []byte {
+			return n.key
+		},
+		// the snapshot is immutable, no locking required
+		lock:   func() {},
+		unlock: func() {},
+	}
+}
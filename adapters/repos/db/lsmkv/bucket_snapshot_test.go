@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestBucketSnapshot(t *testing.T) {
+	ctx := context.Background()
+	tests := bucketTests{
+		{
+			name: "bucketSnapshotIsolation",
+			f:    bucketSnapshotIsolation,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(1),
+			},
+		},
+		{
+			name: "bucketSnapshotReleasedOnShutdown",
+			f:    bucketSnapshotReleasedOnShutdown,
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+			},
+		},
+	}
+	tests.run(ctx, t)
+}
+
+func TestBucketSnapshotUnsupportedStrategy(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	b, err := NewBucketCreator().NewBucket(context.Background(), t.TempDir(), "",
+		logger, nil, cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), WithStrategy(StrategySetCollection))
+	require.Nil(t, err)
+	defer b.Shutdown(context.Background())
+
+	_, err = b.Snapshot()
+	require.ErrorContains(t, err, "not supported")
+}
+
+func bucketSnapshotIsolation(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		opts...)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		require.Nil(t, b.Shutdown(context.Background()))
+	})
+
+	size := 100
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%04d", i)) }
+	secondary := func(i int) []byte { return []byte(fmt.Sprintf("sec-%04d", i)) }
+	value := func(i, round int) []byte { return []byte(fmt.Sprintf("value-%04d-%d", i, round)) }
+
+	// the first half of the keys is on disk, the second half in the memtable
+	for i := 0; i < size/2; i++ {
+		require.Nil(t, b.Put(key(i), value(i, 0), WithSecondaryKey(0, secondary(i))))
+	}
+	require.Nil(t, b.FlushAndSwitch())
+	for i := size / 2; i < size; i++ {
+		require.Nil(t, b.Put(key(i), value(i, 0), WithSecondaryKey(0, secondary(i))))
+	}
+	// delete one key in each half, so that the snapshot contains tombstones
+	require.Nil(t, b.Delete(key(0), WithSecondaryKey(0, secondary(0))))
+	require.Nil(t, b.Delete(key(size-1), WithSecondaryKey(0, secondary(size-1))))
+
+	snap, err := b.Snapshot()
+	require.Nil(t, err)
+
+	// modify every key after the snapshot was taken, then flush and compact,
+	// so that all segments the snapshot references get replaced
+	for i := 0; i < size; i += 2 {
+		require.Nil(t, b.Put(key(i), value(i, 1), WithSecondaryKey(0, secondary(i))))
+	}
+	for i := 1; i < size; i += 2 {
+		require.Nil(t, b.Delete(key(i), WithSecondaryKey(0, secondary(i))))
+	}
+	require.Nil(t, b.Put(key(size), value(size, 1), WithSecondaryKey(0, secondary(size))))
+	require.Nil(t, b.FlushAndSwitch())
+
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	require.True(t, compacted)
+	require.Len(t, b.disk.segments, 1)
+
+	expected := func(i int) []byte {
+		if i == 0 || i >= size-1 {
+			return nil
+		}
+		return value(i, 0)
+	}
+
+	assertSnapshot := func(t *testing.T) {
+		for i := 0; i <= size; i++ {
+			v, err := snap.Get(key(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected(i), v)
+
+			v, err = snap.GetBySecondary(0, secondary(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected(i), v)
+		}
+
+		c, err := snap.Cursor()
+		require.Nil(t, err)
+		defer c.Close()
+
+		count := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			assert.Equal(t, key(count+1), k)
+			assert.Equal(t, value(count+1, 0), v)
+			count++
+		}
+		assert.Equal(t, size-2, count)
+
+		k, v := c.Seek(key(50))
+		assert.Equal(t, key(50), k)
+		assert.Equal(t, value(50, 0), v)
+	}
+
+	t.Run("snapshot does not see changes", assertSnapshot)
+
+	t.Run("bucket sees changes", func(t *testing.T) {
+		for i := 0; i <= size; i++ {
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			if i%2 == 0 {
+				assert.Equal(t, value(i, 1), v)
+			} else {
+				assert.Nil(t, v)
+			}
+		}
+	})
+
+	t.Run("replaced segments are kept open until released", func(t *testing.T) {
+		// only the segment flushed before the snapshot was taken is pinned, the
+		// second one was flushed afterwards and closed as usual
+		require.Len(t, b.disk.retiredSegments, 1)
+
+		// a second snapshot pins the compacted segment
+		snap2, err := b.Snapshot()
+		require.Nil(t, err)
+
+		assertSnapshot(t)
+
+		require.Nil(t, snap.Release())
+		assert.Len(t, b.disk.retiredSegments, 0)
+		assert.Len(t, b.disk.pinnedSegments, 1)
+
+		require.Nil(t, snap2.Release())
+		assert.Len(t, b.disk.pinnedSegments, 0)
+		assert.Len(t, b.disk.snapshots, 0)
+	})
+
+	t.Run("released snapshot can't be read", func(t *testing.T) {
+		_, err := snap.Get(key(1))
+		assert.ErrorIs(t, err, ErrSnapshotReleased)
+
+		c, err := snap.Cursor()
+		assert.ErrorIs(t, err, ErrSnapshotReleased)
+		if c != nil {
+			c.Close()
+		}
+
+		// release is idempotent
+		require.Nil(t, snap.Release())
+	})
+}
+
+func bucketSnapshotReleasedOnShutdown(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		opts...)
+	require.Nil(t, err)
+
+	require.Nil(t, b.Put([]byte("key"), []byte("value")))
+	require.Nil(t, b.FlushAndSwitch())
+
+	snap, err := b.Snapshot()
+	require.Nil(t, err)
+
+	v, err := snap.Get([]byte("key"))
+	require.Nil(t, err)
+	assert.Equal(t, []byte("value"), v)
+
+	require.Nil(t, b.Shutdown(ctx))
+
+	_, err = snap.Get([]byte("key"))
+	assert.ErrorIs(t, err, ErrSnapshotReleased)
+
+	_, err = b.Snapshot()
+	assert.Error(t, err)
+}
@@ -44,6 +44,18 @@ func TestSegmentsCleanup(t *testing.T) {
 				WithSegmentsCleanupInterval(time.Second),
 			},
 		},
+		{
+			name: "cleanupReplaceStrategy_WithSecondaryKeys_Compressed",
+			f: func(ctx context.Context, t *testing.T, opts []BucketOption) {
+				cleanupReplaceStrategy_WithSecondaryKeys(ctx, t, opts)
+			},
+			opts: []BucketOption{
+				WithStrategy(StrategyReplace),
+				WithSecondaryIndices(2),
+				WithSegmentsCleanupInterval(time.Second),
+				WithCompression(CompressionZstd),
+			},
+		},
 	}
 	tests.run(ctx, t)
 }
@@ -0,0 +1,418 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"io"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/config"
+	"github.com/weaviate/weaviate/usecases/monitoring"
+	"golang.org/x/time/rate"
+)
+
+const (
+	// compactionIOChunkSize is the largest write that is passed through the IO
+	// budget at once, it is also the burst size of the budget
+	compactionIOChunkSize = 256 * 1024
+
+	// a segment group that has not asked for a compaction for this long is
+	// no longer considered to be waiting, e.g. because it was shut down, has
+	// nothing left to compact or backed off its compaction cycle. Groups that
+	// wait for a slot keep asking on every cycle, see waitsForCompaction.
+	compactionRequestActiveFor = 10 * time.Second
+
+	// heavy compactions that are deferred to their window do not keep their
+	// compaction cycle busy, they are only dropped after this long
+	compactionRequestStaleAfter = time.Minute
+)
+
+type CompactionSchedulerConfig struct {
+	// MaxBytesPerSecond limits the combined write throughput of all
+	// compactions of the node, 0 means unlimited
+	MaxBytesPerSecond int64
+	// MaxConcurrent limits the number of compactions that run at the same
+	// time, 0 means unlimited
+	MaxConcurrent int
+	// HeavyWindows restricts compactions with a combined input size of at
+	// least HeavyMinSize to the given times of day. If empty, heavy
+	// compactions may run at any time.
+	HeavyWindows []config.TimeWindow
+	HeavyMinSize int64
+}
+
+// CompactionScheduler coordinates the compactions of all segment groups of a
+// node. Segment groups still decide what to compact in their own compaction
+// cycle, but ask the scheduler for permission before they start. If the
+// number of concurrent compactions is limited, the groups with the highest
+// read amplification (the most segments) go first. All compactions share a
+// single disk write budget.
+//
+// The scheduler never blocks a compaction cycle while waiting for a slot,
+// a group that was not admitted simply tries again on its next cycle. Only
+// groups that keep asking count as waiting, so a group that went idle does
+// not hold back the others.
+type CompactionScheduler struct {
+	logger  logrus.FieldLogger
+	metrics *monitoring.PrometheusMetrics
+	limiter *rate.Limiter
+	now     func() time.Time
+
+	mu      sync.Mutex
+	cfg     CompactionSchedulerConfig
+	waiting map[*SegmentGroup]*compactionRequest
+	running map[*compactionTicket]struct{}
+}
+
+// NewCompactionScheduler creates a scheduler, metrics are optional
+func NewCompactionScheduler(cfg CompactionSchedulerConfig, logger logrus.FieldLogger,
+	metrics *monitoring.PrometheusMetrics,
+) *CompactionScheduler {
+	s := &CompactionScheduler{
+		logger:  logger,
+		metrics: metrics,
+		limiter: rate.NewLimiter(rate.Inf, compactionIOChunkSize),
+		now:     time.Now,
+		cfg:     cfg,
+		waiting: map[*SegmentGroup]*compactionRequest{},
+		running: map[*compactionTicket]struct{}{},
+	}
+	s.SetMaxBytesPerSecond(cfg.MaxBytesPerSecond)
+	return s
+}
+
+// SetMaxBytesPerSecond changes the IO budget at runtime, 0 means unlimited.
+// Running compactions pick up the new budget with their next write.
+func (s *CompactionScheduler) SetMaxBytesPerSecond(bytesPerSecond int64) {
+	s.mu.Lock()
+	s.cfg.MaxBytesPerSecond = bytesPerSecond
+	s.mu.Unlock()
+
+	if bytesPerSecond <= 0 {
+		s.limiter.SetLimit(rate.Inf)
+	} else {
+		s.limiter.SetLimit(rate.Limit(bytesPerSecond))
+	}
+
+	if s.metrics != nil {
+		s.metrics.LSMCompactionIOBudget.Set(float64(bytesPerSecond))
+	}
+}
+
+type compactionRequest struct {
+	dir      string
+	strategy string
+	segments int
+	size     int64
+	since    time.Time
+	lastSeen time.Time
+	deferred bool
+}
+
+func (r *compactionRequest) expired(now time.Time) bool {
+	if r.deferred {
+		return now.Sub(r.lastSeen) > compactionRequestStaleAfter
+	}
+	return now.Sub(r.lastSeen) > compactionRequestActiveFor
+}
+
+// hasPriorityOver prefers the group with more segments, as every segment
+// adds to the read amplification of the bucket. Ties go to the group that
+// has waited longer.
+func (r *compactionRequest) hasPriorityOver(other *compactionRequest) bool {
+	if r.segments != other.segments {
+		return r.segments > other.segments
+	}
+	return r.since.Before(other.since)
+}
+
+// tryAcquire admits the compaction described by req or records it as
+// waiting. It returns nil if the compaction must not start yet.
+func (s *CompactionScheduler) tryAcquire(sg *SegmentGroup, req compactionRequest,
+) *compactionTicket {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	defer s.reportQueueLocked()
+
+	for other, r := range s.waiting {
+		if r.expired(now) {
+			delete(s.waiting, other)
+		}
+	}
+
+	req.since = now
+	if existing, ok := s.waiting[sg]; ok {
+		req.since = existing.since
+	}
+	req.lastSeen = now
+	req.deferred = s.isHeavyLocked(req.size) && !s.inHeavyWindowLocked(now)
+	s.waiting[sg] = &req
+
+	if req.deferred {
+		return nil
+	}
+
+	if s.cfg.MaxConcurrent > 0 {
+		ahead := 0
+		for other, r := range s.waiting {
+			if other != sg && !r.deferred && r.hasPriorityOver(&req) {
+				ahead++
+			}
+		}
+		if len(s.running)+ahead >= s.cfg.MaxConcurrent {
+			return nil
+		}
+	}
+
+	delete(s.waiting, sg)
+	t := &compactionTicket{
+		scheduler: s,
+		dir:       req.dir,
+		strategy:  req.strategy,
+		size:      req.size,
+		started:   now,
+	}
+	s.running[t] = struct{}{}
+	return t
+}
+
+// isWaiting indicates that the group waits for a slot, as opposed to not
+// asking at all or being deferred to the heavy compaction window
+func (s *CompactionScheduler) isWaiting(sg *SegmentGroup) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.waiting[sg]
+	return ok && !r.deferred
+}
+
+// withdraw removes a waiting request, e.g. because the group no longer has
+// anything to compact
+func (s *CompactionScheduler) withdraw(sg *SegmentGroup) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.waiting[sg]; ok {
+		delete(s.waiting, sg)
+		s.reportQueueLocked()
+	}
+}
+
+func (s *CompactionScheduler) isHeavyLocked(size int64) bool {
+	return len(s.cfg.HeavyWindows) > 0 && size >= s.cfg.HeavyMinSize
+}
+
+func (s *CompactionScheduler) inHeavyWindowLocked(now time.Time) bool {
+	for _, w := range s.cfg.HeavyWindows {
+		if w.Contains(now) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *CompactionScheduler) reportQueueLocked() {
+	if s.metrics == nil {
+		return
+	}
+
+	waiting, deferred := 0, 0
+	for _, r := range s.waiting {
+		if r.deferred {
+			deferred++
+		} else {
+			waiting++
+		}
+	}
+
+	s.metrics.LSMCompactionSchedulerQueue.WithLabelValues("running").Set(float64(len(s.running)))
+	s.metrics.LSMCompactionSchedulerQueue.WithLabelValues("waiting").Set(float64(waiting))
+	s.metrics.LSMCompactionSchedulerQueue.WithLabelValues("deferred").Set(float64(deferred))
+}
+
+// waitForBudget blocks until n bytes may be written
+func (s *CompactionScheduler) waitForBudget(n int) {
+	if s.limiter.Limit() == rate.Inf {
+		return
+	}
+
+	start := time.Now()
+	if err := s.limiter.WaitN(context.Background(), n); err != nil {
+		// can only happen if n exceeds the burst, which the caller prevents
+		s.logger.WithField("action", "lsm_compaction_scheduler").
+			WithError(err).Warn("could not wait for compaction IO budget")
+	}
+
+	if s.metrics != nil {
+		s.metrics.LSMCompactionThrottledSeconds.Add(time.Since(start).Seconds())
+	}
+}
+
+// compactionTicket is handed out for every admitted compaction, it must be
+// released with done
+type compactionTicket struct {
+	scheduler *CompactionScheduler
+	dir       string
+	strategy  string
+	size      int64
+	started   time.Time
+	written   atomic.Int64
+}
+
+func (t *compactionTicket) done() {
+	s := t.scheduler
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.running, t)
+	s.reportQueueLocked()
+}
+
+// writer wraps the file the compacted segment is written to, so that all
+// writes count against the IO budget
+func (t *compactionTicket) writer(w io.WriteSeeker) io.WriteSeeker {
+	return &budgetedWriter{w: w, ticket: t}
+}
+
+type budgetedWriter struct {
+	w      io.WriteSeeker
+	ticket *compactionTicket
+}
+
+func (b *budgetedWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		chunk := p
+		if len(chunk) > compactionIOChunkSize {
+			chunk = chunk[:compactionIOChunkSize]
+		}
+
+		b.ticket.scheduler.waitForBudget(len(chunk))
+		n, err := b.w.Write(chunk)
+		written += n
+		b.ticket.written.Add(int64(n))
+		if metrics := b.ticket.scheduler.metrics; metrics != nil {
+			metrics.LSMCompactionWrittenBytes.Add(float64(n))
+		}
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
+
+func (b *budgetedWriter) Seek(offset int64, whence int) (int64, error) {
+	return b.w.Seek(offset, whence)
+}
+
+// CompactionSchedulerStatus is a snapshot of the scheduler for debugging
+type CompactionSchedulerStatus struct {
+	MaxBytesPerSecond int64              `json:"maxBytesPerSecond"`
+	MaxConcurrent     int                `json:"maxConcurrent"`
+	HeavyWindows      []string           `json:"heavyWindows,omitempty"`
+	HeavyMinSize      int64              `json:"heavyMinSize,omitempty"`
+	InHeavyWindow     bool               `json:"inHeavyWindow"`
+	Running           []CompactionStatus `json:"running"`
+	Waiting           []CompactionStatus `json:"waiting"`
+}
+
+type CompactionStatus struct {
+	Path     string `json:"path"`
+	Strategy string `json:"strategy"`
+	// Segments is the number of segments of the bucket, only set for waiting
+	// compactions
+	Segments   int   `json:"segments,omitempty"`
+	InputBytes int64 `json:"inputBytes"`
+	// Progress is the ratio of written to input bytes. The compacted segment
+	// is typically smaller than its inputs, so this is an upper bound of the
+	// remaining work.
+	WrittenBytes int64   `json:"writtenBytes,omitempty"`
+	Progress     float64 `json:"progress,omitempty"`
+	// Deferred compactions wait for the next heavy compaction window
+	Deferred bool   `json:"deferred,omitempty"`
+	Duration string `json:"duration"`
+}
+
+// Status lists running compactions by start time and waiting compactions in
+// the order in which they will be admitted
+func (s *CompactionScheduler) Status() CompactionSchedulerStatus {
+	now := s.now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := CompactionSchedulerStatus{
+		MaxBytesPerSecond: s.cfg.MaxBytesPerSecond,
+		MaxConcurrent:     s.cfg.MaxConcurrent,
+		InHeavyWindow:     s.inHeavyWindowLocked(now),
+		Running:           []CompactionStatus{},
+		Waiting:           []CompactionStatus{},
+	}
+	if len(s.cfg.HeavyWindows) > 0 {
+		status.HeavyMinSize = s.cfg.HeavyMinSize
+		for _, w := range s.cfg.HeavyWindows {
+			status.HeavyWindows = append(status.HeavyWindows, w.String())
+		}
+	}
+
+	running := make([]*compactionTicket, 0, len(s.running))
+	for t := range s.running {
+		running = append(running, t)
+	}
+	sort.Slice(running, func(i, j int) bool { return running[i].started.Before(running[j].started) })
+	for _, t := range running {
+		written := t.written.Load()
+		progress := 1.0
+		if written < t.size {
+			progress = float64(written) / float64(t.size)
+		}
+		status.Running = append(status.Running, CompactionStatus{
+			Path:         t.dir,
+			Strategy:     t.strategy,
+			InputBytes:   t.size,
+			WrittenBytes: written,
+			Progress:     progress,
+			Duration:     now.Sub(t.started).Round(time.Millisecond).String(),
+		})
+	}
+
+	waiting := make([]*compactionRequest, 0, len(s.waiting))
+	for _, r := range s.waiting {
+		waiting = append(waiting, r)
+	}
+	sort.Slice(waiting, func(i, j int) bool {
+		if waiting[i].deferred != waiting[j].deferred {
+			return !waiting[i].deferred
+		}
+		return waiting[i].hasPriorityOver(waiting[j])
+	})
+	for _, r := range waiting {
+		status.Waiting = append(status.Waiting, CompactionStatus{
+			Path:       r.dir,
+			Strategy:   r.strategy,
+			Segments:   r.segments,
+			InputBytes: r.size,
+			Deferred:   r.deferred,
+			Duration:   now.Sub(r.since).Round(time.Millisecond).String(),
+		})
+	}
+
+	return status
+}
@@ -0,0 +1,268 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func TestCompactionScheduler(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+
+	newScheduler := func(cfg CompactionSchedulerConfig, now time.Time) *CompactionScheduler {
+		s := NewCompactionScheduler(cfg, logger, nil)
+		s.now = func() time.Time { return now }
+		return s
+	}
+	request := func(dir string, segments int, size int64) compactionRequest {
+		return compactionRequest{dir: dir, strategy: StrategyReplace, segments: segments, size: size}
+	}
+
+	t.Run("unlimited", func(t *testing.T) {
+		s := newScheduler(CompactionSchedulerConfig{}, time.Now())
+		sg1, sg2 := &SegmentGroup{}, &SegmentGroup{}
+
+		t1 := s.tryAcquire(sg1, request("a", 2, 100))
+		t2 := s.tryAcquire(sg2, request("b", 2, 100))
+		require.NotNil(t, t1)
+		require.NotNil(t, t2)
+		assert.Len(t, s.Status().Running, 2)
+
+		t1.done()
+		t2.done()
+		assert.Len(t, s.Status().Running, 0)
+		assert.Len(t, s.Status().Waiting, 0)
+	})
+
+	t.Run("limited concurrency prefers read amplification", func(t *testing.T) {
+		now := time.Now()
+		s := newScheduler(CompactionSchedulerConfig{MaxConcurrent: 1}, now)
+		sgRunning, sgFew, sgMany := &SegmentGroup{}, &SegmentGroup{}, &SegmentGroup{}
+
+		running := s.tryAcquire(sgRunning, request("running", 2, 100))
+		require.NotNil(t, running)
+
+		assert.Nil(t, s.tryAcquire(sgFew, request("few", 3, 100)))
+		assert.Nil(t, s.tryAcquire(sgMany, request("many", 10, 100)))
+
+		status := s.Status()
+		require.Len(t, status.Waiting, 2)
+		assert.Equal(t, "many", status.Waiting[0].Path)
+		assert.Equal(t, "few", status.Waiting[1].Path)
+
+		running.done()
+
+		// the group with fewer segments asks first, but has to wait
+		assert.Nil(t, s.tryAcquire(sgFew, request("few", 3, 100)))
+		next := s.tryAcquire(sgMany, request("many", 10, 100))
+		require.NotNil(t, next)
+		next.done()
+		next = s.tryAcquire(sgFew, request("few", 3, 100))
+		require.NotNil(t, next)
+
+		// a group that no longer has anything to compact gives way
+		assert.Nil(t, s.tryAcquire(sgMany, request("many", 10, 100)))
+		next.done()
+		s.withdraw(sgMany)
+		next = s.tryAcquire(sgFew, request("few", 3, 100))
+		require.NotNil(t, next)
+		next.done()
+	})
+
+	t.Run("stale requests are dropped", func(t *testing.T) {
+		now := time.Now()
+		s := newScheduler(CompactionSchedulerConfig{MaxConcurrent: 1}, now)
+		sgGone, sg := &SegmentGroup{}, &SegmentGroup{}
+
+		running := s.tryAcquire(&SegmentGroup{}, request("running", 2, 100))
+		require.NotNil(t, running)
+		assert.Nil(t, s.tryAcquire(sgGone, request("gone", 10, 100)))
+		running.done()
+
+		s.now = func() time.Time { return now.Add(2 * compactionRequestActiveFor) }
+		next := s.tryAcquire(sg, request("other", 2, 100))
+		require.NotNil(t, next)
+		next.done()
+	})
+
+	t.Run("groups that stop asking do not hold back others", func(t *testing.T) {
+		now := time.Now()
+		s := newScheduler(CompactionSchedulerConfig{MaxConcurrent: 1}, now)
+		sgIdle, sg := &SegmentGroup{}, &SegmentGroup{}
+
+		running := s.tryAcquire(&SegmentGroup{}, request("running", 2, 100))
+		require.NotNil(t, running)
+		assert.Nil(t, s.tryAcquire(sgIdle, request("idle", 10, 100)))
+		assert.True(t, s.isWaiting(sgIdle))
+		running.done()
+
+		// the group with more segments still asks, so it goes first
+		s.now = func() time.Time { return now.Add(compactionRequestActiveFor) }
+		assert.Nil(t, s.tryAcquire(sg, request("other", 2, 100)))
+
+		// it backed off its compaction cycle, its request expires
+		s.now = func() time.Time { return now.Add(compactionRequestActiveFor + time.Second) }
+		next := s.tryAcquire(sg, request("other", 2, 100))
+		require.NotNil(t, next)
+		assert.False(t, s.isWaiting(sgIdle))
+		next.done()
+	})
+
+	t.Run("deferred requests expire later", func(t *testing.T) {
+		cfg := CompactionSchedulerConfig{
+			HeavyWindows: []config.TimeWindow{{Start: 22 * time.Hour, End: 6 * time.Hour}},
+			HeavyMinSize: 1000,
+		}
+		noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		s := newScheduler(cfg, noon)
+		sgHeavy := &SegmentGroup{}
+
+		assert.Nil(t, s.tryAcquire(sgHeavy, request("heavy", 2, 1000)))
+		assert.False(t, s.isWaiting(sgHeavy))
+
+		s.now = func() time.Time { return noon.Add(compactionRequestActiveFor + time.Second) }
+		light := s.tryAcquire(&SegmentGroup{}, request("light", 2, 100))
+		require.NotNil(t, light)
+		light.done()
+		assert.Len(t, s.Status().Waiting, 1)
+
+		s.now = func() time.Time { return noon.Add(compactionRequestStaleAfter + time.Second) }
+		light = s.tryAcquire(&SegmentGroup{}, request("light", 2, 100))
+		require.NotNil(t, light)
+		light.done()
+		assert.Len(t, s.Status().Waiting, 0)
+	})
+
+	t.Run("heavy compactions wait for their window", func(t *testing.T) {
+		cfg := CompactionSchedulerConfig{
+			HeavyWindows: []config.TimeWindow{{Start: 22 * time.Hour, End: 6 * time.Hour}},
+			HeavyMinSize: 1000,
+		}
+		noon := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+		s := newScheduler(cfg, noon)
+		sg := &SegmentGroup{}
+
+		light := s.tryAcquire(sg, request("light", 2, 999))
+		require.NotNil(t, light)
+		light.done()
+
+		assert.Nil(t, s.tryAcquire(sg, request("heavy", 2, 1000)))
+		status := s.Status()
+		assert.False(t, status.InHeavyWindow)
+		assert.Equal(t, []string{"22:00-06:00"}, status.HeavyWindows)
+		require.Len(t, status.Waiting, 1)
+		assert.True(t, status.Waiting[0].Deferred)
+
+		s.now = func() time.Time { return noon.Add(12 * time.Hour) }
+		heavy := s.tryAcquire(sg, request("heavy", 2, 1000))
+		require.NotNil(t, heavy)
+		heavy.done()
+	})
+
+	t.Run("writes are limited by the budget", func(t *testing.T) {
+		s := NewCompactionScheduler(CompactionSchedulerConfig{
+			MaxBytesPerSecond: 4 * compactionIOChunkSize,
+		}, logger, nil)
+		ticket := s.tryAcquire(&SegmentGroup{}, request("a", 2, 6*compactionIOChunkSize))
+		require.NotNil(t, ticket)
+		defer ticket.done()
+
+		f, err := os.Create(filepath.Join(t.TempDir(), "segment"))
+		require.Nil(t, err)
+		defer f.Close()
+		w := ticket.writer(f)
+
+		// the first chunk is covered by the burst, the other two take half a
+		// second at four chunks per second
+		start := time.Now()
+		n, err := w.Write(make([]byte, 3*compactionIOChunkSize))
+		require.Nil(t, err)
+		assert.Equal(t, 3*compactionIOChunkSize, n)
+		assert.GreaterOrEqual(t, time.Since(start), 400*time.Millisecond)
+
+		pos, err := w.Seek(0, io.SeekCurrent)
+		require.Nil(t, err)
+		assert.Equal(t, int64(3*compactionIOChunkSize), pos)
+
+		status := s.Status()
+		require.Len(t, status.Running, 1)
+		assert.Equal(t, int64(3*compactionIOChunkSize), status.Running[0].WrittenBytes)
+		assert.InDelta(t, 0.5, status.Running[0].Progress, 0.001)
+
+		// removing the limit takes effect immediately
+		s.SetMaxBytesPerSecond(0)
+		start = time.Now()
+		_, err = w.Write(make([]byte, 3*compactionIOChunkSize))
+		require.Nil(t, err)
+		assert.Less(t, time.Since(start), 200*time.Millisecond)
+	})
+}
+
+func TestCompactionSchedulerBucket(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	scheduler := NewCompactionScheduler(CompactionSchedulerConfig{MaxConcurrent: 1}, logger, nil)
+	b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithCompactionScheduler(scheduler))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	for i := 0; i < 20; i++ {
+		require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%03d", i)), bytes.Repeat([]byte("v"), 100)))
+		if i%10 == 9 {
+			require.Nil(t, b.FlushAndSwitch())
+		}
+	}
+
+	// another compaction occupies the only slot
+	other := scheduler.tryAcquire(&SegmentGroup{}, compactionRequest{dir: "other", segments: 100})
+	require.NotNil(t, other)
+
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	assert.False(t, compacted)
+	// the compaction cycle does not back off while waiting
+	assert.True(t, b.disk.waitsForCompaction())
+	require.Len(t, scheduler.Status().Waiting, 1)
+	assert.Equal(t, b.disk.dir, scheduler.Status().Waiting[0].Path)
+	assert.Equal(t, 2, b.disk.Len())
+
+	other.done()
+	compacted, err = b.disk.compactOnce()
+	require.Nil(t, err)
+	assert.True(t, compacted)
+	assert.Equal(t, 1, b.disk.Len())
+	assert.False(t, b.disk.waitsForCompaction())
+
+	// nothing left to do, the group no longer waits
+	compacted, err = b.disk.compactOnce()
+	require.Nil(t, err)
+	assert.False(t, compacted)
+	status := scheduler.Status()
+	assert.Len(t, status.Running, 0)
+	assert.Len(t, status.Waiting, 0)
+}
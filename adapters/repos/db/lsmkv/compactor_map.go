@@ -236,6 +236,10 @@ func (c *compactorMap) writeIndices(keys []segmentindex.Key) error {
 		ScratchSpacePath:    c.scratchSpacePath,
 	}
 
+	if err := endSegmentData(c.bufw, c.w); err != nil {
+		return err
+	}
+
 	_, err := indices.WriteTo(c.bufw)
 	return err
 }
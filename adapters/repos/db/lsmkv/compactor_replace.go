@@ -184,6 +184,10 @@ func (c *compactorReplace) writeIndices(keys []segmentindex.Key) error {
 		ScratchSpacePath:    c.scratchSpacePath,
 	}
 
+	if err := endSegmentData(c.bufw, c.w); err != nil {
+		return err
+	}
+
 	_, err := indices.WriteTo(c.bufw)
 	return err
 }
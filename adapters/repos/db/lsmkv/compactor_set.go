@@ -178,6 +178,10 @@ func (c *compactorSet) writeIndices(keys []segmentindex.Key) error {
 		ScratchSpacePath:    c.scratchSpacePath,
 	}
 
+	if err := endSegmentData(c.bufw, c.w); err != nil {
+		return err
+	}
+
 	_, err := indices.WriteTo(c.bufw)
 	return err
 }
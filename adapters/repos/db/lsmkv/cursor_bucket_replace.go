@@ -24,6 +24,10 @@ type CursorReplace struct {
 	unlock       func()
 	serveCache   cursorStateReplace
 
+	// reverse is set by Last and SeekReverse, the cursor then moves from the
+	// highest to the lowest key
+	reverse bool
+
 	reusableIDList []int
 }
 
@@ -31,6 +35,9 @@ type innerCursorReplace interface {
 	first() ([]byte, []byte, error)
 	next() ([]byte, []byte, error)
 	seek([]byte) ([]byte, []byte, error)
+	last() ([]byte, []byte, error)
+	prev() ([]byte, []byte, error)
+	seekReverse([]byte) ([]byte, []byte, error)
 }
 
 type cursorStateReplace struct {
@@ -108,7 +115,13 @@ func (c *CursorReplace) Close() {
 func (c *CursorReplace) seekAll(target []byte) {
 	state := make([]cursorStateReplace, len(c.innerCursors))
 	for i, cur := range c.innerCursors {
-		key, value, err := cur.seek(target)
+		var key, value []byte
+		var err error
+		if c.reverse {
+			key, value, err = cur.seekReverse(target)
+		} else {
+			key, value, err = cur.seek(target)
+		}
 		if errors.Is(err, lsmkv.NotFound) {
 			state[i].err = err
 			continue
@@ -132,7 +145,7 @@ func (c *CursorReplace) seekAll(target []byte) {
 }
 
 func (c *CursorReplace) serveCurrentStateAndAdvance() ([]byte, []byte) {
-	id, err := c.cursorWithLowestKey()
+	id, err := c.cursorWithNextKey()
 	if err != nil {
 		if errors.Is(err, lsmkv.NotFound) {
 			return nil, nil
@@ -183,7 +196,7 @@ func (c *CursorReplace) mergeDuplicatesInCurrentStateAndAdvance(ids []int) ([]by
 
 	if errors.Is(c.serveCache.err, lsmkv.Deleted) {
 		// element was deleted, proceed with next round
-		return c.Next()
+		return c.serveCurrentStateAndAdvance()
 	}
 
 	return c.serveCache.key, c.serveCache.value
@@ -208,12 +221,25 @@ func (c *CursorReplace) copyStateIntoServeCache(pos int) {
 	c.serveCache.err = resMut.err
 }
 
+// Seek positions the cursor on the first key that is larger than or equal to
+// the specified key. Use Next to continue in ascending order.
 func (c *CursorReplace) Seek(key []byte) ([]byte, []byte) {
+	c.reverse = false
 	c.seekAll(key)
 	return c.serveCurrentStateAndAdvance()
 }
 
-func (c *CursorReplace) cursorWithLowestKey() (int, error) {
+// SeekReverse positions the cursor on the last key that is smaller than or
+// equal to the specified key. Use Prev to continue in descending order.
+func (c *CursorReplace) SeekReverse(key []byte) ([]byte, []byte) {
+	c.reverse = true
+	c.seekAll(key)
+	return c.serveCurrentStateAndAdvance()
+}
+
+// cursorWithNextKey returns the inner cursor holding the key to be served
+// next, i.e. the lowest key or the highest key when iterating in reverse
+func (c *CursorReplace) cursorWithNextKey() (int, error) {
 	err := lsmkv.NotFound
 	pos := -1
 	var lowest []byte
@@ -223,7 +249,12 @@ func (c *CursorReplace) cursorWithLowestKey() (int, error) {
 			continue
 		}
 
-		if lowest == nil || bytes.Compare(res.key, lowest) <= 0 {
+		cmp := bytes.Compare(res.key, lowest)
+		if c.reverse {
+			cmp = -cmp
+		}
+
+		if lowest == nil || cmp <= 0 {
 			pos = i
 			err = res.err
 			lowest = res.key
@@ -238,7 +269,13 @@ func (c *CursorReplace) cursorWithLowestKey() (int, error) {
 }
 
 func (c *CursorReplace) advanceInner(id int) {
-	k, v, err := c.innerCursors[id].next()
+	var k, v []byte
+	var err error
+	if c.reverse {
+		k, v, err = c.innerCursors[id].prev()
+	} else {
+		k, v, err = c.innerCursors[id].next()
+	}
 	if errors.Is(err, lsmkv.NotFound) {
 		c.state[id].err = err
 		c.state[id].key = nil
@@ -266,10 +303,23 @@ func (c *CursorReplace) Next() ([]byte, []byte) {
 	return c.serveCurrentStateAndAdvance()
 }
 
+// Prev continues a reverse iteration started with Last or SeekReverse. The
+// direction of a cursor is set when positioning it, so Prev and Next are
+// interchangeable and only exist for readability.
+func (c *CursorReplace) Prev() ([]byte, []byte) {
+	return c.serveCurrentStateAndAdvance()
+}
+
 func (c *CursorReplace) firstAll() {
 	state := make([]cursorStateReplace, len(c.innerCursors))
 	for i, cur := range c.innerCursors {
-		key, value, err := cur.first()
+		var key, value []byte
+		var err error
+		if c.reverse {
+			key, value, err = cur.last()
+		} else {
+			key, value, err = cur.first()
+		}
 		if errors.Is(err, lsmkv.NotFound) {
 			state[i].err = err
 			continue
@@ -292,6 +342,15 @@ func (c *CursorReplace) firstAll() {
 }
 
 func (c *CursorReplace) First() ([]byte, []byte) {
+	c.reverse = false
+	c.firstAll()
+	return c.serveCurrentStateAndAdvance()
+}
+
+// Last positions the cursor on the highest key. Use Prev to continue in
+// descending order.
+func (c *CursorReplace) Last() ([]byte, []byte) {
+	c.reverse = true
 	c.firstAll()
 	return c.serveCurrentStateAndAdvance()
 }
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestCursorReplaceReverse(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	secondary := func(i int) []byte { return []byte(fmt.Sprintf("sec-%03d", i)) }
+
+	b, err := NewBucketCreator().NewBucket(ctx, t.TempDir(), "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace), WithSecondaryIndices(1))
+	require.Nil(t, err)
+	defer b.Shutdown(ctx)
+
+	// keys 0-9 in the first segment, 10-19 in the second one with key 5
+	// updated and key 7 deleted, 20-24 in the memtable with key 15 updated
+	for i := 0; i < 10; i++ {
+		require.Nil(t, b.Put(key(i), []byte("v1"), WithSecondaryKey(0, secondary(i))))
+	}
+	require.Nil(t, b.FlushAndSwitch())
+	for i := 10; i < 20; i++ {
+		require.Nil(t, b.Put(key(i), []byte("v1"), WithSecondaryKey(0, secondary(i))))
+	}
+	require.Nil(t, b.Put(key(5), []byte("v2"), WithSecondaryKey(0, secondary(5))))
+	require.Nil(t, b.Delete(key(7), WithSecondaryKey(0, secondary(7))))
+	require.Nil(t, b.FlushAndSwitch())
+	for i := 20; i < 25; i++ {
+		require.Nil(t, b.Put(key(i), []byte("v1"), WithSecondaryKey(0, secondary(i))))
+	}
+	require.Nil(t, b.Put(key(15), []byte("v2"), WithSecondaryKey(0, secondary(15))))
+
+	expected := func(from, to int) ([]string, []string) {
+		var keys, values []string
+		for i := from; i >= to; i-- {
+			if i == 7 {
+				continue
+			}
+			keys = append(keys, string(key(i)))
+			if i == 5 || i == 15 {
+				values = append(values, "v2")
+			} else {
+				values = append(values, "v1")
+			}
+		}
+		return keys, values
+	}
+
+	collect := func(c *CursorReplace, k, v []byte) ([]string, []string) {
+		var keys, values []string
+		for ; k != nil; k, v = c.Prev() {
+			keys = append(keys, string(k))
+			values = append(values, string(v))
+		}
+		return keys, values
+	}
+
+	t.Run("last", func(t *testing.T) {
+		c := b.Cursor()
+		defer c.Close()
+
+		k, v := c.Last()
+		keys, values := collect(c, k, v)
+		expectedKeys, expectedValues := expected(24, 0)
+		assert.Equal(t, expectedKeys, keys)
+		assert.Equal(t, expectedValues, values)
+	})
+
+	t.Run("seek reverse", func(t *testing.T) {
+		c := b.Cursor()
+		defer c.Close()
+
+		k, v := c.SeekReverse(key(16))
+		keys, values := collect(c, k, v)
+		expectedKeys, expectedValues := expected(16, 0)
+		assert.Equal(t, expectedKeys, keys)
+		assert.Equal(t, expectedValues, values)
+
+		// a deleted key is skipped
+		k, _ = c.SeekReverse(key(7))
+		assert.Equal(t, key(6), k)
+
+		// in between keys
+		k, _ = c.SeekReverse([]byte("key-0125"))
+		assert.Equal(t, key(12), k)
+
+		k, _ = c.SeekReverse([]byte("a"))
+		assert.Nil(t, k)
+	})
+
+	t.Run("changing direction", func(t *testing.T) {
+		c := b.Cursor()
+		defer c.Close()
+
+		k, _ := c.SeekReverse(key(20))
+		assert.Equal(t, key(20), k)
+		k, _ = c.Prev()
+		assert.Equal(t, key(19), k)
+
+		k, _ = c.Seek(key(20))
+		assert.Equal(t, key(20), k)
+		k, _ = c.Next()
+		assert.Equal(t, key(21), k)
+	})
+
+	t.Run("secondary index", func(t *testing.T) {
+		c := b.CursorWithSecondaryIndex(0)
+		defer c.Close()
+
+		var keys []string
+		for k, _ := c.SeekReverse(secondary(11)); k != nil; k, _ = c.Prev() {
+			keys = append(keys, string(k))
+		}
+		assert.Equal(t, []string{
+			"sec-011", "sec-010", "sec-009", "sec-008", "sec-006",
+			"sec-005", "sec-004", "sec-003", "sec-002", "sec-001", "sec-000",
+		}, keys)
+	})
+
+	t.Run("snapshot", func(t *testing.T) {
+		snapshot, err := b.Snapshot()
+		require.Nil(t, err)
+		defer snapshot.Release()
+
+		c, err := snapshot.Cursor()
+		require.Nil(t, err)
+		defer c.Close()
+
+		k, v := c.Last()
+		keys, _ := collect(c, k, v)
+		expectedKeys, _ := expected(24, 0)
+		assert.Equal(t, expectedKeys, keys)
+	})
+}
@@ -168,3 +168,60 @@ func (c *memtableCursor) next() ([]byte, []byte, error) {
 	}
 	return c.keyFn(c.data[c.current]), c.data[c.current].value, nil
 }
+
+func (c *memtableCursor) last() ([]byte, []byte, error) {
+	c.lock()
+	defer c.unlock()
+
+	if len(c.data) == 0 {
+		return nil, nil, lsmkv.NotFound
+	}
+
+	c.current = len(c.data) - 1
+
+	if c.data[c.current].tombstone {
+		return c.keyFn(c.data[c.current]), nil, lsmkv.Deleted
+	}
+	return c.keyFn(c.data[c.current]), c.data[c.current].value, nil
+}
+
+func (c *memtableCursor) seekReverse(key []byte) ([]byte, []byte, error) {
+	c.lock()
+	defer c.unlock()
+
+	pos := c.posSmallerThanEqual(key)
+	if pos == -1 {
+		return nil, nil, lsmkv.NotFound
+	}
+
+	c.current = pos
+	if c.data[c.current].tombstone {
+		return c.keyFn(c.data[c.current]), nil, lsmkv.Deleted
+	}
+	return c.keyFn(c.data[pos]), c.data[pos].value, nil
+}
+
+func (c *memtableCursor) posSmallerThanEqual(key []byte) int {
+	for i := len(c.data) - 1; i >= 0; i-- {
+		if bytes.Compare(c.keyFn(c.data[i]), key) <= 0 {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (c *memtableCursor) prev() ([]byte, []byte, error) {
+	c.lock()
+	defer c.unlock()
+
+	c.current--
+	if c.current < 0 {
+		return nil, nil, lsmkv.NotFound
+	}
+
+	if c.data[c.current].tombstone {
+		return c.keyFn(c.data[c.current]), nil, lsmkv.Deleted
+	}
+	return c.keyFn(c.data[c.current]), c.data[c.current].value, nil
+}
@@ -12,6 +12,7 @@
 package lsmkv
 
 import (
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/entities/lsmkv"
 	"github.com/weaviate/weaviate/usecases/byteops"
 )
@@ -110,8 +111,7 @@ func (s *segmentCursorReplace) seek(key []byte) ([]byte, []byte, error) {
 
 	s.currOffset = node.Start
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: node.Start, end: node.End},
-		s.segment.contents[node.Start:node.End])
+	err = s.parseReplaceNodeInto(nodeOffset{start: node.Start, end: node.End})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -131,8 +131,7 @@ func (s *segmentCursorReplace) next() ([]byte, []byte, error) {
 
 	s.currOffset = nextOffset
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset},
-		s.segment.contents[s.currOffset:])
+	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -148,8 +147,48 @@ func (s *segmentCursorReplace) first() ([]byte, []byte, error) {
 
 	s.currOffset = firstOffset
 
-	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset},
-		s.segment.contents[s.currOffset:])
+	err = s.parseReplaceNodeInto(nodeOffset{start: s.currOffset})
+	if err != nil {
+		return s.keyFn(s.reusableNode), nil, err
+	}
+
+	return s.keyFn(s.reusableNode), s.reusableNode.value, nil
+}
+
+func (s *segmentCursorReplace) seekReverse(key []byte) ([]byte, []byte, error) {
+	node, err := s.index.SeekReverse(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.parseIndexNode(node)
+}
+
+// prev relies on the index to find the predecessor of the current node, as
+// nodes don't contain a back reference, so a reverse scan can't be
+// sequential like a forward scan
+func (s *segmentCursorReplace) prev() ([]byte, []byte, error) {
+	node, err := s.index.Prev(s.keyFn(s.reusableNode))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.parseIndexNode(node)
+}
+
+func (s *segmentCursorReplace) last() ([]byte, []byte, error) {
+	node, err := s.index.Last()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return s.parseIndexNode(node)
+}
+
+func (s *segmentCursorReplace) parseIndexNode(node segmentindex.Node) ([]byte, []byte, error) {
+	s.currOffset = node.Start
+
+	err := s.parseReplaceNodeInto(nodeOffset{start: node.Start, end: node.End})
 	if err != nil {
 		return s.keyFn(s.reusableNode), nil, err
 	}
@@ -203,9 +242,12 @@ func (s *segmentCursorReplace) parseReplaceNode(offset nodeOffset) (segmentRepla
 	return out, err
 }
 
-func (s *segmentCursorReplace) parseReplaceNodeInto(offset nodeOffset, buf []byte) error {
+func (s *segmentCursorReplace) parseReplaceNodeInto(offset nodeOffset) error {
 	if s.segment.mmapContents {
-		return s.parse(buf)
+		if offset.end != 0 {
+			return s.parse(s.segment.contents[offset.start:offset.end])
+		}
+		return s.parse(s.segment.contents[offset.start:])
 	}
 
 	r, err := s.segment.newNodeReader(offset)
@@ -34,7 +34,7 @@ func (s *segment) newRoaringSetRangeReader() *roaringsetrange.SegmentReader {
 	if s.mmapContents {
 		segmentCursor = roaringsetrange.NewSegmentCursorMmap(s.contents[s.dataStartPos:s.dataEndPos])
 	} else {
-		sectionReader := io.NewSectionReader(s.contentFile, int64(s.dataStartPos), int64(s.dataEndPos))
+		sectionReader := io.NewSectionReader(s.contentFile, int64(s.dataStartPos), int64(s.dataEndPos-s.dataStartPos))
 		// since segment reader concurrenlty fetches next segment and merges bitmaps of previous segments
 		// at least 2 buffers needs to be used by cursor not to overwrite data before they are consumed.
 		segmentCursor = roaringsetrange.NewSegmentCursorPread(sectionReader, 2)
@@ -50,7 +50,7 @@ func (s *segment) newRoaringSetRangeCursor() roaringsetrange.SegmentCursor {
 		return roaringsetrange.NewSegmentCursorMmap(s.contents[s.dataStartPos:s.dataEndPos])
 	}
 
-	sectionReader := io.NewSectionReader(s.contentFile, int64(s.dataStartPos), int64(s.dataEndPos))
+	sectionReader := io.NewSectionReader(s.contentFile, int64(s.dataStartPos), int64(s.dataEndPos-s.dataStartPos))
 	// compactor does not work concurrently, next segment is fetched after previous one gets consumed,
 	// therefore just one buffer is sufficient.
 	return roaringsetrange.NewSegmentCursorPread(sectionReader, 1)
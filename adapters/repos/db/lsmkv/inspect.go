@@ -0,0 +1,484 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+	"github.com/willf/bloom"
+)
+
+// BucketFiles groups the files found in a bucket directory by their role,
+// see [ListBucketFiles]
+type BucketFiles struct {
+	Segments []string // disk segments (.db)
+	WALs     []string // write-ahead-logs of active or crashed memtables (.wal)
+	Derived  []string // bloom filters and net count additions (.bloom, .cna)
+	// segments and write-ahead-logs which failed their checksum validation
+	// and were moved aside (.quarantined)
+	Quarantined []string
+	// markers of segments whose data was moved to tiered storage (.cold), the
+	// segment file itself is only a stub
+	Cold []string
+	// files that are not expected in a healthy bucket at rest, e.g. leftovers
+	// of an interrupted compaction (.tmp) or segments marked for deletion
+	Leftovers []string
+}
+
+// ListBucketFiles lists the files of a bucket directory without opening
+// them. All paths are absolute, i.e. prefixed with dir.
+func ListBucketFiles(dir string) (BucketFiles, error) {
+	var files BucketFiles
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return files, fmt.Errorf("read bucket dir: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		path := filepath.Join(dir, entry.Name())
+		switch filepath.Ext(entry.Name()) {
+		case ".db":
+			files.Segments = append(files.Segments, path)
+		case ".wal":
+			files.WALs = append(files.WALs, path)
+		case ".bloom", ".cna":
+			files.Derived = append(files.Derived, path)
+		case QuarantineSuffix:
+			files.Quarantined = append(files.Quarantined, path)
+		case ColdMarkerSuffix:
+			files.Cold = append(files.Cold, path)
+		default:
+			files.Leftovers = append(files.Leftovers, path)
+		}
+	}
+
+	return files, nil
+}
+
+// SegmentInspector provides read-only access to a single disk segment for
+// offline tooling, e.g. to look into a bucket after a crash. Contrary to a
+// regular segment, it never creates or overwrites derived files such as bloom
+// filters or net count additions.
+type SegmentInspector struct {
+	seg *segment
+}
+
+// SegmentInfo summarizes a disk segment, see [SegmentInspector.Info]
+type SegmentInfo struct {
+	Path             string
+	Size             int64
+	Level            uint16
+	Version          uint16
+	Strategy         string
+	SecondaryIndices uint16
+	Compressed       bool
+	Checksums        bool
+	Keys             int
+	// Tombstones counts deleted keys on the replace strategy, deleted values
+	// on the collection strategies and deleted ids on the roaring set
+	// strategies
+	Tombstones  int
+	FirstKey    []byte
+	LastKey     []byte
+	BloomFilter string
+}
+
+// SegmentEntry is a single key of a segment as passed to the callback of
+// [SegmentInspector.Dump]. Which of the fields are set depends on the
+// strategy of the segment.
+type SegmentEntry struct {
+	Key []byte
+
+	// replace
+	Value         []byte
+	SecondaryKeys [][]byte
+	Tombstone     bool
+
+	// setcollection
+	Values        [][]byte
+	DeletedValues [][]byte
+
+	// mapcollection and inverted
+	Pairs []MapPair
+
+	// roaringset and roaringsetrange, the key of a roaringsetrange entry is
+	// the bit position
+	Additions []uint64
+	Deletions []uint64
+}
+
+// OpenSegmentInspector opens the segment at path read-only
+func OpenSegmentInspector(path string, logger logrus.FieldLogger) (*SegmentInspector, error) {
+	seg, err := newSegment(path, logger, nil, nil, true, false, false, false,
+		checksumVerification{}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SegmentInspector{seg: seg}, nil
+}
+
+// Close releases the file handles and mmaps of the segment
+func (i *SegmentInspector) Close() error {
+	return i.seg.close()
+}
+
+// Info scans the entire segment to collect its statistics
+func (i *SegmentInspector) Info() (SegmentInfo, error) {
+	info := SegmentInfo{
+		Path:             i.seg.path,
+		Size:             i.seg.size,
+		Level:            i.seg.level,
+		Version:          i.seg.version,
+		Strategy:         segmentStrategyName(i.seg.strategy),
+		SecondaryIndices: i.seg.secondaryIndexCount,
+		Compressed:       i.seg.compressedData != nil,
+		Checksums:        i.seg.checksums != nil,
+	}
+
+	var keys [][]byte
+	err := i.Dump(func(e SegmentEntry) error {
+		if info.Keys == 0 {
+			info.FirstKey = e.Key
+		}
+		info.LastKey = e.Key
+		info.Keys++
+		info.Tombstones += e.tombstones()
+		keys = append(keys, e.Key)
+		return nil
+	})
+	if err != nil {
+		return info, err
+	}
+
+	info.BloomFilter, _ = checkBloomFilter(i.seg.bloomFilterPath(), keys)
+	return info, nil
+}
+
+// Dump calls fn for each entry of the segment in the order they are stored
+// on disk. Errors returned by fn stop the iteration.
+func (i *SegmentInspector) Dump(fn func(SegmentEntry) error) (err error) {
+	defer func() {
+		// a corrupt segment can make the parsers panic
+		if p := recover(); p != nil {
+			err = fmt.Errorf("unexpected error reading segment %q: %v", i.seg.path, p)
+		}
+	}()
+
+	if i.seg.dataStartPos >= i.seg.dataEndPos {
+		return nil
+	}
+
+	switch i.seg.strategy {
+	case segmentindex.StrategyReplace:
+		return i.dumpReplace(fn)
+	case segmentindex.StrategySetCollection:
+		return i.dumpSet(fn)
+	case segmentindex.StrategyMapCollection, segmentindex.StrategyInverted:
+		return i.dumpMap(fn)
+	case segmentindex.StrategyRoaringSet:
+		return i.dumpRoaringSet(fn)
+	case segmentindex.StrategyRoaringSetRange:
+		return i.dumpRoaringSetRange(fn)
+	default:
+		return fmt.Errorf("unsupported strategy %v", i.seg.strategy)
+	}
+}
+
+func (i *SegmentInspector) dumpReplace(fn func(SegmentEntry) error) error {
+	c := i.seg.newCursor()
+	for n, err := c.firstWithAllKeys(); ; n, err = c.nextWithAllKeys() {
+		if errors.Is(err, lsmkv.NotFound) {
+			return nil
+		}
+		if err != nil && !errors.Is(err, lsmkv.Deleted) {
+			return fmt.Errorf("parse node at offset %d: %w", c.currOffset, err)
+		}
+
+		if err := fn(SegmentEntry{
+			Key:           n.primaryKey,
+			Value:         n.value,
+			SecondaryKeys: n.secondaryKeys,
+			Tombstone:     n.tombstone,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (i *SegmentInspector) dumpSet(fn func(SegmentEntry) error) error {
+	c := i.seg.newCollectionCursor()
+	for k, vals, err := c.first(); ; k, vals, err = c.next() {
+		if errors.Is(err, lsmkv.NotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("parse node at offset %d: %w", c.nextOffset, err)
+		}
+
+		e := SegmentEntry{Key: k}
+		for _, v := range vals {
+			if v.tombstone {
+				e.DeletedValues = append(e.DeletedValues, v.value)
+			} else {
+				e.Values = append(e.Values, v.value)
+			}
+		}
+		if err := fn(e); err != nil {
+			return err
+		}
+	}
+}
+
+func (i *SegmentInspector) dumpMap(fn func(SegmentEntry) error) error {
+	// inverted segments store the property lengths apart from the postings
+	var propLengths map[uint64]uint32
+	if i.seg.strategy == segmentindex.StrategyInverted {
+		var err error
+		if propLengths, err = i.seg.GetPropertyLengths(); err != nil {
+			return fmt.Errorf("read property lengths: %w", err)
+		}
+	}
+
+	c := i.seg.newMapCursor()
+	for k, pairs, err := c.first(); ; k, pairs, err = c.next() {
+		if errors.Is(err, lsmkv.NotFound) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("parse node at offset %d: %w", c.nextOffset, err)
+		}
+
+		if propLengths != nil {
+			for j := range pairs {
+				if len(pairs[j].Key) < 8 || len(pairs[j].Value) < 8 {
+					continue
+				}
+				docID := binary.BigEndian.Uint64(pairs[j].Key)
+				binary.LittleEndian.PutUint32(pairs[j].Value[4:],
+					math.Float32bits(float32(propLengths[docID])))
+			}
+		}
+
+		if err := fn(SegmentEntry{Key: k, Pairs: pairs}); err != nil {
+			return err
+		}
+	}
+}
+
+func (i *SegmentInspector) dumpRoaringSet(fn func(SegmentEntry) error) error {
+	c := i.seg.newRoaringSetCursor()
+	for k, layer, err := c.First(); ; k, layer, err = c.Next() {
+		if err != nil {
+			return fmt.Errorf("parse node after key %q: %w", k, err)
+		}
+		if k == nil {
+			return nil
+		}
+
+		if err := fn(SegmentEntry{
+			Key:       k,
+			Additions: layer.Additions.ToArray(),
+			Deletions: layer.Deletions.ToArray(),
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+func (i *SegmentInspector) dumpRoaringSetRange(fn func(SegmentEntry) error) error {
+	c := i.seg.newRoaringSetRangeCursor()
+	for bit, layer, ok := c.First(); ok; bit, layer, ok = c.Next() {
+		if err := fn(SegmentEntry{
+			Key:       []byte{bit},
+			Additions: layer.Additions.ToArray(),
+			Deletions: layer.Deletions.ToArray(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the checksums of the segment, that all entries can be
+// parsed, that they are ordered and match the primary and secondary indexes,
+// and that the checksums of derived files are valid. Derived files which do not exist are
+// not considered an error, as they are rebuilt on startup.
+func (i *SegmentInspector) Validate() error {
+	var errs []error
+
+	// header and index were already verified when opening the segment
+	if i.seg.checksums != nil {
+		for pos := range i.seg.checksums.Blocks {
+			if err := i.seg.verifyBlock(pos); err != nil {
+				errs = append(errs, fmt.Errorf("checksums: %w", err))
+			}
+		}
+	}
+
+	var keys [][]byte
+	secondaryKeys := make([][][]byte, i.seg.secondaryIndexCount)
+	var prev []byte
+	err := i.Dump(func(e SegmentEntry) error {
+		if prev != nil && bytes.Compare(prev, e.Key) >= 0 {
+			errs = append(errs, fmt.Errorf("key %q is not greater than previous key %q", e.Key, prev))
+		}
+		prev = e.Key
+		keys = append(keys, e.Key)
+
+		for pos, sk := range e.SecondaryKeys {
+			if pos >= len(secondaryKeys) || len(sk) == 0 {
+				continue
+			}
+			secondaryKeys[pos] = append(secondaryKeys[pos], sk)
+
+			pk, _, _, err := i.seg.getBySecondaryIntoMemory(pos, sk, nil)
+			if err != nil && !errors.Is(err, lsmkv.Deleted) {
+				errs = append(errs, fmt.Errorf("secondary index %d: lookup %q: %w", pos, sk, err))
+			} else if err == nil && !bytes.Equal(pk, e.Key) {
+				errs = append(errs, fmt.Errorf("secondary index %d: %q points to %q instead of %q", pos, sk, pk, e.Key))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		// the remaining checks can't be trusted on a partially read segment
+		return errors.Join(append(errs, err)...)
+	}
+
+	if i.seg.strategy != segmentindex.StrategyRoaringSetRange {
+		if err := i.validatePrimaryIndex(keys); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if _, err := checkBloomFilter(i.seg.bloomFilterPath(), keys); err != nil {
+		errs = append(errs, fmt.Errorf("bloom filter: %w", err))
+	}
+	for pos := range secondaryKeys {
+		if _, err := checkBloomFilter(i.seg.bloomFilterSecondaryPath(pos), secondaryKeys[pos]); err != nil {
+			errs = append(errs, fmt.Errorf("bloom filter of secondary index %d: %w", pos, err))
+		}
+	}
+
+	if ok, _ := fileExists(i.seg.countNetPath()); ok {
+		if _, err := loadWithChecksum(i.seg.countNetPath(), 12); err != nil {
+			errs = append(errs, fmt.Errorf("net count additions: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// validatePrimaryIndex makes sure that the primary index contains exactly
+// the keys found in the data section
+func (i *SegmentInspector) validatePrimaryIndex(keys [][]byte) error {
+	indexKeys, err := i.seg.index.AllKeys()
+	if err != nil {
+		return fmt.Errorf("read primary index: %w", err)
+	}
+	sort.Slice(indexKeys, func(a, b int) bool {
+		return bytes.Compare(indexKeys[a], indexKeys[b]) < 0
+	})
+
+	if len(indexKeys) != len(keys) {
+		return fmt.Errorf("primary index has %d keys, data section has %d", len(indexKeys), len(keys))
+	}
+	for pos := range keys {
+		if !bytes.Equal(indexKeys[pos], keys[pos]) {
+			return fmt.Errorf("primary index key %q does not match data key %q at position %d",
+				indexKeys[pos], keys[pos], pos)
+		}
+	}
+
+	return nil
+}
+
+// checkBloomFilter returns a human readable status of the bloom filter at
+// path and an error if it is corrupt or yields false negatives for keys
+func checkBloomFilter(path string, keys [][]byte) (string, error) {
+	ok, err := fileExists(path)
+	if err != nil {
+		return "error", err
+	}
+	if !ok {
+		return "missing", nil
+	}
+
+	data, err := loadWithChecksum(path, -1)
+	if err != nil {
+		return "corrupt", err
+	}
+
+	bf := new(bloom.BloomFilter)
+	if _, err := bf.ReadFrom(bytes.NewReader(data)); err != nil {
+		return "corrupt", err
+	}
+
+	falseNegatives := 0
+	for _, key := range keys {
+		if !bf.Test(key) {
+			falseNegatives++
+		}
+	}
+	if falseNegatives > 0 {
+		return "stale", fmt.Errorf("%d of %d keys are not contained", falseNegatives, len(keys))
+	}
+
+	return "ok", nil
+}
+
+func (e SegmentEntry) tombstones() int {
+	switch {
+	case e.Tombstone:
+		return 1
+	case len(e.DeletedValues) > 0:
+		return len(e.DeletedValues)
+	case len(e.Deletions) > 0:
+		return len(e.Deletions)
+	}
+
+	count := 0
+	for _, p := range e.Pairs {
+		if p.Tombstone {
+			count++
+		}
+	}
+	return count
+}
+
+func segmentStrategyName(strategy segmentindex.Strategy) string {
+	for _, name := range []string{
+		StrategyReplace, StrategySetCollection, StrategyMapCollection,
+		StrategyRoaringSet, StrategyRoaringSetRange, StrategyInverted,
+	} {
+		if SegmentStrategyFromString(name) == strategy {
+			return name
+		}
+	}
+	return fmt.Sprintf("unknown(%d)", strategy)
+}
@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestSegmentInspector(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	docKey := func(id uint64) []byte {
+		k := make([]byte, 8)
+		binary.BigEndian.PutUint64(k, id)
+		return k
+	}
+	tf := func(tf, propLength float32) []byte {
+		v := make([]byte, 8)
+		binary.LittleEndian.PutUint32(v[0:4], math.Float32bits(tf))
+		binary.LittleEndian.PutUint32(v[4:8], math.Float32bits(propLength))
+		return v
+	}
+
+	tests := []struct {
+		strategy           string
+		opts               []BucketOption
+		write              func(t *testing.T, b *Bucket)
+		expectedKeys       int
+		expectedTombstones int
+		assertEntry        func(t *testing.T, e SegmentEntry)
+	}{
+		{
+			strategy: StrategyReplace,
+			opts:     []BucketOption{WithSecondaryIndices(1)},
+			write: func(t *testing.T, b *Bucket) {
+				for i := 0; i < 10; i++ {
+					require.Nil(t, b.Put(key(i), []byte("value"),
+						WithSecondaryKey(0, []byte(fmt.Sprintf("sec-%03d", i)))))
+				}
+				require.Nil(t, b.Delete(key(3)))
+			},
+			expectedKeys:       10,
+			expectedTombstones: 1,
+			assertEntry: func(t *testing.T, e SegmentEntry) {
+				if string(e.Key) == string(key(3)) {
+					assert.True(t, e.Tombstone)
+				} else {
+					assert.Equal(t, []byte("value"), e.Value)
+					assert.Len(t, e.SecondaryKeys, 1)
+				}
+			},
+		},
+		{
+			strategy: StrategySetCollection,
+			write: func(t *testing.T, b *Bucket) {
+				for i := 0; i < 10; i++ {
+					require.Nil(t, b.SetAdd(key(i), [][]byte{[]byte("a"), []byte("b")}))
+				}
+				require.Nil(t, b.SetDeleteSingle(key(3), []byte("a")))
+			},
+			expectedKeys:       10,
+			expectedTombstones: 1,
+			assertEntry: func(t *testing.T, e SegmentEntry) {
+				assert.Contains(t, e.Values, []byte("b"))
+			},
+		},
+		{
+			strategy: StrategyMapCollection,
+			write: func(t *testing.T, b *Bucket) {
+				for i := 0; i < 10; i++ {
+					require.Nil(t, b.MapSet(key(i), MapPair{Key: []byte("k"), Value: []byte("v")}))
+				}
+			},
+			expectedKeys: 10,
+			assertEntry: func(t *testing.T, e SegmentEntry) {
+				require.Len(t, e.Pairs, 1)
+				assert.Equal(t, []byte("k"), e.Pairs[0].Key)
+				assert.Equal(t, []byte("v"), e.Pairs[0].Value)
+			},
+		},
+		{
+			strategy: StrategyInverted,
+			write: func(t *testing.T, b *Bucket) {
+				for i := 0; i < 10; i++ {
+					require.Nil(t, b.MapSet(key(i), MapPair{Key: docKey(uint64(i)), Value: tf(float32(i+1), 4)}))
+				}
+				require.Nil(t, b.MapDeleteKey(key(3), docKey(3)))
+			},
+			// the deleted doc moves to the tombstones and leaves key 3 empty
+			expectedKeys: 9,
+			assertEntry: func(t *testing.T, e SegmentEntry) {
+				require.Len(t, e.Pairs, 1)
+				id := binary.BigEndian.Uint64(e.Pairs[0].Key)
+				assert.Equal(t, key(int(id)), e.Key)
+				assert.Equal(t, tf(float32(id+1), 4), e.Pairs[0].Value)
+			},
+		},
+		{
+			strategy: StrategyRoaringSet,
+			write: func(t *testing.T, b *Bucket) {
+				for i := 0; i < 10; i++ {
+					require.Nil(t, b.RoaringSetAddOne(key(i), uint64(i)))
+				}
+			},
+			expectedKeys: 10,
+			assertEntry: func(t *testing.T, e SegmentEntry) {
+				assert.Len(t, e.Additions, 1)
+			},
+		},
+		{
+			strategy: StrategyRoaringSetRange,
+			write: func(t *testing.T, b *Bucket) {
+				for i := 0; i < 10; i++ {
+					require.Nil(t, b.RoaringSetRangeAdd(uint64(i), uint64(i)))
+				}
+			},
+			// the non-null bitmap plus one entry per bit of the values 0-9
+			expectedKeys: 5,
+			// additions are also stored as deletions in the non-null bitmap,
+			// so that they overwrite previous keys of the same value
+			expectedTombstones: 10,
+			assertEntry: func(t *testing.T, e SegmentEntry) {
+				assert.Len(t, e.Key, 1)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.strategy, func(t *testing.T) {
+			dir := t.TempDir()
+			b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+				cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+				append(tt.opts, WithStrategy(tt.strategy))...)
+			require.Nil(t, err)
+
+			tt.write(t, b)
+			require.Nil(t, b.Shutdown(ctx))
+
+			files, err := ListBucketFiles(dir)
+			require.Nil(t, err)
+			require.Len(t, files.Segments, 1)
+			assert.Len(t, files.WALs, 0)
+			assert.Len(t, files.Leftovers, 0)
+
+			insp, err := OpenSegmentInspector(files.Segments[0], logger)
+			require.Nil(t, err)
+			defer insp.Close()
+
+			info, err := insp.Info()
+			require.Nil(t, err)
+			assert.Equal(t, tt.strategy, info.Strategy)
+			assert.Equal(t, tt.expectedKeys, info.Keys)
+			assert.Equal(t, tt.expectedTombstones, info.Tombstones)
+
+			entries := 0
+			require.Nil(t, insp.Dump(func(e SegmentEntry) error {
+				entries++
+				tt.assertEntry(t, e)
+				return nil
+			}))
+			assert.Equal(t, tt.expectedKeys, entries)
+
+			assert.Nil(t, insp.Validate())
+		})
+	}
+
+	t.Run("stale bloom filter", func(t *testing.T) {
+		dir := t.TempDir()
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		require.Nil(t, b.Put(key(0), []byte("value")))
+		require.Nil(t, b.FlushAndSwitch())
+		for i := 1; i < 20; i++ {
+			require.Nil(t, b.Put(key(i), []byte("value")))
+		}
+		require.Nil(t, b.Shutdown(ctx))
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		require.Len(t, files.Segments, 2)
+
+		insp, err := OpenSegmentInspector(files.Segments[1], logger)
+		require.Nil(t, err)
+		defer insp.Close()
+
+		// replace the bloom filter with the one of the other segment
+		other, err := os.ReadFile(files.Segments[0][:len(files.Segments[0])-len(".db")] + ".bloom")
+		require.Nil(t, err)
+		require.Nil(t, os.WriteFile(insp.seg.bloomFilterPath(), other, 0o666))
+
+		info, err := insp.Info()
+		require.Nil(t, err)
+		assert.Equal(t, "stale", info.BloomFilter)
+		assert.ErrorContains(t, insp.Validate(), "bloom filter")
+	})
+}
+
+func TestInspectAndRepairWAL(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dir := t.TempDir()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace))
+	require.Nil(t, err)
+	for i := 0; i < 10; i++ {
+		require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+	}
+	require.Nil(t, b.WriteWAL())
+
+	files, err := ListBucketFiles(dir)
+	require.Nil(t, err)
+	require.Len(t, files.WALs, 1)
+	data, err := os.ReadFile(files.WALs[0])
+	require.Nil(t, err)
+	require.Nil(t, b.Shutdown(ctx))
+
+	t.Run("valid log", func(t *testing.T) {
+		path := dir + "/valid.wal"
+		require.Nil(t, os.WriteFile(path, data, 0o666))
+
+		info, err := InspectWAL(path, 0)
+		require.Nil(t, err)
+		assert.Equal(t, 10, info.Records)
+		assert.Equal(t, 10, info.RecordsByType["replace"])
+		assert.False(t, info.Corrupt())
+		assert.Nil(t, info.Err)
+	})
+
+	t.Run("truncated log", func(t *testing.T) {
+		path := dir + "/truncated.wal"
+		require.Nil(t, os.WriteFile(path, data[:len(data)-3], 0o666))
+
+		info, err := InspectWAL(path, 0)
+		require.Nil(t, err)
+		assert.Equal(t, 9, info.Records)
+		assert.True(t, info.Corrupt())
+		assert.Error(t, info.Err)
+	})
+
+	t.Run("repair corrupt log", func(t *testing.T) {
+		path := dir + "/corrupt.wal"
+		corrupt := make([]byte, len(data), len(data)+8)
+		copy(corrupt, data)
+		// a record with a valid header, but a wrong checksum
+		corrupt = append(corrupt, byte(CommitTypeReplace), CurrentVersion)
+		corrupt = binary.LittleEndian.AppendUint32(corrupt, 0)
+		corrupt = append(corrupt, 1, 2, 3, 4)
+		require.Nil(t, os.WriteFile(path, corrupt, 0o666))
+
+		info, err := RepairWAL(path, 0)
+		require.Nil(t, err)
+		assert.Equal(t, 10, info.Records)
+		assert.ErrorIs(t, info.Err, ErrInvalidChecksum)
+		assert.Equal(t, int64(len(data)), info.ValidSize)
+
+		repaired, err := os.ReadFile(path)
+		require.Nil(t, err)
+		assert.Equal(t, data, repaired)
+
+		backup, err := os.ReadFile(path + ".corrupt")
+		require.Nil(t, err)
+		assert.Equal(t, corrupt, backup)
+
+		// repairing a healthy log is a no-op
+		info, err = RepairWAL(path, 0)
+		require.Nil(t, err)
+		assert.False(t, info.Corrupt())
+	})
+}
@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/rwhasher"
+)
+
+// WALInfo describes a write-ahead-log, see [InspectWAL]
+type WALInfo struct {
+	Path    string
+	Size    int64
+	Records int
+	// number of records per commit type, e.g. "replace" or "collection"
+	RecordsByType map[string]int
+	// ValidSize is the offset right after the last record that could be
+	// read and whose checksum matched. It is smaller than Size if the log is
+	// truncated or corrupt.
+	ValidSize int64
+	// Err describes why reading stopped before the end of the log
+	Err error
+}
+
+// Corrupt indicates that not the entire log could be read
+func (i WALInfo) Corrupt() bool {
+	return i.ValidSize < i.Size
+}
+
+// InspectWAL reads all records of the write-ahead-log at path and validates
+// their checksums without applying them to a memtable. The number of
+// secondary indices is only required to read legacy (version 0) records of
+// the replace strategy, as those are not length-prefixed.
+func InspectWAL(path string, secondaryIndices uint16) (WALInfo, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return WALInfo{}, fmt.Errorf("read wal: %w", err)
+	}
+
+	info := WALInfo{
+		Path:          path,
+		Size:          int64(len(data)),
+		RecordsByType: map[string]int{},
+	}
+
+	r := bytes.NewReader(data)
+	// the checksum covers all non-checksum bytes written so far, not just the
+	// current record, see commitLogger.writeEntry
+	checksumReader := rwhasher.NewCRC32Reader(r)
+	for {
+		offset := info.Size - int64(r.Len())
+		info.ValidSize = offset
+		if r.Len() == 0 {
+			return info, nil
+		}
+
+		commitType, err := readWALRecord(r, checksumReader, secondaryIndices)
+		if err != nil {
+			info.Err = fmt.Errorf("record %d at offset %d: %w", info.Records, offset, err)
+			return info, nil
+		}
+
+		info.Records++
+		info.RecordsByType[commitType.String()]++
+	}
+}
+
+func readWALRecord(r *bytes.Reader, checksumReader rwhasher.ReaderHasher,
+	secondaryIndices uint16,
+) (CommitType, error) {
+	var commitType CommitType
+	if err := binary.Read(checksumReader, binary.LittleEndian, &commitType); err != nil {
+		return commitType, fmt.Errorf("read commit type: %w", err)
+	}
+
+	var version uint8
+	if err := binary.Read(checksumReader, binary.LittleEndian, &version); err != nil {
+		return commitType, fmt.Errorf("read commit version: %w", err)
+	}
+
+	switch version {
+	case 0:
+		return commitType, skipWALRecordV0(r, commitType, secondaryIndices)
+	case 1:
+		var nodeLen uint32
+		if err := binary.Read(checksumReader, binary.LittleEndian, &nodeLen); err != nil {
+			return commitType, fmt.Errorf("read commit node length: %w", err)
+		}
+		if int64(nodeLen) > int64(r.Len()) {
+			return commitType, fmt.Errorf("commit node length %d exceeds remaining %d bytes: %w",
+				nodeLen, r.Len(), io.ErrUnexpectedEOF)
+		}
+		if _, err := io.CopyN(io.Discard, checksumReader, int64(nodeLen)); err != nil {
+			return commitType, fmt.Errorf("read commit node: %w", err)
+		}
+
+		var checksum [4]byte
+		if _, err := io.ReadFull(r, checksum[:]); err != nil {
+			return commitType, fmt.Errorf("read commit checksum: %w", err)
+		}
+		if !bytes.Equal(checksum[:], checksumReader.Hash()) {
+			return commitType, ErrInvalidChecksum
+		}
+		return commitType, nil
+	default:
+		return commitType, fmt.Errorf("unsupported commit version %d", version)
+	}
+}
+
+// skipWALRecordV0 parses a legacy record which has neither a length prefix
+// nor a checksum, so the node itself needs to be parsed to find its end
+func skipWALRecordV0(r *bytes.Reader, commitType CommitType, secondaryIndices uint16) error {
+	switch commitType {
+	case CommitTypeReplace:
+		_, err := ParseReplaceNode(r, secondaryIndices)
+		return err
+	case CommitTypeCollection:
+		_, err := ParseCollectionNode(r)
+		return err
+	case CommitTypeRoaringSet:
+		var segmentLen uint64
+		if err := binary.Read(r, binary.LittleEndian, &segmentLen); err != nil {
+			return fmt.Errorf("read segment len: %w", err)
+		}
+		// the length includes the 8 bytes of the length itself
+		if segmentLen < 8 || segmentLen-8 > uint64(r.Len()) {
+			return fmt.Errorf("invalid segment len %d: %w", segmentLen, io.ErrUnexpectedEOF)
+		}
+		_, err := r.Seek(int64(segmentLen-8), io.SeekCurrent)
+		return err
+	default:
+		return fmt.Errorf("unsupported commit type %s", commitType)
+	}
+}
+
+// RepairWAL truncates a corrupt write-ahead-log right after its last valid
+// record, so that the bucket can recover all records up to this point on
+// the next startup. The original file is kept next to it with a ".corrupt"
+// suffix. Logs which are not corrupt are not touched.
+func RepairWAL(path string, secondaryIndices uint16) (WALInfo, error) {
+	info, err := InspectWAL(path, secondaryIndices)
+	if err != nil {
+		return info, err
+	}
+	if !info.Corrupt() {
+		return info, nil
+	}
+
+	if err := copyFile(path, path+".corrupt"); err != nil {
+		return info, fmt.Errorf("back up corrupt wal: %w", err)
+	}
+
+	if err := os.Truncate(path, info.ValidSize); err != nil {
+		return info, fmt.Errorf("truncate wal: %w", err)
+	}
+
+	return info, nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o666)
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/sroar"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringsetrange"
 	"github.com/weaviate/weaviate/entities/lsmkv"
@@ -47,6 +48,11 @@ type Memtable struct {
 	metrics   *memtableMetrics
 
 	tombstones *sroar.Bitmap
+
+	// segments are compressed after flushing if set, see WithCompression
+	compression segmentindex.Compression
+	// flushed segments carry a checksum footer if set, see WithSegmentChecksums
+	writeChecksums bool
 }
 
 func newMemtable(path string, strategy string, secondaryIndices uint16,
@@ -47,7 +47,9 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
-	w := bufio.NewWriter(f)
+	segmentWriter := newSegmentWriter(f, m.compression,
+		SegmentStrategyFromString(m.flushStrategy), m.secondaryIndices)
+	w := bufio.NewWriter(segmentWriter)
 
 	var keys []segmentindex.Key
 	skipIndices := false
@@ -93,6 +95,10 @@ func (m *Memtable) flush() error {
 			ScratchSpacePath:    m.path + ".scratch.d",
 		}
 
+		if err := endSegmentData(w, segmentWriter); err != nil {
+			return errors.Wrap(err, "end data section")
+		}
+
 		if _, err := indices.WriteTo(w); err != nil {
 			return err
 		}
@@ -102,6 +108,10 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
+	if err := finishSegment(segmentWriter); err != nil {
+		return errors.Wrap(err, "finish flushed segment")
+	}
+
 	if err := f.Sync(); err != nil {
 		return err
 	}
@@ -110,6 +120,12 @@ func (m *Memtable) flush() error {
 		return err
 	}
 
+	if m.writeChecksums {
+		if err := checksumSegmentFile(m.path+".db", DefaultChecksumBlockSize); err != nil {
+			return errors.Wrap(err, "checksum flushed segment")
+		}
+	}
+
 	// only now that the file has been flushed is it safe to delete the commit log
 	// TODO: there might be an interest in keeping the commit logs around for
 	// longer as they might come in handy for replication
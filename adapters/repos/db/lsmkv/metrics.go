@@ -43,6 +43,9 @@ type Metrics struct {
 	memtableDurations            prometheus.ObserverVec
 	memtableSize                 *prometheus.GaugeVec
 	DimensionSum                 *prometheus.GaugeVec
+	checksumFailures             *prometheus.CounterVec
+	quarantinedFiles             *prometheus.CounterVec
+	tieringOperations            *prometheus.CounterVec
 
 	groupClasses        bool
 	criticalBucketsOnly bool
@@ -146,6 +149,18 @@ func NewMetrics(promMetrics *monitoring.PrometheusMetrics, className,
 			"class_name": className,
 			"shard_name": shardName,
 		}),
+		checksumFailures: promMetrics.LSMChecksumFailures.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		quarantinedFiles: promMetrics.LSMQuarantinedFiles.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
+		tieringOperations: promMetrics.LSMTieringOperations.MustCurryWith(prometheus.Labels{
+			"class_name": className,
+			"shard_name": shardName,
+		}),
 	}
 }
 
@@ -249,3 +264,47 @@ func (m *Metrics) ObjectCount(count int) {
 
 	m.objectCount.Set(float64(count))
 }
+
+// ChecksumFailure counts a checksum mismatch. The source is where it was
+// detected, e.g. "segment_load", "segment_read" or "wal".
+func (m *Metrics) ChecksumFailure(strategy, source string) {
+	if m == nil {
+		return
+	}
+
+	m.checksumFailures.With(prometheus.Labels{
+		"strategy": strategy,
+		"source":   source,
+	}).Inc()
+}
+
+// QuarantinedFile counts a corrupt file that was moved aside, the kind is
+// either "segment" or "wal"
+func (m *Metrics) QuarantinedFile(strategy, kind string) {
+	if m == nil {
+		return
+	}
+
+	m.quarantinedFiles.With(prometheus.Labels{
+		"strategy": strategy,
+		"kind":     kind,
+	}).Inc()
+}
+
+// TieringOperation counts an operation on a tiered segment, the operation is
+// one of "offload", "fetch" or "evict"
+func (m *Metrics) TieringOperation(operation string, err error) {
+	if m == nil {
+		return
+	}
+
+	status := "success"
+	if err != nil {
+		status = "failed"
+	}
+
+	m.tieringOperations.With(prometheus.Labels{
+		"operation": operation,
+		"status":    status,
+	}).Inc()
+}
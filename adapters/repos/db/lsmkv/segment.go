@@ -37,6 +37,7 @@ type segment struct {
 	dataStartPos        uint64
 	dataEndPos          uint64
 	contents            []byte
+	mapped              mmap.MMap // the entire file, contents may be a subslice
 	contentFile         *os.File
 	strategy            segmentindex.Strategy
 	index               diskIndex
@@ -57,6 +58,18 @@ type segment struct {
 
 	invertedHeader *segmentindex.HeaderInverted
 	invertedData   *segmentInvertedData
+
+	// only set on block-compressed segments, all data reads need to go
+	// through it, as index offsets do not match the physical layout
+	compressedData *segmentCompressedData
+
+	// only set on segments with a checksum footer, see segment_checksums.go
+	checksums             *segmentChecksums
+	verifyChecksumsOnRead bool
+
+	// only set on segments whose data lives in tiered storage, the local file
+	// is a stub, see segment_tiering.go
+	cold *coldSegment
 }
 
 type diskIndex interface {
@@ -70,6 +83,15 @@ type diskIndex interface {
 
 	Next(key []byte) (segmentindex.Node, error)
 
+	// SeekReverse returns lsmkv.NotFound in case the seek value is smaller
+	// than the lowest value in the collection, otherwise it returns the next
+	// lowest value (or the exact value if present)
+	SeekReverse(key []byte) (segmentindex.Node, error)
+
+	Prev(key []byte) (segmentindex.Node, error)
+
+	Last() (segmentindex.Node, error)
+
 	// AllKeys in no specific order, e.g. for building a bloom filter
 	AllKeys() ([][]byte, error)
 
@@ -82,6 +104,7 @@ type diskIndex interface {
 func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 	existsLower existsOnLowerSegmentsFn, mmapContents bool,
 	useBloomFilter bool, calcCountNetAdditions bool, overwriteDerived bool,
+	verify checksumVerification, cold *coldSegment,
 ) (_ *segment, err error) {
 	defer func() {
 		p := recover()
@@ -116,6 +139,22 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		return nil, fmt.Errorf("unsupported strategy in segment: %w", err)
 	}
 
+	// the mapping itself is kept to unmap it on close, all reads must go
+	// through the contents without the checksum footer
+	mapped := contents
+	var checksums *segmentChecksums
+	if header.HasChecksums() {
+		// the data section of a stub is empty, it is only verified when read
+		checksums, contents, err = loadSegmentChecksums(contents, header,
+			verify.onLoad && cold == nil)
+		if err != nil {
+			metrics.ChecksumFailure(segmentStrategyName(header.Strategy), "segment_load")
+			mapped.Unmap()
+			file.Close()
+			return nil, fmt.Errorf("validate checksums: %w", err)
+		}
+	}
+
 	primaryIndex, err := header.PrimaryIndex(contents)
 	if err != nil {
 		return nil, fmt.Errorf("extract primary index position: %w", err)
@@ -136,11 +175,29 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		dataEndPos = invertedHeader.TombstoneOffset
 	}
 
+	var compressedData *segmentCompressedData
+	if header.LayoutVersion() == segmentindex.SegmentV1Compressed {
+		compressedData, err = newSegmentCompressedData(contents, header.IndexStart)
+		if err != nil {
+			return nil, fmt.Errorf("load compression header: %w", err)
+		}
+		dataEndPos = compressedData.header.DataEnd
+		// the mmaped contents hold compressed blocks, so they can never be
+		// read from directly
+		mmapContents = false
+	}
+
+	if cold != nil {
+		// all data reads need to go through the cached copy
+		mmapContents = false
+	}
+
 	seg := &segment{
 		level:                 header.Level,
 		path:                  path,
 		contents:              contents,
-		version:               header.Version,
+		mapped:                mapped,
+		version:               header.LayoutVersion(),
 		secondaryIndexCount:   header.SecondaryIndices,
 		segmentStartPos:       header.IndexStart,
 		segmentEndPos:         uint64(fileInfo.Size()),
@@ -156,6 +213,14 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 		calcCountNetAdditions: calcCountNetAdditions,
 		invertedHeader:        invertedHeader,
 		invertedData:          &segmentInvertedData{},
+		compressedData:        compressedData,
+		checksums:             checksums,
+		verifyChecksumsOnRead: verify.onRead,
+		cold:                  cold,
+	}
+
+	if compressedData != nil {
+		compressedData.verify = seg.verifyRange
 	}
 
 	// Using pread strategy requires file to remain open for segment lifetime
@@ -193,11 +258,15 @@ func newSegment(path string, logger logrus.FieldLogger, metrics *Metrics,
 func (s *segment) close() error {
 	var munmapErr, fileCloseErr error
 
-	m := mmap.MMap(s.contents)
-	munmapErr = m.Unmap()
+	munmapErr = s.mapped.Unmap()
 	if s.contentFile != nil {
 		fileCloseErr = s.contentFile.Close()
 	}
+	if s.cold != nil {
+		if err := s.cold.close(); err != nil && fileCloseErr == nil {
+			fileCloseErr = err
+		}
+	}
 
 	if munmapErr != nil || fileCloseErr != nil {
 		return fmt.Errorf("close segment: munmap: %v, close contents file: %w", munmapErr, fileCloseErr)
@@ -301,6 +370,15 @@ func (s *segment) markForDeletion() error {
 		return fmt.Errorf("mark segment deleted: %w", err)
 	}
 
+	// the marker is renamed after the segment, a stub without a marker would
+	// be loaded as a regular segment. Its store object is deleted by the
+	// segment group, see SegmentGroup.deleteColdSegment.
+	if s.cold != nil {
+		if err := markDeleted(coldMarkerPath(s.path)); err != nil {
+			return fmt.Errorf("mark cold marker deleted: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -332,7 +410,15 @@ func (s *segment) newNodeReader(offset nodeOffset) (*nodeReader, error) {
 		r   io.Reader
 		err error
 	)
-	if s.mmapContents {
+	if s.compressedData == nil && offset.end != 0 {
+		if err := s.verifyRange(offset.start, offset.end); err != nil {
+			return nil, fmt.Errorf("new nodeReader: %w", err)
+		}
+	}
+
+	if s.compressedData != nil {
+		r, err = s.compressedData.newReader(offset.start, offset.end)
+	} else if s.mmapContents {
 		contents := s.contents[offset.start:]
 		if offset.end != 0 {
 			contents = s.contents[offset.start:offset.end]
@@ -348,7 +434,13 @@ func (s *segment) newNodeReader(offset nodeOffset) (*nodeReader, error) {
 }
 
 func (s *segment) copyNode(b []byte, offset nodeOffset) error {
+	if s.compressedData != nil {
+		return s.compressedData.readAt(b, offset.start)
+	}
 	if s.mmapContents {
+		if err := s.verifyRange(offset.start, offset.end); err != nil {
+			return fmt.Errorf("copy node: %w", err)
+		}
 		copy(b, s.contents[offset.start:offset.end])
 		return nil
 	}
@@ -368,10 +460,26 @@ func (s *segment) bytesReaderFrom(in []byte) (*bytes.Reader, error) {
 }
 
 func (s *segment) bufferedReaderAt(offset uint64) (*bufio.Reader, error) {
+	contentFile, err := s.contentReader()
+	if err != nil {
+		return nil, err
+	}
+
+	// the contents end before the checksum footer, if there is one
+	r := io.NewSectionReader(contentFile, int64(offset), int64(len(s.contents))-int64(offset))
+	return bufio.NewReader(r), nil
+}
+
+// contentReader returns the file to pread data from. For cold segments this
+// is the cached copy, which is fetched on first use.
+func (s *segment) contentReader() (io.ReaderAt, error) {
+	if s.cold != nil {
+		return s.cold.reader()
+	}
+
 	if s.contentFile == nil {
 		return nil, fmt.Errorf("nil contentFile for segment at %s", s.path)
 	}
 
-	r := io.NewSectionReader(s.contentFile, int64(offset), s.size)
-	return bufio.NewReader(r), nil
+	return s.contentFile, nil
 }
@@ -141,8 +141,11 @@ type SegmentBlockMax struct {
 	propertyBoost        float32
 
 	currentBlockImpact float32
-	tombstones         *sroar.Bitmap
-	filterDocIds       helpers.AllowList
+	// if set, documents are scored by the product of idf, which holds the
+	// query weight, and the frequency instead of BM25
+	dotProduct   bool
+	tombstones   *sroar.Bitmap
+	filterDocIds helpers.AllowList
 
 	// at position 0 we have the doc ids decoder, at position 1 is the tfs decoder
 	decoders []varenc.VarEncEncoder[uint64]
@@ -189,6 +192,50 @@ func NewSegmentBlockMax(s *segment, key []byte, queryTermIndex int, idf float64,
 	return output
 }
 
+// NewSegmentBlockMaxDotProduct returns a posting list of the segment that
+// scores documents by the product of the query weight and the stored
+// frequency, as used for sparse vectors
+func NewSegmentBlockMaxDotProduct(s *segment, key []byte, queryTermIndex int, weight float32, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList) *SegmentBlockMax {
+	node, err := s.index.Get(key)
+	if err != nil {
+		return nil
+	}
+
+	codecs := s.invertedHeader.DataFields
+	decoders := make([]varenc.VarEncEncoder[uint64], len(codecs))
+
+	for i, codec := range codecs {
+		decoders[i] = varenc.GetVarEncEncoder64(codec)
+	}
+
+	output := &SegmentBlockMax{
+		segment:        s,
+		node:           node,
+		idf:            float64(weight),
+		queryTermIndex: queryTermIndex,
+		decoders:       decoders,
+		propertyBoost:  1,
+		filterDocIds:   filterDocIds,
+		tombstones:     tombstones,
+		dotProduct:     true,
+	}
+
+	err = output.reset()
+	if err != nil {
+		return nil
+	}
+	if !output.exhausted && len(output.blockEntries) > 0 {
+		// decoding a posting list that is stored in full does not compute the
+		// impact
+		output.currentBlockImpact = output.computeCurrentBlockImpact()
+	}
+	output.Metrics.BlockCountTotal += uint64(len(output.blockEntries))
+	output.Metrics.DocCountTotal += output.docCount
+	output.Metrics.LastAddedBlock = -1
+
+	return output
+}
+
 func NewSegmentBlockMaxTest(docCount uint64, blockEntries []*terms.BlockEntry, blockDatas []*terms.BlockData, propLengths map[uint64]uint32, key []byte, queryTermIndex int, idf float64, propertyBoost float32, tombstones *sroar.Bitmap, filterDocIds helpers.AllowList, averagePropLength float64, config schema.BM25Config, codecs []varenc.VarEncDataType) *SegmentBlockMax {
 	decoders := make([]varenc.VarEncEncoder[uint64], len(codecs))
 
@@ -278,6 +325,9 @@ func (s *SegmentBlockMax) reset() error {
 	s.decoded = true
 
 	s.advanceOnTombstoneOrFilter()
+	if !s.exhausted {
+		s.idPointer = s.blockDataDecoded.DocIds[s.blockDataIdx]
+	}
 
 	return nil
 }
@@ -426,7 +476,10 @@ func (s *SegmentBlockMax) Score(averagePropLength float64, additionalExplanation
 
 	freq := float32(s.blockDataDecoded.Tfs[s.blockDataIdx])
 	propLength := s.propLengths[s.blockDataDecoded.DocIds[s.blockDataIdx]]
-	tf := freq / (freq + s.k1*(1-s.b+s.b*(float32(propLength)/s.averagePropLength)))
+	tf := freq
+	if !s.dotProduct {
+		tf = freq / (freq + s.k1*(1-s.b+s.b*(float32(propLength)/s.averagePropLength)))
+	}
 	s.Metrics.DocCountScored++
 	if s.blockEntryIdx != s.Metrics.LastAddedBlock {
 		s.Metrics.BlockCountDecodedFreqs++
@@ -475,6 +528,11 @@ func (s *SegmentBlockMax) Advance() {
 
 func (s *SegmentBlockMax) computeCurrentBlockImpact() float32 {
 	freq := float32(s.blockEntries[s.blockEntryIdx].MaxImpactTf)
+	if s.dotProduct {
+		// all documents have the same length, so the max impact of the block
+		// is the one with the highest frequency
+		return float32(s.idf) * freq
+	}
 	propLength := float32(s.blockEntries[s.blockEntryIdx].MaxImpactPropLength)
 	return float32(s.idf) * (freq / (freq + s.k1*(1-s.b+s.b*(propLength/float32(s.averagePropLength))))) * s.propertyBoost
 }
@@ -0,0 +1,317 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// SegmentCache holds local copies of cold segments that were fetched from
+// the SegmentStore. When it grows beyond its maximum size, the least recently
+// used segments are evicted. Segments that are currently being read can't be
+// evicted, so the maximum size is a soft limit.
+type SegmentCache struct {
+	dir       string
+	maxSize   int64
+	sizeGauge prometheus.Gauge
+
+	mu   sync.Mutex
+	lru  *list.List
+	size int64
+}
+
+// NewSegmentCache creates a cache in dir. Anything in dir is deleted, as
+// cached segments are not reused across restarts. The gauge is optional.
+func NewSegmentCache(dir string, maxSize int64, sizeGauge prometheus.Gauge,
+) (*SegmentCache, error) {
+	if err := os.RemoveAll(dir); err != nil {
+		return nil, fmt.Errorf("clear segment cache: %w", err)
+	}
+	if err := os.MkdirAll(dir, 0o777); err != nil {
+		return nil, fmt.Errorf("create segment cache: %w", err)
+	}
+
+	return &SegmentCache{
+		dir:       dir,
+		maxSize:   maxSize,
+		sizeGauge: sizeGauge,
+		lru:       list.New(),
+	}, nil
+}
+
+func (c *SegmentCache) path(key string) string {
+	return filepath.Join(c.dir, url.PathEscape(key))
+}
+
+// Size is the total size of all cached segments
+func (c *SegmentCache) Size() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.size
+}
+
+// add registers a freshly downloaded segment and evicts others until the
+// cache fits its maximum size again, or no other segment can be evicted. The
+// caller must hold seg's lock.
+func (c *SegmentCache) add(seg *coldSegment) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	seg.elem = c.lru.PushFront(seg)
+	c.size += seg.size
+
+	c.shrinkLocked(seg)
+	c.reportSize()
+}
+
+// shrink evicts segments until the cache fits its maximum size. A segment
+// that is being read can't be evicted, this includes all segments of the
+// group that triggered a fetch. Segment groups therefore call shrink
+// periodically.
+func (c *SegmentCache) shrink() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shrinkLocked(nil)
+	c.reportSize()
+}
+
+func (c *SegmentCache) shrinkLocked(keep *coldSegment) {
+	for e := c.lru.Back(); e != nil && c.size > c.maxSize; {
+		prev := e.Prev()
+		other := e.Value.(*coldSegment)
+		if other != keep && other.tryEvict != nil && other.tryEvict() {
+			c.removeLocked(other)
+		}
+		e = prev
+	}
+}
+
+func (c *SegmentCache) touch(seg *coldSegment) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if seg.elem != nil {
+		c.lru.MoveToFront(seg.elem)
+	}
+}
+
+func (c *SegmentCache) remove(seg *coldSegment) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.removeLocked(seg)
+	c.reportSize()
+}
+
+func (c *SegmentCache) removeLocked(seg *coldSegment) {
+	if seg.elem == nil {
+		return
+	}
+
+	c.lru.Remove(seg.elem)
+	seg.elem = nil
+	c.size -= seg.size
+}
+
+func (c *SegmentCache) reportSize() {
+	if c.sizeGauge != nil {
+		c.sizeGauge.Set(float64(c.size))
+	}
+}
+
+// coldSegment is the part of a segment that only exists in the SegmentStore.
+// Its data is fetched into the SegmentCache on the first read.
+type coldSegment struct {
+	key     string
+	store   SegmentStore
+	cache   *SegmentCache
+	metrics *Metrics
+
+	// tryEvict is set by the owning segment group, it checks that no reader
+	// holds the segment before calling evict
+	tryEvict func() bool
+
+	mu   sync.Mutex
+	file *os.File
+	size int64
+	elem *list.Element // position in the cache, guarded by the cache
+
+	// deleteMarker is set if the segment was replaced by a compaction while a
+	// snapshot still read it. Its data is deleted from the store on close.
+	deleteMarker string
+	// retained is set once the marker was copied into a backup, the data in
+	// the store is then never deleted, see SegmentGroup.retainColdSegments
+	retained bool
+}
+
+func newColdSegment(key string, store SegmentStore, cache *SegmentCache,
+	metrics *Metrics,
+) *coldSegment {
+	return &coldSegment{
+		key:     key,
+		store:   store,
+		cache:   cache,
+		metrics: metrics,
+	}
+}
+
+// reader returns the cached copy of the segment, fetching it if required.
+// The file remains valid until the segment is evicted, which only happens
+// while no reader holds the segment group's maintenanceLock or a pin on the
+// segment.
+func (c *coldSegment) reader() (*os.File, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file != nil {
+		c.cache.touch(c)
+		return c.file, nil
+	}
+
+	f, err := c.fetch()
+	c.metrics.TieringOperation("fetch", err)
+	if err != nil {
+		return nil, fmt.Errorf("fetch cold segment %q: %w", c.key, err)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("stat cached segment: %w", err)
+	}
+
+	c.file = f
+	c.size = info.Size()
+	c.cache.add(c)
+
+	return c.file, nil
+}
+
+func (c *coldSegment) fetch() (*os.File, error) {
+	path := c.cache.path(c.key)
+	tmpPath := path + ".tmp"
+	os.Remove(tmpPath)
+
+	if err := c.store.DownloadSegment(context.Background(), c.key, tmpPath); err != nil {
+		os.Remove(tmpPath)
+		return nil, err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return nil, err
+	}
+
+	return os.Open(path)
+}
+
+// evict drops the cached copy. The caller must make sure that nobody reads
+// from it, see SegmentGroup.tryEvictCold.
+func (c *coldSegment) evict() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	err := c.evictLocked()
+	c.metrics.TieringOperation("evict", err)
+	return err
+}
+
+func (c *coldSegment) evictLocked() error {
+	if c.file == nil {
+		return nil
+	}
+
+	if err := c.file.Close(); err != nil {
+		return fmt.Errorf("close cached segment: %w", err)
+	}
+	c.file = nil
+
+	return os.Remove(c.cache.path(c.key))
+}
+
+// close drops the cached copy and removes it from the cache, it is called
+// when the segment is closed
+func (c *coldSegment) close() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.file != nil {
+		if err := c.evictLocked(); err != nil {
+			return err
+		}
+		c.cache.remove(c)
+	}
+
+	if c.deleteMarker != "" {
+		return c.deleteLocked(c.deleteMarker)
+	}
+	return nil
+}
+
+// delete removes the data of the segment from the store and then its
+// marker, see SegmentGroup.deleteColdSegment
+func (c *coldSegment) delete(markerPath string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.deleteLocked(markerPath)
+}
+
+// retain marks the segment as referenced by a backup. The flag is persisted
+// in the marker before the marker is listed for the backup, so that the copy
+// in the backup carries it as well.
+func (c *coldSegment) retain(markerPath string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.retained {
+		return nil
+	}
+	if err := writeColdMarker(markerPath, c.key, true); err != nil {
+		return fmt.Errorf("retain cold segment %q: %w", c.key, err)
+	}
+	c.retained = true
+	return nil
+}
+
+func (c *coldSegment) deleteOnClose(markerPath string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.deleteMarker = markerPath
+}
+
+func (c *coldSegment) deleteLocked(markerPath string) error {
+	if !c.retained {
+		err := c.store.DeleteSegment(context.Background(), c.key)
+		c.metrics.TieringOperation("delete", err)
+		if err != nil {
+			return fmt.Errorf("delete cold segment %q: %w", c.key, err)
+		}
+	}
+
+	if err := os.Remove(markerPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("delete cold marker: %w", err)
+	}
+	c.deleteMarker = ""
+	return nil
+}
@@ -0,0 +1,333 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+// DefaultChecksumBlockSize is the size of the data section covered by a single
+// checksum. Verifying a read requires reading all blocks it touches, so this
+// is a trade-off between footer size and read amplification.
+const DefaultChecksumBlockSize = 64 * 1024
+
+// QuarantineSuffix is appended to segments and write-ahead-logs which failed
+// their checksum validation. They are no longer loaded, but kept for manual
+// inspection, e.g. with the lsmkv-inspect tool.
+const QuarantineSuffix = ".quarantined"
+
+// checksumSegmentFile adds a checksum footer to a completely written segment
+// and sets the [segmentindex.SegmentChecksumsFlag] in its header. It must run
+// after any other rewrite of the segment, such as compression. Segments that
+// already carry checksums are not touched.
+//
+// The file is changed in place. This is only safe for files that are not
+// live yet: flushed segments are discarded on startup as long as their WAL
+// still exists, compacted segments still have their .tmp suffix. The header
+// is written before the footer, so that a crash in between leaves a segment
+// that fails validation rather than one with trailing garbage.
+func checksumSegmentFile(path string, blockSize int) error {
+	f, err := os.OpenFile(path, os.O_RDWR, 0o666)
+	if err != nil {
+		return fmt.Errorf("open file: %w", err)
+	}
+	defer f.Close()
+
+	stat, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("stat file: %w", err)
+	}
+
+	header, err := segmentindex.ParseHeader(io.NewSectionReader(f, 0, segmentindex.HeaderSize))
+	if err != nil {
+		return fmt.Errorf("parse header: %w", err)
+	}
+
+	if header.HasChecksums() {
+		return nil
+	}
+
+	if header.IndexStart < segmentindex.HeaderSize || int64(header.IndexStart) > stat.Size() {
+		return fmt.Errorf("invalid index start %d for segment of %d bytes",
+			header.IndexStart, stat.Size())
+	}
+
+	header.Version |= segmentindex.SegmentChecksumsFlag
+	var headerBuf bytes.Buffer
+	if _, err := header.WriteTo(&headerBuf); err != nil {
+		return fmt.Errorf("serialize header: %w", err)
+	}
+
+	dataLen := int64(header.IndexStart) - segmentindex.HeaderSize
+	blockCount := (dataLen + int64(blockSize) - 1) / int64(blockSize)
+	checksums := &segmentindex.Checksums{
+		BlockSize: uint32(blockSize),
+		Blocks:    make([]uint32, blockCount),
+		Header:    crc32.ChecksumIEEE(headerBuf.Bytes()),
+	}
+
+	r := bufio.NewReaderSize(io.NewSectionReader(f, segmentindex.HeaderSize, dataLen), 256*1024)
+	buf := make([]byte, blockSize)
+	for i := range checksums.Blocks {
+		n, err := io.ReadFull(r, buf)
+		if err != nil && !(err == io.ErrUnexpectedEOF && i == len(checksums.Blocks)-1) {
+			return fmt.Errorf("read block %d: %w", i, err)
+		}
+		checksums.Blocks[i] = crc32.ChecksumIEEE(buf[:n])
+	}
+
+	indexHash := crc32.NewIEEE()
+	indexLen := stat.Size() - int64(header.IndexStart)
+	if _, err := io.Copy(indexHash, io.NewSectionReader(f, int64(header.IndexStart), indexLen)); err != nil {
+		return fmt.Errorf("read index: %w", err)
+	}
+	checksums.Index = indexHash.Sum32()
+
+	if _, err := f.WriteAt(headerBuf.Bytes(), 0); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("fsync header: %w", err)
+	}
+
+	var footerBuf bytes.Buffer
+	if _, err := checksums.WriteTo(&footerBuf); err != nil {
+		return fmt.Errorf("serialize checksums: %w", err)
+	}
+
+	if _, err := f.WriteAt(footerBuf.Bytes(), stat.Size()); err != nil {
+		return fmt.Errorf("write checksums: %w", err)
+	}
+
+	if err := f.Sync(); err != nil {
+		return fmt.Errorf("fsync checksums: %w", err)
+	}
+
+	return f.Close()
+}
+
+// checksumSegment adds checksums to a newly written segment of the segment
+// group if enabled, see checksumSegmentFile
+func (sg *SegmentGroup) checksumSegment(path string) error {
+	if !sg.writeChecksums {
+		return nil
+	}
+	return checksumSegmentFile(path, DefaultChecksumBlockSize)
+}
+
+// checksumVerification controls which checksums are verified in addition to
+// header and index, see WithSegmentChecksumVerification
+type checksumVerification struct {
+	onLoad bool
+	onRead bool
+}
+
+// segmentChecksums holds the checksums of a segment and knows which physical
+// range of the file each one covers
+type segmentChecksums struct {
+	*segmentindex.Checksums
+	// dataEnd is the physical start of the index, i.e. the end of the last
+	// block
+	dataEnd uint64
+	bufPool *sync.Pool
+}
+
+// loadSegmentChecksums parses the checksum footer of the segment contents and
+// verifies the header, the index and, if verifyData is set, all blocks of the
+// data section. It returns the contents without the footer. All validation
+// errors wrap ErrInvalidChecksum.
+func loadSegmentChecksums(contents []byte, header *segmentindex.Header,
+	verifyData bool,
+) (*segmentChecksums, []byte, error) {
+	footer, err := segmentindex.LoadChecksums(contents)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%v: %w", err, ErrInvalidChecksum)
+	}
+
+	footerStart := uint64(len(contents) - footer.Size())
+	if header.IndexStart < segmentindex.HeaderSize || header.IndexStart > footerStart {
+		return nil, nil, fmt.Errorf("index start %d outside of segment: %w",
+			header.IndexStart, ErrInvalidChecksum)
+	}
+
+	c := &segmentChecksums{
+		Checksums: footer,
+		dataEnd:   header.IndexStart,
+		bufPool: &sync.Pool{New: func() any {
+			buf := make([]byte, footer.BlockSize)
+			return &buf
+		}},
+	}
+
+	dataLen := header.IndexStart - segmentindex.HeaderSize
+	if expected := (dataLen + uint64(c.BlockSize) - 1) / uint64(c.BlockSize); expected != uint64(len(c.Blocks)) {
+		return nil, nil, fmt.Errorf("expected %d block checksums, got %d: %w",
+			expected, len(c.Blocks), ErrInvalidChecksum)
+	}
+
+	if crc32.ChecksumIEEE(contents[:segmentindex.HeaderSize]) != c.Header {
+		return nil, nil, fmt.Errorf("header: %w", ErrInvalidChecksum)
+	}
+
+	if crc32.ChecksumIEEE(contents[header.IndexStart:footerStart]) != c.Index {
+		return nil, nil, fmt.Errorf("index: %w", ErrInvalidChecksum)
+	}
+
+	if verifyData {
+		for i := range c.Blocks {
+			start, end := c.block(i)
+			if err := c.verifyBlock(i, contents[start:end]); err != nil {
+				return nil, nil, err
+			}
+		}
+	}
+
+	return c, contents[:footerStart], nil
+}
+
+// block returns the physical range covered by the checksum at pos
+func (c *segmentChecksums) block(pos int) (uint64, uint64) {
+	start := segmentindex.HeaderSize + uint64(pos)*uint64(c.BlockSize)
+	end := start + uint64(c.BlockSize)
+	if end > c.dataEnd {
+		end = c.dataEnd
+	}
+	return start, end
+}
+
+// blockFor returns the position of the block holding the physical offset
+func (c *segmentChecksums) blockFor(offset uint64) int {
+	return int((offset - segmentindex.HeaderSize) / uint64(c.BlockSize))
+}
+
+func (c *segmentChecksums) verifyBlock(pos int, data []byte) error {
+	if crc32.ChecksumIEEE(data) != c.Blocks[pos] {
+		start, _ := c.block(pos)
+		return fmt.Errorf("block %d at offset %d: %w", pos, start, ErrInvalidChecksum)
+	}
+	return nil
+}
+
+// verifyRange verifies all blocks overlapping the physical range [start,
+// end) if the segment carries checksums and verification on read is enabled
+func (s *segment) verifyRange(start, end uint64) error {
+	if s.checksums == nil || !s.verifyChecksumsOnRead {
+		return nil
+	}
+
+	if start < segmentindex.HeaderSize {
+		start = segmentindex.HeaderSize
+	}
+	if end > s.checksums.dataEnd {
+		end = s.checksums.dataEnd
+	}
+	if start >= end {
+		return nil
+	}
+
+	for pos := s.checksums.blockFor(start); pos <= s.checksums.blockFor(end-1); pos++ {
+		if err := s.verifyBlock(pos); err != nil {
+			s.metrics.ChecksumFailure(segmentStrategyName(s.strategy), "segment_read")
+			return fmt.Errorf("segment %s: %w", s.path, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *segment) verifyBlock(pos int) error {
+	start, end := s.checksums.block(pos)
+	if s.cold == nil && (s.mmapContents || s.compressedData != nil || s.contentFile == nil) {
+		return s.checksums.verifyBlock(pos, s.contents[start:end])
+	}
+
+	r, err := s.contentReader()
+	if err != nil {
+		return err
+	}
+
+	bufp := s.checksums.bufPool.Get().(*[]byte)
+	defer s.checksums.bufPool.Put(bufp)
+
+	buf := (*bufp)[:end-start]
+	if _, err := r.ReadAt(buf, int64(start)); err != nil {
+		return fmt.Errorf("read block %d: %w", pos, err)
+	}
+	return s.checksums.verifyBlock(pos, buf)
+}
+
+// quarantineSegment moves a corrupt segment out of the way, so that the
+// bucket can be loaded without it. Its derived files are deleted, as they
+// are rebuilt from the segment anyway.
+//
+// Leaving out a segment loses its keys, so unless enabled is set, the bucket
+// fails to load instead. Only a segment without older segments can be left
+// out at all. A newer segment holds the updates and deletes of keys of the
+// older ones, which would resurface without it.
+func quarantineSegment(path string, olderSegments int, enabled bool,
+	logger logrus.FieldLogger, metrics *Metrics, strategy string, cause error,
+) error {
+	if !enabled {
+		return fmt.Errorf("segment %s failed checksum validation. Repair the data, "+
+			"e.g. through replication or a backup, or enable quarantining of corrupt "+
+			"segments to load the bucket without it: %w", path, cause)
+	}
+
+	if olderSegments > 0 {
+		return fmt.Errorf("segment %s failed checksum validation and can't be left out, "+
+			"as older values of its keys in %d older segments would resurface. "+
+			"Repair the data, e.g. through replication or a backup: %w",
+			path, olderSegments, cause)
+	}
+
+	if err := os.Rename(path, path+QuarantineSuffix); err != nil {
+		return fmt.Errorf("quarantine segment %s: %w", path, err)
+	}
+
+	extless := strings.TrimSuffix(path, filepath.Ext(path))
+	derived, err := filepath.Glob(extless + ".secondary.*.bloom")
+	if err != nil {
+		return fmt.Errorf("list secondary bloom filters of %s: %w", path, err)
+	}
+	derived = append(derived, extless+".bloom", countNetPathFromSegmentPath(path))
+	for _, file := range derived {
+		if err := os.RemoveAll(file); err != nil {
+			return fmt.Errorf("delete derived file %s: %w", file, err)
+		}
+	}
+
+	if err := fsync(filepath.Dir(path)); err != nil {
+		return fmt.Errorf("fsync segment directory: %w", err)
+	}
+
+	metrics.QuarantinedFile(strategy, "segment")
+	logger.WithError(cause).WithFields(logrus.Fields{
+		"action": "lsm_segment_quarantine",
+		"path":   path,
+	}).Error("segment failed checksum validation, moved it aside and continuing " +
+		"without it. Its contents are missing until the data is repaired, e.g. " +
+		"through replication or a backup.")
+
+	return nil
+}
@@ -0,0 +1,367 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestSegmentChecksums(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+
+	openBucket := func(t *testing.T, dir string, opts ...BucketOption) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			append(opts, WithStrategy(StrategyReplace))...)
+		require.Nil(t, err)
+		return b
+	}
+
+	// writeSegments creates a bucket with two segments, the first one holds
+	// key-000 to key-009, the second one key-010 to key-019. It returns the
+	// paths of both segments.
+	writeSegments := func(t *testing.T, dir string) []string {
+		b := openBucket(t, dir, WithSegmentChecksums(true))
+		for i := 0; i < 20; i++ {
+			require.Nil(t, b.Put(key(i), bytes.Repeat([]byte("v"), 100)))
+			if i == 9 {
+				require.Nil(t, b.FlushAndSwitch())
+			}
+		}
+		require.Nil(t, b.Shutdown(ctx))
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		require.Len(t, files.Segments, 2)
+		return files.Segments
+	}
+
+	// flipByte corrupts the byte at offset, a negative offset counts from the
+	// start of the index
+	flipByte := func(t *testing.T, path string, offset int) {
+		contents, err := os.ReadFile(path)
+		require.Nil(t, err)
+
+		header, err := segmentindex.ParseHeader(bytes.NewReader(contents[:segmentindex.HeaderSize]))
+		require.Nil(t, err)
+		require.True(t, header.HasChecksums())
+		if offset < 0 {
+			offset = int(header.IndexStart) - offset
+		}
+
+		contents[offset] ^= 0xff
+		require.Nil(t, os.WriteFile(path, contents, 0o666))
+	}
+
+	t.Run("valid segments", func(t *testing.T) {
+		dir := t.TempDir()
+		writeSegments(t, dir)
+
+		b := openBucket(t, dir, WithSegmentChecksumVerification(true, true))
+		defer b.Shutdown(ctx)
+
+		for i := 0; i < 20; i++ {
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			assert.Len(t, v, 100)
+		}
+	})
+
+	t.Run("segments carry no checksums unless enabled", func(t *testing.T) {
+		dir := t.TempDir()
+		b := openBucket(t, dir)
+		require.Nil(t, b.Put(key(0), []byte("v")))
+		require.Nil(t, b.Shutdown(ctx))
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		require.Len(t, files.Segments, 1)
+
+		contents, err := os.ReadFile(files.Segments[0])
+		require.Nil(t, err)
+		header, err := segmentindex.ParseHeader(bytes.NewReader(contents[:segmentindex.HeaderSize]))
+		require.Nil(t, err)
+		assert.False(t, header.HasChecksums())
+		assert.Equal(t, uint16(0), header.Version)
+	})
+
+	t.Run("corrupt oldest segment fails the bucket by default", func(t *testing.T) {
+		dir := t.TempDir()
+		path := writeSegments(t, dir)[0]
+		flipByte(t, path, -1)
+
+		_, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace))
+		assert.ErrorIs(t, err, ErrInvalidChecksum)
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Len(t, files.Segments, 2)
+		assert.Len(t, files.Quarantined, 0)
+	})
+
+	t.Run("corrupt index is always detected on load", func(t *testing.T) {
+		dir := t.TempDir()
+		path := writeSegments(t, dir)[0]
+		flipByte(t, path, -1)
+
+		b := openBucket(t, dir, WithSegmentQuarantine(true))
+		defer b.Shutdown(ctx)
+
+		v, err := b.Get(key(0))
+		require.Nil(t, err)
+		assert.Nil(t, v)
+		v, err = b.Get(key(10))
+		require.Nil(t, err)
+		assert.NotNil(t, v)
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Len(t, files.Segments, 1)
+		assert.Equal(t, []string{path + QuarantineSuffix}, files.Quarantined)
+	})
+
+	t.Run("corrupt data is detected on load if enabled", func(t *testing.T) {
+		dir := t.TempDir()
+		path := writeSegments(t, dir)[0]
+		flipByte(t, path, segmentindex.HeaderSize+20)
+
+		b := openBucket(t, dir, WithSegmentChecksumVerification(true, false),
+			WithSegmentQuarantine(true))
+		defer b.Shutdown(ctx)
+
+		v, err := b.Get(key(0))
+		require.Nil(t, err)
+		assert.Nil(t, v)
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Equal(t, []string{path + QuarantineSuffix}, files.Quarantined)
+	})
+
+	t.Run("corrupt newer segment fails the bucket", func(t *testing.T) {
+		dir := t.TempDir()
+		path := writeSegments(t, dir)[1]
+		flipByte(t, path, -1)
+
+		// leaving it out would resurface older values of its keys
+		_, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace), WithSegmentQuarantine(true))
+		assert.ErrorIs(t, err, ErrInvalidChecksum)
+		assert.ErrorContains(t, err, "1 older segments")
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Equal(t, []string{path}, files.Segments[1:])
+		assert.Len(t, files.Quarantined, 0)
+	})
+
+	t.Run("corrupt data is detected on read if enabled", func(t *testing.T) {
+		dir := t.TempDir()
+		path := writeSegments(t, dir)[1]
+		flipByte(t, path, segmentindex.HeaderSize+20)
+
+		b := openBucket(t, dir, WithSegmentChecksumVerification(false, true))
+		defer b.Shutdown(ctx)
+
+		_, err := b.Get(key(10))
+		assert.ErrorIs(t, err, ErrInvalidChecksum)
+
+		// the other segment is not affected
+		v, err := b.Get(key(0))
+		require.Nil(t, err)
+		assert.NotNil(t, v)
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Len(t, files.Segments, 2)
+		assert.Len(t, files.Quarantined, 0)
+	})
+
+	t.Run("compressed segments", func(t *testing.T) {
+		dir := t.TempDir()
+		b := openBucket(t, dir, WithCompression(CompressionSnappy), WithSegmentChecksums(true))
+		for i := 0; i < 20; i++ {
+			require.Nil(t, b.Put(key(i), bytes.Repeat([]byte("v"), 100)))
+		}
+		require.Nil(t, b.Shutdown(ctx))
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		require.Len(t, files.Segments, 1)
+
+		b = openBucket(t, dir, WithSegmentChecksumVerification(true, true))
+		v, err := b.Get(key(3))
+		require.Nil(t, err)
+		assert.Len(t, v, 100)
+		require.Nil(t, b.Shutdown(ctx))
+
+		// corrupt the first compressed block, which follows the headers
+		flipByte(t, files.Segments[0], segmentindex.HeaderSize+segmentindex.HeaderCompressionSize+16)
+		b = openBucket(t, dir, WithSegmentChecksumVerification(false, true))
+		defer b.Shutdown(ctx)
+
+		_, err = b.Get(key(3))
+		assert.ErrorIs(t, err, ErrInvalidChecksum)
+	})
+
+	t.Run("inspector validates checksums", func(t *testing.T) {
+		dir := t.TempDir()
+		path := writeSegments(t, dir)[1]
+
+		insp, err := OpenSegmentInspector(path, logger)
+		require.Nil(t, err)
+		info, err := insp.Info()
+		require.Nil(t, err)
+		assert.True(t, info.Checksums)
+		assert.Nil(t, insp.Validate())
+		require.Nil(t, insp.Close())
+
+		flipByte(t, path, segmentindex.HeaderSize+20)
+		insp, err = OpenSegmentInspector(path, logger)
+		require.Nil(t, err)
+		defer insp.Close()
+		assert.ErrorIs(t, insp.Validate(), ErrInvalidChecksum)
+	})
+}
+
+func TestWALChecksumQuarantine(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	openBucket := func(t *testing.T, dir string) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+		return b
+	}
+
+	dir := t.TempDir()
+	b := openBucket(t, dir)
+	for i := 0; i < 10; i++ {
+		require.Nil(t, b.Put([]byte(fmt.Sprintf("key-%d", i)), []byte("value")))
+	}
+	require.Nil(t, b.WriteWAL())
+
+	files, err := ListBucketFiles(dir)
+	require.Nil(t, err)
+	require.Len(t, files.WALs, 1)
+	data, err := os.ReadFile(files.WALs[0])
+	require.Nil(t, err)
+	require.Nil(t, b.Shutdown(ctx))
+
+	// corrupt the checksum of the last record in a copy of the log
+	recoverDir := t.TempDir()
+	walPath := filepath.Join(recoverDir, filepath.Base(files.WALs[0]))
+	data[len(data)-1] ^= 0xff
+	require.Nil(t, os.WriteFile(walPath, data, 0o666))
+
+	b = openBucket(t, recoverDir)
+	defer b.Shutdown(ctx)
+
+	for i := 0; i < 9; i++ {
+		v, err := b.Get([]byte(fmt.Sprintf("key-%d", i)))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("value"), v)
+	}
+	v, err := b.Get([]byte("key-9"))
+	require.Nil(t, err)
+	assert.Nil(t, v)
+
+	quarantined, err := os.ReadFile(walPath + QuarantineSuffix)
+	require.Nil(t, err)
+	assert.Equal(t, data, quarantined)
+}
+
+// BenchmarkFlushChecksums measures the cost of adding checksums to a flushed
+// segment, which requires reading the segment once more after it is written.
+// The checksum pass alone is measured by BenchmarkChecksumSegmentFile.
+func BenchmarkFlushChecksums(b *testing.B) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	value := make([]byte, 4096)
+	const count = 16 * 1024
+
+	for _, writeChecksums := range []bool{false, true} {
+		b.Run(fmt.Sprintf("checksums=%t", writeChecksums), func(b *testing.B) {
+			b.SetBytes(count * int64(len(value)))
+
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				bucket, err := NewBucketCreator().NewBucket(ctx, b.TempDir(), "", logger, nil,
+					cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+					WithStrategy(StrategyReplace), WithSegmentChecksums(writeChecksums))
+				require.Nil(b, err)
+				for j := 0; j < count; j++ {
+					require.Nil(b, bucket.Put([]byte(fmt.Sprintf("key-%06d", j)), value))
+				}
+				b.StartTimer()
+
+				require.Nil(b, bucket.FlushAndSwitch())
+
+				b.StopTimer()
+				require.Nil(b, bucket.Shutdown(ctx))
+				b.StartTimer()
+			}
+		})
+	}
+}
+
+func BenchmarkChecksumSegmentFile(b *testing.B) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	value := make([]byte, 4096)
+	const count = 16 * 1024
+
+	dir := b.TempDir()
+	bucket, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		WithStrategy(StrategyReplace))
+	require.Nil(b, err)
+	for j := 0; j < count; j++ {
+		require.Nil(b, bucket.Put([]byte(fmt.Sprintf("key-%06d", j)), value))
+	}
+	require.Nil(b, bucket.Shutdown(ctx))
+
+	files, err := ListBucketFiles(dir)
+	require.Nil(b, err)
+	require.Len(b, files.Segments, 1)
+	contents, err := os.ReadFile(files.Segments[0])
+	require.Nil(b, err)
+	b.SetBytes(int64(len(contents)))
+
+	path := filepath.Join(b.TempDir(), "segment.db")
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		require.Nil(b, os.WriteFile(path, contents, 0o666))
+		b.StartTimer()
+
+		require.Nil(b, checksumSegmentFile(path, DefaultChecksumBlockSize))
+	}
+}
@@ -138,6 +138,10 @@ func (p *segmentCleanerReplace) writeIndices(keys []segmentindex.Key) error {
 		ScratchSpacePath:    p.scratchSpacePath,
 	}
 
+	if err := endSegmentData(p.bufw, p.w); err != nil {
+		return err
+	}
+
 	_, err := indices.WriteTo(p.bufw)
 	return err
 }
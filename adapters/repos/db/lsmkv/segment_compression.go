@@ -0,0 +1,495 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
+)
+
+const (
+	CompressionNone   = "none"
+	CompressionSnappy = "snappy"
+	CompressionZstd   = "zstd"
+)
+
+// DefaultCompressionBlockSize is the uncompressed size of a single block. A
+// point lookup needs to decompress at least one full block, so this is a
+// trade-off between compression ratio and read amplification.
+const DefaultCompressionBlockSize = 64 * 1024
+
+func SegmentCompressionFromString(in string) (segmentindex.Compression, error) {
+	switch in {
+	case "", CompressionNone:
+		return segmentindex.CompressionNone, nil
+	case CompressionSnappy:
+		return segmentindex.CompressionSnappy, nil
+	case CompressionZstd:
+		return segmentindex.CompressionZstd, nil
+	default:
+		return segmentindex.CompressionNone, fmt.Errorf("unsupported compression %q", in)
+	}
+}
+
+// segmentCompressionSupported indicates whether a strategy can be stored in
+// block-compressed segments. The roaring set and inverted strategies read
+// their contents directly from the mmaped file and are always written
+// uncompressed.
+func segmentCompressionSupported(strategy segmentindex.Strategy) bool {
+	switch strategy {
+	case segmentindex.StrategyReplace, segmentindex.StrategySetCollection,
+		segmentindex.StrategyMapCollection:
+		return true
+	default:
+		return false
+	}
+}
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedDefault))
+	zstdDecoder, _ = zstd.NewReader(nil, zstd.WithDecoderConcurrency(0))
+)
+
+func compressBlock(codec segmentindex.Compression, dst, src []byte) ([]byte, error) {
+	switch codec {
+	case segmentindex.CompressionSnappy:
+		return s2.EncodeSnappy(dst[:cap(dst)], src), nil
+	case segmentindex.CompressionZstd:
+		return zstdEncoder.EncodeAll(src, dst[:0]), nil
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %s", codec)
+	}
+}
+
+func decompressBlock(codec segmentindex.Compression, dst, src []byte) ([]byte, error) {
+	switch codec {
+	case segmentindex.CompressionSnappy:
+		return s2.Decode(dst[:cap(dst)], src)
+	case segmentindex.CompressionZstd:
+		return zstdDecoder.DecodeAll(src, dst[:0])
+	default:
+		return nil, fmt.Errorf("unsupported compression codec %s", codec)
+	}
+}
+
+// compressedSegmentWriter compresses a segment while it is written. It is
+// handed the segment in the uncompressed (v0) layout, so that the flush and
+// compaction code write the same bytes whether compression is enabled or not.
+// The data section is compressed block by block. The indexes are passed on
+// unchanged once endData marked the end of the data section, only the
+// secondary index offsets are shifted to the physical layout. The header is
+// held back, as the compactors only write it at the very end, and finish
+// turns it into the header of a compressed segment.
+type compressedSegmentWriter struct {
+	w                io.WriteSeeker
+	secondaryIndices uint16
+	header           *segmentindex.HeaderCompression
+
+	// pos is the position in the uncompressed layout, physical the one in the
+	// underlying writer
+	pos      uint64
+	physical uint64
+
+	rawHeader [segmentindex.HeaderSize]byte
+	// only the header may be written again after seeking to the start
+	rewound bool
+
+	block      []byte
+	compressed []byte
+
+	// indexStart is the physical start of the indexes, 0 until endData
+	indexStart       uint64
+	secondaryOffsets []byte
+}
+
+func newCompressedSegmentWriter(w io.WriteSeeker, codec segmentindex.Compression,
+	blockSize int, secondaryIndices uint16,
+) *compressedSegmentWriter {
+	return &compressedSegmentWriter{
+		w:                w,
+		secondaryIndices: secondaryIndices,
+		header: &segmentindex.HeaderCompression{
+			Codec:     codec,
+			BlockSize: uint32(blockSize),
+		},
+		block: make([]byte, 0, blockSize),
+	}
+}
+
+func (w *compressedSegmentWriter) Write(p []byte) (int, error) {
+	written := len(p)
+	for len(p) > 0 {
+		switch {
+		case w.pos < segmentindex.HeaderSize:
+			n := copy(w.rawHeader[w.pos:], p)
+			w.pos += uint64(n)
+			p = p[n:]
+
+		case w.rewound:
+			return 0, fmt.Errorf("write at %d: only the header can be rewritten", w.pos)
+
+		case w.indexStart == 0:
+			n := copy(w.block[len(w.block):cap(w.block)], p)
+			w.block = w.block[:len(w.block)+n]
+			w.pos += uint64(n)
+			p = p[n:]
+			if len(w.block) == cap(w.block) {
+				if err := w.flushBlock(); err != nil {
+					return 0, err
+				}
+			}
+
+		default:
+			n, err := w.writeIndexes(p)
+			if err != nil {
+				return 0, err
+			}
+			w.pos += uint64(n)
+			p = p[n:]
+		}
+	}
+
+	return written, nil
+}
+
+// Seek only supports seeking to the start, which the compactors do to write
+// the header once everything else has been written
+func (w *compressedSegmentWriter) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, fmt.Errorf("compressed segment writer can only seek to the start")
+	}
+
+	w.pos = 0
+	w.rewound = true
+	return 0, nil
+}
+
+func (w *compressedSegmentWriter) writeRaw(p []byte) error {
+	if w.physical == 0 {
+		// leave room for the headers, they are written by finish
+		reserved := make([]byte, segmentindex.HeaderSize+segmentindex.HeaderCompressionSize)
+		if _, err := w.w.Write(reserved); err != nil {
+			return fmt.Errorf("write empty headers: %w", err)
+		}
+		w.physical = uint64(len(reserved))
+	}
+
+	n, err := w.w.Write(p)
+	w.physical += uint64(n)
+	return err
+}
+
+func (w *compressedSegmentWriter) flushBlock() error {
+	if len(w.block) == 0 {
+		return nil
+	}
+
+	compressed, err := compressBlock(w.header.Codec, w.compressed, w.block)
+	if err != nil {
+		return fmt.Errorf("compress block %d: %w", len(w.header.BlockOffsets), err)
+	}
+	w.compressed = compressed
+
+	// an empty write only reserves the headers, so that the block starts
+	// after them
+	if err := w.writeRaw(nil); err != nil {
+		return err
+	}
+	w.header.BlockOffsets = append(w.header.BlockOffsets, w.physical)
+	if err := w.writeRaw(compressed); err != nil {
+		return fmt.Errorf("write block %d: %w", len(w.header.BlockOffsets)-1, err)
+	}
+
+	w.block = w.block[:0]
+	return nil
+}
+
+// endData marks the end of the data section. It compresses the last block and
+// writes the block offsets, everything written afterwards is part of the
+// indexes.
+func (w *compressedSegmentWriter) endData() error {
+	if w.indexStart != 0 {
+		return fmt.Errorf("end of data section marked twice")
+	}
+	if w.pos < segmentindex.HeaderSize {
+		return fmt.Errorf("end of data section at %d within the header", w.pos)
+	}
+
+	if err := w.flushBlock(); err != nil {
+		return err
+	}
+	if err := w.writeRaw(nil); err != nil {
+		return err
+	}
+
+	w.header.BlockOffsets = append(w.header.BlockOffsets, w.physical)
+	w.header.BlockCount = uint32(len(w.header.BlockOffsets) - 1)
+	w.header.DataEnd = w.pos
+
+	var buf bytes.Buffer
+	if _, err := w.header.WriteBlockOffsetsTo(&buf); err != nil {
+		return err
+	}
+	if err := w.writeRaw(buf.Bytes()); err != nil {
+		return fmt.Errorf("write block offsets: %w", err)
+	}
+
+	w.indexStart = w.physical
+	return nil
+}
+
+// writeIndexes passes the indexes on to the underlying writer. They start
+// with the offsets of the secondary indexes, which are absolute positions in
+// the file and need to be shifted to the physical start of the indexes.
+func (w *compressedSegmentWriter) writeIndexes(p []byte) (int, error) {
+	offsetsLen := int(w.secondaryIndices) * 8
+	if missing := offsetsLen - len(w.secondaryOffsets); missing > 0 {
+		n := min(missing, len(p))
+		w.secondaryOffsets = append(w.secondaryOffsets, p[:n]...)
+		if len(w.secondaryOffsets) < offsetsLen {
+			return n, nil
+		}
+
+		for i := 0; i < offsetsLen; i += 8 {
+			pos := binary.LittleEndian.Uint64(w.secondaryOffsets[i : i+8])
+			binary.LittleEndian.PutUint64(w.secondaryOffsets[i:i+8],
+				pos-w.header.DataEnd+w.indexStart)
+		}
+		if err := w.writeRaw(w.secondaryOffsets); err != nil {
+			return 0, fmt.Errorf("write secondary index offsets: %w", err)
+		}
+		return n, nil
+	}
+
+	if err := w.writeRaw(p); err != nil {
+		return 0, fmt.Errorf("write indexes: %w", err)
+	}
+	return len(p), nil
+}
+
+// finish writes the headers of the compressed segment. It must be called once
+// the header of the uncompressed layout has been written for the last time.
+func (w *compressedSegmentWriter) finish() error {
+	if w.indexStart == 0 {
+		return fmt.Errorf("end of data section was never marked")
+	}
+	if len(w.secondaryOffsets) < int(w.secondaryIndices)*8 {
+		return fmt.Errorf("incomplete secondary index offsets")
+	}
+
+	header, err := segmentindex.ParseHeader(bytes.NewReader(w.rawHeader[:]))
+	if err != nil {
+		return fmt.Errorf("parse header: %w", err)
+	}
+	if header.IndexStart != w.header.DataEnd {
+		return fmt.Errorf("header points to the index at %d, but the data section ends at %d",
+			header.IndexStart, w.header.DataEnd)
+	}
+	header.Version = segmentindex.SegmentV1Compressed
+	header.IndexStart = w.indexStart
+
+	if _, err := w.w.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("seek to beginning to write headers: %w", err)
+	}
+	if _, err := header.WriteTo(w.w); err != nil {
+		return fmt.Errorf("write header: %w", err)
+	}
+	if _, err := w.header.WriteTo(w.w); err != nil {
+		return fmt.Errorf("write compression header: %w", err)
+	}
+
+	return nil
+}
+
+// newSegmentWriter returns the writer for a new segment on top of w. If the
+// segment is compressed, it is compressed while it is written, see
+// compressedSegmentWriter. In that case, the writer relies on endSegmentData
+// before the indexes are written and finishSegment at the very end.
+func newSegmentWriter(w io.WriteSeeker, codec segmentindex.Compression,
+	strategy segmentindex.Strategy, secondaryIndices uint16,
+) io.WriteSeeker {
+	if codec == segmentindex.CompressionNone || !segmentCompressionSupported(strategy) {
+		return w
+	}
+	return newCompressedSegmentWriter(w, codec, DefaultCompressionBlockSize, secondaryIndices)
+}
+
+// endSegmentData marks the end of the data section if w compresses the
+// segment. buffered is the buffer on top of w, it is flushed first.
+func endSegmentData(buffered *bufio.Writer, w io.Writer) error {
+	cw, ok := w.(*compressedSegmentWriter)
+	if !ok {
+		return nil
+	}
+
+	if err := buffered.Flush(); err != nil {
+		return err
+	}
+	return cw.endData()
+}
+
+// finishSegment writes the headers if w compresses the segment
+func finishSegment(w io.Writer) error {
+	cw, ok := w.(*compressedSegmentWriter)
+	if !ok {
+		return nil
+	}
+	return cw.finish()
+}
+
+// segmentCompressedData provides access to the logical (uncompressed) data
+// section of a block-compressed segment.
+type segmentCompressedData struct {
+	header   *segmentindex.HeaderCompression
+	contents []byte
+	bufPool  *sync.Pool
+	// verifies the checksums of a physical range of the segment before it is
+	// decompressed, nil if the segment has no checksums
+	verify func(start, end uint64) error
+}
+
+func newSegmentCompressedData(contents []byte, indexStart uint64) (*segmentCompressedData, error) {
+	header, err := segmentindex.LoadHeaderCompression(contents, indexStart)
+	if err != nil {
+		return nil, err
+	}
+
+	offsetsStart := indexStart - uint64(header.BlockOffsetsSize())
+	if header.BlockOffsets[header.BlockCount] > offsetsStart {
+		return nil, fmt.Errorf("compressed blocks end at %d, beyond the block offsets at %d",
+			header.BlockOffsets[header.BlockCount], offsetsStart)
+	}
+
+	blockSize := int(header.BlockSize)
+	return &segmentCompressedData{
+		header:   header,
+		contents: contents,
+		bufPool: &sync.Pool{New: func() any {
+			buf := make([]byte, 0, blockSize)
+			return &buf
+		}},
+	}, nil
+}
+
+// blockFor returns the index of the block holding the logical offset
+func (c *segmentCompressedData) blockFor(offset uint64) int {
+	return int((offset - segmentindex.HeaderSize) / uint64(c.header.BlockSize))
+}
+
+func (c *segmentCompressedData) blockStart(block int) uint64 {
+	return segmentindex.HeaderSize + uint64(block)*uint64(c.header.BlockSize)
+}
+
+func (c *segmentCompressedData) decompress(dst []byte, block int) ([]byte, error) {
+	if block < 0 || block >= int(c.header.BlockCount) {
+		return nil, fmt.Errorf("block %d out of range, segment has %d blocks",
+			block, c.header.BlockCount)
+	}
+
+	start := c.header.BlockOffsets[block]
+	end := c.header.BlockOffsets[block+1]
+	if c.verify != nil {
+		if err := c.verify(start, end); err != nil {
+			return nil, err
+		}
+	}
+
+	out, err := decompressBlock(c.header.Codec, dst, c.contents[start:end])
+	if err != nil {
+		return nil, fmt.Errorf("decompress block %d: %w", block, err)
+	}
+
+	return out, nil
+}
+
+// readAt fills b with the logical contents starting at offset
+func (c *segmentCompressedData) readAt(b []byte, offset uint64) error {
+	if offset+uint64(len(b)) > c.header.DataEnd {
+		return fmt.Errorf("read of %d bytes at %d beyond end of data %d",
+			len(b), offset, c.header.DataEnd)
+	}
+
+	bufp := c.bufPool.Get().(*[]byte)
+	defer c.bufPool.Put(bufp)
+
+	for len(b) > 0 {
+		block := c.blockFor(offset)
+		decompressed, err := c.decompress(*bufp, block)
+		if err != nil {
+			return err
+		}
+		*bufp = decompressed
+
+		n := copy(b, decompressed[offset-c.blockStart(block):])
+		b = b[n:]
+		offset += uint64(n)
+	}
+
+	return nil
+}
+
+// newReader returns a reader over the logical contents from start up to end.
+// If end is 0, the reader continues until the end of the data section.
+func (c *segmentCompressedData) newReader(start, end uint64) (io.Reader, error) {
+	if end == 0 || end > c.header.DataEnd {
+		end = c.header.DataEnd
+	}
+
+	if start >= end {
+		return nil, lsmkv.NotFound
+	}
+
+	return &compressedDataReader{data: c, offset: start, end: end, block: -1}, nil
+}
+
+type compressedDataReader struct {
+	data   *segmentCompressedData
+	offset uint64
+	end    uint64
+	block  int
+	buf    []byte
+}
+
+func (r *compressedDataReader) Read(b []byte) (int, error) {
+	if r.offset >= r.end {
+		return 0, io.EOF
+	}
+
+	block := r.data.blockFor(r.offset)
+	if block != r.block {
+		decompressed, err := r.data.decompress(r.buf, block)
+		if err != nil {
+			return 0, err
+		}
+		r.buf = decompressed
+		r.block = block
+	}
+
+	blockOffset := r.offset - r.data.blockStart(block)
+	remaining := r.buf[blockOffset:]
+	if uint64(len(remaining)) > r.end-r.offset {
+		remaining = remaining[:r.end-r.offset]
+	}
+
+	n := copy(b, remaining)
+	r.offset += uint64(n)
+	return n, nil
+}
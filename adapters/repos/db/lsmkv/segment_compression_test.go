@@ -0,0 +1,268 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestSegmentCompression(t *testing.T) {
+	ctx := context.Background()
+	tests := bucketTests{}
+	for _, compression := range []string{CompressionSnappy, CompressionZstd} {
+		tests = append(tests,
+			bucketTest{
+				name: "compressionReplace_" + compression,
+				f:    compressionReplace,
+				opts: []BucketOption{
+					WithStrategy(StrategyReplace),
+					WithSecondaryIndices(1),
+					WithCompression(compression),
+				},
+			},
+			bucketTest{
+				name: "compressionMapCollection_" + compression,
+				f:    compressionMapCollection,
+				opts: []BucketOption{
+					WithStrategy(StrategyMapCollection),
+					WithCompression(compression),
+				},
+			},
+			bucketTest{
+				name: "compressionSetCollection_" + compression,
+				f:    compressionSetCollection,
+				opts: []BucketOption{
+					WithStrategy(StrategySetCollection),
+					WithCompression(compression),
+				},
+			},
+		)
+	}
+	tests.run(ctx, t)
+}
+
+func TestSegmentCompressionInvalidOption(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	_, err := NewBucketCreator().NewBucket(context.Background(), t.TempDir(), "",
+		logger, nil, cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(), WithCompression("lzma"))
+	require.ErrorContains(t, err, "unsupported compression")
+}
+
+func compressionReplace(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	size := 2000
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%06d", i)) }
+	secondary := func(i int) []byte { return []byte(fmt.Sprintf("sec-%06d", i)) }
+	value := func(i, round int) []byte {
+		return []byte(fmt.Sprintf("value for key %d written in round %d, "+
+			"repeated to make it compressible, repeated to make it compressible", i, round))
+	}
+
+	// the first segment is written without compression, to make sure mixed
+	// segments can be read and compacted. The compression option is always
+	// the last one.
+	uncompressedOpts := opts[:len(opts)-1]
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		uncompressedOpts...)
+	require.Nil(t, err)
+
+	for i := 0; i < size; i++ {
+		require.Nil(t, b.Put(key(i), value(i, 0), WithSecondaryKey(0, secondary(i))))
+	}
+	require.Nil(t, b.FlushAndSwitch())
+	require.Nil(t, b.Shutdown(ctx))
+
+	b, err = NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		opts...)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		require.Nil(t, b.Shutdown(context.Background()))
+	})
+
+	// overwrite every other key, delete every fifth key
+	for i := 0; i < size; i += 2 {
+		require.Nil(t, b.Put(key(i), value(i, 1), WithSecondaryKey(0, secondary(i))))
+	}
+	for i := 0; i < size; i += 5 {
+		require.Nil(t, b.Delete(key(i)))
+	}
+	require.Nil(t, b.FlushAndSwitch())
+
+	expected := func(i int) []byte {
+		if i%5 == 0 {
+			return nil
+		}
+		if i%2 == 0 {
+			return value(i, 1)
+		}
+		return value(i, 0)
+	}
+
+	assertContents := func(t *testing.T) {
+		for i := 0; i < size; i++ {
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected(i), v)
+
+			v, err = b.GetBySecondary(0, secondary(i))
+			require.Nil(t, err)
+			assert.Equal(t, expected(i), v)
+		}
+
+		c := b.Cursor()
+		defer c.Close()
+
+		i := 0
+		for k, v := c.First(); k != nil; k, v = c.Next() {
+			for expected(i) == nil {
+				i++
+			}
+			assert.Equal(t, key(i), k)
+			assert.Equal(t, expected(i), v)
+			i++
+		}
+		assert.Equal(t, size-size/5, b.Count())
+	}
+
+	t.Run("mixed segments", func(t *testing.T) {
+		require.Len(t, b.disk.segments, 2)
+		assert.Equal(t, segmentindex.SegmentV0, b.disk.segments[0].version)
+		assert.Equal(t, segmentindex.SegmentV1Compressed, b.disk.segments[1].version)
+		assert.Less(t, b.disk.segments[1].size, int64(b.disk.segments[1].dataEndPos))
+
+		assertContents(t)
+	})
+
+	t.Run("compacted segment", func(t *testing.T) {
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+
+		require.Len(t, b.disk.segments, 1)
+		assert.Equal(t, segmentindex.SegmentV1Compressed, b.disk.segments[0].version)
+
+		assertContents(t)
+	})
+}
+
+func compressionMapCollection(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		opts...)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		require.Nil(t, b.Shutdown(context.Background()))
+	})
+
+	rows, pairs := 200, 20
+	rowKey := func(i int) []byte { return []byte(fmt.Sprintf("row-%04d", i)) }
+	pair := func(j, round int) MapPair {
+		return MapPair{
+			Key:   []byte(fmt.Sprintf("key-%04d", j)),
+			Value: []byte(fmt.Sprintf("value-%04d-round-%d", j, round)),
+		}
+	}
+
+	for round := 0; round < 2; round++ {
+		for i := 0; i < rows; i++ {
+			for j := round; j < pairs; j += 2 {
+				require.Nil(t, b.MapSet(rowKey(i), pair(j, round)))
+			}
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	assertContents := func(t *testing.T) {
+		for i := 0; i < rows; i++ {
+			res, err := b.MapList(ctx, rowKey(i))
+			require.Nil(t, err)
+			require.Len(t, res, pairs)
+			for j := range res {
+				assert.Equal(t, pair(j, j%2), res[j])
+			}
+		}
+	}
+
+	require.Len(t, b.disk.segments, 2)
+	for _, seg := range b.disk.segments {
+		assert.Equal(t, segmentindex.SegmentV1Compressed, seg.version)
+	}
+	assertContents(t)
+
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	require.True(t, compacted)
+	require.Len(t, b.disk.segments, 1)
+	assertContents(t)
+}
+
+func compressionSetCollection(ctx context.Context, t *testing.T, opts []BucketOption) {
+	dir := t.TempDir()
+	logger, _ := test.NewNullLogger()
+
+	b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		opts...)
+	require.Nil(t, err)
+	t.Cleanup(func() {
+		require.Nil(t, b.Shutdown(context.Background()))
+	})
+
+	rows := 500
+	rowKey := func(i int) []byte { return []byte(fmt.Sprintf("row-%04d", i)) }
+
+	for round := 0; round < 2; round++ {
+		for i := 0; i < rows; i++ {
+			require.Nil(t, b.SetAdd(rowKey(i), [][]byte{
+				[]byte(fmt.Sprintf("value-%04d-round-%d", i, round)),
+			}))
+		}
+		require.Nil(t, b.FlushAndSwitch())
+	}
+
+	assertContents := func(t *testing.T) {
+		for i := 0; i < rows; i++ {
+			res, err := b.SetList(rowKey(i))
+			require.Nil(t, err)
+			assert.ElementsMatch(t, [][]byte{
+				[]byte(fmt.Sprintf("value-%04d-round-0", i)),
+				[]byte(fmt.Sprintf("value-%04d-round-1", i)),
+			}, res)
+		}
+	}
+
+	assertContents(t)
+
+	compacted, err := b.disk.compactOnce()
+	require.Nil(t, err)
+	require.True(t, compacted)
+	require.Len(t, b.disk.segments, 1)
+	assert.Equal(t, segmentindex.SegmentV1Compressed, b.disk.segments[0].version)
+	assertContents(t)
+}
@@ -55,9 +55,16 @@ type SegmentGroup struct {
 	// not guaranteed to be sorted yet
 	mapRequiresSorting bool
 
-	status     storagestate.Status
-	statusLock sync.Mutex
-	metrics    *Metrics
+	status      storagestate.Status
+	bulkLoading bool
+	statusLock  sync.Mutex
+	metrics     *Metrics
+
+	// positions of the first segment written during a bulk load and of the
+	// segment after the last one, protected by statusLock, see
+	// mergeBulkLoadRuns
+	bulkLoadFirst int
+	bulkLoadEnd   int
 
 	// all "replace" buckets support counting through net additions, but not all
 	// produce a meaningful count. Typically, the only count we're interested in
@@ -69,10 +76,24 @@ type SegmentGroup struct {
 	useBloomFilter          bool // see bucket for more datails
 	calcCountNetAdditions   bool // see bucket for more datails
 	compactLeftOverSegments bool // see bucket for more datails
+	compression             segmentindex.Compression
+	checksumVerification    checksumVerification
+	writeChecksums          bool
+	quarantineSegments      bool
+	tiering                 *TieringConfig
+	compactionScheduler     *CompactionScheduler
 
 	allocChecker   memwatch.AllocChecker
 	maxSegmentSize int64
 
+	// snapshots pin segments, so that they are not closed while a
+	// point-in-time view still references them, see bucket_snapshot.go
+	snapshotLock    sync.Mutex
+	snapshots       map[*BucketSnapshot]struct{}
+	snapshotsClosed bool
+	pinnedSegments  map[*segment]int
+	retiredSegments map[*segment]struct{}
+
 	segmentCleaner     segmentCleaner
 	cleanupInterval    time.Duration
 	lastCleanupCall    time.Time
@@ -91,6 +112,12 @@ type sgConfig struct {
 	forceCompaction       bool
 	maxSegmentSize        int64
 	cleanupInterval       time.Duration
+	compression           segmentindex.Compression
+	checksumVerification  checksumVerification
+	writeChecksums        bool
+	quarantineSegments    bool
+	tiering               *TieringConfig
+	compactionScheduler   *CompactionScheduler
 }
 
 func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
@@ -116,6 +143,12 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 		useBloomFilter:          cfg.useBloomFilter,
 		calcCountNetAdditions:   cfg.calcCountNetAdditions,
 		compactLeftOverSegments: cfg.forceCompaction,
+		compression:             cfg.compression,
+		checksumVerification:    cfg.checksumVerification,
+		writeChecksums:          cfg.writeChecksums,
+		quarantineSegments:      cfg.quarantineSegments,
+		tiering:                 cfg.tiering,
+		compactionScheduler:     cfg.compactionScheduler,
 		maxSegmentSize:          cfg.maxSegmentSize,
 		cleanupInterval:         cfg.cleanupInterval,
 		allocChecker:            allocChecker,
@@ -137,6 +170,15 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 
 		potentialCompactedSegmentFileName := strings.TrimSuffix(entry.Name(), ".tmp")
 
+		if strings.HasSuffix(entry.Name(), coldStubTmpSuffix) ||
+			filepath.Ext(potentialCompactedSegmentFileName) == ColdMarkerSuffix {
+			// interrupted offload to tiered storage, the original is still intact
+			if err := os.Remove(filepath.Join(sg.dir, entry.Name())); err != nil {
+				return nil, fmt.Errorf("delete partially offloaded segment %q: %w", entry.Name(), err)
+			}
+			continue
+		}
+
 		if filepath.Ext(potentialCompactedSegmentFileName) != ".db" {
 			// another kind of temporal file, ignore at this point but it may need to be deleted...
 			continue
@@ -188,12 +230,25 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 			return nil, fmt.Errorf("missing right segment %q", rightSegmentFilename)
 		}
 
+		// the compaction may have been interrupted before the markers of cold
+		// segments were marked for deletion, the compacted segment must not
+		// inherit the marker of the right segment
+		if !leftSegmentFound {
+			if err := sg.dropColdMarker(coldMarkerPath(leftSegmentPath)); err != nil {
+				return nil, fmt.Errorf("drop cold marker of compacted segment %s: %w", leftSegmentFilename, err)
+			}
+		}
+		if err := sg.dropColdMarker(coldMarkerPath(rightSegmentPath)); err != nil {
+			return nil, fmt.Errorf("drop cold marker of compacted segment %s: %w", rightSegmentFilename, err)
+		}
+
 		if !leftSegmentFound && rightSegmentFound {
 			// segment is initialized just to be erased
 			// there is no need of bloom filters nor net addition counter re-calculation
 			rightSegment, err := newSegment(rightSegmentPath, logger,
 				metrics, sg.makeExistsOnLower(segmentIndex),
-				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+				sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false,
+				sg.checksumVerification, nil)
 			if err != nil {
 				return nil, fmt.Errorf("init already compacted right segment %s: %w", rightSegmentFilename, err)
 			}
@@ -235,20 +290,38 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 
 		segment, err := newSegment(rightSegmentPath, logger,
 			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true,
+			sg.checksumVerification, nil)
+		segmentsAlreadyRecoveredFromCompaction[rightSegmentFilename] = struct{}{}
+		if errors.Is(err, ErrInvalidChecksum) {
+			older, olderErr := olderSegments(sg.dir, list, rightSegmentFilename)
+			if olderErr != nil {
+				return nil, olderErr
+			}
+			if err := quarantineSegment(rightSegmentPath, older, sg.quarantineSegments,
+				logger, metrics, sg.strategy, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", rightSegmentFilename, err)
 		}
 
 		sg.segments[segmentIndex] = segment
 		segmentIndex++
-
-		segmentsAlreadyRecoveredFromCompaction[rightSegmentFilename] = struct{}{}
 	}
 
 	for _, entry := range list {
 		if filepath.Ext(entry.Name()) == DeleteMarkerSuffix {
 			// marked for deletion, but never actually deleted. Delete now.
+			if strings.HasSuffix(entry.Name(), ColdMarkerSuffix+DeleteMarkerSuffix) {
+				// the data of the cold segment needs to be deleted first
+				if err := sg.dropColdMarker(filepath.Join(sg.dir, entry.Name())); err != nil {
+					return nil, fmt.Errorf("drop cold marker %s: %w", entry.Name(), err)
+				}
+				continue
+			}
 			if err := os.Remove(filepath.Join(sg.dir, entry.Name())); err != nil {
 				// don't abort if the delete fails, we can still continue (albeit
 				// without freeing disk space that should have been freed)
@@ -297,13 +370,37 @@ func newSegmentGroup(logger logrus.FieldLogger, metrics *Metrics,
 			continue
 		}
 
+		cold, err := sg.coldSegmentFor(filepath.Join(sg.dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("init segment %s: %w", entry.Name(), err)
+		}
+
 		segment, err := newSegment(filepath.Join(sg.dir, entry.Name()), logger,
 			metrics, sg.makeExistsOnLower(segmentIndex),
-			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+			sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false,
+			sg.checksumVerification, cold)
+		if errors.Is(err, ErrInvalidChecksum) {
+			// if enabled, a corrupt segment does not prevent the entire shard
+			// from loading, unless leaving it out would change the contents of
+			// the others
+			older, olderErr := olderSegments(sg.dir, list, entry.Name())
+			if olderErr != nil {
+				return nil, olderErr
+			}
+			if err := quarantineSegment(filepath.Join(sg.dir, entry.Name()), older,
+				sg.quarantineSegments, logger, metrics, sg.strategy, err); err != nil {
+				return nil, err
+			}
+			continue
+		}
 		if err != nil {
 			return nil, fmt.Errorf("init segment %s: %w", entry.Name(), err)
 		}
 
+		if cold != nil {
+			cold.tryEvict = func() bool { return sg.tryEvictCold(segment) }
+		}
+
 		sg.segments[segmentIndex] = segment
 		segmentIndex++
 	}
@@ -352,7 +449,8 @@ func (sg *SegmentGroup) add(path string) error {
 	newSegmentIndex := len(sg.segments)
 	segment, err := newSegment(path, sg.logger,
 		sg.metrics, sg.makeExistsOnLower(newSegmentIndex),
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true,
+		sg.checksumVerification, nil)
 	if err != nil {
 		return fmt.Errorf("init segment %s: %w", path, err)
 	}
@@ -408,6 +506,10 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundary(key []byte, topMostSegment i
 				return nil, nil
 			}
 
+			if errors.Is(err, ErrInvalidChecksum) {
+				return nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -436,7 +538,7 @@ func (sg *SegmentGroup) getWithUpperSegmentBoundaryErrDeleted(key []byte, topMos
 				continue
 			}
 
-			if errors.Is(err, lsmkv.Deleted) {
+			if errors.Is(err, lsmkv.Deleted) || errors.Is(err, ErrInvalidChecksum) {
 				return nil, err
 			}
 
@@ -468,6 +570,10 @@ func (sg *SegmentGroup) getBySecondaryIntoMemory(pos int, key []byte, buffer []b
 				return nil, nil, nil, nil
 			}
 
+			if errors.Is(err, ErrInvalidChecksum) {
+				return nil, nil, nil, err
+			}
+
 			panic(fmt.Sprintf("unsupported error in segmentGroup.get(): %v", err))
 		}
 
@@ -573,9 +679,15 @@ func (sg *SegmentGroup) shutdown(ctx context.Context) error {
 	if err := sg.compactionCallbackCtrl.Unregister(ctx); err != nil {
 		return fmt.Errorf("long-running compaction in progress: %w", ctx.Err())
 	}
+	if sg.compactionScheduler != nil {
+		sg.compactionScheduler.withdraw(sg)
+	}
 	if err := sg.segmentCleaner.close(); err != nil {
 		return err
 	}
+	if err := sg.releaseSnapshots(); err != nil {
+		return fmt.Errorf("release snapshots: %w", err)
+	}
 
 	// Lock acquirement placed after compaction cycle stop request, due to occasional deadlock,
 	// because compaction logic used in cycle also requires maintenance lock.
@@ -617,6 +729,62 @@ func (sg *SegmentGroup) isReadyOnly() bool {
 	return sg.status == storagestate.StatusReadOnly
 }
 
+// startBulkLoadRuns halts maintenance for a bulk load. Only the segments
+// written from now on are merged when the load finishes.
+func (sg *SegmentGroup) startBulkLoadRuns() {
+	first := sg.Len()
+
+	sg.statusLock.Lock()
+	defer sg.statusLock.Unlock()
+
+	sg.bulkLoading = true
+	sg.bulkLoadFirst = first
+	sg.bulkLoadEnd = first
+}
+
+func (sg *SegmentGroup) updateBulkLoading(bulkLoading bool) {
+	sg.statusLock.Lock()
+	defer sg.statusLock.Unlock()
+
+	sg.bulkLoading = bulkLoading
+}
+
+// isMaintenanceHalted indicates that segments must not be rewritten, either
+// because the shard is read-only or because it is being bulk loaded and the
+// segments are merged once the load has finished
+func (sg *SegmentGroup) isMaintenanceHalted() bool {
+	sg.statusLock.Lock()
+	defer sg.statusLock.Unlock()
+
+	return sg.status == storagestate.StatusReadOnly || sg.bulkLoading
+}
+
+// waitsForCompaction indicates that a compaction was not admitted by the
+// scheduler yet
+func (sg *SegmentGroup) waitsForCompaction() bool {
+	return sg.compactionScheduler != nil && sg.compactionScheduler.isWaiting(sg)
+}
+
+// olderSegments counts the segments in the directory listing which are older
+// than the segment with the given file name and still exist. Segment names
+// sort by their creation time.
+func olderSegments(dir string, list []os.DirEntry, name string) (int, error) {
+	count := 0
+	for _, entry := range list {
+		if filepath.Ext(entry.Name()) != ".db" || entry.Name() >= name {
+			continue
+		}
+		ok, err := fileExists(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return 0, fmt.Errorf("check for presence of segment %s: %w", entry.Name(), err)
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
 func fileExists(path string) (bool, error) {
 	_, err := os.Stat(path)
 	if err == nil {
@@ -659,6 +827,16 @@ func (sg *SegmentGroup) compactOrCleanup(shouldAbort cyclemanager.ShouldAbortCal
 		}
 		return cleaned
 	}
+	offload := func() bool {
+		offloaded, err := sg.offloadOnce()
+		if err != nil {
+			sg.logger.WithField("action", "lsm_tiering_offload").
+				WithField("path", sg.dir).
+				WithError(err).
+				Errorf("offload to tiered storage failed")
+		}
+		return offloaded
+	}
 
 	// alternatively run compaction or cleanup first
 	// if 1st one called succeeds, 2nd one is skipped, otherwise 2nd one is called as well
@@ -667,12 +845,17 @@ func (sg *SegmentGroup) compactOrCleanup(shouldAbort cyclemanager.ShouldAbortCal
 	// was not called for over [forceCleanupInterval], force at least one execution
 	// in between compactions.
 	// (ignore if compaction was not called within that time either)
+	//
+	// segments are only moved to tiered storage if there was nothing else to do
+	//
+	// a group that waits for the compaction scheduler does not back off, so
+	// that it keeps its place in the queue
 	forceCleanupInterval := time.Hour * 12
 
 	if time.Since(sg.lastCleanupCall) > forceCleanupInterval && sg.lastCleanupCall.Before(sg.lastCompactionCall) {
-		return cleanup() || compact()
+		return cleanup() || compact() || offload() || sg.waitsForCompaction()
 	}
-	return compact() || cleanup()
+	return compact() || cleanup() || offload() || sg.waitsForCompaction()
 }
 
 func (sg *SegmentGroup) Len() int {
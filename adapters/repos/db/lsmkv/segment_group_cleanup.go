@@ -419,7 +419,7 @@ func (c *segmentCleanerCommon) storeSegmentMeta(id, lastProcessedId, size, clean
 
 func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortCallback,
 ) (bool, error) {
-	if c.sg.isReadyOnly() {
+	if c.sg.isMaintenanceHalted() {
 		return false, nil
 	}
 
@@ -461,6 +461,15 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 	}
 
 	oldSegment := c.sg.segmentAtPos(candidateIdx)
+	if oldSegment.cold != nil {
+		// segments in tiered storage are never rewritten, mark as cleaned so
+		// that the next candidate is picked
+		if err = onCompleted(oldSegment.size); err != nil {
+			err = fmt.Errorf("callback skipped cold segment: %w", err)
+			return false, err
+		}
+		return false, nil
+	}
 	segmentId := segmentID(oldSegment.path)
 	tmpSegmentPath := filepath.Join(c.sg.dir, "segment-"+segmentId+".db.tmp")
 	scratchSpacePath := oldSegment.path + "cleanup.scratch.d"
@@ -493,9 +502,12 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 		return false, err
 	}
 
+	w := newSegmentWriter(file, c.sg.compression, oldSegment.strategy,
+		oldSegment.secondaryIndexCount)
+
 	switch c.sg.strategy {
 	case StrategyReplace:
-		c := newSegmentCleanerReplace(file, oldSegment.newCursor(),
+		c := newSegmentCleanerReplace(w, oldSegment.newCursor(),
 			c.sg.makeKeyExistsOnUpperSegments(startIdx, lastIdx), oldSegment.level,
 			oldSegment.secondaryIndexCount, scratchSpacePath)
 		if err = c.do(shouldAbort); err != nil {
@@ -506,6 +518,11 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 		return false, err
 	}
 
+	if err = finishSegment(w); err != nil {
+		err = fmt.Errorf("finish cleaned segment file: %w", err)
+		return false, err
+	}
+
 	if err = file.Sync(); err != nil {
 		err = fmt.Errorf("fsync cleaned segment file: %w", err)
 		return false, err
@@ -514,6 +531,10 @@ func (c *segmentCleanerCommon) cleanupOnce(shouldAbort cyclemanager.ShouldAbortC
 		err = fmt.Errorf("close cleaned segment file: %w", err)
 		return false, err
 	}
+	if err = c.sg.checksumSegment(tmpSegmentPath); err != nil {
+		err = fmt.Errorf("checksum cleaned segment file: %w", err)
+		return false, err
+	}
 
 	segment, err := c.sg.replaceSegment(candidateIdx, tmpSegmentPath)
 	if err != nil {
@@ -605,7 +626,7 @@ func (sg *SegmentGroup) replaceSegmentBlocking(
 
 	start := time.Now()
 
-	if err := oldSegment.close(); err != nil {
+	if err := sg.closeOrRetireSegment(oldSegment); err != nil {
 		return nil, fmt.Errorf("close disk segment %q: %w", oldSegment.path, err)
 	}
 	if err := oldSegment.markForDeletion(); err != nil {
@@ -632,7 +653,8 @@ func (sg *SegmentGroup) replaceSegmentBlocking(
 	}
 
 	newSegment, err := newSegment(segmentPath, sg.logger, sg.metrics, nil,
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false,
+		sg.checksumVerification, nil)
 	if err != nil {
 		return nil, fmt.Errorf("create new segment %q: %w", newSegment.path, err)
 	}
@@ -13,6 +13,7 @@ package lsmkv
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"path/filepath"
@@ -65,10 +66,10 @@ import (
 // maxSegmentSize ise respected for pair of leftover segments.
 func (sg *SegmentGroup) findCompactionCandidates() (pair []int, level uint16) {
 	// if true, the parent shard has indicated that it has
-	// entered an immutable state. During this time, the
-	// SegmentGroup should refrain from flushing until its
-	// shard indicates otherwise
-	if sg.isReadyOnly() {
+	// entered an immutable state or is bulk loading. During
+	// this time, the SegmentGroup should refrain from
+	// compacting until its shard indicates otherwise
+	if sg.isMaintenanceHalted() {
 		return nil, 0
 	}
 
@@ -191,6 +192,9 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	pair, level := sg.findCompactionCandidates()
 	if pair == nil {
 		// nothing to do
+		if sg.compactionScheduler != nil {
+			sg.compactionScheduler.withdraw(sg)
+		}
 		return false, nil
 	}
 
@@ -217,6 +221,36 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	leftSegment := sg.segmentAtPos(pair[0])
 	rightSegment := sg.segmentAtPos(pair[1])
 
+	var ticket *compactionTicket
+	if sg.compactionScheduler != nil {
+		ticket = sg.compactionScheduler.tryAcquire(sg, compactionRequest{
+			dir:      sg.dir,
+			strategy: sg.strategy,
+			segments: sg.Len(),
+			size:     leftSegment.size + rightSegment.size,
+		})
+		if ticket == nil {
+			// not admitted yet, the scheduler remembers that this group is
+			// waiting and it will try again on its next cycle
+			return false, nil
+		}
+		defer ticket.done()
+	}
+
+	return sg.compactPair(pair, level, ticket)
+}
+
+// compactPair merges the adjacent segments at pair into a new segment of the
+// given level and replaces them with it. The ticket is optional.
+func (sg *SegmentGroup) compactPair(pair []int, level uint16,
+	ticket *compactionTicket,
+) (bool, error) {
+	leftSegment := sg.segmentAtPos(pair[0])
+	rightSegment := sg.segmentAtPos(pair[1])
+
+	unpin := sg.pinColdForCompaction(leftSegment, rightSegment)
+	defer unpin()
+
 	path := filepath.Join(sg.dir, "segment-"+segmentID(leftSegment.path)+"_"+segmentID(rightSegment.path)+".db.tmp")
 
 	f, err := os.Create(path)
@@ -224,11 +258,17 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		return false, err
 	}
 
+	var w io.WriteSeeker = f
+	if ticket != nil {
+		w = ticket.writer(f)
+	}
+
 	scratchSpacePath := rightSegment.path + "compaction.scratch.d"
 
 	strategy := leftSegment.strategy
 	secondaryIndices := leftSegment.secondaryIndexCount
 	cleanupTombstones := !sg.keepTombstones && pair[0] == 0
+	w = newSegmentWriter(w, sg.compression, strategy, secondaryIndices)
 
 	pathLabel := "n/a"
 	if sg.metrics != nil && !sg.metrics.groupClasses {
@@ -239,7 +279,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 	// TODO: call metrics just once with variable strategy label
 
 	case segmentindex.StrategyReplace:
-		c := newCompactorReplace(f, leftSegment.newCursor(),
+		c := newCompactorReplace(w, leftSegment.newCursor(),
 			rightSegment.newCursor(), level, secondaryIndices, scratchSpacePath, cleanupTombstones)
 
 		if sg.metrics != nil {
@@ -251,7 +291,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategySetCollection:
-		c := newCompactorSetCollection(f, leftSegment.newCollectionCursor(),
+		c := newCompactorSetCollection(w, leftSegment.newCollectionCursor(),
 			rightSegment.newCollectionCursor(), level, secondaryIndices,
 			scratchSpacePath, cleanupTombstones)
 
@@ -264,7 +304,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategyMapCollection:
-		c := newCompactorMapCollection(f,
+		c := newCompactorMapCollection(w,
 			leftSegment.newCollectionCursorReusable(),
 			rightSegment.newCollectionCursorReusable(),
 			level, secondaryIndices, scratchSpacePath, sg.mapRequiresSorting, cleanupTombstones)
@@ -281,7 +321,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		leftCursor := leftSegment.newRoaringSetCursor()
 		rightCursor := rightSegment.newRoaringSetCursor()
 
-		c := roaringset.NewCompactor(f, leftCursor, rightCursor,
+		c := roaringset.NewCompactor(w, leftCursor, rightCursor,
 			level, scratchSpacePath, cleanupTombstones)
 
 		if sg.metrics != nil {
@@ -297,7 +337,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		leftCursor := leftSegment.newRoaringSetRangeCursor()
 		rightCursor := rightSegment.newRoaringSetRangeCursor()
 
-		c := roaringsetrange.NewCompactor(f, leftCursor, rightCursor,
+		c := roaringsetrange.NewCompactor(w, leftCursor, rightCursor,
 			level, cleanupTombstones)
 
 		if sg.metrics != nil {
@@ -309,7 +349,7 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 			return false, err
 		}
 	case segmentindex.StrategyInverted:
-		c := newCompactorInverted(f,
+		c := newCompactorInverted(w,
 			leftSegment.newInvertedCursorReusable(),
 			rightSegment.newInvertedCursorReusable(),
 			level, secondaryIndices, scratchSpacePath, cleanupTombstones)
@@ -326,6 +366,10 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		return false, errors.Errorf("unrecognized strategy %v", strategy)
 	}
 
+	if err := finishSegment(w); err != nil {
+		return false, errors.Wrap(err, "finish compacted segment file")
+	}
+
 	if err := f.Sync(); err != nil {
 		return false, errors.Wrap(err, "fsync compacted segment file")
 	}
@@ -334,6 +378,14 @@ func (sg *SegmentGroup) compactOnce() (bool, error) {
 		return false, errors.Wrap(err, "close compacted segment file")
 	}
 
+	if err := sg.checksumSegment(path); err != nil {
+		return false, errors.Wrap(err, "checksum compacted segment file")
+	}
+
+	if err := unpin(); err != nil {
+		return false, errors.Wrap(err, "unpin compacted segments")
+	}
+
 	if err := sg.replaceCompactedSegments(pair[0], pair[1], path); err != nil {
 		return false, errors.Wrap(err, "replace compacted segments")
 	}
@@ -409,11 +461,11 @@ func (sg *SegmentGroup) replaceCompactedSegmentsBlocking(
 	leftSegment := sg.segments[old1]
 	rightSegment := sg.segments[old2]
 
-	if err := leftSegment.close(); err != nil {
+	if err := sg.closeOrRetireSegment(leftSegment); err != nil {
 		return nil, nil, errors.Wrap(err, "close disk segment")
 	}
 
-	if err := rightSegment.close(); err != nil {
+	if err := sg.closeOrRetireSegment(rightSegment); err != nil {
 		return nil, nil, errors.Wrap(err, "close disk segment")
 	}
 
@@ -450,7 +502,8 @@ func (sg *SegmentGroup) replaceCompactedSegmentsBlocking(
 	}
 
 	seg, err := newSegment(newPath, sg.logger, sg.metrics, nil,
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, false,
+		sg.checksumVerification, nil)
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "create new segment")
 	}
@@ -491,6 +544,12 @@ func (sg *SegmentGroup) deleteOldSegmentsNonBlocking(segments ...*segment) error
 		if err := seg.dropMarked(); err != nil {
 			return fmt.Errorf("drop segment at pos %d: %w", pos, err)
 		}
+
+		if seg.cold != nil {
+			if err := sg.deleteColdSegment(seg); err != nil {
+				return fmt.Errorf("drop cold segment at pos %d: %w", pos, err)
+			}
+		}
 	}
 
 	return nil
@@ -0,0 +1,135 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errSnapshotsClosed = errors.New("segment group is shutting down")
+
+// pinSegments returns the current list of segments and pins each of them, so
+// that they are not closed when they get replaced by a compaction or cleanup
+// while a snapshot still references them.
+func (sg *SegmentGroup) pinSegments() ([]*segment, error) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	sg.snapshotLock.Lock()
+	defer sg.snapshotLock.Unlock()
+
+	if sg.snapshotsClosed {
+		return nil, errSnapshotsClosed
+	}
+
+	if sg.pinnedSegments == nil {
+		sg.pinnedSegments = map[*segment]int{}
+	}
+
+	segments := make([]*segment, len(sg.segments))
+	copy(segments, sg.segments)
+	for _, seg := range segments {
+		sg.pinnedSegments[seg]++
+	}
+
+	return segments, nil
+}
+
+// unpinSegments releases the pins of a snapshot. Segments which have been
+// replaced in the meantime are closed once their last pin is gone. Their
+// files have already been deleted at that point.
+func (sg *SegmentGroup) unpinSegments(segments []*segment) error {
+	sg.snapshotLock.Lock()
+	defer sg.snapshotLock.Unlock()
+
+	var errs []error
+	for _, seg := range segments {
+		sg.pinnedSegments[seg]--
+		if sg.pinnedSegments[seg] > 0 {
+			continue
+		}
+
+		delete(sg.pinnedSegments, seg)
+		if _, ok := sg.retiredSegments[seg]; ok {
+			delete(sg.retiredSegments, seg)
+			if err := seg.close(); err != nil {
+				errs = append(errs, fmt.Errorf("close retired segment %q: %w", seg.path, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// closeOrRetireSegment is called on segments that were replaced by a
+// compaction or cleanup. The caller needs to hold the maintenanceLock.
+// Pinned segments are not closed immediately, but once the last snapshot
+// referencing them is released. Their files can still be deleted as usual,
+// as the open file handle (and mmap) remains valid.
+func (sg *SegmentGroup) closeOrRetireSegment(seg *segment) error {
+	sg.snapshotLock.Lock()
+	defer sg.snapshotLock.Unlock()
+
+	if sg.pinnedSegments[seg] > 0 {
+		if sg.retiredSegments == nil {
+			sg.retiredSegments = map[*segment]struct{}{}
+		}
+		sg.retiredSegments[seg] = struct{}{}
+		return nil
+	}
+
+	return seg.close()
+}
+
+func (sg *SegmentGroup) registerSnapshot(snap *BucketSnapshot) error {
+	sg.snapshotLock.Lock()
+	defer sg.snapshotLock.Unlock()
+
+	if sg.snapshotsClosed {
+		return errSnapshotsClosed
+	}
+
+	if sg.snapshots == nil {
+		sg.snapshots = map[*BucketSnapshot]struct{}{}
+	}
+	sg.snapshots[snap] = struct{}{}
+	return nil
+}
+
+func (sg *SegmentGroup) unregisterSnapshot(snap *BucketSnapshot) {
+	sg.snapshotLock.Lock()
+	defer sg.snapshotLock.Unlock()
+
+	delete(sg.snapshots, snap)
+}
+
+// releaseSnapshots releases all open snapshots and prevents new ones from
+// being opened. It is called on shutdown before the segments are closed.
+func (sg *SegmentGroup) releaseSnapshots() error {
+	sg.snapshotLock.Lock()
+	sg.snapshotsClosed = true
+	snapshots := make([]*BucketSnapshot, 0, len(sg.snapshots))
+	for snap := range sg.snapshots {
+		snapshots = append(snapshots, snap)
+	}
+	sg.snapshotLock.Unlock()
+
+	var errs []error
+	for _, snap := range snapshots {
+		if err := snap.Release(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -21,6 +21,7 @@ import (
 	"strings"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+	"github.com/weaviate/weaviate/entities/lsmkv"
 )
 
 // ErrInvalidChecksum indicates that the read file should not be trusted. For
@@ -94,10 +95,18 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 		}
 	}
 
-	extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
-		s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
+	if s.compressedData != nil || s.cold != nil {
+		// the extractor operates on the raw contents, which are not usable on a
+		// compressed segment or a stub, fall back to a regular cursor instead
+		if err := s.forEachKeyAndTombstone(cb); err != nil {
+			return fmt.Errorf("iterate compressed segment: %w", err)
+		}
+	} else {
+		extr := newBufferedKeyAndTombstoneExtractor(s.contents, s.dataStartPos,
+			s.dataEndPos, 10e6, s.secondaryIndexCount, cb)
 
-	extr.do()
+		extr.do()
+	}
 
 	s.countNetAdditions = countNet
 
@@ -112,6 +121,18 @@ func (s *segment) initCountNetAdditions(exists existsOnLowerSegmentsFn, overwrit
 	return nil
 }
 
+func (s *segment) forEachKeyAndTombstone(cb keyAndTombstoneCallbackFn) error {
+	c := s.newCursor()
+	for key, _, err := c.first(); key != nil; key, _, err = c.next() {
+		if err != nil && !errors.Is(err, lsmkv.Deleted) {
+			return err
+		}
+		cb(key, errors.Is(err, lsmkv.Deleted))
+	}
+
+	return nil
+}
+
 func (s *segment) storeCountNetOnDisk() error {
 	return storeCountNetOnDisk(s.countNetPath(), s.countNetAdditions)
 }
@@ -66,6 +66,15 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		return nil, fmt.Errorf("unsupported strategy in segment: %w", err)
 	}
 
+	if header.HasChecksums() {
+		// the segment was just written, so only the footer needs to be split off
+		// here, the data is validated when the segment is loaded
+		_, contents, err = loadSegmentChecksums(contents, header, false)
+		if err != nil {
+			return nil, fmt.Errorf("validate checksums: %w", err)
+		}
+	}
+
 	primaryIndex, err := header.PrimaryIndex(contents)
 	if err != nil {
 		return nil, fmt.Errorf("extract primary index position: %w", err)
@@ -96,7 +105,7 @@ func preComputeSegmentMeta(path string, updatedCountNetAdditions int,
 		path:                  strings.TrimSuffix(path, ".tmp"),
 		contents:              contents,
 		contentFile:           file,
-		version:               header.Version,
+		version:               header.LayoutVersion(),
 		secondaryIndexCount:   header.SecondaryIndices,
 		segmentStartPos:       header.IndexStart,
 		segmentEndPos:         uint64(fileInfo.Size()),
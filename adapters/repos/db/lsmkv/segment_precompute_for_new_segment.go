@@ -39,7 +39,8 @@ func (sg *SegmentGroup) initAndPrecomputeNewSegment(path string) (*segment, erro
 
 	segment, err := newSegment(path, sg.logger,
 		sg.metrics, sg.makeExistsOnLower(newSegmentIndex),
-		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true)
+		sg.mmapContents, sg.useBloomFilter, sg.calcCountNetAdditions, true,
+		sg.checksumVerification, nil)
 	if err != nil {
 		return nil, fmt.Errorf("init and pre-compute new segment %s: %w", path, err)
 	}
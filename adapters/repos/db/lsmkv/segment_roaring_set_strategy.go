@@ -49,6 +49,10 @@ func (s *segment) roaringSetGet(key []byte) (roaringset.BitmapLayer, error) {
 }
 
 func (s *segment) segmentNodeFromBuffer(offset nodeOffset) (*roaringset.SegmentNode, error) {
+	if err := s.verifyRange(offset.start, offset.end); err != nil {
+		return nil, err
+	}
+
 	var contents []byte
 	if s.mmapContents {
 		contents = s.contents[offset.start:offset.end]
@@ -0,0 +1,525 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv/segmentindex"
+)
+
+// SegmentStore is the backend that holds the data of cold segments. It is
+// implemented by modules with the OffloadSegments capability and by
+// [FilesystemSegmentStore]. Keys are slash-separated and unique per offload,
+// a key is never reused once its segment was deleted.
+type SegmentStore interface {
+	// UploadSegment copies the local file to the store, overwriting any
+	// previous object with the same key
+	UploadSegment(ctx context.Context, key, localPath string) error
+	// DownloadSegment copies the object to the local file
+	DownloadSegment(ctx context.Context, key, localPath string) error
+	// DeleteSegment removes the object, it does not error if it does not exist
+	DeleteSegment(ctx context.Context, key string) error
+}
+
+// TieringConfig controls which segments of a bucket are moved to the
+// SegmentStore, see WithTiering
+type TieringConfig struct {
+	Store SegmentStore
+	// Cache holds downloaded cold segments, it is typically shared across all
+	// buckets of a node
+	Cache *SegmentCache
+	// KeyPrefix is prepended to the file name of each segment and a random
+	// suffix to build the key in the store
+	KeyPrefix string
+	// MinAge is the minimum time since a segment was last written before it
+	// becomes eligible. As compactions only ever merge pairs of segments of
+	// the same level, old segments are typically fully compacted.
+	MinAge time.Duration
+	// MinSegmentSize excludes small segments, which are cheap to keep local
+	MinSegmentSize int64
+}
+
+const (
+	// ColdMarkerSuffix marks a segment whose data section only exists in the
+	// SegmentStore. The marker holds the key of the segment in the store, the
+	// local .db file is a sparse stub holding only the header and the index.
+	// A second line coldRetainedFlag marks segments whose marker was copied
+	// into a backup.
+	ColdMarkerSuffix = ".cold"
+
+	// coldRetainedFlag keeps the data of a cold segment in the store once the
+	// segment is deleted locally. Backups only contain the stub and the
+	// marker, restoring them requires the data to still exist.
+	coldRetainedFlag = "retained"
+
+	coldStubTmpSuffix = ".stub.tmp"
+)
+
+func coldMarkerPath(segmentPath string) string {
+	return strings.TrimSuffix(segmentPath, filepath.Ext(segmentPath)) + ColdMarkerSuffix
+}
+
+// isTieringEligible is true for segments that can be served from a stub,
+// i.e. all data reads go through the pread path. Block-compressed segments
+// and strategies that read data sections directly from the mmaped contents
+// (roaring sets, inverted) are excluded.
+func (s *segment) isTieringEligible() bool {
+	if s.cold != nil || s.compressedData != nil {
+		return false
+	}
+
+	switch s.strategy {
+	case segmentindex.StrategyReplace, segmentindex.StrategySetCollection,
+		segmentindex.StrategyMapCollection:
+		return true
+	default:
+		return false
+	}
+}
+
+// coldSegmentFor returns the cold state of the segment at path if it has a
+// marker, nil otherwise
+func (sg *SegmentGroup) coldSegmentFor(segmentPath string) (*coldSegment, error) {
+	key, retained, err := readColdMarker(coldMarkerPath(segmentPath))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if sg.tiering == nil || sg.tiering.Store == nil {
+		return nil, fmt.Errorf("segment %s was moved to tiered storage, but no "+
+			"tiering backend is configured", segmentPath)
+	}
+
+	cold := newColdSegment(key, sg.tiering.Store, sg.tiering.Cache, sg.metrics)
+	cold.retained = retained
+	return cold, nil
+}
+
+// findOffloadCandidate returns the oldest segment that is eligible for
+// tiering, if any
+func (sg *SegmentGroup) findOffloadCandidate() (int, *segment) {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	for i, seg := range sg.segments {
+		if !seg.isTieringEligible() || seg.size < sg.tiering.MinSegmentSize {
+			continue
+		}
+
+		info, err := os.Stat(seg.path)
+		if err != nil || time.Since(info.ModTime()) < sg.tiering.MinAge {
+			continue
+		}
+
+		return i, seg
+	}
+
+	return -1, nil
+}
+
+// offloadOnce moves the data of at most one segment to the SegmentStore and
+// replaces the local file with a stub. It runs as part of the compaction
+// cycle, so segment positions can't change in between, other than new
+// segments being appended.
+func (sg *SegmentGroup) offloadOnce() (bool, error) {
+	if sg.tiering == nil || sg.tiering.Store == nil || sg.isMaintenanceHalted() {
+		return false, nil
+	}
+
+	// reads can't evict segments of their own group, catch up on those
+	sg.tiering.Cache.shrink()
+
+	pos, seg := sg.findOffloadCandidate()
+	if seg == nil {
+		return false, nil
+	}
+
+	start := time.Now()
+	// compactions reuse the file name of the right segment, the suffix keeps
+	// a later offload from reusing the key of a segment pending deletion
+	key := path.Join(sg.tiering.KeyPrefix, filepath.Base(seg.path)+"."+uuid.NewString())
+	newSeg, err := sg.offloadSegment(pos, seg, key)
+	sg.metrics.TieringOperation("offload", err)
+	if err != nil {
+		return false, fmt.Errorf("offload segment %s: %w", seg.path, err)
+	}
+
+	sg.logger.WithFields(logrus.Fields{
+		"action":  "lsm_tiering_offload",
+		"path":    newSeg.path,
+		"key":     key,
+		"size":    newSeg.size,
+		"took":    time.Since(start),
+		"segment": pos,
+	}).Debug("moved segment to tiered storage")
+
+	return true, nil
+}
+
+func (sg *SegmentGroup) offloadSegment(pos int, seg *segment, key string) (*segment, error) {
+	if err := sg.tiering.Store.UploadSegment(context.Background(), key, seg.path); err != nil {
+		return nil, fmt.Errorf("upload: %w", err)
+	}
+
+	stubPath := seg.path + coldStubTmpSuffix
+	if err := writeColdStub(seg.path, stubPath, seg.segmentStartPos); err != nil {
+		os.Remove(stubPath)
+		return nil, fmt.Errorf("write stub: %w", err)
+	}
+
+	// the marker is written first, so that a crash before the stub replaces
+	// the segment leaves a complete segment that is read from the store. That
+	// is wasteful, but correct.
+	markerPath := coldMarkerPath(seg.path)
+	if err := writeColdMarker(markerPath, key, false); err != nil {
+		os.Remove(stubPath)
+		return nil, fmt.Errorf("write marker: %w", err)
+	}
+
+	sg.maintenanceLock.Lock()
+	defer sg.maintenanceLock.Unlock()
+
+	if err := os.Rename(stubPath, seg.path); err != nil {
+		return nil, fmt.Errorf("replace segment with stub: %w", err)
+	}
+	if err := fsync(sg.dir); err != nil {
+		return nil, fmt.Errorf("fsync segment directory: %w", err)
+	}
+
+	// readers holding the old segment keep using its (now unlinked) file
+	// until it is closed
+	cold := newColdSegment(key, sg.tiering.Store, sg.tiering.Cache, sg.metrics)
+	newSeg, err := newSegment(seg.path, sg.logger, sg.metrics,
+		sg.makeExistsOnLower(pos), sg.mmapContents, sg.useBloomFilter,
+		sg.calcCountNetAdditions, false, sg.checksumVerification, cold)
+	if err != nil {
+		return nil, fmt.Errorf("init stub: %w", err)
+	}
+	cold.tryEvict = func() bool { return sg.tryEvictCold(newSeg) }
+
+	if err := sg.closeOrRetireSegment(seg); err != nil {
+		return nil, fmt.Errorf("close offloaded segment: %w", err)
+	}
+	sg.segments[pos] = newSeg
+
+	return newSeg, nil
+}
+
+// tryEvictCold drops the cached data of a cold segment if nobody is reading
+// it. It never blocks, as the cache may call it while the caller holds the
+// maintenanceLock of another (or the same) segment group.
+func (sg *SegmentGroup) tryEvictCold(seg *segment) bool {
+	if !sg.maintenanceLock.TryLock() {
+		return false
+	}
+	defer sg.maintenanceLock.Unlock()
+
+	if !sg.snapshotLock.TryLock() {
+		return false
+	}
+	defer sg.snapshotLock.Unlock()
+
+	if sg.pinnedSegments[seg] > 0 {
+		return false
+	}
+
+	return seg.cold.evict() == nil
+}
+
+// pinColdForCompaction pins the segments of a compaction pair if one of
+// them is cold, so that its cached copy is not evicted while the compactor
+// reads it. The returned func releases the pins, it must be called before the
+// segments are replaced, as pinned segments would be retired instead of
+// closed.
+func (sg *SegmentGroup) pinColdForCompaction(segments ...*segment) func() error {
+	cold := false
+	for _, seg := range segments {
+		cold = cold || seg.cold != nil
+	}
+	if !cold {
+		return func() error { return nil }
+	}
+
+	sg.snapshotLock.Lock()
+	if sg.pinnedSegments == nil {
+		sg.pinnedSegments = map[*segment]int{}
+	}
+	for _, seg := range segments {
+		sg.pinnedSegments[seg]++
+	}
+	sg.snapshotLock.Unlock()
+
+	released := false
+	return func() error {
+		if released {
+			return nil
+		}
+		released = true
+		return sg.unpinSegments(segments)
+	}
+}
+
+// deleteColdSegment removes the data of a cold segment that was replaced by
+// a compaction from the store. If a snapshot still reads the segment, this is
+// deferred until the snapshot is released and the segment is closed.
+func (sg *SegmentGroup) deleteColdSegment(seg *segment) error {
+	markerPath := coldMarkerPath(seg.path) + DeleteMarkerSuffix
+
+	sg.snapshotLock.Lock()
+	if _, ok := sg.retiredSegments[seg]; ok {
+		seg.cold.deleteOnClose(markerPath)
+		sg.snapshotLock.Unlock()
+		return nil
+	}
+	sg.snapshotLock.Unlock()
+
+	return seg.cold.delete(markerPath)
+}
+
+// dropColdMarker deletes the store object referenced by the marker, unless
+// it is retained for a backup, and then the marker itself. It is a no-op if
+// the marker does not exist. On startup it finishes what an interrupted
+// compaction of cold segments left behind.
+func (sg *SegmentGroup) dropColdMarker(markerPath string) error {
+	key, retained, err := readColdMarker(markerPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+
+	if !retained {
+		if sg.tiering == nil || sg.tiering.Store == nil {
+			return fmt.Errorf("segment %s was moved to tiered storage, but no "+
+				"tiering backend is configured", markerPath)
+		}
+
+		if err := sg.tiering.Store.DeleteSegment(context.Background(), key); err != nil {
+			return fmt.Errorf("delete cold segment %q: %w", key, err)
+		}
+	}
+	return os.Remove(markerPath)
+}
+
+// retainColdSegments marks all cold segments as referenced by a backup. It is
+// called before the files of the bucket are listed for a backup (or a copy of
+// the shard), from then on their data is never deleted from the store, as the
+// copy only holds stubs referencing it.
+func (sg *SegmentGroup) retainColdSegments() error {
+	sg.maintenanceLock.RLock()
+	defer sg.maintenanceLock.RUnlock()
+
+	for _, seg := range sg.segments {
+		if seg.cold == nil {
+			continue
+		}
+		if err := seg.cold.retain(coldMarkerPath(seg.path)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteColdSegments deletes the store objects of all cold segments below
+// dir, including those of segments that are already marked for deletion.
+// Objects retained for a backup are kept. It must be called before a dropped
+// bucket (or shard) directory is removed, as the markers are the only
+// reference to the objects. A missing dir is not an error.
+func DeleteColdSegments(ctx context.Context, dir string, store SegmentStore) error {
+	err := filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		name := strings.TrimSuffix(d.Name(), DeleteMarkerSuffix)
+		if filepath.Ext(name) != ColdMarkerSuffix {
+			return nil
+		}
+
+		key, retained, err := readColdMarker(p)
+		if err != nil {
+			return err
+		}
+		if !retained {
+			if err := store.DeleteSegment(ctx, key); err != nil {
+				return fmt.Errorf("delete cold segment %q: %w", key, err)
+			}
+		}
+		return os.Remove(p)
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+// writeColdStub creates a sparse copy of the segment that only contains the
+// header and everything from the index onwards. All offsets remain valid,
+// the data section reads as zeros and must be fetched from the store.
+func writeColdStub(segmentPath, stubPath string, indexStart uint64) error {
+	in, err := os.Open(segmentPath)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+
+	out, err := os.Create(stubPath)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	if err := out.Truncate(info.Size()); err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(io.NewOffsetWriter(out, 0),
+		io.NewSectionReader(in, 0, segmentindex.HeaderSize)); err != nil {
+		return fmt.Errorf("copy header: %w", err)
+	}
+
+	if _, err := io.Copy(io.NewOffsetWriter(out, int64(indexStart)),
+		io.NewSectionReader(in, int64(indexStart), info.Size()-int64(indexStart))); err != nil {
+		return fmt.Errorf("copy index: %w", err)
+	}
+
+	if err := out.Sync(); err != nil {
+		return err
+	}
+	return out.Close()
+}
+
+// readColdMarker returns the key and the retained flag of the marker.
+// Markers written by older versions only hold the key.
+func readColdMarker(markerPath string) (string, bool, error) {
+	contents, err := os.ReadFile(markerPath)
+	if err != nil {
+		return "", false, fmt.Errorf("read cold marker: %w", err)
+	}
+
+	key, flags, _ := strings.Cut(string(contents), "\n")
+	return key, flags == coldRetainedFlag, nil
+}
+
+func writeColdMarker(markerPath, key string, retained bool) error {
+	contents := key
+	if retained {
+		contents += "\n" + coldRetainedFlag
+	}
+
+	tmpPath := markerPath + ".tmp"
+	f, err := os.Create(tmpPath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := f.WriteString(contents); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, markerPath); err != nil {
+		return err
+	}
+	return fsync(filepath.Dir(markerPath))
+}
+
+// FilesystemSegmentStore is a SegmentStore backed by a local directory. It
+// stands in for an object store in tests and single-node setups where the
+// cold tier is a separate (slower, cheaper) disk.
+type FilesystemSegmentStore struct {
+	root string
+}
+
+func NewFilesystemSegmentStore(root string) (*FilesystemSegmentStore, error) {
+	if err := os.MkdirAll(root, 0o777); err != nil {
+		return nil, fmt.Errorf("create segment store root: %w", err)
+	}
+	return &FilesystemSegmentStore{root: root}, nil
+}
+
+func (s *FilesystemSegmentStore) path(key string) (string, error) {
+	p := filepath.Join(s.root, filepath.FromSlash(key))
+	if !strings.HasPrefix(p, filepath.Clean(s.root)+string(filepath.Separator)) {
+		return "", fmt.Errorf("invalid segment key %q", key)
+	}
+	return p, nil
+}
+
+func (s *FilesystemSegmentStore) UploadSegment(ctx context.Context, key, localPath string) error {
+	dst, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0o777); err != nil {
+		return err
+	}
+	return copyFileReplace(localPath, dst)
+}
+
+func (s *FilesystemSegmentStore) DownloadSegment(ctx context.Context, key, localPath string) error {
+	src, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	return copyFileReplace(src, localPath)
+}
+
+func (s *FilesystemSegmentStore) DeleteSegment(ctx context.Context, key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	return os.RemoveAll(p)
+}
+
+// copyFileReplace copies src to dst through a temporary file, so that dst
+// is either absent, the previous version or complete
+func copyFileReplace(src, dst string) error {
+	tmp := dst + ".tmp"
+	os.Remove(tmp)
+	if err := copyFile(src, tmp); err != nil {
+		os.Remove(tmp)
+		return err
+	}
+	return os.Rename(tmp, dst)
+}
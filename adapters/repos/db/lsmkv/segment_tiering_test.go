@@ -0,0 +1,369 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestSegmentTiering(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+	value := func(i int) []byte { return bytes.Repeat([]byte{byte(i)}, 100) }
+
+	newTiering := func(t *testing.T, cacheSize int64) TieringConfig {
+		store, err := NewFilesystemSegmentStore(t.TempDir())
+		require.Nil(t, err)
+		cache, err := NewSegmentCache(t.TempDir(), cacheSize, nil)
+		require.Nil(t, err)
+		return TieringConfig{Store: store, Cache: cache, KeyPrefix: "class/shard/objects"}
+	}
+
+	openBucket := func(t *testing.T, dir string, opts ...BucketOption) *Bucket {
+		b, err := NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			append(opts, WithStrategy(StrategyReplace))...)
+		require.Nil(t, err)
+		return b
+	}
+
+	// writeSegments writes 10 keys into each of n segments
+	writeSegments := func(t *testing.T, b *Bucket, n int) {
+		for i := 0; i < n*10; i++ {
+			require.Nil(t, b.Put(key(i), value(i)))
+			if i%10 == 9 {
+				require.Nil(t, b.FlushAndSwitch())
+			}
+		}
+	}
+
+	assertValues := func(t *testing.T, b *Bucket, n int) {
+		for i := 0; i < n*10; i++ {
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			assert.Equal(t, value(i), v)
+		}
+	}
+
+	t.Run("offload, read and reload", func(t *testing.T) {
+		dir := t.TempDir()
+		tiering := newTiering(t, 1<<30)
+		b := openBucket(t, dir, WithTiering(tiering))
+		writeSegments(t, b, 2)
+
+		offloaded, err := b.disk.offloadOnce()
+		require.Nil(t, err)
+		require.True(t, offloaded)
+		assert.Equal(t, int64(0), tiering.Cache.Size())
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		require.Len(t, files.Cold, 1)
+		require.Len(t, files.Segments, 2)
+		marker, err := os.ReadFile(files.Cold[0])
+		require.Nil(t, err)
+		assert.True(t, strings.HasPrefix(string(marker), "class/shard/objects/"+filepath.Base(files.Segments[0])+"."))
+
+		// reads fetch the segment into the cache
+		assertValues(t, b, 2)
+		assert.Greater(t, tiering.Cache.Size(), int64(0))
+
+		// the second segment is offloaded next, then there is nothing left
+		offloaded, err = b.disk.offloadOnce()
+		require.Nil(t, err)
+		require.True(t, offloaded)
+		offloaded, err = b.disk.offloadOnce()
+		require.Nil(t, err)
+		require.False(t, offloaded)
+
+		require.Nil(t, b.Shutdown(ctx))
+		assert.Equal(t, int64(0), tiering.Cache.Size())
+
+		b = openBucket(t, dir, WithTiering(tiering))
+		defer b.Shutdown(ctx)
+		assertValues(t, b, 2)
+	})
+
+	// storeKeys lists the objects in a FilesystemSegmentStore
+	storeKeys := func(t *testing.T, tiering TieringConfig) []string {
+		var keys []string
+		root := tiering.Store.(*FilesystemSegmentStore).root
+		err := filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+			if err == nil && !d.IsDir() {
+				keys = append(keys, p)
+			}
+			return err
+		})
+		require.Nil(t, err)
+		return keys
+	}
+
+	t.Run("cold segments are compacted", func(t *testing.T) {
+		dir := t.TempDir()
+		tiering := newTiering(t, 1<<30)
+		b := openBucket(t, dir, WithTiering(tiering))
+		writeSegments(t, b, 3)
+
+		for i := 0; i < 2; i++ {
+			offloaded, err := b.disk.offloadOnce()
+			require.Nil(t, err)
+			require.True(t, offloaded)
+		}
+		require.Len(t, storeKeys(t, tiering), 2)
+
+		// the cold pair is merged into a regular segment, the store objects
+		// and markers of the compacted segments are deleted
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+		require.Len(t, b.disk.segments, 2)
+		assert.Nil(t, b.disk.segments[0].cold)
+		assert.Nil(t, b.disk.segments[1].cold)
+		assertValues(t, b, 3)
+
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Len(t, files.Cold, 0)
+		assert.Len(t, files.Leftovers, 0)
+		assert.Len(t, storeKeys(t, tiering), 0)
+		assert.Equal(t, int64(0), tiering.Cache.Size())
+
+		require.Nil(t, b.Shutdown(ctx))
+		b = openBucket(t, dir, WithTiering(tiering))
+		defer b.Shutdown(ctx)
+		assertValues(t, b, 3)
+	})
+
+	t.Run("cold segments read by a snapshot are deleted on release", func(t *testing.T) {
+		dir := t.TempDir()
+		tiering := newTiering(t, 1<<30)
+		b := openBucket(t, dir, WithTiering(tiering))
+		defer b.Shutdown(ctx)
+		writeSegments(t, b, 2)
+
+		for i := 0; i < 2; i++ {
+			_, err := b.disk.offloadOnce()
+			require.Nil(t, err)
+		}
+
+		snap, err := b.Snapshot()
+		require.Nil(t, err)
+
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+
+		// the snapshot can still fetch the replaced segments
+		tiering.Cache.shrink()
+		assert.Len(t, storeKeys(t, tiering), 2)
+		v, err := snap.Get(key(5))
+		require.Nil(t, err)
+		assert.Equal(t, value(5), v)
+
+		require.Nil(t, snap.Release())
+		assert.Len(t, storeKeys(t, tiering), 0)
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Len(t, files.Leftovers, 0)
+	})
+
+	t.Run("dropping a bucket deletes its cold segments", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "lsm", "objects")
+		tiering := newTiering(t, 1<<30)
+		b := openBucket(t, dir, WithTiering(tiering))
+		writeSegments(t, b, 2)
+		for i := 0; i < 2; i++ {
+			_, err := b.disk.offloadOnce()
+			require.Nil(t, err)
+		}
+		require.Nil(t, b.Shutdown(ctx))
+		require.Len(t, storeKeys(t, tiering), 2)
+
+		require.Nil(t, DeleteColdSegments(ctx, filepath.Dir(dir), tiering.Store))
+		assert.Len(t, storeKeys(t, tiering), 0)
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Len(t, files.Cold, 0)
+
+		// dropping again, or a directory that never existed, is a no-op
+		require.Nil(t, DeleteColdSegments(ctx, filepath.Dir(dir), tiering.Store))
+		require.Nil(t, DeleteColdSegments(ctx, filepath.Join(dir, "missing"), tiering.Store))
+	})
+
+	t.Run("offloading a compacted segment uses a new key", func(t *testing.T) {
+		dir := t.TempDir()
+		tiering := newTiering(t, 1<<30)
+		b := openBucket(t, dir, WithTiering(tiering))
+		defer b.Shutdown(ctx)
+		writeSegments(t, b, 2)
+
+		for i := 0; i < 2; i++ {
+			_, err := b.disk.offloadOnce()
+			require.Nil(t, err)
+		}
+		keys := map[string]struct{}{}
+		for _, seg := range b.disk.segments {
+			keys[seg.cold.key] = struct{}{}
+		}
+
+		// the snapshot defers the deletion of the compacted segments, the
+		// compacted segment has the file name of the right one
+		snap, err := b.Snapshot()
+		require.Nil(t, err)
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+		offloaded, err := b.disk.offloadOnce()
+		require.Nil(t, err)
+		require.True(t, offloaded)
+		require.Len(t, b.disk.segments, 1)
+		assert.NotContains(t, keys, b.disk.segments[0].cold.key)
+
+		require.Nil(t, snap.Release())
+		assert.Len(t, storeKeys(t, tiering), 1)
+		assertValues(t, b, 2)
+	})
+
+	t.Run("cold segments listed for a backup are retained", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "lsm", "objects")
+		tiering := newTiering(t, 1<<30)
+		b := openBucket(t, dir, WithTiering(tiering))
+		writeSegments(t, b, 2)
+		for i := 0; i < 2; i++ {
+			_, err := b.disk.offloadOnce()
+			require.Nil(t, err)
+		}
+
+		// copy the files like a backup does
+		backupDir := filepath.Join(t.TempDir(), "lsm", "objects")
+		require.Nil(t, os.MkdirAll(backupDir, 0o777))
+		listed, err := b.ListFiles(ctx, "")
+		require.Nil(t, err)
+		for _, name := range listed {
+			require.Nil(t, copyFile(filepath.Join(dir, name), filepath.Join(backupDir, name)))
+		}
+
+		// neither compacting nor dropping the bucket deletes the data the
+		// backup references
+		compacted, err := b.disk.compactOnce()
+		require.Nil(t, err)
+		require.True(t, compacted)
+		require.Nil(t, b.Shutdown(ctx))
+		require.Nil(t, DeleteColdSegments(ctx, filepath.Dir(dir), tiering.Store))
+		assert.Len(t, storeKeys(t, tiering), 2)
+
+		restored := openBucket(t, backupDir, WithTiering(tiering))
+		assertValues(t, restored, 2)
+		require.Nil(t, restored.Shutdown(ctx))
+		require.Nil(t, DeleteColdSegments(ctx, filepath.Dir(backupDir), tiering.Store))
+		assert.Len(t, storeKeys(t, tiering), 2)
+	})
+
+	t.Run("interrupted compaction of cold segments", func(t *testing.T) {
+		dir := t.TempDir()
+		tiering := newTiering(t, 1<<30)
+		b := openBucket(t, dir, WithTiering(tiering))
+		writeSegments(t, b, 2)
+		for i := 0; i < 2; i++ {
+			_, err := b.disk.offloadOnce()
+			require.Nil(t, err)
+		}
+		files, err := ListBucketFiles(dir)
+		require.Nil(t, err)
+		require.Len(t, files.Segments, 2)
+
+		// simulate a crash after the left segment was marked for deletion: a
+		// complete compacted segment exists, the right segment and both
+		// markers are still in place
+		require.Nil(t, b.disk.segments[0].markForDeletion())
+		left, right := segmentID(files.Segments[0]), segmentID(files.Segments[1])
+		require.Nil(t, b.Shutdown(ctx))
+
+		hot := openBucket(t, t.TempDir())
+		writeSegments(t, hot, 2)
+		_, err = hot.disk.compactOnce()
+		require.Nil(t, err)
+		require.Len(t, hot.disk.segments, 1)
+		require.Nil(t, copyFile(hot.disk.segments[0].path,
+			filepath.Join(dir, "segment-"+left+"_"+right+".db.tmp")))
+		require.Nil(t, hot.Shutdown(ctx))
+
+		b = openBucket(t, dir, WithTiering(tiering))
+		defer b.Shutdown(ctx)
+		require.Len(t, b.disk.segments, 1)
+		assert.Nil(t, b.disk.segments[0].cold)
+		assertValues(t, b, 2)
+
+		files, err = ListBucketFiles(dir)
+		require.Nil(t, err)
+		assert.Len(t, files.Cold, 0)
+		assert.Len(t, storeKeys(t, tiering), 0)
+	})
+
+	t.Run("cache evicts segments", func(t *testing.T) {
+		tiering := newTiering(t, 1)
+		buckets := make([]*Bucket, 2)
+		for i := range buckets {
+			buckets[i] = openBucket(t, t.TempDir(), WithTiering(tiering))
+			defer buckets[i].Shutdown(ctx)
+			writeSegments(t, buckets[i], 2)
+			for j := 0; j < 2; j++ {
+				offloaded, err := buckets[i].disk.offloadOnce()
+				require.Nil(t, err)
+				require.True(t, offloaded)
+			}
+		}
+
+		// a read evicts the segments of other buckets, but not those of its
+		// own bucket
+		assertValues(t, buckets[0], 2)
+		assertValues(t, buckets[1], 2)
+		assert.Equal(t, buckets[1].disk.segments[0].size+buckets[1].disk.segments[1].size,
+			tiering.Cache.Size())
+
+		tiering.Cache.shrink()
+		assert.Equal(t, int64(0), tiering.Cache.Size())
+		entries, err := os.ReadDir(tiering.Cache.dir)
+		require.Nil(t, err)
+		assert.Len(t, entries, 0)
+
+		// evicted segments are fetched again
+		assertValues(t, buckets[0], 2)
+	})
+
+	t.Run("segments with a marker can't be loaded without tiering", func(t *testing.T) {
+		dir := t.TempDir()
+		b := openBucket(t, dir, WithTiering(newTiering(t, 1<<30)))
+		writeSegments(t, b, 1)
+		_, err := b.disk.offloadOnce()
+		require.Nil(t, err)
+		require.Nil(t, b.Shutdown(ctx))
+
+		_, err = NewBucketCreator().NewBucket(ctx, dir, "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			WithStrategy(StrategyReplace))
+		assert.ErrorContains(t, err, "no tiering backend is configured")
+	})
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package segmentindex
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+)
+
+// checksumsFixedSize is the part of the checksum footer that does not depend
+// on the block count: 4 bytes for the block size, 4 bytes for the block
+// count, 4 bytes each for the index and header checksums and 4 bytes each for
+// the footer size and footer checksum at the very end
+const checksumsFixedSize = 24
+
+// Checksums is the footer of segments whose header has the
+// [SegmentChecksumsFlag] set. All checksums are CRC32 (IEEE).
+//
+// The section between the header and the index (the data section, including
+// compression or inverted headers) is split into blocks of BlockSize bytes
+// with one checksum each, so that reads only need to verify the blocks they
+// touch. The index, which is always read on load, and the header (including
+// the flag) have a single checksum each.
+//
+// The footer is laid out as follows, ending with its own size and checksum,
+// so that it can be located and validated from the end of the file:
+//
+//	| block size (4) | block count (4) | block checksums (4 * count) |
+//	| index checksum (4) | header checksum (4) | footer size (4) |
+//	| footer checksum (4) |
+type Checksums struct {
+	BlockSize uint32
+	Blocks    []uint32
+	Index     uint32
+	Header    uint32
+}
+
+// Size is the total size of the footer in bytes
+func (c *Checksums) Size() int {
+	return checksumsFixedSize + 4*len(c.Blocks)
+}
+
+func (c *Checksums) WriteTo(w io.Writer) (int64, error) {
+	buf := make([]byte, c.Size())
+	binary.LittleEndian.PutUint32(buf[0:4], c.BlockSize)
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(c.Blocks)))
+	pos := 8
+	for _, sum := range c.Blocks {
+		binary.LittleEndian.PutUint32(buf[pos:pos+4], sum)
+		pos += 4
+	}
+	binary.LittleEndian.PutUint32(buf[pos:pos+4], c.Index)
+	binary.LittleEndian.PutUint32(buf[pos+4:pos+8], c.Header)
+	binary.LittleEndian.PutUint32(buf[pos+8:pos+12], uint32(len(buf)))
+	binary.LittleEndian.PutUint32(buf[pos+12:pos+16], crc32.ChecksumIEEE(buf[:pos+12]))
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// LoadChecksums parses the checksum footer at the end of the segment
+// contents. It validates the footer itself, but none of the checksums it
+// contains.
+func LoadChecksums(contents []byte) (*Checksums, error) {
+	if len(contents) < HeaderSize+checksumsFixedSize {
+		return nil, fmt.Errorf("segment of %d bytes too short for checksum footer", len(contents))
+	}
+
+	end := len(contents)
+	size := int(binary.LittleEndian.Uint32(contents[end-8 : end-4]))
+	if size < checksumsFixedSize || size > end-HeaderSize {
+		return nil, fmt.Errorf("invalid checksum footer size %d", size)
+	}
+
+	footer := contents[end-size:]
+	if sum := binary.LittleEndian.Uint32(footer[size-4:]); sum != crc32.ChecksumIEEE(footer[:size-4]) {
+		return nil, fmt.Errorf("checksum footer does not match its checksum")
+	}
+
+	blockCount := int(binary.LittleEndian.Uint32(footer[4:8]))
+	if size != checksumsFixedSize+4*blockCount {
+		return nil, fmt.Errorf("checksum footer size %d does not match %d blocks", size, blockCount)
+	}
+
+	c := &Checksums{
+		BlockSize: binary.LittleEndian.Uint32(footer[0:4]),
+		Blocks:    make([]uint32, blockCount),
+	}
+	if c.BlockSize == 0 {
+		return nil, fmt.Errorf("invalid checksum block size 0")
+	}
+
+	pos := 8
+	for i := range c.Blocks {
+		c.Blocks[i] = binary.LittleEndian.Uint32(footer[pos : pos+4])
+		pos += 4
+	}
+	c.Index = binary.LittleEndian.Uint32(footer[pos : pos+4])
+	c.Header = binary.LittleEndian.Uint32(footer[pos+4 : pos+8])
+
+	return c, nil
+}
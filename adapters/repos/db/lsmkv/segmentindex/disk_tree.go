@@ -172,6 +172,91 @@ func (t *DiskTree) seekAt(offset int64, key []byte, includingKey bool) (Node, er
 	}
 }
 
+// SeekReverse returns the node with the largest key that is smaller than or
+// equal to the specified key. It is the counterpart of Seek for reverse
+// iteration.
+func (t *DiskTree) SeekReverse(key []byte) (Node, error) {
+	if len(t.data) == 0 {
+		return Node{}, lsmkv.NotFound
+	}
+
+	return t.seekReverseAt(0, key, true)
+}
+
+// Prev returns the node with the largest key that is strictly smaller than
+// the specified key. It is the counterpart of Next for reverse iteration.
+func (t *DiskTree) Prev(key []byte) (Node, error) {
+	if len(t.data) == 0 {
+		return Node{}, lsmkv.NotFound
+	}
+
+	return t.seekReverseAt(0, key, false)
+}
+
+// Last returns the node with the largest key in the tree
+func (t *DiskTree) Last() (Node, error) {
+	if len(t.data) == 0 {
+		return Node{}, lsmkv.NotFound
+	}
+
+	offset := int64(0)
+	for {
+		node, err := t.readNodeAt(offset)
+		if err != nil {
+			return Node{}, err
+		}
+
+		if node.rightChild < 0 {
+			return Node{
+				Key:   node.key,
+				Start: node.startPos,
+				End:   node.endPos,
+			}, nil
+		}
+		offset = node.rightChild
+	}
+}
+
+func (t *DiskTree) seekReverseAt(offset int64, key []byte, includingKey bool) (Node, error) {
+	node, err := t.readNodeAt(offset)
+	if err != nil {
+		return Node{}, err
+	}
+
+	self := Node{
+		Key:   node.key,
+		Start: node.startPos,
+		End:   node.endPos,
+	}
+
+	if includingKey && bytes.Equal(key, node.key) {
+		return self, nil
+	}
+
+	if bytes.Compare(key, node.key) > 0 {
+		if node.rightChild < 0 {
+			return self, nil
+		}
+
+		right, err := t.seekReverseAt(node.rightChild, key, includingKey)
+		if err == nil {
+			return right, nil
+		}
+
+		if errors.Is(err, lsmkv.NotFound) {
+			return self, nil
+		}
+
+		return Node{}, err
+	} else {
+		if node.leftChild < 0 {
+			return Node{}, lsmkv.NotFound
+		}
+
+		return t.seekReverseAt(node.leftChild, key, includingKey)
+	}
+}
+
 // AllKeys is a relatively expensive operation as it basically does a full disk
 // read of the index. It is meant for one of operations, such as initializing a
 // segment where we need access to all keys, e.g. to build a bloom filter. This
@@ -25,6 +25,21 @@ import (
 // for the pointer to the index part
 const HeaderSize = 16
 
+const (
+	// SegmentV0 is the original segment layout, the data section is stored
+	// uncompressed
+	SegmentV0 uint16 = 0
+	// SegmentV1Compressed stores the data section in compressed blocks, see
+	// [HeaderCompression] for details. Index offsets still refer to the
+	// uncompressed layout.
+	SegmentV1Compressed uint16 = 1
+
+	// SegmentChecksumsFlag is set on top of the layout version if the segment
+	// ends with a checksum footer, see [Checksums]. Both layouts can carry
+	// checksums.
+	SegmentChecksumsFlag uint16 = 1 << 15
+)
+
 type Header struct {
 	Level            uint16
 	Version          uint16
@@ -53,6 +68,17 @@ func (h *Header) WriteTo(w io.Writer) (int64, error) {
 	return int64(HeaderSize), nil
 }
 
+// LayoutVersion is the version without flags, i.e. [SegmentV0] or
+// [SegmentV1Compressed]
+func (h *Header) LayoutVersion() uint16 {
+	return h.Version &^ SegmentChecksumsFlag
+}
+
+// HasChecksums indicates that the segment ends with a checksum footer
+func (h *Header) HasChecksums() bool {
+	return h.Version&SegmentChecksumsFlag != 0
+}
+
 func (h *Header) PrimaryIndex(source []byte) ([]byte, error) {
 	if h.SecondaryIndices == 0 {
 		return source[h.IndexStart:], nil
@@ -121,7 +147,7 @@ func ParseHeader(r io.Reader) (*Header, error) {
 		return nil, err
 	}
 
-	if out.Version != 0 {
+	if v := out.LayoutVersion(); v != SegmentV0 && v != SegmentV1Compressed {
 		return nil, fmt.Errorf("unsupported version %d", out.Version)
 	}
 
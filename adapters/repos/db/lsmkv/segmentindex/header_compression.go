@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package segmentindex
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+type Compression uint8
+
+const (
+	CompressionNone Compression = iota
+	CompressionSnappy
+	CompressionZstd
+)
+
+func (c Compression) String() string {
+	switch c {
+	case CompressionNone:
+		return "none"
+	case CompressionSnappy:
+		return "snappy"
+	case CompressionZstd:
+		return "zstd"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(c))
+	}
+}
+
+// HeaderCompressionSize is the size of the compression header that follows
+// the regular segment header on block-compressed segments. It is composed of
+// 1 byte for the codec, 4 bytes for the (uncompressed) block size, 4 bytes
+// for the block count and 8 bytes for the logical end of the data section.
+//
+// The compressed blocks follow the compression header. After the last block,
+// BlockCount+1 uint64 offsets point to the physical start of each block (the
+// last one marks the end of the last block). The indexes start right after
+// the block offsets. As the block offsets are only known at the end of the
+// data section, a segment can be compressed while it is written.
+const HeaderCompressionSize = 17
+
+type HeaderCompression struct {
+	Codec      Compression
+	BlockSize  uint32
+	BlockCount uint32
+	// DataEnd is the end of the data section in the logical (uncompressed)
+	// layout. All offsets in the segment indexes refer to this layout.
+	DataEnd uint64
+	// BlockOffsets contains BlockCount+1 physical offsets into the segment
+	// file
+	BlockOffsets []uint64
+}
+
+// BlockOffsetsSize is the size of the block offsets in front of the indexes
+func (h *HeaderCompression) BlockOffsetsSize() int {
+	return (int(h.BlockCount) + 1) * 8
+}
+
+// WriteTo writes the compression header without the block offsets, see
+// WriteBlockOffsetsTo
+func (h *HeaderCompression) WriteTo(w io.Writer) (int64, error) {
+	buf := make([]byte, HeaderCompressionSize)
+	buf[0] = byte(h.Codec)
+	binary.LittleEndian.PutUint32(buf[1:5], h.BlockSize)
+	binary.LittleEndian.PutUint32(buf[5:9], h.BlockCount)
+	binary.LittleEndian.PutUint64(buf[9:17], h.DataEnd)
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+func (h *HeaderCompression) WriteBlockOffsetsTo(w io.Writer) (int64, error) {
+	if len(h.BlockOffsets) != int(h.BlockCount)+1 {
+		return 0, fmt.Errorf("expected %d block offsets, got %d",
+			h.BlockCount+1, len(h.BlockOffsets))
+	}
+
+	buf := make([]byte, h.BlockOffsetsSize())
+	for i, offset := range h.BlockOffsets {
+		binary.LittleEndian.PutUint64(buf[i*8:i*8+8], offset)
+	}
+
+	n, err := w.Write(buf)
+	return int64(n), err
+}
+
+// LoadHeaderCompression parses the compression header from the bytes
+// immediately following the regular segment header and the block offsets
+// from the bytes in front of the indexes at indexStart
+func LoadHeaderCompression(contents []byte, indexStart uint64) (*HeaderCompression, error) {
+	if len(contents) < HeaderSize+HeaderCompressionSize {
+		return nil, fmt.Errorf("compression header too short: %d bytes",
+			max(len(contents)-HeaderSize, 0))
+	}
+
+	source := contents[HeaderSize:]
+	h := &HeaderCompression{
+		Codec:      Compression(source[0]),
+		BlockSize:  binary.LittleEndian.Uint32(source[1:5]),
+		BlockCount: binary.LittleEndian.Uint32(source[5:9]),
+		DataEnd:    binary.LittleEndian.Uint64(source[9:17]),
+	}
+
+	if h.Codec != CompressionSnappy && h.Codec != CompressionZstd {
+		return nil, fmt.Errorf("unsupported compression codec %s", h.Codec)
+	}
+
+	if h.BlockSize == 0 {
+		return nil, fmt.Errorf("invalid compression block size 0")
+	}
+
+	blocksStart := uint64(HeaderSize + HeaderCompressionSize)
+	if indexStart > uint64(len(contents)) ||
+		indexStart < blocksStart+uint64(h.BlockOffsetsSize()) {
+		return nil, fmt.Errorf("offsets of %d blocks don't fit in front of the index at %d",
+			h.BlockCount, indexStart)
+	}
+
+	offsets := contents[indexStart-uint64(h.BlockOffsetsSize()) : indexStart]
+	h.BlockOffsets = make([]uint64, h.BlockCount+1)
+	for i := range h.BlockOffsets {
+		h.BlockOffsets[i] = binary.LittleEndian.Uint64(offsets[i*8 : i*8+8])
+	}
+
+	return h, nil
+}
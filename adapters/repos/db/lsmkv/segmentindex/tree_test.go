@@ -12,6 +12,7 @@
 package segmentindex
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -194,6 +195,52 @@ func TestTree(t *testing.T) {
 			assert.Equal(t, lsmkv.NotFound, err)
 		})
 
+		t.Run("seek reverse", func(t *testing.T) {
+			n, err := dTree.SeekReverse([]byte("foobar"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("foobar"), n.Key)
+			assert.Equal(t, uint64(17), n.Start)
+			assert.Equal(t, uint64(18), n.End)
+
+			n, err = dTree.SeekReverse([]byte("g"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("foobar"), n.Key)
+
+			n, err = dTree.SeekReverse([]byte("abd"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("abc"), n.Key)
+
+			n, err = dTree.SeekReverse([]byte("zzzzz"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("zzzz"), n.Key)
+			assert.Equal(t, uint64(100), n.Start)
+			assert.Equal(t, uint64(102), n.End)
+
+			n, err = dTree.SeekReverse([]byte("aaa"))
+			assert.Nil(t, err)
+			assert.Equal(t, []byte("aaa"), n.Key)
+
+			_, err = dTree.SeekReverse([]byte("a"))
+			assert.Equal(t, lsmkv.NotFound, err)
+		})
+
+		t.Run("iterate in reverse order", func(t *testing.T) {
+			n, err := dTree.Last()
+			require.Nil(t, err)
+
+			var keys []string
+			for {
+				keys = append(keys, string(n.Key))
+				n, err = dTree.Prev(n.Key)
+				if errors.Is(err, lsmkv.NotFound) {
+					break
+				}
+				require.Nil(t, err)
+			}
+
+			assert.Equal(t, []string{"zzzz", "zzz", "foobar", "abc", "aaa"}, keys)
+		})
+
 		t.Run("get all keys (for building bloom filters at segment init time)", func(t *testing.T) {
 			expected := [][]byte{
 				[]byte("aaa"),
@@ -56,6 +56,13 @@ type Store struct {
 	// action on the bucket in the meantime.
 	bucketsLocks *wsync.KeyLocker
 
+	// optional, applies to all buckets created after it was set
+	compactionScheduler *CompactionScheduler
+
+	// set between StartBulkLoad and FinishBulkLoad, protected by
+	// bucketAccessLock so that buckets created during the load join it
+	bulkLoading bool
+
 	closeLock sync.RWMutex
 	closed    bool
 }
@@ -81,6 +88,21 @@ func New(dir, rootDir string, logger logrus.FieldLogger, metrics *Metrics,
 	return s, s.init()
 }
 
+// SetCompactionScheduler makes the compactions of all buckets that are
+// created afterwards subject to the node-wide scheduler
+func (s *Store) SetCompactionScheduler(scheduler *CompactionScheduler) {
+	s.compactionScheduler = scheduler
+}
+
+// withStoreOptions prepends the options that apply to all buckets of the
+// store, so that explicit options take precedence
+func (s *Store) withStoreOptions(opts []BucketOption) []BucketOption {
+	if s.compactionScheduler == nil {
+		return opts
+	}
+	return append([]BucketOption{WithCompactionScheduler(s.compactionScheduler)}, opts...)
+}
+
 func (s *Store) Bucket(name string) *Bucket {
 	s.bucketAccessLock.RLock()
 	defer s.bucketAccessLock.RUnlock()
@@ -191,21 +213,24 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	// bucket can be concurrently loaded with another buckets but
 	// the same bucket will be loaded only once
 	b, err := s.bcreator.NewBucket(ctx, s.bucketDir(bucketName), s.rootDir, s.logger, s.metrics,
-		compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.withStoreOptions(opts)...)
 	if err != nil {
 		return err
 	}
 
-	s.setBucket(bucketName, b)
-
-	return nil
+	return s.setBucket(bucketName, b)
 }
 
-func (s *Store) setBucket(name string, b *Bucket) {
+func (s *Store) setBucket(name string, b *Bucket) error {
 	s.bucketAccessLock.Lock()
-	defer s.bucketAccessLock.Unlock()
-
 	s.bucketsByName[name] = b
+	bulkLoading := s.bulkLoading
+	s.bucketAccessLock.Unlock()
+
+	if bulkLoading {
+		return b.startBulkLoad()
+	}
+	return nil
 }
 
 func (s *Store) Shutdown(ctx context.Context) error {
@@ -402,14 +427,12 @@ func (s *Store) CreateBucket(ctx context.Context, bucketName string,
 	}
 
 	b, err := s.bcreator.NewBucket(ctx, bucketDir, s.rootDir, s.logger, s.metrics,
-		compactionCallbacks, s.cycleCallbacks.flushCallbacks, opts...)
+		compactionCallbacks, s.cycleCallbacks.flushCallbacks, s.withStoreOptions(opts)...)
 	if err != nil {
 		return err
 	}
 
-	s.setBucket(bucketName, b)
-
-	return nil
+	return s.setBucket(bucketName, b)
 }
 
 // Replaces 1st bucket with 2nd one. Both buckets have to registered in bucketsByName.
@@ -499,6 +522,34 @@ func (s *Store) RenameBucket(ctx context.Context, bucketName, newBucketName stri
 	return nil
 }
 
+// DropBucket shuts down the bucket with the given name and removes all of its
+// files. Dropping a bucket that does not exist is a no-op.
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.closeLock.RLock()
+	defer s.closeLock.RUnlock()
+
+	if s.closed {
+		return fmt.Errorf("%w: dropping bucket %q in store %q", ErrAlreadyClosed, bucketName, s.dir)
+	}
+
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	bucket := s.bucketsByName[bucketName]
+	if bucket == nil {
+		return nil
+	}
+	delete(s.bucketsByName, bucketName)
+
+	if err := bucket.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "failed shutting down bucket '%s'", bucketName)
+	}
+	if err := os.RemoveAll(bucket.dir); err != nil {
+		return errors.Wrapf(err, "failed removing dir '%s'", bucket.dir)
+	}
+	return nil
+}
+
 func (s *Store) updateBucketDir(bucket *Bucket, bucketDir, newBucketDir string) {
 	updatePath := func(src string) string {
 		return strings.Replace(src, bucketDir, newBucketDir, 1)
@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+)
+
+// StartBulkLoad puts all buckets of the store, including the ones created
+// later on, into bulk load mode: Writes skip the WAL and memtables are
+// flushed into segments that are only merged by FinishBulkLoad. The store must be
+// taken out of this mode using FinishBulkLoad. Data that was not yet flushed
+// when the process stops is not recovered.
+func (s *Store) StartBulkLoad() error {
+	s.closeLock.RLock()
+	defer s.closeLock.RUnlock()
+
+	if s.closed {
+		return fmt.Errorf("%w: starting bulk load in store %q", ErrAlreadyClosed, s.dir)
+	}
+
+	s.bucketAccessLock.Lock()
+	s.bulkLoading = true
+	buckets := make([]*Bucket, 0, len(s.bucketsByName))
+	for _, b := range s.bucketsByName {
+		if b != nil {
+			buckets = append(buckets, b)
+		}
+	}
+	s.bucketAccessLock.Unlock()
+
+	ec := &errorcompounder.ErrorCompounder{}
+	for _, b := range buckets {
+		ec.AddWrap(b.startBulkLoad(), b.dir)
+	}
+	return ec.ToError()
+}
+
+// FinishBulkLoad flushes the memtables that were filled during the bulk load,
+// turns the WAL back on and merges the segments written during the load. The
+// merge respects the max segment size and the compaction scheduler, and
+// memtables keep being flushed while it runs. Regular compactions resume per
+// bucket once its merge is done. It only returns once all bulk loaded data has
+// been written to its final segments.
+func (s *Store) FinishBulkLoad(ctx context.Context) error {
+	s.closeLock.RLock()
+	defer s.closeLock.RUnlock()
+
+	if s.closed {
+		return fmt.Errorf("%w: finishing bulk load in store %q", ErrAlreadyClosed, s.dir)
+	}
+
+	if err := s.finishBulkLoadFlush(ctx); err != nil {
+		return err
+	}
+
+	merge := func(ctx context.Context, b *Bucket) (interface{}, error) {
+		return nil, b.mergeBulkLoad(ctx)
+	}
+	_, err := s.runJobOnBuckets(ctx, merge, nil)
+	return err
+}
+
+// finishBulkLoadFlush flushes the bulk loaded memtables, which must not race
+// with the regular flush cycle
+func (s *Store) finishBulkLoadFlush(ctx context.Context) error {
+	if err := s.cycleCallbacks.flushCallbacksCtrl.Deactivate(ctx); err != nil {
+		return errors.Wrap(err, "long-running memtable flush in progress")
+	}
+	defer s.cycleCallbacks.flushCallbacksCtrl.Activate()
+
+	s.bucketAccessLock.Lock()
+	s.bulkLoading = false
+	s.bucketAccessLock.Unlock()
+
+	finish := func(ctx context.Context, b *Bucket) (interface{}, error) {
+		return nil, b.finishBulkLoad()
+	}
+	_, err := s.runJobOnBuckets(ctx, finish, nil)
+	return err
+}
+
+// IsBulkLoading indicates whether the store is between StartBulkLoad and
+// FinishBulkLoad
+func (s *Store) IsBulkLoading() bool {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
+	return s.bulkLoading
+}
@@ -0,0 +1,298 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package lsmkv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+func TestStoreBulkLoad(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+
+	newStore := func(t *testing.T) *Store {
+		store, err := New(dirName, dirName, logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		require.Nil(t, store.CreateOrLoadBucket(ctx, "existing", WithStrategy(StrategyReplace)))
+		return store
+	}
+
+	walSize := func(t *testing.T, b *Bucket) int64 {
+		require.Nil(t, b.WriteWAL())
+		info, err := os.Stat(b.active.commitlog.path)
+		require.Nil(t, err)
+		return info.Size()
+	}
+
+	store := newStore(t)
+	existing := store.Bucket("existing")
+	require.Nil(t, existing.Put(key(0), []byte("before")))
+	sizeBefore := walSize(t, existing)
+	require.Greater(t, sizeBefore, int64(0))
+
+	t.Run("start bulk load", func(t *testing.T) {
+		require.Nil(t, store.StartBulkLoad())
+		assert.True(t, store.IsBulkLoading())
+		require.Nil(t, store.CreateOrLoadBucket(ctx, "created", WithStrategy(StrategyReplace)))
+	})
+
+	created := store.Bucket("created")
+
+	t.Run("writes skip the WAL", func(t *testing.T) {
+		for i := 1; i < 100; i++ {
+			require.Nil(t, existing.Put(key(i), []byte("bulk")))
+			require.Nil(t, created.Put(key(i), []byte("bulk")))
+		}
+		assert.Equal(t, sizeBefore, walSize(t, existing))
+		assert.Equal(t, int64(0), walSize(t, created))
+	})
+
+	t.Run("flushed runs are not compacted", func(t *testing.T) {
+		for _, b := range []*Bucket{existing, created} {
+			require.Nil(t, b.FlushAndSwitch())
+			require.Nil(t, b.Put(key(100), []byte("bulk")))
+			require.Nil(t, b.FlushAndSwitch())
+			require.Nil(t, b.Put(key(101), []byte("bulk")))
+
+			assert.Equal(t, 2, b.disk.Len())
+			assert.Equal(t, int64(0), walSize(t, b))
+			pair, _ := b.disk.findCompactionCandidates()
+			assert.Nil(t, pair)
+		}
+	})
+
+	t.Run("finish bulk load", func(t *testing.T) {
+		require.Nil(t, store.FinishBulkLoad(ctx))
+		assert.False(t, store.IsBulkLoading())
+
+		for _, b := range []*Bucket{existing, created} {
+			assert.Equal(t, 1, b.disk.Len())
+			assert.Equal(t, uint64(0), b.active.Size())
+
+			require.Nil(t, b.Put(key(200), []byte("after")))
+			assert.Greater(t, walSize(t, b), int64(0))
+		}
+	})
+
+	t.Run("bulk loaded data survives a restart", func(t *testing.T) {
+		require.Nil(t, store.Shutdown(ctx))
+
+		store = newStore(t)
+		defer store.Shutdown(ctx)
+		require.Nil(t, store.CreateOrLoadBucket(ctx, "created", WithStrategy(StrategyReplace)))
+
+		for _, name := range []string{"existing", "created"} {
+			b := store.Bucket(name)
+			for _, i := range []int{1, 99, 100, 101, 200} {
+				v, err := b.Get(key(i))
+				require.Nil(t, err)
+				assert.NotNil(t, v, "key %d in bucket %s", i, name)
+			}
+		}
+		v, err := store.Bucket("existing").Get(key(0))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("before"), v)
+		assert.DirExists(t, filepath.Join(dirName, "created"))
+	})
+}
+
+func TestStoreBulkLoadMergesRuns(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dirName := t.TempDir()
+
+	store, err := New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	require.Nil(t, store.StartBulkLoad())
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "objects", WithStrategy(StrategyReplace)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "inverted", WithStrategy(StrategyInverted)))
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "roaring", WithStrategy(StrategyRoaringSet)))
+	objects, inverted, roaring := store.Bucket("objects"), store.Bucket("inverted"), store.Bucket("roaring")
+
+	docKey := func(id uint64) []byte {
+		k := make([]byte, 8)
+		binary.BigEndian.PutUint64(k, id)
+		return k
+	}
+	tf := func(tf, propLength float32) []byte {
+		v := make([]byte, 8)
+		binary.LittleEndian.PutUint32(v[0:4], math.Float32bits(tf))
+		binary.LittleEndian.PutUint32(v[4:8], math.Float32bits(propLength))
+		return v
+	}
+
+	// every run overwrites one object of the previous run, so the merge
+	// order matters
+	const runs, perRun = 7, 10
+	for run := 0; run < runs; run++ {
+		for i := 0; i < perRun; i++ {
+			id := uint64(run*perRun + i)
+			require.Nil(t, objects.Put(docKey(id), []byte(fmt.Sprintf("obj-%d", id))))
+			require.Nil(t, inverted.MapSet([]byte("term"), MapPair{Key: docKey(id), Value: tf(1, 2)}))
+			require.Nil(t, roaring.RoaringSetAddOne([]byte("prop"), id))
+		}
+		if run > 0 {
+			require.Nil(t, objects.Put(docKey(uint64(run*perRun-1)), []byte("overwritten")))
+		}
+		for _, b := range []*Bucket{objects, inverted, roaring} {
+			require.Nil(t, b.FlushAndSwitch())
+		}
+	}
+	for _, b := range []*Bucket{objects, inverted, roaring} {
+		require.Equal(t, runs, b.disk.Len())
+	}
+
+	require.Nil(t, store.FinishBulkLoad(ctx))
+
+	for _, b := range []*Bucket{objects, inverted, roaring} {
+		assert.Equal(t, 1, b.disk.Len(), b.dir)
+	}
+
+	for id := uint64(0); id < runs*perRun; id++ {
+		v, err := objects.Get(docKey(id))
+		require.Nil(t, err)
+		if id%perRun == perRun-1 && id < (runs-1)*perRun {
+			assert.Equal(t, []byte("overwritten"), v)
+		} else {
+			assert.Equal(t, []byte(fmt.Sprintf("obj-%d", id)), v)
+		}
+	}
+
+	pairs, err := inverted.MapList(ctx, []byte("term"))
+	require.Nil(t, err)
+	assert.Len(t, pairs, runs*perRun)
+
+	bm, err := roaring.RoaringSetGet([]byte("prop"))
+	require.Nil(t, err)
+	assert.Equal(t, runs*perRun, bm.GetCardinality())
+}
+
+func TestStoreBulkLoadMergesOnlyLoadedRuns(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+
+	key := func(i int) []byte { return []byte(fmt.Sprintf("key-%03d", i)) }
+
+	newStore := func(t *testing.T, opts ...BucketOption) (*Store, *Bucket) {
+		store, err := New(t.TempDir(), "", logger, nil,
+			cyclemanager.NewCallbackGroupNoop(), cyclemanager.NewCallbackGroupNoop(),
+			cyclemanager.NewCallbackGroupNoop())
+		require.Nil(t, err)
+		t.Cleanup(func() { store.Shutdown(ctx) })
+
+		opts = append([]BucketOption{WithStrategy(StrategyReplace)}, opts...)
+		require.Nil(t, store.CreateOrLoadBucket(ctx, "objects", opts...))
+		return store, store.Bucket("objects")
+	}
+
+	writeRuns := func(t *testing.T, b *Bucket, offset, runs int) {
+		for run := 0; run < runs; run++ {
+			for i := 0; i < 10; i++ {
+				require.Nil(t, b.Put(key(offset+run*10+i), bytes.Repeat([]byte("v"), 100)))
+			}
+			require.Nil(t, b.FlushAndSwitch())
+		}
+	}
+
+	t.Run("segments from before the load are not merged", func(t *testing.T) {
+		store, b := newStore(t)
+		writeRuns(t, b, 0, 2)
+		before := []string{b.disk.segmentAtPos(0).path, b.disk.segmentAtPos(1).path}
+
+		require.Nil(t, store.StartBulkLoad())
+		writeRuns(t, b, 100, 5)
+		require.Equal(t, 7, b.disk.Len())
+		require.Nil(t, store.FinishBulkLoad(ctx))
+
+		require.Equal(t, 3, b.disk.Len())
+		assert.Equal(t, before, []string{b.disk.segmentAtPos(0).path, b.disk.segmentAtPos(1).path})
+		for _, i := range []int{0, 19, 100, 149} {
+			v, err := b.Get(key(i))
+			require.Nil(t, err)
+			assert.NotNil(t, v, i)
+		}
+	})
+
+	t.Run("runs are not merged beyond the max segment size", func(t *testing.T) {
+		store, b := newStore(t, WithMaxSegmentSize(1))
+
+		require.Nil(t, store.StartBulkLoad())
+		writeRuns(t, b, 0, 3)
+		require.Nil(t, store.FinishBulkLoad(ctx))
+
+		assert.Equal(t, 3, b.disk.Len())
+		assert.False(t, b.disk.isMaintenanceHalted())
+	})
+
+	t.Run("the merge waits for the compaction scheduler", func(t *testing.T) {
+		retry := bulkLoadMergeRetryInterval
+		bulkLoadMergeRetryInterval = 10 * time.Millisecond
+		defer func() { bulkLoadMergeRetryInterval = retry }()
+
+		scheduler := NewCompactionScheduler(CompactionSchedulerConfig{MaxConcurrent: 1}, logger, nil)
+		store, b := newStore(t, WithCompactionScheduler(scheduler))
+
+		require.Nil(t, store.StartBulkLoad())
+		writeRuns(t, b, 0, 2)
+
+		other := scheduler.tryAcquire(&SegmentGroup{}, compactionRequest{dir: "other", segments: 100})
+		require.NotNil(t, other)
+
+		finished := make(chan error, 1)
+		go func() { finished <- store.FinishBulkLoad(ctx) }()
+		require.Eventually(t, func() bool {
+			return len(scheduler.Status().Waiting) == 1
+		}, time.Second, 5*time.Millisecond)
+
+		// memtables are flushed while the merge is waiting
+		require.Nil(t, b.Put(key(500), []byte("during merge")))
+		require.Nil(t, b.FlushAndSwitch())
+
+		select {
+		case err := <-finished:
+			t.Fatalf("merge did not wait for the scheduler: %v", err)
+		case <-time.After(100 * time.Millisecond):
+		}
+		assert.Equal(t, 3, b.disk.Len())
+
+		other.done()
+		require.Nil(t, <-finished)
+		assert.Equal(t, 2, b.disk.Len())
+		assert.Len(t, scheduler.Status().Running, 0)
+
+		v, err := b.Get(key(500))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("during merge"), v)
+	})
+}
@@ -110,3 +110,30 @@ func TestCreateBucketConcurrency(t *testing.T) {
 	mockBucketCreator.AssertNumberOfCalls(t, "NewBucket", 1)
 	mockBucketCreator.AssertExpectations(t)
 }
+
+func TestDropBucket(t *testing.T) {
+	dirName := t.TempDir()
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	store, err := New(dirName, dirName, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	defer store.Shutdown(ctx)
+
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "to_drop"))
+	require.Nil(t, store.Bucket("to_drop").Put([]byte("key"), []byte("value")))
+	require.Nil(t, store.CreateOrLoadBucket(ctx, "to_keep"))
+	dir := store.bucketDir("to_drop")
+
+	require.Nil(t, store.DropBucket(ctx, "to_drop"))
+	require.Nil(t, store.Bucket("to_drop"))
+	require.NotNil(t, store.Bucket("to_keep"))
+	_, err = os.Stat(dir)
+	require.True(t, os.IsNotExist(err))
+
+	// dropping a missing bucket is a no-op
+	require.Nil(t, store.DropBucket(ctx, "to_drop"))
+}
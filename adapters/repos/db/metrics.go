@@ -26,6 +26,7 @@ type Metrics struct {
 	batchDeleteTime       prometheus.ObserverVec
 	batchCount            prometheus.Counter
 	batchCountBytes       prometheus.Counter
+	objectsTTLExpired     prometheus.Counter
 	objectTime            prometheus.ObserverVec
 	startupDurations      prometheus.ObserverVec
 	filteredVectorFilter  prometheus.Observer
@@ -73,6 +74,10 @@ func NewMetrics(
 		"class_name": className,
 		"shard_name": shardName,
 	})
+	m.objectsTTLExpired = prom.ObjectsTTLExpired.With(prometheus.Labels{
+		"class_name": className,
+		"shard_name": shardName,
+	})
 	m.objectTime = prom.ObjectsTime.MustCurryWith(prometheus.Labels{
 		"class_name": className,
 		"shard_name": shardName,
@@ -118,6 +123,14 @@ func (m *Metrics) DeleteShardLabels(class, shard string) {
 	m.baseMetrics.DeleteShard(class, shard)
 }
 
+func (m *Metrics) ObjectsTTLExpired(count int) {
+	if !m.monitoring {
+		return
+	}
+
+	m.objectsTTLExpired.Add(float64(count))
+}
+
 func (m *Metrics) BatchObject(start time.Time, size int) {
 	took := time.Since(start)
 	m.logger.WithField("action", "batch_objects").
@@ -20,9 +20,12 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	command "github.com/weaviate/weaviate/cluster/proto/api"
 	"github.com/weaviate/weaviate/cluster/types"
 	"github.com/weaviate/weaviate/entities/errorcompounder"
@@ -105,30 +108,38 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class,
 
 	idx, err := NewIndex(ctx,
 		IndexConfig{
-			ClassName:                      schema.ClassName(class.Class),
-			RootPath:                       m.db.config.RootPath,
-			ResourceUsage:                  m.db.config.ResourceUsage,
-			QueryMaximumResults:            m.db.config.QueryMaximumResults,
-			QueryNestedRefLimit:            m.db.config.QueryNestedRefLimit,
-			MemtablesFlushDirtyAfter:       m.db.config.MemtablesFlushDirtyAfter,
-			MemtablesInitialSizeMB:         m.db.config.MemtablesInitialSizeMB,
-			MemtablesMaxSizeMB:             m.db.config.MemtablesMaxSizeMB,
-			MemtablesMinActiveSeconds:      m.db.config.MemtablesMinActiveSeconds,
-			MemtablesMaxActiveSeconds:      m.db.config.MemtablesMaxActiveSeconds,
-			SegmentsCleanupIntervalSeconds: m.db.config.SegmentsCleanupIntervalSeconds,
-			SeparateObjectsCompactions:     m.db.config.SeparateObjectsCompactions,
-			MaxSegmentSize:                 m.db.config.MaxSegmentSize,
-			HNSWMaxLogSize:                 m.db.config.HNSWMaxLogSize,
-			HNSWWaitForCachePrefill:        m.db.config.HNSWWaitForCachePrefill,
-			HNSWFlatSearchConcurrency:      m.db.config.HNSWFlatSearchConcurrency,
-			VisitedListPoolMaxSize:         m.db.config.VisitedListPoolMaxSize,
-			TrackVectorDimensions:          m.db.config.TrackVectorDimensions,
-			AvoidMMap:                      m.db.config.AvoidMMap,
-			DisableLazyLoadShards:          m.db.config.DisableLazyLoadShards,
-			ForceFullReplicasSearch:        m.db.config.ForceFullReplicasSearch,
-			ReplicationFactor:              NewAtomicInt64(class.ReplicationConfig.Factor),
-			AsyncReplicationEnabled:        class.ReplicationConfig.AsyncEnabled,
-			DeletionStrategy:               class.ReplicationConfig.DeletionStrategy,
+			ClassName:                       schema.ClassName(class.Class),
+			RootPath:                        m.db.config.RootPath,
+			ResourceUsage:                   m.db.config.ResourceUsage,
+			QueryMaximumResults:             m.db.config.QueryMaximumResults,
+			QueryMaximumRadiusResults:       m.db.config.QueryMaximumRadiusResults,
+			QueryNestedRefLimit:             m.db.config.QueryNestedRefLimit,
+			MemtablesFlushDirtyAfter:        m.db.config.MemtablesFlushDirtyAfter,
+			MemtablesInitialSizeMB:          m.db.config.MemtablesInitialSizeMB,
+			MemtablesMaxSizeMB:              m.db.config.MemtablesMaxSizeMB,
+			MemtablesMinActiveSeconds:       m.db.config.MemtablesMinActiveSeconds,
+			MemtablesMaxActiveSeconds:       m.db.config.MemtablesMaxActiveSeconds,
+			SegmentsCleanupIntervalSeconds:  m.db.config.SegmentsCleanupIntervalSeconds,
+			SeparateObjectsCompactions:      m.db.config.SeparateObjectsCompactions,
+			VerifyChecksumsOnLoad:           m.db.config.VerifyChecksumsOnLoad,
+			VerifyChecksumsOnRead:           m.db.config.VerifyChecksumsOnRead,
+			WriteSegmentChecksums:           m.db.config.WriteSegmentChecksums,
+			QuarantineCorruptSegments:       m.db.config.QuarantineCorruptSegments,
+			ObjectsTTLDeleteIntervalSeconds: m.db.config.ObjectsTTLDeleteIntervalSeconds,
+			Tiering:                         m.db.tiering,
+			CompactionScheduler:             m.db.compactionScheduler,
+			MaxSegmentSize:                  m.db.config.MaxSegmentSize,
+			HNSWMaxLogSize:                  m.db.config.HNSWMaxLogSize,
+			HNSWWaitForCachePrefill:         m.db.config.HNSWWaitForCachePrefill,
+			HNSWFlatSearchConcurrency:       m.db.config.HNSWFlatSearchConcurrency,
+			VisitedListPoolMaxSize:          m.db.config.VisitedListPoolMaxSize,
+			TrackVectorDimensions:           m.db.config.TrackVectorDimensions,
+			AvoidMMap:                       m.db.config.AvoidMMap,
+			DisableLazyLoadShards:           m.db.config.DisableLazyLoadShards,
+			ForceFullReplicasSearch:         m.db.config.ForceFullReplicasSearch,
+			ReplicationFactor:               NewAtomicInt64(class.ReplicationConfig.Factor),
+			AsyncReplicationEnabled:         class.ReplicationConfig.AsyncEnabled,
+			DeletionStrategy:                class.ReplicationConfig.DeletionStrategy,
 		},
 		shardState,
 		// no backward-compatibility check required, since newly added classes will
@@ -323,9 +334,17 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop ...*m
 	return idx.addProperty(ctx, prop...)
 }
 
-// DropProperty is ignored, API compliant change
+// DropProperty keeps the data of the property, API compliant change. Only
+// what is kept in memory for the property is released.
 func (m *Migrator) DropProperty(ctx context.Context, className string, propertyName string) error {
-	// ignore but don't error
+	indexID := indexID(schema.ClassName(className))
+
+	m.classLocks.Lock(indexID)
+	defer m.classLocks.Unlock(indexID)
+
+	if idx := m.db.GetIndex(schema.ClassName(className)); idx != nil {
+		idx.dropProperty(propertyName)
+	}
 	return nil
 }
 
@@ -601,9 +620,12 @@ func (m *Migrator) UpdateVectorIndexConfigs(ctx context.Context,
 func (m *Migrator) ValidateVectorIndexConfigUpdate(
 	old, updated schemaConfig.VectorIndexConfig,
 ) error {
-	// hnsw is the only supported vector index type at the moment, so no need
-	// to check, we can always use that an hnsw-specific validation should be
-	// used for now.
+	// changes that require the index to be rebuilt are applied by an online
+	// migration, which is not bound by the immutable fields of the index type
+	if vectorIndexNeedsRebuild(old, updated) {
+		return validateVectorIndexMigration(old, updated)
+	}
+
 	switch old.IndexType() {
 	case vectorindex.VectorIndexTypeHNSW:
 		return hnsw.ValidateUserConfigUpdate(old, updated)
@@ -611,6 +633,12 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(
 		return flat.ValidateUserConfigUpdate(old, updated)
 	case vectorindex.VectorIndexTypeDYNAMIC:
 		return dynamic.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeDISKANN:
+		return diskann.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeIVF:
+		return ivf.ValidateUserConfigUpdate(old, updated)
+	case vectorindex.VectorIndexTypeSPARSE:
+		return sparse.ValidateUserConfigUpdate(old, updated)
 	}
 	return fmt.Errorf("Invalid index type: %s", old.IndexType())
 }
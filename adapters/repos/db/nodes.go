@@ -214,6 +214,7 @@ func (i *Index) getShardsNodeStatus(ctx context.Context,
 			Compressed:           compressed,
 			Loaded:               true,
 		}
+		shardStatus.VectorIndexMigrations = shard.VectorIndexMigrationStatus()
 		*status = append(*status, shardStatus)
 		shardCount++
 		return nil
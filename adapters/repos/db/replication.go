@@ -243,6 +243,17 @@ func (i *Index) ReplicateReferences(ctx context.Context, shard, requestID string
 	return localShard.prepareAddReferences(ctx, requestID, refs)
 }
 
+func (i *Index) ReplicateKeyValue(ctx context.Context, shard, requestID string, write *objects.KeyValueWrite) replica.SimpleResponse {
+	localShard, release, pr := i.writableShard(shard)
+	if pr != nil {
+		return *pr
+	}
+
+	defer release()
+
+	return localShard.prepareWriteKeyValue(ctx, requestID, write)
+}
+
 func (i *Index) CommitReplication(shard, requestID string) interface{} {
 	localShard, release, err := i.getOrInitShard(context.Background(), shard)
 	if err != nil {
@@ -576,6 +587,25 @@ func (i *Index) IncomingHashTreeLevel(ctx context.Context,
 	return i.HashTreeLevel(ctx, shardName, level, discriminant)
 }
 
+// FetchKeyValue returns the local entry of the key-value store, a zero
+// entry if the key has never been written on this replica
+func (i *Index) FetchKeyValue(ctx context.Context,
+	shardName, key string,
+) (objects.KeyValue, error) {
+	shard, release, err := i.getOrInitShard(ctx, shardName)
+	if err != nil {
+		return objects.KeyValue{}, fmt.Errorf("shard %q does not exist locally", shardName)
+	}
+
+	defer release()
+
+	kv, err := shard.GetKeyValue(ctx, key)
+	if err != nil || kv == nil {
+		return objects.KeyValue{}, err
+	}
+	return *kv, nil
+}
+
 func (i *Index) FetchObject(ctx context.Context,
 	shardName string, id strfmt.UUID,
 ) (objects.Replica, error) {
@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"path/filepath"
 	"runtime"
 	"sync"
 	"sync/atomic"
@@ -25,8 +26,10 @@ import (
 
 	"github.com/cenkalti/backoff/v4"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/queue"
 	"github.com/weaviate/weaviate/cluster/utils"
 	"github.com/weaviate/weaviate/entities/replication"
@@ -39,6 +42,10 @@ import (
 	"github.com/weaviate/weaviate/usecases/sharding"
 )
 
+// tieringCacheDir is the directory below the root path that holds cold
+// segments fetched from tiered storage
+const tieringCacheDir = "lsm-tiering-cache"
+
 type DB struct {
 	logger            logrus.FieldLogger
 	schemaGetter      schemaUC.SchemaGetter
@@ -88,6 +95,12 @@ type DB struct {
 	// in the case of metrics grouping we need to observe some metrics
 	// node-centric, rather than shard-centric
 	metricsObserver *nodeWideMetricsObserver
+
+	// optional tiered storage for old lsmkv segments, see SetSegmentStore
+	tiering *lsmkv.TieringConfig
+
+	// coordinates the lsmkv compactions of all shards
+	compactionScheduler *lsmkv.CompactionScheduler
 }
 
 func (db *DB) GetSchemaGetter() schemaUC.SchemaGetter {
@@ -110,6 +123,33 @@ func (db *DB) SetSchemaGetter(sg schemaUC.SchemaGetter) {
 	db.schemaGetter = sg
 }
 
+// SetSegmentStore enables tiered storage of old lsmkv segments in store. It
+// must be called before the indexes are loaded.
+func (db *DB) SetSegmentStore(store lsmkv.SegmentStore) error {
+	var sizeGauge prometheus.Gauge
+	if db.promMetrics != nil {
+		sizeGauge = db.promMetrics.LSMTieringCacheSize
+	}
+
+	cache, err := lsmkv.NewSegmentCache(filepath.Join(db.config.RootPath, tieringCacheDir),
+		db.config.TieringCacheMaxSize, sizeGauge)
+	if err != nil {
+		return fmt.Errorf("init tiering cache: %w", err)
+	}
+
+	db.tiering = &lsmkv.TieringConfig{
+		Store:          store,
+		Cache:          cache,
+		MinAge:         time.Duration(db.config.TieringMinAgeSeconds) * time.Second,
+		MinSegmentSize: db.config.TieringMinSegmentSize,
+	}
+	return nil
+}
+
+func (db *DB) CompactionScheduler() *lsmkv.CompactionScheduler {
+	return db.compactionScheduler
+}
+
 func (db *DB) GetScheduler() *queue.Scheduler {
 	return db.scheduler
 }
@@ -149,6 +189,8 @@ func New(logger logrus.FieldLogger, config Config,
 		maxNumberGoroutines: int(math.Round(config.MaxImportGoroutinesFactor * float64(runtime.GOMAXPROCS(0)))),
 		resourceScanState:   newResourceScanState(),
 		memMonitor:          memMonitor,
+		compactionScheduler: lsmkv.NewCompactionScheduler(config.CompactionScheduler,
+			logger, promMetrics),
 	}
 
 	if db.maxNumberGoroutines == 0 {
@@ -182,31 +224,41 @@ func New(logger logrus.FieldLogger, config Config,
 }
 
 type Config struct {
-	RootPath                       string
-	QueryLimit                     int64
-	QueryMaximumResults            int64
-	QueryNestedRefLimit            int64
-	ResourceUsage                  config.ResourceUsage
-	MaxImportGoroutinesFactor      float64
-	MemtablesFlushDirtyAfter       int
-	MemtablesInitialSizeMB         int
-	MemtablesMaxSizeMB             int
-	MemtablesMinActiveSeconds      int
-	MemtablesMaxActiveSeconds      int
-	SegmentsCleanupIntervalSeconds int
-	SeparateObjectsCompactions     bool
-	MaxSegmentSize                 int64
-	HNSWMaxLogSize                 int64
-	HNSWWaitForCachePrefill        bool
-	HNSWFlatSearchConcurrency      int
-	VisitedListPoolMaxSize         int
-	TrackVectorDimensions          bool
-	ServerVersion                  string
-	GitHash                        string
-	AvoidMMap                      bool
-	DisableLazyLoadShards          bool
-	ForceFullReplicasSearch        bool
-	Replication                    replication.GlobalConfig
+	RootPath                        string
+	QueryLimit                      int64
+	QueryMaximumResults             int64
+	QueryMaximumRadiusResults       int64
+	QueryNestedRefLimit             int64
+	ResourceUsage                   config.ResourceUsage
+	MaxImportGoroutinesFactor       float64
+	MemtablesFlushDirtyAfter        int
+	MemtablesInitialSizeMB          int
+	MemtablesMaxSizeMB              int
+	MemtablesMinActiveSeconds       int
+	MemtablesMaxActiveSeconds       int
+	SegmentsCleanupIntervalSeconds  int
+	SeparateObjectsCompactions      bool
+	VerifyChecksumsOnLoad           bool
+	VerifyChecksumsOnRead           bool
+	WriteSegmentChecksums           bool
+	QuarantineCorruptSegments       bool
+	TieringMinAgeSeconds            int
+	TieringMinSegmentSize           int64
+	TieringCacheMaxSize             int64
+	CompactionScheduler             lsmkv.CompactionSchedulerConfig
+	ObjectsTTLDeleteIntervalSeconds int
+	MaxSegmentSize                  int64
+	HNSWMaxLogSize                  int64
+	HNSWWaitForCachePrefill         bool
+	HNSWFlatSearchConcurrency       int
+	VisitedListPoolMaxSize          int
+	TrackVectorDimensions           bool
+	ServerVersion                   string
+	GitHash                         string
+	AvoidMMap                       bool
+	DisableLazyLoadShards           bool
+	ForceFullReplicasSearch         bool
+	Replication                     replication.GlobalConfig
 }
 
 // GetIndex returns the index if it exists or nil if it doesn't
@@ -22,6 +22,7 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/refcache"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
@@ -78,16 +79,55 @@ func (db *DB) SparseObjectSearch(ctx context.Context, params dto.GetParams) ([]*
 		tenant = ""
 	}
 
+	addl := params.AdditionalProperties
+	properties := params.Properties.GetPropertyNames()
+	highlight := addl.Highlights != nil && params.KeywordRanking != nil
+	if highlight {
+		// the highlighted properties are needed, even if they are not returned
+		addl.NoProps = false
+		properties = nil
+	}
+
 	res, scores, err := idx.objectSearch(ctx, totalLimit,
 		params.Filters, params.KeywordRanking, params.Sort, params.Cursor,
-		params.AdditionalProperties, params.ReplicationProperties, tenant, params.Pagination.Autocut, params.Properties.GetPropertyNames())
+		addl, params.ReplicationProperties, tenant, params.Pagination.Autocut, properties)
 	if err != nil {
 		return nil, nil, errors.Wrapf(err, "object search at index %s", idx.ID())
 	}
 
+	if highlight {
+		if err := addHighlights(idx, res, params.KeywordRanking, *addl.Highlights); err != nil {
+			return nil, nil, err
+		}
+	}
+
 	return res, scores, nil
 }
 
+// addHighlights stores the highlights of a keyword search in the additional
+// properties of its results
+func addHighlights(idx *Index, objs []*storobj.Object,
+	keywordRanking *searchparams.KeywordRanking, opts additional.Highlights,
+) error {
+	class := idx.getSchema.ReadOnlyClass(idx.Config.ClassName.String())
+	if class == nil {
+		return fmt.Errorf("could not find class %s in schema", idx.Config.ClassName)
+	}
+	highlighter, err := inverted.NewHighlighter(class, idx.stopwords, idx.synonyms, keywordRanking, opts)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range objs {
+		props, _ := obj.Properties().(map[string]interface{})
+		if obj.Object.Additional == nil {
+			obj.Object.Additional = models.AdditionalProperties{}
+		}
+		obj.Object.Additional["highlights"] = highlighter.Highlight(props)
+	}
+	return nil
+}
+
 func (db *DB) Search(ctx context.Context, params dto.GetParams) ([]search.Result, error) {
 	if params.Pagination == nil {
 		return nil, fmt.Errorf("invalid params, pagination object is nil")
@@ -123,6 +163,8 @@ func (db *DB) VectorSearch(ctx context.Context,
 	}
 
 	targetDist := extractDistanceFromParams(params)
+	params.AdditionalProperties.RescoreMultiplier = traverser.ExtractRescoreMultiplierFromParams(params)
+	params.AdditionalProperties.DistanceCursor = traverser.ExtractDistanceCursorFromParams(params)
 	res, dists, err := idx.objectVectorSearch(ctx, searchVectors, targetVectors,
 		targetDist, totalLimit, params.Filters, params.Sort, params.GroupBy,
 		params.AdditionalProperties, params.ReplicationProperties, params.Tenant, params.TargetVectorCombination, params.Properties.GetPropertyNames())
@@ -229,6 +271,9 @@ func (db *DB) Query(ctx context.Context, q *objects.QueryInput) (search.Results,
 	if idx == nil {
 		return nil, &objects.Error{Msg: "class not found " + q.Class, Code: objects.StatusNotFound}
 	}
+	if q.Additional.Snapshot != "" && (q.Filters != nil || len(q.Sort) > 0) {
+		return nil, &objects.Error{Msg: "snapshot " + q.Additional.Snapshot, Code: objects.StatusBadRequest, Err: ErrSnapshotUnsupported}
+	}
 	if q.Cursor != nil {
 		if err := filters.ValidateCursor(schema.ClassName(q.Class), q.Cursor, q.Offset, q.Filters, q.Sort); err != nil {
 			return nil, &objects.Error{Msg: "cursor api: invalid 'after' parameter", Code: objects.StatusBadRequest, Err: err}
@@ -237,6 +282,9 @@ func (db *DB) Query(ctx context.Context, q *objects.QueryInput) (search.Results,
 	res, _, err := idx.objectSearch(ctx, totalLimit, q.Filters,
 		nil, q.Sort, q.Cursor, q.Additional, nil, q.Tenant, 0, nil)
 	if err != nil {
+		if errors.Is(err, ErrSnapshotNotFound) {
+			return nil, &objects.Error{Msg: "snapshot " + q.Additional.Snapshot, Code: objects.StatusBadRequest, Err: err}
+		}
 		switch err.(type) {
 		case objects.ErrMultiTenancy:
 			return nil, &objects.Error{Msg: "search index " + idx.ID(), Code: objects.StatusUnprocessableEntity, Err: err}
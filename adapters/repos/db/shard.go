@@ -34,10 +34,12 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcounter"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/fuzzy"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/weaviate/weaviate/adapters/repos/db/queue"
 	"github.com/weaviate/weaviate/adapters/repos/db/roaringset"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
@@ -97,6 +99,7 @@ type ShardLike interface {
 	drop() error
 	HaltForTransfer(ctx context.Context) error
 	initPropertyBuckets(ctx context.Context, eg *enterrors.ErrorGroupWrapper, props ...*models.Property)
+	dropTermDictionary(propName string)
 	ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor) error
 	resumeMaintenanceCycles(ctx context.Context) error
 	SetPropertyLengths(props []inverted.Property) error
@@ -104,6 +107,8 @@ type ShardLike interface {
 	Aggregate(ctx context.Context, params aggregation.Params, modules *modules.Provider) (*aggregation.Result, error)
 	HashTreeLevel(ctx context.Context, level int, discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
 	MergeObject(ctx context.Context, object objects.MergeDocument) error
+	GetKeyValue(ctx context.Context, key string) (*objects.KeyValue, error)
+	WriteKeyValue(ctx context.Context, write *objects.KeyValueWrite) (*objects.KeyValue, error)
 	Queue() *VectorIndexQueue
 	Queues() map[string]*VectorIndexQueue
 	VectorDistanceForQuery(ctx context.Context, id uint64, searchVectors []models.Vector, targets []string) ([]float32, error)
@@ -118,6 +123,7 @@ type ShardLike interface {
 	WasDeleted(ctx context.Context, id strfmt.UUID) (bool, time.Time, error) // Check if an object was deleted
 	VectorIndex() VectorIndex                                                // Get the vector index
 	VectorIndexes() map[string]VectorIndex                                   // Get the vector indexes
+	VectorIndexMigrationStatus() []*models.NodeShardVectorIndexMigration     // Get the progress of vector index migrations
 	hasTargetVectors() bool
 	// TODO tests only
 	Versioner() *shardVersioner // Get the shard versioner
@@ -130,6 +136,7 @@ type ShardLike interface {
 	prepareDeleteObject(context.Context, string, strfmt.UUID, time.Time) replica.SimpleResponse
 	prepareDeleteObjects(context.Context, string, []strfmt.UUID, time.Time, bool) replica.SimpleResponse
 	prepareAddReferences(context.Context, string, []objects.BatchReference) replica.SimpleResponse
+	prepareWriteKeyValue(context.Context, string, *objects.KeyValueWrite) replica.SimpleResponse
 
 	commitReplication(context.Context, string, *shardTransfer) interface{}
 	abortReplication(context.Context, string) replica.SimpleResponse
@@ -172,7 +179,11 @@ type ShardLike interface {
 	Activity() int32
 	// Debug methods
 	DebugResetVectorIndex(ctx context.Context, targetVector string) error
+	DebugGraphHealth(ctx context.Context, targetVector string, repair bool) (*hnsw.GraphHealth, error)
 	RepairIndex(ctx context.Context, targetVector string) error
+
+	OpenSnapshot(token string, ttl time.Duration) error // Pin the objects under the given token
+	ReleaseSnapshot(token string) error                 // Release a snapshot opened before
 }
 
 // Shard is the smallest completely-contained index unit. A shard manages
@@ -219,6 +230,12 @@ type Shard struct {
 	centralJobQueue chan job // reference to queue used by all shards
 
 	docIdLock []sync.Mutex
+	// serializes conditional writes to the key-value bucket, keyed by a hash
+	// of the key
+	keyValueLock []sync.Mutex
+	// keys of replicated conditional writes that are prepared, but not yet
+	// committed or aborted
+	keyValueReservations keyValueReservations
 	// replication
 	replicationMap pendingReplicaTasks
 
@@ -236,8 +253,33 @@ type Shard struct {
 	cycleCallbacks *shardCycleCallbacks
 	bitmapFactory  *roaringset.BitmapFactory
 
+	// term dictionaries of the text properties serving fuzzy queries,
+	// built on first use and kept up to date on writes
+	termDictionaries *fuzzy.Dictionaries
+
+	// point-in-time snapshots of the objects bucket, keyed by token
+	snapshots     map[string]*shardSnapshot
+	snapshotsLock sync.Mutex
+
 	activityTracker atomic.Int32
 
+	// guards vectorIndex and vectorIndexes, which are replaced when a vector
+	// index migration completes. vectorIndexes is copied on write.
+	vectorIndexLock sync.RWMutex
+	// the on-disk generation of each vector index, see
+	// shard_vector_generations.go
+	vectorIndexGenerations *vectorIndexGenerations
+	// online vector index migrations by target vector, see
+	// shard_vector_migration.go
+	vectorIndexMigrations     map[string]*vectorIndexMigration
+	vectorIndexMigrationsLock sync.Mutex
+	// set while the shard's files are transferred, migrations don't swap
+	// their index in the meantime
+	vectorIndexSwapsHalted atomic.Bool
+
+	// one of the bulkLoad* states, see shard_bulk_load.go
+	bulkLoad atomic.Int32
+
 	// indicates whether shard is shut down or dropped (or ongoing)
 	shut bool
 	// indicates whether shard in being used at the moment (e.g. write request)
@@ -627,14 +669,24 @@ func (s *Shard) UpdateVectorIndexConfig(ctx context.Context, updated schemaConfi
 		return err
 	}
 
+	// changes that require a rebuild are applied by an online migration,
+	// the shard keeps serving from the current index in the meantime
+	if migrate, err := s.updateVectorIndexMigration("", updated); err != nil || migrate {
+		return err
+	}
+
 	err := s.SetStatusReadonly("UpdateVectorIndexConfig")
 	if err != nil {
 		return fmt.Errorf("attempt to mark read-only: %w", err)
 	}
 
-	return s.VectorIndex().UpdateUserConfig(updated, func() {
+	err = s.VectorIndex().UpdateUserConfig(updated, func() {
 		s.UpdateStatus(storagestate.StatusReady.String())
 	})
+	if err != nil {
+		return err
+	}
+	return s.vectorIndexGenerations.updateActiveConfig("", updated)
 }
 
 func (s *Shard) UpdateVectorIndexConfigs(ctx context.Context, updated map[string]schemaConfig.VectorIndexConfig) error {
@@ -648,10 +700,23 @@ func (s *Shard) UpdateVectorIndexConfigs(ctx context.Context, updated map[string
 	wg := new(sync.WaitGroup)
 	var err error
 	for targetName, targetCfg := range updated {
+		// changes that require a rebuild are applied by an online migration,
+		// the shard keeps serving from the current index in the meantime
+		var migrate bool
+		if migrate, err = s.updateVectorIndexMigration(targetName, targetCfg); err != nil {
+			break
+		}
+		if migrate {
+			continue
+		}
+
 		wg.Add(1)
 		if err = s.VectorIndexForName(targetName).UpdateUserConfig(targetCfg, wg.Done); err != nil {
 			break
 		}
+		if err = s.vectorIndexGenerations.updateActiveConfig(targetName, targetCfg); err != nil {
+			break
+		}
 	}
 
 	f := func() {
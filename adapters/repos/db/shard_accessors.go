@@ -33,14 +33,20 @@ func (s *Shard) QueueForName(targetVector string) *VectorIndexQueue {
 }
 
 func (s *Shard) VectorIndex() VectorIndex {
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
 	return s.vectorIndex
 }
 
 func (s *Shard) VectorIndexes() map[string]VectorIndex {
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
 	return s.vectorIndexes
 }
 
 func (s *Shard) VectorIndexForName(targetVector string) VectorIndex {
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
 	return s.vectorIndexes[targetVector]
 }
 
@@ -85,6 +91,11 @@ func (s *Shard) setFallbackToSearchable(fallback bool) {
 	s.fallbackToSearchable = fallback
 }
 
+// dropTermDictionary releases the fuzzy term dictionary of a property
+func (s *Shard) dropTermDictionary(propName string) {
+	s.termDictionaries.Drop(propName)
+}
+
 func (s *Shard) addJobToQueue(job job) {
 	s.centralJobQueue <- job
 }
@@ -20,6 +20,10 @@ import (
 )
 
 func (s *Shard) Aggregate(ctx context.Context, params aggregation.Params, modules *modules.Provider) (*aggregation.Result, error) {
+	if err := s.errIfBulkLoading(); err != nil {
+		return nil, err
+	}
+
 	var vectorIndex VectorIndex
 
 	// we only need the index queue for vector search
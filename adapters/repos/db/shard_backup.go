@@ -41,12 +41,24 @@ func (s *Shard) HaltForTransfer(ctx context.Context) (err error) {
 	}
 	s.hashtreeRWMux.Unlock()
 
+	// vector index migrations must not replace files while they are
+	// transferred
+	s.vectorIndexSwapsHalted.Store(true)
+
 	if err = s.store.PauseCompaction(ctx); err != nil {
 		return fmt.Errorf("pause compaction: %w", err)
 	}
 	if err = s.store.FlushMemtables(ctx); err != nil {
 		return fmt.Errorf("flush memtables: %w", err)
 	}
+	for _, store := range s.activeVectorIndexStores() {
+		if err = store.PauseCompaction(ctx); err != nil {
+			return fmt.Errorf("pause compaction of vector index store: %w", err)
+		}
+		if err = store.FlushMemtables(ctx); err != nil {
+			return fmt.Errorf("flush memtables of vector index store: %w", err)
+		}
+	}
 	if err = s.cycleCallbacks.vectorCombinedCallbacksCtrl.Deactivate(ctx); err != nil {
 		return fmt.Errorf("pause vector maintenance: %w", err)
 	}
@@ -54,13 +66,13 @@ func (s *Shard) HaltForTransfer(ctx context.Context) (err error) {
 		return fmt.Errorf("pause geo props maintenance: %w", err)
 	}
 	if s.hasTargetVectors() {
-		for targetVector, vectorIndex := range s.vectorIndexes {
+		for targetVector, vectorIndex := range s.VectorIndexes() {
 			if err = vectorIndex.SwitchCommitLogs(ctx); err != nil {
 				return fmt.Errorf("switch commit logs of vector %q: %w", targetVector, err)
 			}
 		}
 	} else {
-		if err = s.vectorIndex.SwitchCommitLogs(ctx); err != nil {
+		if err = s.VectorIndex().SwitchCommitLogs(ctx); err != nil {
 			return fmt.Errorf("switch commit logs: %w", err)
 		}
 	}
@@ -77,9 +89,23 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 	if ret.Files, err = s.store.ListFiles(ctx, s.index.Config.RootPath); err != nil {
 		return err
 	}
+	for _, store := range s.activeVectorIndexStores() {
+		files, err := store.ListFiles(ctx, s.index.Config.RootPath)
+		if err != nil {
+			return fmt.Errorf("list files of vector index store: %w", err)
+		}
+		ret.Files = append(ret.Files, files...)
+	}
+	if _, err := os.Stat(s.pathVectorIndexGenerations()); err == nil {
+		relPath, err := filepath.Rel(s.index.Config.RootPath, s.pathVectorIndexGenerations())
+		if err != nil {
+			return fmt.Errorf("vector index generations path: %w", err)
+		}
+		ret.Files = append(ret.Files, relPath)
+	}
 
 	if s.hasTargetVectors() {
-		for targetVector, vectorIndex := range s.vectorIndexes {
+		for targetVector, vectorIndex := range s.VectorIndexes() {
 			files, err := vectorIndex.ListFiles(ctx, s.index.Config.RootPath)
 			if err != nil {
 				return fmt.Errorf("list files of vector %q: %w", targetVector, err)
@@ -87,7 +113,7 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 			ret.Files = append(ret.Files, files...)
 		}
 	} else {
-		files, err := s.vectorIndex.ListFiles(ctx, s.index.Config.RootPath)
+		files, err := s.VectorIndex().ListFiles(ctx, s.index.Config.RootPath)
 		if err != nil {
 			return err
 		}
@@ -100,9 +126,17 @@ func (s *Shard) ListBackupFiles(ctx context.Context, ret *backup.ShardDescriptor
 func (s *Shard) resumeMaintenanceCycles(ctx context.Context) error {
 	g := enterrors.NewErrorGroupWrapper(s.index.logger)
 
+	s.vectorIndexSwapsHalted.Store(false)
+
 	g.Go(func() error {
 		return s.store.ResumeCompaction(ctx)
 	})
+	for _, store := range s.activeVectorIndexStores() {
+		store := store
+		g.Go(func() error {
+			return store.ResumeCompaction(ctx)
+		})
+	}
 	g.Go(func() error {
 		return s.cycleCallbacks.vectorCombinedCallbacksCtrl.Activate()
 	})
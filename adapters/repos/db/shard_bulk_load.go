@@ -0,0 +1,341 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// A shard enters a bulk load when its status is set to LOADING. While
+// loading, the lsmkv store skips the WAL and writes memtables as sorted runs
+// without compacting them, and vectors are not added to the vector index.
+// Queries are rejected. Setting the status to READY afterwards flushes the
+// store, merges the runs of each bucket up to the max segment size and
+// builds the vector index from the loaded objects in a single pass, the shard
+// only becomes READY once that has completed.
+const (
+	bulkLoadNone int32 = iota
+	bulkLoadActive
+	bulkLoadFinishing
+)
+
+// bulkLoadMarkerFile exists in the shard dir for the duration of a bulk load.
+// Data that was not yet flushed is lost on a crash, so a shard that finds the
+// marker on startup stays in LOADING to indicate the load has to be redone.
+const bulkLoadMarkerFile = "bulk_load"
+
+// bulkLoadIndexBatchSize is the number of objects read per page and the
+// number of vectors inserted at once when indexing after a bulk load
+const bulkLoadIndexBatchSize = 1000
+
+const (
+	bulkLoadReason            = "bulk load in progress"
+	bulkLoadFinishingReason   = "finishing bulk load"
+	bulkLoadInterruptedReason = "bulk load was interrupted, objects written since the last flush may be missing"
+)
+
+func (s *Shard) pathBulkLoadMarker() string {
+	return filepath.Join(s.path(), bulkLoadMarkerFile)
+}
+
+func (s *Shard) isBulkLoading() bool {
+	return s.bulkLoad.Load() != bulkLoadNone
+}
+
+// errIfBulkLoading rejects queries until the bulk load has fully finished,
+// as neither the vector index nor the segments are complete before that
+func (s *Shard) errIfBulkLoading() error {
+	if s.isBulkLoading() {
+		return enterrors.NewErrUnprocessable(fmt.Errorf("shard %s is not ready: %s", s.ID(), bulkLoadReason))
+	}
+	return nil
+}
+
+// skipVectorIndexing indicates that vectors are indexed once the bulk load
+// has finished instead of on write
+func (s *Shard) skipVectorIndexing() bool {
+	return s.bulkLoad.Load() == bulkLoadActive
+}
+
+// resumeInterruptedBulkLoad puts the shard back into bulk load mode if it was
+// shut down during a load
+func (s *Shard) resumeInterruptedBulkLoad() error {
+	if _, err := os.Stat(s.pathBulkLoadMarker()); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return errors.Wrap(err, "check bulk load marker")
+	}
+
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	if err := s.updateStatusUnlocked(storagestate.StatusLoading.String(), bulkLoadInterruptedReason); err != nil {
+		return err
+	}
+
+	s.index.logger.
+		WithField("action", "bulk_load").
+		WithField("shard", s.ID()).
+		Warn(bulkLoadInterruptedReason)
+	return nil
+}
+
+// updateBulkLoadStatusUnlocked handles status transitions that start or
+// finish a bulk load. It returns false if the transition is a regular one.
+func (s *Shard) updateBulkLoadStatusUnlocked(target storagestate.Status, reason string) (bool, error) {
+	state := s.bulkLoad.Load()
+
+	switch target {
+	case storagestate.StatusLoading:
+		if state == bulkLoadFinishing {
+			return true, fmt.Errorf("shard %s is already finishing its bulk load", s.ID())
+		}
+		if state == bulkLoadNone {
+			if err := s.startBulkLoad(); err != nil {
+				return true, errors.Wrap(err, "start bulk load")
+			}
+		}
+		if reason == "" {
+			reason = bulkLoadReason
+		}
+		s.status.Status = storagestate.StatusLoading
+		s.status.Reason = reason
+		return true, s.updateStoreStatus(storagestate.StatusLoading)
+
+	case storagestate.StatusReady, storagestate.StatusIndexing:
+		if state == bulkLoadActive {
+			s.bulkLoad.Store(bulkLoadFinishing)
+			f := func() { s.finishBulkLoad(context.Background()) }
+			enterrors.GoWrapper(f, s.index.logger)
+		}
+		if state != bulkLoadNone {
+			// the shard only becomes ready once the load has finished
+			s.status.Status = storagestate.StatusLoading
+			s.status.Reason = bulkLoadFinishingReason
+			return true, s.updateStoreStatus(storagestate.StatusLoading)
+		}
+	}
+
+	return false, nil
+}
+
+func (s *Shard) startBulkLoad() error {
+	if s.hasRunningVectorIndexMigrations() {
+		return fmt.Errorf("vector index migration in progress")
+	}
+
+	f, err := os.Create(s.pathBulkLoadMarker())
+	if err != nil {
+		return errors.Wrap(err, "create marker")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "create marker")
+	}
+
+	if err := s.store.StartBulkLoad(); err != nil {
+		return err
+	}
+	s.bulkLoad.Store(bulkLoadActive)
+	return nil
+}
+
+func (s *Shard) finishBulkLoad(ctx context.Context) {
+	start := time.Now()
+	err := s.flushAndIndexBulkLoad(ctx)
+
+	s.statusLock.Lock()
+	defer s.statusLock.Unlock()
+
+	logger := s.index.logger.
+		WithField("action", "bulk_load").
+		WithField("shard", s.ID())
+
+	if err != nil {
+		// keep the marker, so that setting the shard to READY again retries
+		s.bulkLoad.Store(bulkLoadActive)
+		logger.WithError(err).Error("finish bulk load")
+		if err := s.updateStatusUnlocked(storagestate.StatusReadOnly.String(),
+			fmt.Sprintf("finish bulk load: %v", err)); err != nil {
+			logger.WithError(err).Error("set shard read-only after failed bulk load")
+		}
+		return
+	}
+
+	s.bulkLoad.Store(bulkLoadNone)
+	// the status might have been changed to READONLY in the meantime
+	if s.status.Status == storagestate.StatusLoading {
+		if err := s.updateStatusUnlocked(storagestate.StatusReady.String(), ""); err != nil {
+			logger.WithError(err).Error("set shard ready after bulk load")
+		}
+	}
+	logger.WithField("took", time.Since(start)).Info("finished bulk load")
+
+	if err := s.resumeVectorIndexMigrations(); err != nil {
+		logger.WithError(err).Error("resume vector index migrations after bulk load")
+	}
+}
+
+func (s *Shard) flushAndIndexBulkLoad(ctx context.Context) error {
+	if err := s.store.FinishBulkLoad(ctx); err != nil {
+		return errors.Wrap(err, "flush store")
+	}
+	if err := s.indexBulkLoadedVectors(ctx); err != nil {
+		return errors.Wrap(err, "build vector index")
+	}
+	if err := os.Remove(s.pathBulkLoadMarker()); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "remove marker")
+	}
+	return nil
+}
+
+// indexBulkLoadedVectors adds the vectors of all objects that are not yet
+// contained in their vector index. All target vectors are covered in a single
+// pass over the objects bucket.
+func (s *Shard) indexBulkLoadedVectors(ctx context.Context) error {
+	type target struct {
+		name    string
+		index   VectorIndex
+		queue   *VectorIndexQueue
+		pending []common.VectorRecord
+	}
+
+	var targets []*target
+	if s.hasTargetVectors() {
+		for name, queue := range s.queues {
+			targets = append(targets, &target{name: name, index: s.VectorIndexForName(name), queue: queue})
+		}
+	} else if s.queue != nil {
+		targets = append(targets, &target{index: s.VectorIndex(), queue: s.queue})
+	}
+	if len(targets) == 0 {
+		return nil
+	}
+
+	flush := func(t *target) error {
+		if len(t.pending) == 0 {
+			return nil
+		}
+		if err := t.queue.Insert(ctx, t.pending...); err != nil {
+			return errors.Wrapf(err, "insert vectors of target vector %q", t.name)
+		}
+		t.pending = t.pending[:0]
+		return nil
+	}
+
+	added := 0
+	add := func(obj *storobj.Object) error {
+		for _, t := range targets {
+			if t.index.ContainsNode(obj.DocID) {
+				continue
+			}
+
+			var rec common.VectorRecord
+			if t.index.Multivector() {
+				if vec := obj.MultiVectors[t.name]; len(vec) > 0 {
+					rec = &common.Vector[[][]float32]{ID: obj.DocID, Vector: vec}
+				}
+			} else {
+				vec := obj.Vector
+				if t.name != "" {
+					vec = obj.Vectors[t.name]
+				}
+				if len(vec) > 0 {
+					rec = &common.Vector[[]float32]{ID: obj.DocID, Vector: vec}
+				}
+			}
+			if rec == nil {
+				continue
+			}
+
+			added++
+			t.pending = append(t.pending, rec)
+			if len(t.pending) >= bulkLoadIndexBatchSize {
+				if err := flush(t); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+
+	// the cursor is reopened for every page, so that flushes of the objects
+	// bucket are not blocked for the whole duration of the pass
+	bucket := s.store.Bucket(helpers.ObjectsBucketLSM)
+	var lastKey []byte
+	for done := false; !done; {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		c := bucket.Cursor()
+		k, v := c.First()
+		if lastKey != nil {
+			k, v = c.Seek(lastKey)
+			if bytes.Equal(k, lastKey) {
+				k, v = c.Next()
+			}
+		}
+
+		for i := 0; ; i++ {
+			if k == nil {
+				done = true
+				break
+			}
+			if i == bulkLoadIndexBatchSize {
+				break
+			}
+
+			obj, err := storobj.FromBinary(v)
+			if err != nil {
+				c.Close()
+				return errors.Wrapf(err, "unmarshal object %x", k)
+			}
+			if err := add(obj); err != nil {
+				c.Close()
+				return err
+			}
+
+			lastKey = append(lastKey[:0], k...)
+			k, v = c.Next()
+		}
+		c.Close()
+	}
+
+	for _, t := range targets {
+		if err := flush(t); err != nil {
+			return err
+		}
+		if err := t.queue.Flush(); err != nil {
+			return errors.Wrapf(err, "flush target vector %q", t.name)
+		}
+	}
+
+	s.index.logger.
+		WithField("action", "bulk_load").
+		WithField("shard", s.ID()).
+		WithField("count", added).
+		Info("enqueued bulk loaded vectors")
+	return nil
+}
@@ -0,0 +1,122 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_BulkLoad(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, idx := testShardWithSettings(t, ctx, &models.Class{Class: className},
+		hnsw.NewDefaultUserConfig(), false, false)
+	lazyShard := shd.(*LazyLoadShard)
+	require.Nil(t, lazyShard.Load(ctx))
+	shard := lazyShard.shard
+
+	put := func(t *testing.T, amount int) {
+		for i := 0; i < amount; i++ {
+			obj := testObject(className)
+			obj.Vector = randVector(8)
+			require.Nil(t, shd.PutObject(ctx, obj))
+		}
+	}
+
+	put(t, 5)
+	require.True(t, shd.VectorIndex().ContainsNode(0))
+
+	t.Run("start bulk load", func(t *testing.T) {
+		require.Nil(t, shd.UpdateStatus(storagestate.StatusLoading.String()))
+		assert.Equal(t, storagestate.StatusLoading, shd.GetStatus())
+		assert.FileExists(t, shard.pathBulkLoadMarker())
+		assert.True(t, shd.Store().IsBulkLoading())
+	})
+
+	t.Run("objects are stored without being indexed", func(t *testing.T) {
+		put(t, 100)
+		require.Nil(t, shd.Store().Bucket(helpers.ObjectsBucketLSM).FlushAndSwitch())
+		put(t, 100)
+
+		for docID := uint64(5); docID < 205; docID++ {
+			require.False(t, shd.VectorIndex().ContainsNode(docID))
+		}
+	})
+
+	t.Run("queries are rejected", func(t *testing.T) {
+		_, _, err := shd.ObjectSearch(ctx, 10, nil, nil, nil, nil, additional.Properties{}, nil)
+		assert.ErrorContains(t, err, "bulk load in progress")
+
+		_, err = shd.ObjectList(ctx, 10, nil, nil, additional.Properties{}, schema.ClassName(className))
+		assert.ErrorContains(t, err, "bulk load in progress")
+	})
+
+	t.Run("readonly does not end the bulk load", func(t *testing.T) {
+		require.Nil(t, shd.UpdateStatus(storagestate.StatusReadOnly.String()))
+		assert.Equal(t, storagestate.StatusReadOnly, shd.GetStatus())
+		assert.True(t, shard.isBulkLoading())
+	})
+
+	t.Run("finish bulk load", func(t *testing.T) {
+		require.Nil(t, shd.UpdateStatus(storagestate.StatusReady.String()))
+
+		assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+			assert.Equal(ct, storagestate.StatusReady, shd.GetStatus())
+		}, 10*time.Second, 10*time.Millisecond)
+
+		assert.False(t, shd.Store().IsBulkLoading())
+		assert.NoFileExists(t, shard.pathBulkLoadMarker())
+		for docID := uint64(0); docID < 205; docID++ {
+			require.True(t, shd.VectorIndex().ContainsNode(docID), "doc id %d", docID)
+		}
+
+		objs, err := shd.ObjectList(ctx, 1000, nil, nil, additional.Properties{}, schema.ClassName(className))
+		require.Nil(t, err)
+		assert.Len(t, objs, 205)
+
+		// the runs written during the load are merged into one segment
+		files, err := lsmkv.ListBucketFiles(shd.Store().Bucket(helpers.ObjectsBucketLSM).GetDir())
+		require.Nil(t, err)
+		assert.Len(t, files.Segments, 1)
+	})
+
+	t.Run("interrupted bulk load is resumed", func(t *testing.T) {
+		f, err := os.Create(shard.pathBulkLoadMarker())
+		require.Nil(t, err)
+		require.Nil(t, f.Close())
+
+		require.Nil(t, shard.resumeInterruptedBulkLoad())
+		assert.Equal(t, storagestate.StatusLoading, shd.GetStatus())
+		assert.True(t, shd.Store().IsBulkLoading())
+
+		require.Nil(t, shd.UpdateStatus(storagestate.StatusReady.String()))
+		assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+			assert.Equal(ct, storagestate.StatusReady, shd.GetStatus())
+		}, 10*time.Second, 10*time.Millisecond)
+	})
+
+	require.Nil(t, idx.drop())
+}
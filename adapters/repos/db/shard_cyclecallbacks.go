@@ -33,6 +33,8 @@ type shardCycleCallbacks struct {
 	geoPropsCommitLoggerCallbacks     cyclemanager.CycleCallbackGroup
 	geoPropsTombstoneCleanupCallbacks cyclemanager.CycleCallbackGroup
 	geoPropsCombinedCallbacksCtrl     cyclemanager.CycleCallbackCtrl
+
+	objectTTLCallbacksCtrl cyclemanager.CycleCallbackCtrl
 }
 
 func (s *Shard) initCycleCallbacks() {
@@ -103,6 +105,10 @@ func (s *Shard) initCycleCallbacks() {
 	geoPropsCombinedCallbacksCtrl := cyclemanager.NewCombinedCallbackCtrl(2, s.index.logger,
 		geoPropsCommitLoggerCallbacksCtrl, geoPropsTombstoneCleanupCallbacksCtrl)
 
+	// fixed interval on class level, no need to specify separate on shard level
+	objectTTLCallbacksCtrl := s.index.cycleCallbacks.objectTTLCallbacks.Register(
+		id("object_ttl"), s.deleteExpiredObjects)
+
 	s.cycleCallbacks = &shardCycleCallbacks{
 		compactionCallbacks:        compactionCallbacks,
 		compactionCallbacksCtrl:    compactionCallbacksCtrl,
@@ -119,5 +125,7 @@ func (s *Shard) initCycleCallbacks() {
 		geoPropsCommitLoggerCallbacks:     geoPropsCommitLoggerCallbacks,
 		geoPropsTombstoneCleanupCallbacks: geoPropsTombstoneCleanupCallbacks,
 		geoPropsCombinedCallbacksCtrl:     geoPropsCombinedCallbacksCtrl,
+
+		objectTTLCallbacksCtrl: objectTTLCallbacksCtrl,
 	}
 }
@@ -16,6 +16,8 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 )
 
 // IMPORTANT:
@@ -27,15 +29,21 @@ func (s *Shard) DebugResetVectorIndex(ctx context.Context, targetVector string)
 	if !asyncEnabled() {
 		return fmt.Errorf("async indexing is not enabled")
 	}
+	if s.hasRunningVectorIndexMigrations() {
+		return fmt.Errorf("vector index migration in progress")
+	}
 
 	var vidx VectorIndex
 	var q *VectorIndexQueue
+	var cfg schemaConfig.VectorIndexConfig
 	if s.hasTargetVectors() {
-		vidx = s.vectorIndexes[targetVector]
+		vidx = s.VectorIndexForName(targetVector)
 		q = s.queues[targetVector]
+		cfg = s.index.vectorIndexUserConfigs[targetVector]
 	} else {
-		vidx = s.vectorIndex
+		vidx = s.VectorIndex()
 		q = s.queue
+		cfg = s.index.vectorIndexUserConfig
 	}
 
 	if vidx == nil {
@@ -50,21 +58,34 @@ func (s *Shard) DebugResetVectorIndex(ctx context.Context, targetVector string)
 		return errors.Wrap(err, "drop vector index")
 	}
 
-	if s.hasTargetVectors() {
-		s.vectorIndexes[targetVector], err = s.initVectorIndex(ctx, targetVector, s.index.vectorIndexUserConfigs[targetVector])
-		if err != nil {
-			return errors.Wrap(err, "init vector index")
-		}
-		vidx = s.vectorIndexes[targetVector]
-	} else {
-		s.vectorIndex, err = s.initVectorIndex(ctx, targetVector, s.index.vectorIndexUserConfig)
-		if err != nil {
-			return errors.Wrap(err, "init vector index")
-		}
-		vidx = s.vectorIndex
+	vidx, err = s.initVectorIndex(ctx, targetVector, cfg)
+	if err != nil {
+		return errors.Wrap(err, "init vector index")
 	}
+	s.setVectorIndex(targetVector, vidx)
 
 	q.ResetWith(vidx)
 	q.Resume()
 	return nil
 }
+
+// DebugGraphHealth reports the connectivity of the hnsw index of the given
+// target vector. With repair set, unreachable nodes are reconnected first and
+// the report reflects the state of the graph after the repair.
+func (s *Shard) DebugGraphHealth(ctx context.Context, targetVector string, repair bool) (*hnsw.GraphHealth, error) {
+	vidx, err := s.getVectorIndex(targetVector)
+	if err != nil {
+		return nil, err
+	}
+	if vidx == nil {
+		return nil, fmt.Errorf("vector index %q not found", targetVector)
+	}
+	if !hnsw.IsHNSWIndex(vidx) {
+		return nil, fmt.Errorf("vector index %q is not hnsw", targetVector)
+	}
+
+	if repair {
+		return hnsw.AsHNSWIndex(vidx).RepairGraph(ctx)
+	}
+	return hnsw.AsHNSWIndex(vidx).AnalyzeGraph(ctx)
+}
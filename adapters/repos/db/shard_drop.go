@@ -19,6 +19,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 )
 
@@ -33,6 +34,7 @@ func (s *Shard) drop() (err error) {
 	s.metrics.DeleteShardLabels(s.index.Config.ClassName.String(), s.name)
 	s.metrics.baseMetrics.StartUnloadingShard(s.index.Config.ClassName.String())
 	s.replicationMap.clear()
+	s.stopVectorIndexMigrations()
 
 	if s.index.Config.TrackVectorDimensions {
 		// tracking vector dimensions goroutine only works when tracking is enabled
@@ -65,14 +67,23 @@ func (s *Shard) drop() (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
 	).Unregister(ctx); err != nil {
 		return err
 	}
 
+	if err = s.releaseSnapshots(); err != nil {
+		return errors.Wrap(err, "release snapshots")
+	}
+
 	if err = s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
 
+	if err = dropColdSegments(ctx, s.index, s.pathLSM()); err != nil {
+		return errors.Wrapf(err, "remove tiered segments of lsm store at %s", s.pathLSM())
+	}
+
 	if _, err = os.Stat(s.pathLSM()); err == nil {
 		err := os.RemoveAll(s.pathLSM())
 		if err != nil {
@@ -114,6 +125,10 @@ func (s *Shard) drop() (err error) {
 		}
 	}
 
+	if err = s.vectorIndexGenerations.shutdownStores(ctx); err != nil {
+		return errors.Wrapf(err, "stop vector index stores at %s", s.path())
+	}
+
 	// delete property length tracker
 	err = s.GetPropertyLengthTracker().Drop()
 	if err != nil {
@@ -136,3 +151,13 @@ func (s *Shard) drop() (err error) {
 
 	return nil
 }
+
+// dropColdSegments deletes the segments that were moved to tiered storage.
+// They live outside of the shard directory, so they need to be deleted
+// before the directory is removed, which holds the only reference to them.
+func dropColdSegments(ctx context.Context, idx *Index, lsmPath string) error {
+	if idx.Config.Tiering == nil || idx.Config.Tiering.Store == nil {
+		return nil
+	}
+	return lsmkv.DeleteColdSegments(ctx, lsmPath, idx.Config.Tiering.Store)
+}
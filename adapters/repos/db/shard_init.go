@@ -23,6 +23,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/indexcheckpoint"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/fuzzy"
 	"github.com/weaviate/weaviate/adapters/repos/db/queue"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
@@ -61,6 +62,9 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		shutdownLock: new(sync.RWMutex),
 
 		status: NewShardStatus(),
+
+		vectorIndexMigrations: map[string]*vectorIndexMigration{},
+		termDictionaries:      fuzzy.NewDictionaries(fuzzy.DefaultMaxTerms, index.allocChecker),
 	}
 
 	defer func() {
@@ -97,6 +101,7 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 	s.initCycleCallbacks()
 
 	s.docIdLock = make([]sync.Mutex, IdLockPoolSize)
+	s.keyValueLock = make([]sync.Mutex, IdLockPoolSize)
 
 	defer s.metrics.ShardStartup(before)
 
@@ -117,6 +122,10 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 		return nil, errors.Wrapf(err, "init shard %q", s.ID())
 	}
 
+	if s.vectorIndexGenerations, err = loadVectorIndexGenerations(s.path()); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q", s.ID())
+	}
+
 	if s.hasTargetVectors() {
 		if err := s.initTargetVectors(ctx); err != nil {
 			return nil, err
@@ -157,6 +166,14 @@ func NewShard(ctx context.Context, promMetrics *monitoring.PrometheusMetrics,
 	}
 	s.NotifyReady()
 
+	if err := s.resumeInterruptedBulkLoad(); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q", s.ID())
+	}
+
+	if err := s.resumeVectorIndexMigrations(); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q", s.ID())
+	}
+
 	if exists {
 		s.index.logger.Printf("Completed loading shard %s in %s", s.ID(), time.Since(before))
 	} else {
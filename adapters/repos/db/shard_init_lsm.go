@@ -64,6 +64,10 @@ func (s *Shard) initNonVector(ctx context.Context, class *models.Class) error {
 		return s.initProplenTracker()
 	})
 
+	eg.Go(func() error {
+		return s.initKeyValueBucket(ctx)
+	})
+
 	// geo props depend on the object bucket and we need to wait for its creation in this case
 	hasGeoProp := false
 	for _, prop := range class.Properties {
@@ -127,6 +131,7 @@ func (s *Shard) initLSMStore() error {
 	if err != nil {
 		return fmt.Errorf("init lsmkv store at %s: %w", s.pathLSM(), err)
 	}
+	store.SetCompactionScheduler(s.index.Config.CompactionScheduler)
 
 	s.store = store
 
@@ -138,6 +143,10 @@ func (s *Shard) initObjectBucket(ctx context.Context) error {
 		lsmkv.WithStrategy(lsmkv.StrategyReplace),
 		lsmkv.WithSecondaryIndices(2),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
 		lsmkv.WithKeepTombstones(true),
 		s.dynamicMemtableSizing(),
 		s.memtableDirtyConfig(),
@@ -153,6 +162,15 @@ func (s *Shard) initObjectBucket(ctx context.Context) error {
 		// details.
 		opts = append(opts, lsmkv.WithMonitorCount())
 	}
+
+	if s.index.Config.Tiering != nil && !s.index.partitioningEnabled {
+		// multi-tenant shards are offloaded as a whole instead
+		tiering := *s.index.Config.Tiering
+		tiering.KeyPrefix = path.Join(s.index.ID(), s.name,
+			s.index.getSchema.NodeName(), helpers.ObjectsBucketLSM)
+		opts = append(opts, lsmkv.WithTiering(tiering))
+	}
+
 	err := s.store.CreateOrLoadBucket(ctx, helpers.ObjectsBucketLSM, opts...)
 	if err != nil {
 		return fmt.Errorf("create objects bucket: %w", err)
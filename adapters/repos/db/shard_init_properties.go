@@ -94,6 +94,10 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		s.memtableDirtyConfig(),
 		s.dynamicMemtableSizing(),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
@@ -135,6 +139,15 @@ func (s *Shard) createPropertyValueIndex(ctx context.Context, prop *models.Prope
 		}
 	}
 
+	if inverted.HasPositionIndex(prop) {
+		if err := s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketPositionsFromPropNameLSM(prop.Name),
+			append(bucketOpts, lsmkv.WithStrategy(lsmkv.StrategyMapCollection))...,
+		); err != nil {
+			return err
+		}
+	}
+
 	if inverted.HasRangeableIndex(prop) {
 		if err := s.store.CreateOrLoadBucket(ctx,
 			helpers.BucketRangeableFromPropNameLSM(prop.Name),
@@ -168,6 +181,10 @@ func (s *Shard) createPropertyLengthIndex(ctx context.Context, prop *models.Prop
 		helpers.BucketFromPropNameLengthLSM(prop.Name),
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
@@ -183,6 +200,10 @@ func (s *Shard) createPropertyNullIndex(ctx context.Context, prop *models.Proper
 		helpers.BucketFromPropNameNullLSM(prop.Name),
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
@@ -199,6 +220,10 @@ func (s *Shard) addIDProperty(ctx context.Context) error {
 		s.memtableDirtyConfig(),
 		lsmkv.WithStrategy(lsmkv.StrategySetCollection),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
@@ -220,6 +245,10 @@ func (s *Shard) addDimensionsProperty(ctx context.Context) error {
 		helpers.DimensionsBucketLSM,
 		lsmkv.WithStrategy(lsmkv.StrategyMapCollection),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
@@ -253,6 +282,10 @@ func (s *Shard) addCreationTimeUnixProperty(ctx context.Context) error {
 		s.memtableDirtyConfig(),
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
@@ -265,6 +298,10 @@ func (s *Shard) addLastUpdateTimeUnixProperty(ctx context.Context) error {
 		s.memtableDirtyConfig(),
 		lsmkv.WithStrategy(lsmkv.StrategyRoaringSet),
 		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
 		lsmkv.WithAllocChecker(s.index.allocChecker),
 		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
 		s.segmentCleanupConfig(),
@@ -16,21 +16,31 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/diskann"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/dynamic"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/flat"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/ivf"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/noop"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/sparse"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/vectorindex"
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
-func (s *Shard) initVectorIndex(ctx context.Context,
+// initVectorIndexAt opens the vector index of the target vector with all of
+// its files located in rootPath and its buckets in the given store
+func (s *Shard) initVectorIndexAt(ctx context.Context,
 	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+	rootPath string, store *lsmkv.Store,
 ) (VectorIndex, error) {
 	var distProv distancer.Provider
 
@@ -77,10 +87,11 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 
 			vi, err := hnsw.New(hnsw.Config{
 				Logger:                    s.index.logger,
-				RootPath:                  s.path(),
+				RootPath:                  rootPath,
 				ID:                        vecIdxID,
 				ShardName:                 s.name,
 				ClassName:                 s.index.Config.ClassName.String(),
+				TargetVector:              targetVector,
 				PrometheusMetrics:         s.promMetrics,
 				VectorForIDThunk:          hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
 				MultiVectorForIDThunk:     hnsw.NewVectorForIDThunk(targetVector, s.multiVectorByIndexID),
@@ -88,7 +99,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				TempMultiVectorForIDThunk: hnsw.NewTempMultiVectorForIDThunk(targetVector, s.readMultiVectorByIndexIDIntoSlice),
 				DistanceProvider:          distProv,
 				MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-					return hnsw.NewCommitLogger(s.path(), vecIdxID,
+					return hnsw.NewCommitLogger(rootPath, vecIdxID,
 						s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks,
 						hnsw.WithAllocChecker(s.index.allocChecker),
 						hnsw.WithCommitlogThresholdForCombining(s.index.Config.HNSWMaxLogSize),
@@ -100,7 +111,7 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 				WaitForCachePrefill:    s.index.Config.HNSWWaitForCachePrefill,
 				FlatSearchConcurrency:  s.index.Config.HNSWFlatSearchConcurrency,
 				VisitedListPoolMaxSize: s.index.Config.VisitedListPoolMaxSize,
-			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, s.store)
+			}, hnswUserConfig, s.cycleCallbacks.vectorTombstoneCleanupCallbacks, store)
 			if err != nil {
 				return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 			}
@@ -124,11 +135,11 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 		vi, err := flat.New(flat.Config{
 			ID:               vecIdxID,
 			TargetVector:     targetVector,
-			RootPath:         s.path(),
+			RootPath:         rootPath,
 			Logger:           s.index.logger,
 			DistanceProvider: distProv,
 			AllocChecker:     s.index.allocChecker,
-		}, flatUserConfig, s.store)
+		}, flatUserConfig, store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: flat index", s.ID())
 		}
@@ -153,25 +164,105 @@ func (s *Shard) initVectorIndex(ctx context.Context,
 			TargetVector:         targetVector,
 			Logger:               s.index.logger,
 			DistanceProvider:     distProv,
-			RootPath:             s.path(),
+			RootPath:             rootPath,
 			ShardName:            s.name,
 			ClassName:            s.index.Config.ClassName.String(),
 			PrometheusMetrics:    s.promMetrics,
 			VectorForIDThunk:     hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
 			TempVectorForIDThunk: hnsw.NewTempVectorForIDThunk(targetVector, s.readVectorByIndexIDIntoSlice),
 			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-				return hnsw.NewCommitLogger(s.path(), vecIdxID,
+				return hnsw.NewCommitLogger(rootPath, vecIdxID,
 					s.index.logger, s.cycleCallbacks.vectorCommitLoggerCallbacks)
 			},
 			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
-		}, dynamicUserConfig, s.store)
+		}, dynamicUserConfig, store)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: dynamic index", s.ID())
 		}
 		vectorIndex = vi
+	case vectorindex.VectorIndexTypeDISKANN:
+		diskannUserConfig, ok := vectorIndexUserConfig.(diskannent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("diskann vector index: config is not diskann.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+		s.index.cycleCallbacks.vectorTombstoneCleanupCycle.Start()
+
+		// a shard can actually have multiple vector indexes:
+		// - the main index, which is used for all normal object vectors
+		// - a geo property index for each geo prop in the schema
+		//
+		// here we label the main vector index as such.
+		vecIdxID := s.vectorIndexID(targetVector)
+
+		vi, err := diskann.New(diskann.Config{
+			ID:                 vecIdxID,
+			TargetVector:       targetVector,
+			RootPath:           rootPath,
+			ShardName:          s.name,
+			ClassName:          s.index.Config.ClassName.String(),
+			Logger:             s.index.logger,
+			DistanceProvider:   distProv,
+			AllocChecker:       s.index.allocChecker,
+			TombstoneCallbacks: s.cycleCallbacks.vectorTombstoneCleanupCallbacks,
+		}, diskannUserConfig, store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: diskann index", s.ID())
+		}
+		vectorIndex = vi
+	case vectorindex.VectorIndexTypeIVF:
+		ivfUserConfig, ok := vectorIndexUserConfig.(ivfent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("ivf vector index: config is not ivf.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+
+		// a shard can actually have multiple vector indexes:
+		// - the main index, which is used for all normal object vectors
+		// - a geo property index for each geo prop in the schema
+		//
+		// here we label the main vector index as such.
+		vecIdxID := s.vectorIndexID(targetVector)
+
+		vi, err := ivf.New(ivf.Config{
+			ID:               vecIdxID,
+			TargetVector:     targetVector,
+			RootPath:         rootPath,
+			ShardName:        s.name,
+			ClassName:        s.index.Config.ClassName.String(),
+			Logger:           s.index.logger,
+			DistanceProvider: distProv,
+			VectorForIDThunk: hnsw.NewVectorForIDThunk(targetVector, s.vectorByIndexID),
+			AllocChecker:     s.index.allocChecker,
+		}, ivfUserConfig, store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: ivf index", s.ID())
+		}
+		vectorIndex = vi
+	case vectorindex.VectorIndexTypeSPARSE:
+		sparseUserConfig, ok := vectorIndexUserConfig.(sparseent.UserConfig)
+		if !ok {
+			return nil, errors.Errorf("sparse vector index: config is not sparse.UserConfig: %T",
+				vectorIndexUserConfig)
+		}
+
+		vi, err := sparse.New(sparse.Config{
+			ID:               s.vectorIndexID(targetVector),
+			TargetVector:     targetVector,
+			ShardName:        s.name,
+			ClassName:        s.index.Config.ClassName.String(),
+			Logger:           s.index.logger,
+			DistanceProvider: distProv,
+		}, sparseUserConfig, store)
+		if err != nil {
+			return nil, errors.Wrapf(err, "init shard %q: sparse index", s.ID())
+		}
+		vectorIndex = vi
 	default:
-		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\"]",
-			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC)
+		return nil, fmt.Errorf("Unknown vector index type: %q. Choose one from [\"%s\", \"%s\", \"%s\", \"%s\", \"%s\", \"%s\"]",
+			vectorIndexUserConfig.IndexType(), vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT,
+			vectorindex.VectorIndexTypeDYNAMIC, vectorindex.VectorIndexTypeDISKANN, vectorindex.VectorIndexTypeIVF,
+			vectorindex.VectorIndexTypeSPARSE)
 	}
 	defer vectorIndex.PostStartup()
 	return vectorIndex, nil
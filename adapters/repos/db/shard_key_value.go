@@ -0,0 +1,294 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// Entries of the key-value bucket are encoded as
+//
+//	[1 byte flags][8 bytes last update time (unix millis)][value]
+//
+// Deletions are kept as entries with the deleted flag set, so that replicas
+// can tell a recent deletion from an outdated value.
+const (
+	keyValueHeaderSize  = 9
+	keyValueFlagDeleted = byte(1)
+)
+
+// initKeyValueBucket loads the key-value bucket if the shard has one. The
+// bucket is only created on the first write, so shards which never hold any
+// key-value entries don't carry an empty bucket around.
+func (s *Shard) initKeyValueBucket(ctx context.Context) error {
+	if _, err := os.Stat(filepath.Join(s.pathLSM(), helpers.KeyValueBucketLSM)); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("stat key-value bucket: %w", err)
+	}
+	_, err := s.keyValueBucket(ctx, true)
+	return err
+}
+
+func (s *Shard) keyValueBucket(ctx context.Context, create bool) (*lsmkv.Bucket, error) {
+	if b := s.store.Bucket(helpers.KeyValueBucketLSM); b != nil || !create {
+		return b, nil
+	}
+
+	err := s.store.CreateOrLoadBucket(ctx, helpers.KeyValueBucketLSM,
+		lsmkv.WithStrategy(lsmkv.StrategyReplace),
+		lsmkv.WithPread(s.index.Config.AvoidMMap),
+		lsmkv.WithSegmentChecksumVerification(s.index.Config.VerifyChecksumsOnLoad,
+			s.index.Config.VerifyChecksumsOnRead),
+		lsmkv.WithSegmentChecksums(s.index.Config.WriteSegmentChecksums),
+		lsmkv.WithSegmentQuarantine(s.index.Config.QuarantineCorruptSegments),
+		s.memtableDirtyConfig(),
+		lsmkv.WithAllocChecker(s.index.allocChecker),
+		lsmkv.WithMaxSegmentSize(s.index.Config.MaxSegmentSize),
+		s.segmentCleanupConfig(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create key-value bucket: %w", err)
+	}
+	return s.store.Bucket(helpers.KeyValueBucketLSM), nil
+}
+
+// GetKeyValue returns the entry stored under key, including deleted entries.
+// It returns nil if the key has never been written on this shard.
+func (s *Shard) GetKeyValue(ctx context.Context, key string) (*objects.KeyValue, error) {
+	b, err := s.keyValueBucket(ctx, false)
+	if err != nil || b == nil {
+		return nil, err
+	}
+	return s.getKeyValue(b, key)
+}
+
+func (s *Shard) getKeyValue(b *lsmkv.Bucket, key string) (*objects.KeyValue, error) {
+	raw, err := b.Get([]byte(key))
+	if err != nil {
+		return nil, fmt.Errorf("get key-value entry %q: %w", key, err)
+	}
+	if raw == nil {
+		return nil, nil
+	}
+	return decodeKeyValue(key, raw)
+}
+
+// WriteKeyValue applies a single write to the key-value bucket. Conditional
+// writes return objects.ErrPreconditionFailed if the condition does not
+// hold. Unconditional writes which are older than the current entry are
+// ignored, which makes replicated writes arriving out of order converge to
+// the most recent one.
+func (s *Shard) WriteKeyValue(ctx context.Context, write *objects.KeyValueWrite) (*objects.KeyValue, error) {
+	if err := s.isReadOnly(); err != nil {
+		return nil, err
+	}
+
+	b, err := s.keyValueBucket(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+
+	lock := &s.keyValueLock[keyValueLockPoolID(write.Key)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	return s.writeKeyValueLocked(b, write)
+}
+
+// writeKeyValueLocked applies the write, the caller holds the lock of the key
+func (s *Shard) writeKeyValueLocked(b *lsmkv.Bucket, write *objects.KeyValueWrite) (*objects.KeyValue, error) {
+	current, err := s.getKeyValue(b, write.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	if write.Condition != nil {
+		if !write.Condition.Matches(current) {
+			return nil, objects.NewErrPreconditionFailed("condition on key %q not met", write.Key)
+		}
+	} else if current != nil && current.LastUpdateTimeUnixMilli > write.UpdateTime {
+		return current, nil
+	}
+
+	next := &objects.KeyValue{
+		Key:                     write.Key,
+		LastUpdateTimeUnixMilli: write.UpdateTime,
+		Deleted:                 write.Delete,
+	}
+	if !write.Delete {
+		next.Value = write.Value
+	}
+	if err := b.Put([]byte(write.Key), encodeKeyValue(next)); err != nil {
+		return nil, fmt.Errorf("put key-value entry %q: %w", write.Key, err)
+	}
+	return next, nil
+}
+
+// reserveKeyValue evaluates the condition of a replicated write when it is
+// prepared and reserves the key until the write is committed or aborted. A
+// key can only be reserved by one request at a time, so that concurrent
+// conditional writes can't both pass the check on different replicas.
+func (s *Shard) reserveKeyValue(ctx context.Context, requestID string, write *objects.KeyValueWrite) error {
+	if err := s.isReadOnly(); err != nil {
+		return err
+	}
+
+	b, err := s.keyValueBucket(ctx, true)
+	if err != nil {
+		return err
+	}
+
+	lock := &s.keyValueLock[keyValueLockPoolID(write.Key)]
+	lock.Lock()
+	defer lock.Unlock()
+
+	if !s.keyValueReservations.reserve(write.Key, requestID, time.Now()) {
+		return objects.NewErrPreconditionFailed("key %q has a pending conditional write", write.Key)
+	}
+
+	current, err := s.getKeyValue(b, write.Key)
+	if err != nil {
+		s.keyValueReservations.release(requestID)
+		return err
+	}
+	if !write.Condition.Matches(current) {
+		s.keyValueReservations.release(requestID)
+		return objects.NewErrPreconditionFailed("condition on key %q not met", write.Key)
+	}
+	return nil
+}
+
+// commitKeyValue applies a conditional write prepared by reserveKeyValue and
+// releases its reservation. Reservations only keep out other conditional
+// writes, so the condition is checked again under the lock of the key:
+// unconditional writes might have changed the entry since it was prepared.
+// The commit fails if the reservation expired in the meantime.
+func (s *Shard) commitKeyValue(ctx context.Context, requestID string, write *objects.KeyValueWrite) (*objects.KeyValue, error) {
+	if err := s.isReadOnly(); err != nil {
+		s.keyValueReservations.release(requestID)
+		return nil, err
+	}
+
+	b, err := s.keyValueBucket(ctx, true)
+	if err != nil {
+		s.keyValueReservations.release(requestID)
+		return nil, err
+	}
+
+	lock := &s.keyValueLock[keyValueLockPoolID(write.Key)]
+	lock.Lock()
+	defer lock.Unlock()
+	defer s.keyValueReservations.release(requestID)
+
+	if !s.keyValueReservations.holds(write.Key, requestID, time.Now()) {
+		return nil, objects.NewErrPreconditionFailed("reservation of key %q expired before commit", write.Key)
+	}
+	return s.writeKeyValueLocked(b, write)
+}
+
+// keyValueReservationTimeout bounds how long a prepared conditional write
+// holds its key, in case the coordinator never commits or aborts it. It
+// exceeds the timeout of the coordinator's prepare phase.
+const keyValueReservationTimeout = 30 * time.Second
+
+// keyValueReservations holds the keys of prepared conditional writes, see
+// Shard.reserveKeyValue
+type keyValueReservations struct {
+	sync.Mutex
+	byKey map[string]keyValueReservation
+}
+
+type keyValueReservation struct {
+	requestID string
+	expires   time.Time
+}
+
+// reserve returns false if the key is reserved by another request
+func (r *keyValueReservations) reserve(key, requestID string, now time.Time) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	if cur, ok := r.byKey[key]; ok && cur.requestID != requestID && now.Before(cur.expires) {
+		return false
+	}
+	if r.byKey == nil {
+		r.byKey = map[string]keyValueReservation{}
+	}
+	r.byKey[key] = keyValueReservation{requestID: requestID, expires: now.Add(keyValueReservationTimeout)}
+	return true
+}
+
+// holds returns true if the key is reserved by the request and the
+// reservation has not expired
+func (r *keyValueReservations) holds(key, requestID string, now time.Time) bool {
+	r.Lock()
+	defer r.Unlock()
+
+	cur, ok := r.byKey[key]
+	return ok && cur.requestID == requestID && now.Before(cur.expires)
+}
+
+// release drops the reservation of the request, if it holds one
+func (r *keyValueReservations) release(requestID string) {
+	r.Lock()
+	defer r.Unlock()
+
+	for key, res := range r.byKey {
+		if res.requestID == requestID {
+			delete(r.byKey, key)
+			return
+		}
+	}
+}
+
+func keyValueLockPoolID(key string) uint32 {
+	return crc32.ChecksumIEEE([]byte(key)) % IdLockPoolSize
+}
+
+func encodeKeyValue(kv *objects.KeyValue) []byte {
+	buf := make([]byte, keyValueHeaderSize+len(kv.Value))
+	if kv.Deleted {
+		buf[0] = keyValueFlagDeleted
+	}
+	binary.LittleEndian.PutUint64(buf[1:], uint64(kv.LastUpdateTimeUnixMilli))
+	copy(buf[keyValueHeaderSize:], kv.Value)
+	return buf
+}
+
+func decodeKeyValue(key string, raw []byte) (*objects.KeyValue, error) {
+	if len(raw) < keyValueHeaderSize {
+		return nil, fmt.Errorf("key-value entry %q: corrupt entry of %d bytes", key, len(raw))
+	}
+	kv := &objects.KeyValue{
+		Key:                     key,
+		Deleted:                 raw[0]&keyValueFlagDeleted != 0,
+		LastUpdateTimeUnixMilli: int64(binary.LittleEndian.Uint64(raw[1:])),
+	}
+	if !kv.Deleted {
+		kv.Value = make([]byte, len(raw)-keyValueHeaderSize)
+		copy(kv.Value, raw[keyValueHeaderSize:])
+	}
+	return kv, nil
+}
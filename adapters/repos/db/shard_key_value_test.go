@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storagestate"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/usecases/objects"
+	"github.com/weaviate/weaviate/usecases/replica"
+)
+
+func TestShard_KeyValue(t *testing.T) {
+	ctx := testCtx()
+	class := &models.Class{Class: "TestClass"}
+	shd, idx := testShardWithSettings(t, ctx, class, hnsw.NewDefaultUserConfig(), false, false)
+	lazyShard := shd.(*LazyLoadShard)
+	require.Nil(t, lazyShard.Load(ctx))
+	shard := lazyShard.shard
+
+	t.Run("bucket is only created on first write", func(t *testing.T) {
+		kv, err := shd.GetKeyValue(ctx, "k")
+		require.Nil(t, err)
+		assert.Nil(t, kv)
+		assert.Nil(t, shd.Store().Bucket(helpers.KeyValueBucketLSM))
+		assert.NoDirExists(t, filepath.Join(shard.pathLSM(), helpers.KeyValueBucketLSM))
+	})
+
+	t.Run("put and get", func(t *testing.T) {
+		kv, err := shd.WriteKeyValue(ctx, &objects.KeyValueWrite{Key: "k", Value: []byte("v1"), UpdateTime: 10})
+		require.Nil(t, err)
+		assert.Equal(t, []byte("v1"), kv.Value)
+
+		kv, err = shd.GetKeyValue(ctx, "k")
+		require.Nil(t, err)
+		require.NotNil(t, kv)
+		assert.Equal(t, "k", kv.Key)
+		assert.Equal(t, []byte("v1"), kv.Value)
+		assert.Equal(t, int64(10), kv.LastUpdateTimeUnixMilli)
+		assert.False(t, kv.Deleted)
+	})
+
+	t.Run("outdated writes are ignored", func(t *testing.T) {
+		kv, err := shd.WriteKeyValue(ctx, &objects.KeyValueWrite{Key: "k", Value: []byte("old"), UpdateTime: 5})
+		require.Nil(t, err)
+		assert.Equal(t, []byte("v1"), kv.Value)
+
+		kv, err = shd.GetKeyValue(ctx, "k")
+		require.Nil(t, err)
+		assert.Equal(t, []byte("v1"), kv.Value)
+	})
+
+	t.Run("compare and swap", func(t *testing.T) {
+		_, err := shd.WriteKeyValue(ctx, &objects.KeyValueWrite{
+			Key: "k", Value: []byte("v2"), UpdateTime: 20,
+			Condition: &objects.KeyValueCondition{Value: []byte("other")},
+		})
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+
+		_, err = shd.WriteKeyValue(ctx, &objects.KeyValueWrite{
+			Key: "k", Value: []byte("v2"), UpdateTime: 20,
+			Condition: &objects.KeyValueCondition{Absent: true},
+		})
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+
+		kv, err := shd.WriteKeyValue(ctx, &objects.KeyValueWrite{
+			Key: "k", Value: []byte("v2"), UpdateTime: 20,
+			Condition: &objects.KeyValueCondition{Value: []byte("v1")},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []byte("v2"), kv.Value)
+	})
+
+	t.Run("delete keeps a tombstone", func(t *testing.T) {
+		_, err := shd.WriteKeyValue(ctx, &objects.KeyValueWrite{Key: "k", Delete: true, UpdateTime: 30})
+		require.Nil(t, err)
+
+		kv, err := shd.GetKeyValue(ctx, "k")
+		require.Nil(t, err)
+		require.NotNil(t, kv)
+		assert.True(t, kv.Deleted)
+		assert.Nil(t, kv.Value)
+		assert.Equal(t, int64(30), kv.LastUpdateTimeUnixMilli)
+
+		kv, err = shd.WriteKeyValue(ctx, &objects.KeyValueWrite{
+			Key: "k", Value: []byte("v3"), UpdateTime: 40,
+			Condition: &objects.KeyValueCondition{Absent: true},
+		})
+		require.Nil(t, err)
+		assert.Equal(t, []byte("v3"), kv.Value)
+	})
+
+	t.Run("entries survive a restart", func(t *testing.T) {
+		require.Nil(t, shd.Shutdown(ctx))
+
+		var err error
+		shard, err = NewShard(ctx, nil, shd.Name(), idx, class, idx.centralJobQueue,
+			idx.scheduler, idx.indexCheckpoints)
+		require.Nil(t, err)
+		shd = shard
+
+		kv, err := shd.GetKeyValue(ctx, "k")
+		require.Nil(t, err)
+		require.NotNil(t, kv)
+		assert.Equal(t, []byte("v3"), kv.Value)
+	})
+
+	t.Run("replicated compare and swap is checked on prepare and commit", func(t *testing.T) {
+		cas := func(value, expected string, updateTime int64) *objects.KeyValueWrite {
+			return &objects.KeyValueWrite{
+				Key: "k", Value: []byte(value), UpdateTime: updateTime,
+				Condition: &objects.KeyValueCondition{Value: []byte(expected)},
+			}
+		}
+		requireStatus := func(t *testing.T, code replica.StatusCode, resp replica.SimpleResponse) {
+			if code == replica.StatusOK {
+				require.Empty(t, resp.Errors)
+				return
+			}
+			require.Len(t, resp.Errors, 1)
+			assert.Equal(t, code, resp.Errors[0].Code)
+		}
+
+		requireStatus(t, replica.StatusPreconditionFailed,
+			shard.prepareWriteKeyValue(ctx, "r1", cas("v4", "other", 41)))
+
+		// a prepared write reserves the key until it is committed or aborted
+		requireStatus(t, replica.StatusOK, shard.prepareWriteKeyValue(ctx, "r2", cas("v4", "v3", 42)))
+		requireStatus(t, replica.StatusPreconditionFailed,
+			shard.prepareWriteKeyValue(ctx, "r3", cas("v5", "v3", 43)))
+
+		shard.abortReplication(ctx, "r2")
+		requireStatus(t, replica.StatusOK, shard.prepareWriteKeyValue(ctx, "r3", cas("v5", "v3", 43)))
+		resp := shard.commitReplication(ctx, "r3", &idx.shardTransferMutex)
+		requireStatus(t, replica.StatusOK, resp.(replica.SimpleResponse))
+
+		kv, err := shd.GetKeyValue(ctx, "k")
+		require.Nil(t, err)
+		assert.Equal(t, []byte("v5"), kv.Value)
+
+		// the commit released the key
+		requireStatus(t, replica.StatusOK, shard.prepareWriteKeyValue(ctx, "r4", cas("v6", "v5", 44)))
+		shard.abortReplication(ctx, "r4")
+
+		// an unconditional write between prepare and commit fails the commit
+		requireStatus(t, replica.StatusOK, shard.prepareWriteKeyValue(ctx, "r5", cas("v6", "v5", 45)))
+		_, err = shd.WriteKeyValue(ctx, &objects.KeyValueWrite{Key: "k", Value: []byte("blind"), UpdateTime: 46})
+		require.Nil(t, err)
+		resp = shard.commitReplication(ctx, "r5", &idx.shardTransferMutex)
+		requireStatus(t, replica.StatusPreconditionFailed, resp.(replica.SimpleResponse))
+
+		kv, err = shd.GetKeyValue(ctx, "k")
+		require.Nil(t, err)
+		assert.Equal(t, []byte("blind"), kv.Value)
+
+		// the failed commit released the key as well
+		requireStatus(t, replica.StatusOK, shard.prepareWriteKeyValue(ctx, "r6", cas("v7", "blind", 47)))
+		shard.abortReplication(ctx, "r6")
+	})
+
+	t.Run("readonly shards reject writes", func(t *testing.T) {
+		require.Nil(t, shd.UpdateStatus(storagestate.StatusReadOnly.String()))
+		_, err := shd.WriteKeyValue(ctx, &objects.KeyValueWrite{Key: "k", Value: []byte("v4"), UpdateTime: 50})
+		assert.ErrorContains(t, err, storagestate.ErrStatusReadOnly.Error())
+	})
+
+	require.Nil(t, shd.Shutdown(ctx))
+}
+
+func TestKeyValueReservations(t *testing.T) {
+	var r keyValueReservations
+	now := time.Now()
+
+	require.True(t, r.reserve("k", "r1", now))
+	assert.True(t, r.reserve("k", "r1", now), "the holder can reserve again")
+	assert.False(t, r.reserve("k", "r2", now))
+	assert.True(t, r.reserve("other", "r2", now))
+
+	// reservations of requests that were never committed or aborted expire
+	assert.True(t, r.reserve("k", "r3", now.Add(keyValueReservationTimeout)))
+
+	assert.False(t, r.holds("k", "r1", now))
+	assert.True(t, r.holds("k", "r3", now.Add(keyValueReservationTimeout)))
+	assert.False(t, r.holds("k", "r3", now.Add(2*keyValueReservationTimeout)))
+
+	r.release("r3")
+	assert.True(t, r.reserve("k", "r4", now))
+}
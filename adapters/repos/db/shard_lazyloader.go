@@ -28,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
 	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/queue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/aggregation"
 	"github.com/weaviate/weaviate/entities/backup"
@@ -345,8 +346,14 @@ func (l *LazyLoadShard) drop() error {
 			}
 		}
 
+		// remove tiered segments, they are not part of the shard dir
+		dir := shardPath(idx.path(), shardName)
+		if err := dropColdSegments(context.Background(), idx, dir); err != nil {
+			return fmt.Errorf("delete tiered segments: %w", err)
+		}
+
 		// remove shard dir
-		if err := os.RemoveAll(shardPath(idx.path(), shardName)); err != nil {
+		if err := os.RemoveAll(dir); err != nil {
 			return fmt.Errorf("delete shard dir: %w", err)
 		}
 
@@ -363,6 +370,13 @@ func (l *LazyLoadShard) DebugResetVectorIndex(ctx context.Context, targetVector
 	return l.shard.DebugResetVectorIndex(ctx, targetVector)
 }
 
+func (l *LazyLoadShard) DebugGraphHealth(ctx context.Context, targetVector string, repair bool) (*hnsw.GraphHealth, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.DebugGraphHealth(ctx, targetVector, repair)
+}
+
 func (l *LazyLoadShard) initPropertyBuckets(ctx context.Context, eg *enterrors.ErrorGroupWrapper, props ...*models.Property) {
 	l.mustLoad()
 	l.shard.initPropertyBuckets(ctx, eg, props...)
@@ -428,6 +442,20 @@ func (l *LazyLoadShard) MergeObject(ctx context.Context, object objects.MergeDoc
 	return l.shard.MergeObject(ctx, object)
 }
 
+func (l *LazyLoadShard) GetKeyValue(ctx context.Context, key string) (*objects.KeyValue, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.GetKeyValue(ctx, key)
+}
+
+func (l *LazyLoadShard) WriteKeyValue(ctx context.Context, write *objects.KeyValueWrite) (*objects.KeyValue, error) {
+	if err := l.Load(ctx); err != nil {
+		return nil, err
+	}
+	return l.shard.WriteKeyValue(ctx, write)
+}
+
 func (l *LazyLoadShard) Queue() *VectorIndexQueue {
 	l.mustLoad()
 	return l.shard.Queue()
@@ -460,6 +488,21 @@ func (l *LazyLoadShard) RepairIndex(ctx context.Context, targetVector string) er
 	return l.shard.RepairIndex(ctx, targetVector)
 }
 
+func (l *LazyLoadShard) OpenSnapshot(token string, ttl time.Duration) error {
+	if err := l.Load(context.Background()); err != nil {
+		return err
+	}
+	return l.shard.OpenSnapshot(token, ttl)
+}
+
+func (l *LazyLoadShard) ReleaseSnapshot(token string) error {
+	if !l.isLoaded() {
+		// a shard which was never loaded can't hold any snapshots
+		return ErrSnapshotNotFound
+	}
+	return l.shard.ReleaseSnapshot(token)
+}
+
 func (l *LazyLoadShard) Shutdown(ctx context.Context) error {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
@@ -509,6 +552,11 @@ func (l *LazyLoadShard) VectorIndexes() map[string]VectorIndex {
 	return l.shard.VectorIndexes()
 }
 
+func (l *LazyLoadShard) VectorIndexMigrationStatus() []*models.NodeShardVectorIndexMigration {
+	l.mustLoad()
+	return l.shard.VectorIndexMigrationStatus()
+}
+
 func (l *LazyLoadShard) hasTargetVectors() bool {
 	l.mustLoad()
 	return l.shard.hasTargetVectors()
@@ -556,6 +604,11 @@ func (l *LazyLoadShard) prepareAddReferences(ctx context.Context, shardID string
 	return l.shard.prepareAddReferences(ctx, shardID, refs)
 }
 
+func (l *LazyLoadShard) prepareWriteKeyValue(ctx context.Context, shardID string, write *objects.KeyValueWrite) replica.SimpleResponse {
+	l.mustLoadCtx(ctx)
+	return l.shard.prepareWriteKeyValue(ctx, shardID, write)
+}
+
 func (l *LazyLoadShard) commitReplication(ctx context.Context, shardID string, mutex *shardTransfer) interface{} {
 	l.mustLoad()
 	return l.shard.commitReplication(ctx, shardID, mutex)
@@ -612,6 +665,14 @@ func (l *LazyLoadShard) setFallbackToSearchable(fallback bool) {
 	l.shard.setFallbackToSearchable(fallback)
 }
 
+func (l *LazyLoadShard) dropTermDictionary(propName string) {
+	if !l.isLoaded() {
+		// dictionaries are only built by loaded shards
+		return
+	}
+	l.shard.dropTermDictionary(propName)
+}
+
 func (l *LazyLoadShard) addJobToQueue(job job) {
 	l.mustLoad()
 	l.shard.addJobToQueue(job)
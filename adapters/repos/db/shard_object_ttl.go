@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+)
+
+// objectTTLDeleteBatchSize limits how many expired objects are looked up and
+// deleted at once, so that a cycle can be aborted in between batches
+const objectTTLDeleteBatchSize = 1000
+
+// deleteExpiredObjects is registered as a callback on the index's object ttl
+// cycle. It deletes all objects of the shard whose time-to-live is expired
+// according to the class' object ttl config.
+func (s *Shard) deleteExpiredObjects(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	class := s.index.getSchema.ReadOnlyClass(s.index.Config.ClassName.String())
+	if !schema.ObjectTTLEnabled(class) {
+		return false
+	}
+	if s.isReadOnly() != nil {
+		return false
+	}
+
+	now := time.Now()
+	filter, err := objectTTLFilter(class, now)
+	if err != nil {
+		s.index.logger.WithField("action", "object_ttl").
+			WithField("shard", s.name).
+			WithError(err).Error("failed to build filter for expired objects")
+		return false
+	}
+
+	ctx := context.Background()
+	deleted := 0
+	for !shouldAbort() {
+		uuids, err := s.findExpiredUUIDs(ctx, filter)
+		if err != nil {
+			s.index.logger.WithField("action", "object_ttl").
+				WithField("shard", s.name).
+				WithError(err).Error("failed to find expired objects")
+			break
+		}
+
+		deletedBatch := 0
+		for _, res := range s.DeleteObjectBatch(ctx, uuids, now, false) {
+			if res.Err != nil {
+				s.index.logger.WithField("action", "object_ttl").
+					WithField("shard", s.name).
+					WithField("id", res.UUID).
+					WithError(res.Err).Warn("failed to delete expired object")
+				continue
+			}
+			deletedBatch++
+		}
+		deleted += deletedBatch
+
+		// every batch is looked up with the same filter, so the objects which
+		// failed to delete are found again. Without any progress, the next
+		// batch would consist of them only.
+		if len(uuids) < objectTTLDeleteBatchSize || deletedBatch == 0 {
+			break
+		}
+	}
+
+	if deleted > 0 {
+		s.Metrics().ObjectsTTLExpired(deleted)
+		s.index.logger.WithField("action", "object_ttl").
+			WithField("shard", s.name).
+			WithField("deleted", deleted).
+			Debug("deleted expired objects")
+	}
+
+	return deleted > 0
+}
+
+// findExpiredUUIDs returns the ids of up to objectTTLDeleteBatchSize objects
+// matching the filter. Only their ids are read from the objects.
+func (s *Shard) findExpiredUUIDs(ctx context.Context, filter *filters.LocalFilter) ([]strfmt.UUID, error) {
+	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
+		nil, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
+		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
+		WithTermDictionaries(s.termDictionaries).
+		WithSynonyms(s.index.synonyms).
+		Objects(ctx, objectTTLDeleteBatchSize, filter, nil,
+			additional.Properties{ReferenceQuery: true}, s.index.Config.ClassName, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	uuids := make([]strfmt.UUID, len(objs))
+	for i, obj := range objs {
+		uuids[i] = obj.ID()
+	}
+	return uuids, nil
+}
+
+// objectTTLFilter returns a filter matching all objects of the class that are
+// expired at the given point in time
+func objectTTLFilter(class *models.Class, now time.Time) (*filters.LocalFilter, error) {
+	var propName string
+	switch deleteOn := schema.ObjectTTLDeleteOn(class); deleteOn {
+	case models.ObjectTTLConfigDeleteOnCreationTime:
+		propName = filters.InternalPropCreationTimeUnix
+	case models.ObjectTTLConfigDeleteOnUpdateTime:
+		propName = filters.InternalPropLastUpdateTimeUnix
+	case models.ObjectTTLConfigDeleteOnDateProperty:
+		propName = class.ObjectTTLConfig.DateProperty
+	default:
+		return nil, fmt.Errorf("unsupported deleteOn %q", deleteOn)
+	}
+
+	cutoff := now.Add(-time.Duration(class.ObjectTTLConfig.TTLSeconds) * time.Second)
+	return &filters.LocalFilter{
+		Root: &filters.Clause{
+			Operator: filters.OperatorLessThan,
+			On: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: schema.PropertyName(propName),
+			},
+			Value: &filters.Value{
+				Value: cutoff.UTC().Format(time.RFC3339Nano),
+				Type:  schema.DataTypeDate,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,181 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_DeleteExpiredObjects(t *testing.T) {
+	ctx := testCtx()
+	now := time.Now()
+	noAbort := func() bool { return false }
+
+	type testObj struct {
+		id        strfmt.UUID
+		createdAt time.Time
+		updatedAt time.Time
+		date      time.Time
+	}
+
+	objs := []testObj{
+		{createdAt: now.Add(-3 * time.Hour), updatedAt: now.Add(-3 * time.Hour), date: now.Add(-time.Minute)},
+		{createdAt: now.Add(-3 * time.Hour), updatedAt: now.Add(-time.Minute), date: now.Add(time.Hour)},
+		{createdAt: now.Add(-time.Minute), updatedAt: now.Add(-time.Minute), date: now.Add(-2 * time.Hour)},
+	}
+	for i := range objs {
+		objs[i].id = strfmt.UUID(uuid.NewString())
+	}
+
+	tests := []struct {
+		name     string
+		config   *models.ObjectTTLConfig
+		expected []bool // whether the object still exists afterwards
+	}{
+		{
+			name:     "disabled",
+			config:   &models.ObjectTTLConfig{Enabled: false, TTLSeconds: 3600},
+			expected: []bool{true, true, true},
+		},
+		{
+			name:     "creation time",
+			config:   &models.ObjectTTLConfig{Enabled: true, TTLSeconds: 3600},
+			expected: []bool{false, false, true},
+		},
+		{
+			name: "update time",
+			config: &models.ObjectTTLConfig{
+				Enabled:    true,
+				TTLSeconds: 3600,
+				DeleteOn:   models.ObjectTTLConfigDeleteOnUpdateTime,
+			},
+			expected: []bool{false, true, true},
+		},
+		{
+			name: "date property",
+			config: &models.ObjectTTLConfig{
+				Enabled:      true,
+				DeleteOn:     models.ObjectTTLConfigDeleteOnDateProperty,
+				DateProperty: "expiresAt",
+			},
+			expected: []bool{false, true, false},
+		},
+		{
+			name: "date property with ttl",
+			config: &models.ObjectTTLConfig{
+				Enabled:      true,
+				TTLSeconds:   3600,
+				DeleteOn:     models.ObjectTTLConfigDeleteOnDateProperty,
+				DateProperty: "expiresAt",
+			},
+			expected: []bool{true, true, false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			className := "TestClass"
+			class := &models.Class{
+				Class: className,
+				InvertedIndexConfig: &models.InvertedIndexConfig{
+					IndexTimestamps: true,
+				},
+				Properties: []*models.Property{
+					{
+						Name:     "expiresAt",
+						DataType: schema.DataTypeDate.PropString(),
+					},
+				},
+				ObjectTTLConfig: tt.config,
+			}
+			shd, _ := testShardWithSettings(t, ctx, class, hnsw.UserConfig{Skip: true}, false, false)
+
+			for _, o := range objs {
+				obj := storobj.FromObject(&models.Object{
+					ID:                 o.id,
+					Class:              className,
+					CreationTimeUnix:   o.createdAt.UnixMilli(),
+					LastUpdateTimeUnix: o.updatedAt.UnixMilli(),
+					Properties: map[string]interface{}{
+						"expiresAt": o.date.UTC().Format(time.RFC3339Nano),
+					},
+				}, nil, nil, nil)
+				require.Nil(t, shd.PutObject(ctx, obj))
+			}
+
+			lazyShard := shd.(*LazyLoadShard)
+			require.Nil(t, lazyShard.Load(ctx))
+			lazyShard.shard.deleteExpiredObjects(noAbort)
+
+			for i, o := range objs {
+				exists, err := shd.Exists(ctx, o.id)
+				require.Nil(t, err)
+				assert.Equal(t, tt.expected[i], exists, "object %d", i)
+			}
+		})
+	}
+}
+
+func TestShard_DeleteExpiredObjectsInBatches(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	class := &models.Class{
+		Class: className,
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			IndexTimestamps: true,
+		},
+		ObjectTTLConfig: &models.ObjectTTLConfig{Enabled: true, TTLSeconds: 3600},
+	}
+	shd, _ := testShardWithSettings(t, ctx, class, hnsw.UserConfig{Skip: true}, false, false)
+
+	count := objectTTLDeleteBatchSize + objectTTLDeleteBatchSize/2
+	createdAt := time.Now().Add(-2 * time.Hour).UnixMilli()
+	for i := 0; i < count; i++ {
+		obj := storobj.FromObject(&models.Object{
+			ID:                 strfmt.UUID(uuid.NewString()),
+			Class:              className,
+			CreationTimeUnix:   createdAt,
+			LastUpdateTimeUnix: createdAt,
+		}, nil, nil, nil)
+		require.Nil(t, shd.PutObject(ctx, obj))
+	}
+
+	lazyShard := shd.(*LazyLoadShard)
+	require.Nil(t, lazyShard.Load(ctx))
+
+	t.Run("abort after the first batch", func(t *testing.T) {
+		checks := 0
+		abortAfterFirst := func() bool {
+			checks++
+			return checks > 1
+		}
+		assert.True(t, lazyShard.shard.deleteExpiredObjects(abortAfterFirst))
+		assert.Equal(t, count-objectTTLDeleteBatchSize, lazyShard.shard.ObjectCount())
+	})
+
+	t.Run("delete the remaining batch", func(t *testing.T) {
+		assert.True(t, lazyShard.shard.deleteExpiredObjects(func() bool { return false }))
+		assert.Equal(t, 0, lazyShard.shard.ObjectCount())
+	})
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/spaolacci/murmur3"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
 	"github.com/weaviate/weaviate/adapters/repos/db/sorter"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/entities/additional"
@@ -285,6 +286,10 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort, cursor *filters.Cursor,
 	additional additional.Properties, properties []string,
 ) ([]*storobj.Object, []float32, error) {
+	if err := s.errIfBulkLoading(); err != nil {
+		return nil, nil, err
+	}
+
 	var err error
 
 	// Report slow queries if this method takes longer than expected
@@ -308,6 +313,9 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 	}()
 
 	s.activityTracker.Add(1)
+	if additional.Snapshot != "" && (keywordRanking != nil || filters != nil) {
+		return nil, nil, ErrSnapshotUnsupported
+	}
 	if keywordRanking != nil {
 		if v := s.versioner.Version(); v < 2 {
 			return nil, nil, errors.Errorf(
@@ -326,6 +334,8 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 				s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 				s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit,
 				s.bitmapFactory).
+				WithTermDictionaries(s.termDictionaries).
+				WithSynonyms(s.index.synonyms).
 				DocIDs(ctx, filters, additional, s.index.Config.ClassName)
 			if err != nil {
 				return nil, nil, err
@@ -339,7 +349,9 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 		logger := s.index.logger.WithFields(logrus.Fields{"class": s.index.Config.ClassName, "shard": s.name})
 		bm25searcher := inverted.NewBM25Searcher(bm25Config, s.store,
 			s.index.getSchema.ReadOnlyClass, s.propertyIndices, s.index.classSearcher,
-			s.GetPropertyLengthTracker(), logger, s.versioner.Version())
+			s.GetPropertyLengthTracker(), logger, s.versioner.Version()).
+			WithTermDictionaries(s.termDictionaries).
+			WithSynonyms(s.index.synonyms)
 		bm25objs, bm25count, err = bm25searcher.BM25F(ctx, filterDocIds, className, limit, *keywordRanking, additional)
 		if err != nil {
 			return nil, nil, err
@@ -356,6 +368,8 @@ func (s *Shard) ObjectSearch(ctx context.Context, limit int, filters *filters.Lo
 	objs, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
+		WithTermDictionaries(s.termDictionaries).
+		WithSynonyms(s.index.synonyms).
 		Objects(ctx, limit, filters, sort, additional, s.index.Config.ClassName, properties)
 	return objs, nil, err
 }
@@ -373,7 +387,13 @@ func (s *Shard) VectorDistanceForQuery(ctx context.Context, docId uint64, search
 			return nil, fmt.Errorf("index %s not found", target)
 		}
 		var distancer common.QueryVectorDistancer
-		if index.Multivector() {
+		if sparseVector, ok := searchVectors[j].(models.SparseVector); ok {
+			sparseIndex, ok := index.(SparseVectorIndex)
+			if !ok {
+				return nil, fmt.Errorf("index %s is not a sparse vector index", target)
+			}
+			distancer = sparseIndex.QuerySparseVectorDistancer(sparseVector)
+		} else if index.Multivector() {
 			distancer = index.QueryMultiVectorDistancer(searchVectors[j].([][]float32))
 		} else {
 			distancer = index.QueryVectorDistancer(searchVectors[j].([]float32))
@@ -392,7 +412,7 @@ func (s *Shard) getVectorIndex(targetVector string) (VectorIndex, error) {
 		if targetVector == "" {
 			return nil, fmt.Errorf("vector index: missing target vector")
 		}
-		vidx, ok := s.vectorIndexes[targetVector]
+		vidx, ok := s.VectorIndexes()[targetVector]
 		if !ok {
 			return nil, fmt.Errorf("vector index for target vector: %s doesn't exist", targetVector)
 		}
@@ -402,7 +422,7 @@ func (s *Shard) getVectorIndex(targetVector string) (VectorIndex, error) {
 		return nil, fmt.Errorf("vector index: target vector not found: %q", targetVector)
 	}
 
-	return s.vectorIndex, nil
+	return s.VectorIndex(), nil
 }
 
 func (s *Shard) getIndexQueue(targetVector string) (*VectorIndexQueue, error) {
@@ -423,6 +443,10 @@ func (s *Shard) getIndexQueue(targetVector string) (*VectorIndexQueue, error) {
 }
 
 func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.Vector, targetVectors []string, targetDist float32, limit int, filters *filters.LocalFilter, sort []filters.Sort, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination, properties []string) ([]*storobj.Object, []float32, error) {
+	if err := s.errIfBulkLoading(); err != nil {
+		return nil, nil, err
+	}
+
 	startTime := time.Now()
 
 	defer func() {
@@ -443,6 +467,9 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 	}()
 
 	s.activityTracker.Add(1)
+	if additional.Snapshot != "" {
+		return nil, nil, ErrSnapshotUnsupported
+	}
 
 	var allowList helpers.AllowList
 	if filters != nil {
@@ -458,12 +485,27 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 		helpers.AnnotateSlowQueryLog(ctx, "filters_ids_matched", allowList.Len())
 	}
 
+	ctx = common.ContextWithRescoreMultiplier(ctx, additional.RescoreMultiplier)
+
 	eg := enterrors.NewErrorGroupWrapper(s.index.logger)
 	eg.SetLimit(_NUMCPU)
 	idss := make([][]uint64, len(targetVectors))
 	distss := make([][]float32, len(targetVectors))
 	beforeVector := time.Now()
 
+	// a search by distance returns all results within the target distance,
+	// one page at a time. Pages after the first one continue after a cursor,
+	// so the search has to find the results up to it and the page after it.
+	// Grouped and sorted results are not paged and are cut after the maximum
+	// number of results instead.
+	byDistance := limit < 0 || additional.DistanceCursor != nil
+	pageByDistance := byDistance && groupBy == nil && len(sort) == 0
+	maxLimit := s.index.Config.QueryMaximumRadiusResults
+	if maxLimit <= 0 {
+		maxLimit = -1
+	}
+	pageSize := radiusPageSize(limit, s.index.Config.QueryMaximumRadiusResults)
+
 	for i, targetVector := range targetVectors {
 		i := i
 		targetVector := targetVector
@@ -477,32 +519,19 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 				return err
 			}
 
-			if limit < 0 {
-				switch searchVector := searchVectors[i].(type) {
-				case []float32:
-					ids, dists, err = vidx.SearchByVectorDistance(
-						ctx, searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
-					if err != nil {
-						// This should normally not fail. A failure here could indicate that more
-						// attention is required, for example because data is corrupted. That's
-						// why this error is explicitly pushed to sentry.
-						err = fmt.Errorf("vector search by distance: %w", err)
-						entsentry.CaptureException(err)
-						return err
-					}
-				case [][]float32:
-					ids, dists, err = vidx.SearchByMultiVectorDistance(
-						ctx, searchVector, targetDist, s.index.Config.QueryMaximumResults, allowList)
-					if err != nil {
-						// This should normally not fail. A failure here could indicate that more
-						// attention is required, for example because data is corrupted. That's
-						// why this error is explicitly pushed to sentry.
-						err = fmt.Errorf("multi vector search by distance: %w", err)
-						entsentry.CaptureException(err)
-						return err
-					}
-				default:
-					return fmt.Errorf("vector search by distance: unsupported type: %T", searchVectors[i])
+			if byDistance {
+				search := func(dist float32, maxLimit int64) ([]uint64, []float32, error) {
+					return s.vectorSearchByDistance(ctx, vidx, targetVector, searchVectors[i],
+						dist, maxLimit, allowList)
+				}
+				if pageByDistance {
+					ids, dists, err = searchAfterDistanceCursor(additional.DistanceCursor,
+						targetDist, pageSize, search)
+				} else {
+					ids, dists, err = search(targetDist, maxLimit)
+				}
+				if err != nil {
+					return err
 				}
 			} else {
 				switch searchVector := searchVectors[i].(type) {
@@ -530,6 +559,15 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 							s.index.Config.ClassName, s.name, err))
 						return err
 					}
+				case models.SparseVector:
+					sparseIndex, ok := vidx.(SparseVectorIndex)
+					if !ok {
+						return fmt.Errorf("sparse vector search: target vector %q is not a sparse vector", targetVector)
+					}
+					ids, dists, err = sparseIndex.SearchBySparseVector(ctx, searchVector, limit, allowList)
+					if err != nil {
+						return fmt.Errorf("sparse vector search: %w", err)
+					}
 				default:
 					return fmt.Errorf("vector search: unsupported type: %T", searchVectors[i])
 				}
@@ -548,11 +586,20 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 		return nil, nil, err
 	}
 
-	idsCombined, distCombined, err := CombineMultiTargetResults(ctx, s, s.index.logger, idss, distss, targetVectors, searchVectors, targetCombination, limit, targetDist)
+	combineLimit := limit
+	if byDistance {
+		combineLimit = -1
+	}
+	idsCombined, distCombined, err := CombineMultiTargetResults(ctx, s, s.index.logger, idss, distss, targetVectors, searchVectors, targetCombination, combineLimit, targetDist)
 	if err != nil {
 		return nil, nil, err
 	}
 
+	if pageByDistance {
+		idsCombined, distCombined = distanceCursorCandidates(idsCombined, distCombined,
+			additional.DistanceCursor, pageSize)
+	}
+
 	if filters != nil {
 		s.metrics.FilteredVectorVector(time.Since(beforeVector))
 	}
@@ -594,12 +641,65 @@ func (s *Shard) ObjectVectorSearch(ctx context.Context, searchVectors []models.V
 	}
 
 	helpers.AnnotateSlowQueryLog(ctx, "objects_took", took)
+	if pageByDistance {
+		objs, distCombined = distanceCursorPage(objs, distCombined, additional.DistanceCursor, pageSize)
+	}
 	return objs, distCombined, nil
 }
 
+// vectorSearchByDistance searches the vector index for all results within
+// dist of the search vector, at most maxLimit of them unless it is negative
+func (s *Shard) vectorSearchByDistance(ctx context.Context, vidx VectorIndex, targetVector string,
+	searchVector models.Vector, dist float32, maxLimit int64, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	switch searchVector := searchVector.(type) {
+	case []float32:
+		ids, dists, err := vidx.SearchByVectorDistance(ctx, searchVector, dist, maxLimit, allowList)
+		if err != nil {
+			// This should normally not fail. A failure here could indicate that more
+			// attention is required, for example because data is corrupted. That's
+			// why this error is explicitly pushed to sentry.
+			err = fmt.Errorf("vector search by distance: %w", err)
+			entsentry.CaptureException(err)
+			return nil, nil, err
+		}
+		return ids, dists, nil
+	case [][]float32:
+		ids, dists, err := vidx.SearchByMultiVectorDistance(ctx, searchVector, dist, maxLimit, allowList)
+		if err != nil {
+			// This should normally not fail. A failure here could indicate that more
+			// attention is required, for example because data is corrupted. That's
+			// why this error is explicitly pushed to sentry.
+			err = fmt.Errorf("multi vector search by distance: %w", err)
+			entsentry.CaptureException(err)
+			return nil, nil, err
+		}
+		return ids, dists, nil
+	case models.SparseVector:
+		sparseIndex, ok := vidx.(SparseVectorIndex)
+		if !ok {
+			return nil, nil, fmt.Errorf("sparse vector search: target vector %q is not a sparse vector", targetVector)
+		}
+		ids, dists, err := sparseIndex.SearchBySparseVectorDistance(ctx, searchVector, dist, maxLimit, allowList)
+		if err != nil {
+			return nil, nil, fmt.Errorf("sparse vector search by distance: %w", err)
+		}
+		return ids, dists, nil
+	default:
+		return nil, nil, fmt.Errorf("vector search by distance: unsupported type: %T", searchVector)
+	}
+}
+
 func (s *Shard) ObjectList(ctx context.Context, limit int, sort []filters.Sort, cursor *filters.Cursor, additional additional.Properties, className schema.ClassName) ([]*storobj.Object, error) {
+	if err := s.errIfBulkLoading(); err != nil {
+		return nil, err
+	}
+
 	s.activityTracker.Add(1)
 	if len(sort) > 0 {
+		if additional.Snapshot != "" {
+			return nil, ErrSnapshotUnsupported
+		}
 		docIDs, err := s.sortedObjectList(ctx, limit, sort, className)
 		if err != nil {
 			return nil, err
@@ -618,28 +718,51 @@ func (s *Shard) cursorObjectList(ctx context.Context, c *filters.Cursor,
 	additional additional.Properties,
 	className schema.ClassName,
 ) ([]*storobj.Object, error) {
-	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
-	defer cursor.Close()
+	lower, upper, ok, err := c.KeyRange()
+	if err != nil {
+		return nil, errors.Wrap(err, "cursor range")
+	}
+	if !ok {
+		return []*storobj.Object{}, nil
+	}
 
-	var key, val []byte
-	if c.After == "" {
-		key, val = cursor.First()
-	} else {
-		uuidBytes, err := uuid.MustParse(c.After).MarshalBinary()
+	var cursor *lsmkv.CursorReplace
+	if additional.Snapshot != "" {
+		snap, err := s.objectsSnapshot(additional.Snapshot)
 		if err != nil {
-			return nil, errors.Wrap(err, "after argument is not a valid uuid")
+			return nil, err
 		}
-		key, val = cursor.Seek(uuidBytes)
-		if bytes.Equal(key, uuidBytes) {
-			// move cursor by one if it's the same ID
-			key, val = cursor.Next()
+		cursor, err = snap.Cursor()
+		if err != nil {
+			if errors.Is(err, lsmkv.ErrSnapshotReleased) {
+				return nil, fmt.Errorf("shard %s: %w", s.name, ErrSnapshotNotFound)
+			}
+			return nil, errors.Wrap(err, "open snapshot cursor")
 		}
+	} else {
+		cursor = s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	}
+	defer cursor.Close()
+
+	// the keys of the objects bucket are binary uuids, so the uuid order
+	// matches the key order of the cursor
+	var key, val []byte
+	advance, inRange := cursor.Next, func(key []byte) bool {
+		return bytes.Compare(key, upper) <= 0
+	}
+	if c.Reverse {
+		key, val = cursor.SeekReverse(upper)
+		advance, inRange = cursor.Prev, func(key []byte) bool {
+			return bytes.Compare(key, lower) >= 0
+		}
+	} else {
+		key, val = cursor.Seek(lower)
 	}
 
 	i := 0
 	out := make([]*storobj.Object, c.Limit)
 
-	for ; key != nil && i < c.Limit; key, val = cursor.Next() {
+	for ; key != nil && i < c.Limit && inRange(key); key, val = advance() {
 		obj, err := storobj.FromBinary(val)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unmarhsal item %d", i)
@@ -680,6 +803,8 @@ func (s *Shard) buildAllowList(ctx context.Context, filters *filters.LocalFilter
 	list, err := inverted.NewSearcher(s.index.logger, s.store, s.index.getSchema.ReadOnlyClass,
 		s.propertyIndices, s.index.classSearcher, s.index.stopwords, s.versioner.Version(),
 		s.isFallbackToSearchable, s.tenant(), s.index.Config.QueryNestedRefLimit, s.bitmapFactory).
+		WithTermDictionaries(s.termDictionaries).
+		WithSynonyms(s.index.synonyms).
 		DocIDs(ctx, filters, addl, s.index.Config.ClassName)
 	if err != nil {
 		return nil, errors.Wrap(err, "build inverted filter allow list")
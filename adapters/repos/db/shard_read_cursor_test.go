@@ -0,0 +1,98 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_CursorRangeScans(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	shd, _ := testShardWithSettings(t, ctx, &models.Class{Class: className},
+		hnsw.UserConfig{Skip: true}, false, false)
+
+	// ids 00..., 10..., ..., f0... with the first half in a segment and the
+	// second half in the memtable
+	id := func(i int) strfmt.UUID {
+		return strfmt.UUID(fmt.Sprintf("%02x000000-0000-0000-0000-000000000000", i*16))
+	}
+	for i := 0; i < 16; i++ {
+		obj := storobj.FromObject(&models.Object{ID: id(i), Class: className}, nil, nil, nil)
+		require.Nil(t, shd.PutObject(ctx, obj))
+		if i == 7 {
+			require.Nil(t, shd.Store().Bucket(helpers.ObjectsBucketLSM).FlushAndSwitch())
+		}
+	}
+
+	list := func(t *testing.T, cursor *filters.Cursor) []strfmt.UUID {
+		objs, err := shd.ObjectList(ctx, cursor.Limit, nil, cursor,
+			additional.Properties{}, schema.ClassName(className))
+		require.Nil(t, err)
+		out := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			out[i] = obj.ID()
+		}
+		return out
+	}
+
+	t.Run("range", func(t *testing.T) {
+		got := list(t, &filters.Cursor{After: id(3).String(), Before: id(9).String(), Limit: 100})
+		assert.Equal(t, []strfmt.UUID{id(4), id(5), id(6), id(7), id(8)}, got)
+	})
+
+	t.Run("prefix", func(t *testing.T) {
+		assert.Equal(t, []strfmt.UUID{id(10)}, list(t, &filters.Cursor{Prefix: "a", Limit: 100}))
+		assert.Equal(t, []strfmt.UUID{id(10)}, list(t, &filters.Cursor{Prefix: "a0000", Limit: 100}))
+		assert.Empty(t, list(t, &filters.Cursor{Prefix: "a1", Limit: 100}))
+	})
+
+	t.Run("reverse", func(t *testing.T) {
+		got := list(t, &filters.Cursor{Before: id(9).String(), Reverse: true, Limit: 3})
+		assert.Equal(t, []strfmt.UUID{id(8), id(7), id(6)}, got)
+
+		got = list(t, &filters.Cursor{After: id(13).String(), Reverse: true, Limit: 100})
+		assert.Equal(t, []strfmt.UUID{id(15), id(14)}, got)
+	})
+
+	t.Run("paginate in reverse", func(t *testing.T) {
+		var got []strfmt.UUID
+		before := ""
+		for {
+			page := list(t, &filters.Cursor{Before: before, Reverse: true, Limit: 5})
+			if len(page) == 0 {
+				break
+			}
+			got = append(got, page...)
+			before = page[len(page)-1].String()
+		}
+
+		require.Len(t, got, 16)
+		for i := range got {
+			assert.Equal(t, id(15-i), got[i])
+		}
+	})
+}
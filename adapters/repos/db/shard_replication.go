@@ -72,6 +72,7 @@ func (s *Shard) commitReplication(ctx context.Context, requestID string, backupR
 
 func (s *Shard) abortReplication(ctx context.Context, requestID string) replica.SimpleResponse {
 	s.replicationMap.delete(requestID)
+	s.keyValueReservations.release(requestID)
 	return replica.SimpleResponse{}
 }
 
@@ -195,6 +196,45 @@ func (s *Shard) prepareAddReferences(ctx context.Context, requestID string, refs
 	return replica.SimpleResponse{}
 }
 
+func (s *Shard) prepareWriteKeyValue(ctx context.Context, requestID string, write *objects.KeyValueWrite) replica.SimpleResponse {
+	if write.Condition != nil {
+		// the condition is evaluated now, so that the coordinator can abort
+		// the write on all replicas if it does not hold on any of them. The
+		// key stays reserved until the commit, which checks the condition
+		// again.
+		if err := s.reserveKeyValue(ctx, requestID, write); err != nil {
+			var code replica.StatusCode = replica.StatusConflict
+			if errors.As(err, &objects.ErrPreconditionFailed{}) {
+				code = replica.StatusPreconditionFailed
+			}
+			return replica.SimpleResponse{Errors: []replica.Error{{Code: code, Msg: err.Error()}}}
+		}
+	}
+
+	task := func(ctx context.Context) interface{} {
+		var err error
+		if write.Condition != nil {
+			_, err = s.commitKeyValue(ctx, requestID, write)
+		} else {
+			_, err = s.WriteKeyValue(ctx, write)
+		}
+
+		resp := replica.SimpleResponse{}
+		if err != nil {
+			var code replica.StatusCode
+			if errors.As(err, &objects.ErrPreconditionFailed{}) {
+				code = replica.StatusPreconditionFailed
+			} else {
+				code = replica.StatusConflict
+			}
+			resp.Errors = []replica.Error{{Code: code, Msg: err.Error()}}
+		}
+		return resp
+	}
+	s.replicationMap.set(requestID, task)
+	return replica.SimpleResponse{}
+}
+
 func parseBytesUUID(id strfmt.UUID) ([]byte, error) {
 	uuid, err := uuid.Parse(string(id))
 	if err != nil {
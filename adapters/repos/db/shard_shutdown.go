@@ -50,6 +50,10 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 
 	ec := errorcompounder.New()
 
+	// the index a migration was building is removed, the migration starts
+	// over on the next startup
+	s.stopVectorIndexMigrations()
+
 	err = s.GetPropertyLengthTracker().Close()
 	ec.AddWrap(err, "close prop length tracker")
 
@@ -60,9 +64,15 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		s.cycleCallbacks.flushCallbacksCtrl,
 		s.cycleCallbacks.vectorCombinedCallbacksCtrl,
 		s.cycleCallbacks.geoPropsCombinedCallbacksCtrl,
+		s.cycleCallbacks.objectTTLCallbacksCtrl,
 	).Unregister(ctx)
 	ec.Add(err)
 
+	err = s.releaseSnapshots()
+	ec.AddWrap(err, "release snapshots")
+
+	s.termDictionaries.Clear()
+
 	s.mayStopHashBeater()
 
 	s.hashtreeRWMux.Lock()
@@ -125,6 +135,9 @@ func (s *Shard) Shutdown(ctx context.Context) (err error) {
 		}
 	}
 
+	err = s.vectorIndexGenerations.shutdownStores(ctx)
+	ec.AddWrap(err, "stop vector index stores")
+
 	if s.store != nil {
 		// store would be nil if loading the objects bucket failed, as we would
 		// only return the store on success from s.initLSMStore()
@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+// ErrSnapshotNotFound is returned when a read references a snapshot token
+// that is unknown to the shard, e.g. because it expired or was released.
+var ErrSnapshotNotFound = errors.New("snapshot not found or expired")
+
+// ErrSnapshotUnsupported is returned for reads which can not be served from a
+// snapshot. Only the objects bucket is pinned, the inverted and vector indexes
+// are not, so only unfiltered and unsorted cursor listings are consistent.
+var ErrSnapshotUnsupported = errors.New(
+	"snapshot reads only support unfiltered and unsorted cursor listings")
+
+// shardSnapshot is a point-in-time view of the objects of a shard, referenced
+// by a token. It is released automatically once its time-to-live expires.
+type shardSnapshot struct {
+	objects *lsmkv.BucketSnapshot
+	timer   *time.Timer
+}
+
+// OpenSnapshot pins the current state of the objects bucket under the given
+// token. Reads which reference the token are served from this state until
+// the snapshot is released or ttl has passed.
+func (s *Shard) OpenSnapshot(token string, ttl time.Duration) error {
+	if token == "" {
+		return fmt.Errorf("snapshot token must not be empty")
+	}
+	if ttl <= 0 {
+		return fmt.Errorf("snapshot ttl must be positive, got %s", ttl)
+	}
+
+	s.snapshotsLock.Lock()
+	defer s.snapshotsLock.Unlock()
+
+	if _, ok := s.snapshots[token]; ok {
+		return fmt.Errorf("snapshot %q already exists", token)
+	}
+
+	objects, err := s.store.Bucket(helpers.ObjectsBucketLSM).Snapshot()
+	if err != nil {
+		return fmt.Errorf("open snapshot of objects bucket: %w", err)
+	}
+
+	if s.snapshots == nil {
+		s.snapshots = map[string]*shardSnapshot{}
+	}
+	s.snapshots[token] = &shardSnapshot{
+		objects: objects,
+		timer: time.AfterFunc(ttl, func() {
+			if err := s.ReleaseSnapshot(token); err != nil && !errors.Is(err, ErrSnapshotNotFound) {
+				s.index.logger.WithField("action", "release_expired_snapshot").
+					WithField("shard", s.name).
+					WithError(err).Warn("failed to release expired snapshot")
+			}
+		}),
+	}
+
+	return nil
+}
+
+// ReleaseSnapshot releases the snapshot with the given token. It waits for
+// cursors still reading from the snapshot to be closed.
+func (s *Shard) ReleaseSnapshot(token string) error {
+	s.snapshotsLock.Lock()
+	snap, ok := s.snapshots[token]
+	delete(s.snapshots, token)
+	s.snapshotsLock.Unlock()
+
+	if !ok {
+		return ErrSnapshotNotFound
+	}
+
+	snap.timer.Stop()
+	return snap.objects.Release()
+}
+
+// releaseSnapshots releases all snapshots of the shard, it is called on
+// shutdown and drop
+func (s *Shard) releaseSnapshots() error {
+	s.snapshotsLock.Lock()
+	snapshots := s.snapshots
+	s.snapshots = nil
+	s.snapshotsLock.Unlock()
+
+	var errs []error
+	for token, snap := range snapshots {
+		snap.timer.Stop()
+		if err := snap.objects.Release(); err != nil {
+			errs = append(errs, fmt.Errorf("release snapshot %q: %w", token, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Shard) objectsSnapshot(token string) (*lsmkv.BucketSnapshot, error) {
+	s.snapshotsLock.Lock()
+	defer s.snapshotsLock.Unlock()
+
+	snap, ok := s.snapshots[token]
+	if !ok {
+		return nil, fmt.Errorf("shard %s: %w", s.name, ErrSnapshotNotFound)
+	}
+
+	return snap.objects, nil
+}
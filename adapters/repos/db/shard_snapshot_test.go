@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/entities/searchparams"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_Snapshot(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	class := &models.Class{
+		Class: className,
+		InvertedIndexConfig: &models.InvertedIndexConfig{
+			IndexTimestamps: true,
+		},
+		Properties: []*models.Property{
+			{
+				Name:         "name",
+				DataType:     schema.DataTypeText.PropString(),
+				Tokenization: models.PropertyTokenizationField,
+			},
+		},
+	}
+	shd, _ := testShardWithSettings(t, ctx, class, hnsw.UserConfig{Skip: true}, false, false)
+
+	put := func(t *testing.T, id strfmt.UUID, name string) {
+		obj := storobj.FromObject(&models.Object{
+			ID:         id,
+			Class:      className,
+			Properties: map[string]interface{}{"name": name},
+		}, nil, nil, nil)
+		require.Nil(t, shd.PutObject(ctx, obj))
+	}
+
+	ids := make([]strfmt.UUID, 20)
+	for i := range ids {
+		ids[i] = strfmt.UUID(uuid.NewString())
+		put(t, ids[i], "original")
+	}
+	sort.Slice(ids, func(a, b int) bool { return ids[a] < ids[b] })
+
+	token := "snapshot-token"
+	require.Nil(t, shd.OpenSnapshot(token, time.Minute))
+
+	// changes after the snapshot was opened
+	for i := 0; i < 5; i++ {
+		put(t, strfmt.UUID(uuid.NewString()), "original")
+	}
+	require.Nil(t, shd.DeleteObject(ctx, ids[3], time.Now()))
+	put(t, ids[5], "changed")
+
+	uuidsOf := func(objs []*storobj.Object) []strfmt.UUID {
+		out := make([]strfmt.UUID, len(objs))
+		for i, obj := range objs {
+			out[i] = obj.ID()
+		}
+		sort.Slice(out, func(a, b int) bool { return out[a] < out[b] })
+		return out
+	}
+	nameOf := func(objs []*storobj.Object, id strfmt.UUID) string {
+		for _, obj := range objs {
+			if obj.ID() == id {
+				return obj.Properties().(map[string]interface{})["name"].(string)
+			}
+		}
+		return ""
+	}
+	list := func(t *testing.T, cursor *filters.Cursor, snapshot string) []*storobj.Object {
+		objs, err := shd.ObjectList(ctx, cursor.Limit, nil, cursor,
+			additional.Properties{Snapshot: snapshot}, schema.ClassName(className))
+		require.Nil(t, err)
+		return objs
+	}
+
+	t.Run("paginate over snapshot", func(t *testing.T) {
+		var got []*storobj.Object
+		after := ""
+		for {
+			page := list(t, &filters.Cursor{After: after, Limit: 7}, token)
+			if len(page) == 0 {
+				break
+			}
+			got = append(got, page...)
+			after = page[len(page)-1].ID().String()
+		}
+		assert.Equal(t, ids, uuidsOf(got))
+		assert.Equal(t, "original", nameOf(got, ids[5]))
+	})
+
+	t.Run("listing without snapshot sees changes", func(t *testing.T) {
+		got := list(t, &filters.Cursor{Limit: 100}, "")
+		assert.Len(t, got, len(ids)+5-1)
+		assert.Equal(t, "changed", nameOf(got, ids[5]))
+	})
+
+	t.Run("searches on a snapshot are rejected", func(t *testing.T) {
+		filter := &filters.LocalFilter{
+			Root: &filters.Clause{
+				Operator: filters.OperatorLessThan,
+				On: &filters.Path{
+					Class:    schema.ClassName(className),
+					Property: filters.InternalPropCreationTimeUnix,
+				},
+				Value: &filters.Value{
+					Value: time.Now().UTC().Format(time.RFC3339Nano),
+					Type:  schema.DataTypeDate,
+				},
+			},
+		}
+		_, _, err := shd.ObjectSearch(ctx, 100, filter, nil, nil, nil,
+			additional.Properties{Snapshot: token}, nil)
+		assert.ErrorIs(t, err, ErrSnapshotUnsupported)
+
+		_, _, err = shd.ObjectSearch(ctx, 10, nil, &searchparams.KeywordRanking{
+			Type: "bm25", Query: "original", Properties: []string{"name"},
+		}, nil, nil, additional.Properties{Snapshot: token}, nil)
+		assert.ErrorIs(t, err, ErrSnapshotUnsupported)
+
+		_, err = shd.ObjectList(ctx, 100, []filters.Sort{{Path: []string{"name"}, Order: "asc"}}, nil,
+			additional.Properties{Snapshot: token}, schema.ClassName(className))
+		assert.ErrorIs(t, err, ErrSnapshotUnsupported)
+
+		_, _, err = shd.ObjectVectorSearch(ctx, []models.Vector{[]float32{1, 2, 3}}, []string{""}, 0, 10,
+			nil, nil, nil, additional.Properties{Snapshot: token}, nil, nil)
+		assert.ErrorIs(t, err, ErrSnapshotUnsupported)
+	})
+
+	t.Run("released snapshot can't be read", func(t *testing.T) {
+		require.Nil(t, shd.ReleaseSnapshot(token))
+		assert.ErrorIs(t, shd.ReleaseSnapshot(token), ErrSnapshotNotFound)
+
+		_, err := shd.ObjectList(ctx, 10, nil, &filters.Cursor{Limit: 10},
+			additional.Properties{Snapshot: token}, schema.ClassName(className))
+		assert.ErrorIs(t, err, ErrSnapshotNotFound)
+	})
+
+	t.Run("snapshot expires", func(t *testing.T) {
+		require.Nil(t, shd.OpenSnapshot("expiring", 10*time.Millisecond))
+		assert.Eventually(t, func() bool {
+			_, err := shd.ObjectList(ctx, 10, nil, &filters.Cursor{Limit: 10},
+				additional.Properties{Snapshot: "expiring"}, schema.ClassName(className))
+			return err != nil
+		}, 5*time.Second, 10*time.Millisecond)
+		assert.ErrorIs(t, shd.ReleaseSnapshot("expiring"), ErrSnapshotNotFound)
+	})
+}
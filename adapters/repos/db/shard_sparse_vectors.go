@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// sparseVectorIndexes returns the sparse vector indexes of the shard by
+// target vector
+func (s *Shard) sparseVectorIndexes() map[string]SparseVectorIndex {
+	s.vectorIndexLock.RLock()
+	defer s.vectorIndexLock.RUnlock()
+
+	var indexes map[string]SparseVectorIndex
+	for targetVector, vectorIndex := range s.vectorIndexes {
+		if sparseIndex, ok := vectorIndex.(SparseVectorIndex); ok {
+			if indexes == nil {
+				indexes = map[string]SparseVectorIndex{}
+			}
+			indexes[targetVector] = sparseIndex
+		}
+	}
+	return indexes
+}
+
+func (s *Shard) sparseVectorIndexForName(targetVector string) (SparseVectorIndex, error) {
+	vectorIndex := s.VectorIndexForName(targetVector)
+	if vectorIndex == nil {
+		return nil, errors.Errorf("vector index for target vector %q not found", targetVector)
+	}
+	sparseIndex, ok := vectorIndex.(SparseVectorIndex)
+	if !ok {
+		return nil, errors.Errorf("vector index for target vector %q is not a sparse index", targetVector)
+	}
+	return sparseIndex, nil
+}
+
+func (s *Shard) validateSparseVectors(obj *storobj.Object) error {
+	for targetVector, vector := range obj.SparseVectors {
+		sparseIndex, err := s.sparseVectorIndexForName(targetVector)
+		if err != nil {
+			return err
+		}
+		if err := sparseIndex.ValidateSparseBeforeInsert(vector); err != nil {
+			return errors.Wrapf(err, "target vector %s", targetVector)
+		}
+	}
+	return nil
+}
+
+// updateSparseVectorIndexes writes the sparse vectors of the object. Unlike
+// dense vectors they don't go through the index queue, their posting lists are
+// written right away, as for the inverted index of properties.
+func (s *Shard) updateSparseVectorIndexes(ctx context.Context, obj *storobj.Object,
+	status objectInsertStatus,
+) error {
+	indexes := s.sparseVectorIndexes()
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	if status.docIDChanged {
+		for targetVector, sparseIndex := range indexes {
+			if err := sparseIndex.Delete(status.oldDocID); err != nil {
+				return errors.Wrapf(err, "delete doc id %d from sparse index for target vector %s",
+					status.oldDocID, targetVector)
+			}
+		}
+	}
+
+	// vector was not changed, object was updated without changing docID
+	if status.docIDPreserved {
+		return nil
+	}
+
+	for targetVector, vector := range obj.SparseVectors {
+		sparseIndex, ok := indexes[targetVector]
+		if !ok {
+			continue
+		}
+		if err := sparseIndex.AddSparse(ctx, status.docID, vector); err != nil {
+			return errors.Wrapf(err, "insert doc id %d to sparse index for target vector %s",
+				status.docID, targetVector)
+		}
+	}
+	return nil
+}
+
+func targetSparseVectorsEqual(prev, next map[string]models.SparseVector) bool {
+	if len(prev) != len(next) {
+		return false
+	}
+	for targetVector, prevVector := range prev {
+		nextVector, ok := next[targetVector]
+		if !ok || len(prevVector.Indices) != len(nextVector.Indices) ||
+			len(prevVector.Values) != len(nextVector.Values) {
+			return false
+		}
+		for i := range prevVector.Indices {
+			if prevVector.Indices[i] != nextVector.Indices[i] {
+				return false
+			}
+		}
+		for i := range prevVector.Values {
+			if prevVector.Values[i] != nextVector.Values[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
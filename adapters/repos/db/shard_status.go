@@ -120,6 +120,10 @@ func (s *Shard) updateStatusUnlocked(in, reason string) error {
 		return errors.Wrap(err, in)
 	}
 
+	if handled, err := s.updateBulkLoadStatusUnlocked(targetStatus, reason); handled {
+		return err
+	}
+
 	s.status.Status = targetStatus
 	s.status.Reason = reason
 
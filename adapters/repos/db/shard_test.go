@@ -25,6 +25,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -35,8 +36,10 @@ import (
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/storagestate"
 	"github.com/weaviate/weaviate/entities/storobj"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 func TestShard_UpdateStatus(t *testing.T) {
@@ -519,3 +522,203 @@ func TestShard_RepairIndex(t *testing.T) {
 		})
 	}
 }
+
+func TestShard_VectorDataTypes(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+
+	halfCfg := flat.NewDefaultUserConfig()
+	halfCfg.Distance = vectorIndexCommon.DistanceL2Squared
+	halfCfg.DataType = vectorIndexCommon.DataTypeFloat16
+	int8Cfg := hnsw.NewDefaultUserConfig()
+	int8Cfg.Distance = vectorIndexCommon.DistanceDot
+	int8Cfg.DataType = vectorIndexCommon.DataTypeInt8
+
+	shd, idx := testShardWithSettings(t, ctx, &models.Class{Class: className},
+		hnsw.UserConfig{}, false, true,
+		func(i *Index) {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+				"half": halfCfg,
+				"int8": int8Cfg,
+				"full": flat.NewDefaultUserConfig(),
+			}
+		},
+	)
+	defer func() {
+		require.Nil(t, idx.drop())
+		require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+	}()
+
+	obj := testObject(className)
+	obj.Vector = nil
+	obj.Vectors = map[string][]float32{
+		"half": {0.1, 0.2, 0.3},
+		"int8": {-3, 4, 127},
+		"full": {0.1, 0.2, 0.3},
+	}
+	require.Nil(t, shd.PutObject(ctx, obj))
+
+	// int8 vectors are not rounded, they must hold integers
+	rejected := testObject(className)
+	rejected.Vector = nil
+	rejected.Vectors = map[string][]float32{"int8": {-3.2, 4, 126.7}}
+	assert.ErrorContains(t, shd.PutObject(ctx, rejected), `dataType "int8" requires integers in [-128, 127]`)
+	rejected.Vectors = map[string][]float32{"int8": {-3, 4, 128}}
+	assert.ErrorContains(t, shd.PutObject(ctx, rejected), `dataType "int8" requires integers in [-128, 127]`)
+
+	stored, err := shd.ObjectByID(ctx, obj.ID(), nil,
+		additional.Properties{Vectors: []string{"half", "int8", "full"}})
+	require.Nil(t, err)
+	require.NotNil(t, stored)
+
+	// vectors are read back with the precision they are stored with
+	assert.Equal(t, []float32{0.099975586, 0.19995117, 0.30004883}, stored.Vectors["half"])
+	assert.Equal(t, []float32{-3, 4, 127}, stored.Vectors["int8"])
+	assert.Equal(t, []float32{0.1, 0.2, 0.3}, stored.Vectors["full"])
+
+	for targetVector, vector := range stored.Vectors {
+		ids, _, err := shd.VectorIndexes()[targetVector].SearchByVector(ctx, vector, 1, nil)
+		require.Nil(t, err)
+		assert.Equal(t, []uint64{stored.DocID}, ids, targetVector)
+	}
+}
+
+func TestShard_SparseVectors(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+
+	shd, idx := testShardWithSettings(t, ctx, &models.Class{Class: className},
+		hnsw.UserConfig{}, false, true,
+		func(i *Index) {
+			i.vectorIndexUserConfigs = map[string]schemaConfig.VectorIndexConfig{
+				"dense":  flat.NewDefaultUserConfig(),
+				"splade": sparse.NewDefaultUserConfig(),
+			}
+		},
+	)
+	defer func() {
+		require.Nil(t, idx.drop())
+		require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+	}()
+
+	put := func(t *testing.T, id strfmt.UUID, indices []uint32, values []float32) uint64 {
+		obj := testObject(className)
+		obj.Object.ID = id
+		obj.Vector = nil
+		obj.Vectors = map[string][]float32{"dense": {0.1, 0.2, 0.3}}
+		obj.SparseVectors = map[string]models.SparseVector{
+			"splade": {Indices: indices, Values: values},
+		}
+		require.Nil(t, shd.PutObject(ctx, obj))
+		return obj.DocID
+	}
+
+	search := func(t *testing.T, indices []uint32, values []float32) ([]strfmt.UUID, []float32) {
+		objs, dists, err := shd.ObjectVectorSearch(ctx,
+			[]models.Vector{models.SparseVector{Indices: indices, Values: values}},
+			[]string{"splade"}, 0, 10, nil, nil, nil, additional.Properties{}, nil, nil)
+		require.Nil(t, err)
+		ids := make([]strfmt.UUID, len(objs))
+		for i := range objs {
+			ids[i] = objs[i].ID()
+		}
+		return ids, dists
+	}
+
+	first := strfmt.UUID(uuid.NewString())
+	second := strfmt.UUID(uuid.NewString())
+	put(t, first, []uint32{1, 5, 9}, []float32{0.5, 1, 2})
+	put(t, second, []uint32{5, 7}, []float32{3, 1})
+
+	t.Run("search", func(t *testing.T) {
+		ids, dists := search(t, []uint32{5, 9}, []float32{1, 1})
+		assert.Equal(t, []strfmt.UUID{first, second}, ids)
+		assert.InDeltaSlice(t, []float32{-3, -3}, dists, 0.01)
+
+		ids, dists = search(t, []uint32{7}, []float32{2})
+		assert.Equal(t, []strfmt.UUID{second}, ids)
+		assert.InDeltaSlice(t, []float32{-2}, dists, 0.01)
+	})
+
+	t.Run("read back", func(t *testing.T) {
+		stored, err := shd.ObjectByID(ctx, first, nil,
+			additional.Properties{Vectors: []string{"splade"}})
+		require.Nil(t, err)
+		require.NotNil(t, stored)
+		assert.Equal(t, models.SparseVector{Indices: []uint32{1, 5, 9}, Values: []float32{0.5, 1, 2}},
+			stored.SparseVectors["splade"])
+	})
+
+	t.Run("update replaces the postings", func(t *testing.T) {
+		put(t, second, []uint32{1}, []float32{4})
+
+		ids, _ := search(t, []uint32{7}, []float32{2})
+		assert.Empty(t, ids)
+
+		ids, dists := search(t, []uint32{1}, []float32{1})
+		assert.Equal(t, []strfmt.UUID{second, first}, ids)
+		assert.InDeltaSlice(t, []float32{-4, -0.5}, dists, 0.01)
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		require.Nil(t, shd.DeleteObject(ctx, second, time.Now()))
+
+		ids, _ := search(t, []uint32{1}, []float32{1})
+		assert.Equal(t, []strfmt.UUID{first}, ids)
+	})
+
+	t.Run("invalid sparse vector is rejected", func(t *testing.T) {
+		obj := testObject(className)
+		obj.Vector = nil
+		obj.SparseVectors = map[string]models.SparseVector{
+			"splade": {Indices: []uint32{1, 2}, Values: []float32{1}},
+		}
+		err := shd.PutObject(ctx, obj)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "sparse vector has 2 indices, but 1 values")
+	})
+
+	t.Run("dense target vector can't be searched with a sparse vector", func(t *testing.T) {
+		_, _, err := shd.ObjectVectorSearch(ctx,
+			[]models.Vector{models.SparseVector{Indices: []uint32{1}, Values: []float32{1}}},
+			[]string{"dense"}, 0, 10, nil, nil, nil, additional.Properties{}, nil, nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "is not a sparse vector")
+	})
+}
+
+func TestShard_DropDeletesColdSegments(t *testing.T) {
+	for _, lazy := range []bool{true, false} {
+		t.Run(fmt.Sprintf("lazy=%t", lazy), func(t *testing.T) {
+			ctx := testCtx()
+			className := "TestClass"
+
+			storeRoot := t.TempDir()
+			store, err := lsmkv.NewFilesystemSegmentStore(storeRoot)
+			require.Nil(t, err)
+			cache, err := lsmkv.NewSegmentCache(t.TempDir(), 1<<30, nil)
+			require.Nil(t, err)
+
+			shd, idx := testShard(t, ctx, className, func(i *Index) {
+				i.Config.Tiering = &lsmkv.TieringConfig{Store: store, Cache: cache}
+				i.Config.DisableLazyLoadShards = !lazy
+			})
+
+			// a cold segment is only referenced by its marker in the bucket
+			// dir, the data lives in the store
+			bucketDir := path.Join(shardPath(idx.path(), shd.Name()), "lsm", helpers.ObjectsBucketLSM)
+			require.Nil(t, os.MkdirAll(bucketDir, 0o777))
+			key := path.Join(className, shd.Name(), "segment-123.db")
+			marker := path.Join(bucketDir, "segment-123"+lsmkv.ColdMarkerSuffix)
+			require.Nil(t, os.WriteFile(marker, []byte(key), 0o666))
+			require.Nil(t, store.UploadSegment(ctx, key, marker))
+
+			require.Nil(t, idx.drop())
+
+			entries, err := os.ReadDir(path.Join(storeRoot, className, shd.Name()))
+			require.Nil(t, err)
+			assert.Len(t, entries, 0)
+			require.Nil(t, os.RemoveAll(idx.Config.RootPath))
+		})
+	}
+}
@@ -0,0 +1,553 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	dynamicent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+const vectorIndexGenerationsFile = "vector_index_generations.json"
+
+// vectorIndexGeneration is one on-disk incarnation of the vector index of a
+// target vector. Generation 0 lives directly in the shard directory and shares
+// the shard's lsmkv store. Every later generation is built by a vector index
+// migration and gets a directory and a store of its own, so that files of the
+// old and the new index never collide.
+type vectorIndexGeneration struct {
+	Generation uint64          `json:"generation"`
+	IndexType  string          `json:"indexType"`
+	Config     json.RawMessage `json:"config"`
+
+	config schemaConfig.VectorIndexConfig
+}
+
+func newVectorIndexGeneration(generation uint64, cfg schemaConfig.VectorIndexConfig,
+) (*vectorIndexGeneration, error) {
+	raw, err := json.Marshal(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal vector index config")
+	}
+	return &vectorIndexGeneration{
+		Generation: generation,
+		IndexType:  cfg.IndexType(),
+		Config:     raw,
+		config:     cfg,
+	}, nil
+}
+
+// userConfig returns the config the generation was built with
+func (g *vectorIndexGeneration) userConfig() (schemaConfig.VectorIndexConfig, error) {
+	if g.config != nil {
+		return g.config, nil
+	}
+
+	var cfg schemaConfig.VectorIndexConfig
+	var err error
+	switch g.IndexType {
+	case vectorindex.VectorIndexTypeHNSW:
+		uc := hnswent.UserConfig{}
+		err = json.Unmarshal(g.Config, &uc)
+		cfg = uc
+	case vectorindex.VectorIndexTypeFLAT:
+		uc := flatent.UserConfig{}
+		err = json.Unmarshal(g.Config, &uc)
+		cfg = uc
+	case vectorindex.VectorIndexTypeDYNAMIC:
+		uc := dynamicent.UserConfig{}
+		err = json.Unmarshal(g.Config, &uc)
+		cfg = uc
+	case vectorindex.VectorIndexTypeDISKANN:
+		uc := diskannent.UserConfig{}
+		err = json.Unmarshal(g.Config, &uc)
+		cfg = uc
+	case vectorindex.VectorIndexTypeIVF:
+		uc := ivfent.UserConfig{}
+		err = json.Unmarshal(g.Config, &uc)
+		cfg = uc
+	case vectorindex.VectorIndexTypeSPARSE:
+		uc := sparseent.UserConfig{}
+		err = json.Unmarshal(g.Config, &uc)
+		cfg = uc
+	default:
+		return nil, fmt.Errorf("unknown vector index type %q", g.IndexType)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "unmarshal %s config", g.IndexType)
+	}
+	g.config = cfg
+	return cfg, nil
+}
+
+type vectorIndexGenerationsState struct {
+	// Active is the generation queries are served from, by target vector
+	Active map[string]*vectorIndexGeneration `json:"active"`
+	// Obsolete is a generation that was replaced, but whose files might not
+	// have been removed yet, by target vector
+	Obsolete map[string]*vectorIndexGeneration `json:"obsolete,omitempty"`
+}
+
+// vectorIndexGenerations keeps track of the active generation of each vector
+// index of a shard and the config it was built with. The config in the schema
+// can change while a shard is not loaded, so it can't be used to tell which
+// kind of index the files on disk belong to.
+//
+// Shards that were never migrated don't have the file, their indexes are
+// implicitly generation 0 built with the config in the schema.
+type vectorIndexGenerations struct {
+	sync.Mutex
+	path  string
+	state vectorIndexGenerationsState
+	// persisted is whether the state has been written to disk
+	persisted bool
+	// lsmkv stores of generations > 0, by directory
+	stores map[string]*lsmkv.Store
+}
+
+func loadVectorIndexGenerations(shardPath string) (*vectorIndexGenerations, error) {
+	g := &vectorIndexGenerations{
+		path: filepath.Join(shardPath, vectorIndexGenerationsFile),
+		state: vectorIndexGenerationsState{
+			Active:   map[string]*vectorIndexGeneration{},
+			Obsolete: map[string]*vectorIndexGeneration{},
+		},
+		stores: map[string]*lsmkv.Store{},
+	}
+
+	data, err := os.ReadFile(g.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return g, nil
+		}
+		return nil, errors.Wrap(err, "read vector index generations")
+	}
+	if err := json.Unmarshal(data, &g.state); err != nil {
+		return nil, errors.Wrap(err, "unmarshal vector index generations")
+	}
+	g.persisted = true
+	if g.state.Active == nil {
+		g.state.Active = map[string]*vectorIndexGeneration{}
+	}
+	if g.state.Obsolete == nil {
+		g.state.Obsolete = map[string]*vectorIndexGeneration{}
+	}
+	return g, nil
+}
+
+func (g *vectorIndexGenerations) saveUnlocked() error {
+	data, err := json.Marshal(g.state)
+	if err != nil {
+		return errors.Wrap(err, "marshal vector index generations")
+	}
+	tmp := g.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o666); err != nil {
+		return errors.Wrap(err, "write vector index generations")
+	}
+	if err := os.Rename(tmp, g.path); err != nil {
+		return errors.Wrap(err, "write vector index generations")
+	}
+	g.persisted = true
+	return nil
+}
+
+// save writes the state to disk. It has to be called before the config of a
+// generation 0 index changes in a way that requires a rebuild.
+func (g *vectorIndexGenerations) save() error {
+	g.Lock()
+	defer g.Unlock()
+
+	return g.saveUnlocked()
+}
+
+func (g *vectorIndexGenerations) active(targetVector string) (*vectorIndexGeneration, bool) {
+	g.Lock()
+	defer g.Unlock()
+
+	gen, ok := g.state.Active[targetVector]
+	return gen, ok
+}
+
+// activeConfig returns the config the active generation of the target vector
+// was built with
+func (g *vectorIndexGenerations) activeConfig(targetVector string) (schemaConfig.VectorIndexConfig, error) {
+	g.Lock()
+	defer g.Unlock()
+
+	gen, ok := g.state.Active[targetVector]
+	if !ok {
+		return nil, fmt.Errorf("no active vector index for target vector %q", targetVector)
+	}
+	return gen.userConfig()
+}
+
+// setInitial records generation 0 of a vector index without writing it to
+// disk, as long as nothing has been persisted it can be derived from the schema
+func (g *vectorIndexGenerations) setInitial(targetVector string, gen *vectorIndexGeneration) error {
+	g.Lock()
+	defer g.Unlock()
+
+	g.state.Active[targetVector] = gen
+	if !g.persisted {
+		return nil
+	}
+	return g.saveUnlocked()
+}
+
+// setActive records the generation queries are served from. The generation
+// it replaces, if any, is marked as obsolete.
+func (g *vectorIndexGenerations) setActive(targetVector string, gen *vectorIndexGeneration) error {
+	g.Lock()
+	defer g.Unlock()
+
+	if prev, ok := g.state.Active[targetVector]; ok && prev.Generation != gen.Generation {
+		g.state.Obsolete[targetVector] = prev
+	}
+	g.state.Active[targetVector] = gen
+	return g.saveUnlocked()
+}
+
+// updateActiveConfig keeps the config of the active generation in sync with
+// updates that were applied in place
+func (g *vectorIndexGenerations) updateActiveConfig(targetVector string,
+	cfg schemaConfig.VectorIndexConfig,
+) error {
+	g.Lock()
+	defer g.Unlock()
+
+	prev, ok := g.state.Active[targetVector]
+	if !ok {
+		return fmt.Errorf("no active vector index for target vector %q", targetVector)
+	}
+	gen, err := newVectorIndexGeneration(prev.Generation, cfg)
+	if err != nil {
+		return err
+	}
+	g.state.Active[targetVector] = gen
+	if !g.persisted || bytes.Equal(prev.Config, gen.Config) {
+		return nil
+	}
+	return g.saveUnlocked()
+}
+
+func (g *vectorIndexGenerations) obsolete(targetVector string) (*vectorIndexGeneration, bool) {
+	g.Lock()
+	defer g.Unlock()
+
+	gen, ok := g.state.Obsolete[targetVector]
+	return gen, ok
+}
+
+func (g *vectorIndexGenerations) clearObsolete(targetVector string) error {
+	g.Lock()
+	defer g.Unlock()
+
+	if _, ok := g.state.Obsolete[targetVector]; !ok {
+		return nil
+	}
+	delete(g.state.Obsolete, targetVector)
+	return g.saveUnlocked()
+}
+
+// setStore registers the lsmkv store of a generation > 0
+func (g *vectorIndexGenerations) setStore(dir string, store *lsmkv.Store) {
+	g.Lock()
+	defer g.Unlock()
+
+	g.stores[dir] = store
+}
+
+func (g *vectorIndexGenerations) store(dir string) *lsmkv.Store {
+	g.Lock()
+	defer g.Unlock()
+
+	return g.stores[dir]
+}
+
+// removeStore shuts down the lsmkv store of a generation > 0
+func (g *vectorIndexGenerations) removeStore(ctx context.Context, dir string) error {
+	g.Lock()
+	store, ok := g.stores[dir]
+	delete(g.stores, dir)
+	g.Unlock()
+
+	if !ok {
+		return nil
+	}
+	return store.Shutdown(ctx)
+}
+
+// activeGenerations returns the active generation by target vector
+func (g *vectorIndexGenerations) activeGenerations() map[string]uint64 {
+	if g == nil {
+		// not initialized yet
+		return nil
+	}
+
+	g.Lock()
+	defer g.Unlock()
+
+	out := make(map[string]uint64, len(g.state.Active))
+	for targetVector, gen := range g.state.Active {
+		out[targetVector] = gen.Generation
+	}
+	return out
+}
+
+// shutdownStores shuts down the lsmkv stores of all generations > 0
+func (g *vectorIndexGenerations) shutdownStores(ctx context.Context) error {
+	if g == nil {
+		// not initialized yet
+		return nil
+	}
+
+	g.Lock()
+	stores := g.stores
+	g.stores = map[string]*lsmkv.Store{}
+	g.Unlock()
+
+	ec := errorcompounder.New()
+	for dir, store := range stores {
+		ec.AddWrap(store.Shutdown(ctx), fmt.Sprintf("shut down vector index store at %s", dir))
+	}
+	return ec.ToError()
+}
+
+func (s *Shard) pathVectorIndexGenerations() string {
+	return filepath.Join(s.path(), vectorIndexGenerationsFile)
+}
+
+// pathVectorIndexGeneration is the directory of a vector index generation.
+// Generation 0 lives directly in the shard directory.
+func (s *Shard) pathVectorIndexGeneration(targetVector string, generation uint64) string {
+	if generation == 0 {
+		return s.path()
+	}
+	return filepath.Join(s.path(), fmt.Sprintf("%s.gen%d.d", s.vectorIndexID(targetVector), generation))
+}
+
+// parseVectorIndexGenerationDir returns the vector index id and generation
+// encoded in a directory name created by pathVectorIndexGeneration
+func parseVectorIndexGenerationDir(name string) (string, uint64, bool) {
+	trimmed, ok := strings.CutSuffix(name, ".d")
+	if !ok {
+		return "", 0, false
+	}
+	pos := strings.LastIndex(trimmed, ".gen")
+	if pos < 0 {
+		return "", 0, false
+	}
+	generation, err := strconv.ParseUint(trimmed[pos+len(".gen"):], 10, 64)
+	if err != nil || generation == 0 {
+		return "", 0, false
+	}
+	return trimmed[:pos], generation, true
+}
+
+// activeVectorIndexStores returns the stores of all active generations that
+// don't share the shard's store. Stores of indexes that are still being built
+// by a migration are not included.
+func (s *Shard) activeVectorIndexStores() []*lsmkv.Store {
+	var stores []*lsmkv.Store
+	for targetVector, generation := range s.vectorIndexGenerations.activeGenerations() {
+		if generation == 0 {
+			continue
+		}
+		if store := s.vectorIndexGenerations.store(s.pathVectorIndexGeneration(targetVector, generation)); store != nil {
+			stores = append(stores, store)
+		}
+	}
+	return stores
+}
+
+// vectorIndexGenerationStore returns the directory and lsmkv store a vector
+// index generation is opened with. Stores of generations > 0 are created on
+// first use.
+func (s *Shard) vectorIndexGenerationStore(targetVector string, generation uint64,
+) (string, *lsmkv.Store, error) {
+	if generation == 0 {
+		return s.path(), s.store, nil
+	}
+
+	dir := s.pathVectorIndexGeneration(targetVector, generation)
+	if store := s.vectorIndexGenerations.store(dir); store != nil {
+		return dir, store, nil
+	}
+
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return "", nil, errors.Wrapf(err, "create vector index dir %s", dir)
+	}
+	store, err := lsmkv.New(filepath.Join(dir, "lsm"), dir, s.index.logger, nil,
+		s.cycleCallbacks.compactionCallbacks,
+		s.cycleCallbacks.compactionAuxCallbacks,
+		s.cycleCallbacks.flushCallbacks)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "init vector index store at %s", dir)
+	}
+	store.SetCompactionScheduler(s.index.Config.CompactionScheduler)
+	s.vectorIndexGenerations.setStore(dir, store)
+	return dir, store, nil
+}
+
+// initVectorIndex opens the active generation of the vector index of the
+// target vector. If a migration to the given config was interrupted, the
+// index is opened with the config it was built with instead, the migration is
+// picked up again once the shard is ready.
+func (s *Shard) initVectorIndex(ctx context.Context,
+	targetVector string, vectorIndexUserConfig schemaConfig.VectorIndexConfig,
+) (VectorIndex, error) {
+	gen, ok := s.vectorIndexGenerations.active(targetVector)
+	if !ok {
+		var err error
+		gen, err = newVectorIndexGeneration(0, vectorIndexUserConfig)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.vectorIndexGenerations.setInitial(targetVector, gen); err != nil {
+			return nil, err
+		}
+	}
+
+	cfg, err := gen.userConfig()
+	if err != nil {
+		return nil, errors.Wrapf(err, "vector index generation %d", gen.Generation)
+	}
+	if !vectorIndexNeedsRebuild(cfg, vectorIndexUserConfig) {
+		cfg = vectorIndexUserConfig
+		if err := s.vectorIndexGenerations.updateActiveConfig(targetVector, cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.removeObsoleteVectorIndexGenerations(ctx, targetVector); err != nil {
+		return nil, err
+	}
+
+	rootPath, store, err := s.vectorIndexGenerationStore(targetVector, gen.Generation)
+	if err != nil {
+		return nil, err
+	}
+	return s.initVectorIndexAt(ctx, targetVector, cfg, rootPath, store)
+}
+
+// removeObsoleteVectorIndexGenerations removes files of generations that are
+// no longer active, left behind by migrations that were interrupted or whose
+// cleanup didn't complete
+func (s *Shard) removeObsoleteVectorIndexGenerations(ctx context.Context, targetVector string) error {
+	active, _ := s.vectorIndexGenerations.active(targetVector)
+
+	if gen, ok := s.vectorIndexGenerations.obsolete(targetVector); ok && gen.Generation != active.Generation {
+		if gen.Generation == 0 {
+			cfg, err := gen.userConfig()
+			if err != nil {
+				return errors.Wrap(err, "obsolete vector index generation 0")
+			}
+			vectorIndex, err := s.initVectorIndexAt(ctx, targetVector, cfg, s.path(), s.store)
+			if err != nil {
+				return errors.Wrap(err, "open obsolete vector index generation 0")
+			}
+			if err := s.dropVectorIndexGeneration(ctx, targetVector, cfg, vectorIndex, 0); err != nil {
+				return err
+			}
+		}
+		if err := s.vectorIndexGenerations.clearObsolete(targetVector); err != nil {
+			return err
+		}
+	}
+
+	entries, err := os.ReadDir(s.path())
+	if err != nil {
+		return errors.Wrap(err, "list shard dir")
+	}
+	vecIdxID := s.vectorIndexID(targetVector)
+	for _, entry := range entries {
+		id, generation, ok := parseVectorIndexGenerationDir(entry.Name())
+		if !ok || id != vecIdxID || generation == active.Generation {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(s.path(), entry.Name())); err != nil {
+			return errors.Wrapf(err, "remove obsolete vector index generation %d", generation)
+		}
+	}
+	return nil
+}
+
+// dropVectorIndexGeneration removes all files of a vector index generation.
+// The index must not be in use anymore.
+func (s *Shard) dropVectorIndexGeneration(ctx context.Context, targetVector string,
+	cfg schemaConfig.VectorIndexConfig, vectorIndex VectorIndex, generation uint64,
+) error {
+	if err := vectorIndex.Drop(ctx); err != nil {
+		return errors.Wrapf(err, "drop vector index generation %d", generation)
+	}
+
+	if generation > 0 {
+		dir := s.pathVectorIndexGeneration(targetVector, generation)
+		if err := s.vectorIndexGenerations.removeStore(ctx, dir); err != nil {
+			return errors.Wrapf(err, "shut down store of vector index generation %d", generation)
+		}
+		if err := os.RemoveAll(dir); err != nil {
+			return errors.Wrapf(err, "remove vector index generation %d", generation)
+		}
+		return nil
+	}
+
+	// generation 0 shares the shard's store, only the buckets owned by the
+	// index are removed
+	for _, bucket := range vectorIndexBuckets(targetVector, cfg) {
+		if err := s.store.DropBucket(ctx, bucket); err != nil {
+			return errors.Wrapf(err, "drop bucket %q of vector index generation 0", bucket)
+		}
+	}
+	return nil
+}
+
+// vectorIndexBuckets lists the buckets a vector index creates in the store it
+// is opened with
+func vectorIndexBuckets(targetVector string, cfg schemaConfig.VectorIndexConfig) []string {
+	switch cfg.IndexType() {
+	case vectorindex.VectorIndexTypeFLAT:
+		if targetVector != "" {
+			return []string{
+				fmt.Sprintf("%s_%s", helpers.VectorsBucketLSM, targetVector),
+				fmt.Sprintf("%s_%s", helpers.VectorsCompressedBucketLSM, targetVector),
+			}
+		}
+		return []string{helpers.VectorsBucketLSM, helpers.VectorsCompressedBucketLSM}
+	case vectorindex.VectorIndexTypeHNSW:
+		// the compressed vectors of all target vectors share a bucket, it can
+		// only be removed if there is just the one index
+		if targetVector == "" {
+			return []string{helpers.VectorsCompressedBucketLSM}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,648 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const (
+	vectorIndexMigrationIndexing = "INDEXING"
+	vectorIndexMigrationSwapping = "SWAPPING"
+	vectorIndexMigrationFailed   = "FAILED"
+
+	vectorIndexMigrationBatchSize = 1000
+)
+
+// vectorIndexNeedsRebuild returns true if an index built with the current
+// config can't be updated in place to the updated config, but needs to be
+// rebuilt from the vectors stored with the objects
+func vectorIndexNeedsRebuild(current, updated schemaConfig.VectorIndexConfig) bool {
+	if current.IndexType() != updated.IndexType() {
+		return true
+	}
+
+	switch currentCfg := current.(type) {
+	case hnswent.UserConfig:
+		updatedCfg := updated.(hnswent.UserConfig)
+		// enabling compression on an uncompressed index happens in place,
+		// switching between or disabling compressions does not
+		currentCompression := hnswCompression(currentCfg)
+		return currentCompression != "" && currentCompression != hnswCompression(updatedCfg)
+	case flatent.UserConfig:
+		updatedCfg := updated.(flatent.UserConfig)
+		return currentCfg.PQ.Enabled != updatedCfg.PQ.Enabled ||
+			currentCfg.BQ.Enabled != updatedCfg.BQ.Enabled ||
+			currentCfg.RQ.Enabled != updatedCfg.RQ.Enabled ||
+			(currentCfg.RQ.Enabled && currentCfg.RQ.Bits != updatedCfg.RQ.Bits)
+	}
+	return false
+}
+
+func hnswCompression(cfg hnswent.UserConfig) string {
+	switch {
+	case cfg.PQ.Enabled:
+		return "pq"
+	case cfg.BQ.Enabled:
+		return "bq"
+	case cfg.SQ.Enabled:
+		return "sq"
+	case cfg.RQ.Enabled:
+		// vectors encoded with a different number of bits can't be compared,
+		// so changing the bits requires a rebuild as well
+		return fmt.Sprintf("rq%d", cfg.RQ.Bits)
+	}
+	return ""
+}
+
+// validateVectorIndexMigration validates an update of the vector index config
+// that requires the index to be rebuilt
+func validateVectorIndexMigration(current, updated schemaConfig.VectorIndexConfig) error {
+	// sparse vectors are stored apart from the dense ones, neither kind of
+	// index can be built from the vectors of the other
+	if (current.IndexType() == vectorindex.VectorIndexTypeSPARSE) !=
+		(updated.IndexType() == vectorindex.VectorIndexTypeSPARSE) {
+		return fmt.Errorf("cannot migrate between a sparse and a dense vector index: "+
+			"attempted change from %q to %q", current.IndexType(), updated.IndexType())
+	}
+	if current.IsMultiVector() != updated.IsMultiVector() {
+		return fmt.Errorf("multi vector setting is immutable: attempted change from \"%v\" to \"%v\"",
+			current.IsMultiVector(), updated.IsMultiVector())
+	}
+	// like for in-place updates, changing the data type is not supported as
+	// the objects already stored would keep their old encoding
+	if currentType, updatedType := vectorindex.DataType(current), vectorindex.DataType(updated); currentType != updatedType {
+		return fmt.Errorf("dataType is immutable: attempted change from \"%v\" to \"%v\"",
+			currentType, updatedType)
+	}
+	return nil
+}
+
+// vectorIndexMigration rebuilds the vector index of a target vector in the
+// background. While the new index is built, queries are served from the
+// current one and writes are mirrored to both. Once all vectors stored with
+// the objects have been added, the new index atomically replaces the current
+// one.
+type vectorIndexMigration struct {
+	shard        *Shard
+	queue        *VectorIndexQueue
+	targetVector string
+	generation   uint64
+	sourceType   string
+	logger       logrus.FieldLogger
+
+	// guards config and index, held while the index is swapped
+	configLock sync.Mutex
+	config     schemaConfig.VectorIndexConfig
+	index      VectorIndex
+
+	// guards status and err
+	stateLock sync.Mutex
+	status    string
+	err       error
+
+	processed atomic.Int64
+	total     atomic.Int64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+func (m *vectorIndexMigration) userConfig() schemaConfig.VectorIndexConfig {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+
+	return m.config
+}
+
+func (m *vectorIndexMigration) running() bool {
+	select {
+	case <-m.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// updateUserConfig applies an update that doesn't require another rebuild to
+// the index that is being built
+func (m *vectorIndexMigration) updateUserConfig(updated schemaConfig.VectorIndexConfig) error {
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+
+	if m.index != nil {
+		if err := m.index.UpdateUserConfig(updated, func() {}); err != nil {
+			return err
+		}
+	}
+	m.config = updated
+	return nil
+}
+
+// fail stops the migration with the given error. It is called from mirrored
+// writes, so it must not wait for the migration to stop.
+func (m *vectorIndexMigration) fail(err error) {
+	m.stateLock.Lock()
+	if m.err == nil {
+		m.err = err
+	}
+	m.stateLock.Unlock()
+
+	m.cancel()
+}
+
+// failure returns the error a mirrored write failed with, if any
+func (m *vectorIndexMigration) failure() error {
+	m.stateLock.Lock()
+	defer m.stateLock.Unlock()
+
+	return m.err
+}
+
+func (m *vectorIndexMigration) stop() {
+	m.cancel()
+	<-m.done
+}
+
+func (m *vectorIndexMigration) setStatus(status string) {
+	m.stateLock.Lock()
+	defer m.stateLock.Unlock()
+
+	m.status = status
+}
+
+func (m *vectorIndexMigration) toModel() *models.NodeShardVectorIndexMigration {
+	targetType := m.userConfig().IndexType()
+
+	m.stateLock.Lock()
+	defer m.stateLock.Unlock()
+
+	out := &models.NodeShardVectorIndexMigration{
+		TargetVector:     m.targetVector,
+		SourceType:       m.sourceType,
+		TargetType:       targetType,
+		Status:           m.status,
+		ObjectsProcessed: m.processed.Load(),
+		ObjectsTotal:     m.total.Load(),
+	}
+	if m.err != nil {
+		out.Error = m.err.Error()
+	}
+	return out
+}
+
+func (m *vectorIndexMigration) run() {
+	defer close(m.done)
+
+	start := time.Now()
+	m.logger.Info("vector index migration started")
+
+	err := m.migrate()
+	if err == nil {
+		m.logger.WithField("took", time.Since(start)).Info("vector index migration completed")
+		return
+	}
+
+	m.stateLock.Lock()
+	if m.err == nil && m.ctx.Err() == nil {
+		m.err = err
+	}
+	failed := m.err != nil
+	if failed {
+		err = m.err
+		m.status = vectorIndexMigrationFailed
+	}
+	m.stateLock.Unlock()
+
+	if failed {
+		m.logger.WithError(err).Error("vector index migration failed")
+	} else {
+		m.logger.Info("vector index migration stopped")
+	}
+}
+
+func (m *vectorIndexMigration) migrate() error {
+	s := m.shard
+	ctx := m.ctx
+
+	q := m.queue
+
+	// files of a previous attempt are never reused
+	dir := s.pathVectorIndexGeneration(m.targetVector, m.generation)
+	if err := os.RemoveAll(dir); err != nil {
+		return errors.Wrapf(err, "remove vector index dir %s", dir)
+	}
+	_, store, err := s.vectorIndexGenerationStore(m.targetVector, m.generation)
+	if err != nil {
+		return err
+	}
+
+	m.configLock.Lock()
+	index, err := s.initVectorIndexAt(ctx, m.targetVector, m.config, dir, store)
+	if err == nil {
+		m.index = index
+	}
+	m.configLock.Unlock()
+	if err != nil {
+		return m.cleanup(nil, errors.Wrap(err, "init vector index"))
+	}
+
+	mirror := newMirroredVectorIndex(q.index(), index, m.fail)
+	s.swapVectorIndex(q, m.targetVector, mirror, nil)
+
+	if err := m.backfill(ctx, mirror); err != nil {
+		return m.cleanup(mirror, errors.Wrap(err, "backfill vector index"))
+	}
+
+	for s.vectorIndexSwapsHalted.Load() {
+		// the shard's files are being transferred, e.g. for a backup
+		select {
+		case <-ctx.Done():
+			return m.cleanup(mirror, ctx.Err())
+		case <-time.After(time.Second):
+		}
+	}
+
+	if err := m.swap(ctx, q, mirror); err != nil {
+		return m.cleanup(mirror, err)
+	}
+	return nil
+}
+
+// backfill adds the vectors of all objects that existed when the mirror was
+// installed. Objects written afterwards reach the new index through the
+// mirror.
+func (m *vectorIndexMigration) backfill(ctx context.Context, mirror *mirroredVectorIndex) error {
+	s := m.shard
+	m.total.Store(int64(s.ObjectCount()))
+
+	ids := make([]uint64, 0, vectorIndexMigrationBatchSize)
+	if mirror.secondary.Multivector() {
+		vectors := make([][][]float32, 0, vectorIndexMigrationBatchSize)
+		err := s.iterateOnLSMMultiVectors(ctx, 0, m.targetVector, func(id uint64, vector [][]float32) error {
+			m.processed.Add(1)
+			if len(vector) == 0 {
+				return nil
+			}
+			ids = append(ids, id)
+			vectors = append(vectors, vector)
+			if len(ids) < vectorIndexMigrationBatchSize {
+				return nil
+			}
+			mirror.addMultiToSecondary(ctx, ids, vectors)
+			ids, vectors = ids[:0], vectors[:0]
+			return ctx.Err()
+		})
+		if err != nil {
+			return err
+		}
+		mirror.addMultiToSecondary(ctx, ids, vectors)
+		return ctx.Err()
+	}
+
+	vectors := make([][]float32, 0, vectorIndexMigrationBatchSize)
+	err := s.iterateOnLSMVectors(ctx, 0, m.targetVector, func(id uint64, vector []float32) error {
+		m.processed.Add(1)
+		if len(vector) == 0 {
+			return nil
+		}
+		ids = append(ids, id)
+		vectors = append(vectors, vector)
+		if len(ids) < vectorIndexMigrationBatchSize {
+			return nil
+		}
+		mirror.addToSecondary(ctx, ids, vectors)
+		ids, vectors = ids[:0], vectors[:0]
+		return ctx.Err()
+	})
+	if err != nil {
+		return err
+	}
+	mirror.addToSecondary(ctx, ids, vectors)
+	return ctx.Err()
+}
+
+// swap makes the new index the active one. Once the new generation has been
+// recorded, the migration can't be aborted anymore, failing to clean up the
+// old generation is retried on the next startup.
+func (m *vectorIndexMigration) swap(ctx context.Context, q *VectorIndexQueue,
+	mirror *mirroredVectorIndex,
+) error {
+	s := m.shard
+	m.setStatus(vectorIndexMigrationSwapping)
+
+	// the config can't be updated while the index is swapped
+	m.configLock.Lock()
+	defer m.configLock.Unlock()
+
+	if err := mirror.secondary.Flush(); err != nil {
+		return errors.Wrap(err, "flush vector index")
+	}
+	if err := s.vectorIndexGenerations.store(
+		s.pathVectorIndexGeneration(m.targetVector, m.generation)).FlushMemtables(ctx); err != nil {
+		return errors.Wrap(err, "flush vector index store")
+	}
+
+	previous, _ := s.vectorIndexGenerations.active(m.targetVector)
+	previousCfg, err := previous.userConfig()
+	if err != nil {
+		return err
+	}
+	gen, err := newVectorIndexGeneration(m.generation, m.config)
+	if err != nil {
+		return err
+	}
+
+	old := mirror.primary
+	err = s.swapVectorIndex(q, m.targetVector, mirror.secondary, func() error {
+		return mirror.promote(func() error {
+			// a write that failed on the new index or a stop while it was
+			// flushed must abort the migration before it is recorded
+			if err := m.failure(); err != nil {
+				return err
+			}
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			return s.vectorIndexGenerations.setActive(m.targetVector, gen)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	// promoting the mirror waited for all searches and writes that reached
+	// the old index through it, so it is no longer in use
+	cleanupCtx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := s.dropVectorIndexGeneration(cleanupCtx, m.targetVector, previousCfg, old,
+		previous.Generation); err != nil {
+		m.logger.WithError(err).Warn("remove previous vector index")
+		return nil
+	}
+	if err := s.vectorIndexGenerations.clearObsolete(m.targetVector); err != nil {
+		m.logger.WithError(err).Warn("record removal of previous vector index")
+	}
+	return nil
+}
+
+// cleanup removes the index that was built, restoring the current index for
+// writes and reads if the mirror was installed already
+func (m *vectorIndexMigration) cleanup(mirror *mirroredVectorIndex, cause error) error {
+	s := m.shard
+
+	if mirror != nil {
+		s.swapVectorIndex(m.queue, m.targetVector, mirror.primary, func() error {
+			mirror.detach()
+			return nil
+		})
+	}
+
+	m.configLock.Lock()
+	index := m.index
+	m.index = nil
+	m.configLock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if index != nil {
+		if err := index.Shutdown(ctx); err != nil {
+			m.logger.WithError(err).Warn("shut down partially built vector index")
+		}
+	}
+	dir := s.pathVectorIndexGeneration(m.targetVector, m.generation)
+	if err := s.vectorIndexGenerations.removeStore(ctx, dir); err != nil {
+		m.logger.WithError(err).Warn("shut down store of partially built vector index")
+	}
+	if err := os.RemoveAll(dir); err != nil {
+		m.logger.WithError(err).Warn("remove partially built vector index")
+	}
+	return cause
+}
+
+// swapVectorIndex replaces the index of the target vector for both writes and
+// reads. Writes that are in progress or scheduled by the queue are applied to
+// the previous index first. beforeSwap, if set, is called once no write
+// reaches the index through the queue anymore.
+func (s *Shard) swapVectorIndex(q *VectorIndexQueue, targetVector string,
+	vectorIndex VectorIndex, beforeSwap func() error,
+) error {
+	q.Pause()
+	defer q.Resume()
+	q.Wait()
+
+	if beforeSwap != nil {
+		if err := beforeSwap(); err != nil {
+			return err
+		}
+	}
+	q.ResetWith(vectorIndex)
+	s.setVectorIndex(targetVector, vectorIndex)
+	return nil
+}
+
+func (s *Shard) setVectorIndex(targetVector string, vectorIndex VectorIndex) {
+	s.vectorIndexLock.Lock()
+	defer s.vectorIndexLock.Unlock()
+
+	if targetVector == "" {
+		s.vectorIndex = vectorIndex
+		return
+	}
+
+	vectorIndexes := make(map[string]VectorIndex, len(s.vectorIndexes))
+	for name, index := range s.vectorIndexes {
+		vectorIndexes[name] = index
+	}
+	vectorIndexes[targetVector] = vectorIndex
+	s.vectorIndexes = vectorIndexes
+}
+
+// updateVectorIndexMigration starts a migration if the update can't be
+// applied to the active index in place. It returns false if the update needs
+// to be applied in place, any migration that is still running for the target
+// vector is stopped in that case.
+func (s *Shard) updateVectorIndexMigration(targetVector string,
+	updated schemaConfig.VectorIndexConfig,
+) (bool, error) {
+	active, err := s.vectorIndexGenerations.activeConfig(targetVector)
+	if err != nil {
+		return false, err
+	}
+	if vectorIndexNeedsRebuild(active, updated) {
+		return true, s.startVectorIndexMigration(targetVector, updated)
+	}
+
+	s.vectorIndexMigrationsLock.Lock()
+	defer s.vectorIndexMigrationsLock.Unlock()
+
+	if m, ok := s.vectorIndexMigrations[targetVector]; ok {
+		m.stop()
+		delete(s.vectorIndexMigrations, targetVector)
+	}
+	return false, nil
+}
+
+// startVectorIndexMigration rebuilds the vector index of the target vector
+// with the given config in the background
+func (s *Shard) startVectorIndexMigration(targetVector string,
+	updated schemaConfig.VectorIndexConfig,
+) error {
+	if s.isBulkLoading() {
+		return fmt.Errorf("shard %s: cannot migrate vector index: %s", s.ID(), bulkLoadReason)
+	}
+
+	s.vectorIndexMigrationsLock.Lock()
+	defer s.vectorIndexMigrationsLock.Unlock()
+
+	if m, ok := s.vectorIndexMigrations[targetVector]; ok {
+		if m.running() && !vectorIndexNeedsRebuild(m.userConfig(), updated) {
+			return m.updateUserConfig(updated)
+		}
+		m.stop()
+		delete(s.vectorIndexMigrations, targetVector)
+	}
+
+	active, ok := s.vectorIndexGenerations.active(targetVector)
+	if !ok {
+		return fmt.Errorf("no active vector index for target vector %q", targetVector)
+	}
+	// the schema no longer has the config the active index was built with
+	// once the migration started, it has to be known if it is interrupted
+	if err := s.vectorIndexGenerations.save(); err != nil {
+		return err
+	}
+	queue, err := s.getIndexQueue(targetVector)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m := &vectorIndexMigration{
+		shard:        s,
+		queue:        queue,
+		targetVector: targetVector,
+		generation:   active.Generation + 1,
+		sourceType:   active.IndexType,
+		config:       updated,
+		status:       vectorIndexMigrationIndexing,
+		ctx:          ctx,
+		cancel:       cancel,
+		done:         make(chan struct{}),
+	}
+	m.logger = s.index.logger.WithFields(logrus.Fields{
+		"action":        "vector_index_migration",
+		"shard":         s.ID(),
+		"target_vector": targetVector,
+		"source_type":   active.IndexType,
+		"target_type":   updated.IndexType(),
+		"generation":    m.generation,
+	})
+	s.vectorIndexMigrations[targetVector] = m
+
+	enterrors.GoWrapper(m.run, s.index.logger)
+	return nil
+}
+
+// resumeVectorIndexMigrations picks up migrations that were interrupted by a
+// restart, the active index was built with a config that differs from the
+// one in the schema in that case
+func (s *Shard) resumeVectorIndexMigrations() error {
+	if s.isBulkLoading() {
+		// resumed once the bulk load has finished
+		return nil
+	}
+
+	configs := map[string]schemaConfig.VectorIndexConfig{}
+	s.index.vectorIndexUserConfigLock.Lock()
+	if s.hasTargetVectors() {
+		for targetVector, cfg := range s.index.vectorIndexUserConfigs {
+			configs[targetVector] = cfg
+		}
+	} else {
+		configs[""] = s.index.vectorIndexUserConfig
+	}
+	s.index.vectorIndexUserConfigLock.Unlock()
+
+	for targetVector, cfg := range configs {
+		active, err := s.vectorIndexGenerations.activeConfig(targetVector)
+		if err != nil || !vectorIndexNeedsRebuild(active, cfg) {
+			continue
+		}
+		if err := s.startVectorIndexMigration(targetVector, cfg); err != nil {
+			return errors.Wrapf(err, "resume vector index migration of %q", targetVector)
+		}
+	}
+	return nil
+}
+
+// stopVectorIndexMigrations stops all running migrations, the index that was
+// being built is removed. Migrations are restarted on the next startup.
+func (s *Shard) stopVectorIndexMigrations() {
+	s.vectorIndexMigrationsLock.Lock()
+	defer s.vectorIndexMigrationsLock.Unlock()
+
+	for targetVector, m := range s.vectorIndexMigrations {
+		m.stop()
+		delete(s.vectorIndexMigrations, targetVector)
+	}
+}
+
+func (s *Shard) hasRunningVectorIndexMigrations() bool {
+	s.vectorIndexMigrationsLock.Lock()
+	defer s.vectorIndexMigrationsLock.Unlock()
+
+	for _, m := range s.vectorIndexMigrations {
+		if m.running() {
+			return true
+		}
+	}
+	return false
+}
+
+// VectorIndexMigrationStatus returns the progress of migrations that are
+// running or have failed
+func (s *Shard) VectorIndexMigrationStatus() []*models.NodeShardVectorIndexMigration {
+	s.vectorIndexMigrationsLock.Lock()
+	defer s.vectorIndexMigrationsLock.Unlock()
+
+	var out []*models.NodeShardVectorIndexMigration
+	for _, m := range s.vectorIndexMigrations {
+		status := m.toModel()
+		// completed migrations are not reported anymore
+		if !m.running() && status.Status != vectorIndexMigrationFailed {
+			continue
+		}
+		out = append(out, status)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].TargetVector < out[j].TargetVector
+	})
+	return out
+}
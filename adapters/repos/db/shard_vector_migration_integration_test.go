@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build integrationTest
+
+package db
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/vectorindex/flat"
+	enthnsw "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestShard_VectorIndexMigration(t *testing.T) {
+	ctx := testCtx()
+	className := "TestClass"
+	class := &models.Class{Class: className}
+	shd, idx := testShardWithSettings(t, ctx, class, enthnsw.NewDefaultUserConfig(), false, false)
+	lazyShard := shd.(*LazyLoadShard)
+	require.Nil(t, lazyShard.Load(ctx))
+	shard := lazyShard.shard
+
+	var docIDs []uint64
+	put := func(t *testing.T, amount int) {
+		for i := 0; i < amount; i++ {
+			obj := testObject(className)
+			obj.Vector = randVector(8)
+			require.Nil(t, shard.PutObject(ctx, obj))
+			docIDs = append(docIDs, obj.DocID)
+		}
+	}
+
+	awaitMigrations := func(t *testing.T) {
+		assert.EventuallyWithT(t, func(ct *assert.CollectT) {
+			assert.False(ct, shard.hasRunningVectorIndexMigrations())
+		}, 30*time.Second, 10*time.Millisecond)
+		assert.Empty(t, shard.VectorIndexMigrationStatus())
+	}
+
+	assertIndexed := func(t *testing.T) {
+		for _, docID := range docIDs {
+			require.True(t, shard.VectorIndex().ContainsNode(docID), "doc id %d", docID)
+		}
+	}
+
+	put(t, 500)
+	require.True(t, hnsw.IsHNSWIndex(shard.VectorIndex()))
+
+	t.Run("migrate from hnsw to flat", func(t *testing.T) {
+		require.Nil(t, idx.updateVectorIndexConfig(ctx, flat.NewDefaultUserConfig()))
+		// writes are mirrored while the migration is running
+		put(t, 100)
+		awaitMigrations(t)
+
+		assert.False(t, hnsw.IsHNSWIndex(shard.VectorIndex()))
+		assert.DirExists(t, filepath.Join(shard.path(), "main.gen1.d"))
+		assert.NoDirExists(t, filepath.Join(shard.path(), "main.hnsw.commitlog.d"))
+		assertIndexed(t)
+
+		ids, _, err := shard.VectorIndex().SearchByVector(ctx, randVector(8), 10, nil)
+		require.Nil(t, err)
+		assert.Len(t, ids, 10)
+	})
+
+	t.Run("migrate back to hnsw", func(t *testing.T) {
+		require.Nil(t, idx.updateVectorIndexConfig(ctx, enthnsw.NewDefaultUserConfig()))
+		awaitMigrations(t)
+
+		assert.True(t, hnsw.IsHNSWIndex(shard.VectorIndex()))
+		assert.NoDirExists(t, filepath.Join(shard.path(), "main.gen1.d"))
+		assert.DirExists(t, filepath.Join(shard.path(), "main.gen2.d"))
+		assertIndexed(t)
+	})
+
+	t.Run("active generation survives a restart", func(t *testing.T) {
+		require.Nil(t, shard.Shutdown(ctx))
+
+		var err error
+		shard, err = NewShard(ctx, nil, shard.Name(), idx, class, idx.centralJobQueue,
+			idx.scheduler, idx.indexCheckpoints)
+		require.Nil(t, err)
+
+		assert.True(t, hnsw.IsHNSWIndex(shard.VectorIndex()))
+		assertIndexed(t)
+	})
+
+	t.Run("interrupted migration is resumed on startup", func(t *testing.T) {
+		require.Nil(t, shard.Shutdown(ctx))
+		// the schema was changed while the shard was not loaded
+		idx.vectorIndexUserConfig = flat.NewDefaultUserConfig()
+
+		var err error
+		shard, err = NewShard(ctx, nil, shard.Name(), idx, class, idx.centralJobQueue,
+			idx.scheduler, idx.indexCheckpoints)
+		require.Nil(t, err)
+		awaitMigrations(t)
+
+		assert.False(t, hnsw.IsHNSWIndex(shard.VectorIndex()))
+		assert.DirExists(t, filepath.Join(shard.path(), "main.gen3.d"))
+		assertIndexed(t)
+	})
+
+	t.Run("failed mirrored write aborts the swap", func(t *testing.T) {
+		active, ok := shard.vectorIndexGenerations.active("")
+		require.True(t, ok)
+		queue, err := shard.getIndexQueue("")
+		require.Nil(t, err)
+		current := shard.VectorIndex()
+
+		migrationCtx, cancel := context.WithCancel(ctx)
+		m := &vectorIndexMigration{
+			shard:      shard,
+			queue:      queue,
+			generation: active.Generation + 1,
+			sourceType: active.IndexType,
+			config:     enthnsw.NewDefaultUserConfig(),
+			logger:     idx.logger,
+			ctx:        migrationCtx,
+			cancel:     cancel,
+			done:       make(chan struct{}),
+		}
+		dir := shard.pathVectorIndexGeneration("", m.generation)
+		_, store, err := shard.vectorIndexGenerationStore("", m.generation)
+		require.Nil(t, err)
+		m.index, err = shard.initVectorIndexAt(ctx, "", m.config, dir, store)
+		require.Nil(t, err)
+
+		mirror := newMirroredVectorIndex(current, m.index, m.fail)
+		require.Nil(t, shard.swapVectorIndex(queue, "", mirror, nil))
+		require.Nil(t, m.backfill(migrationCtx, mirror))
+
+		// the write failed on the new index after it was backfilled
+		m.fail(errors.New("add to new index"))
+		err = m.swap(context.Background(), queue, mirror)
+		require.ErrorContains(t, err, "add to new index")
+
+		after, ok := shard.vectorIndexGenerations.active("")
+		require.True(t, ok)
+		assert.Equal(t, active.Generation, after.Generation)
+		assert.Same(t, mirror, shard.VectorIndex())
+
+		m.cleanup(mirror, err)
+		assert.Same(t, current, shard.VectorIndex())
+		assert.NoDirExists(t, dir)
+		assertIndexed(t)
+	})
+
+	t.Run("multi vector setting can't be changed", func(t *testing.T) {
+		cfg := enthnsw.NewDefaultUserConfig()
+		cfg.Multivector.Enabled = true
+		m := &Migrator{db: nil}
+		assert.ErrorContains(t, m.ValidateVectorIndexConfigUpdate(flat.NewDefaultUserConfig(), cfg),
+			"multi vector setting is immutable")
+	})
+
+	require.Nil(t, shard.Shutdown(ctx))
+	require.Nil(t, os.RemoveAll(shard.path()))
+}
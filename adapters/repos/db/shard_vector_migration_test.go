@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestVectorIndexNeedsRebuild(t *testing.T) {
+	hnswWith := func(mod func(cfg *hnswent.UserConfig)) hnswent.UserConfig {
+		cfg := hnswent.NewDefaultUserConfig()
+		mod(&cfg)
+		return cfg
+	}
+	flatWith := func(mod func(cfg *flatent.UserConfig)) flatent.UserConfig {
+		cfg := flatent.NewDefaultUserConfig()
+		mod(&cfg)
+		return cfg
+	}
+
+	tests := []struct {
+		name     string
+		current  schemaConfig.VectorIndexConfig
+		updated  schemaConfig.VectorIndexConfig
+		expected bool
+	}{
+		{
+			name:     "same hnsw config",
+			current:  hnswent.NewDefaultUserConfig(),
+			updated:  hnswent.NewDefaultUserConfig(),
+			expected: false,
+		},
+		{
+			name:     "mutable hnsw setting",
+			current:  hnswent.NewDefaultUserConfig(),
+			updated:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.EF = 500 }),
+			expected: false,
+		},
+		{
+			name:     "index type",
+			current:  hnswent.NewDefaultUserConfig(),
+			updated:  flatent.NewDefaultUserConfig(),
+			expected: true,
+		},
+		{
+			name:     "enable compression of hnsw",
+			current:  hnswent.NewDefaultUserConfig(),
+			updated:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.PQ.Enabled = true }),
+			expected: false,
+		},
+		{
+			name:     "switch hnsw from pq to bq",
+			current:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.PQ.Enabled = true }),
+			updated:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.BQ.Enabled = true }),
+			expected: true,
+		},
+		{
+			name:     "switch hnsw from pq to sq",
+			current:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.PQ.Enabled = true }),
+			updated:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.SQ.Enabled = true }),
+			expected: true,
+		},
+		{
+			name:     "disable compression of hnsw",
+			current:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.SQ.Enabled = true }),
+			updated:  hnswent.NewDefaultUserConfig(),
+			expected: true,
+		},
+		{
+			name:     "change rq bits of hnsw",
+			current:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.RQ.Enabled = true }),
+			updated:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.RQ.Enabled = true; cfg.RQ.Bits = 4 }),
+			expected: true,
+		},
+		{
+			name:     "change rq rescore limit of hnsw",
+			current:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.RQ.Enabled = true }),
+			updated:  hnswWith(func(cfg *hnswent.UserConfig) { cfg.RQ.Enabled = true; cfg.RQ.RescoreLimit = 100 }),
+			expected: false,
+		},
+		{
+			name:     "enable rq of flat",
+			current:  flatent.NewDefaultUserConfig(),
+			updated:  flatWith(func(cfg *flatent.UserConfig) { cfg.RQ.Enabled = true }),
+			expected: true,
+		},
+		{
+			name:     "enable bq of flat",
+			current:  flatent.NewDefaultUserConfig(),
+			updated:  flatWith(func(cfg *flatent.UserConfig) { cfg.BQ.Enabled = true }),
+			expected: true,
+		},
+		{
+			name:     "mutable flat setting",
+			current:  flatWith(func(cfg *flatent.UserConfig) { cfg.BQ.Enabled = true }),
+			updated:  flatWith(func(cfg *flatent.UserConfig) { cfg.BQ.Enabled = true; cfg.BQ.RescoreLimit = 100 }),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, vectorIndexNeedsRebuild(test.current, test.updated))
+		})
+	}
+}
+
+func TestValidateVectorIndexMigration(t *testing.T) {
+	multiVector := hnswent.NewDefaultUserConfig()
+	multiVector.Multivector.Enabled = true
+
+	assert.Nil(t, validateVectorIndexMigration(hnswent.NewDefaultUserConfig(), flatent.NewDefaultUserConfig()))
+	assert.ErrorContains(t, validateVectorIndexMigration(multiVector, flatent.NewDefaultUserConfig()),
+		"multi vector setting is immutable")
+
+	float16 := flatent.NewDefaultUserConfig()
+	float16.DataType = "float16"
+	assert.ErrorContains(t, validateVectorIndexMigration(hnswent.NewDefaultUserConfig(), float16),
+		"dataType is immutable")
+}
+
+func TestParseVectorIndexGenerationDir(t *testing.T) {
+	tests := []struct {
+		name       string
+		id         string
+		generation uint64
+		ok         bool
+	}{
+		{name: "main.gen1.d", id: "main", generation: 1, ok: true},
+		{name: "vectors_custom.gen12.d", id: "vectors_custom", generation: 12, ok: true},
+		{name: "vectors_my.gen.vector.gen3.d", id: "vectors_my.gen.vector", generation: 3, ok: true},
+		{name: "main.gen0.d", ok: false},
+		{name: "main.hnsw.commitlog.d", ok: false},
+		{name: "main.queue.d", ok: false},
+		{name: "lsm", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			id, generation, ok := parseVectorIndexGenerationDir(test.name)
+			assert.Equal(t, test.ok, ok)
+			if test.ok {
+				assert.Equal(t, test.id, id)
+				assert.Equal(t, test.generation, generation)
+			}
+		})
+	}
+}
@@ -69,6 +69,12 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property, nilProps []i
 }
 
 func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property) error {
+	if property.HasFilterableIndex || property.HasSearchableIndex {
+		for _, item := range property.Items {
+			s.termDictionaries.Add(property.Name, item.Data)
+		}
+	}
+
 	if property.HasFilterableIndex {
 		bucketValue := s.store.Bucket(helpers.BucketFromPropNameLSM(property.Name))
 		if bucketValue == nil {
@@ -109,6 +115,24 @@ func (s *Shard) addToPropertyValueIndex(docID uint64, property inverted.Property
 		}
 	}
 
+	if property.HasPositionIndex {
+		bucketValue := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(property.Name))
+		if bucketValue == nil {
+			return errors.Errorf("no bucket positions for prop '%s' found", property.Name)
+		}
+
+		for _, item := range property.Items {
+			key := item.Data
+			pair := lsmkv.MapPair{
+				Key:   inverted.PositionsDocIDKey(docID),
+				Value: inverted.EncodePositions(item.Positions),
+			}
+			if err := s.addToPropertyMapBucket(bucketValue, pair, key); err != nil {
+				return errors.Wrapf(err, "failed adding to prop '%s' positions bucket", property.Name)
+			}
+		}
+	}
+
 	if property.HasRangeableIndex {
 		bucketValue := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(property.Name))
 		if bucketValue == nil {
@@ -54,6 +54,19 @@ func (s *Shard) deleteFromInvertedIndicesLSM(props []inverted.Property, nilProps
 			}
 		}
 
+		if prop.HasPositionIndex {
+			bucket := s.store.Bucket(helpers.BucketPositionsFromPropNameLSM(prop.Name))
+			if bucket == nil {
+				return fmt.Errorf("no bucket positions for prop %q found", prop.Name)
+			}
+			for _, item := range prop.Items {
+				if err := bucket.MapDeleteKey(item.Data, inverted.PositionsDocIDKey(docID)); err != nil {
+					return errors.Wrapf(err, "delete item '%s' from positions index",
+						string(item.Data))
+				}
+			}
+		}
+
 		if prop.HasRangeableIndex {
 			bucket := s.store.Bucket(helpers.BucketRangeableFromPropNameLSM(prop.Name))
 			if bucket == nil {
@@ -18,6 +18,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/entities/dto"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/storobj"
 	"github.com/weaviate/weaviate/usecases/objects"
@@ -49,6 +50,14 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 				if err != nil {
 					return errors.Wrapf(err, "Validate multi vector index for update of %v for target vector %s", merge.ID, targetVector)
 				}
+			case models.SparseVector:
+				sparseIndex, ok := vectorIndex.(SparseVectorIndex)
+				if !ok {
+					return errors.Errorf("Validate vector index for update of %v for target vector %s: not a sparse vector index", merge.ID, targetVector)
+				}
+				if err := sparseIndex.ValidateSparseBeforeInsert(v); err != nil {
+					return errors.Wrapf(err, "Validate sparse vector index for update of %v for target vector %s", merge.ID, targetVector)
+				}
 			default:
 				return errors.Errorf("Validate vector index for update of %v for target vector %s: unrecongnized vector type: %T", merge.ID, targetVector, vector)
 			}
@@ -56,7 +65,7 @@ func (s *Shard) MergeObject(ctx context.Context, merge objects.MergeDocument) er
 	} else {
 		if merge.Vector != nil {
 			// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
-			err := s.vectorIndex.ValidateBeforeInsert(merge.Vector)
+			err := s.VectorIndex().ValidateBeforeInsert(merge.Vector)
 			if err != nil {
 				return errors.Wrapf(err, "Validate vector index for update of %v", merge.ID)
 			}
@@ -156,6 +165,7 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 			return nil
 		}
 
+		obj.VectorDataTypes = s.index.vectorDataTypes()
 		objBytes, err := obj.MarshalBinary()
 		if err != nil {
 			return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -176,6 +186,10 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, status, errors.Wrap(err, "update inverted indices")
 	}
 
+	if err := s.updateSparseVectorIndexes(context.Background(), obj, status); err != nil {
+		return nil, status, errors.Wrap(err, "update sparse vector indices")
+	}
+
 	return obj, status, nil
 }
 
@@ -235,6 +249,7 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	out.status = status
 
 	obj.DocID = status.docID // is not changed
+	obj.VectorDataTypes = s.index.vectorDataTypes()
 	objBytes, err := obj.MarshalBinary()
 	if err != nil {
 		return out, errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -310,9 +325,11 @@ func mergeProps(previous *storobj.Object,
 	if len(merge.Vectors) == 0 {
 		next.Vectors = previous.Vectors
 		next.MultiVectors = previous.MultiVectors
+		next.SparseVectors = previous.SparseVectors
 	} else {
 		next.Vectors = vectorsAsMap(merge.Vectors)
 		next.MultiVectors = multiVectorsAsMap(merge.Vectors)
+		next.SparseVectors = dto.GetSparseVectors(merge.Vectors)
 	}
 
 	next.Object.LastUpdateTimeUnix = merge.UpdateTime
@@ -180,7 +180,7 @@ func (s *Shard) updateMultiVectorIndexesIgnoreDelete(ctx context.Context,
 func (s *Shard) updateVectorIndex(ctx context.Context, vector []float32,
 	status objectInsertStatus,
 ) error {
-	return updateVectorInVectorIndex(ctx, vector, status, s.queue, s.vectorIndex)
+	return updateVectorInVectorIndex(ctx, vector, status, s.queue, s.VectorIndex())
 }
 
 func (s *Shard) updateVectorIndexForName(ctx context.Context, vector []float32,
@@ -256,10 +256,13 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 				}
 			}
 		}
+		if err := s.validateSparseVectors(obj); err != nil {
+			return status, errors.Wrapf(err, "Validate sparse vectors of %s", obj.ID())
+		}
 	} else {
 		if obj.Vector != nil {
 			// validation needs to happen before any changes are done. Otherwise, insertion is aborted somewhere in-between.
-			err := s.vectorIndex.ValidateBeforeInsert(obj.Vector)
+			err := s.VectorIndex().ValidateBeforeInsert(obj.Vector)
 			if err != nil {
 				return status, errors.Wrapf(err, "Validate vector index for %s", obj.ID())
 			}
@@ -298,6 +301,7 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 			return nil
 		}
 
+		obj.VectorDataTypes = s.index.vectorDataTypes()
 		objBinary, err := obj.MarshalBinary()
 		if err != nil {
 			return errors.Wrapf(err, "marshal object %s to binary", obj.ID())
@@ -322,6 +326,10 @@ func (s *Shard) putObjectLSM(obj *storobj.Object, idBytes []byte,
 	}
 	s.metrics.PutObjectUpdateInverted(before)
 
+	if err := s.updateSparseVectorIndexes(context.Background(), obj, status); err != nil {
+		return objectInsertStatus{}, errors.Wrap(err, "update sparse vector indices")
+	}
+
 	return status, nil
 }
 
@@ -612,6 +620,9 @@ func compareObjsForInsertStatus(prevObj, nextObj *storobj.Object) (preserve, ski
 	if !targetMultiVectorsEqual(prevObj.MultiVectors, nextObj.MultiVectors) {
 		return false, false
 	}
+	if !targetSparseVectorsEqual(prevObj.SparseVectors, nextObj.SparseVectors) {
+		return false, false
+	}
 	if !addPropsEqual(prevObj.Object.Additional, nextObj.Object.Additional) {
 		return true, false
 	}
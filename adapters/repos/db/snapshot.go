@@ -0,0 +1,53 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// OpenSnapshot opens a point-in-time snapshot of the objects of a class (or
+// a single tenant) and returns its token. Setting the token as
+// [additional.Properties.Snapshot] makes cursor listings read the objects
+// from the snapshot, so that a paginated export reflects a single consistent
+// moment in time. Filtered, sorted, keyword and vector searches are rejected
+// with [ErrSnapshotUnsupported]. The snapshot is released automatically after
+// ttl.
+func (db *DB) OpenSnapshot(ctx context.Context, class, tenant string, ttl time.Duration) (string, error) {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return "", fmt.Errorf("index for class %q not found", class)
+	}
+	return index.openSnapshot(ctx, tenant, ttl)
+}
+
+// ReleaseSnapshot releases a snapshot opened using [DB.OpenSnapshot] before
+// its ttl expires.
+func (db *DB) ReleaseSnapshot(ctx context.Context, class, tenant, token string) error {
+	index := db.GetIndex(schema.ClassName(class))
+	if index == nil {
+		return fmt.Errorf("index for class %q not found", class)
+	}
+	if err := index.releaseSnapshot(ctx, tenant, token); err != nil {
+		if errors.Is(err, ErrSnapshotNotFound) {
+			return objects.NewErrNotFound("snapshot %q of class %q not found or expired", token, class)
+		}
+		return err
+	}
+	return nil
+}
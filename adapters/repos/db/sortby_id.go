@@ -20,6 +20,7 @@ import (
 type sortByID struct {
 	objects []*storobj.Object
 	scores  []float32
+	desc    bool
 }
 
 func (s *sortByID) Swap(i, j int) {
@@ -30,6 +31,9 @@ func (s *sortByID) Swap(i, j int) {
 }
 
 func (s *sortByID) Less(i, j int) bool {
+	if s.desc {
+		return s.objects[i].ID() > s.objects[j].ID()
+	}
 	return s.objects[i].ID() < s.objects[j].ID()
 }
 
@@ -37,15 +41,17 @@ func (s *sortByID) Len() int {
 	return len(s.objects)
 }
 
-type sortObjectsByID struct{}
+type sortObjectsByID struct {
+	desc bool
+}
 
-func newIDSorter() *sortObjectsByID {
-	return &sortObjectsByID{}
+func newIDSorter(desc bool) *sortObjectsByID {
+	return &sortObjectsByID{desc: desc}
 }
 
 func (s *sortObjectsByID) sort(objects []*storobj.Object, scores []float32,
 ) ([]*storobj.Object, []float32) {
-	sbd := &sortByID{objects, scores}
+	sbd := &sortByID{objects, scores, s.desc}
 	sort.Sort(sbd)
 	return sbd.objects, sbd.scores
 }
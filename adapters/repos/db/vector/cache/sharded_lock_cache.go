@@ -423,19 +423,23 @@ type CacheKeys struct {
 	RelativeID uint64
 }
 
-type shardedMultipleLockCache[T float32 | uint64] struct {
+type shardedMultipleLockCache[T float32 | uint64 | byte] struct {
 	shardedLocks        *common.ShardedRWLocks
 	cache               [][][]T
 	multipleVectorForID common.MultipleVectorForID[T]
-	normalizeOnRead     bool
-	maxSize             int64
-	count               int64
-	ctx                 context.Context
-	cancelFn            func()
-	logger              logrus.FieldLogger
-	deletionInterval    time.Duration
-	allocChecker        memwatch.AllocChecker
-	vectorDocID         []CacheKeys
+	// vectorForNodeID is set for caches of compressed vectors. Those are
+	// persisted per node rather than per document, so a cache miss has to be
+	// served by node id.
+	vectorForNodeID  common.VectorForID[T]
+	normalizeOnRead  bool
+	maxSize          int64
+	count            int64
+	ctx              context.Context
+	cancelFn         func()
+	logger           logrus.FieldLogger
+	deletionInterval time.Duration
+	allocChecker     memwatch.AllocChecker
+	vectorDocID      []CacheKeys
 
 	// The maintenanceLock makes sure that only one maintenance operation, such
 	// as growing the cache or clearing the cache happens at the same time.
@@ -483,34 +487,40 @@ func NewShardedMultiFloat32LockCache(multipleVecForID common.VectorForID[[]float
 	return vc
 }
 
-func NewShardedMultiUInt64LockCache(multipleVecForID common.VectorForID[uint64], maxSize int,
+func NewShardedMultiUInt64LockCache(vecForNodeID common.VectorForID[uint64], maxSize int,
 	logger logrus.FieldLogger, deletionInterval time.Duration,
 	allocChecker memwatch.AllocChecker,
 ) Cache[uint64] {
-	multipleVecForIDValue := func(ctx context.Context, id uint64, relativeID uint64) ([]uint64, error) {
-		vec, err := multipleVecForID(ctx, id)
-		if err != nil {
-			return nil, err
-		}
-		return vec, nil
-	}
+	return newShardedMultiCompressedLockCache(vecForNodeID, maxSize, logger, deletionInterval, allocChecker)
+}
+
+func NewShardedMultiByteLockCache(vecForNodeID common.VectorForID[byte], maxSize int,
+	logger logrus.FieldLogger, deletionInterval time.Duration,
+	allocChecker memwatch.AllocChecker,
+) Cache[byte] {
+	return newShardedMultiCompressedLockCache(vecForNodeID, maxSize, logger, deletionInterval, allocChecker)
+}
 
-	cache := make([][][]uint64, InitialSize)
+func newShardedMultiCompressedLockCache[T uint64 | byte](vecForNodeID common.VectorForID[T], maxSize int,
+	logger logrus.FieldLogger, deletionInterval time.Duration,
+	allocChecker memwatch.AllocChecker,
+) *shardedMultipleLockCache[T] {
+	cache := make([][][]T, InitialSize)
 	for i := range cache {
-		cache[i] = make([][]uint64, RelativeInitialSize)
+		cache[i] = make([][]T, RelativeInitialSize)
 	}
 
-	vc := &shardedMultipleLockCache[uint64]{
-		multipleVectorForID: multipleVecForIDValue,
-		cache:               cache,
-		count:               0,
-		maxSize:             int64(maxSize),
-		logger:              logger,
-		shardedLocks:        common.NewDefaultShardedRWLocks(),
-		maintenanceLock:     sync.RWMutex{},
-		deletionInterval:    deletionInterval,
-		allocChecker:        allocChecker,
-		vectorDocID:         make([]CacheKeys, InitialSize),
+	vc := &shardedMultipleLockCache[T]{
+		vectorForNodeID:  vecForNodeID,
+		cache:            cache,
+		count:            0,
+		maxSize:          int64(maxSize),
+		logger:           logger,
+		shardedLocks:     common.NewDefaultShardedRWLocks(),
+		maintenanceLock:  sync.RWMutex{},
+		deletionInterval: deletionInterval,
+		allocChecker:     allocChecker,
+		vectorDocID:      make([]CacheKeys, InitialSize),
 	}
 
 	vc.ctx, vc.cancelFn = context.WithCancel(context.Background())
@@ -549,7 +559,7 @@ func (s *shardedMultipleLockCache[T]) GetKeysNoLock(id uint64) (uint64, uint64)
 func (s *shardedMultipleLockCache[T]) Get(ctx context.Context, id uint64) ([]T, error) {
 	s.shardedLocks.RLock(id)
 	docID, relativeID := s.GetKeysNoLock(id)
-	docVecs := s.cache[docID]
+	docVecs := s.docVectorsNoLock(docID)
 	s.shardedLocks.RUnlock(id)
 
 	if len(docVecs) <= int(relativeID) || docVecs[relativeID] == nil || len(docVecs[relativeID]) == 0 {
@@ -559,6 +569,13 @@ func (s *shardedMultipleLockCache[T]) Get(ctx context.Context, id uint64) ([]T,
 	return docVecs[relativeID], nil
 }
 
+func (s *shardedMultipleLockCache[T]) docVectorsNoLock(docID uint64) [][]T {
+	if docID >= uint64(len(s.cache)) {
+		return nil
+	}
+	return s.cache[docID]
+}
+
 func (s *shardedMultipleLockCache[T]) MultiGet(ctx context.Context, ids []uint64) ([][]T, []error) {
 	out := make([][]T, len(ids))
 	errs := make([]error, len(ids))
@@ -566,7 +583,7 @@ func (s *shardedMultipleLockCache[T]) MultiGet(ctx context.Context, ids []uint64
 	for i, id := range ids {
 		s.shardedLocks.RLock(id)
 		docID, relativeID := s.GetKeysNoLock(id)
-		docVecs := s.cache[docID]
+		docVecs := s.docVectorsNoLock(docID)
 		s.shardedLocks.RUnlock(id)
 
 		var vec []T
@@ -594,7 +611,8 @@ func (s *shardedMultipleLockCache[T]) Delete(ctx context.Context, id uint64) {
 
 	docID, relativeID := s.GetKeysNoLock(id)
 
-	if s.cache[docID][relativeID] == nil {
+	docVecs := s.docVectorsNoLock(docID)
+	if len(docVecs) <= int(relativeID) || docVecs[relativeID] == nil {
 		return
 	}
 
@@ -624,22 +642,39 @@ func (s *shardedMultipleLockCache[T]) handleMultipleCacheMiss(ctx context.Contex
 		}
 	}
 
-	vec, err := s.multipleVectorForID(ctx, docID, relativeID)
+	var vec []T
+	var err error
+	if s.vectorForNodeID != nil {
+		vec, err = s.vectorForNodeID(ctx, id)
+	} else {
+		vec, err = s.multipleVectorForID(ctx, docID, relativeID)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	atomic.AddInt64(&s.count, 1)
 	s.shardedLocks.Lock(id)
+	s.preloadNoLock(docID, relativeID, vec)
+	s.shardedLocks.Unlock(id)
+
+	return vec, nil
+}
+
+func (s *shardedMultipleLockCache[T]) preloadNoLock(docID, relativeID uint64, vec []T) {
+	if docID >= uint64(len(s.cache)) {
+		// the cache has not grown to this document yet, the vector will be
+		// loaded on the next miss
+		return
+	}
 	if len(s.cache[docID]) <= int(relativeID) {
 		newCacheLine := make([][]T, relativeID+MinimumIndexGrowthDelta)
 		copy(newCacheLine, s.cache[docID])
 		s.cache[docID] = newCacheLine
 	}
+	if s.cache[docID][relativeID] == nil {
+		atomic.AddInt64(&s.count, 1)
+	}
 	s.cache[docID][relativeID] = vec
-	s.shardedLocks.Unlock(id)
-
-	return vec, nil
 }
 
 func (s *shardedMultipleLockCache[T]) LockAll() {
@@ -655,6 +690,9 @@ func (s *shardedMultipleLockCache[T]) Prefetch(id uint64) {
 	docID, _ := s.GetKeysNoLock(id)
 	defer s.shardedLocks.RUnlock(id)
 
+	if docID >= uint64(len(s.cache)) {
+		return
+	}
 	prefetchFunc(uintptr(unsafe.Pointer(&s.cache[docID])))
 }
 
@@ -669,12 +707,22 @@ func (s *shardedMultipleLockCache[T]) PreloadMulti(docID uint64, ids []uint64, v
 	}
 }
 
-func (s *shardedMultipleLockCache[T]) Preload(docID uint64, vec []T) {
-	panic("not implemented")
+// Preload a single vector by its node id. The keys of the node need to be
+// set already, e.g. when prefilling the cache after the doc mappings were
+// restored.
+func (s *shardedMultipleLockCache[T]) Preload(id uint64, vec []T) {
+	s.shardedLocks.Lock(id)
+	defer s.shardedLocks.Unlock(id)
+
+	s.PreloadNoLock(id, vec)
 }
 
-func (s *shardedMultipleLockCache[T]) PreloadNoLock(docID uint64, vec []T) {
-	panic("not implemented")
+func (s *shardedMultipleLockCache[T]) PreloadNoLock(id uint64, vec []T) {
+	if id >= uint64(len(s.vectorDocID)) {
+		return
+	}
+	docID, relativeID := s.GetKeysNoLock(id)
+	s.preloadNoLock(docID, relativeID, vec)
 }
 
 func (s *shardedMultipleLockCache[T]) SetSizeAndGrowNoLock(size uint64) {
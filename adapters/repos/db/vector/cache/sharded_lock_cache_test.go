@@ -249,3 +249,51 @@ func TestGetAllInCurrentLock(t *testing.T) {
 		}
 	})
 }
+
+func TestMultiCompressedCache(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	// compressed vectors are persisted per node, node 7 is the second vector
+	// of document 3
+	stored := map[uint64][]byte{6: {1, 2}, 7: {3, 4}}
+	vecForNodeID := func(ctx context.Context, id uint64) ([]byte, error) {
+		vec, ok := stored[id]
+		if !ok {
+			return nil, errors.New("not found")
+		}
+		return vec, nil
+	}
+
+	vectorCache := NewShardedMultiByteLockCache(vecForNodeID, 1_000_000, logger, 0, nil)
+	vectorCache.Grow(7)
+	vectorCache.GrowMultiCache(3)
+	vectorCache.SetKeys(6, 3, 0)
+	vectorCache.SetKeys(7, 3, 1)
+
+	t.Run("cache miss is served by node id", func(t *testing.T) {
+		vec, err := vectorCache.Get(ctx, 7)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{3, 4}, vec)
+		assert.Equal(t, int64(1), vectorCache.CountVectors())
+	})
+
+	t.Run("preload by node id", func(t *testing.T) {
+		vectorCache.Preload(6, []byte{5, 6})
+		vecs, errs := vectorCache.MultiGet(ctx, []uint64{6, 7})
+		assert.Nil(t, errs[0])
+		assert.Nil(t, errs[1])
+		assert.Equal(t, [][]byte{{5, 6}, {3, 4}}, vecs)
+		assert.Equal(t, int64(2), vectorCache.CountVectors())
+	})
+
+	t.Run("document outside of the cache", func(t *testing.T) {
+		stored[100] = []byte{7, 8}
+		vectorCache.Grow(100)
+		vectorCache.SetKeys(100, 5000, 0)
+		vec, err := vectorCache.Get(ctx, 100)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{7, 8}, vec)
+		vectorCache.Delete(ctx, 100)
+	})
+}
@@ -18,6 +18,9 @@ const (
 	IndexTypeFlat    = "flat"
 	IndexTypeNoop    = "noop"
 	IndexTypeDynamic = "dynamic"
+	IndexTypeDiskANN = "diskann"
+	IndexTypeIVF     = "ivf"
+	IndexTypeSparse  = "sparse"
 )
 
 type IndexStats interface {
@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Metadata is the bolt file in which a vector index keeps the little state
+// that doesn't belong into its buckets, such as the dimensions or codebooks.
+// It is only written when that state changes, so the file is opened for the
+// duration of each read or write rather than held open.
+type Metadata struct {
+	sync.Mutex
+	rootPath string
+	fileName string
+	bucket   []byte
+}
+
+// NewMetadata returns the metadata of an index of the given type, which
+// names both the file and the bucket within it
+func NewMetadata(rootPath, indexType, targetVector string) *Metadata {
+	fileName := fmt.Sprintf("%s.db", indexType)
+	if targetVector != "" {
+		// This may be redundant as target vector is already validated in the schema
+		cleanTarget := filepath.Base(filepath.Clean(targetVector))
+		fileName = fmt.Sprintf("%s_%s.db", indexType, cleanTarget)
+	}
+	return &Metadata{
+		rootPath: rootPath,
+		fileName: fileName,
+		bucket:   []byte(indexType),
+	}
+}
+
+func (m *Metadata) path() string {
+	return filepath.Join(m.rootPath, m.fileName)
+}
+
+func (m *Metadata) with(fn func(db *bolt.DB) error) error {
+	m.Lock()
+	defer m.Unlock()
+
+	db, err := bolt.Open(m.path(), 0o600, nil)
+	if err != nil {
+		return errors.Wrapf(err, "open %q", m.path())
+	}
+	defer db.Close()
+
+	return fn(db)
+}
+
+// Put writes all values in a single transaction, a nil value deletes the key
+func (m *Metadata) Put(values map[string][]byte) error {
+	return m.with(func(db *bolt.DB) error {
+		return db.Update(func(tx *bolt.Tx) error {
+			b, err := tx.CreateBucketIfNotExists(m.bucket)
+			if err != nil {
+				return errors.Wrap(err, "create bucket")
+			}
+			for key, value := range values {
+				if value == nil {
+					err = b.Delete([]byte(key))
+				} else {
+					err = b.Put([]byte(key), value)
+				}
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	})
+}
+
+// Get reads the given keys, missing keys are left out of the result
+func (m *Metadata) Get(keys ...string) (map[string][]byte, error) {
+	values := make(map[string][]byte, len(keys))
+	err := m.with(func(db *bolt.DB) error {
+		return db.View(func(tx *bolt.Tx) error {
+			b := tx.Bucket(m.bucket)
+			if b == nil {
+				return nil
+			}
+			for _, key := range keys {
+				// values are only valid for the duration of the transaction
+				if value := b.Get([]byte(key)); value != nil {
+					values[key] = bytes.Clone(value)
+				}
+			}
+			return nil
+		})
+	})
+	return values, err
+}
+
+// Remove deletes the file, it is not an error if it doesn't exist
+func (m *Metadata) Remove() error {
+	err := os.Remove(m.path())
+	if err != nil && !os.IsNotExist(err) {
+		return errors.Wrapf(err, "remove metadata file %q", m.path())
+	}
+	return nil
+}
+
+// ListFiles returns the path of the file relative to basePath if it exists,
+// for backups
+func (m *Metadata) ListFiles(basePath string) ([]string, error) {
+	if _, err := os.Stat(m.path()); err != nil {
+		// If the file doesn't exist, we simply don't add it to the list
+		return nil, nil
+	}
+	relPath, err := filepath.Rel(basePath, m.path())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get relative path: %w", err)
+	}
+	return []string{relPath}, nil
+}
@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"context"
+	"math"
+)
+
+type rescoreMultiplierKey struct{}
+
+// ContextWithRescoreMultiplier sets how many candidates are rescored with the
+// uncompressed vectors by indexes that search on compressed vectors. For a
+// search with limit k, k*multiplier candidates are rescored. A multiplier
+// below 1 leaves the index defaults in place.
+func ContextWithRescoreMultiplier(ctx context.Context, multiplier float64) context.Context {
+	if multiplier < 1 {
+		return ctx
+	}
+	return context.WithValue(ctx, rescoreMultiplierKey{}, multiplier)
+}
+
+// RescoreLimitFromContext returns the number of candidates to rescore for a
+// search with limit k, or 0 if no rescore multiplier was set for the query.
+func RescoreLimitFromContext(ctx context.Context, k int) int {
+	multiplier, ok := ctx.Value(rescoreMultiplierKey{}).(float64)
+	if !ok || k <= 0 {
+		return 0
+	}
+	return int(math.Ceil(float64(k) * multiplier))
+}
@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRescoreLimitFromContext(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, 0, RescoreLimitFromContext(ctx, 10))
+	assert.Equal(t, 0, RescoreLimitFromContext(ContextWithRescoreMultiplier(ctx, 0.5), 10))
+	assert.Equal(t, 10, RescoreLimitFromContext(ContextWithRescoreMultiplier(ctx, 1), 10))
+	assert.Equal(t, 25, RescoreLimitFromContext(ContextWithRescoreMultiplier(ctx, 2.5), 10))
+	assert.Equal(t, 5, RescoreLimitFromContext(ContextWithRescoreMultiplier(ctx, 1.5), 3))
+	assert.Equal(t, 0, RescoreLimitFromContext(ContextWithRescoreMultiplier(ctx, 4), 0))
+}
@@ -11,6 +11,14 @@
 
 package common
 
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
 const (
 	// DefaultSearchByDistInitialLimit :
 	// the initial limit of 100 here is an
@@ -46,6 +54,14 @@ func NewSearchByDistParams(
 	}
 }
 
+// NewDefaultSearchByDistParams starts at the default initial limit
+func NewDefaultSearchByDistParams(maxLimit int64) *SearchByDistParams {
+	initialOffset := 0
+	initialLimit := DefaultSearchByDistInitialLimit
+
+	return NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
+}
+
 func (params *SearchByDistParams) TotalLimit() int {
 	return params.totalLimit
 }
@@ -81,3 +97,76 @@ func (params *SearchByDistParams) MaxLimitReached() bool {
 
 	return int64(params.totalLimit) > params.maximumSearchLimit
 }
+
+// SearchByVectorDistance serves a search by distance with an index which can
+// only search for the k nearest vectors: k is widened until a result beyond
+// targetDistance shows up or maxLimit is reached.
+func SearchByVectorDistance(ctx context.Context, targetDistance float32, maxLimit int64,
+	search func(ctx context.Context, k int) ([]uint64, []float32, error),
+	logger logrus.FieldLogger,
+) ([]uint64, []float32, error) {
+	var (
+		searchParams = NewDefaultSearchByDistParams(maxLimit)
+
+		resultIDs  []uint64
+		resultDist []float32
+	)
+
+	recursiveSearch := func() (bool, error) {
+		totalLimit := searchParams.TotalLimit()
+		ids, dist, err := search(ctx, totalLimit)
+		if err != nil {
+			return false, errors.Wrap(err, "vector search")
+		}
+
+		// if there is less results than given limit search can be stopped
+		shouldContinue := !(len(ids) < totalLimit)
+
+		// ensures the indexes aren't out of range
+		offsetCap := searchParams.OffsetCapacity(ids)
+		totalLimitCap := searchParams.TotalLimitCapacity(ids)
+
+		if offsetCap == totalLimitCap {
+			return false, nil
+		}
+
+		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
+		for i := range ids {
+			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
+				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, ids[i])
+				resultDist = append(resultDist, dist[i])
+			} else {
+				// as soon as we encounter a certainty which
+				// is below threshold, we can stop searching
+				shouldContinue = false
+				break
+			}
+		}
+
+		return shouldContinue, nil
+	}
+
+	shouldContinue, err := recursiveSearch()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for shouldContinue {
+		searchParams.Iterate()
+		if searchParams.MaxLimitReached() {
+			logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached",
+					searchParams.MaximumSearchLimit())
+			break
+		}
+
+		shouldContinue, err = recursiveSearch()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return resultIDs, resultDist, nil
+}
@@ -0,0 +1,37 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import "sort"
+
+// SortByDistance orders the ids of search results by their distances
+func SortByDistance(ids []uint64, dists []float32) {
+	sort.Sort(&idsByDistance{ids: ids, dists: dists})
+}
+
+type idsByDistance struct {
+	ids   []uint64
+	dists []float32
+}
+
+func (s *idsByDistance) Len() int {
+	return len(s.ids)
+}
+
+func (s *idsByDistance) Less(i, j int) bool {
+	return s.dists[i] < s.dists[j]
+}
+
+func (s *idsByDistance) Swap(i, j int) {
+	s.ids[i], s.ids[j] = s.ids[j], s.ids[i]
+	s.dists[i], s.dists[j] = s.dists[j], s.dists[i]
+}
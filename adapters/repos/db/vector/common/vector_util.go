@@ -11,6 +11,12 @@
 
 package common
 
+import (
+	"encoding/binary"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
 func VectorsEqual(vecA, vecB []float32) bool {
 	return vectorsEqual(vecA, vecB, func(valueA, valueB float32) bool {
 		return valueA == valueB
@@ -51,3 +57,21 @@ func CalculateOptimalSegments(dims int) int {
 	}
 	return dims
 }
+
+// IDKey is the big endian key of an id in an lsmkv bucket, so that cursors
+// iterate in id order
+func IDKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+// Normalized returns the vector as the given distancer expects it
+func Normalized(provider distancer.Provider, vector []float32) []float32 {
+	if provider.Type() == "cosine-dot" {
+		// cosine-dot requires normalized vectors, as the dot product and cosine
+		// similarity are only identical if the vector is normalized
+		return distancer.Normalize(vector)
+	}
+	return vector
+}
@@ -15,6 +15,7 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"math/rand"
 	"runtime"
 	"time"
 
@@ -39,6 +40,7 @@ type ReturnDistancerFn func()
 type CommitLogger interface {
 	AddPQCompression(PQData) error
 	AddSQCompression(SQData) error
+	AddRQCompression(RQData) error
 }
 
 type VectorCompressor interface {
@@ -397,6 +399,131 @@ func NewBQMultiCompressor(
 	return bqVectorsCompressor, nil
 }
 
+// asMultiCompressor replaces the cache of a freshly created compressor with
+// one that groups the compressed token vectors of a multi vector by document.
+func asMultiCompressor(
+	c VectorCompressor,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	allocChecker memwatch.AllocChecker,
+) VectorCompressor {
+	switch compressor := c.(type) {
+	case *quantizedVectorsCompressor[byte]:
+		compressor.cache.Drop()
+		compressor.cache = cache.NewShardedMultiByteLockCache(
+			compressor.getCompressedVectorForID, vectorCacheMaxObjects, logger, 0,
+			allocChecker)
+	case *quantizedVectorsCompressor[uint64]:
+		compressor.cache.Drop()
+		compressor.cache = cache.NewShardedMultiUInt64LockCache(
+			compressor.getCompressedVectorForID, vectorCacheMaxObjects, logger, 0,
+			allocChecker)
+	}
+	return c
+}
+
+func NewHNSWPQMultiCompressor(
+	cfg hnsw.PQConfig,
+	distance distancer.Provider,
+	dimensions int,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data [][]float32,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	c, err := NewHNSWPQCompressor(cfg, distance, dimensions, vectorCacheMaxObjects,
+		logger, data, store, allocChecker)
+	if err != nil {
+		return nil, err
+	}
+	return asMultiCompressor(c, vectorCacheMaxObjects, logger, allocChecker), nil
+}
+
+func RestoreHNSWPQMultiCompressor(
+	cfg hnsw.PQConfig,
+	distance distancer.Provider,
+	dimensions int,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	encoders []PQEncoder,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	c, err := RestoreHNSWPQCompressor(cfg, distance, dimensions, vectorCacheMaxObjects,
+		logger, encoders, store, allocChecker)
+	if err != nil {
+		return nil, err
+	}
+	return asMultiCompressor(c, vectorCacheMaxObjects, logger, allocChecker), nil
+}
+
+func NewHNSWSQMultiCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data [][]float32,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	c, err := NewHNSWSQCompressor(distance, vectorCacheMaxObjects, logger, data,
+		store, allocChecker)
+	if err != nil {
+		return nil, err
+	}
+	return asMultiCompressor(c, vectorCacheMaxObjects, logger, allocChecker), nil
+}
+
+func RestoreHNSWSQMultiCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	a, b float32,
+	dimensions uint16,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	c, err := RestoreHNSWSQCompressor(distance, vectorCacheMaxObjects, logger, a, b,
+		dimensions, store, allocChecker)
+	if err != nil {
+		return nil, err
+	}
+	return asMultiCompressor(c, vectorCacheMaxObjects, logger, allocChecker), nil
+}
+
+func NewHNSWRQMultiCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	dimensions int,
+	bits int,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	c, err := NewHNSWRQCompressor(distance, vectorCacheMaxObjects, logger, dimensions,
+		bits, store, allocChecker)
+	if err != nil {
+		return nil, err
+	}
+	return asMultiCompressor(c, vectorCacheMaxObjects, logger, allocChecker), nil
+}
+
+func RestoreHNSWRQMultiCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data RQData,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	c, err := RestoreHNSWRQCompressor(distance, vectorCacheMaxObjects, logger, data,
+		store, allocChecker)
+	if err != nil {
+		return nil, err
+	}
+	return asMultiCompressor(c, vectorCacheMaxObjects, logger, allocChecker), nil
+}
+
 func NewHNSWSQCompressor(
 	distance distancer.Provider,
 	vectorCacheMaxObjects int,
@@ -448,6 +575,58 @@ func RestoreHNSWSQCompressor(
 	return sqVectorsCompressor, nil
 }
 
+func NewHNSWRQCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	dimensions int,
+	bits int,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := NewRotationalQuantizer(dimensions, bits, rand.Uint64(), distance)
+	if err != nil {
+		return nil, err
+	}
+	return newHNSWRQCompressor(quantizer, vectorCacheMaxObjects, logger, store, allocChecker), nil
+}
+
+func RestoreHNSWRQCompressor(
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	data RQData,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := RestoreRotationalQuantizer(data, distance)
+	if err != nil {
+		return nil, err
+	}
+	return newHNSWRQCompressor(quantizer, vectorCacheMaxObjects, logger, store, allocChecker), nil
+}
+
+func newHNSWRQCompressor(
+	quantizer *RotationalQuantizer,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) *quantizedVectorsCompressor[byte] {
+	rqVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       quantizer,
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	rqVectorsCompressor.initCompressedStore()
+	rqVectorsCompressor.cache = cache.NewShardedByteLockCache(
+		rqVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
+		0, allocChecker)
+	return rqVectorsCompressor
+}
+
 type quantizedCompressorDistancer[T byte | uint64] struct {
 	compressor *quantizedVectorsCompressor[T]
 	distancer  quantizerDistancer[T]
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/cache"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+// DataTypeQuantizer stores vectors with the configured data type instead of
+// float32. Unlike the other quantizers it needs no training and no
+// persisted state, and as vectors from models that output the data type
+// natively are stored without loss, results don't need to be rescored.
+type DataTypeQuantizer struct {
+	provider  distancer.Provider
+	distancer *distancer.DataTypeDistancer
+}
+
+func NewDataTypeQuantizer(dataType string, distance distancer.Provider) (*DataTypeQuantizer, error) {
+	d, err := distancer.NewDataTypeDistancer(distance, dataType)
+	if err != nil {
+		return nil, err
+	}
+	return &DataTypeQuantizer{provider: distance, distancer: d}, nil
+}
+
+func (q *DataTypeQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	return q.distancer.Distance(x, y)
+}
+
+func (q *DataTypeQuantizer) Encode(vec []float32) []byte {
+	return q.distancer.Encode(vec, nil)
+}
+
+type DataTypeDistancer struct {
+	x          []float32
+	q          *DataTypeQuantizer
+	compressed []byte
+}
+
+func (q *DataTypeQuantizer) NewDistancer(a []float32) *DataTypeDistancer {
+	return &DataTypeDistancer{
+		x: a,
+		q: q,
+	}
+}
+
+func (d *DataTypeDistancer) Distance(x []byte) (float32, error) {
+	if d.x != nil {
+		return d.q.distancer.FloatDistance(d.x, x)
+	}
+	return d.q.distancer.Distance(d.compressed, x)
+}
+
+func (d *DataTypeDistancer) DistanceToFloat(x []float32) (float32, error) {
+	if d.x != nil {
+		return d.q.provider.SingleDist(d.x, x)
+	}
+	return d.q.distancer.FloatDistance(x, d.compressed)
+}
+
+func (q *DataTypeQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return q.NewDistancer(a)
+}
+
+func (q *DataTypeQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &DataTypeDistancer{
+		q:          q,
+		compressed: a,
+	}
+}
+
+func (q *DataTypeQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (q *DataTypeQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (q *DataTypeQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (q *DataTypeQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+// PersistCompression is a no-op, the data type is part of the immutable index
+// config and the quantizer is recreated from it on startup
+func (q *DataTypeQuantizer) PersistCompression(logger CommitLogger) {}
+
+func NewHNSWDataTypeCompressor(
+	dataType string,
+	distance distancer.Provider,
+	vectorCacheMaxObjects int,
+	logger logrus.FieldLogger,
+	store *lsmkv.Store,
+	allocChecker memwatch.AllocChecker,
+) (VectorCompressor, error) {
+	quantizer, err := NewDataTypeQuantizer(dataType, distance)
+	if err != nil {
+		return nil, err
+	}
+	dtVectorsCompressor := &quantizedVectorsCompressor[byte]{
+		quantizer:       quantizer,
+		compressedStore: store,
+		storeId:         binary.BigEndian.PutUint64,
+		loadId:          binary.BigEndian.Uint64,
+		logger:          logger,
+	}
+	dtVectorsCompressor.initCompressedStore()
+	dtVectorsCompressor.cache = cache.NewShardedByteLockCache(
+		dtVectorsCompressor.getCompressedVectorForID, vectorCacheMaxObjects, 1, logger,
+		0, allocChecker)
+	return dtVectorsCompressor, nil
+}
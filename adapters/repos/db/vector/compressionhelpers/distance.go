@@ -31,3 +31,24 @@ var dotByteImpl func(a, b []uint8) uint32 = func(a, b []byte) uint32 {
 
 	return sum
 }
+
+var dotFloatByteImpl func(a []float32, b []byte) float32 = func(a []float32, b []byte) float32 {
+	var sum float32
+
+	for i := range a {
+		sum += a[i] * float32(b[i])
+	}
+
+	return sum
+}
+
+// dotNibbleImpl multiplies 4-bit codes packed two per byte
+var dotNibbleImpl func(a, b []byte) uint32 = func(a, b []byte) uint32 {
+	var sum uint32
+
+	for i := range a {
+		sum += uint32(a[i]&0x0f)*uint32(b[i]&0x0f) + uint32(a[i]>>4)*uint32(b[i]>>4)
+	}
+
+	return sum
+}
@@ -20,5 +20,7 @@ func init() {
 	if cpu.X86.HasAVX2 {
 		l2SquaredByteImpl = asm.L2ByteAVX256
 		dotByteImpl = asm.DotByteAVX256
+		dotFloatByteImpl = asm.DotFloatByteAVX256
+		dotNibbleImpl = asm.DotNibbleAVX256
 	}
 }
@@ -20,5 +20,6 @@ func init() {
 	if cpu.ARM64.HasASIMD {
 		l2SquaredByteImpl = asm.L2ByteARM64
 		dotByteImpl = asm.DotByteARM64
+		dotFloatByteImpl = asm.DotFloatByte_Neon
 	}
 }
@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+const pqCodebookHeaderSize = 6
+
+// KMeansPQConfig is the product quantization config of the indexes which
+// train their codebook on their own rather than through the hnsw config
+func KMeansPQConfig(segments, centroids, trainingLimit int) ent.PQConfig {
+	return ent.PQConfig{
+		Enabled:       true,
+		Segments:      segments,
+		Centroids:     centroids,
+		TrainingLimit: trainingLimit,
+		Encoder: ent.PQEncoder{
+			Type:         ent.PQEncoderTypeKMeans,
+			Distribution: ent.PQEncoderDistributionLogNormal,
+		},
+	}
+}
+
+// MarshalPQCodebook appends the codebook of a k-means product quantizer to
+// buf for indexes which don't persist it through a commit log. Layout:
+//
+//	| dimensions (uint16) | centroids (uint16) | segments (uint16) | centers of every segment |
+func MarshalPQCodebook(buf []byte, pq *ProductQuantizer) []byte {
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(pq.dimensions))
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(pq.ks))
+	buf = binary.LittleEndian.AppendUint16(buf, uint16(pq.m))
+	for _, encoder := range pq.kms {
+		buf = append(buf, encoder.ExposeDataForRestore()...)
+	}
+	return buf
+}
+
+// UnmarshalPQCodebook restores a product quantizer written by
+// MarshalPQCodebook from the start of buf and returns the remaining bytes.
+// The segments and centroids of cfg are taken from the codebook.
+func UnmarshalPQCodebook(buf []byte, cfg ent.PQConfig, distance distancer.Provider,
+	logger logrus.FieldLogger,
+) (*ProductQuantizer, []byte, error) {
+	if len(buf) < pqCodebookHeaderSize {
+		return nil, nil, fmt.Errorf("codebook of %d bytes is too short", len(buf))
+	}
+	dims := int(binary.LittleEndian.Uint16(buf[0:2]))
+	ks := int(binary.LittleEndian.Uint16(buf[2:4]))
+	m := int(binary.LittleEndian.Uint16(buf[4:6]))
+	if m == 0 || dims%m != 0 {
+		return nil, nil, fmt.Errorf("invalid codebook: %d segments for %d dimensions", m, dims)
+	}
+	ds := dims / m
+	end := pqCodebookHeaderSize + m*ks*ds*4
+	if len(buf) < end {
+		return nil, nil, fmt.Errorf("invalid codebook: expected %d bytes, got %d", end, len(buf))
+	}
+
+	offset := pqCodebookHeaderSize
+	encoders := make([]PQEncoder, m)
+	for segment := range encoders {
+		centers := make([][]float32, ks)
+		for k := range centers {
+			centers[k] = make([]float32, ds)
+			for i := range centers[k] {
+				centers[k][i] = math.Float32frombits(binary.LittleEndian.Uint32(buf[offset:]))
+				offset += 4
+			}
+		}
+		encoders[segment] = NewKMeansWithCenters(ks, ds, segment, centers)
+	}
+
+	cfg.Segments = m
+	cfg.Centroids = ks
+	pq, err := NewProductQuantizerWithEncoders(cfg, distance, dims, encoders, logger)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pq, buf[end:], nil
+}
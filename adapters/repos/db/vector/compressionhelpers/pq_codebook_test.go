@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers_test
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+)
+
+func TestPQCodebook_Roundtrip(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	vectors, _ := testinghelpers.RandomVecs(300, 0, 16)
+	provider := distancer.NewL2SquaredProvider()
+	cfg := compressionhelpers.KMeansPQConfig(4, 16, 300)
+
+	pq, err := compressionhelpers.NewProductQuantizer(cfg, provider, 16, logger)
+	require.Nil(t, err)
+	require.Nil(t, pq.Fit(vectors))
+
+	prefix := []byte{1, 2, 3}
+	buf := compressionhelpers.MarshalPQCodebook(prefix, pq)
+	assert.Equal(t, prefix, buf[:3])
+	buf = append(buf, 4, 5)
+
+	restored, rest, err := compressionhelpers.UnmarshalPQCodebook(buf[3:],
+		compressionhelpers.KMeansPQConfig(0, 0, 300), provider, logger)
+	require.Nil(t, err)
+	assert.Equal(t, []byte{4, 5}, rest)
+	for _, vec := range vectors[:20] {
+		assert.Equal(t, pq.Encode(vec), restored.Encode(vec))
+	}
+
+	_, _, err = compressionhelpers.UnmarshalPQCodebook(buf[3:20], cfg, provider, logger)
+	assert.ErrorContains(t, err, "invalid codebook")
+}
@@ -0,0 +1,400 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+const (
+	// rotationRounds is the number of times the random sign flips and the
+	// blockwise Hadamard transforms are applied
+	rotationRounds = 3
+
+	// rqCorrectionSize is the size of the correction factors stored behind the
+	// codes of every vector: the lower bound and step size of its grid, the sum
+	// of its codes, its squared norm and the norm of its quantization error
+	rqCorrectionSize = 20
+)
+
+// RotationalQuantizer applies a random orthogonal rotation to every vector
+// and then quantizes each rotated coordinate to 4 or 8 bits on a uniform grid
+// fitted to that single vector.
+//
+// After the rotation the coordinates of a vector x concentrate around
+// ±||x||/sqrt(d), so the range of the grid is O(||x|| sqrt(log(d)/d)) with
+// high probability. Rounding to the closest grid point moves each coordinate
+// by at most half a step, which bounds the quantization error
+// e_x = ||x - x̂|| by O(||x|| sqrt(log d) / 2^bits) independent of the data
+// distribution. Every code stores e_x next to the exact norm of the vector, so
+// an estimated inner product deviates from the exact one by at most
+// e_x ||y|| + (||x|| + e_x) e_y, see DistanceErrorBound. Unlike PQ there is
+// nothing to train, the rotation is fully determined by a seed.
+type RotationalQuantizer struct {
+	distancer  distancer.Provider
+	rotation   *fastRotation
+	dimensions int
+	bits       int
+	levels     float32
+	codeSize   int
+	seed       uint64
+}
+
+type RQData struct {
+	Dimensions uint16
+	Bits       uint8
+	Seed       uint64
+}
+
+func NewRotationalQuantizer(dimensions, bits int, seed uint64, distance distancer.Provider) (*RotationalQuantizer, error) {
+	if dimensions <= 0 || dimensions > math.MaxUint16 {
+		return nil, errors.Errorf("invalid number of dimensions for RQ: %d", dimensions)
+	}
+	if bits != 4 && bits != 8 {
+		return nil, errors.Errorf("RQ supports 4 or 8 bits per dimension, got %d", bits)
+	}
+	switch distance.Type() {
+	case "l2-squared", "dot", "cosine-dot":
+	default:
+		return nil, errors.Errorf("distance %q is not supported by RQ", distance.Type())
+	}
+
+	return &RotationalQuantizer{
+		distancer:  distance,
+		rotation:   newFastRotation(dimensions, seed),
+		dimensions: dimensions,
+		bits:       bits,
+		levels:     float32(int(1)<<bits - 1),
+		codeSize:   (dimensions*bits + 7) / 8,
+		seed:       seed,
+	}, nil
+}
+
+func RestoreRotationalQuantizer(data RQData, distance distancer.Provider) (*RotationalQuantizer, error) {
+	return NewRotationalQuantizer(int(data.Dimensions), int(data.Bits), data.Seed, distance)
+}
+
+// Encode returns the codes of the rotated vector followed by its correction
+// factors
+func (rq *RotationalQuantizer) Encode(vec []float32) []byte {
+	rotated := rq.rotation.rotate(vec)
+
+	lower, upper := rotated[0], rotated[0]
+	for _, x := range rotated[1:] {
+		if x < lower {
+			lower = x
+		} else if x > upper {
+			upper = x
+		}
+	}
+	step := (upper - lower) / rq.levels
+
+	code := make([]byte, rq.codeSize+rqCorrectionSize)
+	var codeSum uint32
+	var norm2, err2 float32
+	for i, x := range rotated {
+		var c float32
+		if step > 0 {
+			c = float32(math.Round(float64((x - lower) / step)))
+			c = max(0, min(c, rq.levels))
+		}
+		e := x - (lower + c*step)
+		err2 += e * e
+		norm2 += x * x
+		codeSum += uint32(c)
+
+		if rq.bits == 8 {
+			code[i] = byte(c)
+		} else {
+			code[i/2] |= byte(c) << (4 * (i % 2))
+		}
+	}
+
+	correction := code[rq.codeSize:]
+	binary.BigEndian.PutUint32(correction[0:], math.Float32bits(lower))
+	binary.BigEndian.PutUint32(correction[4:], math.Float32bits(step))
+	binary.BigEndian.PutUint32(correction[8:], codeSum)
+	binary.BigEndian.PutUint32(correction[12:], math.Float32bits(norm2))
+	binary.BigEndian.PutUint32(correction[16:], math.Float32bits(float32(math.Sqrt(float64(err2)))))
+	return code
+}
+
+type rqCorrection struct {
+	lower   float32
+	step    float32
+	codeSum float32
+	norm2   float32
+	err     float32
+}
+
+func (rq *RotationalQuantizer) correction(code []byte) rqCorrection {
+	correction := code[rq.codeSize:]
+	return rqCorrection{
+		lower:   math.Float32frombits(binary.BigEndian.Uint32(correction[0:])),
+		step:    math.Float32frombits(binary.BigEndian.Uint32(correction[4:])),
+		codeSum: float32(binary.BigEndian.Uint32(correction[8:])),
+		norm2:   math.Float32frombits(binary.BigEndian.Uint32(correction[12:])),
+		err:     math.Float32frombits(binary.BigEndian.Uint32(correction[16:])),
+	}
+}
+
+func (rq *RotationalQuantizer) validCode(code []byte) error {
+	if len(code) != rq.codeSize+rqCorrectionSize {
+		return errors.Errorf("RQ code has length %d, expected %d",
+			len(code), rq.codeSize+rqCorrectionSize)
+	}
+	return nil
+}
+
+// innerProduct estimates the inner product of two vectors from their codes.
+// With x̂_i = l_x + s_x c_i the sum expands to terms which only depend on the
+// correction factors and the dot product of the integer codes.
+func (rq *RotationalQuantizer) innerProduct(x, y []byte) float32 {
+	cx, cy := rq.correction(x), rq.correction(y)
+
+	var dot uint32
+	if rq.bits == 8 {
+		dot = dotByteImpl(x[:rq.codeSize], y[:rq.codeSize])
+	} else {
+		dot = dotNibbleImpl(x[:rq.codeSize], y[:rq.codeSize])
+	}
+
+	return float32(rq.dimensions)*cx.lower*cy.lower +
+		cx.lower*cy.step*cy.codeSum +
+		cy.lower*cx.step*cx.codeSum +
+		cx.step*cy.step*float32(dot)
+}
+
+func (rq *RotationalQuantizer) distanceFromInnerProduct(ip, norm2x, norm2y float32) (float32, error) {
+	switch rq.distancer.Type() {
+	case "l2-squared":
+		return norm2x + norm2y - 2*ip, nil
+	case "dot":
+		return -ip, nil
+	case "cosine-dot":
+		return 1 - ip, nil
+	}
+	return 0, errors.Errorf("distance not supported yet %s", rq.distancer.Type())
+}
+
+func (rq *RotationalQuantizer) DistanceBetweenCompressedVectors(x, y []byte) (float32, error) {
+	if err := rq.validCode(x); err != nil {
+		return 0, err
+	}
+	if err := rq.validCode(y); err != nil {
+		return 0, err
+	}
+	return rq.distanceFromInnerProduct(rq.innerProduct(x, y),
+		rq.correction(x).norm2, rq.correction(y).norm2)
+}
+
+// DistanceErrorBound returns an upper bound for the absolute difference
+// between the distance estimated from both codes and the exact distance of the
+// original vectors
+func (rq *RotationalQuantizer) DistanceErrorBound(x, y []byte) float32 {
+	cx, cy := rq.correction(x), rq.correction(y)
+	normX := float32(math.Sqrt(float64(cx.norm2)))
+	normY := float32(math.Sqrt(float64(cy.norm2)))
+
+	bound := cx.err*normY + (normX+cx.err)*cy.err
+	if rq.distancer.Type() == "l2-squared" {
+		return 2 * bound
+	}
+	return bound
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytesWithSubsliceBuffer(compressed []byte, buffer *[]byte) []byte {
+	if len(*buffer) < len(compressed) {
+		*buffer = make([]byte, len(compressed)*1000)
+	}
+
+	// take from end so we can address the start of the buffer
+	out := (*buffer)[len(*buffer)-len(compressed):]
+	copy(out, compressed)
+	*buffer = (*buffer)[:len(*buffer)-len(compressed)]
+
+	return out
+}
+
+// RQDistancer compares a query to codes. Queries created from an uncompressed
+// vector are only rotated but not quantized, which removes their share of the
+// quantization error from the estimate.
+type RQDistancer struct {
+	x          []float32
+	rotated    []float32
+	sum        float32
+	norm2      float32
+	rq         *RotationalQuantizer
+	compressed []byte
+}
+
+func (rq *RotationalQuantizer) NewDistancer(a []float32) *RQDistancer {
+	rotated := rq.rotation.rotate(a)
+	var sum, norm2 float32
+	for _, x := range rotated {
+		sum += x
+		norm2 += x * x
+	}
+	return &RQDistancer{
+		x:       a,
+		rotated: rotated,
+		sum:     sum,
+		norm2:   norm2,
+		rq:      rq,
+	}
+}
+
+func (d *RQDistancer) Distance(x []byte) (float32, error) {
+	if d.compressed != nil {
+		return d.rq.DistanceBetweenCompressedVectors(d.compressed, x)
+	}
+	if err := d.rq.validCode(x); err != nil {
+		return 0, err
+	}
+
+	c := d.rq.correction(x)
+	var dot float32
+	if d.rq.bits == 8 {
+		dot = dotFloatByteImpl(d.rotated, x[:d.rq.codeSize])
+	} else {
+		dot = dotFloatNibble(d.rotated, x[:d.rq.codeSize])
+	}
+	return d.rq.distanceFromInnerProduct(c.lower*d.sum+c.step*dot, d.norm2, c.norm2)
+}
+
+func (d *RQDistancer) DistanceToFloat(x []float32) (float32, error) {
+	if len(d.x) > 0 {
+		return d.rq.distancer.SingleDist(d.x, x)
+	}
+	return d.rq.DistanceBetweenCompressedVectors(d.compressed, d.rq.Encode(x))
+}
+
+func dotFloatNibble(a []float32, b []byte) float32 {
+	var sum float32
+	for i, x := range b {
+		sum += a[2*i] * float32(x&0x0f)
+		if 2*i+1 < len(a) {
+			sum += a[2*i+1] * float32(x>>4)
+		}
+	}
+	return sum
+}
+
+func (rq *RotationalQuantizer) NewQuantizerDistancer(a []float32) quantizerDistancer[byte] {
+	return rq.NewDistancer(a)
+}
+
+func (rq *RotationalQuantizer) NewCompressedQuantizerDistancer(a []byte) quantizerDistancer[byte] {
+	return &RQDistancer{
+		rq:         rq,
+		compressed: a,
+	}
+}
+
+func (rq *RotationalQuantizer) ReturnQuantizerDistancer(distancer quantizerDistancer[byte]) {}
+
+func (rq *RotationalQuantizer) CompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) FromCompressedBytes(compressed []byte) []byte {
+	return compressed
+}
+
+func (rq *RotationalQuantizer) PersistCompression(logger CommitLogger) {
+	logger.AddRQCompression(rq.Data())
+}
+
+func (rq *RotationalQuantizer) Data() RQData {
+	return RQData{
+		Dimensions: uint16(rq.dimensions),
+		Bits:       uint8(rq.bits),
+		Seed:       rq.seed,
+	}
+}
+
+// fastRotation is a random orthogonal transformation which is applied in
+// O(d log d) without materializing a d x d matrix. Every round flips the signs
+// of the coordinates at random and applies a normalized Walsh-Hadamard
+// transform to the first and then to the last block of the largest power of
+// two that fits into the dimensions. Both steps are orthogonal, and as the
+// blocks overlap whenever the dimensions aren't a power of two, every
+// coordinate is mixed with every other one.
+type fastRotation struct {
+	dimensions int
+	block      int
+	scale      float32
+	signs      [][]float32
+}
+
+func newFastRotation(dimensions int, seed uint64) *fastRotation {
+	block := 1
+	for block*2 <= dimensions {
+		block *= 2
+	}
+
+	r := rand.New(rand.NewSource(int64(seed)))
+	signs := make([][]float32, 2*rotationRounds)
+	for i := range signs {
+		signs[i] = make([]float32, dimensions)
+		for j := range signs[i] {
+			signs[i][j] = 1
+			if r.Intn(2) == 0 {
+				signs[i][j] = -1
+			}
+		}
+	}
+
+	return &fastRotation{
+		dimensions: dimensions,
+		block:      block,
+		scale:      float32(1 / math.Sqrt(float64(block))),
+		signs:      signs,
+	}
+}
+
+func (r *fastRotation) rotate(vec []float32) []float32 {
+	out := make([]float32, r.dimensions)
+	copy(out, vec)
+	for round := 0; round < rotationRounds; round++ {
+		flipSigns(out, r.signs[2*round])
+		walshHadamard(out[:r.block], r.scale)
+		flipSigns(out, r.signs[2*round+1])
+		walshHadamard(out[r.dimensions-r.block:], r.scale)
+	}
+	return out
+}
+
+func flipSigns(x, signs []float32) {
+	for i := range x {
+		x[i] *= signs[i]
+	}
+}
+
+// walshHadamard transforms x in place, len(x) must be a power of two
+func walshHadamard(x []float32, scale float32) {
+	for h := 1; h < len(x); h *= 2 {
+		for i := 0; i < len(x); i += 2 * h {
+			for j := i; j < i+h; j++ {
+				x[j], x[j+h] = x[j]+x[j+h], x[j]-x[j+h]
+			}
+		}
+	}
+	for i := range x {
+		x[i] *= scale
+	}
+}
@@ -0,0 +1,237 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package compressionhelpers
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+func randomRQVectors(r *rand.Rand, n, dims int, normalize bool) [][]float32 {
+	vecs := make([][]float32, n)
+	for i := range vecs {
+		vecs[i] = make([]float32, dims)
+		for j := range vecs[i] {
+			vecs[i][j] = float32(r.NormFloat64())
+		}
+		if normalize {
+			vecs[i] = distancer.Normalize(vecs[i])
+		}
+	}
+	return vecs
+}
+
+func dotFloat(x, y []float32) float64 {
+	var sum float64
+	for i := range x {
+		sum += float64(x[i]) * float64(y[i])
+	}
+	return sum
+}
+
+func TestFastRotationIsOrthogonal(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for _, dims := range []int{1, 7, 64, 100, 768, 1000} {
+		t.Run(fmt.Sprintf("%d dimensions", dims), func(t *testing.T) {
+			rotation := newFastRotation(dims, r.Uint64())
+			vecs := randomRQVectors(r, 20, dims, false)
+			for i := 1; i < len(vecs); i++ {
+				x, y := vecs[i-1], vecs[i]
+				rx, ry := rotation.rotate(x), rotation.rotate(y)
+				assert.InDelta(t, dotFloat(x, x), dotFloat(rx, rx), 1e-3*dotFloat(x, x))
+				assert.InDelta(t, dotFloat(x, y), dotFloat(rx, ry), 1e-3*math.Sqrt(dotFloat(x, x)*dotFloat(y, y)))
+			}
+		})
+	}
+}
+
+func TestFastRotationSpreadsEnergy(t *testing.T) {
+	dims := 768
+	rotation := newFastRotation(dims, 7)
+
+	// a vector with all its energy in a single dimension is the worst case
+	// for a per-vector grid
+	vec := make([]float32, dims)
+	vec[3] = 1
+	rotated := rotation.rotate(vec)
+
+	var maxAbs float32
+	for _, x := range rotated {
+		maxAbs = max(maxAbs, float32(math.Abs(float64(x))))
+	}
+	assert.Less(t, maxAbs, float32(5/math.Sqrt(float64(dims))))
+}
+
+func TestRQDistanceWithinErrorBound(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	providers := []distancer.Provider{
+		distancer.NewL2SquaredProvider(),
+		distancer.NewDotProductProvider(),
+		distancer.NewCosineDistanceProvider(),
+	}
+
+	for _, provider := range providers {
+		for _, bits := range []int{4, 8} {
+			for _, dims := range []int{33, 768} {
+				name := fmt.Sprintf("%s with %d bits and %d dimensions", provider.Type(), bits, dims)
+				t.Run(name, func(t *testing.T) {
+					normalize := provider.Type() == "cosine-dot"
+					vecs := randomRQVectors(r, 50, dims, normalize)
+					rq, err := NewRotationalQuantizer(dims, bits, r.Uint64(), provider)
+					require.Nil(t, err)
+
+					codes := make([][]byte, len(vecs))
+					for i := range vecs {
+						codes[i] = rq.Encode(vecs[i])
+					}
+
+					for i := 1; i < len(vecs); i++ {
+						exact, err := provider.SingleDist(vecs[i-1], vecs[i])
+						require.Nil(t, err)
+						bound := rq.DistanceErrorBound(codes[i-1], codes[i])
+
+						estimate, err := rq.DistanceBetweenCompressedVectors(codes[i-1], codes[i])
+						require.Nil(t, err)
+						assert.InDelta(t, exact, estimate, float64(bound)+1e-3)
+
+						estimate, err = rq.NewDistancer(vecs[i-1]).Distance(codes[i])
+						require.Nil(t, err)
+						assert.InDelta(t, exact, estimate, float64(bound)+1e-3)
+					}
+				})
+			}
+		}
+	}
+}
+
+func TestRQErrorDecreasesWithBits(t *testing.T) {
+	r := rand.New(rand.NewSource(2))
+	dims := 256
+	vecs := randomRQVectors(r, 100, dims, true)
+	provider := distancer.NewCosineDistanceProvider()
+
+	meanError := func(bits int) float64 {
+		rq, err := NewRotationalQuantizer(dims, bits, 3, provider)
+		require.Nil(t, err)
+
+		var sum float64
+		for i := 1; i < len(vecs); i++ {
+			exact, _ := provider.SingleDist(vecs[i-1], vecs[i])
+			estimate, err := rq.DistanceBetweenCompressedVectors(rq.Encode(vecs[i-1]), rq.Encode(vecs[i]))
+			require.Nil(t, err)
+			sum += math.Abs(float64(exact - estimate))
+		}
+		return sum / float64(len(vecs)-1)
+	}
+
+	errors4, errors8 := meanError(4), meanError(8)
+	assert.Less(t, errors8, errors4)
+	assert.Less(t, errors8, 0.002)
+}
+
+func TestRQRecall(t *testing.T) {
+	r := rand.New(rand.NewSource(3))
+	dims := 128
+	k := 10
+	vecs := randomRQVectors(r, 2000, dims, true)
+	queries := randomRQVectors(r, 20, dims, true)
+	provider := distancer.NewCosineDistanceProvider()
+
+	for _, test := range []struct {
+		bits      int
+		minRecall float64
+	}{
+		{bits: 4, minRecall: 0.7},
+		{bits: 8, minRecall: 0.95},
+	} {
+		t.Run(fmt.Sprintf("%d bits", test.bits), func(t *testing.T) {
+			rq, err := NewRotationalQuantizer(dims, test.bits, 4, provider)
+			require.Nil(t, err)
+			codes := make([][]byte, len(vecs))
+			for i := range vecs {
+				codes[i] = rq.Encode(vecs[i])
+			}
+
+			topK := func(dist func(i int) float32) map[int]struct{} {
+				ids := make([]int, len(vecs))
+				dists := make([]float32, len(vecs))
+				for i := range vecs {
+					ids[i] = i
+					dists[i] = dist(i)
+				}
+				sort.Slice(ids, func(a, b int) bool { return dists[ids[a]] < dists[ids[b]] })
+				out := map[int]struct{}{}
+				for _, id := range ids[:k] {
+					out[id] = struct{}{}
+				}
+				return out
+			}
+
+			hits := 0
+			for _, query := range queries {
+				exact := topK(func(i int) float32 {
+					d, _ := provider.SingleDist(query, vecs[i])
+					return d
+				})
+				d := rq.NewDistancer(query)
+				compressed := topK(func(i int) float32 {
+					dist, _ := d.Distance(codes[i])
+					return dist
+				})
+				for id := range compressed {
+					if _, ok := exact[id]; ok {
+						hits++
+					}
+				}
+			}
+			recall := float64(hits) / float64(k*len(queries))
+			assert.GreaterOrEqual(t, recall, test.minRecall)
+		})
+	}
+}
+
+func TestRQRestore(t *testing.T) {
+	r := rand.New(rand.NewSource(5))
+	vec := randomRQVectors(r, 1, 100, false)[0]
+	provider := distancer.NewL2SquaredProvider()
+
+	rq, err := NewRotationalQuantizer(100, 4, 1234, provider)
+	require.Nil(t, err)
+	assert.Len(t, rq.Encode(vec), 50+rqCorrectionSize)
+
+	restored, err := RestoreRotationalQuantizer(rq.Data(), provider)
+	require.Nil(t, err)
+	assert.Equal(t, rq.Encode(vec), restored.Encode(vec))
+}
+
+func TestRQInvalidSettings(t *testing.T) {
+	_, err := NewRotationalQuantizer(100, 3, 1, distancer.NewL2SquaredProvider())
+	assert.ErrorContains(t, err, "4 or 8 bits")
+
+	_, err = NewRotationalQuantizer(100, 8, 1, distancer.NewManhattanProvider())
+	assert.ErrorContains(t, err, "not supported")
+
+	_, err = NewRotationalQuantizer(0, 8, 1, distancer.NewL2SquaredProvider())
+	assert.ErrorContains(t, err, "dimensions")
+
+	rq, err := NewRotationalQuantizer(10, 8, 1, distancer.NewL2SquaredProvider())
+	require.Nil(t, err)
+	_, err = rq.DistanceBetweenCompressedVectors(make([]byte, 3), make([]byte, 3))
+	assert.NotNil(t, err)
+}
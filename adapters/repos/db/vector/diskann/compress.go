@@ -0,0 +1,146 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+// compressIfNeeded starts the training of the codebook in the background once
+// the index holds enough vectors. Until then, searches score neighbors with
+// the vectors read from disk.
+func (index *diskann) compressIfNeeded() {
+	if index.count.Load() < uint64(index.pqTrainingLimit) {
+		return
+	}
+	if index.shutdownCtx.Err() != nil || !index.compressing.CompareAndSwap(false, true) {
+		return
+	}
+
+	index.compressLock.RLock()
+	compressed := index.pq != nil
+	index.compressLock.RUnlock()
+	if compressed {
+		// compressing is never reset, so this check is only done once
+		return
+	}
+
+	index.compressionWg.Add(1)
+	enterrors.GoWrapper(func() {
+		defer index.compressionWg.Done()
+		if err := index.compress(); err != nil {
+			index.logger.WithField("action", "diskann_compress").
+				WithField("class", index.className).
+				WithField("shard", index.shardName).
+				WithError(err).Error("compress vectors")
+			// allow the next insert to try again
+			index.compressing.Store(false)
+		}
+	}, index.logger)
+}
+
+func (index *diskann) compress() error {
+	before := time.Now()
+	dims := int(index.dims.Load())
+
+	segments := index.pqSegments
+	if segments <= 0 {
+		segments = common.CalculateOptimalSegments(dims)
+	}
+	pq, err := compressionhelpers.NewProductQuantizer(index.pqConfig(segments),
+		index.distancerProvider, dims, index.logger)
+	if err != nil {
+		return errors.Wrap(err, "create product quantizer")
+	}
+
+	sample, err := index.sampleVectors(index.pqTrainingLimit)
+	if err != nil {
+		return errors.Wrap(err, "sample training data")
+	}
+	if err := pq.Fit(sample); err != nil {
+		return errors.Wrap(err, "fit product quantizer")
+	}
+
+	// nodes inserted from here on need to be encoded at insert time, so the
+	// remaining work is done while no insert is running
+	index.compressLock.Lock()
+	defer index.compressLock.Unlock()
+
+	cursor := index.store.Bucket(index.graphBucketName()).Cursor()
+	defer cursor.Close()
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		if err := index.shutdownCtx.Err(); err != nil {
+			return err
+		}
+		n, err := unmarshalNode(binary.BigEndian.Uint64(key), value)
+		if err != nil {
+			return err
+		}
+		if err := index.storeCode(n.id, pq.Encode(n.vector)); err != nil {
+			return err
+		}
+	}
+
+	if err := index.metadata.Put(map[string][]byte{
+		metadataKeyCodebook: compressionhelpers.MarshalPQCodebook(nil, pq),
+	}); err != nil {
+		return errors.Wrap(err, "persist codebook")
+	}
+	index.pq = pq
+
+	index.logger.WithField("action", "diskann_compress").
+		WithField("class", index.className).
+		WithField("shard", index.shardName).
+		WithField("took", time.Since(before)).
+		Infof("compressed %d vectors into %d segments", len(index.codes), segments)
+	return nil
+}
+
+// sampleVectors picks a uniform sample of at most limit vectors from the
+// graph using reservoir sampling, so that the training data does not depend
+// on the insertion order.
+func (index *diskann) sampleVectors(limit int) ([][]float32, error) {
+	cursor := index.store.Bucket(index.graphBucketName()).Cursor()
+	defer cursor.Close()
+
+	sample := make([][]float32, 0, limit)
+	seen := 0
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		if err := index.shutdownCtx.Err(); err != nil {
+			return nil, err
+		}
+		seen++
+		pos := len(sample)
+		if pos == limit {
+			if pos = rand.Intn(seen); pos >= limit {
+				continue
+			}
+		}
+		n, err := unmarshalNode(binary.BigEndian.Uint64(key), value)
+		if err != nil {
+			return nil, err
+		}
+		if pos == len(sample) {
+			sample = append(sample, n.vector)
+		} else {
+			sample[pos] = n.vector
+		}
+	}
+	return sample, nil
+}
@@ -0,0 +1,120 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+type Config struct {
+	ID                 string
+	RootPath           string
+	TargetVector       string
+	ShardName          string
+	ClassName          string
+	Logger             logrus.FieldLogger
+	DistanceProvider   distancer.Provider
+	AllocChecker       memwatch.AllocChecker
+	TombstoneCallbacks cyclemanager.CycleCallbackGroup
+}
+
+func (c Config) Validate() error {
+	ec := errorcompounder.New()
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	if c.RootPath == "" {
+		ec.Addf("rootPath cannot be empty")
+	}
+
+	if c.DistanceProvider == nil {
+		ec.Addf("distancerProvider cannot be nil")
+	}
+
+	return ec.ToError()
+}
+
+type immutableParameter struct {
+	accessor func(c diskannent.UserConfig) interface{}
+	name     string
+}
+
+func validateImmutableField(u immutableParameter,
+	previous, next diskannent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%v\" to \"%v\"",
+			u.name, oldField, newField)
+	}
+
+	return nil
+}
+
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	initialParsed, ok := initial.(diskannent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(diskannent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	// the graph is built with maxDegree and alpha, changing them would leave
+	// the already persisted part of the graph with different properties than
+	// the rest
+	immutableFields := []immutableParameter{
+		{
+			name:     "distance",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.Distance },
+		},
+		{
+			name:     "maxDegree",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.MaxDegree },
+		},
+		{
+			name:     "alpha",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.Alpha },
+		},
+		{
+			name:     "pq.segments",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.PQ.Segments },
+		},
+		{
+			name:     "pq.centroids",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.PQ.Centroids },
+		},
+		{
+			name:     "pq.trainingLimit",
+			accessor: func(c diskannent.UserConfig) interface{} { return c.PQ.TrainingLimit },
+		},
+	}
+
+	for _, u := range immutableFields {
+		if err := validateImmutableField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+)
+
+func TestDiskANNUserConfigUpdates(t *testing.T) {
+	initial := diskannent.NewDefaultUserConfig()
+
+	t.Run("list sizes can be changed", func(t *testing.T) {
+		updated := diskannent.NewDefaultUserConfig()
+		updated.SearchListSize = 200
+		updated.BuildListSize = 200
+		updated.FlatSearchCutoff = 1000
+		assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+
+	t.Run("graph parameters are immutable", func(t *testing.T) {
+		updated := diskannent.NewDefaultUserConfig()
+		updated.MaxDegree = 32
+		assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "maxDegree is immutable")
+
+		updated = diskannent.NewDefaultUserConfig()
+		updated.Alpha = 1.5
+		assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "alpha is immutable")
+
+		updated = diskannent.NewDefaultUserConfig()
+		updated.PQ.Segments = 8
+		assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "pq.segments is immutable")
+	})
+}
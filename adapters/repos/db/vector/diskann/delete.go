@@ -0,0 +1,245 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// tombstoneValue is the value written to the tombstones bucket, only the
+// keys are of interest
+var tombstoneValue = []byte{1}
+
+// Delete only marks the nodes as deleted. They are still traversed by
+// searches, but never returned, until the cleanup cycle removes them from the
+// graph.
+func (index *diskann) Delete(ids ...uint64) error {
+	bucket := index.store.Bucket(index.tombstonesBucketName())
+	for _, id := range ids {
+		if err := bucket.Put(common.IDKey(id), tombstoneValue); err != nil {
+			return errors.Wrapf(err, "add tombstone for node %d", id)
+		}
+		index.tombstoneLock.Lock()
+		index.tombstones[id] = struct{}{}
+		index.tombstoneLock.Unlock()
+	}
+	return nil
+}
+
+// cleanUpTombstones reconnects all nodes that have deleted neighbors to the
+// neighbors of those and only then removes the deleted nodes, so that the
+// graph stays navigable. Every step can be repeated safely, so if the cycle
+// is aborted, the next run picks up the remaining work.
+func (index *diskann) cleanUpTombstones(shouldAbort cyclemanager.ShouldAbortCallback) bool {
+	index.tombstoneLock.RLock()
+	deleted := make(map[uint64]struct{}, len(index.tombstones))
+	for id := range index.tombstones {
+		deleted[id] = struct{}{}
+	}
+	index.tombstoneLock.RUnlock()
+
+	if len(deleted) == 0 {
+		return false
+	}
+
+	index.compressLock.RLock()
+	defer index.compressLock.RUnlock()
+
+	affected, err := index.nodesWithDeletedNeighbors(deleted, shouldAbort)
+	if err != nil {
+		index.logger.WithField("action", "diskann_tombstone_cleanup").
+			WithError(err).Error("find nodes with deleted neighbors")
+		return false
+	}
+
+	for _, id := range affected {
+		if shouldAbort() {
+			return true
+		}
+		if err := index.reassignNeighbors(id, deleted); err != nil {
+			index.logger.WithField("action", "diskann_tombstone_cleanup").
+				WithError(err).Errorf("reassign neighbors of node %d", id)
+			return true
+		}
+	}
+
+	if err := index.replaceDeletedEntryPoint(deleted); err != nil {
+		index.logger.WithField("action", "diskann_tombstone_cleanup").
+			WithError(err).Error("replace entry point")
+		return true
+	}
+
+	for id := range deleted {
+		if shouldAbort() {
+			return true
+		}
+		if err := index.removeNode(id); err != nil {
+			index.logger.WithField("action", "diskann_tombstone_cleanup").
+				WithError(err).Errorf("remove node %d", id)
+			return true
+		}
+	}
+
+	index.logger.WithField("action", "diskann_tombstone_cleanup").
+		WithField("class", index.className).
+		WithField("shard", index.shardName).
+		Debugf("removed %d deleted nodes, reassigned neighbors of %d nodes",
+			len(deleted), len(affected))
+	return true
+}
+
+func (index *diskann) nodesWithDeletedNeighbors(deleted map[uint64]struct{},
+	shouldAbort cyclemanager.ShouldAbortCallback,
+) ([]uint64, error) {
+	cursor := index.store.Bucket(index.graphBucketName()).Cursor()
+	defer cursor.Close()
+
+	var affected []uint64
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		if shouldAbort() {
+			return nil, errors.New("aborted")
+		}
+		id := binary.BigEndian.Uint64(key)
+		if _, ok := deleted[id]; ok {
+			continue
+		}
+		n, err := unmarshalNode(id, value)
+		if err != nil {
+			return nil, err
+		}
+		for _, neighbor := range n.neighbors {
+			if _, ok := deleted[neighbor]; ok {
+				affected = append(affected, id)
+				break
+			}
+		}
+	}
+	return affected, nil
+}
+
+// reassignNeighbors replaces every deleted neighbor of the node with the
+// neighbors of the deleted node and prunes the result, as described for the
+// deletion consolidation of FreshDiskANN.
+func (index *diskann) reassignNeighbors(id uint64, deleted map[uint64]struct{}) error {
+	index.nodeLocks.Lock(id)
+	defer index.nodeLocks.Unlock(id)
+
+	n, err := index.readNode(id)
+	if err != nil || n == nil {
+		return err
+	}
+
+	seen := map[uint64]struct{}{id: {}}
+	neighbors := make([]uint64, 0, len(n.neighbors))
+	add := func(candidate uint64) {
+		if _, ok := seen[candidate]; ok {
+			return
+		}
+		if _, ok := deleted[candidate]; ok {
+			return
+		}
+		seen[candidate] = struct{}{}
+		neighbors = append(neighbors, candidate)
+	}
+
+	for _, neighbor := range n.neighbors {
+		if _, ok := deleted[neighbor]; !ok {
+			add(neighbor)
+			continue
+		}
+		d, err := index.readNode(neighbor)
+		if err != nil {
+			return err
+		}
+		if d == nil {
+			continue
+		}
+		for _, candidate := range d.neighbors {
+			add(candidate)
+		}
+	}
+
+	n.neighbors = neighbors
+	candidates, err := index.scoreNeighbors(n, nil)
+	if err != nil {
+		return err
+	}
+	if n.neighbors, err = index.robustPrune(candidates); err != nil {
+		return err
+	}
+	return index.writeNode(n)
+}
+
+func (index *diskann) replaceDeletedEntryPoint(deleted map[uint64]struct{}) error {
+	index.entryPointLock.Lock()
+	defer index.entryPointLock.Unlock()
+
+	if _, ok := deleted[index.entryPoint]; !index.hasEntryPoint || !ok {
+		return nil
+	}
+
+	cursor := index.store.Bucket(index.graphBucketName()).Cursor()
+	defer cursor.Close()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		id := binary.BigEndian.Uint64(key)
+		if _, ok := deleted[id]; ok || index.isTombstoned(id) {
+			continue
+		}
+		if err := index.persistEntryPoint(id, true); err != nil {
+			return err
+		}
+		index.entryPoint = id
+		return nil
+	}
+
+	// every remaining node is deleted, the next insert starts a new graph
+	if err := index.persistEntryPoint(0, false); err != nil {
+		return err
+	}
+	index.entryPoint, index.hasEntryPoint = 0, false
+	return nil
+}
+
+func (index *diskann) removeNode(id uint64) error {
+	index.nodeLocks.Lock(id)
+	defer index.nodeLocks.Unlock(id)
+
+	n, err := index.readNode(id)
+	if err != nil {
+		return err
+	}
+	if n != nil {
+		if err := index.deleteNode(id); err != nil {
+			return err
+		}
+		index.count.Add(^uint64(0))
+	}
+
+	if err := index.store.Bucket(index.compressedBucketName()).Delete(common.IDKey(id)); err != nil {
+		return errors.Wrapf(err, "delete code of node %d", id)
+	}
+	index.codesLock.Lock()
+	delete(index.codes, id)
+	index.codesLock.Unlock()
+
+	if err := index.store.Bucket(index.tombstonesBucketName()).Delete(common.IDKey(id)); err != nil {
+		return errors.Wrapf(err, "delete tombstone of node %d", id)
+	}
+	index.tombstoneLock.Lock()
+	delete(index.tombstones, id)
+	index.tombstoneLock.Unlock()
+	return nil
+}
@@ -0,0 +1,405 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// diskann is a disk-resident graph index following the Vamana construction
+// of DiskANN. The graph and the full vectors live in an lsmkv bucket, so
+// memory usage is limited to the product quantization codes of the vectors,
+// which are used to steer the traversal. Every node which is expanded during
+// a search is read from disk anyway, so its exact distance is used for the
+// final ranking without an additional rescoring step.
+type diskann struct {
+	id                string
+	targetVector      string
+	rootPath          string
+	shardName         string
+	className         string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+
+	metadata            *common.Metadata
+	dims                atomic.Int32
+	trackDimensionsOnce sync.Once
+
+	// maxDegree and alpha define the shape of the persisted graph and are
+	// therefore immutable
+	maxDegree int
+	alpha     float32
+
+	// read on every search, stored as atomics so that config updates don't
+	// need a lock
+	searchListSize   atomic.Int64
+	buildListSize    atomic.Int64
+	flatSearchCutoff atomic.Int64
+
+	pqSegments      int
+	pqCentroids     int
+	pqTrainingLimit int
+
+	entryPointLock sync.RWMutex
+	entryPoint     uint64
+	hasEntryPoint  bool
+
+	// compressLock is held for reading by every operation that touches the
+	// graph and exclusively while the codebook is trained and all existing
+	// vectors are encoded
+	compressLock sync.RWMutex
+	pq           *compressionhelpers.ProductQuantizer
+	codesLock    sync.RWMutex
+	codes        map[uint64][]byte
+
+	tombstoneLock sync.RWMutex
+	tombstones    map[uint64]struct{}
+
+	nodeLocks *common.ShardedLocks
+	count     atomic.Uint64
+
+	compressing   atomic.Bool
+	compressionWg sync.WaitGroup
+
+	shutdownCtx                  context.Context
+	shutdownCtxCancel            context.CancelFunc
+	tombstoneCleanupCallbackCtrl cyclemanager.CycleCallbackCtrl
+}
+
+func New(cfg Config, uc diskannent.UserConfig, store *lsmkv.Store) (*diskann, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &diskann{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		rootPath:          cfg.RootPath,
+		shardName:         cfg.ShardName,
+		className:         cfg.ClassName,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		metadata:          common.NewMetadata(cfg.RootPath, metadataPrefix, cfg.TargetVector),
+		maxDegree:         uc.MaxDegree,
+		alpha:             float32(uc.Alpha),
+		pqSegments:        uc.PQ.Segments,
+		pqCentroids:       uc.PQ.Centroids,
+		pqTrainingLimit:   uc.PQ.TrainingLimit,
+		codes:             map[uint64][]byte{},
+		tombstones:        map[uint64]struct{}{},
+		nodeLocks:         common.NewDefaultShardedLocks(),
+	}
+	index.searchListSize.Store(int64(uc.SearchListSize))
+	index.buildListSize.Store(int64(uc.BuildListSize))
+	index.flatSearchCutoff.Store(int64(uc.FlatSearchCutoff))
+	index.shutdownCtx, index.shutdownCtxCancel = context.WithCancel(context.Background())
+
+	if err := index.initBuckets(); err != nil {
+		return nil, fmt.Errorf("init diskann index buckets: %w", err)
+	}
+	if err := index.restoreMetadata(); err != nil {
+		return nil, err
+	}
+	if err := index.restoreState(); err != nil {
+		return nil, err
+	}
+
+	id := strings.Join([]string{"diskann", "tombstone_cleanup", index.className,
+		index.shardName, index.targetVector}, "/")
+	if cfg.TombstoneCallbacks != nil {
+		index.tombstoneCleanupCallbackCtrl = cfg.TombstoneCallbacks.Register(id, index.cleanUpTombstones)
+	} else {
+		index.tombstoneCleanupCallbackCtrl = cyclemanager.NewCallbackCtrlNoop()
+	}
+
+	return index, nil
+}
+
+// restoreState loads everything that is kept in memory from the buckets:
+// the node count, the tombstones and, if the index is already compressed,
+// the codes.
+func (index *diskann) restoreState() error {
+	cursor := index.store.Bucket(index.graphBucketName()).Cursor()
+	count := uint64(0)
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		count++
+	}
+	cursor.Close()
+	index.count.Store(count)
+
+	cursor = index.store.Bucket(index.tombstonesBucketName()).Cursor()
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		index.tombstones[binary.BigEndian.Uint64(key)] = struct{}{}
+	}
+	cursor.Close()
+
+	if index.pq == nil {
+		return nil
+	}
+
+	cursor = index.store.Bucket(index.compressedBucketName()).Cursor()
+	defer cursor.Close()
+	for key, value := cursor.First(); key != nil; key, value = cursor.Next() {
+		index.codes[binary.BigEndian.Uint64(key)] = bytes.Clone(value)
+	}
+	return nil
+}
+
+func (index *diskann) pqConfig(segments int) hnswent.PQConfig {
+	return compressionhelpers.KMeansPQConfig(segments, index.pqCentroids, index.pqTrainingLimit)
+}
+
+func (index *diskann) getEntryPoint() (uint64, bool) {
+	index.entryPointLock.RLock()
+	defer index.entryPointLock.RUnlock()
+	return index.entryPoint, index.hasEntryPoint
+}
+
+func (index *diskann) isTombstoned(id uint64) bool {
+	index.tombstoneLock.RLock()
+	defer index.tombstoneLock.RUnlock()
+	_, ok := index.tombstones[id]
+	return ok
+}
+
+func (index *diskann) code(id uint64) []byte {
+	index.codesLock.RLock()
+	defer index.codesLock.RUnlock()
+	return index.codes[id]
+}
+
+func (index *diskann) Compressed() bool {
+	index.compressLock.RLock()
+	defer index.compressLock.RUnlock()
+	return index.pq != nil
+}
+
+func (index *diskann) Multivector() bool {
+	return false
+}
+
+func (index *diskann) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("AddMulti is not supported for diskann index")
+}
+
+func (index *diskann) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("AddMultiBatch is not supported for diskann index")
+}
+
+func (index *diskann) DeleteMulti(ids ...uint64) error {
+	return errors.Errorf("DeleteMulti is not supported for diskann index")
+}
+
+func (index *diskann) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVector is not supported for diskann index")
+}
+
+func (index *diskann) SearchByMultiVectorDistance(ctx context.Context, vector [][]float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVectorDistance is not supported for diskann index")
+}
+
+func (index *diskann) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(diskannent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	index.searchListSize.Store(int64(parsed.SearchListSize))
+	index.buildListSize.Store(int64(parsed.BuildListSize))
+	index.flatSearchCutoff.Store(int64(parsed.FlatSearchCutoff))
+
+	callback()
+	return nil
+}
+
+func (index *diskann) Drop(ctx context.Context) error {
+	if err := index.Shutdown(ctx); err != nil {
+		return err
+	}
+	if err := index.metadata.Remove(); err != nil {
+		return err
+	}
+	// Shard::drop will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) Flush() error {
+	// nothing to do here
+	// Shard will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) Shutdown(ctx context.Context) error {
+	index.shutdownCtxCancel()
+	if err := index.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
+		return errors.Wrap(err, "unregister tombstone cleanup")
+	}
+	// a running compression checks the shutdown context and returns early,
+	// but it must not write to the buckets after the shard closed them
+	index.compressionWg.Wait()
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (index *diskann) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (index *diskann) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	return index.metadata.ListFiles(basePath)
+}
+
+func (index *diskann) GetKeys(id uint64) (uint64, uint64, error) {
+	return 0, 0, errors.Errorf("GetKeys is not supported for diskann index")
+}
+
+func (index *diskann) ValidateBeforeInsert(vector []float32) error {
+	dims := int(index.dims.Load())
+	if dims == 0 {
+		return nil
+	}
+	if dims != len(vector) {
+		return errors.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+	return nil
+}
+
+func (index *diskann) ValidateMultiBeforeInsert(vector [][]float32) error {
+	return errors.Errorf("multi vectors are not supported for diskann index")
+}
+
+func (index *diskann) PostStartup() {}
+
+func (index *diskann) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", index.id)
+	fmt.Printf("Nodes: %d\n", index.count.Load())
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (index *diskann) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return index.distancerProvider.SingleDist(x, y)
+}
+
+func (index *diskann) ContainsNode(id uint64) bool {
+	if index.isTombstoned(id) {
+		return false
+	}
+	n, err := index.readNode(id)
+	return err == nil && n != nil
+}
+
+func (index *diskann) Iterate(fn func(id uint64) bool) {
+	cursor := index.store.Bucket(index.graphBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		id := binary.BigEndian.Uint64(key)
+		if index.isTombstoned(id) {
+			continue
+		}
+		if !fn(id) {
+			break
+		}
+	}
+}
+
+func (index *diskann) DistancerProvider() distancer.Provider {
+	return index.distancerProvider
+}
+
+func (index *diskann) AlreadyIndexed() uint64 {
+	return index.count.Load()
+}
+
+func (index *diskann) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = common.Normalized(index.distancerProvider, queryVector)
+	distFunc := func(nodeID uint64) (float32, error) {
+		n, err := index.readNode(nodeID)
+		if err != nil {
+			return 0, err
+		}
+		if n == nil {
+			return 0, fmt.Errorf("node %d does not exist", nodeID)
+		}
+		return index.distancerProvider.SingleDist(queryVector, n.vector)
+	}
+	return common.QueryVectorDistancer{DistanceFunc: distFunc}
+}
+
+func (index *diskann) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
+	return common.QueryVectorDistancer{}
+}
+
+func (index *diskann) Stats() (common.IndexStats, error) {
+	index.tombstoneLock.RLock()
+	tombstones := len(index.tombstones)
+	index.tombstoneLock.RUnlock()
+
+	entryPoint, _ := index.getEntryPoint()
+
+	return &DiskANNStats{
+		Dimensions:    index.dims.Load(),
+		Nodes:         index.count.Load(),
+		EntryPointID:  entryPoint,
+		NumTombstones: tombstones,
+		Compressed:    index.Compressed(),
+	}, nil
+}
+
+type DiskANNStats struct {
+	Dimensions    int32  `json:"dimensions"`
+	Nodes         uint64 `json:"nodes"`
+	EntryPointID  uint64 `json:"entryPointID"`
+	NumTombstones int    `json:"numTombstones"`
+	Compressed    bool   `json:"compressed"`
+}
+
+func (s *DiskANNStats) IndexType() common.IndexType {
+	return common.IndexTypeDiskANN
+}
@@ -0,0 +1,156 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package diskann
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	diskannent "github.com/weaviate/weaviate/entities/vectorindex/diskann"
+)
+
+func newTestIndex(t *testing.T, dir string, uc diskannent.UserConfig) (*diskann, *lsmkv.Store) {
+	logger, _ := test.NewNullLogger()
+	store := testinghelpers.NewStore(t, dir)
+	index, err := New(Config{
+		ID:               "diskann-test",
+		RootPath:         dir,
+		Logger:           logger,
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+	}, uc, store)
+	require.Nil(t, err)
+	return index, store
+}
+
+func TestDiskANN(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	vectors, queries := testinghelpers.RandomVecs(2000, 20, 32)
+	all := testinghelpers.VectorMap(vectors)
+	k := 10
+
+	uc := diskannent.NewDefaultUserConfig()
+	uc.MaxDegree = 24
+	uc.BuildListSize = 48
+	uc.SearchListSize = 64
+	uc.FlatSearchCutoff = 100
+	uc.PQ.Segments = 8
+	uc.PQ.TrainingLimit = 1000
+
+	index, store := newTestIndex(t, dir, uc)
+
+	t.Run("uncompressed", func(t *testing.T) {
+		require.Nil(t, index.AddBatch(ctx, []uint64{0, 1, 2}, vectors[:3]))
+		for i := 3; i < 900; i++ {
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+		assert.False(t, index.Compressed())
+		assert.Equal(t, uint64(900), index.AlreadyIndexed())
+		assert.Greater(t, testinghelpers.Recall(t, index,
+			testinghelpers.VectorMap(vectors[:900]), queries, k, nil), float32(0.9))
+	})
+
+	t.Run("compressed once the training limit is reached", func(t *testing.T) {
+		for i := 900; i < len(vectors); i++ {
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+		index.compressionWg.Wait()
+		assert.True(t, index.Compressed())
+		assert.Greater(t, testinghelpers.Recall(t, index, all, queries, k, nil), float32(0.9))
+	})
+
+	t.Run("filtered search", func(t *testing.T) {
+		testinghelpers.AssertFilteredSearch(t, index, all, queries, k, 0.9)
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		testinghelpers.AssertSearchByDistance(t, index, queries[0], k)
+	})
+
+	t.Run("deleted nodes are not returned", func(t *testing.T) {
+		ids, _, err := index.SearchByVector(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		require.Nil(t, index.Delete(ids...))
+
+		for _, id := range ids {
+			assert.False(t, index.ContainsNode(id))
+		}
+		after, _, err := index.SearchByVector(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		assert.Len(t, after, k)
+		for _, id := range ids {
+			assert.NotContains(t, after, id)
+		}
+
+		assert.True(t, index.cleanUpTombstones(func() bool { return false }))
+		assert.Equal(t, uint64(len(vectors)-k), index.AlreadyIndexed())
+		for _, id := range ids {
+			n, err := index.readNode(id)
+			require.Nil(t, err)
+			assert.Nil(t, n)
+		}
+		assert.Empty(t, index.tombstones)
+
+		// the graph around the deleted nodes was rebuilt, the results are
+		// approximate and may differ slightly
+		again, _, err := index.SearchByVector(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		assert.GreaterOrEqual(t, testinghelpers.MatchesInLists(after, again), uint64(k-2))
+	})
+
+	t.Run("state is restored after a restart", func(t *testing.T) {
+		ids, _, err := index.SearchByVector(ctx, queries[1], k, nil)
+		require.Nil(t, err)
+
+		require.Nil(t, index.Shutdown(ctx))
+		require.Nil(t, store.Shutdown(ctx))
+
+		index, store = newTestIndex(t, dir, uc)
+		defer store.Shutdown(ctx)
+		defer index.Shutdown(ctx)
+
+		assert.True(t, index.Compressed())
+		assert.Equal(t, uint64(len(vectors)-k), index.AlreadyIndexed())
+
+		restored, _, err := index.SearchByVector(ctx, queries[1], k, nil)
+		require.Nil(t, err)
+		assert.Equal(t, ids, restored)
+	})
+
+	t.Run("wrong dimensions are rejected", func(t *testing.T) {
+		testinghelpers.AssertWrongDimensionsRejected(t, index, uint64(len(vectors)))
+	})
+
+	t.Run("backup lists the metadata file", func(t *testing.T) {
+		files, err := index.ListFiles(ctx, dir)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"diskann.db"}, files)
+	})
+}
+
+func TestNode_Marshal(t *testing.T) {
+	n := &node{id: 7, vector: []float32{1, 2.5, -3}, neighbors: []uint64{1, 1 << 40}}
+	restored, err := unmarshalNode(7, n.marshal())
+	require.Nil(t, err)
+	assert.Equal(t, n, restored)
+
+	_, err = unmarshalNode(7, []byte{3, 0, 1})
+	assert.ErrorContains(t, err, "corrupt")
+}
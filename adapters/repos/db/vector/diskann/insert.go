@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+)
+
+// backlinkSlack is the factor by which the degree of a node may exceed
+// maxDegree through backlinks before it is pruned again. Without it, almost
+// every insert would have to prune all of its neighbors, each of which costs
+// maxDegree reads from disk.
+const backlinkSlack = 1.3
+
+func (index *diskann) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := index.Add(ctx, ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *diskann) Add(ctx context.Context, id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	var err error
+	index.trackDimensionsOnce.Do(func() {
+		index.dims.Store(int32(len(vector)))
+		err = index.setDimensions(int32(len(vector)))
+	})
+	if err != nil {
+		return err
+	}
+	if err := index.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+
+	vector = common.Normalized(index.distancerProvider, vector)
+
+	index.compressLock.RLock()
+	err = index.insert(ctx, id, vector)
+	index.compressLock.RUnlock()
+	if err != nil {
+		return errors.Wrapf(err, "insert node %d", id)
+	}
+
+	index.compressIfNeeded()
+	return nil
+}
+
+// insert must be called while holding compressLock for reading
+func (index *diskann) insert(ctx context.Context, id uint64, vector []float32) error {
+	existing, err := index.readNode(id)
+	if err != nil {
+		return err
+	}
+	if existing != nil {
+		// doc ids are never reused, so this is a retry of an earlier insert
+		return nil
+	}
+
+	if index.pq != nil {
+		if err := index.storeCode(id, index.pq.Encode(vector)); err != nil {
+			return err
+		}
+	}
+
+	entryPoint, ok := index.getEntryPoint()
+	if !ok {
+		index.entryPointLock.Lock()
+		if !index.hasEntryPoint {
+			defer index.entryPointLock.Unlock()
+			if err := index.writeNode(&node{id: id, vector: vector}); err != nil {
+				return err
+			}
+			if err := index.persistEntryPoint(id, true); err != nil {
+				return err
+			}
+			index.entryPoint, index.hasEntryPoint = id, true
+			index.count.Add(1)
+			return nil
+		}
+		entryPoint = index.entryPoint
+		index.entryPointLock.Unlock()
+	}
+
+	s := index.newSearcher(vector)
+	defer s.close()
+	expanded, err := index.greedySearch(ctx, s, entryPoint, int(index.buildListSize.Load()))
+	if err != nil {
+		return errors.Wrap(err, "search neighbors")
+	}
+
+	candidates := make([]scored, 0, len(expanded))
+	for _, c := range expanded {
+		if c.id != id && !index.isTombstoned(c.id) {
+			candidates = append(candidates, c)
+		}
+	}
+	neighbors, err := index.robustPrune(candidates)
+	if err != nil {
+		return err
+	}
+
+	index.nodeLocks.Lock(id)
+	err = index.writeNode(&node{id: id, vector: vector, neighbors: neighbors})
+	index.nodeLocks.Unlock(id)
+	if err != nil {
+		return err
+	}
+	index.count.Add(1)
+
+	for _, neighbor := range neighbors {
+		if err := index.addBacklink(neighbor, id, vector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// robustPrune is the RobustPrune of the Vamana paper. It picks the closest
+// candidate and discards every other candidate which is closer to the picked
+// one than alpha times its own distance, then repeats with the remaining
+// candidates until maxDegree neighbors are picked. Alpha > 1 keeps some long
+// edges, which is what makes the graph navigable in few hops.
+func (index *diskann) robustPrune(candidates []scored) ([]uint64, error) {
+	sort.Slice(candidates, func(a, b int) bool { return candidates[a].dist < candidates[b].dist })
+
+	neighbors := make([]uint64, 0, index.maxDegree)
+	for len(candidates) > 0 && len(neighbors) < index.maxDegree {
+		picked := candidates[0]
+		neighbors = append(neighbors, picked.id)
+
+		remaining := candidates[:0]
+		for _, c := range candidates[1:] {
+			if c.id == picked.id {
+				continue
+			}
+			dist, err := index.distancerProvider.SingleDist(picked.vector, c.vector)
+			if err != nil {
+				return nil, errors.Wrap(err, "prune neighbors")
+			}
+			if index.alpha*dist > c.dist {
+				remaining = append(remaining, c)
+			}
+		}
+		candidates = remaining
+	}
+	return neighbors, nil
+}
+
+// addBacklink adds the new node to the adjacency list of its neighbor. If the
+// neighbor's degree grows too large, its adjacency list is pruned again.
+func (index *diskann) addBacklink(neighborID, id uint64, vector []float32) error {
+	index.nodeLocks.Lock(neighborID)
+	defer index.nodeLocks.Unlock(neighborID)
+
+	n, err := index.readNode(neighborID)
+	if err != nil {
+		return err
+	}
+	if n == nil {
+		// cleaned up concurrently
+		return nil
+	}
+	for _, existing := range n.neighbors {
+		if existing == id {
+			return nil
+		}
+	}
+	n.neighbors = append(n.neighbors, id)
+
+	if float64(len(n.neighbors)) > float64(index.maxDegree)*backlinkSlack {
+		candidates, err := index.scoreNeighbors(n, map[uint64][]float32{id: vector})
+		if err != nil {
+			return err
+		}
+		if n.neighbors, err = index.robustPrune(candidates); err != nil {
+			return err
+		}
+	}
+
+	return index.writeNode(n)
+}
+
+// scoreNeighbors reads the vectors of all neighbors of n from disk unless
+// they are contained in known, and scores them against n. Neighbors which no
+// longer exist are dropped.
+func (index *diskann) scoreNeighbors(n *node, known map[uint64][]float32) ([]scored, error) {
+	candidates := make([]scored, 0, len(n.neighbors))
+	for _, id := range n.neighbors {
+		vector, ok := known[id]
+		if !ok {
+			neighbor, err := index.readNode(id)
+			if err != nil {
+				return nil, err
+			}
+			if neighbor == nil {
+				continue
+			}
+			vector = neighbor.vector
+		}
+		dist, err := index.distancerProvider.SingleDist(n.vector, vector)
+		if err != nil {
+			return nil, errors.Wrap(err, "score neighbors")
+		}
+		candidates = append(candidates, scored{id: id, dist: dist, vector: vector})
+	}
+	return candidates, nil
+}
+
+func (index *diskann) storeCode(id uint64, code []byte) error {
+	if err := index.store.Bucket(index.compressedBucketName()).Put(common.IDKey(id), code); err != nil {
+		return errors.Wrapf(err, "store code of node %d", id)
+	}
+
+	index.codesLock.Lock()
+	index.codes[id] = code
+	index.codesLock.Unlock()
+	return nil
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+)
+
+const (
+	metadataPrefix        = "diskann"
+	metadataKeyDimensions = "dimensions"
+	metadataKeyEntryPoint = "entrypoint"
+	metadataKeyCodebook   = "codebook"
+)
+
+// restoreMetadata loads dimensions, entry point and (if the index was already
+// compressed) the product quantizer from the metadata file
+func (index *diskann) restoreMetadata() error {
+	values, err := index.metadata.Get(metadataKeyDimensions, metadataKeyEntryPoint,
+		metadataKeyCodebook)
+	if err != nil {
+		return errors.Wrap(err, "restore metadata")
+	}
+	dims := values[metadataKeyDimensions]
+	entryPoint := values[metadataKeyEntryPoint]
+	codebook := values[metadataKeyCodebook]
+
+	if len(dims) == 4 {
+		d := int32(binary.LittleEndian.Uint32(dims))
+		index.trackDimensionsOnce.Do(func() {
+			index.dims.Store(d)
+		})
+	}
+	if len(entryPoint) == 8 {
+		index.entryPoint = binary.LittleEndian.Uint64(entryPoint)
+		index.hasEntryPoint = true
+	}
+	if len(codebook) > 0 {
+		pq, _, err := compressionhelpers.UnmarshalPQCodebook(codebook, index.pqConfig(0),
+			index.distancerProvider, index.logger)
+		if err != nil {
+			return errors.Wrap(err, "restore codebook")
+		}
+		index.pq = pq
+	}
+	return nil
+}
+
+func (index *diskann) setDimensions(dims int32) error {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dims))
+	return errors.Wrap(index.metadata.Put(map[string][]byte{
+		metadataKeyDimensions: buf,
+	}), "set dimensions")
+}
+
+func (index *diskann) persistEntryPoint(id uint64, ok bool) error {
+	if !ok {
+		return errors.Wrap(index.metadata.Put(map[string][]byte{
+			metadataKeyEntryPoint: nil,
+		}), "unset entry point")
+	}
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint64(buf, id)
+	return errors.Wrap(index.metadata.Put(map[string][]byte{
+		metadataKeyEntryPoint: buf,
+	}), "set entry point")
+}
@@ -0,0 +1,141 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+)
+
+// node is a single vertex of the graph as it is persisted on disk. The full
+// vector is stored next to the adjacency list, so that expanding a node
+// during a search only costs a single read.
+type node struct {
+	id        uint64
+	vector    []float32
+	neighbors []uint64
+}
+
+// record layout:
+//
+//	| neighbor count (uint16) | neighbors (uint64 each) | vector (float32 each) |
+func (n *node) marshal() []byte {
+	buf := make([]byte, 2+8*len(n.neighbors)+4*len(n.vector))
+	binary.LittleEndian.PutUint16(buf, uint16(len(n.neighbors)))
+	offset := 2
+	for _, neighbor := range n.neighbors {
+		binary.LittleEndian.PutUint64(buf[offset:], neighbor)
+		offset += 8
+	}
+	for _, v := range n.vector {
+		binary.LittleEndian.PutUint32(buf[offset:], math.Float32bits(v))
+		offset += 4
+	}
+	return buf
+}
+
+func unmarshalNode(id uint64, data []byte) (*node, error) {
+	if len(data) < 2 {
+		return nil, fmt.Errorf("node %d: record of %d bytes is too short", id, len(data))
+	}
+	neighborCount := int(binary.LittleEndian.Uint16(data))
+	offset := 2
+	if len(data) < offset+8*neighborCount || (len(data)-offset-8*neighborCount)%4 != 0 {
+		return nil, fmt.Errorf("node %d: record of %d bytes is corrupt", id, len(data))
+	}
+
+	n := &node{
+		id:        id,
+		neighbors: make([]uint64, neighborCount),
+		vector:    make([]float32, (len(data)-offset-8*neighborCount)/4),
+	}
+	for i := range n.neighbors {
+		n.neighbors[i] = binary.LittleEndian.Uint64(data[offset:])
+		offset += 8
+	}
+	for i := range n.vector {
+		n.vector[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[offset:]))
+		offset += 4
+	}
+	return n, nil
+}
+
+func (index *diskann) graphBucketName() string {
+	return index.bucketName(helpers.VectorsDiskANNBucketLSM)
+}
+
+func (index *diskann) compressedBucketName() string {
+	return index.bucketName(helpers.VectorsDiskANNCompressedBucketLSM)
+}
+
+func (index *diskann) tombstonesBucketName() string {
+	return index.bucketName(helpers.VectorsDiskANNTombstonesBucketLSM)
+}
+
+func (index *diskann) bucketName(prefix string) string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", prefix, index.targetVector)
+	}
+	return prefix
+}
+
+func (index *diskann) initBuckets() error {
+	for _, name := range []string{
+		index.graphBucketName(),
+		index.compressedBucketName(),
+		index.tombstonesBucketName(),
+	} {
+		if err := index.store.CreateOrLoadBucket(index.shutdownCtx, name,
+			lsmkv.WithUseBloomFilter(false),
+			lsmkv.WithCalcCountNetAdditions(false),
+			// see flat index, mmap is still faster for the random reads of a
+			// graph traversal
+			lsmkv.WithPread(false),
+		); err != nil {
+			return errors.Wrapf(err, "create or load bucket %q", name)
+		}
+	}
+	return nil
+}
+
+// readNode returns the node with the given id from disk. It returns nil if
+// the node does not exist (anymore).
+func (index *diskann) readNode(id uint64) (*node, error) {
+	data, err := index.store.Bucket(index.graphBucketName()).Get(common.IDKey(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read node %d", id)
+	}
+	if len(data) == 0 {
+		return nil, nil
+	}
+	return unmarshalNode(id, data)
+}
+
+func (index *diskann) writeNode(n *node) error {
+	if err := index.store.Bucket(index.graphBucketName()).Put(common.IDKey(n.id), n.marshal()); err != nil {
+		return errors.Wrapf(err, "write node %d", n.id)
+	}
+	return nil
+}
+
+func (index *diskann) deleteNode(id uint64) error {
+	if err := index.store.Bucket(index.graphBucketName()).Delete(common.IDKey(id)); err != nil {
+		return errors.Wrapf(err, "delete node %d", id)
+	}
+	return nil
+}
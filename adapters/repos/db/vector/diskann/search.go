@@ -0,0 +1,325 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+)
+
+// maxFilteredListSizeFactor caps how much the search list is widened for
+// filtered searches that are too broad for the flat search path
+const maxFilteredListSizeFactor = 8
+
+// scored is a node with its exact distance to a reference vector
+type scored struct {
+	id     uint64
+	dist   float32
+	vector []float32
+}
+
+type candidate struct {
+	id       uint64
+	dist     float32
+	expanded bool
+}
+
+// searcher holds the per-query state. Every record read from disk is kept
+// until the search is over, as a node is typically first scored as a
+// neighbor and later expanded.
+type searcher struct {
+	index       *diskann
+	query       []float32
+	pqDistancer *compressionhelpers.PQDistancer
+	nodes       map[uint64]*node
+}
+
+// newSearcher must be called while holding compressLock for reading
+func (index *diskann) newSearcher(query []float32) *searcher {
+	s := &searcher{
+		index: index,
+		query: query,
+		nodes: map[uint64]*node{},
+	}
+	if index.pq != nil {
+		s.pqDistancer = index.pq.NewDistancer(query)
+	}
+	return s
+}
+
+func (s *searcher) close() {
+	if s.pqDistancer != nil {
+		s.index.pq.ReturnDistancer(s.pqDistancer)
+	}
+}
+
+func (s *searcher) node(id uint64) (*node, error) {
+	if n, ok := s.nodes[id]; ok {
+		return n, nil
+	}
+	n, err := s.index.readNode(id)
+	if err != nil {
+		return nil, err
+	}
+	s.nodes[id] = n
+	return n, nil
+}
+
+func (s *searcher) exactDistance(n *node) (float32, error) {
+	return s.index.distancerProvider.SingleDist(s.query, n.vector)
+}
+
+// approxDistance uses the in-memory codes if the index is compressed and
+// falls back to reading the record otherwise. The second return value is
+// false if the node does not exist (anymore).
+func (s *searcher) approxDistance(id uint64) (float32, bool, error) {
+	if s.pqDistancer != nil {
+		if code := s.index.code(id); code != nil {
+			dist, err := s.pqDistancer.Distance(code)
+			return dist, err == nil, err
+		}
+	}
+
+	n, err := s.node(id)
+	if err != nil || n == nil {
+		return 0, false, err
+	}
+	dist, err := s.exactDistance(n)
+	return dist, err == nil, err
+}
+
+// greedySearch is the GreedySearch of the Vamana paper. It keeps a list of
+// the listSize closest candidates seen so far and expands the closest one
+// which has not been expanded yet until there is none left. It returns all
+// expanded nodes with their exact distance, sorted by that distance.
+// Tombstoned nodes are expanded like any other node, it is up to the caller
+// to skip them.
+func (index *diskann) greedySearch(ctx context.Context, s *searcher, start uint64,
+	listSize int,
+) ([]scored, error) {
+	startDist, ok, err := s.approxDistance(start)
+	if err != nil {
+		return nil, errors.Wrap(err, "score entry point")
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	list := make([]candidate, 1, listSize+1)
+	list[0] = candidate{id: start, dist: startDist}
+	seen := map[uint64]struct{}{start: {}}
+	var expanded []scored
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		pos := -1
+		for i := range list {
+			if !list[i].expanded {
+				pos = i
+				break
+			}
+		}
+		if pos == -1 {
+			break
+		}
+		list[pos].expanded = true
+
+		n, err := s.node(list[pos].id)
+		if err != nil {
+			return nil, err
+		}
+		if n == nil {
+			// deleted concurrently
+			continue
+		}
+		dist, err := s.exactDistance(n)
+		if err != nil {
+			return nil, err
+		}
+		expanded = append(expanded, scored{id: n.id, dist: dist, vector: n.vector})
+
+		for _, neighbor := range n.neighbors {
+			if _, ok := seen[neighbor]; ok {
+				continue
+			}
+			seen[neighbor] = struct{}{}
+
+			dist, ok, err := s.approxDistance(neighbor)
+			if err != nil {
+				return nil, err
+			}
+			if !ok || (len(list) >= listSize && dist >= list[len(list)-1].dist) {
+				continue
+			}
+
+			i := sort.Search(len(list), func(i int) bool { return list[i].dist > dist })
+			list = append(list, candidate{})
+			copy(list[i+1:], list[i:])
+			list[i] = candidate{id: neighbor, dist: dist}
+			if len(list) > listSize {
+				list = list[:listSize]
+			}
+		}
+	}
+
+	sort.Slice(expanded, func(a, b int) bool { return expanded[a].dist < expanded[b].dist })
+	return expanded, nil
+}
+
+func (index *diskann) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+	vector = common.Normalized(index.distancerProvider, vector)
+
+	index.compressLock.RLock()
+	defer index.compressLock.RUnlock()
+
+	s := index.newSearcher(vector)
+	defer s.close()
+
+	if allow != nil && int64(allow.Len()) < index.flatSearchCutoff.Load() {
+		return index.flatSearch(ctx, s, k, allow)
+	}
+
+	entryPoint, ok := index.getEntryPoint()
+	if !ok {
+		return nil, nil, nil
+	}
+
+	listSize := int(index.searchListSize.Load())
+	if listSize < k {
+		listSize = k
+	}
+	if allow != nil && allow.Len() > 0 {
+		// only a fraction of the visited nodes qualify, widen the list
+		// accordingly so that the result still contains k matches
+		factor := int(index.count.Load()) / allow.Len()
+		if factor > maxFilteredListSizeFactor {
+			factor = maxFilteredListSizeFactor
+		}
+		if factor > 1 {
+			listSize *= factor
+		}
+	}
+
+	expanded, err := index.greedySearch(ctx, s, entryPoint, listSize)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "greedy search")
+	}
+
+	ids := make([]uint64, 0, k)
+	dists := make([]float32, 0, k)
+	for _, res := range expanded {
+		if len(ids) == k {
+			break
+		}
+		if index.isTombstoned(res.id) || (allow != nil && !allow.Contains(res.id)) {
+			continue
+		}
+		ids = append(ids, res.id)
+		dists = append(dists, res.dist)
+	}
+	return ids, dists, nil
+}
+
+// flatSearch scores every allowed node instead of traversing the graph. For
+// restrictive filters this is cheaper than the graph search, which would have
+// to visit a large number of nodes to find enough matches. Once the index is
+// compressed, the codes are used to pick a shortlist which is then rescored
+// with the vectors from disk.
+func (index *diskann) flatSearch(ctx context.Context, s *searcher, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	shortlist := k
+	if s.pqDistancer != nil {
+		if listSize := int(index.searchListSize.Load()); listSize > shortlist {
+			shortlist = listSize
+		}
+	}
+
+	heap := priorityqueue.NewMax[any](shortlist)
+	it := allow.Iterator()
+	for id, ok := it.Next(); ok; id, ok = it.Next() {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if index.isTombstoned(id) {
+			continue
+		}
+		dist, ok, err := s.approxDistance(id)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "score node %d", id)
+		}
+		if !ok {
+			continue
+		}
+		if heap.Len() < shortlist || heap.Top().Dist > dist {
+			heap.Insert(id, dist)
+			if heap.Len() > shortlist {
+				heap.Pop()
+			}
+		}
+	}
+
+	if s.pqDistancer != nil {
+		candidates := heap
+		heap = priorityqueue.NewMax[any](k)
+		for candidates.Len() > 0 {
+			id := candidates.Pop().ID
+			n, err := s.node(id)
+			if err != nil {
+				return nil, nil, err
+			}
+			if n == nil {
+				continue
+			}
+			dist, err := s.exactDistance(n)
+			if err != nil {
+				return nil, nil, err
+			}
+			heap.Insert(id, dist)
+			if heap.Len() > k {
+				heap.Pop()
+			}
+		}
+	}
+
+	ids := make([]uint64, heap.Len())
+	dists := make([]float32, heap.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists, nil
+}
+
+func (index *diskann) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return common.SearchByVectorDistance(ctx, targetDistance, maxLimit,
+		func(ctx context.Context, k int) ([]uint64, []float32, error) {
+			return index.SearchByVector(ctx, vector, k, allow)
+		}, index.logger)
+}
@@ -16,7 +16,6 @@ import (
 	"encoding/binary"
 	"fmt"
 	"io"
-	"math"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -36,6 +35,7 @@ import (
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	werrors "github.com/weaviate/weaviate/entities/errors"
 	schemaconfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/monitoring"
@@ -436,13 +436,6 @@ func (dynamic *dynamic) Upgraded() bool {
 	return dynamic.upgraded.Load() && dynamic.index.(upgradableIndexer).Upgraded()
 }
 
-func float32SliceFromByteSlice(vector []byte, slice []float32) []float32 {
-	for i := range slice {
-		slice[i] = math.Float32frombits(binary.LittleEndian.Uint32(vector[i*4:]))
-	}
-	return slice
-}
-
 func (dynamic *dynamic) Upgrade(callback func()) error {
 	dynamic.Lock()
 	defer dynamic.Unlock()
@@ -505,8 +498,8 @@ func (dynamic *dynamic) Upgrade(callback func()) error {
 
 	for k, v := cursor.First(); k != nil; k, v = cursor.Next() {
 		id := binary.BigEndian.Uint64(k)
-		vc := make([]float32, len(v)/4)
-		float32SliceFromByteSlice(v, vc)
+		// the flat index stores the vectors with the data type shared with hnsw
+		vc := vectorIndexCommon.DecodeVector(dynamic.hnswUC.DataType, v, nil)
 
 		ch <- task{id: id, vector: vc}
 	}
@@ -55,6 +55,14 @@ func TestFlatUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name:    "attempting to change data type",
+				initial: ent.UserConfig{DataType: "float32"},
+				update:  ent.UserConfig{DataType: "float16"},
+				expectedError: errors.Errorf(
+					"dataType is immutable: " +
+						"attempted change from \"float32\" to \"float16\""),
+			},
 			{
 				name:          "changing rescoreLimit",
 				initial:       ent.UserConfig{BQ: ent.CompressionUserConfig{RescoreLimit: 10}},
@@ -38,6 +38,7 @@ import (
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	entlsmkv "github.com/weaviate/weaviate/entities/lsmkv"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/usecases/floatcomp"
 	bolt "go.etcd.io/bbolt"
@@ -47,6 +48,7 @@ const (
 	compressionBQ        = "bq"
 	compressionPQ        = "pq"
 	compressionSQ        = "sq"
+	compressionRQ        = "rq"
 	compressionNone      = "none"
 	defaultCachePageSize = 32
 )
@@ -64,6 +66,10 @@ type flat struct {
 	trackDimensionsOnce sync.Once
 	rescore             int64
 	bq                  compressionhelpers.BinaryQuantizer
+	rq                  atomic.Pointer[compressionhelpers.RotationalQuantizer]
+	rqBits              int
+	// set if vectors are stored with a narrower data type than float32
+	dataTypeDistancer *distancer.DataTypeDistancer
 
 	pqResults *common.PqMaxPool
 	pool      *pools
@@ -98,10 +104,18 @@ func New(cfg Config, uc flatent.UserConfig, store *lsmkv.Store) (*flat, error) {
 		rescore:              extractCompressionRescore(uc),
 		pqResults:            common.NewPqMaxPool(100),
 		compression:          extractCompression(uc),
+		rqBits:               uc.RQ.Bits,
 		pool:                 newPools(),
 		store:                store,
 		concurrentCacheReads: runtime.GOMAXPROCS(0) * 2,
 	}
+	if uc.DataType != "" && uc.DataType != vectorIndexCommon.DataTypeFloat32 {
+		d, err := distancer.NewDataTypeDistancer(cfg.DistanceProvider, uc.DataType)
+		if err != nil {
+			return nil, err
+		}
+		index.dataTypeDistancer = d
+	}
 	if err := index.initBuckets(context.Background()); err != nil {
 		return nil, fmt.Errorf("init flat index buckets: %w", err)
 	}
@@ -145,6 +159,10 @@ func extractCompression(uc flatent.UserConfig) string {
 		return compressionSQ
 	}
 
+	if uc.RQ.Enabled {
+		return compressionRQ
+	}
+
 	return compressionNone
 }
 
@@ -157,6 +175,8 @@ func extractCompressionRescore(uc flatent.UserConfig) int64 {
 		return int64(uc.BQ.RescoreLimit)
 	case compressionSQ:
 		return int64(uc.SQ.RescoreLimit)
+	case compressionRQ:
+		return int64(uc.RQ.RescoreLimit)
 	default:
 		return 0
 	}
@@ -180,6 +200,16 @@ func (index *flat) isBQ() bool {
 	return index.compression == compressionBQ
 }
 
+func (index *flat) isRQ() bool {
+	return index.compression == compressionRQ
+}
+
+// hasCompressedBucket is true for all compressions which store their encoded
+// vectors next to the uncompressed ones
+func (index *flat) hasCompressedBucket() bool {
+	return index.isBQ() || index.isRQ()
+}
+
 func (index *flat) isBQCached() bool {
 	return index.bqCache != nil
 }
@@ -229,7 +259,7 @@ func (index *flat) initBuckets(ctx context.Context) error {
 	); err != nil {
 		return fmt.Errorf("Create or load flat vectors bucket: %w", err)
 	}
-	if index.isBQ() {
+	if index.hasCompressedBucket() {
 		if err := index.store.CreateOrLoadBucket(ctx, index.getCompressedBucketName(),
 			lsmkv.WithForceCompation(forceCompaction),
 			lsmkv.WithUseBloomFilter(false),
@@ -321,13 +351,17 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 		if index.isBQ() {
 			index.bq = compressionhelpers.NewBinaryQuantizer(nil)
 		}
+		if index.isRQ() {
+			if err := index.initRQ(size); err != nil {
+				index.logger.WithError(err).Error("could not initialize rotational quantizer")
+			}
+		}
 	})
 	if len(vector) != int(index.dims) {
 		return errors.Errorf("insert called with a vector of the wrong size")
 	}
 	vector = index.normalized(vector)
-	slice := make([]byte, len(vector)*4)
-	index.storeVector(id, byteSliceFromFloat32Slice(vector, slice))
+	index.storeVector(id, index.encodeVector(vector))
 
 	if index.isBQ() {
 		vectorBQ := index.bq.Encode(vector)
@@ -335,9 +369,17 @@ func (index *flat) Add(ctx context.Context, id uint64, vector []float32) error {
 			index.bqCache.Grow(id)
 			index.bqCache.Preload(id, vectorBQ)
 		}
-		slice = make([]byte, len(vectorBQ)*8)
+		slice := make([]byte, len(vectorBQ)*8)
 		index.storeCompressedVector(id, byteSliceFromUint64Slice(vectorBQ, slice))
 	}
+
+	if index.isRQ() {
+		rq := index.rq.Load()
+		if rq == nil {
+			return errors.Errorf("rotational quantizer is not initialized")
+		}
+		index.storeCompressedVector(id, rq.Encode(vector))
+	}
 	newCount := atomic.LoadUint64(&index.count)
 	atomic.StoreUint64(&index.count, newCount+1)
 	return nil
@@ -363,7 +405,7 @@ func (index *flat) Delete(ids ...uint64) error {
 			return err
 		}
 
-		if index.isBQ() {
+		if index.hasCompressedBucket() {
 			if err := index.store.Bucket(index.getCompressedBucketName()).Delete(idBytes); err != nil {
 				return err
 			}
@@ -390,6 +432,8 @@ func (index *flat) SearchByVector(ctx context.Context, vector []float32, k int,
 	switch index.compression {
 	case compressionBQ:
 		return index.searchByVectorBQ(ctx, vector, k, allow)
+	case compressionRQ:
+		return index.searchByVectorRQ(ctx, vector, k, allow)
 	case compressionPQ:
 		// use uncompressed for now
 		fallthrough
@@ -420,7 +464,20 @@ func (index *flat) searchByVector(ctx context.Context, vector []float32, k int,
 	return ids, dists, nil
 }
 
+// encodeVector returns the vector as it is stored in the vectors bucket
+func (index *flat) encodeVector(vector []float32) []byte {
+	if index.dataTypeDistancer != nil {
+		return index.dataTypeDistancer.Encode(vector, nil)
+	}
+	return byteSliceFromFloat32Slice(vector, make([]byte, len(vector)*4))
+}
+
 func (index *flat) createDistanceCalc(vector []float32) distanceCalc {
+	if index.dataTypeDistancer != nil {
+		return func(vecAsBytes []byte) (float32, error) {
+			return index.dataTypeDistancer.FloatDistance(vector, vecAsBytes)
+		}
+	}
 	return func(vecAsBytes []byte) (float32, error) {
 		vecSlice := index.pool.float32SlicePool.Get(len(vecAsBytes) / 4)
 		defer index.pool.float32SlicePool.Put(vecSlice)
@@ -452,6 +509,46 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 		}
 	}
 
+	if err := index.rescoreCandidates(heap, vector, k); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := index.extractHeap(heap)
+	return ids, dists, nil
+}
+
+func (index *flat) searchByVectorRQ(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	rq := index.rq.Load()
+	if rq == nil {
+		// nothing was added yet
+		return nil, nil, nil
+	}
+
+	rescore := index.searchTimeRescore(k)
+	heap := index.pqResults.GetMax(rescore)
+	defer index.pqResults.Put(heap)
+
+	vector = index.normalized(vector)
+	distancer := rq.NewDistancer(vector)
+
+	if err := index.findTopVectors(heap, allow, rescore,
+		index.store.Bucket(index.getCompressedBucketName()).Cursor,
+		distancer.Distance,
+	); err != nil {
+		return nil, nil, err
+	}
+
+	if err := index.rescoreCandidates(heap, vector, k); err != nil {
+		return nil, nil, err
+	}
+
+	ids, dists := index.extractHeap(heap)
+	return ids, dists, nil
+}
+
+// rescoreCandidates replaces the candidates found with compressed distances
+// by the k closest of them according to their uncompressed vectors
+func (index *flat) rescoreCandidates(heap *priorityqueue.Queue[any], vector []float32, k int) error {
 	distanceCalc := index.createDistanceCalc(vector)
 	idsSlice := index.pool.uint64SlicePool.Get(heap.Len())
 	defer index.pool.uint64SlicePool.Put(idsSlice)
@@ -489,15 +586,13 @@ func (index *flat) searchByVectorBQ(ctx context.Context, vector []float32, k int
 	}
 
 	if err := eg.Wait(); err != nil {
-		return nil, nil, err
+		return err
 	}
 
 	for i, id := range idsSlice.slice {
 		index.insertToHeap(heap, k, id, distancesUncompressedVectors[i])
 	}
-
-	ids, dists := index.extractHeap(heap)
-	return ids, dists, nil
+	return nil
 }
 
 func (index *flat) createDistanceCalcBQ(vectorBQ []uint64) distanceCalc {
@@ -523,6 +618,17 @@ func (index *flat) vectorById(id uint64) ([]byte, error) {
 func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 	allow helpers.AllowList, limit int, cursorFn func() *lsmkv.CursorReplace,
 	distanceCalc distanceCalc,
+) error {
+	return index.scanVectors(allow, cursorFn, distanceCalc, func(id uint64, distance float32) {
+		index.insertToHeap(heap, limit, id, distance)
+	})
+}
+
+// scanVectors calls fn with the distance calculated by distanceCalc for every
+// allowed vector of the bucket
+func (index *flat) scanVectors(allow helpers.AllowList,
+	cursorFn func() *lsmkv.CursorReplace, distanceCalc distanceCalc,
+	fn func(id uint64, distance float32),
 ) error {
 	var key []byte
 	var v []byte
@@ -557,7 +663,7 @@ func (index *flat) findTopVectors(heap *priorityqueue.Queue[any],
 			if err != nil {
 				return err
 			}
-			index.insertToHeap(heap, limit, id, distance)
+			fn(id, distance)
 		}
 	}
 	return nil
@@ -657,67 +763,39 @@ func (index *flat) normalized(vector []float32) []float32 {
 	return vector
 }
 
+// SearchByVectorDistance returns all vectors within the target distance,
+// ordered by distance, in a single pass over the uncompressed vectors.
+// Compressed distances aren't comparable to the target distance, so unlike
+// SearchByVector this doesn't search the compressed vectors first.
+//
+// A positive maxLimit caps the number of results.
 func (index *flat) SearchByVectorDistance(ctx context.Context, vector []float32,
 	targetDistance float32, maxLimit int64, allow helpers.AllowList,
 ) ([]uint64, []float32, error) {
 	var (
-		searchParams = newSearchByDistParams(maxLimit)
-
 		resultIDs  []uint64
 		resultDist []float32
 	)
 
-	recursiveSearch := func() (bool, error) {
-		totalLimit := searchParams.TotalLimit()
-		ids, dist, err := index.SearchByVector(ctx, vector, totalLimit, allow)
-		if err != nil {
-			return false, errors.Wrap(err, "vector search")
-		}
-
-		// if there is less results than given limit search can be stopped
-		shouldContinue := !(len(ids) < totalLimit)
-
-		// ensures the indexes aren't out of range
-		offsetCap := searchParams.OffsetCapacity(ids)
-		totalLimitCap := searchParams.TotalLimitCapacity(ids)
-
-		if offsetCap == totalLimitCap {
-			return false, nil
-		}
-
-		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
-		for i := range ids {
-			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
-				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
-				resultIDs = append(resultIDs, ids[i])
-				resultDist = append(resultDist, dist[i])
-			} else {
-				// as soon as we encounter a certainty which
-				// is below threshold, we can stop searching
-				shouldContinue = false
-				break
+	vector = index.normalized(vector)
+	if err := index.scanVectors(allow,
+		index.store.Bucket(index.getBucketName()).Cursor,
+		index.createDistanceCalc(vector),
+		func(id uint64, distance float32) {
+			if distance <= targetDistance ||
+				floatcomp.InDelta(float64(distance), float64(targetDistance), 1e-6) {
+				resultIDs = append(resultIDs, id)
+				resultDist = append(resultDist, distance)
 			}
-		}
-
-		return shouldContinue, nil
+		},
+	); err != nil {
+		return nil, nil, errors.Wrap(err, "vector search by distance")
 	}
 
-	var shouldContinue bool
-	var err error
-	for shouldContinue, err = recursiveSearch(); shouldContinue && err == nil; {
-		searchParams.Iterate()
-		if searchParams.MaxLimitReached() {
-			index.logger.
-				WithField("action", "unlimited_vector_search").
-				Warnf("maximum search limit of %d results has been reached",
-					searchParams.MaximumSearchLimit())
-			break
-		}
-	}
-	if err != nil {
-		return nil, nil, err
+	common.SortByDistance(resultIDs, resultDist)
+	if maxLimit > 0 && int64(len(resultIDs)) > maxLimit {
+		resultIDs, resultDist = resultIDs[:maxLimit], resultDist[:maxLimit]
 	}
-
 	return resultIDs, resultDist, nil
 }
 
@@ -924,13 +1002,6 @@ func (index *flat) DistancerProvider() distancer.Provider {
 	return index.distancerProvider
 }
 
-func newSearchByDistParams(maxLimit int64) *common.SearchByDistParams {
-	initialOffset := 0
-	initialLimit := common.DefaultSearchByDistInitialLimit
-
-	return common.NewSearchByDistParams(initialOffset, initialLimit, initialOffset+initialLimit, maxLimit)
-}
-
 type immutableParameter struct {
 	accessor func(c flatent.UserConfig) interface{}
 	name     string
@@ -965,6 +1036,10 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "distance",
 			accessor: func(c flatent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "dataType",
+			accessor: func(c flatent.UserConfig) interface{} { return c.DataType },
+		},
 		{
 			name:     "pq.cache",
 			accessor: func(c flatent.UserConfig) interface{} { return c.PQ.Cache },
@@ -977,6 +1052,14 @@ func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) e
 			name:     "bq",
 			accessor: func(c flatent.UserConfig) interface{} { return c.BQ.Enabled },
 		},
+		{
+			name:     "rq",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Enabled },
+		},
+		{
+			name:     "rq.bits",
+			accessor: func(c flatent.UserConfig) interface{} { return c.RQ.Bits },
+		},
 		// as of v1.25.2, updating the BQ cache setting is now possible.
 		// Note that the change does not take effect until the tenant is
 		// reloaded, either from a complete restart or from
@@ -998,12 +1081,13 @@ func (index *flat) AlreadyIndexed() uint64 {
 func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
 	var distFunc func(nodeID uint64) (float32, error)
 	queryVector = index.normalized(queryVector)
+	distanceCalc := index.createDistanceCalc(queryVector)
 	defaultDistFunc := func(nodeID uint64) (float32, error) {
 		vec, err := index.vectorById(nodeID)
 		if err != nil {
 			return 0, err
 		}
-		dist, err := index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec, make([]float32, len(vec)/4)))
+		dist, err := distanceCalc(vec)
 		if err != nil {
 			return 0, err
 		}
@@ -1030,17 +1114,7 @@ func (index *flat) QueryVectorDistancer(queryVector []float32) common.QueryVecto
 		// use uncompressed for now
 		fallthrough
 	default:
-		distFunc = func(nodeID uint64) (float32, error) {
-			vec, err := index.vectorById(nodeID)
-			if err != nil {
-				return 0, err
-			}
-			dist, err := index.distancerProvider.SingleDist(queryVector, float32SliceFromByteSlice(vec, make([]float32, len(vec)/4)))
-			if err != nil {
-				return 0, err
-			}
-			return dist, nil
-		}
+		distFunc = defaultDistFunc
 	}
 	return common.QueryVectorDistancer{DistanceFunc: distFunc}
 }
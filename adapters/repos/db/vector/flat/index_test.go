@@ -37,6 +37,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	flatent "github.com/weaviate/weaviate/entities/vectorindex/flat"
 )
 
@@ -72,6 +73,9 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 	bq := flatent.CompressionUserConfig{
 		Enabled: false,
 	}
+	rq := flatent.RQUserConfig{
+		Enabled: false,
+	}
 	switch compression {
 	case compressionPQ:
 		pq.Enabled = true
@@ -81,6 +85,10 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 		bq.Enabled = true
 		bq.RescoreLimit = 100 * k
 		bq.Cache = vectorCache
+	case compressionRQ:
+		rq.Enabled = true
+		rq.RescoreLimit = 10 * k
+		rq.Bits = 8
 	}
 	index, err := New(Config{
 		ID:               runId,
@@ -89,6 +97,7 @@ func run(ctx context.Context, dirName string, logger *logrus.Logger, compression
 	}, flatent.UserConfig{
 		PQ: pq,
 		BQ: bq,
+		RQ: rq,
 	}, store)
 	if err != nil {
 		return 0, 0, err
@@ -185,11 +194,11 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 	}
 
 	extraVectorsForDelete, _ := testinghelpers.RandomVecs(5_000, 0, dimensions)
-	for _, compression := range []string{compressionNone, compressionBQ} {
+	for _, compression := range []string{compressionNone, compressionBQ, compressionRQ} {
 		t.Run("compression: "+compression, func(t *testing.T) {
 			for _, cache := range []bool{false, true} {
 				t.Run("cache: "+strconv.FormatBool(cache), func(t *testing.T) {
-					if compression == compressionNone && cache == true {
+					if (compression == compressionNone || compression == compressionRQ) && cache == true {
 						return
 					}
 					targetRecall := float32(0.99)
@@ -217,10 +226,13 @@ func Test_NoRaceFlatIndex(t *testing.T) {
 			}
 		})
 	}
-	for _, compression := range []string{compressionNone, compressionBQ} {
+	for _, compression := range []string{compressionNone, compressionBQ, compressionRQ} {
 		t.Run("compression: "+compression, func(t *testing.T) {
 			for _, cache := range []bool{false, true} {
 				t.Run("cache: "+strconv.FormatBool(cache), func(t *testing.T) {
+					if compression == compressionRQ && cache == true {
+						return
+					}
 					from := 0
 					to := 3_000
 					for i := range queries {
@@ -356,3 +368,139 @@ func TestConcurrentReads(t *testing.T) {
 		})
 	}
 }
+
+func TestFlatDataTypes(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dimensions := 32
+	k := 10
+
+	for _, dataType := range []string{
+		vectorIndexCommon.DataTypeFloat16,
+		vectorIndexCommon.DataTypeBFloat16,
+		vectorIndexCommon.DataTypeInt8,
+	} {
+		t.Run(dataType, func(t *testing.T) {
+			vectors, queries := testinghelpers.RandomVecsFixedSeed(500, 10, dimensions)
+			if dataType == vectorIndexCommon.DataTypeInt8 {
+				// emulate a model with int8 output
+				for _, vecs := range [][][]float32{vectors, queries} {
+					for _, vec := range vecs {
+						for i := range vec {
+							vec[i] = float32(vectorIndexCommon.Float32ToInt8(vec[i] * 100))
+						}
+					}
+				}
+			}
+			distancer := distancer.NewL2SquaredProvider()
+
+			dirName := t.TempDir()
+			store, err := lsmkv.New(dirName, dirName, logger, nil,
+				cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop())
+			require.Nil(t, err)
+			defer store.Shutdown(ctx)
+
+			uc := flatent.NewDefaultUserConfig()
+			uc.Distance = vectorIndexCommon.DistanceL2Squared
+			uc.DataType = dataType
+			index, err := New(Config{
+				ID:               "id",
+				RootPath:         t.TempDir(),
+				DistanceProvider: distancer,
+			}, uc, store)
+			require.Nil(t, err)
+
+			for i, vec := range vectors {
+				require.Nil(t, index.Add(ctx, uint64(i), vec))
+			}
+
+			stored, err := index.vectorById(0)
+			require.Nil(t, err)
+			assert.Len(t, stored, dimensions*vectorIndexCommon.DataTypeSize(dataType))
+
+			// search results must be exact with respect to the stored vectors
+			decoded := make([][]float32, len(vectors))
+			for i, vec := range vectors {
+				decoded[i] = vectorIndexCommon.DecodeVector(dataType,
+					vectorIndexCommon.EncodeVector(dataType, vec, nil), nil)
+			}
+			for _, query := range queries {
+				truth, _ := testinghelpers.BruteForce(logger, decoded, query, k, distanceWrapper(distancer))
+				ids, dists, err := index.SearchByVector(ctx, query, k, nil)
+				require.Nil(t, err)
+				assert.ElementsMatch(t, truth, ids)
+
+				expected, err := distancer.SingleDist(query, decoded[ids[0]])
+				require.Nil(t, err)
+				assert.InEpsilon(t, expected, dists[0], 1e-4)
+			}
+		})
+	}
+}
+
+func TestFlatSearchByVectorDistance(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dimensions := 32
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(1000, 10, dimensions)
+	distancer := distancer.NewL2SquaredProvider()
+
+	for _, bq := range []bool{false, true} {
+		t.Run(fmt.Sprintf("bq=%v", bq), func(t *testing.T) {
+			dirName := t.TempDir()
+			store, err := lsmkv.New(dirName, dirName, logger, nil,
+				cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop(),
+				cyclemanager.NewCallbackGroupNoop())
+			require.Nil(t, err)
+			defer store.Shutdown(ctx)
+
+			uc := flatent.NewDefaultUserConfig()
+			uc.Distance = vectorIndexCommon.DistanceL2Squared
+			uc.BQ.Enabled = bq
+			index, err := New(Config{
+				ID:               "id",
+				RootPath:         t.TempDir(),
+				DistanceProvider: distancer,
+			}, uc, store)
+			require.Nil(t, err)
+
+			for i, vec := range vectors {
+				require.Nil(t, index.Add(ctx, uint64(i), vec))
+			}
+
+			allow := helpers.NewAllowList()
+			for id := uint64(0); id < uint64(len(vectors)); id += 3 {
+				allow.Insert(id)
+			}
+
+			for _, query := range queries {
+				// the range of the 300 closest vectors exceeds what a single
+				// search with the initial limit would find
+				truth, truthDists := testinghelpers.BruteForce(logger, vectors, query, 300, distanceWrapper(distancer))
+				targetDistance := truthDists[len(truthDists)-1]
+
+				ids, dists, err := index.SearchByVectorDistance(ctx, query, targetDistance, -1, nil)
+				require.Nil(t, err)
+				assert.ElementsMatch(t, truth, ids)
+				assert.IsNonDecreasing(t, dists)
+
+				ids, _, err = index.SearchByVectorDistance(ctx, query, targetDistance, 50, nil)
+				require.Nil(t, err)
+				assert.Equal(t, truth[:50], ids)
+
+				ids, _, err = index.SearchByVectorDistance(ctx, query, targetDistance, -1, allow)
+				require.Nil(t, err)
+				var expected []uint64
+				for _, id := range truth {
+					if allow.Contains(id) {
+						expected = append(expected, id)
+					}
+				}
+				assert.ElementsMatch(t, expected, ids)
+			}
+		})
+	}
+}
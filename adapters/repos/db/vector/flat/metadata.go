@@ -14,11 +14,14 @@ package flat
 import (
 	"encoding/binary"
 	"fmt"
+	"math/rand"
 	"os"
 	"path/filepath"
 	"sync/atomic"
 
 	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -98,6 +101,12 @@ func (index *flat) initMetadata() error {
 
 	index.initDimensions()
 
+	if dims := atomic.LoadInt32(&index.dims); dims > 0 && index.isRQ() {
+		if err := index.initRQ(dims); err != nil {
+			return errors.Wrap(err, "init rotational quantizer")
+		}
+	}
+
 	return nil
 }
 
@@ -157,13 +166,18 @@ func (index *flat) calculateDimensions() int32 {
 	cursor := bucket.Cursor()
 	defer cursor.Close()
 
+	bytesPerDimension := 4
+	if index.dataTypeDistancer != nil {
+		bytesPerDimension = vectorIndexCommon.DataTypeSize(index.dataTypeDistancer.DataType())
+	}
+
 	var key []byte
 	var v []byte
 	const maxCursorSize = 100000
 	i := 0
 	for key, v = cursor.First(); key != nil; key, v = cursor.Next() {
 		if len(v) > 0 {
-			return int32(len(v) / 4)
+			return int32(len(v) / bytesPerDimension)
 		}
 		if i > maxCursorSize {
 			break
@@ -195,3 +209,89 @@ func (index *flat) setDimensions(dimensions int32) error {
 
 	return nil
 }
+
+// initRQ restores the rotational quantizer from the metadata or creates a new
+// one with a random rotation which is persisted, so that vectors encoded
+// before a restart remain comparable
+func (index *flat) initRQ(dimensions int32) error {
+	data, err := index.fetchRQData()
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		data = &compressionhelpers.RQData{
+			Dimensions: uint16(dimensions),
+			Bits:       uint8(index.rqBits),
+			Seed:       rand.Uint64(),
+		}
+		if err := index.setRQData(*data); err != nil {
+			return err
+		}
+	}
+
+	rq, err := compressionhelpers.RestoreRotationalQuantizer(*data, index.distancerProvider)
+	if err != nil {
+		return err
+	}
+	index.rq.Store(rq)
+	return nil
+}
+
+func (index *flat) fetchRQData() (*compressionhelpers.RQData, error) {
+	err := index.openMetadata()
+	if err != nil {
+		return nil, err
+	}
+	defer index.closeMetadata()
+
+	var data *compressionhelpers.RQData
+	err = index.metadata.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vectorMetadataBucket))
+		if b == nil {
+			return nil
+		}
+		v := b.Get([]byte("rq"))
+		if v == nil {
+			return nil
+		}
+		if len(v) != 11 {
+			return errors.Errorf("invalid rq metadata of length %d", len(v))
+		}
+		data = &compressionhelpers.RQData{
+			Dimensions: binary.LittleEndian.Uint16(v[0:2]),
+			Bits:       v[2],
+			Seed:       binary.LittleEndian.Uint64(v[3:11]),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "fetch rq data")
+	}
+
+	return data, nil
+}
+
+func (index *flat) setRQData(data compressionhelpers.RQData) error {
+	err := index.openMetadata()
+	if err != nil {
+		return err
+	}
+	defer index.closeMetadata()
+
+	err = index.metadata.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(vectorMetadataBucket))
+		if b == nil {
+			return errors.New("failed to get bucket")
+		}
+		buf := make([]byte, 11)
+		binary.LittleEndian.PutUint16(buf[0:2], data.Dimensions)
+		buf[2] = data.Bits
+		binary.LittleEndian.PutUint64(buf[3:11], data.Seed)
+		return b.Put([]byte("rq"), buf)
+	})
+	if err != nil {
+		return errors.Wrap(err, "set rq data")
+	}
+
+	return nil
+}
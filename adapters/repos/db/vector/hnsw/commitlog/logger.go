@@ -43,6 +43,7 @@ const (
 	AddLinksAtLevel   // added in v1.8.0-rc.1, see https://github.com/weaviate/weaviate/issues/1705
 	AddPQ
 	AddSQ
+	AddRQ
 )
 
 func NewLogger(fileName string) *Logger {
@@ -107,6 +108,16 @@ func (l *Logger) AddSQCompression(data compressionhelpers.SQData) error {
 	return err
 }
 
+func (l *Logger) AddRQCompression(data compressionhelpers.RQData) error {
+	toWrite := make([]byte, 12)
+	toWrite[0] = byte(AddRQ)
+	binary.LittleEndian.PutUint16(toWrite[1:], data.Dimensions)
+	toWrite[3] = data.Bits
+	binary.LittleEndian.PutUint64(toWrite[4:], data.Seed)
+	_, err := l.bufw.Write(toWrite)
+	return err
+}
+
 func (l *Logger) AddLinkAtLevel(id uint64, level int, target uint64) error {
 	toWrite := make([]byte, 19)
 	toWrite[0] = byte(AddLinkAtLevel)
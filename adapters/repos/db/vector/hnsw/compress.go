@@ -15,6 +15,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
@@ -23,12 +24,15 @@ import (
 )
 
 func (h *hnsw) compress(cfg ent.UserConfig) error {
-	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled {
+	if !cfg.PQ.Enabled && !cfg.BQ.Enabled && !cfg.SQ.Enabled && !cfg.RQ.Enabled {
 		return nil
 	}
 
 	h.compressActionLock.Lock()
 	defer h.compressActionLock.Unlock()
+	if h.multivector.Load() {
+		return h.compressMulti(cfg)
+	}
 	data := h.cache.All()
 	if cfg.PQ.Enabled || cfg.SQ.Enabled {
 		if h.isEmpty() {
@@ -94,6 +98,19 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 			}
 		}
 		h.compressor.PersistCompression(h.commitLog)
+	} else if cfg.RQ.Enabled {
+		if h.isEmpty() {
+			return errors.New("compress command cannot be executed before inserting some data")
+		}
+		var err error
+		h.compressor, err = compressionhelpers.NewHNSWRQCompressor(
+			h.distancerProvider, 1e12, h.logger, int(atomic.LoadInt32(&h.dims)),
+			cfg.RQ.Bits, h.store, h.allocChecker)
+		if err != nil {
+			h.rqConfig.Enabled = false
+			return fmt.Errorf("compressing vectors: %w", err)
+		}
+		h.compressor.PersistCompression(h.commitLog)
 	} else {
 		var err error
 		h.compressor, err = compressionhelpers.NewBQCompressor(
@@ -114,3 +131,117 @@ func (h *hnsw) compress(cfg ent.UserConfig) error {
 	h.cache.Drop()
 	return nil
 }
+
+// compressMulti compresses the token vectors of a multivector index. The
+// quantizers are trained on a sample of token vectors, the compressed vectors
+// are grouped by document just like the uncompressed ones.
+func (h *hnsw) compressMulti(cfg ent.UserConfig) error {
+	if h.isEmpty() {
+		return errors.New("compress command cannot be executed before inserting some data")
+	}
+
+	h.RLock()
+	nodeCount := len(h.nodes)
+	maxDocID := h.maxDocID
+	docIDVectors := make(map[uint64][]uint64, len(h.docIDVectors))
+	for docID, ids := range h.docIDVectors {
+		docIDVectors[docID] = ids
+	}
+	h.RUnlock()
+
+	var err error
+	switch {
+	case cfg.PQ.Enabled || cfg.SQ.Enabled:
+		trainingLimit := cfg.PQ.TrainingLimit
+		cleanData := make([][]float32, 0, min(nodeCount, trainingLimit))
+		sampler := common.NewSparseFisherYatesIterator(nodeCount)
+		for !sampler.IsDone() {
+			sampledIndex := sampler.Next()
+			if sampledIndex == nil {
+				break
+			}
+			if h.nodeByID(uint64(*sampledIndex)) == nil {
+				continue
+			}
+			p, err := h.cache.Get(context.Background(), uint64(*sampledIndex))
+			if err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					// already deleted, ignore
+					continue
+				}
+				return fmt.Errorf("unexpected error obtaining vectors for fitting: %w", err)
+			}
+			if len(p) == 0 {
+				continue
+			}
+
+			cleanData = append(cleanData, p)
+			if len(cleanData) >= trainingLimit {
+				break
+			}
+		}
+		if cfg.PQ.Enabled {
+			dims := int(h.dims)
+			if cfg.PQ.Segments <= 0 {
+				cfg.PQ.Segments = common.CalculateOptimalSegments(dims)
+				h.pqConfig.Segments = cfg.PQ.Segments
+			}
+			h.compressor, err = compressionhelpers.NewHNSWPQMultiCompressor(
+				cfg.PQ, h.distancerProvider, dims, 1e12, h.logger, cleanData, h.store,
+				h.allocChecker)
+			if err != nil {
+				h.pqConfig.Enabled = false
+				return fmt.Errorf("compressing vectors: %w", err)
+			}
+		} else {
+			h.compressor, err = compressionhelpers.NewHNSWSQMultiCompressor(
+				h.distancerProvider, 1e12, h.logger, cleanData, h.store,
+				h.allocChecker)
+			if err != nil {
+				h.sqConfig.Enabled = false
+				return fmt.Errorf("compressing vectors: %w", err)
+			}
+		}
+		h.compressor.PersistCompression(h.commitLog)
+	case cfg.RQ.Enabled:
+		h.compressor, err = compressionhelpers.NewHNSWRQMultiCompressor(
+			h.distancerProvider, 1e12, h.logger, int(atomic.LoadInt32(&h.dims)),
+			cfg.RQ.Bits, h.store, h.allocChecker)
+		if err != nil {
+			h.rqConfig.Enabled = false
+			return fmt.Errorf("compressing vectors: %w", err)
+		}
+		h.compressor.PersistCompression(h.commitLog)
+	default:
+		h.compressor, err = compressionhelpers.NewBQMultiCompressor(
+			h.distancerProvider, 1e12, h.logger, h.store, h.allocChecker)
+		if err != nil {
+			return err
+		}
+	}
+
+	h.compressor.GrowMultiCache(maxDocID)
+	for docID, ids := range docIDVectors {
+		vecs, errs := h.cache.MultiGet(context.Background(), ids)
+		deleted := false
+		for _, err := range errs {
+			if err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					deleted = true
+					break
+				}
+				return fmt.Errorf("obtaining vectors of doc %d for compression: %w", docID, err)
+			}
+		}
+		if deleted {
+			continue
+		}
+		h.compressor.PreloadMulti(docID, ids, vecs)
+	}
+
+	h.compressed.Store(true)
+	h.cache.Drop()
+	return nil
+}
@@ -42,8 +42,9 @@ type Config struct {
 	FlatSearchConcurrency     int
 
 	// metadata for monitoring
-	ShardName string
-	ClassName string
+	ShardName    string
+	ClassName    string
+	TargetVector string
 
 	VisitedListPoolMaxSize int
 }
@@ -56,10 +56,18 @@ func ValidateUserConfigUpdate(initial, updated config.VectorIndexConfig) error {
 			name:     "distance",
 			accessor: func(c ent.UserConfig) interface{} { return c.Distance },
 		},
+		{
+			name:     "dataType",
+			accessor: func(c ent.UserConfig) interface{} { return c.DataType },
+		},
 		{
 			name:     "multivector enabled",
 			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.Enabled },
 		},
+		{
+			name:     "multivector muvera",
+			accessor: func(c ent.UserConfig) interface{} { return c.Multivector.Muvera },
+		},
 	}
 
 	for _, u := range immutableFields {
@@ -105,8 +113,9 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	atomic.StoreInt64(&h.flatSearchCutoff, int64(parsed.FlatSearchCutoff))
 
 	h.acornSearch.Store(parsed.FilterStrategy == ent.FilterStrategyAcorn)
+	h.updateRecallProbe(parsed.RecallProbe)
 
-	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled {
+	if !parsed.PQ.Enabled && !parsed.BQ.Enabled && !parsed.SQ.Enabled && !parsed.RQ.Enabled {
 		callback()
 		return nil
 	}
@@ -114,6 +123,7 @@ func (h *hnsw) UpdateUserConfig(updated config.VectorIndexConfig, callback func(
 	h.pqConfig = parsed.PQ
 	h.sqConfig = parsed.SQ
 	h.bqConfig = parsed.BQ
+	h.rqConfig = parsed.RQ
 	if asyncEnabled() {
 		callback()
 		return nil
@@ -154,6 +164,7 @@ func (h *hnsw) compressThenCallback(callback func()) {
 		PQ: h.pqConfig,
 		BQ: h.bqConfig,
 		SQ: h.sqConfig,
+		RQ: h.rqConfig,
 	}
 	if err := h.compress(uc); err != nil {
 		h.logger.Error(err)
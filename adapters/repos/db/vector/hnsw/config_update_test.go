@@ -63,6 +63,14 @@ func TestUserConfigUpdates(t *testing.T) {
 					"distance is immutable: " +
 						"attempted change from \"cosine\" to \"l2-squared\""),
 			},
+			{
+				name:    "attempting to change data type",
+				initial: ent.UserConfig{DataType: "float32"},
+				update:  ent.UserConfig{DataType: "float16"},
+				expectedError: errors.Errorf(
+					"dataType is immutable: " +
+						"attempted change from \"float32\" to \"float16\""),
+			},
 			{
 				name: "attempting to change multivector",
 				initial: ent.UserConfig{Multivector: ent.MultivectorConfig{
@@ -75,6 +83,20 @@ func TestUserConfigUpdates(t *testing.T) {
 					"multivector enabled is immutable: " +
 						"attempted change from \"false\" to \"true\""),
 			},
+			{
+				name: "attempting to change muvera",
+				initial: ent.UserConfig{Multivector: ent.MultivectorConfig{
+					Enabled: true,
+					Muvera:  ent.MuveraConfig{Enabled: true, KSim: 4, DProjections: 16, Repetitions: 10},
+				}},
+				update: ent.UserConfig{Multivector: ent.MultivectorConfig{
+					Enabled: true,
+					Muvera:  ent.MuveraConfig{Enabled: true, KSim: 5, DProjections: 16, Repetitions: 10},
+				}},
+				expectedError: errors.Errorf(
+					"multivector muvera is immutable: " +
+						"attempted change from \"{true 4 16 10}\" to \"{true 5 16 10}\""),
+			},
 			{
 				name:          "changing ef",
 				initial:       ent.UserConfig{EF: 100},
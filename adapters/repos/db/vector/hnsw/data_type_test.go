@@ -0,0 +1,125 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestDataTypeIndexAndRestart(t *testing.T) {
+	for _, dataType := range []string{
+		vectorIndexCommon.DataTypeFloat16,
+		vectorIndexCommon.DataTypeBFloat16,
+		vectorIndexCommon.DataTypeInt8,
+	} {
+		t.Run(dataType, func(t *testing.T) {
+			ctx := context.Background()
+			logger, _ := test.NewNullLogger()
+			rootPath := t.TempDir()
+			store := testinghelpers.NewDummyStore(t)
+			dimensions := 64
+			k := 10
+			vectors, queries := testinghelpers.RandomVecsFixedSeed(1000, 10, dimensions)
+			if dataType == vectorIndexCommon.DataTypeInt8 {
+				// emulate a model with int8 output
+				for _, vecs := range [][][]float32{vectors, queries} {
+					for _, vec := range vecs {
+						for i := range vec {
+							vec[i] = float32(vectorIndexCommon.Float32ToInt8(vec[i] * 100))
+						}
+					}
+				}
+			}
+			distancer := distancer.NewL2SquaredProvider()
+
+			uc := ent.NewDefaultUserConfig()
+			uc.EF = 64
+			uc.Distance = vectorIndexCommon.DistanceL2Squared
+			uc.DataType = dataType
+
+			newIndex := func() *hnsw {
+				index, err := New(Config{
+					RootPath: rootPath,
+					ID:       "datatype",
+					MakeCommitLoggerThunk: func() (CommitLogger, error) {
+						return NewCommitLogger(rootPath, "datatype", logger, cyclemanager.NewCallbackGroupNoop())
+					},
+					DistanceProvider: distancer,
+					VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+						return vectors[int(id)], nil
+					},
+					TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+						copy(container.Slice, vectors[int(id)])
+						return container.Slice, nil
+					},
+				}, uc, cyclemanager.NewCallbackGroupNoop(), store)
+				require.Nil(t, err)
+				return index
+			}
+
+			index := newIndex()
+			assert.True(t, index.Compressed())
+			require.Nil(t, compressionhelpers.ConcurrentlyWithError(logger, uint64(len(vectors)), func(id uint64) error {
+				return index.Add(ctx, id, vectors[id])
+			}))
+
+			var hits uint64
+			var before [][]uint64
+			for _, query := range queries {
+				ids, dists, err := index.SearchByVector(ctx, query, k, nil)
+				require.Nil(t, err)
+				before = append(before, ids)
+
+				truth, _ := testinghelpers.BruteForce(logger, vectors, query, k, distanceWrapper(distancer))
+				hits += testinghelpers.MatchesInLists(truth, ids)
+
+				// distances are calculated against the stored data type
+				for i, id := range ids {
+					stored := vectorIndexCommon.DecodeVector(dataType,
+						vectorIndexCommon.EncodeVector(dataType, vectors[id], nil), nil)
+					expected, err := distancer.SingleDist(query, stored)
+					require.Nil(t, err)
+					assert.InDelta(t, expected, dists[i], 1e-3*float64(expected))
+				}
+			}
+			assert.Greater(t, float32(hits)/float32(k*len(queries)), float32(0.9))
+
+			require.Nil(t, index.Flush())
+			require.Nil(t, index.Shutdown(ctx))
+
+			restarted := newIndex()
+			defer restarted.Shutdown(ctx)
+			restarted.PostStartup()
+			assert.True(t, restarted.Compressed())
+
+			for i, query := range queries {
+				ids, _, err := restarted.SearchByVector(ctx, query, k, nil)
+				require.Nil(t, err)
+				assert.Equal(t, before[i], ids)
+			}
+		})
+	}
+}
@@ -39,6 +39,7 @@ type DeserializationResult struct {
 	EntrypointChanged bool
 	CompressionPQData *compressionhelpers.PQData
 	CompressionSQData *compressionhelpers.SQData
+	CompressionRQData *compressionhelpers.RQData
 	Compressed        bool
 
 	// If there is no entry for the links at a level to be replaced, we must
@@ -150,6 +151,9 @@ func (d *Deserializer) Do(fd *bufio.Reader, initialState *DeserializationResult,
 		case AddSQ:
 			err = d.ReadSQ(fd, out)
 			readThisRound = 10
+		case AddRQ:
+			err = d.ReadRQ(fd, out)
+			readThisRound = 11
 		default:
 			err = errors.Errorf("unrecognized commit type %d", ct)
 		}
@@ -622,6 +626,29 @@ func (d *Deserializer) ReadSQ(r io.Reader, res *DeserializationResult) error {
 	return nil
 }
 
+func (d *Deserializer) ReadRQ(r io.Reader, res *DeserializationResult) error {
+	dims, err := d.readUint16(r)
+	if err != nil {
+		return err
+	}
+	bits, err := d.readByte(r)
+	if err != nil {
+		return err
+	}
+	seed, err := d.readUint64(r)
+	if err != nil {
+		return err
+	}
+	res.CompressionRQData = &compressionhelpers.RQData{
+		Dimensions: dims,
+		Bits:       bits,
+		Seed:       seed,
+	}
+	res.Compressed = true
+
+	return nil
+}
+
 func (d *Deserializer) readUint64(r io.Reader) (uint64, error) {
 	var value uint64
 	d.resetResusableBuffer(8)
@@ -115,6 +115,8 @@ func TestDeserializer2ReadCommitType(t *testing.T) {
 		DeleteNode,
 		ResetIndex,
 		AddPQ,
+		AddSQ,
+		AddRQ,
 	}
 	for _, commitType := range commitTypes {
 		b := make([]byte, 1)
@@ -501,3 +503,38 @@ func TestDeserializerTotalReadPQ(t *testing.T) {
 		t.Logf("deserializeSize: %v\n", deserializeSize)
 	})
 }
+
+func TestDeserializerReadRQ(t *testing.T) {
+	rootPath := t.TempDir()
+	ctx := context.Background()
+
+	logger, _ := test.NewNullLogger()
+	commitLogger, err := NewCommitLogger(rootPath, "tmpLogger", logger,
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	rqData := compressionhelpers.RQData{
+		Dimensions: 768,
+		Bits:       4,
+		Seed:       0x1234567890abcdef,
+	}
+	require.Nil(t, commitLogger.AddRQCompression(rqData))
+	require.Nil(t, commitLogger.Flush())
+	require.Nil(t, commitLogger.Shutdown(ctx))
+
+	commitLoggerPath := rootPath + "/tmpLogger.hnsw.commitlog.d"
+	fileName, found, err := getCurrentCommitLogFileName(commitLoggerPath)
+	require.Nil(t, err)
+	require.True(t, found)
+
+	fd, err := os.Open(commitLoggerPath + "/" + fileName)
+	require.Nil(t, err)
+	defer fd.Close()
+
+	res, deserializeSize, err := NewDeserializer(logger).Do(bufio.NewReader(fd), nil, true)
+	require.Nil(t, err)
+	assert.Equal(t, 12, deserializeSize)
+	assert.True(t, res.Compressed)
+	require.NotNil(t, res.CompressionRQData)
+	assert.Equal(t, rqData, *res.CompressionRQData)
+}
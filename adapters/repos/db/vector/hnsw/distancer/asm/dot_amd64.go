@@ -13,6 +13,7 @@ package asm
 
 //go:generate goat ../c/dot_avx256_amd64.c -O3 -mavx2 -mfma -mavx512f -mavx512dq -e="-mfloat-abi=hard" -e="-Rpass-analysis=loop-vectorize" -e="-Rpass=loop-vectorize" -e="-Rpass-missed=loop-vectorize"
 //go:generate goat ../c/dot_avx512_amd64.c -O3 -mavx2 -mfma -mavx512f -mavx512dq -e="-mfloat-abi=hard" -e="-Rpass-analysis=loop-vectorize" -e="-Rpass=loop-vectorize" -e="-Rpass-missed=loop-vectorize"
+//go:generate goat ../c/dot_nibble_avx256_amd64.c -O3 -mavx2 -mno-avx512f -e="-mfloat-abi=hard" -e="-Rpass-analysis=loop-vectorize" -e="-Rpass=loop-vectorize" -e="-Rpass-missed=loop-vectorize"
 
 import (
 	"unsafe"
@@ -96,3 +97,20 @@ func DotFloatByteAVX256(x []float32, y []uint8) float32 {
 
 	return res
 }
+
+// DotNibbleAVX256 computes the dot product of two vectors of 4-bit codes
+// which are packed two per byte, with the first code in the low nibble. Both
+// nibbles of every byte are multiplied pairwise, so a padding nibble must be
+// zero in at least one of the inputs.
+func DotNibbleAVX256(x []uint8, y []uint8) uint32 {
+	var res uint32
+
+	l := len(x)
+	dot_nibble_256(
+		unsafe.Pointer(unsafe.SliceData(x)),
+		unsafe.Pointer(unsafe.SliceData(y)),
+		unsafe.Pointer(&res),
+		unsafe.Pointer(&l))
+
+	return res
+}
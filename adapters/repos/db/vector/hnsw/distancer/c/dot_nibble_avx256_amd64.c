@@ -0,0 +1,63 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+#include <immintrin.h>
+#include <stdint.h>
+
+void dot_nibble_256(unsigned char *a, unsigned char *b, unsigned int *res, long *len)
+{
+    int n = *len;
+
+    __m256i acc = _mm256_setzero_si256();
+    const __m256i mask = _mm256_set1_epi8(0x0f);
+    const __m256i ones = _mm256_set1_epi16(1);
+
+    int i;
+    // Process 32 bytes at a time
+    for (i = 0; i + 31 < n; i += 32)
+    {
+        __m256i vec_a = _mm256_loadu_si256((const __m256i *)(a + i));
+        __m256i vec_b = _mm256_loadu_si256((const __m256i *)(b + i));
+
+        // Split the bytes into their low and high nibbles
+        __m256i a_low = _mm256_and_si256(vec_a, mask);
+        __m256i b_low = _mm256_and_si256(vec_b, mask);
+        __m256i a_high = _mm256_and_si256(_mm256_srli_epi16(vec_a, 4), mask);
+        __m256i b_high = _mm256_and_si256(_mm256_srli_epi16(vec_b, 4), mask);
+
+        // All codes are at most 15, so the unsigned times signed multiply
+        // doesn't saturate and the pairwise sums of both halves fit into
+        // 16 bits
+        __m256i prod = _mm256_add_epi16(_mm256_maddubs_epi16(a_low, b_low),
+                                        _mm256_maddubs_epi16(a_high, b_high));
+
+        // Widen to 32 bits before accumulating
+        acc = _mm256_add_epi32(acc, _mm256_madd_epi16(prod, ones));
+    }
+
+    // Reduce
+    __m128i acc_low = _mm256_extracti128_si256(acc, 0);
+    __m128i acc_high = _mm256_extracti128_si256(acc, 1);
+    __m128i acc128 = _mm_add_epi32(acc_low, acc_high);
+    acc128 = _mm_add_epi32(acc128, _mm_shuffle_epi32(acc128, _MM_SHUFFLE(0, 1, 2, 3)));
+    acc128 = _mm_add_epi32(acc128, _mm_shuffle_epi32(acc128, _MM_SHUFFLE(0, 0, 0, 1)));
+
+    unsigned int result = _mm_extract_epi32(acc128, 0);
+
+    // Tail
+    for (; i < n; i++)
+    {
+        result += (unsigned int)(a[i] & 0x0f) * (unsigned int)(b[i] & 0x0f);
+        result += (unsigned int)(a[i] >> 4) * (unsigned int)(b[i] >> 4);
+    }
+
+    *res = result;
+}
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"encoding/binary"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+// DataTypeDistancer calculates distances to vectors that are stored with a
+// narrower data type than float32, see common.EncodeVector. Dot product,
+// cosine and l2-squared distances are calculated on the encoded vectors
+// directly, other distances decode the vectors and fall back to the provider.
+type DataTypeDistancer struct {
+	provider Provider
+	dataType string
+	size     int
+}
+
+func NewDataTypeDistancer(provider Provider, dataType string) (*DataTypeDistancer, error) {
+	if err := common.ValidateDataType(dataType); err != nil {
+		return nil, err
+	}
+	if dataType == common.DataTypeFloat16 {
+		initFloat16Table()
+	}
+	return &DataTypeDistancer{
+		provider: provider,
+		dataType: dataType,
+		size:     common.DataTypeSize(dataType),
+	}, nil
+}
+
+func (d *DataTypeDistancer) DataType() string {
+	return d.dataType
+}
+
+func (d *DataTypeDistancer) Encode(vec []float32, buf []byte) []byte {
+	return common.EncodeVector(d.dataType, vec, buf)
+}
+
+func (d *DataTypeDistancer) Decode(data []byte, buf []float32) []float32 {
+	return common.DecodeVector(d.dataType, data, buf)
+}
+
+// FloatDistance is the distance between the uncompressed vector x and the
+// encoded vector y
+func (d *DataTypeDistancer) FloatDistance(x []float32, y []byte) (float32, error) {
+	if len(x)*d.size != len(y) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(x), len(y)/d.size)
+	}
+
+	var dot, l2 func(x []float32, y []byte) float32
+	switch d.dataType {
+	case common.DataTypeFloat16:
+		dot, l2 = dotFloat16, l2Float16
+	case common.DataTypeBFloat16:
+		dot, l2 = dotBFloat16, l2BFloat16
+	case common.DataTypeInt8:
+		dot, l2 = dotInt8, l2Int8
+	default:
+		return d.provider.SingleDist(x, d.Decode(y, nil))
+	}
+
+	switch d.provider.Type() {
+	case "dot":
+		return -dot(x, y), nil
+	case "cosine-dot":
+		return 1 - dot(x, y), nil
+	case "l2-squared":
+		return l2(x, y), nil
+	default:
+		return d.provider.SingleDist(x, d.Decode(y, nil))
+	}
+}
+
+// Distance is the distance between the encoded vectors x and y
+func (d *DataTypeDistancer) Distance(x, y []byte) (float32, error) {
+	if len(x) != len(y) {
+		return 0, errors.Wrapf(ErrVectorLength, "%d vs %d",
+			len(x)/d.size, len(y)/d.size)
+	}
+
+	if d.dataType == common.DataTypeInt8 {
+		// int8 products and differences are exact in integer arithmetic
+		switch d.provider.Type() {
+		case "dot":
+			return -float32(dotInt8Int8(x, y)), nil
+		case "cosine-dot":
+			return 1 - float32(dotInt8Int8(x, y)), nil
+		case "l2-squared":
+			return float32(l2Int8Int8(x, y)), nil
+		}
+	}
+
+	return d.FloatDistance(d.Decode(x, nil), y)
+}
+
+var (
+	float16Table     []float32
+	float16TableOnce sync.Once
+)
+
+// initFloat16Table precomputes all half precision values, which is
+// considerably faster than converting the bits on every access
+func initFloat16Table() {
+	float16TableOnce.Do(func() {
+		table := make([]float32, 1<<16)
+		for i := range table {
+			table[i] = common.Float16ToFloat32(uint16(i))
+		}
+		float16Table = table
+	})
+}
+
+func dotFloat16(x []float32, y []byte) float32 {
+	var sum float32
+	for i := range x {
+		sum += x[i] * float16Table[binary.LittleEndian.Uint16(y[i*2:])]
+	}
+	return sum
+}
+
+func l2Float16(x []float32, y []byte) float32 {
+	var sum float32
+	for i := range x {
+		diff := x[i] - float16Table[binary.LittleEndian.Uint16(y[i*2:])]
+		sum += diff * diff
+	}
+	return sum
+}
+
+func dotBFloat16(x []float32, y []byte) float32 {
+	var sum float32
+	for i := range x {
+		sum += x[i] * common.BFloat16ToFloat32(binary.LittleEndian.Uint16(y[i*2:]))
+	}
+	return sum
+}
+
+func l2BFloat16(x []float32, y []byte) float32 {
+	var sum float32
+	for i := range x {
+		diff := x[i] - common.BFloat16ToFloat32(binary.LittleEndian.Uint16(y[i*2:]))
+		sum += diff * diff
+	}
+	return sum
+}
+
+func dotInt8(x []float32, y []byte) float32 {
+	var sum float32
+	for i := range x {
+		sum += x[i] * float32(int8(y[i]))
+	}
+	return sum
+}
+
+func l2Int8(x []float32, y []byte) float32 {
+	var sum float32
+	for i := range x {
+		diff := x[i] - float32(int8(y[i]))
+		sum += diff * diff
+	}
+	return sum
+}
+
+func dotInt8Int8(x, y []byte) int32 {
+	var sum int32
+	for i := range x {
+		sum += int32(int8(x[i])) * int32(int8(y[i]))
+	}
+	return sum
+}
+
+// l2Int8Int8 accumulates into a uint32, as squared differences of up to 255
+// would overflow an int32 for the largest supported vectors
+func l2Int8Int8(x, y []byte) uint32 {
+	var sum uint32
+	for i := range x {
+		diff := int32(int8(x[i])) - int32(int8(y[i]))
+		sum += uint32(diff * diff)
+	}
+	return sum
+}
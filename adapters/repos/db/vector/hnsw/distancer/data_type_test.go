@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package distancer
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func TestDataTypeDistancer(t *testing.T) {
+	providers := []Provider{
+		NewDotProductProvider(),
+		NewCosineDistanceProvider(),
+		NewL2SquaredProvider(),
+		NewManhattanProvider(),
+	}
+	dataTypes := []string{
+		common.DataTypeFloat32,
+		common.DataTypeFloat16,
+		common.DataTypeBFloat16,
+		common.DataTypeInt8,
+	}
+
+	r := rand.New(rand.NewSource(7))
+	randomVector := func(dims int, dataType string) []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			if dataType == common.DataTypeInt8 {
+				vec[i] = float32(r.Intn(256) - 128)
+			} else {
+				vec[i] = r.Float32()*2 - 1
+			}
+		}
+		return vec
+	}
+
+	for _, provider := range providers {
+		for _, dataType := range dataTypes {
+			t.Run(fmt.Sprintf("%s %s", provider.Type(), dataType), func(t *testing.T) {
+				d, err := NewDataTypeDistancer(provider, dataType)
+				require.Nil(t, err)
+
+				for _, dims := range []int{1, 17, 768} {
+					x, y := randomVector(dims, dataType), randomVector(dims, dataType)
+					encodedX, encodedY := d.Encode(x, nil), d.Encode(y, nil)
+					decodedX, decodedY := d.Decode(encodedX, nil), d.Decode(encodedY, nil)
+
+					// distances on the encoded vectors must match the distances of the
+					// decoded vectors
+					expected, err := provider.SingleDist(x, decodedY)
+					require.Nil(t, err)
+					actual, err := d.FloatDistance(x, encodedY)
+					require.Nil(t, err)
+					assert.InEpsilon(t, expected, actual, 1e-3)
+
+					expected, err = provider.SingleDist(decodedX, decodedY)
+					require.Nil(t, err)
+					actual, err = d.Distance(encodedX, encodedY)
+					require.Nil(t, err)
+					assert.InEpsilon(t, expected, actual, 1e-3)
+				}
+			})
+		}
+	}
+}
+
+func TestDataTypeDistancerErrors(t *testing.T) {
+	_, err := NewDataTypeDistancer(NewDotProductProvider(), "float64")
+	assert.NotNil(t, err)
+
+	d, err := NewDataTypeDistancer(NewDotProductProvider(), common.DataTypeFloat16)
+	require.Nil(t, err)
+
+	_, err = d.FloatDistance([]float32{1, 2, 3}, d.Encode([]float32{1, 2}, nil))
+	assert.ErrorIs(t, err, ErrVectorLength)
+	_, err = d.Distance(d.Encode([]float32{1, 2, 3}, nil), d.Encode([]float32{1, 2}, nil))
+	assert.ErrorIs(t, err, ErrVectorLength)
+}
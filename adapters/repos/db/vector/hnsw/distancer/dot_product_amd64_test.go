@@ -31,6 +31,16 @@ var dotByteImpl func(a, b []byte) uint32 = func(a, b []byte) uint32 {
 	return sum
 }
 
+var dotNibbleImpl func(a, b []byte) uint32 = func(a, b []byte) uint32 {
+	var sum uint32
+
+	for i := range a {
+		sum += uint32(a[i]&0x0f)*uint32(b[i]&0x0f) + uint32(a[i]>>4)*uint32(b[i]>>4)
+	}
+
+	return sum
+}
+
 var dotFloatByteImpl func(a []float32, b []byte) float32 = func(a []float32, b []byte) float32 {
 	var sum float32
 
@@ -388,3 +398,63 @@ func BenchmarkDotFloatByte(b *testing.B) {
 		})
 	}
 }
+
+func testDotProductNibbleRandomValue(t *testing.T, size uint, dotFn func(x []uint8, y []uint8) uint32) {
+	r := getRandomSeed()
+	count := 1000
+
+	for i := 0; i < count; i++ {
+		vec1 := make([]byte, size)
+		vec2 := make([]byte, size)
+		for j := range vec1 {
+			vec1[j] = byte(r.Uint32() % 256)
+			vec2[j] = byte(r.Uint32() % 256)
+		}
+
+		res := dotFn(vec1, vec2)
+		resControl := dotNibbleImpl(vec1, vec2)
+		if resControl != res {
+			t.Logf("for dim: %d -> want: %d, got: %d", size, resControl, res)
+			t.Fail()
+		}
+	}
+}
+
+func TestCompareDotProductNibble(t *testing.T) {
+	if !cpu.X86.HasAVX2 {
+		t.Skip("avx2 is not supported")
+	}
+
+	sizes := []uint{1, 2, 3, 7, 16, 31, 32, 33, 63, 64, 65, 128, 192, 300, 384, 385, 768, 1536}
+	for _, size := range sizes {
+		t.Run(fmt.Sprintf("with size %d", size), func(t *testing.T) {
+			testDotProductNibbleRandomValue(t, size, asm.DotNibbleAVX256)
+		})
+	}
+}
+
+func benchmarkDotNibble(b *testing.B, dims int, dotFn func(a, b []byte) uint32) {
+	r := getRandomSeed()
+
+	vec1 := make([]byte, dims)
+	vec2 := make([]byte, dims)
+	for i := range vec1 {
+		vec1[i] = byte(r.Uint32() % 256)
+		vec2[i] = byte(r.Uint32() % 256)
+	}
+
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		dotFn(vec1, vec2)
+	}
+}
+
+func BenchmarkDotNibble(b *testing.B) {
+	dims := []int{32, 64, 128, 192, 384, 768}
+	for _, dim := range dims {
+		b.Run(fmt.Sprintf("%d bytes", dim), func(b *testing.B) {
+			b.Run("pure go", func(b *testing.B) { benchmarkDotNibble(b, dim, dotNibbleImpl) })
+			b.Run("avx", func(b *testing.B) { benchmarkDotNibble(b, dim, asm.DotNibbleAVX256) })
+		})
+	}
+}
@@ -164,6 +164,98 @@ func (h *hnsw) flatMultiSearch(ctx context.Context, queryVectors [][]float32, k
 	return h.computeLateInteraction(queryVectors, k, candidateSet)
 }
 
+// exactSearchByVectors returns the ids of the k nearest neighbors of each of
+// the query vectors, ordered by distance. Unlike flatSearch, distances are
+// always calculated on the uncompressed vectors. All queries are answered in a
+// single pass over the index, so each vector is only read once.
+func (h *hnsw) exactSearchByVectors(ctx context.Context, queryVectors [][]float32, k int,
+) ([][]uint64, error) {
+	h.RLock()
+	nodeSize := uint64(len(h.nodes))
+	h.RUnlock()
+
+	aggregateMu := &sync.Mutex{}
+	results := make([]*priorityqueue.Queue[any], len(queryVectors))
+	for i := range results {
+		results[i] = priorityqueue.NewMax[any](k)
+	}
+
+	eg := enterrors.NewErrorGroupWrapper(h.logger)
+	for workerID := 0; workerID < h.flatSearchConcurrency; workerID++ {
+		workerID := workerID
+		eg.Go(func() error {
+			localResults := make([]*priorityqueue.Queue[any], len(queryVectors))
+			for i := range localResults {
+				localResults[i] = priorityqueue.NewMax[any](k)
+			}
+			slice := h.pools.tempVectors.Get(int(h.dims))
+			defer h.pools.tempVectors.Put(slice)
+
+			var e storobj.ErrNotFound
+			for id := uint64(workerID); id < nodeSize; id += uint64(h.flatSearchConcurrency) {
+				if err := ctx.Err(); err != nil {
+					return err
+				}
+
+				h.shardedNodeLocks.RLock(id)
+				c := h.nodes[id]
+				h.shardedNodeLocks.RUnlock(id)
+
+				if c == nil || h.hasTombstone(id) {
+					continue
+				}
+
+				var vec []float32
+				var err error
+				if h.compressed.Load() {
+					vec, err = h.TempVectorForIDThunk(ctx, id, slice)
+					vec = h.normalizeVec(vec)
+				} else {
+					vec, err = h.vectorForID(ctx, id)
+				}
+				if errors.As(err, &e) {
+					continue
+				}
+				if err != nil {
+					return errors.Wrapf(err, "get vector of node %d", id)
+				}
+
+				for i, queryVector := range queryVectors {
+					dist, err := h.distancerProvider.SingleDist(queryVector, vec)
+					if err != nil {
+						return err
+					}
+					addResult(localResults[i], id, dist, k)
+				}
+			}
+
+			aggregateMu.Lock()
+			defer aggregateMu.Unlock()
+			for i, localResult := range localResults {
+				for localResult.Len() > 0 {
+					res := localResult.Pop()
+					addResult(results[i], res.ID, res.Dist, k)
+				}
+			}
+
+			return nil
+		})
+	}
+
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	ids := make([][]uint64, len(results))
+	for i, result := range results {
+		ids[i] = make([]uint64, result.Len())
+		for j := len(ids[i]) - 1; result.Len() > 0; j-- {
+			ids[i][j] = result.Pop().ID
+		}
+	}
+	return ids, nil
+}
+
 func addResult(results *priorityqueue.Queue[any], id uint64, dist float32, limit int) {
 	if results.Len() < limit {
 		results.Insert(id, dist)
@@ -0,0 +1,383 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
+	"github.com/weaviate/weaviate/entities/storobj"
+)
+
+// GraphHealth is a report on the connectivity of the graph. Nodes are
+// unreachable on a layer if they are part of that layer, but cannot be
+// reached from the entrypoint by following the links of that layer. Such
+// nodes can never be returned by a search, which shows up as a drop in
+// recall.
+type GraphHealth struct {
+	Nodes            int              `json:"nodes"`
+	Tombstones       int              `json:"tombstones"`
+	TombstoneRatio   float64          `json:"tombstoneRatio"`
+	UnreachableNodes int              `json:"unreachableNodes"`
+	EntryPoint       EntryPointHealth `json:"entryPoint"`
+	Layers           []LayerHealth    `json:"layers"`
+	// only set by RepairGraph
+	Repair *GraphRepair `json:"repair,omitempty"`
+}
+
+type EntryPointHealth struct {
+	ID         uint64 `json:"id"`
+	Level      int    `json:"level"`
+	MaxLayer   int    `json:"maxLayer"`
+	Exists     bool   `json:"exists"`
+	Tombstoned bool   `json:"tombstoned"`
+	Healthy    bool   `json:"healthy"`
+}
+
+type LayerHealth struct {
+	Level         int     `json:"level"`
+	Nodes         int     `json:"nodes"`
+	Edges         int     `json:"edges"`
+	AverageDegree float64 `json:"averageDegree"`
+	// links pointing to nodes which no longer exist
+	DanglingEdges    int `json:"danglingEdges"`
+	UnreachableNodes int `json:"unreachableNodes"`
+}
+
+// GraphRepair summarizes the changes made by RepairGraph. The health report
+// it is attached to reflects the state after the repair.
+type GraphRepair struct {
+	EntryPointReplaced bool `json:"entryPointReplaced"`
+	ReconnectedNodes   int  `json:"reconnectedNodes"`
+	FailedNodes        int  `json:"failedNodes"`
+}
+
+// AnalyzeGraph walks every layer of the graph starting at the entrypoint and
+// reports its connectivity. It does not modify the graph.
+func (h *hnsw) AnalyzeGraph(ctx context.Context) (*GraphHealth, error) {
+	report, _, err := h.analyzeGraph(ctx)
+	return report, err
+}
+
+// RepairGraph replaces a missing or tombstoned entrypoint and reconnects all
+// nodes that are unreachable on any of their layers by searching for their
+// neighbors again, just like on insert. It returns the health of the graph
+// after the repair.
+func (h *hnsw) RepairGraph(ctx context.Context) (*GraphHealth, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+	h.resetLock.RLock()
+	defer h.resetLock.RUnlock()
+
+	repair := &GraphRepair{}
+
+	before, _, err := h.analyzeGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !before.EntryPoint.Healthy {
+		replaced, err := h.replaceUnhealthyEntrypoint(before.EntryPoint)
+		if err != nil {
+			return nil, errors.Wrap(err, "replace entrypoint")
+		}
+		repair.EntryPointReplaced = replaced
+	}
+
+	// the reachability has to be determined again from the new entrypoint
+	_, orphans, err := h.analyzeGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denyList := h.tombstonesAsDenyList()
+	for _, id := range orphans {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := h.reconnectOrphan(ctx, id, denyList); err != nil {
+			h.logger.WithFields(logrus.Fields{
+				"action": "hnsw_repair_graph",
+				"class":  h.className,
+				"shard":  h.shardName,
+				"node":   id,
+			}).WithError(err).Warn("failed to reconnect unreachable node")
+			repair.FailedNodes++
+			continue
+		}
+		repair.ReconnectedNodes++
+	}
+
+	after, _, err := h.analyzeGraph(ctx)
+	if err != nil {
+		return nil, err
+	}
+	after.Repair = repair
+
+	h.logger.WithFields(logrus.Fields{
+		"action":              "hnsw_repair_graph",
+		"class":               h.className,
+		"shard":               h.shardName,
+		"reconnected":         repair.ReconnectedNodes,
+		"failed":              repair.FailedNodes,
+		"entrypoint_replaced": repair.EntryPointReplaced,
+		"unreachable_before":  before.UnreachableNodes,
+		"unreachable_after":   after.UnreachableNodes,
+	}).Info("hnsw graph repair complete")
+
+	return after, nil
+}
+
+// analyzeGraph returns the health report and the ids of all live nodes which
+// are unreachable on at least one of their layers.
+func (h *hnsw) analyzeGraph(ctx context.Context) (*GraphHealth, []uint64, error) {
+	h.RLock()
+	nodes := h.nodes
+	entryPointID := h.entryPointID
+	maxLayer := h.currentMaximumLayer
+	h.RUnlock()
+
+	h.tombstoneLock.RLock()
+	tombstones := make(map[uint64]struct{}, len(h.tombstones))
+	for id := range h.tombstones {
+		tombstones[id] = struct{}{}
+	}
+	h.tombstoneLock.RUnlock()
+
+	nodeAt := func(id uint64) *vertex {
+		if id >= uint64(len(nodes)) {
+			return nil
+		}
+		h.shardedNodeLocks.RLock(id)
+		defer h.shardedNodeLocks.RUnlock(id)
+		return nodes[id]
+	}
+	connectionsOf := func(node *vertex, level int) []uint64 {
+		node.Lock()
+		defer node.Unlock()
+		if level >= len(node.connections) {
+			return nil
+		}
+		return append([]uint64(nil), node.connections[level]...)
+	}
+
+	report := &GraphHealth{
+		Tombstones: len(tombstones),
+		EntryPoint: EntryPointHealth{
+			ID:       entryPointID,
+			MaxLayer: maxLayer,
+		},
+		Layers: make([]LayerHealth, maxLayer+1),
+	}
+
+	levels := make(map[uint64]int)
+	for i := range nodes {
+		node := nodeAt(uint64(i))
+		if node == nil {
+			continue
+		}
+		node.Lock()
+		levels[uint64(i)] = node.level
+		node.Unlock()
+	}
+	report.Nodes = len(levels)
+	if report.Nodes > 0 {
+		report.TombstoneRatio = float64(report.Tombstones) / float64(report.Nodes)
+	}
+
+	ep := nodeAt(entryPointID)
+	if ep != nil {
+		_, report.EntryPoint.Tombstoned = tombstones[entryPointID]
+		report.EntryPoint.Exists = true
+		report.EntryPoint.Level = levels[entryPointID]
+		report.EntryPoint.Healthy = !report.EntryPoint.Tombstoned &&
+			report.EntryPoint.Level == maxLayer &&
+			(report.Nodes == 1 || len(connectionsOf(ep, 0)) > 0)
+	}
+
+	orphans := make(map[uint64]struct{})
+	for level := 0; level <= maxLayer; level++ {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		layer := LayerHealth{Level: level}
+
+		visitedNodes := visited.NewList(len(nodes))
+		if ep != nil {
+			stack := []uint64{entryPointID}
+			visitedNodes.Visit(entryPointID)
+			for len(stack) > 0 {
+				id := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				node := nodeAt(id)
+				if node == nil {
+					continue
+				}
+				for _, neighbor := range connectionsOf(node, level) {
+					if neighbor >= uint64(len(nodes)) || visitedNodes.Visited(neighbor) {
+						continue
+					}
+					visitedNodes.Visit(neighbor)
+					stack = append(stack, neighbor)
+				}
+			}
+		}
+
+		for id, nodeLevel := range levels {
+			if nodeLevel < level {
+				continue
+			}
+			node := nodeAt(id)
+			if node == nil {
+				continue
+			}
+			layer.Nodes++
+			for _, neighbor := range connectionsOf(node, level) {
+				layer.Edges++
+				if nodeAt(neighbor) == nil {
+					layer.DanglingEdges++
+				}
+			}
+			if _, deleted := tombstones[id]; deleted {
+				// unreachable tombstones are harmless, they are cleaned up anyway
+				continue
+			}
+			if !visitedNodes.Visited(id) {
+				layer.UnreachableNodes++
+				orphans[id] = struct{}{}
+			}
+		}
+		if layer.Nodes > 0 {
+			layer.AverageDegree = float64(layer.Edges) / float64(layer.Nodes)
+		}
+		report.Layers[level] = layer
+	}
+	if len(report.Layers) > 0 {
+		report.UnreachableNodes = report.Layers[0].UnreachableNodes
+	}
+
+	ids := make([]uint64, 0, len(orphans))
+	for id := range orphans {
+		ids = append(ids, id)
+	}
+	return report, ids, nil
+}
+
+// replaceUnhealthyEntrypoint makes the live node with the highest level the
+// new entrypoint. It returns false if there is no better candidate.
+func (h *hnsw) replaceUnhealthyEntrypoint(current EntryPointHealth) (bool, error) {
+	h.RLock()
+	size := len(h.nodes)
+	h.RUnlock()
+
+	candidate, candidateLevel, found := uint64(0), -1, false
+	for i := 0; i < size; i++ {
+		id := uint64(i)
+		if h.hasTombstone(id) {
+			continue
+		}
+		node := h.nodeByID(id)
+		if node == nil {
+			continue
+		}
+		node.Lock()
+		level := node.level
+		node.Unlock()
+		if level > candidateLevel {
+			candidate, candidateLevel, found = id, level, true
+		}
+	}
+	if !found {
+		return false, nil
+	}
+	if current.Exists && !current.Tombstoned && current.Level >= candidateLevel {
+		candidate, candidateLevel = current.ID, current.Level
+	}
+
+	h.Lock()
+	defer h.Unlock()
+	if h.entryPointID != current.ID {
+		// changed by a concurrent insert or cleanup in the meantime
+		return false, nil
+	}
+	if candidate == h.entryPointID && candidateLevel == h.currentMaximumLayer {
+		return false, nil
+	}
+	if err := h.commitLog.SetEntryPointWithMaxLayer(candidate, candidateLevel); err != nil {
+		return false, err
+	}
+	h.entryPointID = candidate
+	h.currentMaximumLayer = candidateLevel
+	return true, nil
+}
+
+// reconnectOrphan searches the neighbors of an unreachable node on all of its
+// layers and links them in both directions.
+func (h *hnsw) reconnectOrphan(ctx context.Context, id uint64, denyList helpers.AllowList) error {
+	node := h.nodeByID(id)
+	if node == nil || h.hasTombstone(id) {
+		return nil
+	}
+	if id == h.getEntrypoint() {
+		return nil
+	}
+
+	var vec []float32
+	var distancer compressionhelpers.CompressorDistancer
+	var err error
+	if h.compressed.Load() {
+		distancer, err = h.compressor.NewDistancerFromID(id)
+	} else {
+		vec, err = h.cache.Get(ctx, id)
+	}
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			h.handleDeletedNode(e.DocID, "reconnectOrphan")
+			return nil
+		}
+		return fmt.Errorf("get vector of node %d: %w", id, err)
+	}
+
+	h.RLock()
+	entryPointID := h.entryPointID
+	currentMaximumLayer := h.currentMaximumLayer
+	h.RUnlock()
+
+	node.Lock()
+	level := node.level
+	if len(node.connections) <= level {
+		node.upgradeToLevelNoLock(level)
+	}
+	node.Unlock()
+
+	// the node may still be reachable on some of its layers, it must not end
+	// up as its own neighbor there
+	denyList = denyList.DeepCopy()
+	denyList.Insert(id)
+
+	node.markAsMaintenance()
+	defer node.unmarkAsMaintenance()
+
+	entryPointID, err = h.findBestEntrypointForNode(ctx, currentMaximumLayer, level,
+		entryPointID, vec, distancer)
+	if err != nil {
+		return errors.Wrap(err, "find best entrypoint")
+	}
+
+	return h.findAndConnectNeighbors(ctx, node, entryPointID, vec, distancer,
+		level, currentMaximumLayer, denyList)
+}
@@ -0,0 +1,168 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func newGraphHealthTestIndex(t *testing.T, vectors [][]float32) *hnsw {
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+
+	index, err := New(Config{
+		RootPath:              t.TempDir(),
+		ID:                    "graph-health",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			if int(id) >= len(vectors) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			return vectors[int(id)], nil
+		},
+		TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			if int(id) >= len(vectors) {
+				return nil, storobj.NewErrNotFoundf(id, "out of range")
+			}
+			copy(container.Slice, vectors[int(id)])
+			return container.Slice, nil
+		},
+	}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	for id, vec := range vectors {
+		require.Nil(t, index.Add(context.Background(), uint64(id), vec))
+	}
+	return index
+}
+
+// isolate removes all links pointing to the given node on all layers
+func (h *hnsw) isolate(id uint64) {
+	for _, node := range h.nodes {
+		if node == nil {
+			continue
+		}
+		for level, connections := range node.connections {
+			kept := make([]uint64, 0, len(connections))
+			for _, neighbor := range connections {
+				if neighbor != id {
+					kept = append(kept, neighbor)
+				}
+			}
+			node.connections[level] = kept
+		}
+	}
+}
+
+func TestGraphHealth(t *testing.T) {
+	ctx := context.Background()
+	vectors, _ := testinghelpers.RandomVecs(300, 0, 16)
+
+	t.Run("healthy graph", func(t *testing.T) {
+		index := newGraphHealthTestIndex(t, vectors)
+		defer index.Shutdown(ctx)
+
+		report, err := index.AnalyzeGraph(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, len(vectors), report.Nodes)
+		assert.Equal(t, 0, report.Tombstones)
+		assert.Equal(t, 0, report.UnreachableNodes)
+		assert.True(t, report.EntryPoint.Healthy)
+		assert.Equal(t, index.entryPointID, report.EntryPoint.ID)
+		require.Len(t, report.Layers, index.currentMaximumLayer+1)
+		assert.Equal(t, len(vectors), report.Layers[0].Nodes)
+		assert.Greater(t, report.Layers[0].AverageDegree, float64(1))
+		for _, layer := range report.Layers {
+			assert.Equal(t, 0, layer.UnreachableNodes)
+			assert.Equal(t, 0, layer.DanglingEdges)
+		}
+		assert.Nil(t, report.Repair)
+	})
+
+	t.Run("tombstones", func(t *testing.T) {
+		index := newGraphHealthTestIndex(t, vectors)
+		defer index.Shutdown(ctx)
+
+		require.Nil(t, index.Delete(1, 2, 3))
+		report, err := index.AnalyzeGraph(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, 3, report.Tombstones)
+		assert.InDelta(t, 3/float64(len(vectors)), report.TombstoneRatio, 1e-9)
+	})
+
+	t.Run("repair unreachable nodes", func(t *testing.T) {
+		index := newGraphHealthTestIndex(t, vectors)
+		defer index.Shutdown(ctx)
+
+		orphans := []uint64{}
+		for id := uint64(0); len(orphans) < 5; id++ {
+			if id != index.entryPointID {
+				orphans = append(orphans, id)
+				index.isolate(id)
+			}
+		}
+
+		report, err := index.AnalyzeGraph(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, len(orphans), report.UnreachableNodes)
+
+		ids, _, err := index.SearchByVector(ctx, vectors[orphans[0]], 1, nil)
+		require.Nil(t, err)
+		assert.NotEqual(t, []uint64{orphans[0]}, ids)
+
+		report, err = index.RepairGraph(ctx)
+		require.Nil(t, err)
+		require.NotNil(t, report.Repair)
+		assert.Equal(t, len(orphans), report.Repair.ReconnectedNodes)
+		assert.Equal(t, 0, report.Repair.FailedNodes)
+		assert.Equal(t, 0, report.UnreachableNodes)
+
+		for _, id := range orphans {
+			ids, _, err := index.SearchByVector(ctx, vectors[id], 1, nil)
+			require.Nil(t, err)
+			assert.Equal(t, []uint64{id}, ids)
+		}
+	})
+
+	t.Run("repair missing entrypoint", func(t *testing.T) {
+		index := newGraphHealthTestIndex(t, vectors)
+		defer index.Shutdown(ctx)
+
+		oldEntrypoint := index.entryPointID
+		index.isolate(oldEntrypoint)
+		index.nodes[oldEntrypoint] = nil
+
+		report, err := index.AnalyzeGraph(ctx)
+		require.Nil(t, err)
+		assert.False(t, report.EntryPoint.Exists)
+		assert.False(t, report.EntryPoint.Healthy)
+
+		report, err = index.RepairGraph(ctx)
+		require.Nil(t, err)
+		assert.True(t, report.Repair.EntryPointReplaced)
+		assert.True(t, report.EntryPoint.Healthy)
+		assert.NotEqual(t, oldEntrypoint, report.EntryPoint.ID)
+		assert.Equal(t, 0, report.UnreachableNodes)
+		assert.Equal(t, len(vectors)-1, report.Nodes)
+	})
+}
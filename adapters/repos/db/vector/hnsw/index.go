@@ -30,9 +30,11 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/multivector"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
 	"github.com/weaviate/weaviate/entities/schema/config"
 	"github.com/weaviate/weaviate/entities/storobj"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 	"github.com/weaviate/weaviate/usecases/memwatch"
 )
@@ -166,6 +168,7 @@ type hnsw struct {
 	pqConfig   ent.PQConfig
 	bqConfig   ent.BQConfig
 	sqConfig   ent.SQConfig
+	rqConfig   ent.RQConfig
 	// rescoring compressed vectors is disk-bound. On cold starts, we cannot
 	// rescore sequentially, as that would take very long. This setting allows us
 	// to define the rescoring concurrency.
@@ -183,11 +186,24 @@ type hnsw struct {
 
 	visitedListPoolMaxSize int
 
+	recallProbeLock   sync.Mutex
+	recallProbeConfig ent.RecallProbeConfig
+	// recallProbeStarted is set once the index is started up, the probe is
+	// only run from then on
+	recallProbeStarted bool
+	recallProbe        *recallProbe
+
 	// only used for multivector mode
 	multivector  atomic.Bool
 	docIDVectors map[uint64][]uint64
 	vecIDcounter uint64
 	maxDocID     uint64
+
+	// only used for multivector mode with muvera. Every document is a single
+	// node holding the fixed dimensional encoding of its multi vector, so the
+	// graph itself behaves like a regular single vector graph.
+	muvera        atomic.Bool
+	muveraEncoder *multivector.MuveraEncoder
 }
 
 type CommitLogger interface {
@@ -209,6 +225,7 @@ type CommitLogger interface {
 	SwitchCommitLogs(bool) error
 	AddPQCompression(compressionhelpers.PQData) error
 	AddSQCompression(compressionhelpers.SQData) error
+	AddRQCompression(compressionhelpers.RQData) error
 }
 
 type BufferedLinksLogger interface {
@@ -243,13 +260,42 @@ func New(cfg Config, uc ent.UserConfig,
 		normalizeOnRead = true
 	}
 
+	muveraEnabled := uc.Multivector.Enabled && uc.Multivector.Muvera.Enabled
+	// token vectors are only indexed individually without muvera
+	tokenVectors := uc.Multivector.Enabled && !muveraEnabled
+
+	var muveraEncoder *multivector.MuveraEncoder
+	vectorForIDThunk := cfg.VectorForIDThunk
+	tempVectorForIDThunk := cfg.TempVectorForIDThunk
+	if muveraEnabled {
+		muveraEncoder = multivector.NewMuveraEncoder(uc.Multivector.Muvera)
+		normalize := cfg.DistanceProvider.Type() == "cosine-dot"
+		vectorForIDThunk = func(ctx context.Context, id uint64) ([]float32, error) {
+			vecs, err := cfg.MultiVectorForIDThunk(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			if normalize {
+				normalized := make([][]float32, len(vecs))
+				for i, vec := range vecs {
+					normalized[i] = distancer.Normalize(vec)
+				}
+				vecs = normalized
+			}
+			return muveraEncoder.EncodeDoc(vecs)
+		}
+		tempVectorForIDThunk = func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+			return vectorForIDThunk(ctx, id)
+		}
+	}
+
 	var vectorCache cache.Cache[float32]
 
-	if uc.Multivector.Enabled {
+	if tokenVectors {
 		vectorCache = cache.NewShardedMultiFloat32LockCache(cfg.MultiVectorForIDThunk, uc.VectorCacheMaxObjects,
 			cfg.Logger, normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
 	} else {
-		vectorCache = cache.NewShardedFloat32LockCache(cfg.VectorForIDThunk, uc.VectorCacheMaxObjects, 1, cfg.Logger,
+		vectorCache = cache.NewShardedFloat32LockCache(vectorForIDThunk, uc.VectorCacheMaxObjects, 1, cfg.Logger,
 			normalizeOnRead, cache.DefaultDeletionInterval, cfg.AllocChecker)
 	}
 	resetCtx, resetCtxCancel := context.WithCancel(context.Background())
@@ -289,34 +335,43 @@ func New(cfg Config, uc ent.UserConfig,
 		efMax:    int64(uc.DynamicEFMax),
 		efFactor: int64(uc.DynamicEFFactor),
 
-		metrics:   NewMetrics(cfg.PrometheusMetrics, cfg.ClassName, cfg.ShardName),
+		metrics:   NewMetrics(cfg.PrometheusMetrics, cfg.ClassName, cfg.ShardName, cfg.TargetVector),
 		shardName: cfg.ShardName,
 
 		randFunc:                  rand.Float64,
 		compressActionLock:        &sync.RWMutex{},
 		className:                 cfg.ClassName,
-		VectorForIDThunk:          cfg.VectorForIDThunk,
-		TempVectorForIDThunk:      cfg.TempVectorForIDThunk,
+		VectorForIDThunk:          vectorForIDThunk,
+		TempVectorForIDThunk:      tempVectorForIDThunk,
 		TempMultiVectorForIDThunk: cfg.TempMultiVectorForIDThunk,
 		pqConfig:                  uc.PQ,
 		bqConfig:                  uc.BQ,
 		sqConfig:                  uc.SQ,
+		rqConfig:                  uc.RQ,
 		rescoreConcurrency:        2 * runtime.GOMAXPROCS(0), // our default for IO-bound activties
 		shardedNodeLocks:          common.NewDefaultShardedRWLocks(),
 
 		store:                  store,
 		allocChecker:           cfg.AllocChecker,
 		visitedListPoolMaxSize: cfg.VisitedListPoolMaxSize,
+		recallProbeConfig:      uc.RecallProbe,
 
-		docIDVectors: make(map[uint64][]uint64),
+		docIDVectors:  make(map[uint64][]uint64),
+		muveraEncoder: muveraEncoder,
 	}
 	index.acornSearch.Store(uc.FilterStrategy == ent.FilterStrategyAcorn)
 
-	index.multivector.Store(uc.Multivector.Enabled)
+	index.multivector.Store(tokenVectors)
+	index.muvera.Store(muveraEnabled)
+	if muveraEnabled {
+		// the encodings are only used to generate candidates, which are rescored
+		// with the exact MaxSim of their token vectors anyway
+		index.doNotRescore = true
+	}
 
 	if uc.BQ.Enabled {
 		var err error
-		if !uc.Multivector.Enabled {
+		if !tokenVectors {
 			index.compressor, err = compressionhelpers.NewBQCompressor(
 				index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger, store,
 				cfg.AllocChecker)
@@ -333,7 +388,23 @@ func New(cfg Config, uc ent.UserConfig,
 		index.cache = nil
 	}
 
-	if uc.Multivector.Enabled {
+	if uc.DataType != "" && uc.DataType != vectorIndexCommon.DataTypeFloat32 {
+		// vectors of a narrower data type are kept in the compressed cache and
+		// bucket. Distances are exact for them, so there is nothing to rescore.
+		var err error
+		index.compressor, err = compressionhelpers.NewHNSWDataTypeCompressor(
+			uc.DataType, index.distancerProvider, uc.VectorCacheMaxObjects, cfg.Logger,
+			store, cfg.AllocChecker)
+		if err != nil {
+			return nil, err
+		}
+		index.compressed.Store(true)
+		index.doNotRescore = true
+		index.cache.Drop()
+		index.cache = nil
+	}
+
+	if tokenVectors {
 		err := index.store.CreateOrLoadBucket(context.Background(), cfg.ID+"_mv_mappings", lsmkv.WithStrategy(lsmkv.StrategyReplace))
 		if err != nil {
 			return nil, errors.Wrapf(err, "Create or load bucket (multivector store)")
@@ -622,6 +693,8 @@ func (h *hnsw) nodeByID(id uint64) *vertex {
 }
 
 func (h *hnsw) Drop(ctx context.Context) error {
+	h.stopRecallProbe()
+
 	// cancel tombstone cleanup goroutine
 	if err := h.tombstoneCleanupCallbackCtrl.Unregister(ctx); err != nil {
 		return errors.Wrap(err, "hnsw drop")
@@ -649,6 +722,7 @@ func (h *hnsw) Drop(ctx context.Context) error {
 
 func (h *hnsw) Shutdown(ctx context.Context) error {
 	h.shutdownCtxCancel()
+	h.stopRecallProbe()
 
 	if err := h.commitLog.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "hnsw shutdown")
@@ -735,6 +809,11 @@ func (h *hnsw) ShouldUpgrade() (bool, int) {
 	if h.sqConfig.Enabled {
 		return h.sqConfig.Enabled, h.sqConfig.TrainingLimit
 	}
+	if h.rqConfig.Enabled {
+		// RQ doesn't need any training data, it only needs to know the
+		// dimensions which are available as soon as a vector was added
+		return true, 0
+	}
 	return h.pqConfig.Enabled, h.pqConfig.TrainingLimit
 }
 
@@ -743,6 +822,9 @@ func (h *hnsw) ShouldCompressFromConfig(config config.VectorIndexConfig) (bool,
 	if hnswConfig.SQ.Enabled {
 		return hnswConfig.SQ.Enabled, hnswConfig.SQ.TrainingLimit
 	}
+	if hnswConfig.RQ.Enabled {
+		return true, 0
+	}
 	return hnswConfig.PQ.Enabled, hnswConfig.PQ.TrainingLimit
 }
 
@@ -751,7 +833,7 @@ func (h *hnsw) Compressed() bool {
 }
 
 func (h *hnsw) Multivector() bool {
-	return h.multivector.Load()
+	return h.multivector.Load() || h.muvera.Load()
 }
 
 func (h *hnsw) Upgraded() bool {
@@ -802,6 +884,8 @@ func AsHNSWIndex(index any) Index {
 // It is a workaround to avoid circular dependencies.
 type Index interface {
 	CleanUpTombstonedNodes(shouldAbort cyclemanager.ShouldAbortCallback) error
+	AnalyzeGraph(ctx context.Context) (*GraphHealth, error)
+	RepairGraph(ctx context.Context) (*GraphHealth, error)
 }
 
 type nodeLevel struct {
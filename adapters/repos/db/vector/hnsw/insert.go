@@ -43,6 +43,11 @@ func (h *hnsw) ValidateBeforeInsert(vector []float32) error {
 
 func (h *hnsw) ValidateMultiBeforeInsert(vector [][]float32) error {
 	dims := int(atomic.LoadInt32(&h.dims))
+	if h.muvera.Load() {
+		// the index holds the encodings, the token dimensions are only known
+		// to the encoder
+		dims = h.muveraEncoder.TokenDimensions()
+	}
 
 	// no vectors exist
 	if dims == 0 {
@@ -71,7 +76,7 @@ func (h *hnsw) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32)
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	if h.multivector.Load() {
+	if h.multivector.Load() || h.muvera.Load() {
 		return errors.Errorf("AddBatch called on multivector index")
 	}
 	if len(ids) != len(vectors) {
@@ -80,6 +85,10 @@ func (h *hnsw) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32)
 	if len(ids) == 0 {
 		return errors.Errorf("insertBatch called with empty lists")
 	}
+	return h.addBatch(ctx, ids, vectors)
+}
+
+func (h *hnsw) addBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
 	h.trackDimensionsOnce.Do(func() {
 		atomic.StoreInt32(&h.dims, int32(len(vectors[0])))
 	})
@@ -139,7 +148,7 @@ func (h *hnsw) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][
 	if err := ctx.Err(); err != nil {
 		return err
 	}
-	if !h.multivector.Load() {
+	if !h.multivector.Load() && !h.muvera.Load() {
 		return errors.Errorf("AddMultiBatch called on non-multivector index")
 	}
 	if len(docIDs) != len(vectors) {
@@ -148,6 +157,9 @@ func (h *hnsw) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][
 	if len(docIDs) == 0 {
 		return errors.Errorf("insertBatch called with empty lists")
 	}
+	if h.muvera.Load() {
+		return h.addMuveraBatch(ctx, docIDs, vectors)
+	}
 
 	h.trackDimensionsOnce.Do(func() {
 		atomic.StoreInt32(&h.dims, int32(len(vectors[0][0])))
@@ -425,3 +437,17 @@ func (h *hnsw) insertInitialElement(node *vertex, nodeVec []float32) error {
 	// go h.insertHook(node.id, 0, node.connections)
 	return nil
 }
+
+// addMuveraBatch indexes the fixed dimensional encoding of every multi vector
+// as a single node with the doc id as node id.
+func (h *hnsw) addMuveraBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	encodings := make([][]float32, len(vectors))
+	for i := range vectors {
+		encoding, err := h.muveraEncoder.EncodeDoc(h.normalizeVecs(vectors[i]))
+		if err != nil {
+			return errors.Wrapf(err, "encode multi vector of doc %d", docIDs[i])
+		}
+		encodings[i] = encoding
+	}
+	return h.addBatch(ctx, docIDs, encodings)
+}
@@ -40,10 +40,11 @@ type Metrics struct {
 	tombstoneStart                prometheus.Gauge
 	tombstoneEnd                  prometheus.Gauge
 	tombstoneProgress             prometheus.Gauge
+	recallEstimate                prometheus.Gauge
 }
 
 func NewMetrics(prom *monitoring.PrometheusMetrics,
-	className, shardName string,
+	className, shardName, targetVector string,
 ) *Metrics {
 	if prom == nil {
 		return &Metrics{enabled: false}
@@ -160,6 +161,12 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		"shard_name": shardName,
 	})
 
+	recallEstimate := prom.VectorIndexRecallEstimate.With(prometheus.Labels{
+		"class_name":    className,
+		"shard_name":    shardName,
+		"target_vector": targetVector,
+	})
+
 	return &Metrics{
 		enabled:                       true,
 		tombstones:                    tombstones,
@@ -182,6 +189,7 @@ func NewMetrics(prom *monitoring.PrometheusMetrics,
 		tombstoneStart:                tombstoneStart,
 		tombstoneEnd:                  tombstoneEnd,
 		tombstoneProgress:             tombstoneProgress,
+		recallEstimate:                recallEstimate,
 	}
 }
 
@@ -387,3 +395,11 @@ func (m *Metrics) TrackStartupReadCommitlogDiskIO(read int64, nanoseconds int64)
 	throughput := float64(read) / float64(seconds)
 	m.startupDiskIO.With(prometheus.Labels{"operation": "hnsw_read_commitlog"}).Observe(throughput)
 }
+
+func (m *Metrics) SetRecallEstimate(recall float64) {
+	if !m.enabled {
+		return
+	}
+
+	m.recallEstimate.Set(recall)
+}
@@ -18,6 +18,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/sirupsen/logrus/hooks/test"
@@ -26,6 +28,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
 	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
 	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
 )
 
@@ -307,3 +310,274 @@ func TestMultivectorPersistence(t *testing.T) {
 		require.Equal(t, expectedResults[i], ids)
 	}
 }
+
+func TestMultiVectorCompressedHnsw(t *testing.T) {
+	ctx := context.Background()
+	k := 10
+
+	compressions := []struct {
+		name string
+		cfg  ent.UserConfig
+	}{
+		{
+			name: "sq",
+			cfg: ent.UserConfig{
+				PQ: ent.PQConfig{TrainingLimit: 1000},
+				SQ: ent.SQConfig{Enabled: true},
+			},
+		},
+		{
+			name: "rq",
+			cfg: ent.UserConfig{
+				RQ: ent.RQConfig{Enabled: true, Bits: 8},
+			},
+		},
+	}
+
+	for _, compression := range compressions {
+		t.Run(compression.name, func(t *testing.T) {
+			vectorIndex, err := New(Config{
+				RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+				ID:                    "recallbenchmark",
+				MakeCommitLoggerThunk: MakeNoopCommitLogger,
+				DistanceProvider:      distancer.NewDotProductProvider(),
+				VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+					return []float32{0}, errors.New("can not use VectorForIDThunk with multivector")
+				},
+				MultiVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+					return multiVectors[id], nil
+				},
+				TempMultiVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([][]float32, error) {
+					return multiVectors[id], nil
+				},
+			}, ent.UserConfig{
+				MaxConnections: 8,
+				EFConstruction: 64,
+				EF:             64,
+				Multivector:    ent.MultivectorConfig{Enabled: true},
+			}, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+			require.Nil(t, err)
+
+			for i, vec := range multiVectors[:2] {
+				require.Nil(t, vectorIndex.AddMulti(ctx, uint64(i), vec))
+			}
+
+			require.Nil(t, vectorIndex.compress(compression.cfg))
+			require.True(t, vectorIndex.Compressed())
+
+			// inserted after the compression
+			require.Nil(t, vectorIndex.AddMulti(ctx, 2, multiVectors[2]))
+
+			for i, query := range multiQueries {
+				ids, _, err := vectorIndex.SearchByMultiVector(ctx, query, k, nil)
+				require.Nil(t, err)
+				require.Equal(t, expectedResults[i], ids)
+			}
+
+			require.Nil(t, vectorIndex.DeleteMulti(1))
+			for _, query := range multiQueries {
+				ids, _, err := vectorIndex.SearchByMultiVector(ctx, query, k, nil)
+				require.Nil(t, err)
+				require.ElementsMatch(t, []uint64{0, 2}, ids)
+			}
+		})
+	}
+}
+
+func TestMultiVectorMuveraHnsw(t *testing.T) {
+	ctx := context.Background()
+	k := 10
+
+	newIndex := func(t *testing.T, uc ent.UserConfig) *hnsw {
+		uc.MaxConnections = 8
+		uc.EFConstruction = 64
+		uc.EF = 64
+		uc.Multivector = ent.MultivectorConfig{
+			Enabled: true,
+			Muvera: ent.MuveraConfig{
+				Enabled:      true,
+				KSim:         ent.DefaultMuveraKSim,
+				DProjections: ent.DefaultMuveraDProjections,
+				Repetitions:  ent.DefaultMuveraRepetitions,
+			},
+		}
+		index, err := New(Config{
+			RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+			ID:                    "recallbenchmark",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      distancer.NewDotProductProvider(),
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return []float32{0}, errors.New("can not use VectorForIDThunk with multivector")
+			},
+			MultiVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+				if id >= uint64(len(multiVectors)) {
+					return nil, storobj.NewErrNotFoundf(id, "out of range")
+				}
+				return multiVectors[id], nil
+			},
+			TempMultiVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([][]float32, error) {
+				return multiVectors[id], nil
+			},
+		}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+		require.Nil(t, err)
+		require.True(t, index.Multivector())
+		return index
+	}
+
+	assertResults := func(t *testing.T, index *hnsw) {
+		for i, query := range multiQueries {
+			ids, dists, err := index.SearchByMultiVector(ctx, query, k, nil)
+			require.Nil(t, err)
+			require.Equal(t, expectedResults[i], ids)
+			// the results are rescored with the exact MaxSim of the token vectors
+			for j, id := range ids {
+				dist, err := index.QueryMultiVectorDistancer(query).DistanceFunc(id)
+				require.Nil(t, err)
+				require.InDelta(t, dist, dists[j], 1e-5)
+			}
+		}
+	}
+
+	t.Run("uncompressed", func(t *testing.T) {
+		index := newIndex(t, ent.UserConfig{})
+		for i, vec := range multiVectors {
+			require.Nil(t, index.AddMulti(ctx, uint64(i), vec))
+		}
+		// every document is a single node holding its encoding
+		require.Equal(t, ent.DefaultMuveraRepetitions*(1<<ent.DefaultMuveraKSim)*ent.DefaultMuveraDProjections,
+			int(index.dims))
+		require.Len(t, index.docIDVectors, 0)
+		assertResults(t, index)
+
+		require.Nil(t, index.DeleteMulti(1))
+		for _, query := range multiQueries {
+			ids, _, err := index.SearchByMultiVector(ctx, query, k, nil)
+			require.Nil(t, err)
+			require.ElementsMatch(t, []uint64{0, 2}, ids)
+		}
+	})
+
+	t.Run("bq", func(t *testing.T) {
+		index := newIndex(t, ent.UserConfig{BQ: ent.BQConfig{Enabled: true}})
+		for i, vec := range multiVectors {
+			require.Nil(t, index.AddMulti(ctx, uint64(i), vec))
+		}
+		assertResults(t, index)
+	})
+
+	t.Run("sq", func(t *testing.T) {
+		index := newIndex(t, ent.UserConfig{})
+		for i, vec := range multiVectors {
+			require.Nil(t, index.AddMulti(ctx, uint64(i), vec))
+		}
+		require.Nil(t, index.compress(ent.UserConfig{
+			PQ: ent.PQConfig{TrainingLimit: 1000},
+			SQ: ent.SQConfig{Enabled: true},
+		}))
+		assertResults(t, index)
+	})
+
+	t.Run("invalid token dimensions", func(t *testing.T) {
+		index := newIndex(t, ent.UserConfig{})
+		require.Nil(t, index.AddMulti(ctx, 0, multiVectors[0]))
+		require.NotNil(t, index.ValidateMultiBeforeInsert([][]float32{{1, 2}}))
+		_, _, err := index.SearchByMultiVector(ctx, [][]float32{{1, 2}}, k, nil)
+		require.NotNil(t, err)
+	})
+}
+
+func TestMultiVectorHnswSearchByDistance(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(42))
+	dims := 8
+	randomVector := func() []float32 {
+		vec := make([]float32, dims)
+		for i := range vec {
+			vec[i] = r.Float32()*2 - 1
+		}
+		return vec
+	}
+
+	// more documents than the initial limit of a search by distance, so most
+	// results can only be found by traversing the graph
+	docs := make([][][]float32, 3*DefaultSearchByDistInitialLimit)
+	for i := range docs {
+		docs[i] = make([][]float32, 1+r.Intn(3))
+		for j := range docs[i] {
+			docs[i][j] = randomVector()
+		}
+	}
+
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "multivector-search-by-distance",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewDotProductProvider(),
+		VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+			return nil, errors.New("can not use VectorForIDThunk with multivector")
+		},
+		MultiVectorForIDThunk: func(ctx context.Context, id uint64) ([][]float32, error) {
+			return docs[id], nil
+		},
+	}, ent.UserConfig{
+		MaxConnections: 16,
+		EFConstruction: 128,
+		EF:             64,
+		Multivector:    ent.MultivectorConfig{Enabled: true},
+	}, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	for i, doc := range docs {
+		require.Nil(t, index.AddMulti(ctx, uint64(i), doc))
+	}
+
+	query := [][]float32{randomVector(), randomVector()}
+	scores := make([]float32, len(docs))
+	for i := range docs {
+		scores[i], err = index.computeScore(query, uint64(i))
+		require.Nil(t, err)
+	}
+	sorted := append([]float32{}, scores...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	targetDistance := sorted[len(sorted)*4/5]
+
+	var expected []uint64
+	for i, score := range scores {
+		if score <= targetDistance {
+			expected = append(expected, uint64(i))
+		}
+	}
+
+	t.Run("all results within range", func(t *testing.T) {
+		ids, dists, err := index.SearchByMultiVectorDistance(ctx, query, targetDistance, -1, nil)
+		require.Nil(t, err)
+		// like the search by distance of single vectors, this relies on the
+		// graph connecting the results, which isn't guaranteed
+		require.Subset(t, expected, ids)
+		require.Greater(t, len(ids), DefaultSearchByDistInitialLimit)
+		require.Greater(t, float64(len(ids))/float64(len(expected)), 0.95)
+		for i := range dists {
+			require.LessOrEqual(t, dists[i], targetDistance)
+			if i > 0 {
+				require.LessOrEqual(t, dists[i-1], dists[i])
+			}
+		}
+	})
+
+	t.Run("max limit", func(t *testing.T) {
+		_, allDists, err := index.SearchByMultiVectorDistance(ctx, query, targetDistance, -1, nil)
+		require.Nil(t, err)
+		ids, dists, err := index.SearchByMultiVectorDistance(ctx, query, targetDistance, 10, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, 10)
+		// the lowest scores come first, like the shortest distances
+		require.Equal(t, allDists[:10], dists)
+	})
+
+	t.Run("deleted documents", func(t *testing.T) {
+		require.Nil(t, index.DeleteMulti(expected[0]))
+		ids, _, err := index.SearchByMultiVectorDistance(ctx, query, targetDistance, -1, nil)
+		require.Nil(t, err)
+		require.NotContains(t, ids, expected[0])
+		require.Subset(t, expected[1:], ids)
+	})
+}
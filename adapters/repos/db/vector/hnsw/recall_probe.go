@@ -0,0 +1,232 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// recallProbe periodically estimates the recall of the index. Vectors that
+// are stored in the index are used as queries, the results of a regular
+// search, which includes compression and rescoring, are compared to the
+// results of an exact search on the uncompressed vectors.
+type recallProbe struct {
+	index      *hnsw
+	interval   time.Duration
+	sampleSize int
+	k          int
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// startRecallProbe is called once the index is started up and runs the
+// probe if it is enabled in the config
+func (h *hnsw) startRecallProbe() {
+	h.recallProbeLock.Lock()
+	defer h.recallProbeLock.Unlock()
+
+	h.recallProbeStarted = true
+	h.restartRecallProbe()
+}
+
+// updateRecallProbe restarts the probe with the updated config of the
+// index if it changed
+func (h *hnsw) updateRecallProbe(cfg ent.RecallProbeConfig) {
+	h.recallProbeLock.Lock()
+	defer h.recallProbeLock.Unlock()
+
+	if cfg == h.recallProbeConfig {
+		return
+	}
+	h.recallProbeConfig = cfg
+	if h.recallProbeStarted {
+		h.restartRecallProbe()
+	}
+}
+
+func (h *hnsw) stopRecallProbe() {
+	h.recallProbeLock.Lock()
+	defer h.recallProbeLock.Unlock()
+
+	h.recallProbeStarted = false
+	h.stopRunningRecallProbe()
+}
+
+// restartRecallProbe stops the running probe and starts a new one with the
+// current config if it is enabled, h.recallProbeLock must be held
+func (h *hnsw) restartRecallProbe() {
+	h.stopRunningRecallProbe()
+
+	cfg := h.recallProbeConfig
+	if !cfg.Enabled || h.multivector.Load() {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p := &recallProbe{
+		index:      h,
+		interval:   time.Duration(cfg.IntervalSeconds) * time.Second,
+		sampleSize: cfg.SampleSize,
+		k:          cfg.K,
+		cancel:     cancel,
+		done:       make(chan struct{}),
+	}
+	h.recallProbe = p
+
+	enterrors.GoWrapper(func() { p.run(ctx) }, h.logger)
+}
+
+func (h *hnsw) stopRunningRecallProbe() {
+	if h.recallProbe == nil {
+		return
+	}
+	h.recallProbe.cancel()
+	<-h.recallProbe.done
+	h.recallProbe = nil
+}
+
+func (p *recallProbe) run(ctx context.Context) {
+	defer close(p.done)
+
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		recall, sampled, err := p.index.estimateRecall(ctx, p.sampleSize, p.k)
+		if err != nil {
+			if ctx.Err() == nil {
+				p.index.logger.WithField("action", "hnsw_recall_probe").
+					WithError(err).Warn("estimate recall")
+			}
+			continue
+		}
+		if sampled == 0 {
+			continue
+		}
+
+		p.index.metrics.SetRecallEstimate(recall)
+		p.index.logger.WithFields(logrus.Fields{
+			"action":  "hnsw_recall_probe",
+			"recall":  recall,
+			"k":       p.k,
+			"sampled": sampled,
+		}).Debug("estimated recall")
+	}
+}
+
+// estimateRecall returns the recall@k of the index for up to sampleSize
+// randomly picked vectors of the index and the number of vectors that were
+// actually sampled. The sampled vector itself is excluded from both result
+// sets, as it is always its own nearest neighbor.
+func (h *hnsw) estimateRecall(ctx context.Context, sampleSize, k int,
+) (float64, int, error) {
+	ids := h.sampleNodes(sampleSize)
+	queryVectors := make([][]float32, 0, len(ids))
+	queryIDs := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		vec, err := h.VectorForIDThunk(ctx, id)
+		if err != nil {
+			// deleted in the meantime
+			continue
+		}
+		queryVectors = append(queryVectors, h.normalizeVec(vec))
+		queryIDs = append(queryIDs, id)
+	}
+	if len(queryVectors) == 0 {
+		return 0, 0, nil
+	}
+
+	exact, err := h.exactSearchByVectors(ctx, queryVectors, k+1)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "exact search")
+	}
+
+	found, total := 0, 0
+	for i, queryVector := range queryVectors {
+		approx, _, err := h.SearchByVector(ctx, queryVector, k+1, nil)
+		if err != nil {
+			return 0, 0, errors.Wrap(err, "search")
+		}
+
+		expected := make(map[uint64]struct{}, k)
+		for _, id := range withoutID(exact[i], queryIDs[i], k) {
+			expected[id] = struct{}{}
+		}
+		for _, id := range withoutID(approx, queryIDs[i], k) {
+			if _, ok := expected[id]; ok {
+				found++
+			}
+		}
+		total += len(expected)
+	}
+	if total == 0 {
+		return 0, 0, nil
+	}
+
+	return float64(found) / float64(total), len(queryVectors), nil
+}
+
+// sampleNodes picks up to n random nodes that are neither deleted nor
+// tombstoned
+func (h *hnsw) sampleNodes(n int) []uint64 {
+	h.RLock()
+	size := len(h.nodes)
+	h.RUnlock()
+	if size == 0 {
+		return nil
+	}
+
+	picked := make(map[uint64]struct{}, n)
+	ids := make([]uint64, 0, n)
+	// the nodes slice grows ahead of the number of vectors, give up after a
+	// reasonable number of misses instead of scanning it
+	for attempts := 0; attempts < 10*n && len(ids) < n; attempts++ {
+		id := uint64(rand.Intn(size))
+		if _, ok := picked[id]; ok {
+			continue
+		}
+		if h.nodeByID(id) == nil || h.hasTombstone(id) {
+			continue
+		}
+		picked[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func withoutID(ids []uint64, id uint64, limit int) []uint64 {
+	out := make([]uint64, 0, limit)
+	for _, candidate := range ids {
+		if candidate == id {
+			continue
+		}
+		if len(out) == limit {
+			break
+		}
+		out = append(out, candidate)
+	}
+	return out
+}
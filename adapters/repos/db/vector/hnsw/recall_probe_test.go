@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func recallProbeTestUserConfig() ent.UserConfig {
+	uc := ent.NewDefaultUserConfig()
+	uc.MaxConnections = 16
+	uc.EFConstruction = 64
+	uc.EF = 16
+	uc.BQ.Enabled = true
+	return uc
+}
+
+func newRecallProbeTestIndex(t *testing.T, vectors [][]float32, cfg Config) *hnsw {
+	uc := recallProbeTestUserConfig()
+
+	cfg.RootPath = t.TempDir()
+	cfg.ID = "recall-probe"
+	cfg.MakeCommitLoggerThunk = MakeNoopCommitLogger
+	cfg.DistanceProvider = distancer.NewL2SquaredProvider()
+	cfg.VectorForIDThunk = func(ctx context.Context, id uint64) ([]float32, error) {
+		if int(id) >= len(vectors) {
+			return nil, storobj.NewErrNotFoundf(id, "out of range")
+		}
+		return vectors[int(id)], nil
+	}
+	cfg.TempVectorForIDThunk = func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+		if int(id) >= len(vectors) {
+			return nil, storobj.NewErrNotFoundf(id, "out of range")
+		}
+		copy(container.Slice, vectors[int(id)])
+		return container.Slice, nil
+	}
+
+	index, err := New(cfg, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+	require.Nil(t, err)
+	for id, vec := range vectors {
+		require.Nil(t, index.Add(context.Background(), uint64(id), vec))
+	}
+	return index
+}
+
+func bruteForceNeighbors(vectors [][]float32, query []float32, k int) []uint64 {
+	ids := make([]uint64, len(vectors))
+	dists := make([]float32, len(vectors))
+	for i, vec := range vectors {
+		ids[i] = uint64(i)
+		dists[i], _ = distancer.NewL2SquaredProvider().SingleDist(query, vec)
+	}
+	sort.Slice(ids, func(a, b int) bool { return dists[ids[a]] < dists[ids[b]] })
+	return ids[:k]
+}
+
+func TestExactSearchByVectors(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(300, 5, 16)
+	index := newRecallProbeTestIndex(t, vectors, Config{FlatSearchConcurrency: 3})
+	defer index.Shutdown(context.Background())
+
+	results, err := index.exactSearchByVectors(context.Background(), queries, 10)
+	require.Nil(t, err)
+	require.Len(t, results, len(queries))
+	for i, query := range queries {
+		assert.Equal(t, bruteForceNeighbors(vectors, query, 10), results[i])
+	}
+}
+
+func TestEstimateRecall(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(500, 0, 32)
+	index := newRecallProbeTestIndex(t, vectors, Config{})
+	defer index.Shutdown(context.Background())
+
+	recall, sampled, err := index.estimateRecall(context.Background(), 20, 10)
+	require.Nil(t, err)
+	assert.Equal(t, 20, sampled)
+	assert.Greater(t, recall, 0.0)
+	assert.LessOrEqual(t, recall, 1.0)
+}
+
+func TestEstimateRecallEmptyIndex(t *testing.T) {
+	index := newRecallProbeTestIndex(t, nil, Config{})
+	defer index.Shutdown(context.Background())
+
+	_, sampled, err := index.estimateRecall(context.Background(), 20, 10)
+	require.Nil(t, err)
+	assert.Equal(t, 0, sampled)
+}
+
+func TestRecallProbeFollowsConfig(t *testing.T) {
+	vectors, _ := testinghelpers.RandomVecs(100, 0, 16)
+	index := newRecallProbeTestIndex(t, vectors, Config{})
+
+	uc := recallProbeTestUserConfig()
+	update := func(enabled bool, sampleSize int) {
+		uc.RecallProbe.Enabled = enabled
+		uc.RecallProbe.SampleSize = sampleSize
+		require.Nil(t, index.UpdateUserConfig(uc, func() {}))
+	}
+	stopped := func(p *recallProbe) bool {
+		select {
+		case <-p.done:
+			return true
+		default:
+			return false
+		}
+	}
+
+	// the probe only runs once the index is started up
+	update(true, 5)
+	assert.Nil(t, index.recallProbe)
+	index.PostStartup()
+	first := index.recallProbe
+	require.NotNil(t, first)
+	assert.Equal(t, 5, first.sampleSize)
+	assert.Equal(t, time.Duration(ent.DefaultRecallProbeIntervalSeconds)*time.Second, first.interval)
+
+	update(true, 7)
+	second := index.recallProbe
+	require.NotNil(t, second)
+	assert.True(t, stopped(first))
+	assert.Equal(t, 7, second.sampleSize)
+
+	update(false, 7)
+	assert.Nil(t, index.recallProbe)
+	assert.True(t, stopped(second))
+
+	update(true, 7)
+	third := index.recallProbe
+	require.NotNil(t, third)
+	require.Nil(t, index.Shutdown(context.Background()))
+	assert.Nil(t, index.recallProbe)
+	assert.True(t, stopped(third), "recall probe still running after shutdown")
+}
+
+func TestRescoreMultiplier(t *testing.T) {
+	vectors, queries := testinghelpers.RandomVecs(1000, 20, 32)
+	index := newRecallProbeTestIndex(t, vectors, Config{})
+	defer index.Shutdown(context.Background())
+
+	k := 10
+	assert.Equal(t, 0, index.queryRescoreLimit(context.Background(), k))
+	ctx := common.ContextWithRescoreMultiplier(context.Background(), 20)
+	assert.Equal(t, 200, index.queryRescoreLimit(ctx, k))
+
+	recall := func(ctx context.Context) float64 {
+		found := 0
+		for _, query := range queries {
+			ids, _, err := index.SearchByVector(ctx, query, k, nil)
+			require.Nil(t, err)
+			require.Len(t, ids, k)
+			expected := bruteForceNeighbors(vectors, query, k)
+			for _, id := range ids {
+				for _, e := range expected {
+					if id == e {
+						found++
+					}
+				}
+			}
+		}
+		return float64(found) / float64(len(queries)*k)
+	}
+
+	withDefaults := recall(context.Background())
+	withMultiplier := recall(ctx)
+	// binary quantization of random vectors loses a lot of information, the
+	// default ef leaves too few candidates for rescoring to make up for it
+	assert.Greater(t, withMultiplier, withDefaults)
+	assert.Greater(t, withMultiplier, 0.8)
+}
@@ -20,17 +20,14 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
-	"github.com/sirupsen/logrus"
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/visited"
-	"github.com/weaviate/weaviate/entities/dto"
 	enterrors "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/storobj"
-	"github.com/weaviate/weaviate/usecases/floatcomp"
 )
 
 const defaultAcornMaxFilterPercentage = 0.4
@@ -76,17 +73,22 @@ func (h *hnsw) SearchByVector(ctx context.Context, vector []float32,
 	defer h.compressActionLock.RUnlock()
 
 	vector = h.normalizeVec(vector)
+	ef := h.searchTimeEF(k)
+	if limit := h.queryRescoreLimit(ctx, k); limit > ef {
+		// all candidates that are rescored need to be collected first
+		ef = limit
+	}
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
 		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
-		return h.flatSearch(ctx, vector, k, h.searchTimeEF(k), allowList)
+		return h.flatSearch(ctx, vector, k, ef, allowList)
 	}
 	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
-	return h.knnSearchByVector(ctx, vector, k, h.searchTimeEF(k), allowList)
+	return h.knnSearchByVector(ctx, vector, k, ef, allowList)
 }
 
 func (h *hnsw) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allowList helpers.AllowList) ([]uint64, []float32, error) {
-	if !h.multivector.Load() {
+	if !h.multivector.Load() && !h.muvera.Load() {
 		return nil, nil, errors.New("multivector search is not enabled")
 	}
 
@@ -94,6 +96,9 @@ func (h *hnsw) SearchByMultiVector(ctx context.Context, vectors [][]float32, k i
 	defer h.compressActionLock.RUnlock()
 
 	vectors = h.normalizeVecs(vectors)
+	if h.muvera.Load() {
+		return h.muveraSearch(ctx, vectors, k, allowList)
+	}
 	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
 	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
 		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
@@ -103,9 +108,8 @@ func (h *hnsw) SearchByMultiVector(ctx context.Context, vectors [][]float32, k i
 	return h.knnSearchByMultiVector(ctx, vectors, k, allowList)
 }
 
-// SearchByVectorDistance wraps SearchByVector, and calls it recursively until
-// the search results contain all vector within the threshold specified by the
-// target distance.
+// SearchByVectorDistance returns all vectors within the threshold specified by
+// the target distance, ordered by distance. See rangeSearchByVector.
 //
 // The maxLimit param will place an upper bound on the number of search results
 // returned. This is used in situations where the results of the method are all
@@ -116,13 +120,12 @@ func (h *hnsw) SearchByVectorDistance(ctx context.Context, vector []float32,
 	targetDistance float32, maxLimit int64,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
-	return searchByVectorDistance(ctx, vector, targetDistance, maxLimit, allowList,
-		h.SearchByVector, h.logger)
+	return h.rangeSearchByVector(ctx, vector, targetDistance, maxLimit, allowList)
 }
 
-// SearchByMultiVectorDistance wraps SearchByMultiVector, and calls it recursively until
-// the search results contain all vector within the threshold specified by the
-// target distance.
+// SearchByMultiVectorDistance returns all documents whose late interaction
+// score is within the threshold specified by the target distance. See
+// rangeSearchByMultiVector.
 //
 // The maxLimit param will place an upper bound on the number of search results
 // returned. This is used in situations where the results of the method are all
@@ -133,14 +136,23 @@ func (h *hnsw) SearchByMultiVectorDistance(ctx context.Context, vector [][]float
 	targetDistance float32, maxLimit int64,
 	allowList helpers.AllowList,
 ) ([]uint64, []float32, error) {
-	return searchByVectorDistance(ctx, vector, targetDistance, maxLimit, allowList,
-		h.SearchByMultiVector, h.logger)
+	return h.rangeSearchByMultiVector(ctx, vector, targetDistance, maxLimit, allowList)
 }
 
 func (h *hnsw) shouldRescore() bool {
 	return h.compressed.Load() && !h.doNotRescore
 }
 
+// queryRescoreLimit is the number of candidates to rescore as requested by the
+// rescore multiplier of the query, 0 if the query didn't set one or the index
+// doesn't rescore
+func (h *hnsw) queryRescoreLimit(ctx context.Context, k int) int {
+	if !h.shouldRescore() || h.multivector.Load() {
+		return 0
+	}
+	return common.RescoreLimitFromContext(ctx, k)
+}
+
 func (h *hnsw) cacheSize() int64 {
 	var size int64
 	if h.compressed.Load() {
@@ -527,7 +539,7 @@ func (h *hnsw) distanceFromBytesToFloatNode(concreteDistancer compressionhelpers
 	if !h.multivector.Load() {
 		vec, err = h.TempVectorForIDThunk(context.Background(), nodeID, slice)
 	} else {
-		docID, relativeID := h.cache.GetKeys(nodeID)
+		docID, relativeID := h.compressor.GetKeys(nodeID)
 		vecs, err := h.TempMultiVectorForIDThunk(context.Background(), docID, slice)
 		if err != nil {
 			return 0, err
@@ -835,6 +847,74 @@ func (h *hnsw) knnSearchByMultiVector(ctx context.Context, queryVectors [][]floa
 	return h.computeLateInteraction(queryVectors, k, candidateSet)
 }
 
+// muveraSearch generates candidates by searching the graph of fixed
+// dimensional encodings and reranks them by the exact MaxSim of their token
+// vectors. Only the candidates' token vectors are ever compared to the query.
+func (h *hnsw) muveraSearch(ctx context.Context, queryVectors [][]float32, k int,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if h.isEmpty() {
+		return nil, nil, nil
+	}
+	if err := h.initMuveraEncoder(ctx); err != nil {
+		return nil, nil, err
+	}
+	if dims := h.muveraEncoder.TokenDimensions(); dims > 0 {
+		for i := range queryVectors {
+			if len(queryVectors[i]) != dims {
+				return nil, nil, errors.Errorf("query multi vector has a vector with length %d "+
+					"at position %d, existing nodes have vectors with length %d", len(queryVectors[i]), i, dims)
+			}
+		}
+	}
+
+	query, err := h.muveraEncoder.EncodeQuery(queryVectors)
+	if err != nil {
+		return nil, nil, err
+	}
+	query = h.normalizeVec(query)
+
+	kPrime := h.searchTimeEF(k)
+	if limit := common.RescoreLimitFromContext(ctx, k); limit > kPrime {
+		kPrime = limit
+	}
+
+	var ids []uint64
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
+		ids, _, err = h.flatSearch(ctx, query, kPrime, kPrime, allowList)
+	} else {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+		ids, _, err = h.knnSearchByVector(ctx, query, kPrime, kPrime, allowList)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	candidateSet := make(map[uint64]struct{}, len(ids))
+	for _, id := range ids {
+		candidateSet[id] = struct{}{}
+	}
+	return h.computeLateInteraction(queryVectors, k, candidateSet)
+}
+
+// initMuveraEncoder makes sure the encoder knows the token dimensions before a
+// query is encoded. This is only needed when the index was restored in
+// compressed form, as nothing has been encoded since the restart then.
+func (h *hnsw) initMuveraEncoder(ctx context.Context) error {
+	if h.muveraEncoder.TokenDimensions() > 0 {
+		return nil
+	}
+	h.RLock()
+	entryPoint := h.entryPointID
+	h.RUnlock()
+	if _, err := h.VectorForIDThunk(ctx, entryPoint); err != nil {
+		return errors.Wrap(err, "initialize muvera encoder")
+	}
+	return nil
+}
+
 func (h *hnsw) computeLateInteraction(queryVectors [][]float32, k int, candidateSet map[uint64]struct{}) ([]uint64, []float32, error) {
 	resultsQueue := priorityqueue.NewMin[any](1)
 	for docID := range candidateSet {
@@ -867,7 +947,9 @@ func (h *hnsw) computeScore(searchVecs [][]float32, docID uint64) (float32, erro
 	vecIDs := h.docIDVectors[docID]
 	h.RUnlock()
 	var docVecs [][]float32
-	if h.compressed.Load() {
+	if h.compressed.Load() || h.muvera.Load() {
+		// with muvera the nodes hold the encodings, the token vectors are only
+		// available from the store
 		slice := h.pools.tempVectors.Get(int(h.dims))
 		var err error
 		docVecs, err = h.TempMultiVectorForIDThunk(context.Background(), docID, slice)
@@ -931,9 +1013,14 @@ func (h *hnsw) QueryVectorDistancer(queryVector []float32) common.QueryVectorDis
 func (h *hnsw) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
 	queryVector = h.normalizeVecs(queryVector)
 	f := func(docID uint64) (float32, error) {
-		h.RLock()
-		_, ok := h.docIDVectors[docID]
-		h.RUnlock()
+		var ok bool
+		if h.muvera.Load() {
+			ok = h.ContainsNode(docID)
+		} else {
+			h.RLock()
+			_, ok = h.docIDVectors[docID]
+			h.RUnlock()
+		}
 		if !ok {
 			return -1, fmt.Errorf("docID %v is not in the vector index", docID)
 		}
@@ -943,10 +1030,18 @@ func (h *hnsw) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVe
 }
 
 func (h *hnsw) rescore(ctx context.Context, res *priorityqueue.Queue[any], k int, compressorDistancer compressionhelpers.CompressorDistancer) error {
-	if h.sqConfig.Enabled && h.sqConfig.RescoreLimit >= k {
+	if limit := h.queryRescoreLimit(ctx, k); limit > 0 {
+		for res.Len() > limit {
+			res.Pop()
+		}
+	} else if h.sqConfig.Enabled && h.sqConfig.RescoreLimit >= k {
 		for res.Len() > h.sqConfig.RescoreLimit {
 			res.Pop()
 		}
+	} else if h.rqConfig.Enabled && h.rqConfig.RescoreLimit >= k {
+		for res.Len() > h.rqConfig.RescoreLimit {
+			res.Pop()
+		}
 	}
 	ids := make([]uint64, res.Len())
 	i := len(ids) - 1
@@ -1068,76 +1163,3 @@ func (params *searchByDistParams) maxLimitReached() bool {
 
 	return int64(params.totalLimit) > params.maximumSearchLimit
 }
-
-func searchByVectorDistance[T dto.Embedding](ctx context.Context, vector T,
-	targetDistance float32, maxLimit int64,
-	allowList helpers.AllowList,
-	searchByVector func(context.Context, T, int, helpers.AllowList) ([]uint64, []float32, error),
-	logger logrus.FieldLogger,
-) ([]uint64, []float32, error) {
-	var (
-		searchParams = newSearchByDistParams(maxLimit)
-
-		resultIDs  []uint64
-		resultDist []float32
-	)
-
-	recursiveSearch := func() (bool, error) {
-		shouldContinue := false
-
-		ids, dist, err := searchByVector(ctx, vector, searchParams.totalLimit, allowList)
-		if err != nil {
-			return false, errors.Wrap(err, "vector search")
-		}
-
-		// ensures the indexers aren't out of range
-		offsetCap := searchParams.offsetCapacity(ids)
-		totalLimitCap := searchParams.totalLimitCapacity(ids)
-
-		ids, dist = ids[offsetCap:totalLimitCap], dist[offsetCap:totalLimitCap]
-
-		if len(ids) == 0 {
-			return false, nil
-		}
-
-		lastFound := dist[len(dist)-1]
-		shouldContinue = lastFound <= targetDistance
-
-		for i := range ids {
-			if aboveThresh := dist[i] <= targetDistance; aboveThresh ||
-				floatcomp.InDelta(float64(dist[i]), float64(targetDistance), 1e-6) {
-				resultIDs = append(resultIDs, ids[i])
-				resultDist = append(resultDist, dist[i])
-			} else {
-				// as soon as we encounter a certainty which
-				// is below threshold, we can stop searching
-				break
-			}
-		}
-
-		return shouldContinue, nil
-	}
-
-	shouldContinue, err := recursiveSearch()
-	if err != nil {
-		return nil, nil, err
-	}
-
-	for shouldContinue {
-		searchParams.iterate()
-		if searchParams.maxLimitReached() {
-			logger.
-				WithField("action", "unlimited_vector_search").
-				Warnf("maximum search limit of %d results has been reached",
-					searchParams.maximumSearchLimit)
-			break
-		}
-
-		shouldContinue, err = recursiveSearch()
-		if err != nil {
-			return nil, nil, err
-		}
-	}
-
-	return resultIDs, resultDist, nil
-}
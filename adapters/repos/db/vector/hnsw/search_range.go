@@ -0,0 +1,466 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/storobj"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// rangeSearchByVector returns the nodes within targetDistance of the query
+// vector, ordered by distance. A regular knn search finds the closest nodes
+// first. If even the furthest of them is within range, layer 0 is traversed
+// from the ones within range through the nodes that are within range, so the
+// number of results isn't bound by ef or by a growing limit. Like any graph
+// search this is approximate: nodes within range that are only connected
+// through nodes out of range are not found.
+//
+// A positive maxLimit caps the number of results, the traversal stops once
+// it can't find closer ones.
+func (h *hnsw) rangeSearchByVector(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	vector = h.normalizeVec(vector)
+
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	if allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff {
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
+		if allowList.IsEmpty() {
+			return nil, nil, nil
+		}
+		// the allow list is small enough to compare the query against all of it
+		ids, dists, err := h.flatSearch(ctx, vector, allowList.Len(), allowList.Len(), allowList)
+		if err != nil {
+			return nil, nil, err
+		}
+		ids, dists = cutAtDistance(ids, dists, targetDistance)
+		return limitResults(ids, dists, maxLimit)
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+
+	k := DefaultSearchByDistInitialLimit
+	ids, dists, err := h.knnSearchByVector(ctx, vector, k, h.searchTimeEF(k), allowList)
+	if err != nil {
+		return nil, nil, err
+	}
+	complete := len(ids) < k || !withinDistance(dists[len(dists)-1], targetDistance)
+	ids, dists = cutAtDistance(ids, dists, targetDistance)
+	// the candidates of an index that rescores are selected by their compressed
+	// distances, so some that are within range might be missing even if the
+	// furthest result is out of range
+	if len(ids) == 0 || complete && !h.shouldRescore() {
+		return limitResults(ids, dists, maxLimit)
+	}
+
+	ids, dists, err = h.expandRange(ctx, vector, targetDistance, maxLimit, ids, dists, allowList)
+	if err != nil {
+		return nil, nil, err
+	}
+	helpers.AnnotateSlowQueryLog(ctx, "hnsw_range_search_results", len(ids))
+
+	return ids, dists, nil
+}
+
+// expandRange traverses layer 0 from the seeds, which are all within range,
+// and returns them together with the nodes within range it reaches, ordered
+// by distance. Nodes that can't be returned, because they are filtered out
+// or deleted, are traversed as well, as they might be the only connection to
+// other nodes within range.
+//
+// The closest candidates are traversed first. With a positive maxLimit, the
+// traversal stops like a regular search layer with an ef of maxLimit: once
+// that many results are found and the closest candidate left is further
+// away than all of them.
+func (h *hnsw) expandRange(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, ids []uint64, dists []float32,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	var compressorDistancer compressionhelpers.CompressorDistancer
+	var floatDistancer distancer.Distancer
+	if h.compressed.Load() {
+		var returnFn compressionhelpers.ReturnDistancerFn
+		compressorDistancer, returnFn = h.compressor.NewDistancer(vector)
+		defer returnFn()
+	} else {
+		floatDistancer = h.distancerProvider.New(vector)
+	}
+
+	// compressed distances of an index that rescores aren't comparable to the
+	// target distance, so the uncompressed vectors have to be used instead
+	rescore := h.shouldRescore()
+	distanceTo := func(id uint64) (float32, error) {
+		switch {
+		case rescore:
+			return h.distanceFromBytesToFloatNode(compressorDistancer, id)
+		case compressorDistancer != nil:
+			return compressorDistancer.DistanceToNode(id)
+		default:
+			return h.distanceToFloatNode(floatDistancer, id)
+		}
+	}
+
+	h.pools.visitedListsLock.RLock()
+	visited := h.pools.visitedLists.Borrow()
+	h.pools.visitedListsLock.RUnlock()
+	defer func() {
+		h.pools.visitedListsLock.RLock()
+		h.pools.visitedLists.Return(visited)
+		h.pools.visitedListsLock.RUnlock()
+	}()
+
+	pending := priorityqueue.NewMin[any](len(ids))
+	results := newRangeResults(len(ids), maxLimit)
+	for i, id := range ids {
+		visited.Visit(id)
+		pending.Insert(id, dists[i])
+		results.insert(id, dists[i])
+	}
+
+	var connections []uint64
+	for pending.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, fmt.Errorf("range search: %w", err)
+		}
+
+		candidate := pending.Pop()
+		if !results.improvedBy(candidate.Dist) {
+			break
+		}
+
+		connections = h.layerZeroConnections(candidate.ID, connections[:0])
+		for _, neighborID := range connections {
+			if visited.Visited(neighborID) {
+				continue
+			}
+			visited.Visit(neighborID)
+
+			dist, err := distanceTo(neighborID)
+			if err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					h.handleDeletedNode(e.DocID, "expandRange")
+					continue
+				}
+				return nil, nil, errors.Wrap(err, "range search: calculate distance between candidate and query")
+			}
+			if !withinDistance(dist, targetDistance) || !results.improvedBy(dist) {
+				continue
+			}
+
+			pending.Insert(neighborID, dist)
+			if h.hasTombstone(neighborID) || (allowList != nil && !allowList.Contains(neighborID)) {
+				continue
+			}
+			results.insert(neighborID, dist)
+		}
+	}
+
+	ids, dists = results.sorted()
+	return ids, dists, nil
+}
+
+// rangeSearchByMultiVector returns the documents whose late interaction
+// score is within targetDistance. The scores are treated like the distances
+// of rangeSearchByVector, so the results are ordered by ascending score. Like
+// rangeSearchByVector it starts from a regular multi vector search and,
+// unless that already found all documents within range, traverses layer 0
+// from the nodes of the documents within range. Every document reached is
+// scored once, and only the nodes of documents within range are traversed.
+//
+// A positive maxLimit caps the number of results, the traversal stops once
+// it can't find closer ones.
+func (h *hnsw) rangeSearchByMultiVector(ctx context.Context, vectors [][]float32,
+	targetDistance float32, maxLimit int64, allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if !h.multivector.Load() && !h.muvera.Load() {
+		return nil, nil, errors.New("multivector search is not enabled")
+	}
+
+	h.compressActionLock.RLock()
+	defer h.compressActionLock.RUnlock()
+
+	vectors = h.normalizeVecs(vectors)
+
+	k := DefaultSearchByDistInitialLimit
+	var (
+		ids    []uint64
+		scores []float32
+		err    error
+	)
+	flatSearchCutoff := int(atomic.LoadInt64(&h.flatSearchCutoff))
+	switch {
+	case h.muvera.Load():
+		ids, scores, err = h.muveraSearch(ctx, vectors, k, allowList)
+	case allowList != nil && !h.forbidFlat && allowList.Len() < flatSearchCutoff:
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", true)
+		if allowList.IsEmpty() {
+			return nil, nil, nil
+		}
+		// the allow list is small enough to score every document on it
+		ids, scores, err = h.flatMultiSearch(ctx, vectors, allowList.Len(), allowList)
+		if err != nil {
+			return nil, nil, err
+		}
+		ids, scores = scoresWithinDistance(ids, scores, targetDistance)
+		common.SortByDistance(ids, scores)
+		return limitResults(ids, scores, maxLimit)
+	default:
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_flat_search", false)
+		ids, scores, err = h.knnSearchByMultiVector(ctx, vectors, k, allowList)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	complete := len(ids) < k
+	ids, scores = scoresWithinDistance(ids, scores, targetDistance)
+	if len(ids) > 0 && !complete {
+		ids, scores, err = h.expandMultiVectorRange(ctx, vectors, targetDistance, maxLimit,
+			ids, scores, allowList)
+		if err != nil {
+			return nil, nil, err
+		}
+		helpers.AnnotateSlowQueryLog(ctx, "hnsw_range_search_results", len(ids))
+		return ids, scores, nil
+	}
+
+	common.SortByDistance(ids, scores)
+	return limitResults(ids, scores, maxLimit)
+}
+
+// expandMultiVectorRange is the multi vector counterpart of expandRange. The
+// graph connects the nodes of the individual vectors, so each neighbor is
+// mapped to its document, and the traversal continues from all nodes of the
+// documents within range, in the order of their documents' scores.
+func (h *hnsw) expandMultiVectorRange(ctx context.Context, vectors [][]float32,
+	targetDistance float32, maxLimit int64, ids []uint64, scores []float32,
+	allowList helpers.AllowList,
+) ([]uint64, []float32, error) {
+	muvera := h.muvera.Load()
+	compressed := h.compressed.Load()
+	docIDOf := func(nodeID uint64) uint64 {
+		switch {
+		case muvera:
+			// every document is a single node with the doc id as node id
+			return nodeID
+		case compressed:
+			docID, _ := h.compressor.GetKeys(nodeID)
+			return docID
+		default:
+			docID, _ := h.cache.GetKeys(nodeID)
+			return docID
+		}
+	}
+	nodesOf := func(docID uint64) ([]uint64, bool) {
+		if muvera {
+			return []uint64{docID}, true
+		}
+		h.RLock()
+		defer h.RUnlock()
+		nodeIDs, ok := h.docIDVectors[docID]
+		return nodeIDs, ok
+	}
+
+	h.pools.visitedListsLock.RLock()
+	visited := h.pools.visitedLists.Borrow()
+	h.pools.visitedListsLock.RUnlock()
+	defer func() {
+		h.pools.visitedListsLock.RLock()
+		h.pools.visitedLists.Return(visited)
+		h.pools.visitedListsLock.RUnlock()
+	}()
+
+	// documents are scored once, no matter through how many of their nodes
+	// they are reached
+	scored := make(map[uint64]struct{}, len(ids))
+	pending := priorityqueue.NewMin[any](len(ids))
+	results := newRangeResults(len(ids), maxLimit)
+	for i, docID := range ids {
+		scored[docID] = struct{}{}
+		results.insert(docID, scores[i])
+		nodeIDs, _ := nodesOf(docID)
+		for _, nodeID := range nodeIDs {
+			visited.Visit(nodeID)
+			pending.Insert(nodeID, scores[i])
+		}
+	}
+
+	var connections []uint64
+	for pending.Len() > 0 {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, fmt.Errorf("range search: %w", err)
+		}
+
+		candidate := pending.Pop()
+		if !results.improvedBy(candidate.Dist) {
+			break
+		}
+
+		connections = h.layerZeroConnections(candidate.ID, connections[:0])
+		for _, neighborID := range connections {
+			if visited.Visited(neighborID) {
+				continue
+			}
+			visited.Visit(neighborID)
+
+			docID := docIDOf(neighborID)
+			if _, ok := scored[docID]; ok {
+				continue
+			}
+			scored[docID] = struct{}{}
+
+			// a document without nodes has been deleted, scoring it would compare
+			// the query against no vectors at all
+			nodeIDs, ok := nodesOf(docID)
+			if !ok || len(nodeIDs) == 0 {
+				continue
+			}
+			score, err := h.computeScore(vectors, docID)
+			if err != nil {
+				var e storobj.ErrNotFound
+				if errors.As(err, &e) {
+					h.handleDeletedNode(e.DocID, "expandMultiVectorRange")
+					continue
+				}
+				return nil, nil, errors.Wrap(err, "range search: calculate score of candidate")
+			}
+			if !withinDistance(score, targetDistance) || !results.improvedBy(score) {
+				continue
+			}
+
+			for _, nodeID := range nodeIDs {
+				if !visited.Visited(nodeID) {
+					visited.Visit(nodeID)
+					pending.Insert(nodeID, score)
+				}
+			}
+			if h.hasTombstone(neighborID) || (allowList != nil && !allowList.Contains(neighborID)) {
+				continue
+			}
+			results.insert(docID, score)
+		}
+	}
+
+	ids, scores = results.sorted()
+	return ids, scores, nil
+}
+
+// rangeResults collects the results of a range search traversal, at most
+// maxLimit of them if that is positive
+type rangeResults struct {
+	queue    *priorityqueue.Queue[any]
+	maxLimit int64
+}
+
+func newRangeResults(capacity int, maxLimit int64) *rangeResults {
+	return &rangeResults{queue: priorityqueue.NewMax[any](capacity), maxLimit: maxLimit}
+}
+
+func (r *rangeResults) full() bool {
+	return r.maxLimit > 0 && int64(r.queue.Len()) >= r.maxLimit
+}
+
+// improvedBy indicates whether a result at dist could still be added, i.e.
+// whether the results aren't full yet or dist is closer than the furthest
+func (r *rangeResults) improvedBy(dist float32) bool {
+	return !r.full() || dist < r.queue.Top().Dist
+}
+
+func (r *rangeResults) insert(id uint64, dist float32) {
+	if !r.improvedBy(dist) {
+		return
+	}
+	r.queue.Insert(id, dist)
+	if r.maxLimit > 0 && int64(r.queue.Len()) > r.maxLimit {
+		r.queue.Pop()
+	}
+}
+
+// sorted returns the results ordered by ascending distance
+func (r *rangeResults) sorted() ([]uint64, []float32) {
+	ids := make([]uint64, r.queue.Len())
+	dists := make([]float32, r.queue.Len())
+	for i := len(ids) - 1; i >= 0; i-- {
+		item := r.queue.Pop()
+		ids[i], dists[i] = item.ID, item.Dist
+	}
+	return ids, dists
+}
+
+// layerZeroConnections appends the layer 0 connections of the node to buf
+func (h *hnsw) layerZeroConnections(id uint64, buf []uint64) []uint64 {
+	h.shardedNodeLocks.RLock(id)
+	node := h.nodes[id]
+	h.shardedNodeLocks.RUnlock(id)
+	if node == nil {
+		return buf
+	}
+
+	node.Lock()
+	defer node.Unlock()
+	if len(node.connections) > 0 {
+		buf = append(buf, node.connections[0]...)
+	}
+	return buf
+}
+
+func withinDistance(dist, targetDistance float32) bool {
+	return dist <= targetDistance ||
+		floatcomp.InDelta(float64(dist), float64(targetDistance), 1e-6)
+}
+
+// cutAtDistance drops the results, which are ordered by distance, that are
+// further away than the target distance
+func cutAtDistance(ids []uint64, dists []float32, targetDistance float32) ([]uint64, []float32) {
+	end := sort.Search(len(dists), func(i int) bool {
+		return !withinDistance(dists[i], targetDistance)
+	})
+	return ids[:end], dists[:end]
+}
+
+// scoresWithinDistance keeps the multi vector results whose scores are within
+// the target distance. Multi vector searches order their results by
+// descending score, so unlike cutAtDistance this can't stop at the first one
+// out of range.
+func scoresWithinDistance(ids []uint64, scores []float32, targetDistance float32) ([]uint64, []float32) {
+	keptIDs, keptScores := ids[:0], scores[:0]
+	for i := range ids {
+		if withinDistance(scores[i], targetDistance) {
+			keptIDs = append(keptIDs, ids[i])
+			keptScores = append(keptScores, scores[i])
+		}
+	}
+	return keptIDs, keptScores
+}
+
+func limitResults(ids []uint64, dists []float32, maxLimit int64,
+) ([]uint64, []float32, error) {
+	if maxLimit > 0 && int64(len(ids)) > maxLimit {
+		return ids[:maxLimit], dists[:maxLimit], nil
+	}
+	return ids, dists, nil
+}
@@ -0,0 +1,180 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func TestRangeSearch(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	dimensions := 16
+	vectors, queries := testinghelpers.RandomVecsFixedSeed(3000, 10, dimensions)
+	provider := distancer.NewL2SquaredProvider()
+
+	// the distance of the 500th closest vector, so that the results can't be
+	// found by the initial knn search alone
+	targetDistances := make([]float32, len(queries))
+	for i, query := range queries {
+		dists := make([]float32, len(vectors))
+		for j, vec := range vectors {
+			dists[j], _ = provider.SingleDist(query, vec)
+		}
+		sort.Slice(dists, func(a, b int) bool { return dists[a] < dists[b] })
+		targetDistances[i] = dists[499]
+	}
+
+	bruteForce := func(query []float32, targetDistance float32, allow helpers.AllowList) map[uint64]struct{} {
+		out := map[uint64]struct{}{}
+		for id, vec := range vectors {
+			if allow != nil && !allow.Contains(uint64(id)) {
+				continue
+			}
+			if dist, _ := provider.SingleDist(query, vec); dist <= targetDistance {
+				out[uint64(id)] = struct{}{}
+			}
+		}
+		return out
+	}
+
+	newIndex := func(t *testing.T, uc ent.UserConfig) *hnsw {
+		index, err := New(Config{
+			RootPath:              t.TempDir(),
+			ID:                    "range",
+			MakeCommitLoggerThunk: MakeNoopCommitLogger,
+			DistanceProvider:      provider,
+			VectorForIDThunk: func(ctx context.Context, id uint64) ([]float32, error) {
+				return vectors[int(id)], nil
+			},
+			TempVectorForIDThunk: func(ctx context.Context, id uint64, container *common.VectorSlice) ([]float32, error) {
+				copy(container.Slice, vectors[int(id)])
+				return container.Slice, nil
+			},
+		}, uc, cyclemanager.NewCallbackGroupNoop(), testinghelpers.NewDummyStore(t))
+		require.Nil(t, err)
+		require.Nil(t, compressionhelpers.ConcurrentlyWithError(logger, uint64(len(vectors)), func(id uint64) error {
+			return index.Add(ctx, id, vectors[id])
+		}))
+		return index
+	}
+
+	assertResults := func(t *testing.T, query []float32, targetDistance float32,
+		ids []uint64, dists []float32, expected map[uint64]struct{},
+	) {
+		require.Len(t, dists, len(ids))
+		assert.True(t, sort.SliceIsSorted(dists, func(a, b int) bool { return dists[a] < dists[b] }))
+		seen := map[uint64]struct{}{}
+		for i, id := range ids {
+			assert.NotContains(t, seen, id)
+			seen[id] = struct{}{}
+			assert.Contains(t, expected, id)
+			assert.LessOrEqual(t, dists[i], targetDistance)
+			dist, _ := provider.SingleDist(query, vectors[id])
+			assert.InDelta(t, dist, dists[i], 1e-4)
+		}
+		assert.Greater(t, float64(len(ids))/float64(len(expected)), 0.95)
+	}
+
+	configs := map[string]func(uc *ent.UserConfig){
+		"uncompressed": func(uc *ent.UserConfig) {},
+		"bq":           func(uc *ent.UserConfig) { uc.BQ.Enabled = true },
+	}
+
+	for name, configure := range configs {
+		t.Run(name, func(t *testing.T) {
+			uc := ent.NewDefaultUserConfig()
+			uc.EF = 64
+			uc.FlatSearchCutoff = 200
+			configure(&uc)
+			index := newIndex(t, uc)
+
+			t.Run("unfiltered", func(t *testing.T) {
+				for i, query := range queries {
+					ids, dists, err := index.SearchByVectorDistance(ctx, query, targetDistances[i], -1, nil)
+					require.Nil(t, err)
+					assert.Greater(t, len(ids), DefaultSearchByDistInitialLimit)
+					assertResults(t, query, targetDistances[i], ids, dists, bruteForce(query, targetDistances[i], nil))
+				}
+			})
+
+			t.Run("with max limit", func(t *testing.T) {
+				allIDs, _, err := index.SearchByVectorDistance(ctx, queries[0], targetDistances[0], -1, nil)
+				require.Nil(t, err)
+				ids, dists, err := index.SearchByVectorDistance(ctx, queries[0], targetDistances[0], 150, nil)
+				require.Nil(t, err)
+				assert.Len(t, ids, 150)
+				assert.Len(t, dists, 150)
+				// the traversal stops early, but still finds the closest results
+				closest := map[uint64]struct{}{}
+				for _, id := range allIDs[:150] {
+					closest[id] = struct{}{}
+				}
+				found := 0
+				for _, id := range ids {
+					if _, ok := closest[id]; ok {
+						found++
+					}
+				}
+				assert.Greater(t, float64(found)/150, 0.95)
+			})
+
+			t.Run("filtered", func(t *testing.T) {
+				allow := helpers.NewAllowList()
+				for id := uint64(0); id < uint64(len(vectors)); id += 2 {
+					allow.Insert(id)
+				}
+				for i, query := range queries {
+					ids, dists, err := index.SearchByVectorDistance(ctx, query, targetDistances[i], -1, allow)
+					require.Nil(t, err)
+					assertResults(t, query, targetDistances[i], ids, dists, bruteForce(query, targetDistances[i], allow))
+				}
+			})
+
+			t.Run("filtered below flat search cutoff", func(t *testing.T) {
+				allow := helpers.NewAllowList()
+				for id := uint64(0); id < 150; id++ {
+					allow.Insert(id)
+				}
+				for i, query := range queries {
+					ids, dists, err := index.SearchByVectorDistance(ctx, query, targetDistances[i], -1, allow)
+					require.Nil(t, err)
+					expected := bruteForce(query, targetDistances[i], allow)
+					assert.Len(t, ids, len(expected))
+					assertResults(t, query, targetDistances[i], ids, dists, expected)
+				}
+			})
+
+			t.Run("small range", func(t *testing.T) {
+				ids, dists, err := index.SearchByVectorDistance(ctx, vectors[7], 0, -1, nil)
+				require.Nil(t, err)
+				require.Len(t, ids, 1)
+				assert.Equal(t, uint64(7), ids[0])
+				assert.Equal(t, float32(0), dists[0])
+			})
+		})
+	}
+}
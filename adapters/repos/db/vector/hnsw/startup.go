@@ -140,6 +140,16 @@ func (h *hnsw) restoreFromDisk() error {
 	if state.Compressed {
 		h.compressed.Store(state.Compressed)
 		h.cache.Drop()
+
+		restorePQ := compressionhelpers.RestoreHNSWPQCompressor
+		restoreSQ := compressionhelpers.RestoreHNSWSQCompressor
+		restoreRQ := compressionhelpers.RestoreHNSWRQCompressor
+		if h.multivector.Load() {
+			// token vectors are cached grouped by document
+			restorePQ = compressionhelpers.RestoreHNSWPQMultiCompressor
+			restoreSQ = compressionhelpers.RestoreHNSWSQMultiCompressor
+			restoreRQ = compressionhelpers.RestoreHNSWRQMultiCompressor
+		}
 		if state.CompressionPQData != nil {
 			data := state.CompressionPQData
 			h.dims = int32(data.Dimensions)
@@ -149,7 +159,7 @@ func (h *hnsw) restoreFromDisk() error {
 				if h.pqConfig.Segments == 0 {
 					h.pqConfig.Segments = int(data.Dimensions)
 				}
-				h.compressor, err = compressionhelpers.RestoreHNSWPQCompressor(
+				h.compressor, err = restorePQ(
 					h.pqConfig,
 					h.distancerProvider,
 					int(data.Dimensions),
@@ -167,7 +177,7 @@ func (h *hnsw) restoreFromDisk() error {
 		} else if state.CompressionSQData != nil {
 			data := state.CompressionSQData
 			h.dims = int32(data.Dimensions)
-			h.compressor, err = compressionhelpers.RestoreHNSWSQCompressor(
+			h.compressor, err = restoreSQ(
 				h.distancerProvider,
 				1e12,
 				h.logger,
@@ -180,6 +190,20 @@ func (h *hnsw) restoreFromDisk() error {
 			if err != nil {
 				return errors.Wrap(err, "Restoring compressed data.")
 			}
+		} else if state.CompressionRQData != nil {
+			data := state.CompressionRQData
+			h.dims = int32(data.Dimensions)
+			h.compressor, err = restoreRQ(
+				h.distancerProvider,
+				1e12,
+				h.logger,
+				*data,
+				h.store,
+				h.allocChecker,
+			)
+			if err != nil {
+				return errors.Wrap(err, "Restoring compressed data.")
+			}
 		} else {
 			return errors.New("unsupported type while loading compression data")
 		}
@@ -208,6 +232,7 @@ func (h *hnsw) restoreDocMappings() error {
 	prevDocID := uint64(0)
 	relativeID := uint64(0)
 	maxNodeID := uint64(0)
+	maxDocID := uint64(0)
 	buf := make([]byte, 8)
 	for _, node := range h.nodes {
 		if node == nil {
@@ -235,9 +260,18 @@ func (h *hnsw) restoreDocMappings() error {
 		if node.id > maxNodeID {
 			maxNodeID = node.id
 		}
+		if docID > maxDocID {
+			maxDocID = docID
+		}
 	}
 	h.Lock()
 	h.vecIDcounter = maxNodeID + 1
+	h.maxDocID = maxDocID
+	if h.compressed.Load() {
+		h.compressor.GrowMultiCache(maxDocID)
+	} else {
+		h.cache.GrowMultiCache(maxDocID)
+	}
 	h.Unlock()
 	return nil
 }
@@ -281,6 +315,7 @@ func (h *hnsw) tombstoneCleanup(shouldAbort cyclemanager.ShouldAbortCallback) bo
 // getVectorForID.
 func (h *hnsw) PostStartup() {
 	h.prefillCache()
+	h.startRecallProbe()
 }
 
 func (h *hnsw) prefillCache() {
@@ -0,0 +1,124 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/errorcompounder"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	"github.com/weaviate/weaviate/usecases/memwatch"
+)
+
+type Config struct {
+	ID               string
+	RootPath         string
+	TargetVector     string
+	ShardName        string
+	ClassName        string
+	Logger           logrus.FieldLogger
+	DistanceProvider distancer.Provider
+	VectorForIDThunk common.VectorForID[float32]
+	AllocChecker     memwatch.AllocChecker
+}
+
+func (c Config) Validate() error {
+	ec := errorcompounder.New()
+
+	if c.ID == "" {
+		ec.Addf("id cannot be empty")
+	}
+
+	if c.RootPath == "" {
+		ec.Addf("rootPath cannot be empty")
+	}
+
+	if c.DistanceProvider == nil {
+		ec.Addf("distancerProvider cannot be nil")
+	}
+
+	if c.VectorForIDThunk == nil {
+		ec.Addf("vectorForIDThunk cannot be nil")
+	}
+
+	return ec.ToError()
+}
+
+type immutableParameter struct {
+	accessor func(c ivfent.UserConfig) interface{}
+	name     string
+}
+
+func validateImmutableField(u immutableParameter,
+	previous, next ivfent.UserConfig,
+) error {
+	oldField := u.accessor(previous)
+	newField := u.accessor(next)
+	if oldField != newField {
+		return errors.Errorf("%s is immutable: attempted change from \"%v\" to \"%v\"",
+			u.name, oldField, newField)
+	}
+
+	return nil
+}
+
+func ValidateUserConfigUpdate(initial, updated schemaConfig.VectorIndexConfig) error {
+	initialParsed, ok := initial.(ivfent.UserConfig)
+	if !ok {
+		return errors.Errorf("initial is not UserConfig, but %T", initial)
+	}
+
+	updatedParsed, ok := updated.(ivfent.UserConfig)
+	if !ok {
+		return errors.Errorf("updated is not UserConfig, but %T", updated)
+	}
+
+	// nprobe, rescoreLimit, retrainFactor and flatSearchCutoff only affect
+	// future searches and trainings, everything else is baked into the
+	// persisted codes
+	immutableFields := []immutableParameter{
+		{
+			name:     "distance",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.Distance },
+		},
+		{
+			name:     "nlist",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.NList },
+		},
+		{
+			name:     "trainingLimit",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.TrainingLimit },
+		},
+		{
+			name:     "pq.segments",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.PQ.Segments },
+		},
+		{
+			name:     "pq.centroids",
+			accessor: func(c ivfent.UserConfig) interface{} { return c.PQ.Centroids },
+		},
+	}
+
+	for _, u := range immutableFields {
+		if err := validateImmutableField(u, initialParsed, updatedParsed); err != nil {
+			return err
+		}
+	}
+	if updatedParsed.NProbe > updatedParsed.NList {
+		return errors.Errorf("nprobe (%d) cannot be larger than nlist (%d)",
+			updatedParsed.NProbe, updatedParsed.NList)
+	}
+	return nil
+}
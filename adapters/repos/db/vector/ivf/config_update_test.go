@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+func TestIVFUserConfigUpdates(t *testing.T) {
+	initial := ivfent.NewDefaultUserConfig()
+
+	t.Run("search parameters can be changed", func(t *testing.T) {
+		updated := ivfent.NewDefaultUserConfig()
+		updated.NProbe = 64
+		updated.RescoreLimit = 500
+		updated.RetrainFactor = 4
+		updated.FlatSearchCutoff = 1000
+		assert.Nil(t, ValidateUserConfigUpdate(initial, updated))
+	})
+
+	t.Run("nprobe cannot exceed nlist", func(t *testing.T) {
+		updated := ivfent.NewDefaultUserConfig()
+		updated.NProbe = updated.NList + 1
+		assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "nprobe")
+	})
+
+	t.Run("quantization parameters are immutable", func(t *testing.T) {
+		updated := ivfent.NewDefaultUserConfig()
+		updated.NList = 64
+		assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "nlist is immutable")
+
+		updated = ivfent.NewDefaultUserConfig()
+		updated.PQ.Centroids = 128
+		assert.ErrorContains(t, ValidateUserConfigUpdate(initial, updated), "pq.centroids is immutable")
+	})
+}
@@ -0,0 +1,639 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/storobj"
+	hnswent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+// iteratePageSize is the number of entries read per cursor in iterate
+const iteratePageSize = 1000
+
+// ivf is an inverted file index with product quantization (IVF-PQ). The
+// vectors are partitioned into nlist lists by a coarse k-means and every
+// vector is stored as the PQ code of its residual to the centroid of its
+// list. Only the lists are held in memory, a search scores the codes of the
+// nprobe closest lists and rescores the best candidates with the full vectors,
+// which are read from the objects through the vector-for-id thunk.
+//
+// The codes bucket holds a record for every vector in the index, including
+// the ones imported before the first training, and is the source of truth
+// for which vectors are indexed. Until the first trainingLimit vectors are
+// imported there is nothing to train the quantizer on, so searches score all
+// vectors exactly like the flat index does. Once the index has grown by retrainFactor since the last
+// training, the quantizer is retrained in the background. While a training
+// runs, inserts and deletes are applied to both the current and the pending
+// generation, searches keep using the current one until the pending one is
+// complete.
+type ivf struct {
+	id                string
+	targetVector      string
+	rootPath          string
+	shardName         string
+	className         string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+	vectorForID       common.VectorForID[float32]
+
+	metadata            *common.Metadata
+	dims                atomic.Int32
+	trackDimensionsOnce sync.Once
+
+	nlist         int
+	trainingLimit int
+	pqSegments    int
+	pqCentroids   int
+
+	// read on every search or insert, stored as atomics so that config
+	// updates don't need a lock
+	nprobe           atomic.Int64
+	rescoreLimit     atomic.Int64
+	flatSearchCutoff atomic.Int64
+	retrainFactor    atomic.Uint64 // float64 bits
+
+	// stateLock guards current and pending. It is held for reading by every
+	// insert, delete and search, the lists have their own locks.
+	stateLock sync.RWMutex
+	current   *quantizer
+	pending   *quantizer
+
+	// pendingLock guards the bookkeeping that makes sure every vector ends up
+	// in the pending generation exactly once, no matter if it is encoded by
+	// the training or by a concurrent insert. pendingDeleted also remembers
+	// which vectors have to be removed from the current generation if the
+	// training is discarded, as their records no longer name their list.
+	pendingLock    sync.Mutex
+	pendingMembers map[uint64]struct{}
+	pendingDeleted map[uint64]struct{}
+
+	count        atomic.Uint64
+	trainedCount atomic.Uint64
+	training     atomic.Bool
+	trainingWg   sync.WaitGroup
+
+	shutdownCtx       context.Context
+	shutdownCtxCancel context.CancelFunc
+}
+
+func New(cfg Config, uc ivfent.UserConfig, store *lsmkv.Store) (*ivf, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &ivf{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		rootPath:          cfg.RootPath,
+		shardName:         cfg.ShardName,
+		className:         cfg.ClassName,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+		vectorForID:       cfg.VectorForIDThunk,
+		metadata:          common.NewMetadata(cfg.RootPath, metadataPrefix, cfg.TargetVector),
+		nlist:             uc.NList,
+		trainingLimit:     uc.TrainingLimit,
+		pqSegments:        uc.PQ.Segments,
+		pqCentroids:       uc.PQ.Centroids,
+	}
+	index.setUserConfig(uc)
+	index.shutdownCtx, index.shutdownCtxCancel = context.WithCancel(context.Background())
+
+	if err := index.initBuckets(); err != nil {
+		return nil, fmt.Errorf("init ivf index buckets: %w", err)
+	}
+	if err := index.restoreMetadata(); err != nil {
+		return nil, err
+	}
+	if err := index.restoreLists(); err != nil {
+		return nil, err
+	}
+	index.count.Store(uint64(index.store.Bucket(index.codesBucketName()).Count()))
+
+	return index, nil
+}
+
+func (index *ivf) setUserConfig(uc ivfent.UserConfig) {
+	index.nprobe.Store(int64(uc.NProbe))
+	index.rescoreLimit.Store(int64(uc.RescoreLimit))
+	index.flatSearchCutoff.Store(int64(uc.FlatSearchCutoff))
+	index.retrainFactor.Store(math.Float64bits(uc.RetrainFactor))
+}
+
+func (index *ivf) codesBucketName() string {
+	return index.bucketName(helpers.VectorsIVFCodesBucketLSM)
+}
+
+func (index *ivf) bucketName(prefix string) string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", prefix, index.targetVector)
+	}
+	return prefix
+}
+
+func (index *ivf) initBuckets() error {
+	if err := index.store.CreateOrLoadBucket(index.shutdownCtx, index.codesBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(true),
+	); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", index.codesBucketName())
+	}
+	return nil
+}
+
+// restoreLists fills the lists of the current generation from the codes
+// bucket. Records of another generation were written before the first
+// training or by a training that did not complete, those vectors are encoded
+// again.
+func (index *ivf) restoreLists() error {
+	q := index.current
+	if q == nil {
+		return nil
+	}
+
+	stale := 0
+	err := index.iterate(index.codesBucketName(), func(id uint64, value []byte) error {
+		generation, list, code, err := unmarshalRecord(value)
+		if err != nil {
+			return errors.Wrapf(err, "record of vector %d", id)
+		}
+		if generation == q.generation && int(list) < len(q.lists) {
+			q.lists[list].add(id, code)
+			return nil
+		}
+
+		stale++
+		vector, err := index.vectorByID(index.shutdownCtx, id)
+		if err != nil {
+			return err
+		}
+		if vector == nil {
+			// the object is gone, the delete would find nothing to remove
+			return index.store.Bucket(index.codesBucketName()).Delete(common.IDKey(id))
+		}
+		list, code = q.encode(vector)
+		q.lists[list].add(id, code)
+		return index.putRecord(id, q.generation, list, code)
+	})
+	if err != nil {
+		return errors.Wrap(err, "restore lists")
+	}
+	if stale > 0 {
+		index.logger.WithField("action", "ivf_restore_lists").
+			WithField("class", index.className).
+			WithField("shard", index.shardName).
+			Infof("re-encoded %d vectors of an incomplete training", stale)
+	}
+	return nil
+}
+
+// iterate calls fn for every entry of the bucket. The cursor is only held
+// while a page of entries is read, so that a long iteration, e.g. during a
+// training, does not block flushing the bucket.
+func (index *ivf) iterate(bucketName string, fn func(id uint64, value []byte) error) error {
+	type entry struct {
+		id    uint64
+		value []byte
+	}
+
+	bucket := index.store.Bucket(bucketName)
+	var seek []byte
+	for {
+		page := make([]entry, 0, iteratePageSize)
+		cursor := bucket.Cursor()
+		var key, value []byte
+		if seek == nil {
+			key, value = cursor.First()
+		} else {
+			key, value = cursor.Seek(seek)
+		}
+		for ; key != nil && len(page) < iteratePageSize; key, value = cursor.Next() {
+			page = append(page, entry{id: binary.BigEndian.Uint64(key), value: bytes.Clone(value)})
+		}
+		cursor.Close()
+
+		for _, e := range page {
+			if err := fn(e.id, e.value); err != nil {
+				return err
+			}
+		}
+		if len(page) < iteratePageSize || page[len(page)-1].id == math.MaxUint64 {
+			return nil
+		}
+		seek = common.IDKey(page[len(page)-1].id + 1)
+	}
+}
+
+func (index *ivf) pqConfig(segments int) hnswent.PQConfig {
+	return compressionhelpers.KMeansPQConfig(segments, index.pqCentroids, index.trainingLimit)
+}
+
+// vectorByID reads the vector from the object, nil means that the object no
+// longer exists
+func (index *ivf) vectorByID(ctx context.Context, id uint64) ([]float32, error) {
+	vector, err := index.vectorForID(ctx, id)
+	if err != nil {
+		var e storobj.ErrNotFound
+		if errors.As(err, &e) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "read vector %d", id)
+	}
+	if len(vector) == 0 {
+		return nil, nil
+	}
+	return common.Normalized(index.distancerProvider, vector), nil
+}
+
+func (index *ivf) putRecord(id uint64, generation, list uint32, code []byte) error {
+	err := index.store.Bucket(index.codesBucketName()).
+		Put(common.IDKey(id), marshalRecord(generation, list, code))
+	return errors.Wrapf(err, "store code of vector %d", id)
+}
+
+func (index *ivf) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	if len(ids) != len(vectors) {
+		return errors.Errorf("ids and vectors sizes does not match")
+	}
+	if len(ids) == 0 {
+		return errors.Errorf("insertBatch called with empty lists")
+	}
+	for i := range ids {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if err := index.Add(ctx, ids[i], vectors[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (index *ivf) Add(ctx context.Context, id uint64, vector []float32) error {
+	if len(vector) == 0 {
+		return errors.Errorf("insert called with nil-vector")
+	}
+
+	var err error
+	index.trackDimensionsOnce.Do(func() {
+		index.dims.Store(int32(len(vector)))
+		err = index.setDimensions(int32(len(vector)))
+	})
+	if err != nil {
+		return err
+	}
+	if err := index.ValidateBeforeInsert(vector); err != nil {
+		return err
+	}
+
+	vector = common.Normalized(index.distancerProvider, vector)
+
+	index.stateLock.RLock()
+	added, err := index.insert(id, vector)
+	index.stateLock.RUnlock()
+	if err != nil {
+		return errors.Wrapf(err, "insert vector %d", id)
+	}
+	if added {
+		index.count.Add(1)
+	}
+
+	index.trainIfNeeded()
+	return nil
+}
+
+// insert must be called while holding stateLock for reading
+func (index *ivf) insert(id uint64, vector []float32) (bool, error) {
+	existing, err := index.store.Bucket(index.codesBucketName()).Get(common.IDKey(id))
+	if err != nil {
+		return false, err
+	}
+	if existing != nil {
+		// doc ids are never reused, so this is a retry of an earlier insert
+		return false, nil
+	}
+
+	current, pending := index.current, index.pending
+	if current != nil {
+		list, code := current.encode(vector)
+		current.lists[list].add(id, code)
+		if pending == nil {
+			return true, index.putRecord(id, current.generation, list, code)
+		}
+	}
+	if pending != nil {
+		// the record is written for the pending generation only, restoreLists
+		// encodes the vector again if the training does not complete
+		return true, index.addPending(pending, id, vector)
+	}
+	// untrained, the record only marks the vector as indexed
+	return true, index.putRecord(id, 0, 0, nil)
+}
+
+// addPending adds the vector to the pending generation unless that already
+// happened or the vector was deleted since the training started
+func (index *ivf) addPending(q *quantizer, id uint64, vector []float32) error {
+	index.pendingLock.Lock()
+	defer index.pendingLock.Unlock()
+
+	if _, ok := index.pendingMembers[id]; ok {
+		return nil
+	}
+	if _, ok := index.pendingDeleted[id]; ok {
+		return nil
+	}
+	index.pendingMembers[id] = struct{}{}
+
+	list, code := q.encode(vector)
+	q.lists[list].add(id, code)
+	return index.putRecord(id, q.generation, list, code)
+}
+
+func (index *ivf) Delete(ids ...uint64) error {
+	for _, id := range ids {
+		if err := index.delete(id); err != nil {
+			return errors.Wrapf(err, "delete vector %d", id)
+		}
+	}
+	return nil
+}
+
+// delete removes the vector from the list named by its record, as the
+// object, and with it the vector, is usually deleted already
+func (index *ivf) delete(id uint64) error {
+	index.stateLock.RLock()
+	defer index.stateLock.RUnlock()
+
+	pending := index.pending
+	if pending != nil {
+		// addPending rewrites the record while holding pendingLock
+		index.pendingLock.Lock()
+		defer index.pendingLock.Unlock()
+	}
+
+	bucket := index.store.Bucket(index.codesBucketName())
+	value, err := bucket.Get(common.IDKey(id))
+	if err != nil || value == nil {
+		return err
+	}
+	generation, list, _, err := unmarshalRecord(value)
+	if err != nil {
+		return err
+	}
+
+	if q := index.current; q != nil {
+		if generation == q.generation && int(list) < len(q.lists) {
+			q.lists[list].remove(id)
+		} else if pending == nil || generation != pending.generation {
+			// written before the first training or by a discarded one
+			q.removeAll(map[uint64]struct{}{id: {}})
+		}
+	}
+	if pending != nil {
+		if _, ok := index.pendingMembers[id]; ok {
+			pending.lists[list].remove(id)
+			delete(index.pendingMembers, id)
+		}
+		index.pendingDeleted[id] = struct{}{}
+	}
+
+	if err := bucket.Delete(common.IDKey(id)); err != nil {
+		return err
+	}
+	index.count.Add(^uint64(0))
+	return nil
+}
+
+func (index *ivf) Compressed() bool {
+	index.stateLock.RLock()
+	defer index.stateLock.RUnlock()
+	return index.current != nil
+}
+
+func (index *ivf) Multivector() bool {
+	return false
+}
+
+func (index *ivf) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("AddMulti is not supported for ivf index")
+}
+
+func (index *ivf) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("AddMultiBatch is not supported for ivf index")
+}
+
+func (index *ivf) DeleteMulti(ids ...uint64) error {
+	return errors.Errorf("DeleteMulti is not supported for ivf index")
+}
+
+func (index *ivf) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVector is not supported for ivf index")
+}
+
+func (index *ivf) SearchByMultiVectorDistance(ctx context.Context, vector [][]float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVectorDistance is not supported for ivf index")
+}
+
+func (index *ivf) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	parsed, ok := updated.(ivfent.UserConfig)
+	if !ok {
+		callback()
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+
+	index.setUserConfig(parsed)
+
+	callback()
+	return nil
+}
+
+func (index *ivf) Drop(ctx context.Context) error {
+	if err := index.Shutdown(ctx); err != nil {
+		return err
+	}
+	if err := index.metadata.Remove(); err != nil {
+		return err
+	}
+	// Shard::drop will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) Flush() error {
+	// nothing to do here
+	// Shard will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) Shutdown(ctx context.Context) error {
+	index.shutdownCtxCancel()
+	// a running training checks the shutdown context and returns early, but
+	// it must not write to the buckets after the shard closed them
+	index.trainingWg.Wait()
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (index *ivf) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (index *ivf) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	return index.metadata.ListFiles(basePath)
+}
+
+func (index *ivf) GetKeys(id uint64) (uint64, uint64, error) {
+	return 0, 0, errors.Errorf("GetKeys is not supported for ivf index")
+}
+
+func (index *ivf) ValidateBeforeInsert(vector []float32) error {
+	dims := int(index.dims.Load())
+	if dims == 0 {
+		return nil
+	}
+	if dims != len(vector) {
+		return errors.Errorf("new node has a vector with length %v. "+
+			"Existing nodes have vectors with length %v", len(vector), dims)
+	}
+	return nil
+}
+
+func (index *ivf) ValidateMultiBeforeInsert(vector [][]float32) error {
+	return errors.Errorf("multi vectors are not supported for ivf index")
+}
+
+func (index *ivf) PostStartup() {}
+
+func (index *ivf) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", index.id)
+	fmt.Printf("Vectors: %d\n", index.count.Load())
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (index *ivf) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return index.distancerProvider.SingleDist(x, y)
+}
+
+func (index *ivf) ContainsNode(id uint64) bool {
+	value, err := index.store.Bucket(index.codesBucketName()).Get(common.IDKey(id))
+	return err == nil && value != nil
+}
+
+func (index *ivf) Iterate(fn func(id uint64) bool) {
+	cursor := index.store.Bucket(index.codesBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		if !fn(binary.BigEndian.Uint64(key)) {
+			break
+		}
+	}
+}
+
+func (index *ivf) DistancerProvider() distancer.Provider {
+	return index.distancerProvider
+}
+
+func (index *ivf) AlreadyIndexed() uint64 {
+	return index.count.Load()
+}
+
+func (index *ivf) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	queryVector = common.Normalized(index.distancerProvider, queryVector)
+	distFunc := func(nodeID uint64) (float32, error) {
+		vector, err := index.vectorByID(context.Background(), nodeID)
+		if err != nil {
+			return 0, err
+		}
+		if vector == nil {
+			return 0, fmt.Errorf("vector %d does not exist", nodeID)
+		}
+		return index.distancerProvider.SingleDist(queryVector, vector)
+	}
+	return common.QueryVectorDistancer{DistanceFunc: distFunc}
+}
+
+func (index *ivf) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
+	return common.QueryVectorDistancer{}
+}
+
+func (index *ivf) Stats() (common.IndexStats, error) {
+	index.stateLock.RLock()
+	defer index.stateLock.RUnlock()
+
+	stats := &IVFStats{
+		Dimensions: index.dims.Load(),
+		Vectors:    index.count.Load(),
+		Training:   index.pending != nil,
+	}
+	if q := index.current; q != nil {
+		stats.Trained = true
+		stats.Generation = q.generation
+		stats.Lists = len(q.lists)
+		stats.MinListSize = math.MaxInt
+		for _, list := range q.lists {
+			size := list.len()
+			stats.MinListSize = min(stats.MinListSize, size)
+			stats.MaxListSize = max(stats.MaxListSize, size)
+		}
+	}
+	return stats, nil
+}
+
+type IVFStats struct {
+	Dimensions  int32  `json:"dimensions"`
+	Vectors     uint64 `json:"vectors"`
+	Trained     bool   `json:"trained"`
+	Training    bool   `json:"training"`
+	Generation  uint32 `json:"generation"`
+	Lists       int    `json:"lists"`
+	MinListSize int    `json:"minListSize"`
+	MaxListSize int    `json:"maxListSize"`
+}
+
+func (s *IVFStats) IndexType() common.IndexType {
+	return common.IndexTypeIVF
+}
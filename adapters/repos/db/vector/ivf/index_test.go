@@ -0,0 +1,291 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+//go:build !race
+
+package ivf
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/testinghelpers"
+	"github.com/weaviate/weaviate/entities/storobj"
+	ivfent "github.com/weaviate/weaviate/entities/vectorindex/ivf"
+)
+
+// objects stands in for the objects bucket of the shard, which the index
+// reads the vectors from
+type objects struct {
+	sync.RWMutex
+	vectors map[uint64][]float32
+}
+
+func newObjects() *objects {
+	return &objects{vectors: map[uint64][]float32{}}
+}
+
+func (o *objects) put(id uint64, vector []float32) {
+	o.Lock()
+	defer o.Unlock()
+	o.vectors[id] = vector
+}
+
+func (o *objects) delete(id uint64) {
+	o.Lock()
+	defer o.Unlock()
+	delete(o.vectors, id)
+}
+
+func (o *objects) vectorForID(ctx context.Context, id uint64) ([]float32, error) {
+	o.RLock()
+	defer o.RUnlock()
+	vector, ok := o.vectors[id]
+	if !ok {
+		return nil, storobj.NewErrNotFoundf(id, "no object for doc id")
+	}
+	return vector, nil
+}
+
+func newTestIndex(t *testing.T, dir string, uc ivfent.UserConfig, objects *objects,
+) (*ivf, *lsmkv.Store) {
+	logger, _ := test.NewNullLogger()
+	store := testinghelpers.NewStore(t, dir)
+	index, err := New(Config{
+		ID:               "ivf-test",
+		RootPath:         dir,
+		Logger:           logger,
+		DistanceProvider: distancer.NewL2SquaredProvider(),
+		VectorForIDThunk: objects.vectorForID,
+	}, uc, store)
+	require.Nil(t, err)
+	return index, store
+}
+
+func listSizes(index *ivf) int {
+	index.stateLock.RLock()
+	defer index.stateLock.RUnlock()
+	size := 0
+	for _, list := range index.current.lists {
+		size += list.len()
+	}
+	return size
+}
+
+func TestIVF(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	vectors, queries := testinghelpers.RandomVecs(2000, 20, 32)
+	k := 10
+
+	uc := ivfent.NewDefaultUserConfig()
+	uc.Distance = "l2-squared"
+	uc.NList = 16
+	uc.NProbe = 8
+	uc.TrainingLimit = 1000
+	uc.RetrainFactor = 1.5
+	uc.FlatSearchCutoff = 100
+	uc.PQ.Segments = 8
+
+	objects := newObjects()
+	index, store := newTestIndex(t, dir, uc, objects)
+	imported := objects.vectors
+	add := func(from, to int) {
+		for i := from; i < to; i++ {
+			// like the shard, the object is stored before it is indexed
+			objects.put(uint64(i), vectors[i])
+			require.Nil(t, index.Add(ctx, uint64(i), vectors[i]))
+		}
+	}
+
+	t.Run("exact search until trained", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			objects.put(uint64(i), vectors[i])
+		}
+		require.Nil(t, index.AddBatch(ctx, []uint64{0, 1, 2}, vectors[:3]))
+		add(3, 900)
+		assert.False(t, index.Compressed())
+		assert.Equal(t, uint64(900), index.AlreadyIndexed())
+		assert.Equal(t, float32(1), testinghelpers.Recall(t, index, imported, queries, k, nil))
+	})
+
+	t.Run("trained once the training limit is reached", func(t *testing.T) {
+		add(900, 1200)
+		index.trainingWg.Wait()
+		require.True(t, index.Compressed())
+		assert.Equal(t, uint32(1), index.current.generation)
+		assert.Equal(t, len(imported), listSizes(index))
+		assert.Greater(t, testinghelpers.Recall(t, index, imported, queries, k, nil), float32(0.7))
+	})
+
+	t.Run("retrained after growing by the retrain factor", func(t *testing.T) {
+		add(1200, len(vectors))
+		index.trainingWg.Wait()
+		assert.Equal(t, uint32(2), index.current.generation)
+		assert.Equal(t, len(imported), listSizes(index))
+		assert.Greater(t, testinghelpers.Recall(t, index, imported, queries, k, nil), float32(0.7))
+
+		stats, err := index.Stats()
+		require.Nil(t, err)
+		assert.Equal(t, 16, stats.(*IVFStats).Lists)
+	})
+
+	t.Run("filtered search", func(t *testing.T) {
+		testinghelpers.AssertFilteredSearch(t, index, imported, queries, k, 0.7)
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		testinghelpers.AssertSearchByDistance(t, index, queries[0], k)
+	})
+
+	t.Run("deleted vectors are removed from their lists", func(t *testing.T) {
+		ids, _, err := index.SearchByVector(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		// like the shard, the object is deleted before the vector, so the
+		// index has to find the list without reading the vector
+		for _, id := range ids {
+			objects.delete(id)
+		}
+		require.Nil(t, index.Delete(ids...))
+		for _, id := range ids {
+			assert.False(t, index.ContainsNode(id))
+		}
+
+		after, _, err := index.SearchByVector(ctx, queries[0], k, nil)
+		require.Nil(t, err)
+		assert.Len(t, after, k)
+		for _, id := range ids {
+			assert.NotContains(t, after, id)
+		}
+		assert.Equal(t, uint64(len(imported)), index.AlreadyIndexed())
+		assert.Equal(t, len(imported), listSizes(index))
+	})
+
+	t.Run("vectors deleted during a discarded training are removed", func(t *testing.T) {
+		current := index.current
+		pending := newQuantizer(current.generation+1, current.coarse, current.pq)
+		index.stateLock.Lock()
+		index.pending = pending
+		index.pendingMembers = map[uint64]struct{}{}
+		index.pendingDeleted = map[uint64]struct{}{}
+		index.stateLock.Unlock()
+		require.Nil(t, index.encodeAll(pending))
+
+		// the records now name the lists of the pending generation
+		ids, _, err := index.SearchByVector(ctx, queries[2], k, nil)
+		require.Nil(t, err)
+		for _, id := range ids {
+			objects.delete(id)
+		}
+		require.Nil(t, index.Delete(ids...))
+		index.discardPending()
+
+		assert.Equal(t, len(imported), listSizes(index))
+		after, _, err := index.SearchByVector(ctx, queries[2], k, nil)
+		require.Nil(t, err)
+		for _, id := range ids {
+			assert.NotContains(t, after, id)
+		}
+	})
+
+	t.Run("lists are restored after a restart", func(t *testing.T) {
+		ids, _, err := index.SearchByVector(ctx, queries[1], k, nil)
+		require.Nil(t, err)
+
+		// pretend a training was interrupted after re-encoding this vector
+		require.Nil(t, index.putRecord(ids[0], 99, 0, make([]byte, 8)))
+
+		require.Nil(t, index.Shutdown(ctx))
+		require.Nil(t, store.Shutdown(ctx))
+
+		index, store = newTestIndex(t, dir, uc, objects)
+		defer store.Shutdown(ctx)
+		defer index.Shutdown(ctx)
+
+		require.True(t, index.Compressed())
+		assert.Equal(t, uint32(2), index.current.generation)
+		assert.Equal(t, uint64(len(imported)), index.AlreadyIndexed())
+		assert.Equal(t, len(imported), listSizes(index))
+
+		record, err := index.store.Bucket(index.codesBucketName()).Get(common.IDKey(ids[0]))
+		require.Nil(t, err)
+		generation, _, _, err := unmarshalRecord(record)
+		require.Nil(t, err)
+		assert.Equal(t, uint32(2), generation)
+
+		restored, _, err := index.SearchByVector(ctx, queries[1], k, nil)
+		require.Nil(t, err)
+		assert.Equal(t, ids, restored)
+	})
+
+	t.Run("wrong dimensions are rejected", func(t *testing.T) {
+		testinghelpers.AssertWrongDimensionsRejected(t, index, uint64(len(vectors)))
+	})
+
+	t.Run("backup lists the metadata file", func(t *testing.T) {
+		files, err := index.ListFiles(ctx, dir)
+		require.Nil(t, err)
+		assert.Equal(t, []string{"ivf.db"}, files)
+	})
+}
+
+func TestIVF_DotProduct(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	vectors, queries := testinghelpers.RandomVecs(1500, 10, 16)
+	k := 10
+
+	store := testinghelpers.NewDummyStore(t)
+	objects := newObjects()
+	uc := ivfent.NewDefaultUserConfig()
+	uc.Distance = "dot"
+	uc.NList = 8
+	uc.NProbe = 4
+	uc.TrainingLimit = 1000
+	uc.RetrainFactor = 0
+	uc.PQ.Segments = 8
+	index, err := New(Config{
+		ID:               "ivf-dot",
+		RootPath:         t.TempDir(),
+		Logger:           logger,
+		DistanceProvider: distancer.NewDotProductProvider(),
+		VectorForIDThunk: objects.vectorForID,
+	}, uc, store)
+	require.Nil(t, err)
+	defer index.Shutdown(ctx)
+
+	for i, vec := range vectors {
+		objects.put(uint64(i), vec)
+		require.Nil(t, index.Add(ctx, uint64(i), vec))
+	}
+	index.trainingWg.Wait()
+	require.True(t, index.Compressed())
+	assert.Greater(t, testinghelpers.Recall(t, index, objects.vectors, queries, k, nil), float32(0.7))
+}
+
+func TestInvertedList_Remove(t *testing.T) {
+	l := &invertedList{}
+	l.add(1, []byte{1, 1})
+	l.add(2, []byte{2, 2})
+	l.add(3, []byte{3, 3})
+
+	assert.True(t, l.remove(1))
+	assert.False(t, l.remove(1))
+	assert.Equal(t, []uint64{3, 2}, l.ids)
+	assert.Equal(t, []byte{3, 3, 2, 2}, l.codes)
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	metadataPrefix          = "ivf"
+	metadataKeyDimensions   = "dimensions"
+	metadataKeyCodebook     = "codebook"
+	metadataKeyTrainedCount = "trained_count"
+)
+
+// restoreMetadata loads the dimensions and, if the index was already
+// trained, the quantizer of the latest generation
+func (index *ivf) restoreMetadata() error {
+	values, err := index.metadata.Get(metadataKeyDimensions, metadataKeyCodebook,
+		metadataKeyTrainedCount)
+	if err != nil {
+		return errors.Wrap(err, "restore metadata")
+	}
+	dims := values[metadataKeyDimensions]
+	codebook := values[metadataKeyCodebook]
+	trainedCount := values[metadataKeyTrainedCount]
+
+	if len(dims) == 4 {
+		d := int32(binary.LittleEndian.Uint32(dims))
+		index.trackDimensionsOnce.Do(func() {
+			index.dims.Store(d)
+		})
+	}
+	if len(trainedCount) == 8 {
+		index.trainedCount.Store(binary.LittleEndian.Uint64(trainedCount))
+	}
+	if len(codebook) > 0 {
+		q, err := index.unmarshalCodebook(codebook)
+		if err != nil {
+			return errors.Wrap(err, "restore codebook")
+		}
+		index.current = q
+	}
+	return nil
+}
+
+func (index *ivf) setDimensions(dims int32) error {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, uint32(dims))
+	return errors.Wrap(index.metadata.Put(map[string][]byte{metadataKeyDimensions: buf}),
+		"set dimensions")
+}
+
+// persistQuantizer writes the codebook of a new generation. From then on the
+// records of older generations are considered stale.
+func (index *ivf) persistQuantizer(q *quantizer, trainedCount uint64) error {
+	count := make([]byte, 8)
+	binary.LittleEndian.PutUint64(count, trainedCount)
+	return errors.Wrap(index.metadata.Put(map[string][]byte{
+		metadataKeyCodebook:     marshalCodebook(q),
+		metadataKeyTrainedCount: count,
+	}), "persist codebook")
+}
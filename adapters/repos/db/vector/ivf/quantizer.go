@@ -0,0 +1,227 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+)
+
+// quantizer is one trained generation of the index: the coarse centroids
+// which partition the vectors into lists and the product quantizer which
+// encodes the residual of each vector to the centroid of its list.
+type quantizer struct {
+	generation uint32
+	coarse     *compressionhelpers.KMeans
+	pq         *compressionhelpers.ProductQuantizer
+	lists      []*invertedList
+}
+
+func newQuantizer(generation uint32, coarse *compressionhelpers.KMeans,
+	pq *compressionhelpers.ProductQuantizer,
+) *quantizer {
+	lists := make([]*invertedList, len(coarse.Centers()))
+	for i := range lists {
+		lists[i] = &invertedList{}
+	}
+	return &quantizer{
+		generation: generation,
+		coarse:     coarse,
+		pq:         pq,
+		lists:      lists,
+	}
+}
+
+func (q *quantizer) nearestList(vector []float32) uint32 {
+	return uint32(q.coarse.Nearest(vector))
+}
+
+func (q *quantizer) encode(vector []float32) (uint32, []byte) {
+	list := q.nearestList(vector)
+	centroid := q.coarse.Centers()[list]
+	residual := make([]float32, len(vector))
+	for i := range vector {
+		residual[i] = vector[i] - centroid[i]
+	}
+	return list, q.pq.Encode(residual)
+}
+
+// listDistancer returns a distancer for the codes of the given list together
+// with an offset which has to be added to every distance. For l2-squared the
+// query is shifted by the centroid, as |q-(c+r)|² = |(q-c)-r|². For the dot
+// product based metrics the distance is linear, q·(c+r) = q·c + q·r, so the
+// centroid contributes a constant per list.
+func (q *quantizer) listDistancer(query []float32, list uint32,
+	provider distancer.Provider,
+) (*compressionhelpers.PQDistancer, float32) {
+	centroid := q.coarse.Centers()[list]
+	if provider.Type() == "l2-squared" {
+		shifted := make([]float32, len(query))
+		for i := range query {
+			shifted[i] = query[i] - centroid[i]
+		}
+		return q.pq.NewDistancer(shifted), 0
+	}
+
+	var dot float32
+	for i := range query {
+		dot += query[i] * centroid[i]
+	}
+	return q.pq.NewDistancer(query), -dot
+}
+
+// invertedList holds the ids and the concatenated codes of all vectors
+// assigned to one coarse centroid
+type invertedList struct {
+	sync.RWMutex
+	ids   []uint64
+	codes []byte
+}
+
+func (l *invertedList) add(id uint64, code []byte) {
+	l.Lock()
+	defer l.Unlock()
+	l.ids = append(l.ids, id)
+	l.codes = append(l.codes, code...)
+}
+
+// remove swaps the entry with the last one, the order within a list is
+// irrelevant
+func (l *invertedList) remove(id uint64) bool {
+	l.Lock()
+	defer l.Unlock()
+
+	if len(l.ids) == 0 {
+		return false
+	}
+	m := len(l.codes) / len(l.ids)
+	for i, existing := range l.ids {
+		if existing != id {
+			continue
+		}
+		last := len(l.ids) - 1
+		l.ids[i] = l.ids[last]
+		copy(l.codes[i*m:(i+1)*m], l.codes[last*m:])
+		l.ids = l.ids[:last]
+		l.codes = l.codes[:last*m]
+		return true
+	}
+	return false
+}
+
+// removeAll removes the ids from whichever lists they are in
+func (q *quantizer) removeAll(ids map[uint64]struct{}) {
+	for _, l := range q.lists {
+		l.removeAll(ids)
+	}
+}
+
+func (l *invertedList) removeAll(ids map[uint64]struct{}) {
+	l.Lock()
+	defer l.Unlock()
+
+	if len(l.ids) == 0 {
+		return
+	}
+	m := len(l.codes) / len(l.ids)
+	for i := 0; i < len(l.ids); {
+		if _, ok := ids[l.ids[i]]; !ok {
+			i++
+			continue
+		}
+		last := len(l.ids) - 1
+		l.ids[i] = l.ids[last]
+		copy(l.codes[i*m:(i+1)*m], l.codes[last*m:])
+		l.ids = l.ids[:last]
+		l.codes = l.codes[:last*m]
+	}
+}
+
+func (l *invertedList) len() int {
+	l.RLock()
+	defer l.RUnlock()
+	return len(l.ids)
+}
+
+// record layout of the codes bucket:
+//
+//	| generation (uint32) | list (uint32) | code |
+func marshalRecord(generation, list uint32, code []byte) []byte {
+	buf := make([]byte, 8+len(code))
+	binary.LittleEndian.PutUint32(buf[0:4], generation)
+	binary.LittleEndian.PutUint32(buf[4:8], list)
+	copy(buf[8:], code)
+	return buf
+}
+
+func unmarshalRecord(buf []byte) (generation, list uint32, code []byte, err error) {
+	if len(buf) < 8 {
+		return 0, 0, nil, fmt.Errorf("record of %d bytes is too short", len(buf))
+	}
+	code = make([]byte, len(buf)-8)
+	copy(code, buf[8:])
+	return binary.LittleEndian.Uint32(buf[0:4]), binary.LittleEndian.Uint32(buf[4:8]), code, nil
+}
+
+// codebook layout:
+//
+//	| generation (uint32) | lists (uint32) | pq codebook | coarse centroids |
+//
+// see compressionhelpers.MarshalPQCodebook for the layout of the pq codebook
+func marshalCodebook(q *quantizer) []byte {
+	centers := q.coarse.Centers()
+	buf := make([]byte, 8)
+	binary.LittleEndian.PutUint32(buf[0:4], q.generation)
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(centers)))
+	buf = compressionhelpers.MarshalPQCodebook(buf, q.pq)
+	for _, center := range centers {
+		for _, v := range center {
+			buf = binary.LittleEndian.AppendUint32(buf, math.Float32bits(v))
+		}
+	}
+	return buf
+}
+
+func (index *ivf) unmarshalCodebook(buf []byte) (*quantizer, error) {
+	if len(buf) < 10 {
+		return nil, fmt.Errorf("codebook of %d bytes is too short", len(buf))
+	}
+	generation := binary.LittleEndian.Uint32(buf[0:4])
+	nlist := int(binary.LittleEndian.Uint32(buf[4:8]))
+	// the pq codebook starts with the dimensions
+	dims := int(binary.LittleEndian.Uint16(buf[8:10]))
+	pq, rest, err := compressionhelpers.UnmarshalPQCodebook(buf[8:], index.pqConfig(0),
+		index.distancerProvider, index.logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "restore product quantizer")
+	}
+	if expected := 4 * nlist * dims; len(rest) != expected {
+		return nil, fmt.Errorf("invalid codebook: expected %d bytes of coarse centroids, got %d",
+			expected, len(rest))
+	}
+
+	centers := make([][]float32, nlist)
+	for i := range centers {
+		centers[i] = make([]float32, dims)
+		for j := range centers[i] {
+			centers[i][j] = math.Float32frombits(binary.LittleEndian.Uint32(rest[4*(i*dims+j):]))
+		}
+	}
+	coarse := compressionhelpers.NewKMeansWithCenters(nlist, dims, 0, centers)
+	return newQuantizer(generation, coarse, pq), nil
+}
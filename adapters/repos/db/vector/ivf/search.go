@@ -0,0 +1,209 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/priorityqueue"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+)
+
+// maxFilteredProbeFactor caps how many more lists are probed for filtered
+// searches that are too broad for the exact search path
+const maxFilteredProbeFactor = 8
+
+func (index *ivf) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if k <= 0 {
+		return nil, nil, nil
+	}
+	vector = common.Normalized(index.distancerProvider, vector)
+
+	if allow != nil && int64(allow.Len()) < index.flatSearchCutoff.Load() {
+		return index.exactSearch(ctx, vector, k, allow)
+	}
+
+	// a search that started on the previous generation can still finish on
+	// it after a training completed, vectors deleted in the meantime are
+	// dropped when rescoring
+	index.stateLock.RLock()
+	q := index.current
+	index.stateLock.RUnlock()
+	if q == nil {
+		return index.exactSearch(ctx, vector, k, allow)
+	}
+
+	nprobe := int(index.nprobe.Load())
+	if allow != nil && allow.Len() > 0 {
+		// only a fraction of the vectors in each list qualify, probe more
+		// lists accordingly so that the result still contains k matches
+		factor := int(index.count.Load()) / allow.Len()
+		if factor > maxFilteredProbeFactor {
+			factor = maxFilteredProbeFactor
+		}
+		if factor > 1 {
+			nprobe *= factor
+		}
+	}
+	if nprobe > len(q.lists) {
+		nprobe = len(q.lists)
+	}
+
+	shortlist := int(index.rescoreLimit.Load())
+	if shortlist < k {
+		shortlist = k
+	}
+	candidates := priorityqueue.NewMax[any](shortlist)
+	for _, list := range q.coarse.NNearest(vector, nprobe) {
+		if err := ctx.Err(); err != nil {
+			return nil, nil, err
+		}
+		if err := index.scoreList(q, uint32(list), vector, shortlist, allow, candidates); err != nil {
+			return nil, nil, errors.Wrapf(err, "score list %d", list)
+		}
+	}
+
+	results := priorityqueue.NewMax[any](k)
+	for candidates.Len() > 0 {
+		id := candidates.Pop().ID
+		candidate, err := index.vectorByID(ctx, id)
+		if err != nil {
+			return nil, nil, err
+		}
+		if candidate == nil {
+			// deleted concurrently
+			continue
+		}
+		dist, err := index.distancerProvider.SingleDist(vector, candidate)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "rescore")
+		}
+		insertToHeap(results, k, id, dist)
+	}
+	ids, dists := extractHeap(results)
+	return ids, dists, nil
+}
+
+func (index *ivf) scoreList(q *quantizer, list uint32, vector []float32, shortlist int,
+	allow helpers.AllowList, heap *priorityqueue.Queue[any],
+) error {
+	distancer, offset := q.listDistancer(vector, list, index.distancerProvider)
+	defer q.pq.ReturnDistancer(distancer)
+
+	l := q.lists[list]
+	l.RLock()
+	defer l.RUnlock()
+
+	if len(l.ids) == 0 {
+		return nil
+	}
+	m := len(l.codes) / len(l.ids)
+	for i, id := range l.ids {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		dist, err := distancer.Distance(l.codes[i*m : (i+1)*m])
+		if err != nil {
+			return err
+		}
+		insertToHeap(heap, shortlist, id, dist+offset)
+	}
+	return nil
+}
+
+// exactSearch scores the full vectors. It is used before the index is
+// trained and for restrictive filters, for which reading the few allowed
+// vectors is cheaper than probing lists that hardly contain any of them.
+func (index *ivf) exactSearch(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	heap := priorityqueue.NewMax[any](k)
+	score := func(id uint64, candidate []float32) error {
+		dist, err := index.distancerProvider.SingleDist(vector, candidate)
+		if err != nil {
+			return err
+		}
+		insertToHeap(heap, k, id, dist)
+		return nil
+	}
+
+	if allow != nil {
+		it := allow.Iterator()
+		for id, ok := it.Next(); ok; id, ok = it.Next() {
+			if err := ctx.Err(); err != nil {
+				return nil, nil, err
+			}
+			candidate, err := index.vectorByID(ctx, id)
+			if err != nil {
+				return nil, nil, err
+			}
+			if candidate == nil {
+				continue
+			}
+			if err := score(id, candidate); err != nil {
+				return nil, nil, err
+			}
+		}
+	} else {
+		err := index.iterate(index.codesBucketName(), func(id uint64, _ []byte) error {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			candidate, err := index.vectorByID(ctx, id)
+			if err != nil || candidate == nil {
+				return err
+			}
+			return score(id, candidate)
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	ids, dists := extractHeap(heap)
+	return ids, dists, nil
+}
+
+func insertToHeap(heap *priorityqueue.Queue[any], limit int, id uint64, dist float32) {
+	if heap.Len() < limit {
+		heap.Insert(id, dist)
+	} else if heap.Top().Dist > dist {
+		heap.Pop()
+		heap.Insert(id, dist)
+	}
+}
+
+func extractHeap(heap *priorityqueue.Queue[any]) ([]uint64, []float32) {
+	len := heap.Len()
+
+	ids := make([]uint64, len)
+	dists := make([]float32, len)
+	for i := len - 1; i >= 0; i-- {
+		item := heap.Pop()
+		ids[i] = item.ID
+		dists[i] = item.Dist
+	}
+	return ids, dists
+}
+
+func (index *ivf) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return common.SearchByVectorDistance(ctx, targetDistance, maxLimit,
+		func(ctx context.Context, k int) ([]uint64, []float32, error) {
+			return index.SearchByVector(ctx, vector, k, allow)
+		}, index.logger)
+}
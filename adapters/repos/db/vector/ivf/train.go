@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/compressionhelpers"
+	enterrors "github.com/weaviate/weaviate/entities/errors"
+)
+
+func (index *ivf) needsTraining() bool {
+	count := index.count.Load()
+
+	index.stateLock.RLock()
+	trained := index.current != nil
+	index.stateLock.RUnlock()
+
+	if !trained {
+		return count >= uint64(index.trainingLimit)
+	}
+
+	factor := math.Float64frombits(index.retrainFactor.Load())
+	if factor == 0 {
+		return false
+	}
+	return float64(count) >= float64(index.trainedCount.Load())*factor
+}
+
+// trainIfNeeded starts a training in the background if the index reached
+// the training limit for the first time or grew by retrainFactor since the
+// last training
+func (index *ivf) trainIfNeeded() {
+	if index.training.Load() || !index.needsTraining() {
+		return
+	}
+	if index.shutdownCtx.Err() != nil || !index.training.CompareAndSwap(false, true) {
+		return
+	}
+
+	index.trainingWg.Add(1)
+	enterrors.GoWrapper(func() {
+		defer index.trainingWg.Done()
+		defer index.training.Store(false)
+
+		if err := index.train(); err != nil {
+			index.logger.WithField("action", "ivf_train").
+				WithField("class", index.className).
+				WithField("shard", index.shardName).
+				WithError(err).Error("train quantizer")
+		}
+	}, index.logger)
+}
+
+func (index *ivf) train() error {
+	before := time.Now()
+	count := index.count.Load()
+	dims := int(index.dims.Load())
+
+	sample, err := index.sampleVectors(index.trainingLimit)
+	if err != nil {
+		return errors.Wrap(err, "sample training data")
+	}
+	if len(sample) < index.nlist {
+		return errors.Errorf("not enough vectors to train %d lists: %d", index.nlist, len(sample))
+	}
+
+	coarse := compressionhelpers.NewKMeans(index.nlist, dims, 0)
+	if err := coarse.Fit(sample); err != nil {
+		return errors.Wrap(err, "fit coarse centroids")
+	}
+
+	centers := coarse.Centers()
+	residuals := make([][]float32, len(sample))
+	for i, vector := range sample {
+		centroid := centers[coarse.Nearest(vector)]
+		residuals[i] = make([]float32, dims)
+		for j := range vector {
+			residuals[i][j] = vector[j] - centroid[j]
+		}
+	}
+
+	segments := index.pqSegments
+	if segments <= 0 {
+		segments = common.CalculateOptimalSegments(dims)
+	}
+	pq, err := compressionhelpers.NewProductQuantizer(index.pqConfig(segments),
+		index.distancerProvider, dims, index.logger)
+	if err != nil {
+		return errors.Wrap(err, "create product quantizer")
+	}
+	if err := pq.Fit(residuals); err != nil {
+		return errors.Wrap(err, "fit product quantizer")
+	}
+
+	index.stateLock.Lock()
+	generation := uint32(1)
+	if index.current != nil {
+		generation = index.current.generation + 1
+	}
+	q := newQuantizer(generation, coarse, pq)
+	index.pending = q
+	index.pendingMembers = map[uint64]struct{}{}
+	index.pendingDeleted = map[uint64]struct{}{}
+	index.stateLock.Unlock()
+
+	if err := index.encodeAll(q); err != nil {
+		index.discardPending()
+		return errors.Wrap(err, "encode vectors")
+	}
+	if err := index.persistQuantizer(q, count); err != nil {
+		index.discardPending()
+		return err
+	}
+
+	index.stateLock.Lock()
+	index.current = q
+	index.pending = nil
+	index.pendingMembers = nil
+	index.pendingDeleted = nil
+	index.stateLock.Unlock()
+	index.trainedCount.Store(count)
+
+	index.logger.WithField("action", "ivf_train").
+		WithField("class", index.className).
+		WithField("shard", index.shardName).
+		WithField("generation", generation).
+		WithField("took", time.Since(before)).
+		Infof("trained %d lists with %d segments on %d vectors", index.nlist, segments, len(sample))
+	return nil
+}
+
+// encodeAll adds every indexed vector to the pending generation. Vectors
+// inserted concurrently are added by the insert itself.
+func (index *ivf) encodeAll(q *quantizer) error {
+	return index.iterate(index.codesBucketName(), func(id uint64, _ []byte) error {
+		if err := index.shutdownCtx.Err(); err != nil {
+			return err
+		}
+		vector, err := index.vectorByID(index.shutdownCtx, id)
+		if err != nil || vector == nil {
+			// a vector whose object is gone is about to be deleted
+			return err
+		}
+		return index.addPending(q, id, vector)
+	})
+}
+
+// discardPending drops an incomplete generation. Its records stay in the
+// codes bucket until they are overwritten, restoreLists takes care of them
+// after a restart. Vectors deleted during the training were only removed
+// from the current generation if their record still named their list.
+func (index *ivf) discardPending() {
+	index.stateLock.Lock()
+	defer index.stateLock.Unlock()
+	if q := index.current; q != nil && len(index.pendingDeleted) > 0 {
+		q.removeAll(index.pendingDeleted)
+	}
+	index.pending = nil
+	index.pendingMembers = nil
+	index.pendingDeleted = nil
+}
+
+// sampleVectors picks a uniform sample of at most limit vectors using
+// reservoir sampling, so that the training data does not depend on the
+// insertion order. Only the vectors which make it into the sample are read.
+func (index *ivf) sampleVectors(limit int) ([][]float32, error) {
+	sample := make([][]float32, 0, limit)
+	seen := 0
+	err := index.iterate(index.codesBucketName(), func(id uint64, _ []byte) error {
+		if err := index.shutdownCtx.Err(); err != nil {
+			return err
+		}
+		seen++
+		pos := len(sample)
+		if pos == limit {
+			if pos = rand.Intn(seen); pos >= limit {
+				return nil
+			}
+		}
+		vector, err := index.vectorByID(index.shutdownCtx, id)
+		if err != nil || vector == nil {
+			return err
+		}
+		if pos == len(sample) {
+			sample = append(sample, vector)
+		} else {
+			sample[pos] = vector
+		}
+		return nil
+	})
+	return sample, err
+}
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+// muveraSeed is fixed so that an encoder rebuilt after a restart produces the
+// exact same encodings as the one the index was built with. Nothing but the
+// config has to be persisted that way.
+const muveraSeed = 0x6d757665726121
+
+// MuveraEncoder computes fixed dimensional encodings (FDE) of multi vectors as
+// described in "MUVERA: Multi-Vector Retrieval via Fixed Dimensional
+// Encodings". The inner product between the encoding of a query and the
+// encoding of a document approximates their Chamfer (MaxSim) similarity:
+//
+//   - every repetition partitions the token space into 2^ksim buckets using
+//     ksim random hyperplanes (SimHash)
+//   - tokens are projected to dprojections dimensions with a random ±1 matrix
+//   - the query block of a bucket is the sum of its projected query tokens,
+//     the document block is the average of its projected document tokens
+//   - empty document buckets are filled with the token whose SimHash is
+//     closest in Hamming distance, so every query token finds a partner
+//
+// The encoder is initialized lazily with the dimensions of the first multi
+// vector it sees, as the token dimensions are not part of the config.
+type MuveraEncoder struct {
+	config ent.MuveraConfig
+
+	initOnce    sync.Once
+	initialized atomic.Bool
+	dimensions  int
+	hyperplanes [][][]float32 // repetitions x ksim x dimensions
+	projections [][][]float32 // repetitions x dprojections x dimensions
+}
+
+func NewMuveraEncoder(config ent.MuveraConfig) *MuveraEncoder {
+	return &MuveraEncoder{config: config}
+}
+
+// Dimensions of the encoded vectors
+func (e *MuveraEncoder) Dimensions() int {
+	return e.config.Dimensions()
+}
+
+// TokenDimensions returns the dimensions of the token vectors the encoder was
+// initialized with, or 0 if it has not encoded anything yet.
+func (e *MuveraEncoder) TokenDimensions() int {
+	if !e.initialized.Load() {
+		return 0
+	}
+	return e.dimensions
+}
+
+func (e *MuveraEncoder) init(dimensions int) {
+	e.initOnce.Do(func() {
+		rng := rand.New(rand.NewSource(muveraSeed))
+		scale := float32(1 / math.Sqrt(float64(e.config.DProjections)))
+
+		e.dimensions = dimensions
+		e.hyperplanes = make([][][]float32, e.config.Repetitions)
+		e.projections = make([][][]float32, e.config.Repetitions)
+		for r := range e.config.Repetitions {
+			e.hyperplanes[r] = make([][]float32, e.config.KSim)
+			for i := range e.hyperplanes[r] {
+				e.hyperplanes[r][i] = make([]float32, dimensions)
+				for j := range e.hyperplanes[r][i] {
+					e.hyperplanes[r][i][j] = float32(rng.NormFloat64())
+				}
+			}
+			e.projections[r] = make([][]float32, e.config.DProjections)
+			for i := range e.projections[r] {
+				e.projections[r][i] = make([]float32, dimensions)
+				for j := range e.projections[r][i] {
+					if rng.Intn(2) == 0 {
+						e.projections[r][i][j] = scale
+					} else {
+						e.projections[r][i][j] = -scale
+					}
+				}
+			}
+		}
+		e.initialized.Store(true)
+	})
+}
+
+// EncodeQuery returns the fixed dimensional encoding of a query multi vector
+func (e *MuveraEncoder) EncodeQuery(vectors [][]float32) ([]float32, error) {
+	return e.encode(vectors, false)
+}
+
+// EncodeDoc returns the fixed dimensional encoding of a document multi vector
+func (e *MuveraEncoder) EncodeDoc(vectors [][]float32) ([]float32, error) {
+	return e.encode(vectors, true)
+}
+
+func (e *MuveraEncoder) encode(vectors [][]float32, isDoc bool) ([]float32, error) {
+	if len(vectors) == 0 || len(vectors[0]) == 0 {
+		return nil, fmt.Errorf("muvera: cannot encode an empty multi vector")
+	}
+	e.init(len(vectors[0]))
+	for i := range vectors {
+		if len(vectors[i]) != e.dimensions {
+			return nil, fmt.Errorf("muvera: token vector %d has %d dimensions, expected %d",
+				i, len(vectors[i]), e.dimensions)
+		}
+	}
+
+	buckets := 1 << e.config.KSim
+	dproj := e.config.DProjections
+	blockSize := buckets * dproj
+	out := make([]float32, e.config.Repetitions*blockSize)
+
+	bucketOf := make([]int, len(vectors))
+	projected := make([][]float32, len(vectors))
+	for i := range projected {
+		projected[i] = make([]float32, dproj)
+	}
+	counts := make([]int, buckets)
+
+	for r := range e.config.Repetitions {
+		block := out[r*blockSize : (r+1)*blockSize]
+		clear(counts)
+
+		for i, vec := range vectors {
+			bucketOf[i] = e.simHash(r, vec)
+			counts[bucketOf[i]]++
+			for p := range dproj {
+				projected[i][p] = dot(e.projections[r][p], vec)
+			}
+			target := block[bucketOf[i]*dproj : (bucketOf[i]+1)*dproj]
+			for p := range dproj {
+				target[p] += projected[i][p]
+			}
+		}
+
+		if !isDoc {
+			continue
+		}
+
+		for b := range buckets {
+			target := block[b*dproj : (b+1)*dproj]
+			if counts[b] > 0 {
+				inv := 1 / float32(counts[b])
+				for p := range target {
+					target[p] *= inv
+				}
+				continue
+			}
+			nearest := 0
+			nearestDist := math.MaxInt
+			for i := range vectors {
+				if dist := bits.OnesCount(uint(bucketOf[i] ^ b)); dist < nearestDist {
+					nearest, nearestDist = i, dist
+				}
+			}
+			copy(target, projected[nearest])
+		}
+	}
+
+	return out, nil
+}
+
+func (e *MuveraEncoder) simHash(repetition int, vec []float32) int {
+	bucket := 0
+	for i, hyperplane := range e.hyperplanes[repetition] {
+		if dot(hyperplane, vec) > 0 {
+			bucket |= 1 << i
+		}
+	}
+	return bucket
+}
+
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
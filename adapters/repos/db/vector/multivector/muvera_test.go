@@ -0,0 +1,140 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package multivector
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	ent "github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+)
+
+func randomMultiVector(rng *rand.Rand, tokens, dims int) [][]float32 {
+	out := make([][]float32, tokens)
+	for i := range out {
+		out[i] = make([]float32, dims)
+		var norm float32
+		for j := range out[i] {
+			out[i][j] = float32(rng.NormFloat64())
+			norm += out[i][j] * out[i][j]
+		}
+		norm = float32(math.Sqrt(float64(norm)))
+		for j := range out[i] {
+			out[i][j] /= norm
+		}
+	}
+	return out
+}
+
+func maxSim(query, doc [][]float32) float32 {
+	var sum float32
+	for _, q := range query {
+		best := float32(-math.MaxFloat32)
+		for _, d := range doc {
+			best = max(best, dot(q, d))
+		}
+		sum += best
+	}
+	return sum
+}
+
+func TestMuveraEncoder(t *testing.T) {
+	config := ent.MuveraConfig{Enabled: true, KSim: 4, DProjections: 16, Repetitions: 10}
+	dims := 32
+	rng := rand.New(rand.NewSource(7))
+
+	t.Run("dimensions", func(t *testing.T) {
+		encoder := NewMuveraEncoder(config)
+		enc, err := encoder.EncodeDoc(randomMultiVector(rng, 5, dims))
+		require.Nil(t, err)
+		assert.Len(t, enc, 10*16*16)
+		assert.Equal(t, len(enc), encoder.Dimensions())
+	})
+
+	t.Run("encodings are deterministic across encoders", func(t *testing.T) {
+		doc := randomMultiVector(rng, 7, dims)
+		first, err := NewMuveraEncoder(config).EncodeDoc(doc)
+		require.Nil(t, err)
+		second, err := NewMuveraEncoder(config).EncodeDoc(doc)
+		require.Nil(t, err)
+		assert.Equal(t, first, second)
+	})
+
+	t.Run("invalid input", func(t *testing.T) {
+		encoder := NewMuveraEncoder(config)
+		_, err := encoder.EncodeQuery(nil)
+		assert.NotNil(t, err)
+
+		_, err = encoder.EncodeDoc(randomMultiVector(rng, 2, dims))
+		require.Nil(t, err)
+		_, err = encoder.EncodeQuery(randomMultiVector(rng, 2, dims+1))
+		assert.ErrorContains(t, err, "has 33 dimensions, expected 32")
+	})
+
+	t.Run("doc buckets are all filled", func(t *testing.T) {
+		encoder := NewMuveraEncoder(config)
+		enc, err := encoder.EncodeDoc(randomMultiVector(rng, 1, dims))
+		require.Nil(t, err)
+		blocks := len(enc) / config.DProjections
+		for b := range blocks {
+			block := enc[b*config.DProjections : (b+1)*config.DProjections]
+			assert.NotEqual(t, make([]float32, config.DProjections), block, "block %d is empty", b)
+		}
+	})
+
+	t.Run("encoded similarity approximates maxSim", func(t *testing.T) {
+		encoder := NewMuveraEncoder(config)
+		docs := make([][][]float32, 200)
+		encoded := make([][]float32, len(docs))
+		for i := range docs {
+			docs[i] = randomMultiVector(rng, 10, dims)
+			var err error
+			encoded[i], err = encoder.EncodeDoc(docs[i])
+			require.Nil(t, err)
+		}
+
+		queries := 20
+		hits := 0
+		for q := range queries {
+			// the query shares some of its tokens with the target document, so the
+			// target has by far the highest maxSim
+			target := docs[q]
+			query := append(randomMultiVector(rng, 3, dims), target[0], target[3], target[6])
+			encQuery, err := encoder.EncodeQuery(query)
+			require.Nil(t, err)
+
+			type scored struct {
+				id    int
+				score float32
+			}
+			ranked := make([]scored, len(docs))
+			for i := range docs {
+				ranked[i] = scored{i, dot(encQuery, encoded[i])}
+			}
+			sort.Slice(ranked, func(a, b int) bool { return ranked[a].score > ranked[b].score })
+
+			require.Greater(t, maxSim(query, target), maxSim(query, docs[len(docs)-1]))
+			for _, r := range ranked[:10] {
+				if r.id == q {
+					hits++
+					break
+				}
+			}
+		}
+		// the encodings only generate candidates, the target needs to be among them
+		assert.GreaterOrEqual(t, hits, queries*9/10)
+	})
+}
@@ -0,0 +1,481 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/models"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+// weightScale is the factor the weights of a document are multiplied with
+// before they are stored as the frequency of a posting. The inverted strategy
+// stores frequencies as integers once a memtable is flushed, so this is the
+// precision the weights are kept with.
+const weightScale = 1024
+
+// deletedKey is the row key the tombstones of deleted documents are written
+// to. The inverted strategy keeps the tombstones of a memtable or segment in
+// a single bitmap, no matter which key they were written to, and rows
+// consisting only of tombstones are never flushed. Dimension keys are always
+// 4 bytes long, so there is no collision.
+var deletedKey = []byte("deleted")
+
+// sparse indexes sparse vectors, such as learned sparse embeddings, as an
+// inverted index. Every non-zero dimension is a posting list of the documents
+// that have a weight for it, stored with the inverted strategy. A search runs
+// block-max WAND over the posting lists of the dimensions of the query vector
+// and scores documents by their dot product with the query.
+//
+// The vectors themselves are kept in a second bucket, which is what makes
+// deletes possible without knowing the vector and tells which documents are
+// part of the index.
+type sparse struct {
+	id                string
+	targetVector      string
+	shardName         string
+	className         string
+	logger            logrus.FieldLogger
+	distancerProvider distancer.Provider
+	store             *lsmkv.Store
+
+	count atomic.Uint64
+}
+
+func New(cfg Config, uc sparseent.UserConfig, store *lsmkv.Store) (*sparse, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid config")
+	}
+
+	logger := cfg.Logger
+	if logger == nil {
+		l := logrus.New()
+		l.Out = io.Discard
+		logger = l
+	}
+
+	index := &sparse{
+		id:                cfg.ID,
+		targetVector:      cfg.TargetVector,
+		shardName:         cfg.ShardName,
+		className:         cfg.ClassName,
+		logger:            logger,
+		distancerProvider: cfg.DistanceProvider,
+		store:             store,
+	}
+
+	if err := index.initBuckets(); err != nil {
+		return nil, fmt.Errorf("init sparse index buckets: %w", err)
+	}
+	index.count.Store(uint64(index.store.Bucket(index.docsBucketName()).Count()))
+
+	return index, nil
+}
+
+func (index *sparse) postingsBucketName() string {
+	return index.bucketName(helpers.VectorsSparseBucketLSM)
+}
+
+func (index *sparse) docsBucketName() string {
+	return index.bucketName(helpers.VectorsSparseDocsBucketLSM)
+}
+
+func (index *sparse) bucketName(prefix string) string {
+	if index.targetVector != "" {
+		return fmt.Sprintf("%s_%s", prefix, index.targetVector)
+	}
+	return prefix
+}
+
+func (index *sparse) initBuckets() error {
+	if err := index.store.CreateOrLoadBucket(context.Background(), index.postingsBucketName(),
+		lsmkv.WithStrategy(lsmkv.StrategyInverted),
+	); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", index.postingsBucketName())
+	}
+	if err := index.store.CreateOrLoadBucket(context.Background(), index.docsBucketName(),
+		lsmkv.WithUseBloomFilter(false),
+		lsmkv.WithCalcCountNetAdditions(true),
+	); err != nil {
+		return errors.Wrapf(err, "create or load bucket %q", index.docsBucketName())
+	}
+	return nil
+}
+
+func idKey(id uint64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, id)
+	return key
+}
+
+func dimensionKey(dim uint32) []byte {
+	key := make([]byte, 4)
+	binary.BigEndian.PutUint32(key, dim)
+	return key
+}
+
+// postingValue is the value of a posting in the layout of the inverted
+// strategy, the frequency followed by the property length. The property
+// length is always 1, which makes the block max impacts of a segment the
+// maximum frequencies.
+func postingValue(weight float32) []byte {
+	value := make([]byte, 8)
+	freq := max(1, float32(math.Round(float64(weight)*weightScale)))
+	binary.LittleEndian.PutUint32(value[0:4], math.Float32bits(freq))
+	binary.LittleEndian.PutUint32(value[4:8], math.Float32bits(1))
+	return value
+}
+
+func marshalVector(vector models.SparseVector) []byte {
+	out := make([]byte, 8*len(vector.Indices))
+	for i := range vector.Indices {
+		binary.LittleEndian.PutUint32(out[i*8:], vector.Indices[i])
+		binary.LittleEndian.PutUint32(out[i*8+4:], math.Float32bits(vector.Values[i]))
+	}
+	return out
+}
+
+func unmarshalVector(in []byte) models.SparseVector {
+	n := len(in) / 8
+	vector := models.SparseVector{
+		Indices: make([]uint32, n),
+		Values:  make([]float32, n),
+	}
+	for i := 0; i < n; i++ {
+		vector.Indices[i] = binary.LittleEndian.Uint32(in[i*8:])
+		vector.Values[i] = math.Float32frombits(binary.LittleEndian.Uint32(in[i*8+4:]))
+	}
+	return vector
+}
+
+// ValidateSparseVector checks that the vector can be stored or searched with.
+// Weights must not be negative, as block-max WAND requires the contribution
+// of every dimension to be bounded by its maximum.
+func ValidateSparseVector(vector models.SparseVector) error {
+	if len(vector.Indices) != len(vector.Values) {
+		return errors.Errorf("sparse vector has %d indices, but %d values",
+			len(vector.Indices), len(vector.Values))
+	}
+	if len(vector.Indices) == 0 {
+		return errors.Errorf("sparse vector is empty")
+	}
+	seen := make(map[uint32]struct{}, len(vector.Indices))
+	for i, dim := range vector.Indices {
+		if _, ok := seen[dim]; ok {
+			return errors.Errorf("sparse vector contains index %d more than once", dim)
+		}
+		seen[dim] = struct{}{}
+
+		value := vector.Values[i]
+		if value < 0 || math.IsNaN(float64(value)) || math.IsInf(float64(value), 0) {
+			return errors.Errorf("sparse vector has invalid value %v at index %d, "+
+				"values must be finite and not negative", value, dim)
+		}
+	}
+	return nil
+}
+
+func (index *sparse) ValidateSparseBeforeInsert(vector models.SparseVector) error {
+	return ValidateSparseVector(vector)
+}
+
+func (index *sparse) AddSparse(ctx context.Context, id uint64, vector models.SparseVector) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := ValidateSparseVector(vector); err != nil {
+		return err
+	}
+
+	docs := index.store.Bucket(index.docsBucketName())
+	existing, err := docs.Get(idKey(id))
+	if err != nil {
+		return errors.Wrapf(err, "read sparse vector %d", id)
+	}
+	if existing != nil {
+		// doc ids are never reused, so this is a retry of an earlier insert
+		return nil
+	}
+
+	// the postings are written before the vector, so that a stored vector
+	// always has its postings. Postings without a vector are removed when the
+	// object is deleted.
+	postings := index.store.Bucket(index.postingsBucketName())
+	for i, dim := range vector.Indices {
+		if vector.Values[i] == 0 {
+			continue
+		}
+		if err := postings.MapSet(dimensionKey(dim), lsmkv.MapPair{
+			Key:   idKey(id),
+			Value: postingValue(vector.Values[i]),
+		}); err != nil {
+			return errors.Wrapf(err, "store posting of sparse vector %d", id)
+		}
+	}
+
+	if err := docs.Put(idKey(id), marshalVector(vector)); err != nil {
+		return errors.Wrapf(err, "store sparse vector %d", id)
+	}
+	index.count.Add(1)
+	return nil
+}
+
+func (index *sparse) Delete(ids ...uint64) error {
+	for _, id := range ids {
+		if err := index.delete(id); err != nil {
+			return errors.Wrapf(err, "delete sparse vector %d", id)
+		}
+	}
+	return nil
+}
+
+func (index *sparse) delete(id uint64) error {
+	// the tombstone is written even if the vector is not stored, as its
+	// postings might have been written by an insert that failed halfway
+	err := index.store.Bucket(index.postingsBucketName()).MapDeleteKey(deletedKey, idKey(id))
+	if err != nil {
+		return err
+	}
+
+	docs := index.store.Bucket(index.docsBucketName())
+	existing, err := docs.Get(idKey(id))
+	if err != nil || existing == nil {
+		return err
+	}
+	if err := docs.Delete(idKey(id)); err != nil {
+		return err
+	}
+	index.count.Add(^uint64(0))
+	return nil
+}
+
+// VectorByID returns the sparse vector of the document or nil if it is not
+// part of the index
+func (index *sparse) VectorByID(id uint64) (*models.SparseVector, error) {
+	value, err := index.store.Bucket(index.docsBucketName()).Get(idKey(id))
+	if err != nil {
+		return nil, errors.Wrapf(err, "read sparse vector %d", id)
+	}
+	if value == nil {
+		return nil, nil
+	}
+	vector := unmarshalVector(value)
+	return &vector, nil
+}
+
+func (index *sparse) Add(ctx context.Context, id uint64, vector []float32) error {
+	return errors.Errorf("Add is not supported for sparse index, use AddSparse")
+}
+
+func (index *sparse) AddBatch(ctx context.Context, ids []uint64, vectors [][]float32) error {
+	return errors.Errorf("AddBatch is not supported for sparse index, use AddSparse")
+}
+
+func (index *sparse) Compressed() bool {
+	return false
+}
+
+func (index *sparse) Multivector() bool {
+	return false
+}
+
+func (index *sparse) AddMulti(ctx context.Context, docID uint64, vectors [][]float32) error {
+	return errors.Errorf("AddMulti is not supported for sparse index")
+}
+
+func (index *sparse) AddMultiBatch(ctx context.Context, docIDs []uint64, vectors [][][]float32) error {
+	return errors.Errorf("AddMultiBatch is not supported for sparse index")
+}
+
+func (index *sparse) DeleteMulti(ids ...uint64) error {
+	return errors.Errorf("DeleteMulti is not supported for sparse index")
+}
+
+func (index *sparse) SearchByVector(ctx context.Context, vector []float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByVector is not supported for sparse index, use nearSparseVector")
+}
+
+func (index *sparse) SearchByVectorDistance(ctx context.Context, vector []float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByVectorDistance is not supported for sparse index, use nearSparseVector")
+}
+
+func (index *sparse) SearchByMultiVector(ctx context.Context, vectors [][]float32, k int, allow helpers.AllowList) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVector is not supported for sparse index")
+}
+
+func (index *sparse) SearchByMultiVectorDistance(ctx context.Context, vector [][]float32,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	return nil, nil, errors.Errorf("SearchByMultiVectorDistance is not supported for sparse index")
+}
+
+func (index *sparse) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	defer callback()
+
+	if _, ok := updated.(sparseent.UserConfig); !ok {
+		return errors.Errorf("config is not UserConfig, but %T", updated)
+	}
+	// there is nothing mutable in the config yet
+	return nil
+}
+
+func (index *sparse) Drop(ctx context.Context) error {
+	// Shard::drop will take care of handling store's buckets
+	return nil
+}
+
+func (index *sparse) Flush() error {
+	// Shard will take care of handling store's buckets
+	return nil
+}
+
+func (index *sparse) Shutdown(ctx context.Context) error {
+	// Shard::shutdown will take care of handling store's buckets
+	return nil
+}
+
+func (index *sparse) SwitchCommitLogs(context.Context) error {
+	return nil
+}
+
+func (index *sparse) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	// everything is in the buckets, which are part of the shard's backup
+	return nil, nil
+}
+
+func (index *sparse) GetKeys(id uint64) (uint64, uint64, error) {
+	return 0, 0, errors.Errorf("GetKeys is not supported for sparse index")
+}
+
+func (index *sparse) ValidateBeforeInsert(vector []float32) error {
+	return errors.Errorf("dense vectors are not supported for sparse index")
+}
+
+func (index *sparse) ValidateMultiBeforeInsert(vector [][]float32) error {
+	return errors.Errorf("multi vectors are not supported for sparse index")
+}
+
+func (index *sparse) PostStartup() {}
+
+func (index *sparse) Dump(labels ...string) {
+	if len(labels) > 0 {
+		fmt.Printf("--------------------------------------------------\n")
+		fmt.Printf("--  %s\n", strings.Join(labels, ", "))
+	}
+	fmt.Printf("--------------------------------------------------\n")
+	fmt.Printf("ID: %s\n", index.id)
+	fmt.Printf("Vectors: %d\n", index.count.Load())
+	fmt.Printf("--------------------------------------------------\n")
+}
+
+func (index *sparse) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	return 0, errors.Errorf("DistanceBetweenVectors is not supported for sparse index")
+}
+
+func (index *sparse) ContainsNode(id uint64) bool {
+	vector, err := index.VectorByID(id)
+	return err == nil && vector != nil
+}
+
+func (index *sparse) Iterate(fn func(id uint64) bool) {
+	cursor := index.store.Bucket(index.docsBucketName()).Cursor()
+	defer cursor.Close()
+
+	for key, _ := cursor.First(); key != nil; key, _ = cursor.Next() {
+		if !fn(binary.BigEndian.Uint64(key)) {
+			break
+		}
+	}
+}
+
+func (index *sparse) DistancerProvider() distancer.Provider {
+	return index.distancerProvider
+}
+
+func (index *sparse) AlreadyIndexed() uint64 {
+	return index.count.Load()
+}
+
+func (index *sparse) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	return common.QueryVectorDistancer{}
+}
+
+// QuerySparseVectorDistancer returns the negative dot product of the query
+// and the vectors of the index
+func (index *sparse) QuerySparseVectorDistancer(queryVector models.SparseVector) common.QueryVectorDistancer {
+	query := queryWeights(queryVector)
+	distFunc := func(nodeID uint64) (float32, error) {
+		dist, ok, err := index.distance(query, nodeID)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return 0, fmt.Errorf("sparse vector %d does not exist", nodeID)
+		}
+		return dist, nil
+	}
+	return common.QueryVectorDistancer{DistanceFunc: distFunc}
+}
+
+func queryWeights(vector models.SparseVector) map[uint32]float32 {
+	weights := make(map[uint32]float32, len(vector.Indices))
+	for i, dim := range vector.Indices {
+		weights[dim] = vector.Values[i]
+	}
+	return weights
+}
+
+// distance returns the negative dot product of the query weights and the
+// stored vector of the document, false if the document is not stored
+func (index *sparse) distance(query map[uint32]float32, id uint64) (float32, bool, error) {
+	vector, err := index.VectorByID(id)
+	if err != nil || vector == nil {
+		return 0, false, err
+	}
+	var dot float32
+	for i, dim := range vector.Indices {
+		dot += query[dim] * vector.Values[i]
+	}
+	return -dot, true, nil
+}
+
+func (index *sparse) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
+	return common.QueryVectorDistancer{}
+}
+
+func (index *sparse) Stats() (common.IndexStats, error) {
+	return &SparseStats{Vectors: index.count.Load()}, nil
+}
+
+type SparseStats struct {
+	Vectors uint64 `json:"vectors"`
+}
+
+func (s *SparseStats) IndexType() common.IndexType {
+	return common.IndexTypeSparse
+}
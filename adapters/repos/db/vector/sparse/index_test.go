@@ -0,0 +1,267 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+	"github.com/weaviate/weaviate/entities/models"
+	sparseent "github.com/weaviate/weaviate/entities/vectorindex/sparse"
+)
+
+func newTestIndex(t *testing.T, dir string) (*sparse, *lsmkv.Store) {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+
+	index, err := New(Config{
+		ID:               "sparse-test",
+		Logger:           logger,
+		DistanceProvider: distancer.NewDotProductProvider(),
+	}, sparseent.NewDefaultUserConfig(), store)
+	require.Nil(t, err)
+	return index, store
+}
+
+func randomSparseVector(r *rand.Rand, dims, nonZero int) models.SparseVector {
+	vector := models.SparseVector{}
+	for _, dim := range r.Perm(dims)[:nonZero] {
+		vector.Indices = append(vector.Indices, uint32(dim))
+		vector.Values = append(vector.Values, r.Float32())
+	}
+	return vector
+}
+
+func dot(a, b models.SparseVector) float32 {
+	weights := make(map[uint32]float32, len(a.Indices))
+	for i, dim := range a.Indices {
+		weights[dim] = a.Values[i]
+	}
+	var sum float32
+	for i, dim := range b.Indices {
+		sum += weights[dim] * b.Values[i]
+	}
+	return sum
+}
+
+// bruteForce returns the negative dot products of the k best matches
+func bruteForce(vectors map[uint64]models.SparseVector, query models.SparseVector, k int,
+	allow helpers.AllowList,
+) []float32 {
+	var dists []float32
+	for id, vector := range vectors {
+		if allow != nil && !allow.Contains(id) {
+			continue
+		}
+		if d := dot(vector, query); d > 0 {
+			dists = append(dists, -d)
+		}
+	}
+	sort.Slice(dists, func(a, b int) bool { return dists[a] < dists[b] })
+	if len(dists) > k {
+		dists = dists[:k]
+	}
+	return dists
+}
+
+func TestSparseIndex(t *testing.T) {
+	ctx := context.Background()
+	r := rand.New(rand.NewSource(7))
+	index, store := newTestIndex(t, t.TempDir())
+	defer store.Shutdown(ctx)
+
+	const (
+		dims    = 300
+		nonZero = 20
+		k       = 10
+	)
+
+	vectors := make(map[uint64]models.SparseVector)
+	insert := func(from, to uint64) {
+		for id := from; id < to; id++ {
+			vectors[id] = randomSparseVector(r, dims, nonZero)
+			require.Nil(t, index.AddSparse(ctx, id, vectors[id]))
+		}
+	}
+	flush := func() {
+		require.Nil(t, store.Bucket(index.postingsBucketName()).FlushAndSwitch())
+	}
+	remove := func(ids ...uint64) {
+		require.Nil(t, index.Delete(ids...))
+		for _, id := range ids {
+			delete(vectors, id)
+		}
+	}
+
+	assertMatchesBruteForce := func(t *testing.T, allow helpers.AllowList) {
+		for i := 0; i < 20; i++ {
+			query := randomSparseVector(r, dims, 10)
+			ids, dists, err := index.SearchBySparseVector(ctx, query, k, allow)
+			require.Nil(t, err)
+			require.Len(t, ids, len(dists))
+
+			expected := bruteForce(vectors, query, k, allow)
+			require.Len(t, dists, len(expected))
+			for j := range ids {
+				vector, ok := vectors[ids[j]]
+				require.True(t, ok, "deleted document %d was found", ids[j])
+				if allow != nil {
+					assert.True(t, allow.Contains(ids[j]))
+				}
+				// results are rescored exactly, but selected by the weights
+				// stored with a precision of 1/weightScale
+				assert.InDelta(t, -dot(vector, query), dists[j], 1e-5)
+				assert.InDelta(t, expected[j], dists[j], 0.01)
+			}
+		}
+	}
+
+	t.Run("memtable only", func(t *testing.T) {
+		insert(0, 500)
+		assertMatchesBruteForce(t, nil)
+	})
+
+	t.Run("segment and memtable", func(t *testing.T) {
+		flush()
+		insert(500, 1000)
+		assertMatchesBruteForce(t, nil)
+	})
+
+	t.Run("deletes in memtable and segments", func(t *testing.T) {
+		remove(3, 17, 42, 600, 777)
+		flush()
+		remove(5, 501, 999)
+		insert(1000, 1200)
+		remove(1100)
+		assertMatchesBruteForce(t, nil)
+		assert.Equal(t, uint64(len(vectors)), index.AlreadyIndexed())
+		assert.False(t, index.ContainsNode(42))
+		assert.True(t, index.ContainsNode(43))
+	})
+
+	t.Run("with allow list", func(t *testing.T) {
+		allow := helpers.NewAllowList()
+		for id := uint64(0); id < 1200; id += 3 {
+			allow.Insert(id)
+		}
+		assertMatchesBruteForce(t, allow)
+	})
+
+	t.Run("search by distance", func(t *testing.T) {
+		query := randomSparseVector(r, dims, 10)
+		ids, dists, err := index.SearchBySparseVectorDistance(ctx, query, -2, -1, nil)
+		require.Nil(t, err)
+		require.Len(t, ids, len(dists))
+
+		expected := 0
+		for _, vector := range vectors {
+			if -dot(vector, query) <= -2 {
+				expected++
+			}
+		}
+		assert.InDelta(t, expected, len(ids), 1)
+		for _, dist := range dists {
+			assert.LessOrEqual(t, dist, float32(-2+0.01))
+		}
+
+		// a max limit above the initial limit of the search must not cut the
+		// results short of it
+		all, allDists, err := index.SearchBySparseVectorDistance(ctx, query, 100, -1, nil)
+		require.Nil(t, err)
+		require.Greater(t, len(all), 150)
+		limited, limitedDists, err := index.SearchBySparseVectorDistance(ctx, query, 100, 150, nil)
+		require.Nil(t, err)
+		require.Len(t, limited, 150)
+		assert.InDeltaSlice(t, allDists[:150], limitedDists, 1e-5)
+	})
+}
+
+func TestSparseIndexSplitPostings(t *testing.T) {
+	ctx := context.Background()
+	index, store := newTestIndex(t, t.TempDir())
+	defer store.Shutdown(ctx)
+	postings := store.Bucket(index.postingsBucketName())
+
+	// document 0 is the best match, but its postings were split by a flush
+	// during its insert, so that neither layer holds its whole score
+	split := models.SparseVector{Indices: []uint32{1, 2}, Values: []float32{0.6, 0.6}}
+	require.Nil(t, postings.MapSet(dimensionKey(1), lsmkv.MapPair{Key: idKey(0), Value: postingValue(0.6)}))
+	require.Nil(t, index.AddSparse(ctx, 1, models.SparseVector{Indices: []uint32{1}, Values: []float32{1}}))
+	require.Nil(t, postings.FlushAndSwitch())
+	require.Nil(t, index.AddSparse(ctx, 2, models.SparseVector{Indices: []uint32{2}, Values: []float32{1}}))
+	require.Nil(t, postings.MapSet(dimensionKey(2), lsmkv.MapPair{Key: idKey(0), Value: postingValue(0.6)}))
+	require.Nil(t, store.Bucket(index.docsBucketName()).Put(idKey(0), marshalVector(split)))
+
+	query := models.SparseVector{Indices: []uint32{1, 2}, Values: []float32{1, 1}}
+	ids, dists, err := index.SearchBySparseVector(ctx, query, 1, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{0}, ids)
+	assert.InDeltaSlice(t, []float32{-1.2}, dists, 1e-6)
+}
+
+func TestValidateSparseVector(t *testing.T) {
+	tests := []struct {
+		name   string
+		vector models.SparseVector
+		errMsg string
+	}{
+		{
+			name:   "valid",
+			vector: models.SparseVector{Indices: []uint32{1, 7}, Values: []float32{0.5, 0}},
+		},
+		{
+			name:   "empty",
+			vector: models.SparseVector{},
+			errMsg: "sparse vector is empty",
+		},
+		{
+			name:   "length mismatch",
+			vector: models.SparseVector{Indices: []uint32{1, 7}, Values: []float32{0.5}},
+			errMsg: "sparse vector has 2 indices, but 1 values",
+		},
+		{
+			name:   "duplicate index",
+			vector: models.SparseVector{Indices: []uint32{7, 7}, Values: []float32{0.5, 1}},
+			errMsg: "sparse vector contains index 7 more than once",
+		},
+		{
+			name:   "negative value",
+			vector: models.SparseVector{Indices: []uint32{1, 7}, Values: []float32{0.5, -1}},
+			errMsg: "sparse vector has invalid value -1 at index 7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateSparseVector(tt.vector)
+			if tt.errMsg == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package sparse
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/terms"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/floatcomp"
+)
+
+// SearchBySparseVector returns the k documents with the highest dot product
+// with the query vector. The distance of a document is the negative dot
+// product, in line with the dot distance of the dense indexes.
+func (index *sparse) SearchBySparseVector(ctx context.Context, vector models.SparseVector,
+	k int, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	if err := ValidateSparseVector(vector); err != nil {
+		return nil, nil, errors.Wrap(err, "invalid query")
+	}
+	if k <= 0 {
+		return nil, nil, nil
+	}
+
+	keys := make([][]byte, 0, len(vector.Indices))
+	weights := make([]float32, 0, len(vector.Indices))
+	for i, dim := range vector.Indices {
+		if vector.Values[i] == 0 {
+			continue
+		}
+		keys = append(keys, dimensionKey(dim))
+		// the stored frequencies are scaled, scaling the query weights back
+		// makes the score the dot product of the original weights
+		weights = append(weights, vector.Values[i]/weightScale)
+	}
+	if len(keys) == 0 {
+		return nil, nil, nil
+	}
+
+	layers, lock, err := index.store.Bucket(index.postingsBucketName()).
+		CreateDotProductTerms(keys, weights, allow)
+	if lock != nil {
+		defer lock.RUnlock()
+	}
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "read posting lists")
+	}
+
+	// the postings of a document are written at once and never updated, so
+	// they are usually all part of the same layer. A flush during an insert
+	// splits them though, which is why the posting lists of all layers are
+	// searched at once rather than layer by layer, WAND sums up the scores of
+	// all lists positioned at a document.
+	merged := &terms.Terms{Count: len(keys)}
+	for _, layer := range layers {
+		merged.T = append(merged.T, layer...)
+	}
+	if len(merged.T) == 0 {
+		return nil, nil, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+	topKHeap := terms.DoBlockMaxWand(k, merged, 0, false)
+
+	// the scores of WAND are based on the weights as they are kept in the
+	// postings, with a precision of 1/weightScale. The results are rescored
+	// with the stored vectors, so that their distances are the ones of
+	// QuerySparseVectorDistancer.
+	query := queryWeights(vector)
+	ids := make([]uint64, 0, topKHeap.Len())
+	scores := make(map[uint64]float32, topKHeap.Len())
+	for topKHeap.Len() > 0 {
+		item := topKHeap.Pop()
+		dist, ok, err := index.distance(query, item.ID)
+		if err != nil {
+			return nil, nil, err
+		}
+		// a concurrent delete removed the vector after the postings were read
+		if !ok {
+			continue
+		}
+		ids = append(ids, item.ID)
+		scores[item.ID] = dist
+	}
+	sort.Slice(ids, func(a, b int) bool {
+		if scores[ids[a]] != scores[ids[b]] {
+			return scores[ids[a]] < scores[ids[b]]
+		}
+		return ids[a] < ids[b]
+	})
+
+	dists := make([]float32, len(ids))
+	for i, id := range ids {
+		dists[i] = scores[id]
+	}
+	return ids, dists, nil
+}
+
+// SearchBySparseVectorDistance returns the documents within the target
+// distance, ordered by distance. The search is repeated with a growing limit
+// until a result is out of range, but never with a limit above a non-negative
+// maxLimit, which caps the number of results.
+func (index *sparse) SearchBySparseVectorDistance(ctx context.Context, vector models.SparseVector,
+	targetDistance float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	k := common.DefaultSearchByDistInitialLimit
+	for {
+		if maxLimit >= 0 && int64(k) > maxLimit {
+			k = int(maxLimit)
+		}
+		ids, dists, err := index.SearchBySparseVector(ctx, vector, k, allow)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "sparse vector search")
+		}
+
+		end := sort.Search(len(dists), func(i int) bool {
+			return dists[i] > targetDistance &&
+				!floatcomp.InDelta(float64(dists[i]), float64(targetDistance), 1e-6)
+		})
+		// fewer results than the limit means there are no more documents, a
+		// result out of range means the rest is out of range as well
+		if end < len(ids) || len(ids) < k {
+			return ids[:end], dists[:end], nil
+		}
+		if maxLimit >= 0 && int64(k) >= maxLimit {
+			index.logger.
+				WithField("action", "unlimited_vector_search").
+				Warnf("maximum search limit of %d results has been reached", maxLimit)
+			return ids, dists, nil
+		}
+		k *= common.DefaultSearchByDistLimitMultiplier
+	}
+}
@@ -0,0 +1,137 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package testinghelpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/cyclemanager"
+)
+
+// VectorIndex is the part of a vector index exercised by the shared
+// assertions below
+type VectorIndex interface {
+	Add(ctx context.Context, id uint64, vector []float32) error
+	SearchByVector(ctx context.Context, vector []float32, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchByVectorDistance(ctx context.Context, vector []float32, dist float32,
+		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	DistancerProvider() distancer.Provider
+}
+
+func DistanceWrapper(provider distancer.Provider) DistanceFunction {
+	return func(x, y []float32) float32 {
+		dist, _ := provider.SingleDist(x, y)
+		return dist
+	}
+}
+
+// NewStore opens a store in dir. Unlike NewDummyStore, the store can be
+// shut down and opened again to test restarts.
+func NewStore(t testing.TB, dir string) *lsmkv.Store {
+	logger, _ := test.NewNullLogger()
+	store, err := lsmkv.New(dir, dir, logger, nil,
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop(),
+		cyclemanager.NewCallbackGroupNoop())
+	require.Nil(t, err)
+	return store
+}
+
+// VectorMap keys the vectors by their position, which is their id in most
+// tests
+func VectorMap(vectors [][]float32) map[uint64][]float32 {
+	m := make(map[uint64][]float32, len(vectors))
+	for i, vec := range vectors {
+		m[uint64(i)] = vec
+	}
+	return m
+}
+
+// Recall compares the results of the index with a brute force search over
+// the vectors and checks that only allowed ids are returned
+func Recall(t *testing.T, index VectorIndex, vectors map[uint64][]float32,
+	queries [][]float32, k int, allow helpers.AllowList,
+) float32 {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	relevant := uint64(0)
+	for _, query := range queries {
+		ids, _, err := index.SearchByVector(ctx, query, k, allow)
+		require.Nil(t, err)
+
+		var control [][]float32
+		var controlIDs []uint64
+		for id, vec := range vectors {
+			if allow == nil || allow.Contains(id) {
+				control = append(control, vec)
+				controlIDs = append(controlIDs, id)
+			}
+		}
+		truth, _ := BruteForce(logger, control, query, k,
+			DistanceWrapper(index.DistancerProvider()))
+		for i := range truth {
+			truth[i] = controlIDs[truth[i]]
+		}
+		for _, id := range ids {
+			if allow != nil {
+				assert.True(t, allow.Contains(id))
+			}
+		}
+		relevant += MatchesInLists(truth, ids)
+	}
+	return float32(relevant) / float32(k*len(queries))
+}
+
+// AssertFilteredSearch expects exact results for an allow list below a flat
+// search cutoff of 100 and at least minRecall for one above it
+func AssertFilteredSearch(t *testing.T, index VectorIndex, vectors map[uint64][]float32,
+	queries [][]float32, k int, minRecall float32,
+) {
+	small := helpers.NewAllowList()
+	for i := uint64(0); i < 50; i++ {
+		small.Insert(i * 7)
+	}
+	assert.Equal(t, float32(1), Recall(t, index, vectors, queries, k, small))
+
+	large := helpers.NewAllowList()
+	for id := range vectors {
+		if id%2 == 0 {
+			large.Insert(id)
+		}
+	}
+	assert.Greater(t, Recall(t, index, vectors, queries, k, large), minRecall)
+}
+
+// AssertSearchByDistance expects a search by the distance of the k-th result
+// to return at least the k nearest results
+func AssertSearchByDistance(t *testing.T, index VectorIndex, query []float32, k int) {
+	ctx := context.Background()
+	ids, dists, err := index.SearchByVector(ctx, query, k, nil)
+	require.Nil(t, err)
+	require.Len(t, dists, k)
+	found, _, err := index.SearchByVectorDistance(ctx, query, dists[k-1], -1, nil)
+	require.Nil(t, err)
+	assert.Subset(t, found, ids)
+}
+
+func AssertWrongDimensionsRejected(t *testing.T, index VectorIndex, id uint64) {
+	err := index.Add(context.Background(), id, []float32{1, 2, 3})
+	assert.ErrorContains(t, err, "vector with length 3")
+}
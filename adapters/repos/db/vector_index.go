@@ -17,6 +17,7 @@ import (
 	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
 	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	"github.com/weaviate/weaviate/entities/models"
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
 )
 
@@ -63,3 +64,18 @@ type VectorIndex interface {
 	QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer
 	Stats() (common.IndexStats, error)
 }
+
+// SparseVectorIndex is a VectorIndex for sparse vectors. Sparse vectors are
+// not embeddings, they are added directly when an object is stored instead of
+// through the index queue, and searched with their own methods. For an example
+// look at ./vector/sparse/index.go
+type SparseVectorIndex interface {
+	VectorIndex
+	AddSparse(ctx context.Context, id uint64, vector models.SparseVector) error
+	ValidateSparseBeforeInsert(vector models.SparseVector) error
+	SearchBySparseVector(ctx context.Context, vector models.SparseVector, k int,
+		allow helpers.AllowList) ([]uint64, []float32, error)
+	SearchBySparseVectorDistance(ctx context.Context, vector models.SparseVector, dist float32,
+		maxLimit int64, allow helpers.AllowList) ([]uint64, []float32, error)
+	QuerySparseVectorDistancer(queryVector models.SparseVector) common.QueryVectorDistancer
+}
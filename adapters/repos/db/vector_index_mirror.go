@@ -0,0 +1,446 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package db
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaviate/weaviate/adapters/repos/db/helpers"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/common"
+	"github.com/weaviate/weaviate/adapters/repos/db/vector/hnsw/distancer"
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+)
+
+// mirroredVectorIndex serves reads from the primary index and applies writes
+// to both the primary and the secondary index. It is installed while a vector
+// index migration builds the secondary index, see shard_vector_migration.go.
+//
+// The primary remains the source of truth until the migration completes, so
+// failed writes to the secondary are not returned to the caller but reported
+// through onError, which fails the migration.
+type mirroredVectorIndex struct {
+	sync.RWMutex
+	primary   VectorIndex
+	secondary VectorIndex
+	onError   func(error)
+
+	// serializes writes to the secondary, so that the backfill and mirrored
+	// writes don't race on the same ids
+	secondaryLock sync.Mutex
+	// ids deleted while the secondary is built. Doc ids are never reused, so
+	// the backfill can safely skip them.
+	deleted map[uint64]struct{}
+}
+
+func newMirroredVectorIndex(primary, secondary VectorIndex, onError func(error)) *mirroredVectorIndex {
+	return &mirroredVectorIndex{
+		primary:   primary,
+		secondary: secondary,
+		onError:   onError,
+		deleted:   map[uint64]struct{}{},
+	}
+}
+
+// promote makes the secondary the only index. Writes that are in progress
+// complete on both indexes first. If check fails, the secondary is not
+// promoted. As no writes can run while it is called, a failed mirrored write
+// has been reported before check is called.
+func (m *mirroredVectorIndex) promote(check func() error) error {
+	m.Lock()
+	defer m.Unlock()
+
+	if err := check(); err != nil {
+		return err
+	}
+	m.primary = m.secondary
+	m.secondary = nil
+	return nil
+}
+
+// detach stops mirroring writes to the secondary. Writes that are in progress
+// complete on both indexes first.
+func (m *mirroredVectorIndex) detach() {
+	m.Lock()
+	defer m.Unlock()
+
+	m.secondary = nil
+}
+
+// addToSecondary adds all vectors the secondary doesn't contain yet and that
+// were not deleted in the meantime
+func (m *mirroredVectorIndex) addToSecondary(ctx context.Context, ids []uint64, vectors [][]float32) {
+	m.RLock()
+	defer m.RUnlock()
+
+	m.addToSecondaryUnlocked(ctx, ids, vectors)
+}
+
+func (m *mirroredVectorIndex) addToSecondaryUnlocked(ctx context.Context, ids []uint64, vectors [][]float32) {
+	if m.secondary == nil {
+		return
+	}
+
+	m.secondaryLock.Lock()
+	defer m.secondaryLock.Unlock()
+
+	missingIDs := make([]uint64, 0, len(ids))
+	missingVectors := make([][]float32, 0, len(vectors))
+	for i, id := range ids {
+		if m.skipSecondary(id) {
+			continue
+		}
+		missingIDs = append(missingIDs, id)
+		missingVectors = append(missingVectors, vectors[i])
+	}
+	if len(missingIDs) == 0 {
+		return
+	}
+	m.report(m.secondary.AddBatch(ctx, missingIDs, missingVectors))
+}
+
+// addMultiToSecondary is the multi vector counterpart of addToSecondary
+func (m *mirroredVectorIndex) addMultiToSecondary(ctx context.Context, ids []uint64, vectors [][][]float32) {
+	m.RLock()
+	defer m.RUnlock()
+
+	m.addMultiToSecondaryUnlocked(ctx, ids, vectors)
+}
+
+func (m *mirroredVectorIndex) addMultiToSecondaryUnlocked(ctx context.Context, ids []uint64, vectors [][][]float32) {
+	if m.secondary == nil {
+		return
+	}
+
+	m.secondaryLock.Lock()
+	defer m.secondaryLock.Unlock()
+
+	missingIDs := make([]uint64, 0, len(ids))
+	missingVectors := make([][][]float32, 0, len(vectors))
+	for i, id := range ids {
+		if m.skipSecondary(id) {
+			continue
+		}
+		missingIDs = append(missingIDs, id)
+		missingVectors = append(missingVectors, vectors[i])
+	}
+	if len(missingIDs) == 0 {
+		return
+	}
+	m.report(m.secondary.AddMultiBatch(ctx, missingIDs, missingVectors))
+}
+
+func (m *mirroredVectorIndex) skipSecondary(id uint64) bool {
+	if _, ok := m.deleted[id]; ok {
+		return true
+	}
+	return m.secondary.ContainsNode(id)
+}
+
+func (m *mirroredVectorIndex) deleteFromSecondaryUnlocked(multi bool, ids []uint64) {
+	if m.secondary == nil {
+		return
+	}
+
+	m.secondaryLock.Lock()
+	defer m.secondaryLock.Unlock()
+
+	contained := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		m.deleted[id] = struct{}{}
+		if m.secondary.ContainsNode(id) {
+			contained = append(contained, id)
+		}
+	}
+	if len(contained) == 0 {
+		return
+	}
+	if multi {
+		m.report(m.secondary.DeleteMulti(contained...))
+	} else {
+		m.report(m.secondary.Delete(contained...))
+	}
+}
+
+func (m *mirroredVectorIndex) report(err error) {
+	if err != nil && m.onError != nil {
+		m.onError(err)
+	}
+}
+
+func (m *mirroredVectorIndex) Dump(labels ...string) {
+	m.RLock()
+	defer m.RUnlock()
+
+	m.primary.Dump(labels...)
+}
+
+func (m *mirroredVectorIndex) Add(ctx context.Context, id uint64, vector []float32) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	if err := m.primary.Add(ctx, id, vector); err != nil {
+		return err
+	}
+	if m.secondary != nil {
+		m.secondaryLock.Lock()
+		defer m.secondaryLock.Unlock()
+
+		if !m.skipSecondary(id) {
+			m.report(m.secondary.Add(ctx, id, vector))
+		}
+	}
+	return nil
+}
+
+func (m *mirroredVectorIndex) AddMulti(ctx context.Context, docId uint64, vector [][]float32) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	if err := m.primary.AddMulti(ctx, docId, vector); err != nil {
+		return err
+	}
+	if m.secondary != nil {
+		m.secondaryLock.Lock()
+		defer m.secondaryLock.Unlock()
+
+		if !m.skipSecondary(docId) {
+			m.report(m.secondary.AddMulti(ctx, docId, vector))
+		}
+	}
+	return nil
+}
+
+func (m *mirroredVectorIndex) AddBatch(ctx context.Context, ids []uint64, vector [][]float32) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	if err := m.primary.AddBatch(ctx, ids, vector); err != nil {
+		return err
+	}
+	m.addToSecondaryUnlocked(ctx, ids, vector)
+	return nil
+}
+
+func (m *mirroredVectorIndex) AddMultiBatch(ctx context.Context, docIds []uint64, vectors [][][]float32) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	if err := m.primary.AddMultiBatch(ctx, docIds, vectors); err != nil {
+		return err
+	}
+	m.addMultiToSecondaryUnlocked(ctx, docIds, vectors)
+	return nil
+}
+
+func (m *mirroredVectorIndex) Delete(id ...uint64) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	if err := m.primary.Delete(id...); err != nil {
+		return err
+	}
+	m.deleteFromSecondaryUnlocked(false, id)
+	return nil
+}
+
+func (m *mirroredVectorIndex) DeleteMulti(id ...uint64) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	if err := m.primary.DeleteMulti(id...); err != nil {
+		return err
+	}
+	m.deleteFromSecondaryUnlocked(true, id)
+	return nil
+}
+
+func (m *mirroredVectorIndex) SearchByVector(ctx context.Context, vector []float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.SearchByVector(ctx, vector, k, allow)
+}
+
+func (m *mirroredVectorIndex) SearchByVectorDistance(ctx context.Context, vector []float32,
+	dist float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.SearchByVectorDistance(ctx, vector, dist, maxLimit, allow)
+}
+
+func (m *mirroredVectorIndex) SearchByMultiVector(ctx context.Context, vector [][]float32, k int,
+	allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.SearchByMultiVector(ctx, vector, k, allow)
+}
+
+func (m *mirroredVectorIndex) SearchByMultiVectorDistance(ctx context.Context, vector [][]float32,
+	dist float32, maxLimit int64, allow helpers.AllowList,
+) ([]uint64, []float32, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.SearchByMultiVectorDistance(ctx, vector, dist, maxLimit, allow)
+}
+
+// UpdateUserConfig is applied to the primary only, updates of the index that
+// is being built are handled by the migration
+func (m *mirroredVectorIndex) UpdateUserConfig(updated schemaConfig.VectorIndexConfig, callback func()) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.UpdateUserConfig(updated, callback)
+}
+
+func (m *mirroredVectorIndex) GetKeys(id uint64) (uint64, uint64, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.GetKeys(id)
+}
+
+// Drop, Shutdown and Flush only affect the primary, the lifecycle of the
+// secondary is owned by the migration
+func (m *mirroredVectorIndex) Drop(ctx context.Context) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.Drop(ctx)
+}
+
+func (m *mirroredVectorIndex) Shutdown(ctx context.Context) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.Shutdown(ctx)
+}
+
+func (m *mirroredVectorIndex) Flush() error {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.Flush()
+}
+
+func (m *mirroredVectorIndex) SwitchCommitLogs(ctx context.Context) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.SwitchCommitLogs(ctx)
+}
+
+func (m *mirroredVectorIndex) ListFiles(ctx context.Context, basePath string) ([]string, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.ListFiles(ctx, basePath)
+}
+
+func (m *mirroredVectorIndex) PostStartup() {
+	m.RLock()
+	defer m.RUnlock()
+
+	m.primary.PostStartup()
+}
+
+func (m *mirroredVectorIndex) Compressed() bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.Compressed()
+}
+
+func (m *mirroredVectorIndex) Multivector() bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.Multivector()
+}
+
+func (m *mirroredVectorIndex) ValidateBeforeInsert(vector []float32) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.ValidateBeforeInsert(vector)
+}
+
+func (m *mirroredVectorIndex) ValidateMultiBeforeInsert(vector [][]float32) error {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.ValidateMultiBeforeInsert(vector)
+}
+
+func (m *mirroredVectorIndex) DistanceBetweenVectors(x, y []float32) (float32, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.DistanceBetweenVectors(x, y)
+}
+
+func (m *mirroredVectorIndex) ContainsNode(id uint64) bool {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.ContainsNode(id)
+}
+
+func (m *mirroredVectorIndex) AlreadyIndexed() uint64 {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.AlreadyIndexed()
+}
+
+func (m *mirroredVectorIndex) Iterate(fn func(id uint64) bool) {
+	m.RLock()
+	primary := m.primary
+	m.RUnlock()
+
+	primary.Iterate(fn)
+}
+
+func (m *mirroredVectorIndex) DistancerProvider() distancer.Provider {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.DistancerProvider()
+}
+
+func (m *mirroredVectorIndex) QueryVectorDistancer(queryVector []float32) common.QueryVectorDistancer {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.QueryVectorDistancer(queryVector)
+}
+
+func (m *mirroredVectorIndex) QueryMultiVectorDistancer(queryVector [][]float32) common.QueryVectorDistancer {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.QueryMultiVectorDistancer(queryVector)
+}
+
+func (m *mirroredVectorIndex) Stats() (common.IndexStats, error) {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.primary.Stats()
+}
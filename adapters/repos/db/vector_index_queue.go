@@ -18,6 +18,7 @@ import (
 	"math"
 	"os"
 	"path/filepath"
+	"sync"
 	"sync/atomic"
 	"time"
 
@@ -43,7 +44,10 @@ type VectorIndexQueue struct {
 	// tracks the dimensions of the vectors in the queue
 	dims atomic.Int32
 
-	vectorIndex VectorIndex
+	// guards vectorIndex, which is replaced while the vector index is
+	// migrated, see vectorIndexMigration
+	vectorIndexLock sync.RWMutex
+	vectorIndex     VectorIndex
 }
 
 func NewVectorIndexQueue(
@@ -107,7 +111,16 @@ func (iq *VectorIndexQueue) Close() error {
 }
 
 func (iq *VectorIndexQueue) Insert(ctx context.Context, vectors ...common.VectorRecord) error {
+	if iq.shard.skipVectorIndexing() {
+		// the vectors are indexed once the bulk load has finished
+		return nil
+	}
+
 	if !iq.asyncEnabled {
+		// hold the lock for the whole write, so that ResetWith only returns
+		// once no write is applied to the previous index anymore
+		iq.vectorIndexLock.RLock()
+		defer iq.vectorIndexLock.RUnlock()
 		return common.AddVectorsToIndex(ctx, vectors, iq.vectorIndex)
 	}
 
@@ -119,7 +132,7 @@ func (iq *VectorIndexQueue) Insert(ctx context.Context, vectors ...common.Vector
 
 	for _, v := range vectors {
 		// validate vector
-		if err := v.Validate(iq.vectorIndex); err != nil {
+		if err := v.Validate(iq.index()); err != nil {
 			return errors.Wrap(err, "failed to validate")
 		}
 
@@ -149,6 +162,8 @@ func (iq *VectorIndexQueue) Insert(ctx context.Context, vectors ...common.Vector
 
 func (iq *VectorIndexQueue) Delete(ids ...uint64) error {
 	if !iq.asyncEnabled {
+		iq.vectorIndexLock.RLock()
+		defer iq.vectorIndexLock.RUnlock()
 		return iq.vectorIndex.Delete(ids...)
 	}
 
@@ -180,7 +195,7 @@ func (iq *VectorIndexQueue) Flush() error {
 	}
 
 	if !iq.asyncEnabled {
-		return iq.vectorIndex.Flush()
+		return iq.index().Flush()
 	}
 
 	return iq.DiskQueue.Flush()
@@ -192,7 +207,7 @@ func (iq *VectorIndexQueue) BeforeSchedule() (skip bool) {
 
 // Flush the vector index after a batch is processed.
 func (iq *VectorIndexQueue) OnBatchProcessed() {
-	if err := iq.vectorIndex.Flush(); err != nil {
+	if err := iq.index().Flush(); err != nil {
 		iq.Logger.WithError(err).Error("failed to flush vector index")
 	}
 }
@@ -205,7 +220,8 @@ type upgradableIndexer interface {
 
 // triggers compression if the index is ready to be upgraded
 func (iq *VectorIndexQueue) checkCompressionSettings() (skip bool) {
-	ci, ok := iq.vectorIndex.(upgradableIndexer)
+	vectorIndex := iq.index()
+	ci, ok := vectorIndex.(upgradableIndexer)
 	if !ok {
 		return false
 	}
@@ -215,7 +231,7 @@ func (iq *VectorIndexQueue) checkCompressionSettings() (skip bool) {
 		return false
 	}
 
-	if iq.vectorIndex.AlreadyIndexed() > uint64(shouldUpgradeAt) {
+	if vectorIndex.AlreadyIndexed() > uint64(shouldUpgradeAt) {
 		iq.scheduler.PauseQueue(iq.DiskQueue.ID())
 
 		err := ci.Upgrade(func() {
@@ -233,10 +249,23 @@ func (iq *VectorIndexQueue) checkCompressionSettings() (skip bool) {
 
 // ResetWith resets the queue with the given vector index.
 // The queue must be paused before calling this method.
+// ResetWith replaces the index the queue writes to. Writes that are still
+// applied to the previous index complete before it returns, tasks scheduled
+// by an async queue need to be waited for by pausing the queue beforehand.
 func (iq *VectorIndexQueue) ResetWith(vidx VectorIndex) {
+	iq.vectorIndexLock.Lock()
+	defer iq.vectorIndexLock.Unlock()
+
 	iq.vectorIndex = vidx
 }
 
+func (iq *VectorIndexQueue) index() VectorIndex {
+	iq.vectorIndexLock.RLock()
+	defer iq.vectorIndexLock.RUnlock()
+
+	return iq.vectorIndex
+}
+
 type vectorIndexQueueDecoder struct {
 	q *VectorIndexQueue
 }
@@ -267,7 +296,7 @@ func (v *vectorIndexQueueDecoder) DecodeTask(data []byte) (queue.Task, error) {
 			op:     op,
 			id:     uint64(id),
 			vector: vec,
-			idx:    v.q.vectorIndex,
+			idx:    v.q.index(),
 		}, nil
 	case vectorIndexQueueDeleteOp:
 		// decode id
@@ -276,7 +305,7 @@ func (v *vectorIndexQueueDecoder) DecodeTask(data []byte) (queue.Task, error) {
 		return &Task[[]float32]{
 			op:  op,
 			id:  uint64(id),
-			idx: v.q.vectorIndex,
+			idx: v.q.index(),
 		}, nil
 	case vectorIndexQueueMultiInsertOp:
 		// decode id
@@ -305,7 +334,7 @@ func (v *vectorIndexQueueDecoder) DecodeTask(data []byte) (queue.Task, error) {
 			op:     op,
 			id:     uint64(id),
 			vector: multiVec,
-			idx:    v.q.vectorIndex,
+			idx:    v.q.index(),
 		}, nil
 	}
 
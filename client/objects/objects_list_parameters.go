@@ -79,6 +79,12 @@ type ObjectsListParams struct {
 	*/
 	After *string
 
+	/* Before.
+
+	   A threshold UUID of the objects to retrieve before, using an UUID-based ordering. This object is not part of the set. <br/><br/>Must be used with `class`. Can be combined with `after` to list a range of UUIDs. <br/><br/>Note `before` cannot be used with `offset` or `sort`.
+	*/
+	Before *string
+
 	/* Class.
 
 	   The collection from which to query objects.  <br/><br/>Note that if `class` is not provided, the response will not include any objects.
@@ -113,6 +119,24 @@ type ObjectsListParams struct {
 	*/
 	Order *string
 
+	/* Prefix.
+
+	   Only retrieve objects whose UUID starts with the given hexadecimal prefix, e.g. `0a3f`. Dashes are ignored. <br/><br/>Must be used with `class`. Note `prefix` cannot be used with `offset` or `sort`.
+	*/
+	Prefix *string
+
+	/* Reverse.
+
+	   Retrieve the objects in descending UUID order. To paginate in reverse, pass the UUID of the last object of a page as `before`. <br/><br/>Must be used with `class`. Note `reverse` cannot be used with `offset` or `sort`.
+	*/
+	Reverse *bool
+
+	/* Snapshot.
+
+	   Read the objects from the point-in-time snapshot with this token, as returned when opening a snapshot of the class. Objects are returned as they were when the snapshot was opened. Can not be combined with sort. <br/><br/>Must be used with `class`.
+	*/
+	Snapshot *string
+
 	/* Sort.
 
 	   Name(s) of the property to sort by - e.g. `city`, or `country,city`.
@@ -144,10 +168,13 @@ func (o *ObjectsListParams) WithDefaults() *ObjectsListParams {
 func (o *ObjectsListParams) SetDefaults() {
 	var (
 		offsetDefault = int64(0)
+
+		reverseDefault = bool(false)
 	)
 
 	val := ObjectsListParams{
-		Offset: &offsetDefault,
+		Offset:  &offsetDefault,
+		Reverse: &reverseDefault,
 	}
 
 	val.timeout = o.timeout
@@ -200,6 +227,17 @@ func (o *ObjectsListParams) SetAfter(after *string) {
 	o.After = after
 }
 
+// WithBefore adds the before to the objects list params
+func (o *ObjectsListParams) WithBefore(before *string) *ObjectsListParams {
+	o.SetBefore(before)
+	return o
+}
+
+// SetBefore adds the before to the objects list params
+func (o *ObjectsListParams) SetBefore(before *string) {
+	o.Before = before
+}
+
 // WithClass adds the class to the objects list params
 func (o *ObjectsListParams) WithClass(class *string) *ObjectsListParams {
 	o.SetClass(class)
@@ -255,6 +293,39 @@ func (o *ObjectsListParams) SetOrder(order *string) {
 	o.Order = order
 }
 
+// WithPrefix adds the prefix to the objects list params
+func (o *ObjectsListParams) WithPrefix(prefix *string) *ObjectsListParams {
+	o.SetPrefix(prefix)
+	return o
+}
+
+// SetPrefix adds the prefix to the objects list params
+func (o *ObjectsListParams) SetPrefix(prefix *string) {
+	o.Prefix = prefix
+}
+
+// WithReverse adds the reverse to the objects list params
+func (o *ObjectsListParams) WithReverse(reverse *bool) *ObjectsListParams {
+	o.SetReverse(reverse)
+	return o
+}
+
+// SetReverse adds the reverse to the objects list params
+func (o *ObjectsListParams) SetReverse(reverse *bool) {
+	o.Reverse = reverse
+}
+
+// WithSnapshot adds the snapshot to the objects list params
+func (o *ObjectsListParams) WithSnapshot(snapshot *string) *ObjectsListParams {
+	o.SetSnapshot(snapshot)
+	return o
+}
+
+// SetSnapshot adds the snapshot to the objects list params
+func (o *ObjectsListParams) SetSnapshot(snapshot *string) {
+	o.Snapshot = snapshot
+}
+
 // WithSort adds the sort to the objects list params
 func (o *ObjectsListParams) WithSort(sort *string) *ObjectsListParams {
 	o.SetSort(sort)
@@ -302,6 +373,23 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.Before != nil {
+
+		// query param before
+		var qrBefore string
+
+		if o.Before != nil {
+			qrBefore = *o.Before
+		}
+		qBefore := qrBefore
+		if qBefore != "" {
+
+			if err := r.SetQueryParam("before", qBefore); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Class != nil {
 
 		// query param class
@@ -387,6 +475,57 @@ func (o *ObjectsListParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		}
 	}
 
+	if o.Prefix != nil {
+
+		// query param prefix
+		var qrPrefix string
+
+		if o.Prefix != nil {
+			qrPrefix = *o.Prefix
+		}
+		qPrefix := qrPrefix
+		if qPrefix != "" {
+
+			if err := r.SetQueryParam("prefix", qPrefix); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Reverse != nil {
+
+		// query param reverse
+		var qrReverse bool
+
+		if o.Reverse != nil {
+			qrReverse = *o.Reverse
+		}
+		qReverse := swag.FormatBool(qrReverse)
+		if qReverse != "" {
+
+			if err := r.SetQueryParam("reverse", qReverse); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Snapshot != nil {
+
+		// query param snapshot
+		var qrSnapshot string
+
+		if o.Snapshot != nil {
+			qrSnapshot = *o.Snapshot
+		}
+		qSnapshot := qrSnapshot
+		if qSnapshot != "" {
+
+			if err := r.SetQueryParam("snapshot", qSnapshot); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Sort != nil {
 
 		// query param sort
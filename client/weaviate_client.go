@@ -27,11 +27,13 @@ import (
 	"github.com/weaviate/weaviate/client/classifications"
 	"github.com/weaviate/weaviate/client/cluster"
 	"github.com/weaviate/weaviate/client/graphql"
+	"github.com/weaviate/weaviate/client/kv"
 	"github.com/weaviate/weaviate/client/meta"
 	"github.com/weaviate/weaviate/client/nodes"
 	"github.com/weaviate/weaviate/client/objects"
 	"github.com/weaviate/weaviate/client/operations"
 	"github.com/weaviate/weaviate/client/schema"
+	"github.com/weaviate/weaviate/client/snapshots"
 	"github.com/weaviate/weaviate/client/well_known"
 )
 
@@ -83,11 +85,13 @@ func New(transport runtime.ClientTransport, formats strfmt.Registry) *Weaviate {
 	cli.Classifications = classifications.New(transport, formats)
 	cli.Cluster = cluster.New(transport, formats)
 	cli.Graphql = graphql.New(transport, formats)
+	cli.Kv = kv.New(transport, formats)
 	cli.Meta = meta.New(transport, formats)
 	cli.Nodes = nodes.New(transport, formats)
 	cli.Objects = objects.New(transport, formats)
 	cli.Operations = operations.New(transport, formats)
 	cli.Schema = schema.New(transport, formats)
+	cli.Snapshots = snapshots.New(transport, formats)
 	cli.WellKnown = well_known.New(transport, formats)
 	return cli
 }
@@ -145,6 +149,8 @@ type Weaviate struct {
 
 	Graphql graphql.ClientService
 
+	Kv kv.ClientService
+
 	Meta meta.ClientService
 
 	Nodes nodes.ClientService
@@ -155,6 +161,8 @@ type Weaviate struct {
 
 	Schema schema.ClientService
 
+	Snapshots snapshots.ClientService
+
 	WellKnown well_known.ClientService
 
 	Transport runtime.ClientTransport
@@ -169,10 +177,12 @@ func (c *Weaviate) SetTransport(transport runtime.ClientTransport) {
 	c.Classifications.SetTransport(transport)
 	c.Cluster.SetTransport(transport)
 	c.Graphql.SetTransport(transport)
+	c.Kv.SetTransport(transport)
 	c.Meta.SetTransport(transport)
 	c.Nodes.SetTransport(transport)
 	c.Objects.SetTransport(transport)
 	c.Operations.SetTransport(transport)
 	c.Schema.SetTransport(transport)
+	c.Snapshots.SetTransport(transport)
 	c.WellKnown.SetTransport(transport)
 }
@@ -220,6 +220,7 @@ func (s *SchemaManager) UpdateClass(cmd *command.ApplyRequest, nodeID string, sc
 		meta.Class.VectorConfig = u.VectorConfig
 		meta.Class.ReplicationConfig = u.ReplicationConfig
 		meta.Class.MultiTenancyConfig = u.MultiTenancyConfig
+		meta.Class.ObjectTTLConfig = u.ObjectTTLConfig
 		meta.Class.Description = u.Description
 		meta.ClassVersion = cmd.Version
 		if req.State != nil {
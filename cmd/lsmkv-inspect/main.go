@@ -0,0 +1,393 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+// lsmkv-inspect is an offline tool to look into the lsmkv buckets of a
+// stopped node, e.g. after a crash. All commands except "wal --repair" open
+// the files read-only. Never run it against the data directory of a running
+// node.
+package main
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+	"github.com/urfave/cli/v2"
+	"github.com/weaviate/weaviate/adapters/repos/db/lsmkv"
+)
+
+func main() {
+	logger := logrus.New()
+	logger.SetOutput(os.Stderr)
+	logger.SetLevel(logrus.WarnLevel)
+
+	app := &cli.App{
+		Name:  "lsmkv-inspect",
+		Usage: "inspect and repair lsmkv buckets offline",
+		Commands: []*cli.Command{
+			{
+				Name:      "files",
+				Usage:     "list the files of a bucket directory by their role",
+				ArgsUsage: "<bucket-dir>",
+				Action:    filesCmd,
+			},
+			{
+				Name:      "segments",
+				Usage:     "show level, strategy, key range, tombstones and bloom filter health per segment",
+				ArgsUsage: "<bucket-dir>",
+				Action: func(c *cli.Context) error {
+					return segmentsCmd(c, logger)
+				},
+			},
+			{
+				Name:      "dump",
+				Usage:     "print the keys and values of a segment",
+				ArgsUsage: "<segment-file>",
+				Flags: []cli.Flag{
+					&cli.IntFlag{Name: "limit", Usage: "stop after this many keys, 0 for all"},
+					&cli.BoolFlag{Name: "keys-only", Usage: "do not print values"},
+				},
+				Action: func(c *cli.Context) error {
+					return dumpCmd(c, logger)
+				},
+			},
+			{
+				Name:      "validate",
+				Usage:     "validate all segments and write-ahead-logs of a bucket directory",
+				ArgsUsage: "<bucket-dir>",
+				Flags:     []cli.Flag{secondaryIndicesFlag},
+				Action: func(c *cli.Context) error {
+					return validateCmd(c, logger)
+				},
+			},
+			{
+				Name:      "wal",
+				Usage:     "inspect the write-ahead-logs of a bucket directory or a single log",
+				ArgsUsage: "<bucket-dir|wal-file>",
+				Flags: []cli.Flag{
+					secondaryIndicesFlag,
+					&cli.BoolFlag{
+						Name:  "repair",
+						Usage: "truncate corrupt logs after the last valid record, the original is kept as <file>.corrupt",
+					},
+				},
+				Action: walCmd,
+			},
+		},
+	}
+
+	if err := app.Run(os.Args); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+var secondaryIndicesFlag = &cli.UintFlag{
+	Name:  "secondary-indices",
+	Usage: "number of secondary indices of a replace bucket, only needed for legacy (v0) log records",
+}
+
+var errValidationFailed = errors.New("validation failed")
+
+func singleArg(c *cli.Context) (string, error) {
+	if c.NArg() != 1 {
+		return "", fmt.Errorf("expected exactly one argument %s", c.Command.ArgsUsage)
+	}
+	return c.Args().First(), nil
+}
+
+func filesCmd(c *cli.Context) error {
+	dir, err := singleArg(c)
+	if err != nil {
+		return err
+	}
+
+	files, err := lsmkv.ListBucketFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, group := range []struct {
+		name  string
+		paths []string
+	}{
+		{"segments", files.Segments},
+		{"write-ahead-logs", files.WALs},
+		{"derived", files.Derived},
+		{"quarantined", files.Quarantined},
+		{"tiered", files.Cold},
+		{"leftovers", files.Leftovers},
+	} {
+		fmt.Printf("%s (%d):\n", group.name, len(group.paths))
+		for _, path := range group.paths {
+			fmt.Printf("  %s\n", filepath.Base(path))
+		}
+	}
+	return nil
+}
+
+func segmentsCmd(c *cli.Context, logger logrus.FieldLogger) error {
+	dir, err := singleArg(c)
+	if err != nil {
+		return err
+	}
+
+	files, err := lsmkv.ListBucketFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SEGMENT\tLEVEL\tSTRATEGY\tVERSION\tCHECKSUMS\tSIZE\tKEYS\tTOMBSTONES\tFIRST KEY\tLAST KEY\tBLOOM")
+	for _, path := range files.Segments {
+		info, err := segmentInfo(path, logger)
+		if err != nil {
+			fmt.Fprintf(w, "%s\terror: %v\n", filepath.Base(path), err)
+			continue
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%d\t%t\t%d\t%d\t%d\t%s\t%s\t%s\n",
+			filepath.Base(path), info.Level, info.Strategy, info.Version, info.Checksums, info.Size,
+			info.Keys, info.Tombstones, formatBytes(info.FirstKey),
+			formatBytes(info.LastKey), info.BloomFilter)
+	}
+	return w.Flush()
+}
+
+func segmentInfo(path string, logger logrus.FieldLogger) (lsmkv.SegmentInfo, error) {
+	insp, err := lsmkv.OpenSegmentInspector(path, logger)
+	if err != nil {
+		return lsmkv.SegmentInfo{}, err
+	}
+	defer insp.Close()
+
+	return insp.Info()
+}
+
+var errLimitReached = errors.New("limit reached")
+
+func dumpCmd(c *cli.Context, logger logrus.FieldLogger) error {
+	path, err := singleArg(c)
+	if err != nil {
+		return err
+	}
+
+	insp, err := lsmkv.OpenSegmentInspector(path, logger)
+	if err != nil {
+		return err
+	}
+	defer insp.Close()
+
+	limit := c.Int("limit")
+	keysOnly := c.Bool("keys-only")
+	count := 0
+	err = insp.Dump(func(e lsmkv.SegmentEntry) error {
+		if limit > 0 && count >= limit {
+			return errLimitReached
+		}
+		count++
+
+		fmt.Println(formatBytes(e.Key))
+		if !keysOnly {
+			printEntry(e)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errLimitReached) {
+		return err
+	}
+	return nil
+}
+
+func printEntry(e lsmkv.SegmentEntry) {
+	if e.Tombstone {
+		fmt.Println("  tombstone")
+	}
+	if e.Value != nil {
+		fmt.Printf("  value: %s\n", formatBytes(e.Value))
+	}
+	for pos, sk := range e.SecondaryKeys {
+		fmt.Printf("  secondary key %d: %s\n", pos, formatBytes(sk))
+	}
+	for _, v := range e.Values {
+		fmt.Printf("  + %s\n", formatBytes(v))
+	}
+	for _, v := range e.DeletedValues {
+		fmt.Printf("  - %s\n", formatBytes(v))
+	}
+	for _, p := range e.Pairs {
+		prefix := "+"
+		if p.Tombstone {
+			prefix = "-"
+		}
+		fmt.Printf("  %s %s: %s\n", prefix, formatBytes(p.Key), formatBytes(p.Value))
+	}
+	if len(e.Additions) > 0 {
+		fmt.Printf("  additions: %v\n", e.Additions)
+	}
+	if len(e.Deletions) > 0 {
+		fmt.Printf("  deletions: %v\n", e.Deletions)
+	}
+}
+
+func validateCmd(c *cli.Context, logger logrus.FieldLogger) error {
+	dir, err := singleArg(c)
+	if err != nil {
+		return err
+	}
+
+	files, err := lsmkv.ListBucketFiles(dir)
+	if err != nil {
+		return err
+	}
+
+	cold := map[string]struct{}{}
+	for _, path := range files.Cold {
+		cold[strings.TrimSuffix(path, lsmkv.ColdMarkerSuffix)+".db"] = struct{}{}
+	}
+
+	failed := false
+	for _, path := range files.Segments {
+		if _, ok := cold[path]; ok {
+			// the data section of a stub is empty, opening it still verifies
+			// header and index
+			if err := openSegment(path, logger); err != nil {
+				failed = true
+				fmt.Printf("%s: %v\n", filepath.Base(path), err)
+			} else {
+				fmt.Printf("%s: ok (data in tiered storage, not validated)\n", filepath.Base(path))
+			}
+			continue
+		}
+
+		if err := validateSegment(path, logger); err != nil {
+			failed = true
+			fmt.Printf("%s: %v\n", filepath.Base(path), err)
+		} else {
+			fmt.Printf("%s: ok\n", filepath.Base(path))
+		}
+	}
+
+	for _, path := range files.WALs {
+		info, err := lsmkv.InspectWAL(path, uint16(c.Uint("secondary-indices")))
+		if err != nil {
+			failed = true
+			fmt.Printf("%s: %v\n", filepath.Base(path), err)
+		} else if info.Corrupt() {
+			failed = true
+			fmt.Printf("%s: %d of %d bytes valid: %v\n", filepath.Base(path),
+				info.ValidSize, info.Size, info.Err)
+		} else {
+			fmt.Printf("%s: ok\n", filepath.Base(path))
+		}
+	}
+
+	for _, path := range files.Quarantined {
+		failed = true
+		fmt.Printf("%s: quarantined after a checksum failure\n", filepath.Base(path))
+	}
+
+	for _, path := range files.Leftovers {
+		fmt.Printf("%s: unexpected file\n", filepath.Base(path))
+	}
+
+	if failed {
+		return errValidationFailed
+	}
+	return nil
+}
+
+func validateSegment(path string, logger logrus.FieldLogger) error {
+	insp, err := lsmkv.OpenSegmentInspector(path, logger)
+	if err != nil {
+		return err
+	}
+	defer insp.Close()
+
+	return insp.Validate()
+}
+
+func openSegment(path string, logger logrus.FieldLogger) error {
+	insp, err := lsmkv.OpenSegmentInspector(path, logger)
+	if err != nil {
+		return err
+	}
+	return insp.Close()
+}
+
+func walCmd(c *cli.Context) error {
+	target, err := singleArg(c)
+	if err != nil {
+		return err
+	}
+
+	paths := []string{target}
+	if stat, err := os.Stat(target); err != nil {
+		return err
+	} else if stat.IsDir() {
+		files, err := lsmkv.ListBucketFiles(target)
+		if err != nil {
+			return err
+		}
+		paths = files.WALs
+	}
+
+	secondaryIndices := uint16(c.Uint("secondary-indices"))
+	repair := c.Bool("repair")
+	for _, path := range paths {
+		var info lsmkv.WALInfo
+		if repair {
+			info, err = lsmkv.RepairWAL(path, secondaryIndices)
+		} else {
+			info, err = lsmkv.InspectWAL(path, secondaryIndices)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+
+		fmt.Printf("%s: %d bytes, %d records %v\n", filepath.Base(path), info.Size,
+			info.Records, info.RecordsByType)
+		if info.Corrupt() {
+			fmt.Printf("  corrupt after %d bytes: %v\n", info.ValidSize, info.Err)
+			if repair {
+				fmt.Printf("  truncated to %d bytes, original kept as %s\n", info.ValidSize,
+					filepath.Base(path)+".corrupt")
+			}
+		}
+	}
+	return nil
+}
+
+// formatBytes prints keys and values as strings if they are valid and
+// printable utf8, and as hex otherwise
+func formatBytes(b []byte) string {
+	if b == nil {
+		return "-"
+	}
+	if utf8.Valid(b) {
+		printable := true
+		for _, r := range string(b) {
+			if r < 0x20 || r == 0x7f {
+				printable = false
+				break
+			}
+		}
+		if printable {
+			return fmt.Sprintf("%q", b)
+		}
+	}
+	return "0x" + hex.EncodeToString(b)
+}
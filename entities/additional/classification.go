@@ -36,6 +36,24 @@ type Properties struct {
 	ExplainScore       bool                   `json:"explainScore"`
 	IsConsistent       bool                   `json:"isConsistent"`
 	Group              bool                   `json:"group"`
+	Cursor             bool                   `json:"cursor"`
+	// Highlights requests the highlighted fragments of a keyword search,
+	// nil if they are not requested
+	Highlights *Highlights `json:"highlights,omitempty"`
+
+	// RescoreMultiplier is a search parameter rather than an additional
+	// property. It is carried along with them, so that it reaches all shards,
+	// including remote ones. See searchparams.NearVector.
+	RescoreMultiplier float64 `json:"rescoreMultiplier,omitempty"`
+
+	// DistanceCursor is a search parameter as well, see RescoreMultiplier. If
+	// set, a search by distance only returns results after the cursor.
+	DistanceCursor *DistanceCursor `json:"distanceCursor,omitempty"`
+
+	// Snapshot is a search parameter as well, see RescoreMultiplier. If set,
+	// objects are returned as they were in the point-in-time snapshot opened
+	// on the shards with this token.
+	Snapshot string `json:"snapshot,omitempty"`
 
 	// The User is not interested in returning props, we can skip any costly
 	// operation that isn't required.
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+)
+
+// DistanceCursor is the position after which a search by distance continues.
+// Results of a search by distance are ordered by distance and then by id, so
+// the position is unambiguous even if several results share a distance.
+type DistanceCursor struct {
+	Distance float32     `json:"distance"`
+	ID       strfmt.UUID `json:"id"`
+}
+
+// Encode returns the opaque representation of the cursor that is handed to
+// and accepted from users
+func (c DistanceCursor) Encode() string {
+	id, _ := uuid.Parse(c.ID.String())
+	buf := make([]byte, 4+len(id))
+	binary.LittleEndian.PutUint32(buf, math.Float32bits(c.Distance))
+	copy(buf[4:], id[:])
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+// DecodeDistanceCursor parses a cursor created by DistanceCursor.Encode
+func DecodeDistanceCursor(in string) (*DistanceCursor, error) {
+	buf, err := base64.RawURLEncoding.DecodeString(in)
+	if err != nil || len(buf) != 4+16 {
+		return nil, fmt.Errorf("invalid cursor %q", in)
+	}
+	id, err := uuid.FromBytes(buf[4:])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %q: %w", in, err)
+	}
+	return &DistanceCursor{
+		Distance: math.Float32frombits(binary.LittleEndian.Uint32(buf)),
+		ID:       strfmt.UUID(id.String()),
+	}, nil
+}
+
+// Precedes reports whether the cursor comes before a result with the given
+// distance and id, i.e. whether the result belongs to the next page
+func (c DistanceCursor) Precedes(dist float32, id strfmt.UUID) bool {
+	if dist != c.Distance {
+		return dist > c.Distance
+	}
+	return CompareIDs(c.ID, id) < 0
+}
+
+// CompareIDs orders object ids the way results of a search by distance with
+// equal distances are ordered
+func CompareIDs(a, b strfmt.UUID) int {
+	return strings.Compare(strings.ToLower(a.String()), strings.ToLower(b.String()))
+}
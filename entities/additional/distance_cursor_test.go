@@ -0,0 +1,60 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+import (
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistanceCursor(t *testing.T) {
+	cursor := DistanceCursor{
+		Distance: 0.1234,
+		ID:       "7b3f0a4e-55d4-4c2f-9a47-1cf0f0d3c2aa",
+	}
+
+	t.Run("encode and decode", func(t *testing.T) {
+		decoded, err := DecodeDistanceCursor(cursor.Encode())
+		require.NoError(t, err)
+		assert.Equal(t, cursor, *decoded)
+	})
+
+	t.Run("decode invalid", func(t *testing.T) {
+		for _, in := range []string{"", "not a cursor", "AAAA"} {
+			_, err := DecodeDistanceCursor(in)
+			assert.Error(t, err, in)
+		}
+	})
+
+	t.Run("precedes", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			dist     float32
+			id       strfmt.UUID
+			expected bool
+		}{
+			{"closer", 0.1, "ffffffff-ffff-ffff-ffff-ffffffffffff", false},
+			{"further", 0.2, "00000000-0000-0000-0000-000000000000", true},
+			{"same position", cursor.Distance, cursor.ID, false},
+			{"same distance lower id", cursor.Distance, "7b3f0a4e-55d4-4c2f-9a47-1cf0f0d3c2a9", false},
+			{"same distance higher id", cursor.Distance, "7B3F0A4E-55D4-4C2F-9A47-1CF0F0D3C2AB", true},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				assert.Equal(t, tt.expected, cursor.Precedes(tt.dist, tt.id))
+			})
+		}
+	})
+}
@@ -0,0 +1,66 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package additional
+
+import "fmt"
+
+const (
+	DefaultHighlightFragmentSize = 100
+	DefaultHighlightMaxFragments = 3
+
+	// HighlightPreTag and HighlightPostTag surround the matched terms in
+	// the fragments of a highlight
+	HighlightPreTag  = "<em>"
+	HighlightPostTag = "</em>"
+)
+
+// Highlights configures the highlighting of the query terms of a keyword
+// search in the text properties of its results. Zero values fall back to
+// the defaults.
+type Highlights struct {
+	// FragmentSize is the number of characters a fragment aims for, a
+	// fragment is only longer if a single matched term is longer
+	FragmentSize int `json:"fragmentSize,omitempty"`
+	// MaxFragments limits the number of fragments per property, the
+	// fragments with the most matched terms are kept
+	MaxFragments int `json:"maxFragments,omitempty"`
+}
+
+// Validate checks the highlight options
+func (h Highlights) Validate() error {
+	if h.FragmentSize < 0 {
+		return fmt.Errorf("highlights: fragmentSize must not be negative, got %d", h.FragmentSize)
+	}
+	if h.MaxFragments < 0 {
+		return fmt.Errorf("highlights: maxFragments must not be negative, got %d", h.MaxFragments)
+	}
+	return nil
+}
+
+// WithDefaults returns the options with the defaults filled in
+func (h Highlights) WithDefaults() Highlights {
+	if h.FragmentSize == 0 {
+		h.FragmentSize = DefaultHighlightFragmentSize
+	}
+	if h.MaxFragments == 0 {
+		h.MaxFragments = DefaultHighlightMaxFragments
+	}
+	return h
+}
+
+// Highlight holds the fragments of a property containing the matched terms
+// of the query, the terms are surrounded by HighlightPreTag and
+// HighlightPostTag and the stored text is HTML-escaped
+type Highlight struct {
+	Property  string   `json:"property"`
+	Fragments []string `json:"fragments"`
+}
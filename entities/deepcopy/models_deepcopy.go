@@ -41,6 +41,11 @@ func Class(c *models.Class) *models.Class {
 			DeletionStrategy: c.ReplicationConfig.DeletionStrategy,
 		}
 	}
+	var objectTTLConf *models.ObjectTTLConfig = nil
+	if c.ObjectTTLConfig != nil {
+		conf := *c.ObjectTTLConfig
+		objectTTLConf = &conf
+	}
 
 	return &models.Class{
 		Class:               c.Class,
@@ -50,6 +55,7 @@ func Class(c *models.Class) *models.Class {
 		VectorIndexConfig:   c.VectorIndexConfig,
 		VectorIndexType:     c.VectorIndexType,
 		ReplicationConfig:   replicationConf,
+		ObjectTTLConfig:     objectTTLConf,
 		Vectorizer:          c.Vectorizer,
 		InvertedIndexConfig: InvertedIndexConfig(c.InvertedIndexConfig),
 		Properties:          properties,
@@ -66,6 +72,8 @@ func Prop(p *models.Property) *models.Property {
 		IndexFilterable:   ptrBoolCopy(p.IndexFilterable),
 		IndexSearchable:   ptrBoolCopy(p.IndexSearchable),
 		IndexRangeFilters: ptrBoolCopy(p.IndexRangeFilters),
+		IndexPositions:    ptrBoolCopy(p.IndexPositions),
+		Analyzer:          p.Analyzer,
 	}
 }
 
@@ -92,12 +100,73 @@ func InvertedIndexConfig(i *models.InvertedIndexConfig) *models.InvertedIndexCon
 		stopwords = &models.StopwordConfig{Additions: i.Stopwords.Additions, Preset: i.Stopwords.Preset, Removals: i.Stopwords.Removals}
 	}
 
+	var analyzers []*models.TextAnalyzerConfig
+	if i.Analyzers != nil {
+		analyzers = make([]*models.TextAnalyzerConfig, len(i.Analyzers))
+		for idx, analyzer := range i.Analyzers {
+			analyzers[idx] = TextAnalyzerConfig(analyzer)
+		}
+	}
+
+	var synonyms []*models.SynonymSet
+	if i.Synonyms != nil {
+		synonyms = make([]*models.SynonymSet, len(i.Synonyms))
+		for idx, set := range i.Synonyms {
+			if set != nil {
+				synonyms[idx] = &models.SynonymSet{
+					Input:    set.Input,
+					Synonyms: stringSliceCopy(set.Synonyms),
+					Type:     set.Type,
+				}
+			}
+		}
+	}
+
 	return &models.InvertedIndexConfig{
+		Analyzers:              analyzers,
 		Bm25:                   bm25,
 		CleanupIntervalSeconds: i.CleanupIntervalSeconds,
 		IndexNullState:         i.IndexNullState,
 		IndexPropertyLength:    i.IndexPropertyLength,
 		IndexTimestamps:        i.IndexTimestamps,
 		Stopwords:              stopwords,
+		Synonyms:               synonyms,
+	}
+}
+
+func TextAnalyzerConfig(a *models.TextAnalyzerConfig) *models.TextAnalyzerConfig {
+	if a == nil {
+		return nil
+	}
+
+	var filters []*models.TextAnalyzerFilter
+	if a.Filters != nil {
+		filters = make([]*models.TextAnalyzerFilter, len(a.Filters))
+		for idx, f := range a.Filters {
+			if f == nil {
+				continue
+			}
+			filters[idx] = &models.TextAnalyzerFilter{
+				Additions: stringSliceCopy(f.Additions),
+				Language:  f.Language,
+				Preset:    f.Preset,
+				Removals:  stringSliceCopy(f.Removals),
+				Synonyms:  stringSliceCopy(f.Synonyms),
+				Type:      f.Type,
+			}
+		}
+	}
+
+	return &models.TextAnalyzerConfig{
+		Filters:   filters,
+		Name:      a.Name,
+		Tokenizer: a.Tokenizer,
+	}
+}
+
+func stringSliceCopy(s []string) []string {
+	if s == nil {
+		return nil
 	}
+	return append([]string{}, s...)
 }
@@ -82,6 +82,8 @@ func IsVectorEmpty(vector models.Vector) (bool, error) {
 		return len(v) == 0, nil
 	case [][]float32:
 		return len(v) == 0, nil
+	case models.SparseVector:
+		return len(v.Indices) == 0, nil
 	default:
 		return false, fmt.Errorf("unrecognized vector type: %T", vector)
 	}
@@ -150,6 +152,9 @@ func GetVectors(in models.Vectors) (map[string][]float32, map[string][][]float32
 					multiVectors = make(map[string][][]float32)
 				}
 				multiVectors[targetVector] = vec
+			case models.SparseVector:
+				// sparse vectors are not embeddings, see GetSparseVectors
+				continue
 			default:
 				return nil, nil, fmt.Errorf("unrecognized vector type: %T for target vector: %s", vector, targetVector)
 			}
@@ -157,3 +162,18 @@ func GetVectors(in models.Vectors) (map[string][]float32, map[string][][]float32
 	}
 	return vectors, multiVectors, nil
 }
+
+// GetSparseVectors returns the sparse vectors among the given target vectors,
+// nil if there are none
+func GetSparseVectors(in models.Vectors) map[string]models.SparseVector {
+	var sparseVectors map[string]models.SparseVector
+	for targetVector, vector := range in {
+		if vec, ok := vector.(models.SparseVector); ok {
+			if sparseVectors == nil {
+				sparseVectors = make(map[string]models.SparseVector)
+			}
+			sparseVectors[targetVector] = vec
+		}
+	}
+	return sparseVectors
+}
@@ -11,9 +11,27 @@
 
 package filters
 
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
 type Cursor struct {
 	After string `json:"after"`
 	Limit int    `json:"limit"`
+	// Before optionally sets an exclusive upper bound, so that together with
+	// After only a range of UUIDs is listed
+	Before string `json:"before,omitempty"`
+	// Prefix optionally restricts the listing to UUIDs starting with the
+	// given hexadecimal prefix, e.g. "0a3f"
+	Prefix string `json:"prefix,omitempty"`
+	// Reverse lists the objects in descending UUID order. Paginating in
+	// reverse is done by passing the last UUID of a page as Before.
+	Reverse bool `json:"reverse,omitempty"`
 }
 
 // ExtractCursorFromArgs gets the limit key out of a map. Not specific to
@@ -35,3 +53,87 @@ func ExtractCursorFromArgs(args map[string]interface{}) (*Cursor, error) {
 		Limit: limit.(int),
 	}, nil
 }
+
+// KeyRange returns the inclusive bounds of the binary UUIDs covered by
+// After, Before and Prefix. ok is false if the range is empty, e.g. because
+// After and Before exclude each other.
+func (c *Cursor) KeyRange() (lower, upper []byte, ok bool, err error) {
+	lower = make([]byte, 16)
+	upper = bytes.Repeat([]byte{0xff}, 16)
+
+	if c.Prefix != "" {
+		prefix, err := parseUUIDPrefix(c.Prefix)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		// both are valid hex strings of 32 characters
+		lower, _ = hex.DecodeString(prefix + strings.Repeat("0", 32-len(prefix)))
+		upper, _ = hex.DecodeString(prefix + strings.Repeat("f", 32-len(prefix)))
+	}
+
+	if c.After != "" {
+		after, err := uuid.Parse(c.After)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("after: %w", err)
+		}
+		if !incrementKey(after[:]) {
+			return nil, nil, false, nil
+		}
+		if bytes.Compare(after[:], lower) > 0 {
+			lower = after[:]
+		}
+	}
+
+	if c.Before != "" {
+		before, err := uuid.Parse(c.Before)
+		if err != nil {
+			return nil, nil, false, fmt.Errorf("before: %w", err)
+		}
+		if !decrementKey(before[:]) {
+			return nil, nil, false, nil
+		}
+		if bytes.Compare(before[:], upper) < 0 {
+			upper = before[:]
+		}
+	}
+
+	return lower, upper, bytes.Compare(lower, upper) <= 0, nil
+}
+
+// parseUUIDPrefix returns the prefix in lower case without dashes
+func parseUUIDPrefix(in string) (string, error) {
+	prefix := strings.ToLower(strings.ReplaceAll(in, "-", ""))
+	if prefix == "" || len(prefix) > 32 {
+		return "", fmt.Errorf("must contain between 1 and 32 hexadecimal characters")
+	}
+	for _, r := range prefix {
+		if (r < '0' || r > '9') && (r < 'a' || r > 'f') {
+			return "", fmt.Errorf("invalid character %q", r)
+		}
+	}
+	return prefix, nil
+}
+
+// incrementKey returns false if the key overflows, i.e. it is the highest
+// possible key
+func incrementKey(key []byte) bool {
+	for i := len(key) - 1; i >= 0; i-- {
+		key[i]++
+		if key[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// decrementKey returns false if the key underflows, i.e. it is the lowest
+// possible key
+func decrementKey(key []byte) bool {
+	for i := len(key) - 1; i >= 0; i-- {
+		key[i]--
+		if key[i] != 0xff {
+			return true
+		}
+	}
+	return false
+}
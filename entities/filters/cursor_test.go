@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package filters
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCursorKeyRange(t *testing.T) {
+	key := func(s string) []byte {
+		b, err := hex.DecodeString(s)
+		require.Nil(t, err)
+		return b
+	}
+
+	tests := []struct {
+		name   string
+		cursor Cursor
+		lower  string
+		upper  string
+		empty  bool
+		err    bool
+	}{
+		{
+			name:   "unbounded",
+			cursor: Cursor{},
+			lower:  "00000000000000000000000000000000",
+			upper:  "ffffffffffffffffffffffffffffffff",
+		},
+		{
+			name:   "after and before are exclusive",
+			cursor: Cursor{After: "00000000-0000-0000-0000-0000000000ff", Before: "10000000-0000-0000-0000-000000000000"},
+			lower:  "00000000000000000000000000000100",
+			upper:  "0fffffffffffffffffffffffffffffff",
+		},
+		{
+			name:   "prefix",
+			cursor: Cursor{Prefix: "0A3-f"},
+			lower:  "0a3f0000000000000000000000000000",
+			upper:  "0a3fffffffffffffffffffffffffffff",
+		},
+		{
+			name:   "prefix within after",
+			cursor: Cursor{Prefix: "0a3f", After: "0a3f0000-0000-0000-0000-000000000001"},
+			lower:  "0a3f0000000000000000000000000002",
+			upper:  "0a3fffffffffffffffffffffffffffff",
+		},
+		{
+			name:   "after beyond prefix",
+			cursor: Cursor{Prefix: "0a3f", After: "0b000000-0000-0000-0000-000000000000"},
+			empty:  true,
+		},
+		{
+			name:   "after the highest key",
+			cursor: Cursor{After: "ffffffff-ffff-ffff-ffff-ffffffffffff"},
+			empty:  true,
+		},
+		{
+			name:   "before the lowest key",
+			cursor: Cursor{Before: "00000000-0000-0000-0000-000000000000"},
+			empty:  true,
+		},
+		{
+			name:   "invalid prefix",
+			cursor: Cursor{Prefix: "0x3f"},
+			err:    true,
+		},
+		{
+			name:   "prefix too long",
+			cursor: Cursor{Prefix: "0a3f0000000000000000000000000000a"},
+			err:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lower, upper, ok, err := tt.cursor.KeyRange()
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.Nil(t, err)
+			if tt.empty {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, key(tt.lower), lower)
+			assert.Equal(t, key(tt.upper), upper)
+		})
+	}
+}
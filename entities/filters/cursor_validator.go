@@ -42,6 +42,16 @@ func ValidateCursor(className schema.ClassName, cursor *Cursor, offset int, filt
 			return errors.Wrapf(err, "after parameter '%s' is not a valid uuid", cursor.After)
 		}
 	}
+	if cursor.Before != "" {
+		if _, err := uuid.Parse(cursor.Before); err != nil {
+			return errors.Wrapf(err, "before parameter '%s' is not a valid uuid", cursor.Before)
+		}
+	}
+	if cursor.Prefix != "" {
+		if _, err := parseUUIDPrefix(cursor.Prefix); err != nil {
+			return errors.Wrapf(err, "prefix parameter '%s' is not a valid uuid prefix", cursor.Prefix)
+		}
+	}
 	if cursor.Limit < 0 {
 		return fmt.Errorf("limit parameter must be set")
 	}
@@ -94,6 +94,15 @@ func validateClause(authorizedGetClass func(string) (*models.Class, error), cw *
 		return nil
 	}
 
+	if cw.getOperator() == OperatorFuzzy {
+		switch dt, _ := schema.AsPrimitive(prop.DataType); dt {
+		case schema.DataTypeText, schema.DataTypeTextArray:
+		default:
+			return errors.Errorf("operator Fuzzy is only supported on text/text[] properties, "+
+				"property %q is of type %q", propName, prop.DataType[0])
+		}
+	}
+
 	if isPropLengthFilter {
 		if !cw.isType(schema.DataTypeInt) {
 			return errors.Errorf("Filtering for property length requires IntValue, got %q instead",
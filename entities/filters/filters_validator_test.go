@@ -227,6 +227,48 @@ func TestValidateUUIDFilter(t *testing.T) {
 	}
 }
 
+func TestValidateFuzzyFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		prop  schema.PropertyName
+		valid bool
+	}{
+		{name: "text", prop: "name", valid: true},
+		{name: "text[]", prop: "names", valid: true},
+		{name: "int", prop: "horsepower", valid: false},
+		{name: "uuid", prop: "my_id", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cl := Clause{
+				Operator: OperatorFuzzy,
+				Value:    &Value{Value: "wether", Type: schema.DataTypeText},
+				On:       &Path{Class: "Car", Property: tt.prop},
+			}
+
+			f := &fakeFinder{}
+			f.On("ReadOnlyClass", mock.Anything).Return(
+				&models.Class{
+					Class: "Car",
+					Properties: []*models.Property{
+						{Name: "name", DataType: []string{string(schema.DataTypeText)}},
+						{Name: "names", DataType: []string{string(schema.DataTypeTextArray)}},
+						{Name: "horsepower", DataType: []string{string(schema.DataTypeInt)}},
+						{Name: "my_id", DataType: []string{string(schema.DataTypeUUID)}},
+					},
+				},
+			)
+			err := validateClause(f.ReadOnlyClass, newClauseWrapper(&cl))
+			if tt.valid {
+				require.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+			}
+		})
+	}
+}
+
 func TestClauseWrapper(t *testing.T) {
 	type testCase struct {
 		name         string
@@ -18,6 +18,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -29,6 +30,9 @@ import (
 // swagger:model InvertedIndexConfig
 type InvertedIndexConfig struct {
 
+	// Named text analyzers, each combining a tokenizer with a chain of token filters. Properties reference an analyzer by name to use it at index and query time.
+	Analyzers []*TextAnalyzerConfig `json:"analyzers,omitempty"`
+
 	// bm25
 	Bm25 *BM25Config `json:"bm25,omitempty"`
 
@@ -46,12 +50,19 @@ type InvertedIndexConfig struct {
 
 	// stopwords
 	Stopwords *StopwordConfig `json:"stopwords,omitempty"`
+
+	// Synonym sets used to expand the terms of keyword queries and text filters. Synonyms are applied at query time only and can be updated without reindexing.
+	Synonyms []*SynonymSet `json:"synonyms,omitempty"`
 }
 
 // Validate validates this inverted index config
 func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAnalyzers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateBm25(formats); err != nil {
 		res = append(res, err)
 	}
@@ -60,12 +71,42 @@ func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateSynonyms(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *InvertedIndexConfig) validateAnalyzers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Analyzers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Analyzers); i++ {
+		if swag.IsZero(m.Analyzers[i]) { // not required
+			continue
+		}
+
+		if m.Analyzers[i] != nil {
+			if err := m.Analyzers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("analyzers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("analyzers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InvertedIndexConfig) validateBm25(formats strfmt.Registry) error {
 	if swag.IsZero(m.Bm25) { // not required
 		return nil
@@ -104,10 +145,40 @@ func (m *InvertedIndexConfig) validateStopwords(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *InvertedIndexConfig) validateSynonyms(formats strfmt.Registry) error {
+	if swag.IsZero(m.Synonyms) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Synonyms); i++ {
+		if swag.IsZero(m.Synonyms[i]) { // not required
+			continue
+		}
+
+		if m.Synonyms[i] != nil {
+			if err := m.Synonyms[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this inverted index config based on the context it is used
 func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateAnalyzers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateBm25(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -116,12 +187,36 @@ func (m *InvertedIndexConfig) ContextValidate(ctx context.Context, formats strfm
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSynonyms(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *InvertedIndexConfig) contextValidateAnalyzers(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Analyzers); i++ {
+
+		if m.Analyzers[i] != nil {
+			if err := m.Analyzers[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("analyzers" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("analyzers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *InvertedIndexConfig) contextValidateBm25(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Bm25 != nil {
@@ -154,6 +249,26 @@ func (m *InvertedIndexConfig) contextValidateStopwords(ctx context.Context, form
 	return nil
 }
 
+func (m *InvertedIndexConfig) contextValidateSynonyms(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Synonyms); i++ {
+
+		if m.Synonyms[i] != nil {
+			if err := m.Synonyms[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("synonyms" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *InvertedIndexConfig) MarshalBinary() ([]byte, error) {
 	if m == nil {
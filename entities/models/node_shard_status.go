@@ -18,7 +18,9 @@ package models
 
 import (
 	"context"
+	"strconv"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 )
@@ -43,6 +45,9 @@ type NodeShardStatus struct {
 	// The number of objects in shard.
 	ObjectCount int64 `json:"objectCount"`
 
+	// Online migrations of the vector indexes of the shard that are in progress or failed.
+	VectorIndexMigrations []*NodeShardVectorIndexMigration `json:"vectorIndexMigrations,omitempty"`
+
 	// The status of the vector indexing process.
 	VectorIndexingStatus string `json:"vectorIndexingStatus"`
 
@@ -52,11 +57,75 @@ type NodeShardStatus struct {
 
 // Validate validates this node shard status
 func (m *NodeShardStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateVectorIndexMigrations(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) validateVectorIndexMigrations(formats strfmt.Registry) error {
+	if swag.IsZero(m.VectorIndexMigrations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.VectorIndexMigrations); i++ {
+		if swag.IsZero(m.VectorIndexMigrations[i]) { // not required
+			continue
+		}
+
+		if m.VectorIndexMigrations[i] != nil {
+			if err := m.VectorIndexMigrations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorIndexMigrations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorIndexMigrations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
-// ContextValidate validates this node shard status based on context it is used
+// ContextValidate validate this node shard status based on the context it is used
 func (m *NodeShardStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateVectorIndexMigrations(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NodeShardStatus) contextValidateVectorIndexMigrations(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.VectorIndexMigrations); i++ {
+
+		if m.VectorIndexMigrations[i] != nil {
+			if err := m.VectorIndexMigrations[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("vectorIndexMigrations" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("vectorIndexMigrations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -32,6 +32,9 @@ import (
 // swagger:model Property
 type Property struct {
 
+	// Name of a text analyzer defined in the class' `invertedIndexConfig.analyzers`, used instead of the plain tokenization at index and query time. Applies to text and text[] data types.
+	Analyzer string `json:"analyzer,omitempty"`
+
 	// Data type of the property (required). If it starts with a capital (for example Person), may be a reference to another type.
 	DataType []string `json:"dataType"`
 
@@ -44,6 +47,9 @@ type Property struct {
 	// (Deprecated). Whether to include this property in the inverted index. If `false`, this property cannot be used in `where` filters, `bm25` or `hybrid` search. <br/><br/>Unrelated to vectorization behavior (deprecated as of v1.19; use indexFilterable or/and indexSearchable instead)
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Whether to store token positions for this property in the inverted index. Enables `phrase` and `slop` (proximity) operators in `bm25` and `hybrid` search. Applicable only to properties of data type text and text[] with a searchable index. Defaults to false.
+	IndexPositions *bool `json:"indexPositions,omitempty"`
+
 	// Whether to include this property in the filterable, range-based Roaring Bitmap index. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date.
 	IndexRangeFilters *bool `json:"indexRangeFilters,omitempty"`
 
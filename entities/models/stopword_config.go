@@ -31,7 +31,7 @@ type StopwordConfig struct {
 	// Stopwords to be considered additionally (default: []). Can be any array of custom strings.
 	Additions []string `json:"additions"`
 
-	// Pre-existing list of common words by language (default: 'en'). Options: ['en', 'none'].
+	// Pre-existing list of common words by language (default: 'en'). Options: ['en', 'de', 'fr', 'es', 'it', 'pt', 'nl', 'none'].
 	Preset string `json:"preset,omitempty"`
 
 	// Stopwords to be removed from consideration (default: []). Can be any array of custom strings.
@@ -0,0 +1,65 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// SynonymSet A set of synonyms applied to keyword queries and text filters at query time. Entries are tokenized like the searched property, entries which do not result in a single token are ignored
+//
+// swagger:model SynonymSet
+type SynonymSet struct {
+
+	// Term expanded by a `oneWay` set. Must be empty for `equivalent` sets.
+	Input string `json:"input,omitempty"`
+
+	// Terms of the set. In an `equivalent` set all terms are synonyms of each other, in a `oneWay` set they are added to queries containing the input.
+	Synonyms []string `json:"synonyms"`
+
+	// Type of the set (default: 'equivalent'). Options: ['equivalent', 'oneWay'].
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this synonym set
+func (m *SynonymSet) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this synonym set based on context it is used
+func (m *SynonymSet) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SynonymSet) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SynonymSet) UnmarshalBinary(b []byte) error {
+	var res SynonymSet
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// TextAnalyzerFilter A token filter of a text analyzer
+//
+// swagger:model TextAnalyzerFilter
+type TextAnalyzerFilter struct {
+
+	// Stopwords removed by the `stopwords` filter in addition to the preset.
+	Additions []string `json:"additions"`
+
+	// Language of the `stemmer` filter, e.g. 'english', 'german', 'french', 'spanish'.
+	Language string `json:"language,omitempty"`
+
+	// Stopword preset of the `stopwords` filter, e.g. 'en', 'de', 'fr', 'es'.
+	Preset string `json:"preset,omitempty"`
+
+	// Stopwords of the preset kept by the `stopwords` filter.
+	Removals []string `json:"removals"`
+
+	// Synonym groups of the `synonyms` filter, each a comma separated list of equivalent terms. All terms of a group are replaced with the first term of the group.
+	Synonyms []string `json:"synonyms"`
+
+	// Type of the filter. Options: ['lowercase', 'asciiFolding', 'stemmer', 'stopwords', 'synonyms'].
+	Type string `json:"type,omitempty"`
+}
+
+// Validate validates this text analyzer filter
+func (m *TextAnalyzerFilter) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this text analyzer filter based on context it is used
+func (m *TextAnalyzerFilter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TextAnalyzerFilter) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TextAnalyzerFilter) UnmarshalBinary(b []byte) error {
+	var res TextAnalyzerFilter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
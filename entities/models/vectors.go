@@ -17,6 +17,7 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -65,8 +66,63 @@ func (v *Vectors) UnmarshalJSON(data []byte) error {
 				}
 				continue
 			}
-			return fmt.Errorf("vectors: cannot unmarshal vector into either []float32 or [][]float32 for target vector %s", targetVector)
+			// An object can only be a SparseVector, which reports what is wrong with it
+			if trimmed := bytes.TrimSpace(rawMessage); len(trimmed) > 0 && trimmed[0] == '{' {
+				var sparseVector SparseVector
+				if err := json.Unmarshal(rawMessage, &sparseVector); err != nil {
+					return fmt.Errorf("vectors: target vector %s: %w", targetVector, err)
+				}
+				if len(sparseVector.Indices) > 0 {
+					(*v)[targetVector] = sparseVector
+				}
+				continue
+			}
+			return fmt.Errorf("vectors: cannot unmarshal vector into either []float32, [][]float32 or a sparse vector for target vector %s", targetVector)
 		}
 	}
 	return nil
 }
+
+// SparseVector is a vector of which only the non-zero dimensions are given,
+// as pairs of the dimension index and its weight
+type SparseVector struct {
+	Indices []uint32  `json:"indices"`
+	Values  []float32 `json:"values"`
+}
+
+// UnmarshalJSON requires both the indices and the values, with a value for
+// every index
+func (s *SparseVector) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("sparse vector: %w", err)
+	}
+	for key := range raw {
+		if key != "indices" && key != "values" {
+			return fmt.Errorf("sparse vector: unknown field %q", key)
+		}
+	}
+	rawIndices, ok := raw["indices"]
+	if !ok {
+		return fmt.Errorf("sparse vector: missing field %q", "indices")
+	}
+	rawValues, ok := raw["values"]
+	if !ok {
+		return fmt.Errorf("sparse vector: missing field %q", "values")
+	}
+
+	var indices []uint32
+	if err := json.Unmarshal(rawIndices, &indices); err != nil {
+		return fmt.Errorf("sparse vector: indices: %w", err)
+	}
+	var values []float32
+	if err := json.Unmarshal(rawValues, &values); err != nil {
+		return fmt.Errorf("sparse vector: values: %w", err)
+	}
+	if len(indices) != len(values) {
+		return fmt.Errorf("sparse vector: %d indices but %d values", len(indices), len(values))
+	}
+
+	s.Indices, s.Values = indices, values
+	return nil
+}
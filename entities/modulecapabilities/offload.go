@@ -32,3 +32,17 @@ type OffloadCloud interface {
 	// {cloud_provider}://{configured_bucket}/{className}/{shardName}/{nodeName}/{shard content}
 	Delete(ctx context.Context, className, shardName, nodeName string) error
 }
+
+// OffloadSegments is implemented by offload modules that can also act as the
+// backend of tiered lsmkv storage, where individual segments are moved to
+// the cloud provider instead of entire shards
+type OffloadSegments interface {
+	// UploadSegment uploads a single file, overwriting any existing object
+	// {cloud_provider}://{configured_bucket}/segments/{key}
+	UploadSegment(ctx context.Context, key, localPath string) error
+	// DownloadSegment downloads a single object to the local path
+	DownloadSegment(ctx context.Context, key, localPath string) error
+	// DeleteSegment deletes a single object, it does not error if the object
+	// does not exist
+	DeleteSegment(ctx context.Context, key string) error
+}
@@ -124,6 +124,12 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to false. Provides better performance for range queries compared to filterable index in large datasets. Applicable only to properties of data type int, number, date."
 	IndexRangeFilters bool `json:"indexRangeFilters,omitempty"`
 
+	// Optional. Should token positions be stored in the inverted index. Defaults to false. Enables phrase and proximity operators in bm25 and hybrid search. Applicable only to properties of data type text and text[] with a searchable index.
+	IndexPositions bool `json:"indexPositions,omitempty"`
+
+	// Name of the text analyzer of the property, defined in the invertedIndexConfig of the class. Optional. Applies to text and text[] data types.
+	Analyzer string `json:"analyzer,omitempty"`
+
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig map[string]interface{} `json:"moduleConfig,omitempty"`
 
@@ -248,10 +254,14 @@ func PropertyFromModel(m models.Property) Property {
 	} else {
 		p.IndexRangeFilters = false
 	}
+	if m.IndexPositions != nil {
+		p.IndexPositions = *m.IndexPositions
+	}
 	if v, ok := m.ModuleConfig.(map[string]interface{}); ok {
 		p.ModuleConfig = v
 	}
 	p.Tokenization = m.Tokenization
+	p.Analyzer = m.Analyzer
 	if len(m.NestedProperties) > 0 {
 		p.NestedProperties = make([]NestedProperty, 0, len(m.NestedProperties))
 		for _, npm := range m.NestedProperties {
@@ -279,9 +289,15 @@ func PropertyToModel(p Property) models.Property {
 	m.IndexSearchable = &indexSearchable
 	indexRangeFilters := p.IndexRangeFilters
 	m.IndexRangeFilters = &indexRangeFilters
+	// IndexPositions is optional and disabled by default, keep it unset
+	if p.IndexPositions {
+		indexPositions := p.IndexPositions
+		m.IndexPositions = &indexPositions
+	}
 	m.ModuleConfig = p.ModuleConfig
 	m.Name = p.Name
 	m.Tokenization = p.Tokenization
+	m.Analyzer = p.Analyzer
 	if len(p.NestedProperties) > 0 {
 		m.NestedProperties = make([]*models.NestedProperty, 0, len(p.NestedProperties))
 		for _, np := range p.NestedProperties {
@@ -22,6 +22,8 @@ type InvertedIndexConfig struct {
 	IndexTimestamps        bool
 	IndexNullState         bool
 	IndexPropertyLength    bool
+	Analyzers              []*models.TextAnalyzerConfig
+	Synonyms               []*models.SynonymSet
 }
 
 type BM25Config struct {
@@ -43,6 +45,8 @@ func InvertedIndexConfigFromModel(m models.InvertedIndexConfig) InvertedIndexCon
 	i.IndexTimestamps = m.IndexTimestamps
 	i.IndexNullState = m.IndexNullState
 	i.IndexPropertyLength = m.IndexPropertyLength
+	i.Analyzers = m.Analyzers
+	i.Synonyms = m.Synonyms
 
 	return i
 }
@@ -62,6 +66,8 @@ func InvertedIndexConfigToModel(i InvertedIndexConfig) models.InvertedIndexConfi
 	m.IndexTimestamps = i.IndexTimestamps
 	m.IndexNullState = i.IndexNullState
 	m.IndexPropertyLength = i.IndexPropertyLength
+	m.Analyzers = i.Analyzers
+	m.Synonyms = i.Synonyms
 
 	return m
 }
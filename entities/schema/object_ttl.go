@@ -0,0 +1,35 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package schema
+
+import "github.com/weaviate/weaviate/entities/models"
+
+func ObjectTTLEnabled(class *models.Class) bool {
+	if class == nil {
+		return false
+	}
+
+	if class.ObjectTTLConfig != nil {
+		return class.ObjectTTLConfig.Enabled
+	}
+	return false
+}
+
+// ObjectTTLDeleteOn returns the reference time expiry is based on, falling
+// back to the creation time if none is configured
+func ObjectTTLDeleteOn(class *models.Class) string {
+	if class == nil || class.ObjectTTLConfig == nil ||
+		class.ObjectTTLConfig.DeleteOn == "" {
+		return models.ObjectTTLConfigDeleteOnCreationTime
+	}
+	return class.ObjectTTLConfig.DeleteOn
+}
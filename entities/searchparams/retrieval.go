@@ -15,6 +15,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/search"
 
 	"github.com/weaviate/weaviate/entities/models"
@@ -27,6 +28,22 @@ type NearVector struct {
 	WithDistance  bool            `json:"-"`
 	Vectors       []models.Vector `json:"vectors"`
 	TargetVectors []string        `json:"targetVectors"`
+	// RescoreMultiplier overrides how many candidates a vector index that
+	// searches on compressed vectors rescores with the uncompressed vectors,
+	// limit * RescoreMultiplier. 0 keeps the default of the index.
+	RescoreMultiplier float64 `json:"rescoreMultiplier"`
+	// Cursor continues a search by distance after the last result of the
+	// previous page
+	Cursor *additional.DistanceCursor `json:"cursor,omitempty"`
+}
+
+// ValidateRescoreMultiplier checks the rescore multiplier of a vector search,
+// 0 means it isn't set
+func ValidateRescoreMultiplier(multiplier float64) error {
+	if multiplier != 0 && multiplier < 1 {
+		return fmt.Errorf("rescoreMultiplier must be at least 1, got %v", multiplier)
+	}
+	return nil
 }
 
 type KeywordRanking struct {
@@ -34,6 +51,42 @@ type KeywordRanking struct {
 	Properties             []string `json:"properties"`
 	Query                  string   `json:"query"`
 	AdditionalExplanations bool     `json:"additionalExplanations"`
+	// Phrase restricts the results to objects containing the query terms as
+	// an exact phrase, requires properties with a position index
+	Phrase bool `json:"phrase"`
+	// Slop relaxes a phrase query to objects containing all query terms
+	// within a window of Slop extra tokens, in any order
+	Slop int `json:"slop"`
+	// Fuzziness additionally matches indexed terms within this Levenshtein
+	// edit distance of the query terms, 0 disables fuzzy matching
+	Fuzziness int `json:"fuzziness"`
+}
+
+// MaxSlop is the largest slop of a phrase query. The positions of the
+// elements of a text[] property are further apart than that, so that a
+// relaxed phrase never matches across elements.
+const MaxSlop = 99
+
+// ValidatePhrase checks the phrase and slop operators of a keyword search
+func (k *KeywordRanking) ValidatePhrase() error {
+	if k.Slop < 0 || k.Slop > MaxSlop {
+		return fmt.Errorf("slop must be between 0 and %d, got %d", MaxSlop, k.Slop)
+	}
+	if k.Slop > 0 && !k.Phrase {
+		return fmt.Errorf("slop can only be set together with phrase")
+	}
+	return nil
+}
+
+// ValidateFuzziness checks the edit distance of a fuzzy keyword search
+func (k *KeywordRanking) ValidateFuzziness() error {
+	if k.Fuzziness < 0 || k.Fuzziness > 2 {
+		return fmt.Errorf("fuzziness must be between 0 and 2, got %d", k.Fuzziness)
+	}
+	if k.Fuzziness > 0 && k.Phrase {
+		return fmt.Errorf("fuzziness cannot be combined with phrase")
+	}
+	return nil
 }
 
 // Indicates whether property should be indexed
@@ -110,6 +163,14 @@ type HybridSearch struct {
 	WithDistance     bool          `json:"withDistance"`
 	NearTextParams   *NearTextParams
 	NearVectorParams *NearVector
+	// NearSparseVectorParams replaces the keyword search with a search on a
+	// sparse target vector, the vectors are of type models.SparseVector
+	NearSparseVectorParams *NearVector
+	// Phrase and Slop are passed on to the keyword search, see KeywordRanking
+	Phrase bool `json:"phrase"`
+	Slop   int  `json:"slop"`
+	// Fuzziness is passed on to the keyword search, see KeywordRanking
+	Fuzziness int `json:"fuzziness"`
 }
 
 type NearObject struct {
@@ -119,6 +180,8 @@ type NearObject struct {
 	Distance      float64  `json:"distance"`
 	WithDistance  bool     `json:"-"`
 	TargetVectors []string `json:"targetVectors"`
+	// RescoreMultiplier see NearVector
+	RescoreMultiplier float64 `json:"rescoreMultiplier"`
 }
 
 type ObjectMove struct {
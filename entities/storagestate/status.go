@@ -19,8 +19,10 @@ import (
 const (
 	StatusReadOnly Status = "READONLY"
 	StatusIndexing Status = "INDEXING"
-	StatusLoading  Status = "LOADING"
-	StatusReady    Status = "READY"
+	// StatusLoading is reported while a shard is initializing, as well as
+	// during a bulk load. Queries against a loading shard are rejected.
+	StatusLoading Status = "LOADING"
+	StatusReady   Status = "READY"
 )
 
 var ErrStatusReadOnlyWithReason = func(reason string) error {
@@ -44,6 +46,8 @@ func ValidateStatus(in string) (status Status, err error) {
 		status = StatusReadOnly
 	case string(StatusIndexing):
 		status = StatusIndexing
+	case string(StatusLoading):
+		status = StatusLoading
 	case string(StatusReady):
 		status = StatusReady
 	default:
@@ -42,6 +42,7 @@ func TestStatusValidation(t *testing.T) {
 			{"READONLY", StatusReadOnly},
 			{"READY", StatusReady},
 			{"INDEXING", StatusIndexing},
+			{"LOADING", StatusLoading},
 		}
 
 		for _, test := range tests {
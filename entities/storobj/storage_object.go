@@ -27,10 +27,12 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/vmihailenco/msgpack/v5"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
 	errwrap "github.com/weaviate/weaviate/entities/errors"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 	"github.com/weaviate/weaviate/usecases/byteops"
 )
 
@@ -57,6 +59,12 @@ type Object struct {
 	DocID             uint64
 	Vectors           map[string][]float32   `json:"vectors"`
 	MultiVectors      map[string][][]float32 `json:"multivectors"`
+	// VectorDataTypes holds the data type target vectors are stored as, if it
+	// is not float32. It is set by the shard based on the vector index config.
+	VectorDataTypes map[string]string `json:"-"`
+	// SparseVectors holds the target vectors of which only the non-zero
+	// dimensions are stored
+	SparseVectors map[string]models.SparseVector `json:"sparsevectors"`
 }
 
 func New(docID uint64) *Object {
@@ -103,6 +111,7 @@ func FromObject(object *models.Object, vector []float32, vectors map[string][]fl
 		VectorLen:         len(vector),
 		Vectors:           vecs,
 		MultiVectors:      multiVectors,
+		SparseVectors:     dto.GetSparseVectors(object.Vectors),
 	}
 }
 
@@ -204,11 +213,12 @@ func FromBinaryOptional(data []byte,
 	vectorWeights := rw.ReadBytesFromBuffer(uint64(vectorWeightsLength))
 
 	if len(addProp.Vectors) > 0 {
-		vectors, err := unmarshalTargetVectors(&rw)
+		vectors, dataTypes, err := unmarshalTargetVectors(&rw)
 		if err != nil {
 			return nil, err
 		}
 		ko.Vectors = vectors
+		ko.VectorDataTypes = dataTypes
 
 		if vectors != nil {
 			ko.Object.Vectors = make(models.Vectors)
@@ -238,6 +248,16 @@ func FromBinaryOptional(data []byte,
 					return nil, errors.Wrap(err, "Could not unmarshal multivectors")
 				}
 			}
+		} else {
+			rw.MoveBufferPositionForward(uint64(multiVectorsLength))
+		}
+
+		if len(addProp.Vectors) > 0 {
+			sparseVectors, err := unmarshalSparseVectors(&rw)
+			if err != nil {
+				return nil, err
+			}
+			ko.SparseVectors = sparseVectors
 		}
 	}
 
@@ -526,6 +546,9 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		if additional.Group {
 			additionalProperties["group"] = ko.AdditionalProperties()["group"]
 		}
+		if additional.Highlights != nil {
+			additionalProperties["highlights"] = ko.AdditionalProperties()["highlights"]
+		}
 	}
 	if ko.ExplainScore() != "" {
 		additionalProperties["explainScore"] = ko.ExplainScore()
@@ -537,7 +560,7 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
-		Vectors:   ko.asVectors(ko.Vectors, ko.MultiVectors),
+		Vectors:   ko.asVectors(ko.Vectors, ko.MultiVectors, ko.SparseVectors),
 		Dims:      ko.VectorLen,
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
@@ -551,8 +574,10 @@ func (ko *Object) SearchResult(additional additional.Properties, tenant string)
 	}
 }
 
-func (ko *Object) asVectors(vectors map[string][]float32, multiVectors map[string][][]float32) models.Vectors {
-	if (len(vectors) + len(multiVectors)) > 0 {
+func (ko *Object) asVectors(vectors map[string][]float32, multiVectors map[string][][]float32,
+	sparseVectors map[string]models.SparseVector,
+) models.Vectors {
+	if (len(vectors) + len(multiVectors) + len(sparseVectors)) > 0 {
 		out := make(models.Vectors)
 		for targetVector, vector := range vectors {
 			out[targetVector] = vector
@@ -560,6 +585,9 @@ func (ko *Object) asVectors(vectors map[string][]float32, multiVectors map[strin
 		for targetVector, vector := range multiVectors {
 			out[targetVector] = vector
 		}
+		for targetVector, vector := range sparseVectors {
+			out[targetVector] = vector
+		}
 		return out
 	}
 	return nil
@@ -694,6 +722,15 @@ func DocIDAndTimeFromBinary(in []byte) (docID uint64, updateTime int64, err erro
 // n          | uint16+[]byte | target vectors segment: sequence of vec_length + vec (uint16 + []byte), (uint16 + []byte) ...
 // 4          | uint32        | length of multivectors as msgpack
 // n          | []byte        | multivectors as msgpack
+// 4          | uint32        | length of target vector data types as msgpack (optional)
+// n          | []byte        | target vector data types as msgpack { name : data_type }
+// 4          | uint32        | length of sparse vectors as msgpack (optional)
+// n          | []byte        | sparse vectors as msgpack { name : { indices, values } }
+//
+// The target vector data types are only present if at least one target vector
+// is not stored as float32. The vec bytes of such a vector are encoded with
+// its data type instead. If there are sparse vectors, the length of the data
+// types is written even if it is 0.
 
 const (
 	maxVectorLength               int = math.MaxUint16
@@ -766,6 +803,7 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	var targetVectorsSegmentLength int
 
 	targetVectorsOffsetOrder := make([]string, 0, len(ko.Vectors))
+	var dataTypes map[string]string
 	if len(ko.Vectors) > 0 {
 		offsetsMap := map[string]uint32{}
 		for name, vec := range ko.Vectors {
@@ -773,8 +811,19 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 				return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "vector", len(vec), maxVectorLength)
 			}
 
+			dataType := ko.vectorDataType(name)
+			if err := vectorIndexCommon.ValidateVector(dataType, vec); err != nil {
+				return nil, errors.Wrapf(err, "could not marshal target vector %q", name)
+			}
+			if dataType != vectorIndexCommon.DataTypeFloat32 {
+				if dataTypes == nil {
+					dataTypes = map[string]string{}
+				}
+				dataTypes[name] = dataType
+			}
+
 			offsetsMap[name] = uint32(targetVectorsSegmentLength)
-			targetVectorsSegmentLength += 2 + 4*len(vec) // 2 for vec length + vec bytes
+			targetVectorsSegmentLength += 2 + vectorIndexCommon.DataTypeSize(dataType)*len(vec) // 2 for vec length + vec bytes
 
 			if targetVectorsSegmentLength > maxTargetVectorsSegmentLength {
 				return nil,
@@ -806,6 +855,25 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	var dataTypesPacked []byte
+	if len(dataTypes) > 0 {
+		dataTypesPacked, err = msgpack.Marshal(dataTypes)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal target vector data types: %w", err)
+		}
+	}
+
+	var sparseVectorsPacked []byte
+	if len(ko.SparseVectors) > 0 {
+		sparseVectorsPacked, err = msgpack.Marshal(ko.SparseVectors)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal sparse vectors: %w", err)
+		}
+		if len(sparseVectorsPacked) > maxTargetVectorsSegmentLength {
+			return nil, fmt.Errorf("could not marshal '%s' max length exceeded (%d/%d)", "sparseVectors", len(sparseVectorsPacked), maxTargetVectorsSegmentLength)
+		}
+	}
+
 	totalBufferLength := 1 + 8 + 1 + 16 + 8 + 8 +
 		2 + vectorLength*4 +
 		2 + classNameLength +
@@ -815,6 +883,12 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		4 + targetVectorsOffsetsLength +
 		4 + uint32(targetVectorsSegmentLength) +
 		4 + uint32(len(multiVectorsPacked)) // multivectors
+	if len(dataTypesPacked) > 0 || len(sparseVectorsPacked) > 0 {
+		totalBufferLength += 4 + uint32(len(dataTypesPacked))
+	}
+	if len(sparseVectorsPacked) > 0 {
+		totalBufferLength += 4 + uint32(len(sparseVectorsPacked))
+	}
 
 	byteBuffer := make([]byte, totalBufferLength)
 	rw := byteops.NewReadWriter(byteBuffer)
@@ -870,6 +944,13 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		vecLen := len(vec)
 
 		rw.WriteUint16(uint16(vecLen))
+		if dataType, ok := dataTypes[name]; ok {
+			err = rw.CopyBytesToBuffer(vectorIndexCommon.EncodeVector(dataType, vec, nil))
+			if err != nil {
+				return byteBuffer, errors.Wrap(err, "Could not copy target vector")
+			}
+			continue
+		}
 		for j := 0; j < vecLen; j++ {
 			rw.WriteUint32(math.Float32bits(vec[j]))
 		}
@@ -883,9 +964,32 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 		}
 	}
 
+	if len(dataTypesPacked) > 0 || len(sparseVectorsPacked) > 0 {
+		rw.WriteUint32(uint32(len(dataTypesPacked)))
+		err = rw.CopyBytesToBuffer(dataTypesPacked)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy target vector data types")
+		}
+	}
+
+	if len(sparseVectorsPacked) > 0 {
+		rw.WriteUint32(uint32(len(sparseVectorsPacked)))
+		err = rw.CopyBytesToBuffer(sparseVectorsPacked)
+		if err != nil {
+			return byteBuffer, errors.Wrap(err, "Could not copy sparse vectors")
+		}
+	}
+
 	return byteBuffer, nil
 }
 
+func (ko *Object) vectorDataType(targetVector string) string {
+	if dataType, ok := ko.VectorDataTypes[targetVector]; ok && dataType != "" {
+		return dataType
+	}
+	return vectorIndexCommon.DataTypeFloat32
+}
+
 // UnmarshalPropertiesFromObject only unmarshals and returns the properties part of the object
 //
 // Check MarshalBinary for the order of elements in the input array
@@ -1068,11 +1172,12 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		return errors.Wrap(err, "Could not copy vectorWeights")
 	}
 
-	vectors, err := unmarshalTargetVectors(&rw)
+	vectors, dataTypes, err := unmarshalTargetVectors(&rw)
 	if err != nil {
 		return err
 	}
 	ko.Vectors = vectors
+	ko.VectorDataTypes = dataTypes
 
 	if rw.Position < uint64(len(rw.Buffer)) {
 		multivectorsLength := rw.ReadUint32()
@@ -1085,6 +1190,12 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 				return errors.Wrap(err, "Could not unmarshal multivectors")
 			}
 		}
+
+		sparseVectors, err := unmarshalSparseVectors(&rw)
+		if err != nil {
+			return err
+		}
+		ko.SparseVectors = sparseVectors
 	}
 
 	return ko.parseObject(
@@ -1098,7 +1209,7 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	)
 }
 
-func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error) {
+func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, map[string]string, error) {
 	// This check prevents from panic when somebody is upgrading from version that
 	// didn't have multi vector support. This check is needed bc with named vectors
 	// feature storage object can have vectors data appended at the end of the file
@@ -1110,13 +1221,23 @@ func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error
 		if len(targetVectorsOffsets) > 0 {
 			var tvOffsets map[string]uint32
 			if err := msgpack.Unmarshal(targetVectorsOffsets, &tvOffsets); err != nil {
-				return nil, fmt.Errorf("Could not unmarshal target vectors offset: %w", err)
+				return nil, nil, fmt.Errorf("Could not unmarshal target vectors offset: %w", err)
+			}
+
+			dataTypes, err := unmarshalTargetVectorDataTypes(rw.Buffer, pos+uint64(targetVectorsSegmentLength))
+			if err != nil {
+				return nil, nil, err
 			}
 
 			targetVectors := map[string][]float32{}
 			for name, offset := range tvOffsets {
 				rw.MoveBufferToAbsolutePosition(pos + uint64(offset))
 				vecLen := rw.ReadUint16()
+				if dataType, ok := dataTypes[name]; ok {
+					vecBytes := rw.ReadBytesFromBuffer(uint64(vecLen) * uint64(vectorIndexCommon.DataTypeSize(dataType)))
+					targetVectors[name] = vectorIndexCommon.DecodeVector(dataType, vecBytes, nil)
+					continue
+				}
 				vec := make([]float32, vecLen)
 				for j := uint16(0); j < vecLen; j++ {
 					vec[j] = math.Float32frombits(rw.ReadUint32())
@@ -1125,10 +1246,63 @@ func unmarshalTargetVectors(rw *byteops.ReadWriter) (map[string][]float32, error
 			}
 
 			rw.MoveBufferToAbsolutePosition(pos + uint64(targetVectorsSegmentLength))
-			return targetVectors, nil
+			return targetVectors, dataTypes, nil
 		}
 	}
-	return nil, nil
+	return nil, nil, nil
+}
+
+// unmarshalTargetVectorDataTypes reads the optional data types segment that
+// follows the multivectors. They are needed to decode the target vectors,
+// which come first, so the segment is located by skipping ahead.
+func unmarshalTargetVectorDataTypes(data []byte, multiVectorsPos uint64) (map[string]string, error) {
+	if multiVectorsPos+4 > uint64(len(data)) {
+		return nil, nil
+	}
+	multiVectorsLength := uint64(binary.LittleEndian.Uint32(data[multiVectorsPos:]))
+	pos := multiVectorsPos + 4 + multiVectorsLength
+	if pos+4 > uint64(len(data)) {
+		return nil, nil
+	}
+	dataTypesLength := uint64(binary.LittleEndian.Uint32(data[pos:]))
+	if pos+4+dataTypesLength > uint64(len(data)) {
+		return nil, fmt.Errorf("target vector data types exceed object length")
+	}
+	if dataTypesLength == 0 {
+		// only written as a placeholder for the sparse vectors that follow
+		return nil, nil
+	}
+
+	var dataTypes map[string]string
+	if err := msgpack.Unmarshal(data[pos+4:pos+4+dataTypesLength], &dataTypes); err != nil {
+		return nil, fmt.Errorf("Could not unmarshal target vector data types: %w", err)
+	}
+	return dataTypes, nil
+}
+
+// unmarshalSparseVectors reads the optional sparse vectors segment, rw must be
+// positioned after the multivectors
+func unmarshalSparseVectors(rw *byteops.ReadWriter) (map[string]models.SparseVector, error) {
+	if rw.Position+4 > uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+	// skip the target vector data types, they are read with the target vectors
+	dataTypesLength := rw.ReadUint32()
+	rw.MoveBufferPositionForward(uint64(dataTypesLength))
+
+	if rw.Position+4 > uint64(len(rw.Buffer)) {
+		return nil, nil
+	}
+	sparseVectorsLength := uint64(rw.ReadUint32())
+	if rw.Position+sparseVectorsLength > uint64(len(rw.Buffer)) {
+		return nil, fmt.Errorf("sparse vectors exceed object length")
+	}
+
+	var sparseVectors map[string]models.SparseVector
+	if err := msgpack.Unmarshal(rw.ReadBytesFromBuffer(sparseVectorsLength), &sparseVectors); err != nil {
+		return nil, errors.Wrap(err, "Could not unmarshal sparse vectors")
+	}
+	return sparseVectors, nil
 }
 
 func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float32, error) {
@@ -1160,7 +1334,7 @@ func VectorFromBinary(in []byte, buffer []float32, targetVector string) ([]float
 		vectorWeightsLength := uint64(rw.ReadUint32())
 		rw.MoveBufferPositionForward(vectorWeightsLength)
 
-		targetVectors, err := unmarshalTargetVectors(&rw)
+		targetVectors, _, err := unmarshalTargetVectors(&rw)
 		if err != nil {
 			return nil, errors.Errorf("unable to unmarshal vector for target vector: %s", targetVector)
 		}
@@ -1383,6 +1557,8 @@ func (ko *Object) DeepCopyDangerous() *Object {
 		Vector:            deepCopyVector(ko.Vector),
 		Vectors:           deepCopyVectorsMap(ko.Vectors),
 		MultiVectors:      deepCopyMultiVectorsMap(ko.MultiVectors),
+		VectorDataTypes:   deepCopyDataTypes(ko.VectorDataTypes),
+		SparseVectors:     deepCopySparseVectorsMap(ko.SparseVectors),
 	}
 
 	return o
@@ -1415,6 +1591,8 @@ func deepCopyVectors(orig models.Vectors) models.Vectors {
 			out[key] = deepCopyVector(v)
 		case [][]float32:
 			out[key] = deepCopyMultiVector(v)
+		case models.SparseVector:
+			out[key] = deepCopySparseVector(v)
 		default:
 			// do nothing
 		}
@@ -1506,3 +1684,32 @@ func deepCopyMRef(orig models.MultipleRef) models.MultipleRef {
 
 	return out
 }
+
+func deepCopyDataTypes(orig map[string]string) map[string]string {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string]string, len(orig))
+	for k, v := range orig {
+		out[k] = v
+	}
+	return out
+}
+
+func deepCopySparseVector(orig models.SparseVector) models.SparseVector {
+	return models.SparseVector{
+		Indices: append([]uint32(nil), orig.Indices...),
+		Values:  append([]float32(nil), orig.Values...),
+	}
+}
+
+func deepCopySparseVectorsMap(orig map[string]models.SparseVector) map[string]models.SparseVector {
+	if orig == nil {
+		return nil
+	}
+	out := make(map[string]models.SparseVector, len(orig))
+	for k, v := range orig {
+		out[k] = deepCopySparseVector(v)
+	}
+	return out
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/weaviate/weaviate/entities/additional"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
+	vectorIndexCommon "github.com/weaviate/weaviate/entities/vectorindex/common"
 )
 
 func TestStorageObjectMarshalling(t *testing.T) {
@@ -1021,6 +1022,155 @@ func TestVectorFromBinary(t *testing.T) {
 	assert.Equal(t, vector3, outVector3)
 }
 
+func TestVectorDataTypesMarshalling(t *testing.T) {
+	build := func(dataTypes map[string]string) *Object {
+		obj := FromObject(
+			&models.Object{
+				Class:              "MyFavoriteClass",
+				CreationTimeUnix:   123456,
+				LastUpdateTimeUnix: 56789,
+				ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+				Properties: map[string]interface{}{
+					"name": "MyName",
+				},
+			},
+			[]float32{1, 2, 0.7},
+			map[string][]float32{
+				"half":  {0.5, -1.25, 3},
+				"bhalf": {0.5, -1.25, 3},
+				"int8":  {-7, 0, 120},
+				"full":  {0.1, 0.2, 0.3},
+			},
+			map[string][][]float32{
+				"multi": {{1, 2}, {3, 4}},
+			},
+		)
+		obj.DocID = 7
+		obj.VectorDataTypes = dataTypes
+		return obj
+	}
+
+	before := build(map[string]string{
+		"half":  vectorIndexCommon.DataTypeFloat16,
+		"bhalf": vectorIndexCommon.DataTypeBFloat16,
+		"int8":  vectorIndexCommon.DataTypeInt8,
+	})
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("unmarshal", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+		assert.Equal(t, before.VectorDataTypes, after.VectorDataTypes)
+		assert.Equal(t, before.Object.Properties, after.Object.Properties)
+	})
+
+	t.Run("unmarshal optional", func(t *testing.T) {
+		after, err := FromBinaryOptional(asBinary,
+			additional.Properties{Vectors: []string{"half"}}, nil)
+		require.Nil(t, err)
+		assert.Equal(t, before.Vectors, after.Vectors)
+		assert.Equal(t, before.MultiVectors, after.MultiVectors)
+	})
+
+	t.Run("vector from binary", func(t *testing.T) {
+		for name, vec := range before.Vectors {
+			out, err := VectorFromBinary(asBinary, nil, name)
+			require.Nil(t, err)
+			assert.Equal(t, vec, out, name)
+		}
+	})
+
+	t.Run("values are rounded to the data type", func(t *testing.T) {
+		obj := build(map[string]string{"full": vectorIndexCommon.DataTypeBFloat16})
+		asBinary, err := obj.MarshalBinary()
+		require.Nil(t, err)
+
+		out, err := VectorFromBinary(asBinary, nil, "full")
+		require.Nil(t, err)
+		assert.Equal(t, []float32{0.100097656, 0.20019531, 0.30078125}, out)
+	})
+
+	t.Run("int8 vectors must hold integers", func(t *testing.T) {
+		obj := build(map[string]string{"full": vectorIndexCommon.DataTypeInt8})
+		_, err := obj.MarshalBinary()
+		assert.ErrorContains(t, err, `could not marshal target vector "full"`)
+	})
+
+	t.Run("narrower data types take less space", func(t *testing.T) {
+		obj := build(nil)
+		obj.Vectors["full"] = make([]float32, 256)
+		asFloat32Binary, err := obj.MarshalBinary()
+		require.Nil(t, err)
+
+		obj.VectorDataTypes = map[string]string{"full": vectorIndexCommon.DataTypeInt8}
+		asInt8Binary, err := obj.MarshalBinary()
+		require.Nil(t, err)
+
+		// 3 bytes saved per dimension minus the data types segment
+		assert.Less(t, len(asInt8Binary), len(asFloat32Binary)-3*256+32)
+	})
+}
+
+func TestSparseVectorsMarshalling(t *testing.T) {
+	sparse := models.SparseVector{Indices: []uint32{3, 17, 40000}, Values: []float32{0.5, 1.25, 0.01}}
+	build := func(dataTypes map[string]string) *Object {
+		obj := FromObject(
+			&models.Object{
+				Class: "MyFavoriteClass",
+				ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+				Properties: map[string]interface{}{
+					"name": "MyName",
+				},
+				Vectors: models.Vectors{"sparse": sparse},
+			},
+			nil,
+			map[string][]float32{"dense": {0.5, -1.25, 3}},
+			map[string][][]float32{"multi": {{1, 2}, {3, 4}}},
+		)
+		obj.DocID = 7
+		obj.VectorDataTypes = dataTypes
+		return obj
+	}
+
+	for name, dataTypes := range map[string]map[string]string{
+		"without data types": nil,
+		"with data types":    {"dense": vectorIndexCommon.DataTypeFloat16},
+	} {
+		t.Run(name, func(t *testing.T) {
+			before := build(dataTypes)
+			require.Equal(t, map[string]models.SparseVector{"sparse": sparse}, before.SparseVectors)
+
+			asBinary, err := before.MarshalBinary()
+			require.Nil(t, err)
+
+			after, err := FromBinary(asBinary)
+			require.Nil(t, err)
+			assert.Equal(t, before.Vectors, after.Vectors)
+			assert.Equal(t, before.MultiVectors, after.MultiVectors)
+			assert.Equal(t, before.VectorDataTypes, after.VectorDataTypes)
+			assert.Equal(t, before.SparseVectors, after.SparseVectors)
+
+			optional, err := FromBinaryOptional(asBinary,
+				additional.Properties{Vectors: []string{"sparse"}}, nil)
+			require.Nil(t, err)
+			assert.Equal(t, before.Vectors, optional.Vectors)
+			assert.Equal(t, before.SparseVectors, optional.SparseVectors)
+			assert.Equal(t, sparse, optional.SearchResult(additional.Properties{}, "").Vectors["sparse"])
+
+			withoutVectors, err := FromBinaryOptional(asBinary, additional.Properties{}, nil)
+			require.Nil(t, err)
+			assert.Nil(t, withoutVectors.SparseVectors)
+
+			out, err := VectorFromBinary(asBinary, nil, "dense")
+			require.Nil(t, err)
+			assert.Equal(t, before.Vectors["dense"], out)
+		})
+	}
+}
+
 func TestMultiVectorFromBinary(t *testing.T) {
 	vector1 := [][]float32{{1, 2, 3}, {4, 5, 6}}
 	vector2 := [][]float32{{4, 5, 6}, {7, 8, 9}}
@@ -67,6 +67,35 @@ func OptionalIntFromMap(in map[string]interface{}, name string,
 	return nil
 }
 
+// OptionalFloatFromMap parses a float value from the map, numbers can either
+// be json.Number or float64 depending on where the config was read from
+func OptionalFloatFromMap(in map[string]interface{}, name string,
+	setFn func(v float64),
+) error {
+	value, ok := in[name]
+	if !ok {
+		return nil
+	}
+
+	var asFloat64 float64
+	var err error
+
+	switch typed := value.(type) {
+	case json.Number:
+		asFloat64, err = typed.Float64()
+	case float64:
+		asFloat64 = typed
+	default:
+		return nil
+	}
+	if err != nil {
+		return errors.Wrapf(err, "json.Number to float64 for %q", name)
+	}
+
+	setFn(asFloat64)
+	return nil
+}
+
 func OptionalBoolFromMap(in map[string]interface{}, name string,
 	setFn func(v bool),
 ) error {
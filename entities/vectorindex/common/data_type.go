@@ -0,0 +1,248 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+)
+
+const (
+	DataTypeFloat32  = "float32"
+	DataTypeFloat16  = "float16"
+	DataTypeBFloat16 = "bfloat16"
+	DataTypeInt8     = "int8"
+
+	DefaultDataType = DataTypeFloat32
+)
+
+// ValidateDataType returns an error if dataType is not one of the supported
+// vector storage types
+func ValidateDataType(dataType string) error {
+	switch dataType {
+	case DataTypeFloat32, DataTypeFloat16, DataTypeBFloat16, DataTypeInt8:
+		return nil
+	default:
+		return fmt.Errorf("invalid dataType %q, must be one of %q, %q, %q or %q", dataType,
+			DataTypeFloat32, DataTypeFloat16, DataTypeBFloat16, DataTypeInt8)
+	}
+}
+
+// ValidateDataTypeForDistance is like ValidateDataType, but also rejects data
+// types that can't represent the vectors the distance operates on
+func ValidateDataTypeForDistance(dataType, distance string) error {
+	if err := ValidateDataType(dataType); err != nil {
+		return err
+	}
+	if dataType == DataTypeInt8 && distance == DistanceCosine {
+		// cosine vectors are normalized before they are stored, which leaves
+		// nothing but zeros after rounding to integers
+		return fmt.Errorf("dataType %q can't be used with distance %q", dataType, distance)
+	}
+	return nil
+}
+
+// DataTypeSize is the number of bytes a single dimension occupies when stored
+// as dataType. Unknown types are treated as float32.
+func DataTypeSize(dataType string) int {
+	switch dataType {
+	case DataTypeFloat16, DataTypeBFloat16:
+		return 2
+	case DataTypeInt8:
+		return 1
+	default:
+		return 4
+	}
+}
+
+// ValidateVector returns an error if vec can't be stored as dataType without
+// changing its values beyond rounding. int8 only stores integers, so unlike
+// for the float types, rounding would silently turn vectors of unrelated
+// values into the same or zero vectors.
+func ValidateVector(dataType string, vec []float32) error {
+	if dataType != DataTypeInt8 {
+		return nil
+	}
+	for i, v := range vec {
+		if v != float32(math.Trunc(float64(v))) || v < math.MinInt8 || v > math.MaxInt8 {
+			return fmt.Errorf("dataType %q requires integers in [%d, %d], got %v at position %d",
+				dataType, math.MinInt8, math.MaxInt8, v, i)
+		}
+	}
+	return nil
+}
+
+// EncodeVector stores vec as dataType in little endian byte order. The result
+// is written to buf if it is large enough.
+//
+// float16 and bfloat16 round to nearest even. Vectors from models that output
+// these types natively therefore round trip without loss. int8 vectors are
+// expected to pass ValidateVector, other values are rounded to the nearest
+// integer and clamped to [-128, 127].
+func EncodeVector(dataType string, vec []float32, buf []byte) []byte {
+	size := len(vec) * DataTypeSize(dataType)
+	if cap(buf) < size {
+		buf = make([]byte, size)
+	}
+	buf = buf[:size]
+
+	switch dataType {
+	case DataTypeFloat16:
+		for i, v := range vec {
+			binary.LittleEndian.PutUint16(buf[i*2:], Float32ToFloat16(v))
+		}
+	case DataTypeBFloat16:
+		for i, v := range vec {
+			binary.LittleEndian.PutUint16(buf[i*2:], Float32ToBFloat16(v))
+		}
+	case DataTypeInt8:
+		for i, v := range vec {
+			buf[i] = byte(Float32ToInt8(v))
+		}
+	default:
+		for i, v := range vec {
+			binary.LittleEndian.PutUint32(buf[i*4:], math.Float32bits(v))
+		}
+	}
+	return buf
+}
+
+// DecodeVector is the inverse of EncodeVector. The result is written to buf if
+// it is large enough.
+func DecodeVector(dataType string, data []byte, buf []float32) []float32 {
+	dims := len(data) / DataTypeSize(dataType)
+	if cap(buf) < dims {
+		buf = make([]float32, dims)
+	}
+	buf = buf[:dims]
+
+	switch dataType {
+	case DataTypeFloat16:
+		for i := range buf {
+			buf[i] = Float16ToFloat32(binary.LittleEndian.Uint16(data[i*2:]))
+		}
+	case DataTypeBFloat16:
+		for i := range buf {
+			buf[i] = BFloat16ToFloat32(binary.LittleEndian.Uint16(data[i*2:]))
+		}
+	case DataTypeInt8:
+		for i := range buf {
+			buf[i] = float32(int8(data[i]))
+		}
+	default:
+		for i := range buf {
+			buf[i] = math.Float32frombits(binary.LittleEndian.Uint32(data[i*4:]))
+		}
+	}
+	return buf
+}
+
+// Float32ToFloat16 converts f to the bits of an IEEE 754 half precision float,
+// rounding to nearest even. Values out of range become +/-Inf.
+func Float32ToFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	sign := uint16(bits>>16) & 0x8000
+	exp := int32(bits>>23) & 0xff
+	mant := bits & 0x7fffff
+
+	switch {
+	case exp == 0xff:
+		// Inf or NaN, keep NaNs quiet
+		if mant != 0 {
+			return sign | 0x7e00
+		}
+		return sign | 0x7c00
+	case exp > 127+15:
+		return sign | 0x7c00
+	case exp < 127-14:
+		// subnormal or zero in half precision
+		if exp < 127-14-11 {
+			return sign
+		}
+		mant |= 0x800000
+		shift := uint32(127 - 14 - exp + 13)
+		half := uint16(mant >> shift)
+		rem := mant & (1<<shift - 1)
+		halfway := uint32(1) << (shift - 1)
+		if rem > halfway || (rem == halfway && half&1 == 1) {
+			half++
+		}
+		return sign | half
+	}
+
+	half := uint32(exp-127+15)<<10 | mant>>13
+	rem := mant & 0x1fff
+	if rem > 0x1000 || (rem == 0x1000 && half&1 == 1) {
+		// a carry into the exponent is the correct result, including Inf
+		half++
+	}
+	return sign | uint16(half)
+}
+
+// Float16ToFloat32 converts the bits of an IEEE 754 half precision float to a
+// float32. The conversion is exact.
+func Float16ToFloat32(h uint16) float32 {
+	sign := uint32(h&0x8000) << 16
+	exp := uint32(h>>10) & 0x1f
+	mant := uint32(h & 0x3ff)
+
+	switch {
+	case exp == 0x1f:
+		return math.Float32frombits(sign | 0x7f800000 | mant<<13)
+	case exp == 0:
+		if mant == 0 {
+			return math.Float32frombits(sign)
+		}
+		// normalize the subnormal
+		exp = 127 - 14
+		for mant&0x400 == 0 {
+			mant <<= 1
+			exp--
+		}
+		mant &= 0x3ff
+		return math.Float32frombits(sign | exp<<23 | mant<<13)
+	}
+	return math.Float32frombits(sign | (exp+127-15)<<23 | mant<<13)
+}
+
+// Float32ToBFloat16 converts f to the bits of a bfloat16, which keeps the
+// exponent of a float32 and the upper 7 bits of its mantissa, rounding to
+// nearest even.
+func Float32ToBFloat16(f float32) uint16 {
+	bits := math.Float32bits(f)
+	if bits&0x7fffffff > 0x7f800000 {
+		// NaN, make sure truncation does not turn it into Inf
+		return uint16(bits>>16) | 0x40
+	}
+	bits += 0x7fff + (bits>>16)&1
+	return uint16(bits >> 16)
+}
+
+// BFloat16ToFloat32 converts the bits of a bfloat16 to a float32. The
+// conversion is exact.
+func BFloat16ToFloat32(b uint16) float32 {
+	return math.Float32frombits(uint32(b) << 16)
+}
+
+// Float32ToInt8 rounds f to the nearest integer and clamps it to the int8
+// range.
+func Float32ToInt8(f float32) int8 {
+	r := math.Round(float64(f))
+	if r > math.MaxInt8 {
+		return math.MaxInt8
+	}
+	if r < math.MinInt8 {
+		return math.MinInt8
+	}
+	return int8(r)
+}
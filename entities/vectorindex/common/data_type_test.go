@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package common
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFloat16Conversion(t *testing.T) {
+	// every finite half precision value must survive a round trip through
+	// float32
+	for h := 0; h <= math.MaxUint16; h++ {
+		f := Float16ToFloat32(uint16(h))
+		if math.IsNaN(float64(f)) {
+			continue
+		}
+		require.Equal(t, uint16(h), Float32ToFloat16(f), "bits %#04x", h)
+	}
+
+	tests := []struct {
+		in       float32
+		expected uint16
+	}{
+		{in: 1, expected: 0x3c00},
+		{in: -2, expected: 0xc000},
+		{in: 65504, expected: 0x7bff},
+		{in: 70000, expected: 0x7c00},
+		{in: 1.0 / 16777216, expected: 0x0001},
+		{in: 1.0 / 16777216 / 2, expected: 0x0000},
+		// 1 + 2^-11 is exactly halfway between 1 and the next half, round to even
+		{in: 1 + 1.0/2048, expected: 0x3c00},
+		{in: 1 + 3.0/2048, expected: 0x3c02},
+	}
+	for _, test := range tests {
+		assert.Equal(t, test.expected, Float32ToFloat16(test.in), "input %v", test.in)
+	}
+}
+
+func TestBFloat16Conversion(t *testing.T) {
+	for b := 0; b <= math.MaxUint16; b++ {
+		f := BFloat16ToFloat32(uint16(b))
+		if math.IsNaN(float64(f)) {
+			assert.True(t, math.IsNaN(float64(BFloat16ToFloat32(Float32ToBFloat16(f)))))
+			continue
+		}
+		require.Equal(t, uint16(b), Float32ToBFloat16(f), "bits %#04x", b)
+	}
+
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16(1))
+	assert.Equal(t, uint16(0x3f80), Float32ToBFloat16(math.Float32frombits(0x3f808000)))
+	assert.Equal(t, uint16(0x3f82), Float32ToBFloat16(math.Float32frombits(0x3f818000)))
+}
+
+func TestEncodeDecodeVector(t *testing.T) {
+	vec := []float32{-130, -1.4, 0, 0.5, 1.6, 3, 127.2, 200}
+
+	tests := []struct {
+		dataType string
+		expected []float32
+	}{
+		{dataType: DataTypeFloat32, expected: vec},
+		{dataType: DataTypeFloat16, expected: []float32{-130, -1.4003906, 0, 0.5, 1.5996094, 3, 127.1875, 200}},
+		{dataType: DataTypeBFloat16, expected: []float32{-130, -1.3984375, 0, 0.5, 1.6015625, 3, 127, 200}},
+		{dataType: DataTypeInt8, expected: []float32{-128, -1, 0, 1, 2, 3, 127, 127}},
+	}
+	for _, test := range tests {
+		t.Run(test.dataType, func(t *testing.T) {
+			encoded := EncodeVector(test.dataType, vec, nil)
+			assert.Len(t, encoded, len(vec)*DataTypeSize(test.dataType))
+			assert.Equal(t, test.expected, DecodeVector(test.dataType, encoded, nil))
+
+			// decoding the encoded vector again must not change it
+			assert.Equal(t, encoded, EncodeVector(test.dataType, DecodeVector(test.dataType, encoded, nil), nil))
+		})
+	}
+}
+
+func TestValidateVector(t *testing.T) {
+	for _, dataType := range []string{DataTypeFloat32, DataTypeFloat16, DataTypeBFloat16} {
+		assert.Nil(t, ValidateVector(dataType, []float32{-130, -1.4, 0.5, 200}))
+	}
+
+	assert.Nil(t, ValidateVector(DataTypeInt8, []float32{-128, -1, 0, 3, 127}))
+	for _, vec := range [][]float32{
+		{0, 1.5},
+		{0, -0.1},
+		{0, 128},
+		{0, -129},
+		{float32(math.NaN())},
+		{float32(math.Inf(1))},
+	} {
+		assert.ErrorContains(t, ValidateVector(DataTypeInt8, vec),
+			`dataType "int8" requires integers in [-128, 127]`, vec)
+	}
+}
+
+func TestValidateDataType(t *testing.T) {
+	for _, dataType := range []string{DataTypeFloat32, DataTypeFloat16, DataTypeBFloat16, DataTypeInt8} {
+		assert.Nil(t, ValidateDataType(dataType))
+	}
+	assert.EqualError(t, ValidateDataType("float64"),
+		`invalid dataType "float64", must be one of "float32", "float16", "bfloat16" or "int8"`)
+}
@@ -15,9 +15,13 @@ import (
 	"fmt"
 
 	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 )
 
 const (
@@ -25,6 +29,9 @@ const (
 	VectorIndexTypeHNSW    = "hnsw"
 	VectorIndexTypeFLAT    = "flat"
 	VectorIndexTypeDYNAMIC = "dynamic"
+	VectorIndexTypeDISKANN = "diskann"
+	VectorIndexTypeIVF     = "ivf"
+	VectorIndexTypeSPARSE  = "sparse"
 )
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -41,7 +48,27 @@ func ParseAndValidateConfig(input interface{}, vectorIndexType string, isMultiVe
 		return flat.ParseAndValidateConfig(input)
 	case VectorIndexTypeDYNAMIC:
 		return dynamic.ParseAndValidateConfig(input, isMultiVector)
+	case VectorIndexTypeDISKANN:
+		return diskann.ParseAndValidateConfig(input, isMultiVector)
+	case VectorIndexTypeIVF:
+		return ivf.ParseAndValidateConfig(input, isMultiVector)
+	case VectorIndexTypeSPARSE:
+		return sparse.ParseAndValidateConfig(input, isMultiVector)
 	default:
-		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw and flat", vectorIndexType)
+		return nil, fmt.Errorf("invalid vector index %q. Supported types are hnsw, flat, dynamic, diskann, ivf and sparse", vectorIndexType)
 	}
 }
+
+// DataType returns the type the vectors of an index with the given config are
+// stored as. Index types without a dataType setting store float32 vectors.
+func DataType(cfg schemaConfig.VectorIndexConfig) string {
+	switch typed := cfg.(type) {
+	case hnsw.UserConfig:
+		return typed.DataType
+	case flat.UserConfig:
+		return typed.DataType
+	case dynamic.UserConfig:
+		return typed.HnswUC.DataType
+	}
+	return common.DataTypeFloat32
+}
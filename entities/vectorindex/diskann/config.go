@@ -0,0 +1,208 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"fmt"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultMaxDegree        = 64
+	DefaultSearchListSize   = 100
+	DefaultBuildListSize    = 128
+	DefaultAlpha            = 1.2
+	DefaultFlatSearchCutoff = 40000
+	DefaultPQSegments       = 0 // indicates "let Weaviate pick"
+	DefaultPQCentroids      = 256
+	DefaultPQTrainingLimit  = 100000
+)
+
+// PQConfig controls the product quantization of the vectors which are held
+// in memory. Unlike hnsw, compression cannot be turned off: once the index
+// holds TrainingLimit vectors, the codebook is trained and from then on only
+// the codes are kept in memory while the full vectors stay on disk.
+type PQConfig struct {
+	Segments      int `json:"segments"`
+	Centroids     int `json:"centroids"`
+	TrainingLimit int `json:"trainingLimit"`
+}
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of a disk-resident (Vamana) vector index
+type UserConfig struct {
+	Distance         string   `json:"distance"`
+	MaxDegree        int      `json:"maxDegree"`
+	SearchListSize   int      `json:"searchListSize"`
+	BuildListSize    int      `json:"buildListSize"`
+	Alpha            float64  `json:"alpha"`
+	FlatSearchCutoff int      `json:"flatSearchCutoff"`
+	PQ               PQConfig `json:"pq"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "diskann"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+func (u UserConfig) IsMultiVector() bool {
+	return false
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorindexcommon.DefaultDistanceMetric
+	u.MaxDegree = DefaultMaxDegree
+	u.SearchListSize = DefaultSearchListSize
+	u.BuildListSize = DefaultBuildListSize
+	u.Alpha = DefaultAlpha
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.PQ = PQConfig{
+		Segments:      DefaultPQSegments,
+		Centroids:     DefaultPQCentroids,
+		TrainingLimit: DefaultPQTrainingLimit,
+	}
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if isMultiVector {
+		return uc, fmt.Errorf("multi vectors are not supported by the diskann index")
+	}
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "maxDegree", func(v int) {
+		uc.MaxDegree = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "searchListSize", func(v int) {
+		uc.SearchListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "buildListSize", func(v int) {
+		uc.BuildListSize = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalFloatFromMap(asMap, "alpha", func(v float64) {
+		uc.Alpha = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
+		uc.FlatSearchCutoff = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := parsePQMap(asMap, &uc.PQ); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
+	pqConfigValue, ok := in["pq"]
+	if !ok {
+		return nil
+	}
+
+	pqConfigMap, ok := pqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(pqConfigMap, "segments", func(v int) {
+		pq.Segments = v
+	}); err != nil {
+		return err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(pqConfigMap, "centroids", func(v int) {
+		pq.Centroids = v
+	}); err != nil {
+		return err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(pqConfigMap, "trainingLimit", func(v int) {
+		pq.TrainingLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u UserConfig) validate() error {
+	if u.MaxDegree < 2 {
+		return fmt.Errorf("maxDegree must be at least 2, got %d", u.MaxDegree)
+	}
+	if u.SearchListSize < 1 {
+		return fmt.Errorf("searchListSize must be a positive integer, got %d", u.SearchListSize)
+	}
+	if u.BuildListSize < u.MaxDegree {
+		return fmt.Errorf("buildListSize (%d) must not be smaller than maxDegree (%d)",
+			u.BuildListSize, u.MaxDegree)
+	}
+	if u.Alpha < 1 {
+		return fmt.Errorf("alpha must be at least 1, got %v", u.Alpha)
+	}
+	if u.PQ.Segments < 0 {
+		return fmt.Errorf("pq.segments cannot be negative")
+	}
+	if u.PQ.Centroids < 2 || u.PQ.Centroids > 256 {
+		return fmt.Errorf("pq.centroids must be between 2 and 256, got %d", u.PQ.Centroids)
+	}
+	if u.PQ.TrainingLimit < u.PQ.Centroids {
+		return fmt.Errorf("pq.trainingLimit (%d) must not be smaller than pq.centroids (%d)",
+			u.PQ.TrainingLimit, u.PQ.Centroids)
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package diskann
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_DiskANNUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		multiVector  bool
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance:         common.DefaultDistanceMetric,
+				MaxDegree:        DefaultMaxDegree,
+				SearchListSize:   DefaultSearchListSize,
+				BuildListSize:    DefaultBuildListSize,
+				Alpha:            DefaultAlpha,
+				FlatSearchCutoff: DefaultFlatSearchCutoff,
+				PQ: PQConfig{
+					Segments:      DefaultPQSegments,
+					Centroids:     DefaultPQCentroids,
+					TrainingLimit: DefaultPQTrainingLimit,
+				},
+			},
+		},
+		{
+			name: "all fields specified",
+			input: map[string]interface{}{
+				"distance":         "l2-squared",
+				"maxDegree":        float64(32),
+				"searchListSize":   json.Number("50"),
+				"buildListSize":    float64(64),
+				"alpha":            json.Number("1.4"),
+				"flatSearchCutoff": float64(1000),
+				"pq": map[string]interface{}{
+					"segments":      float64(16),
+					"centroids":     float64(128),
+					"trainingLimit": float64(5000),
+				},
+			},
+			expected: UserConfig{
+				Distance:         "l2-squared",
+				MaxDegree:        32,
+				SearchListSize:   50,
+				BuildListSize:    64,
+				Alpha:            1.4,
+				FlatSearchCutoff: 1000,
+				PQ: PQConfig{
+					Segments:      16,
+					Centroids:     128,
+					TrainingLimit: 5000,
+				},
+			},
+		},
+		{
+			name: "build list smaller than max degree",
+			input: map[string]interface{}{
+				"maxDegree":     float64(32),
+				"buildListSize": float64(16),
+			},
+			expectErr:    true,
+			expectErrMsg: "buildListSize (16) must not be smaller than maxDegree (32)",
+		},
+		{
+			name: "alpha below 1",
+			input: map[string]interface{}{
+				"alpha": float64(0.9),
+			},
+			expectErr:    true,
+			expectErrMsg: "alpha must be at least 1",
+		},
+		{
+			name: "too many centroids",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"centroids": float64(512),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "centroids",
+		},
+		{
+			name:         "multi vector",
+			input:        nil,
+			multiVector:  true,
+			expectErr:    true,
+			expectErrMsg: "multi vectors are not supported",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input, test.multiVector)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
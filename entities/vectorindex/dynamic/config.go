@@ -101,20 +101,25 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig
 	}
 
 	flatConfig, ok := asMap["flat"]
-	if !ok || flatConfig == nil {
-		return uc, nil
-	}
+	if ok && flatConfig != nil {
+		flatUC, err := flat.ParseAndValidateConfig(flatConfig)
+		if err != nil {
+			return uc, err
+		}
 
-	flatUC, err := flat.ParseAndValidateConfig(flatConfig)
-	if err != nil {
-		return uc, err
+		castedFlatUC, ok := flatUC.(flat.UserConfig)
+		if !ok {
+			return uc, fmt.Errorf("invalid flat configuration")
+		}
+		uc.FlatUC = castedFlatUC
 	}
 
-	castedFlatUC, ok := flatUC.(flat.UserConfig)
-	if !ok {
-		return uc, fmt.Errorf("invalid flat configuration")
+	// vectors are stored with the objects using the data type, so it must not
+	// change when the index is upgraded from flat to hnsw
+	if uc.HnswUC.DataType != uc.FlatUC.DataType {
+		return uc, fmt.Errorf("hnsw and flat dataType must match, got %q and %q",
+			uc.HnswUC.DataType, uc.FlatUC.DataType)
 	}
-	uc.FlatUC = castedFlatUC
 
 	return uc, nil
 }
@@ -49,6 +49,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
 					Distance:               common.DefaultDistanceMetric,
+					DataType:               common.DefaultDataType,
 					PQ: hnsw.PQConfig{
 						Enabled:       hnsw.DefaultPQEnabled,
 						Segments:      hnsw.DefaultPQSegments,
@@ -64,15 +65,33 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						Muvera: hnsw.MuveraConfig{
+							Enabled:      hnsw.DefaultMuveraEnabled,
+							KSim:         hnsw.DefaultMuveraKSim,
+							DProjections: hnsw.DefaultMuveraDProjections,
+							Repetitions:  hnsw.DefaultMuveraRepetitions,
+						},
+					},
+					RecallProbe: hnsw.RecallProbeConfig{
+						Enabled:         hnsw.DefaultRecallProbeEnabled,
+						IntervalSeconds: hnsw.DefaultRecallProbeIntervalSeconds,
+						SampleSize:      hnsw.DefaultRecallProbeSampleSize,
+						K:               hnsw.DefaultRecallProbeK,
 					},
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
 					Distance:              common.DefaultDistanceMetric,
+					DataType:              common.DefaultDataType,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
 						RescoreLimit: flat.DefaultCompressionRescore,
@@ -88,6 +107,12 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Cache:        flat.DefaultVectorCache,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -111,6 +136,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
 					Distance:               common.DefaultDistanceMetric,
+					DataType:               common.DefaultDataType,
 					PQ: hnsw.PQConfig{
 						Enabled:       hnsw.DefaultPQEnabled,
 						Segments:      hnsw.DefaultPQSegments,
@@ -126,15 +152,33 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						Muvera: hnsw.MuveraConfig{
+							Enabled:      hnsw.DefaultMuveraEnabled,
+							KSim:         hnsw.DefaultMuveraKSim,
+							DProjections: hnsw.DefaultMuveraDProjections,
+							Repetitions:  hnsw.DefaultMuveraRepetitions,
+						},
+					},
+					RecallProbe: hnsw.RecallProbeConfig{
+						Enabled:         hnsw.DefaultRecallProbeEnabled,
+						IntervalSeconds: hnsw.DefaultRecallProbeIntervalSeconds,
+						SampleSize:      hnsw.DefaultRecallProbeSampleSize,
+						K:               hnsw.DefaultRecallProbeK,
 					},
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
 					Distance:              common.DefaultDistanceMetric,
+					DataType:              common.DefaultDataType,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
 						RescoreLimit: flat.DefaultCompressionRescore,
@@ -150,6 +194,12 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Cache:        flat.DefaultVectorCache,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -193,6 +243,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 					DynamicEFMax:           18,
 					DynamicEFFactor:        19,
 					Distance:               common.DefaultDistanceMetric,
+					DataType:               common.DefaultDataType,
 					PQ: hnsw.PQConfig{
 						Enabled:       true,
 						Segments:      64,
@@ -208,15 +259,33 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.FilterStrategyAcorn,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						Muvera: hnsw.MuveraConfig{
+							Enabled:      hnsw.DefaultMuveraEnabled,
+							KSim:         hnsw.DefaultMuveraKSim,
+							DProjections: hnsw.DefaultMuveraDProjections,
+							Repetitions:  hnsw.DefaultMuveraRepetitions,
+						},
+					},
+					RecallProbe: hnsw.RecallProbeConfig{
+						Enabled:         hnsw.DefaultRecallProbeEnabled,
+						IntervalSeconds: hnsw.DefaultRecallProbeIntervalSeconds,
+						SampleSize:      hnsw.DefaultRecallProbeSampleSize,
+						K:               hnsw.DefaultRecallProbeK,
 					},
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
 					Distance:              common.DefaultDistanceMetric,
+					DataType:              common.DefaultDataType,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      flat.DefaultCompressionEnabled,
 						RescoreLimit: flat.DefaultCompressionRescore,
@@ -232,6 +301,12 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Cache:        flat.DefaultVectorCache,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
@@ -263,6 +338,7 @@ func Test_DynamicUserConfig(t *testing.T) {
 					DynamicEFMax:           hnsw.DefaultDynamicEFMax,
 					DynamicEFFactor:        hnsw.DefaultDynamicEFFactor,
 					Distance:               common.DefaultDistanceMetric,
+					DataType:               common.DefaultDataType,
 					PQ: hnsw.PQConfig{
 						Enabled:       hnsw.DefaultPQEnabled,
 						Segments:      hnsw.DefaultPQSegments,
@@ -278,15 +354,33 @@ func Test_DynamicUserConfig(t *testing.T) {
 						TrainingLimit: hnsw.DefaultSQTrainingLimit,
 						RescoreLimit:  hnsw.DefaultSQRescoreLimit,
 					},
+					RQ: hnsw.RQConfig{
+						Enabled:      hnsw.DefaultRQEnabled,
+						Bits:         hnsw.DefaultRQBits,
+						RescoreLimit: hnsw.DefaultRQRescoreLimit,
+					},
 					FilterStrategy: hnsw.DefaultFilterStrategy,
 					Multivector: hnsw.MultivectorConfig{
 						Enabled:     hnsw.DefaultMultivectorEnabled,
 						Aggregation: hnsw.DefaultMultivectorAggregation,
+						Muvera: hnsw.MuveraConfig{
+							Enabled:      hnsw.DefaultMuveraEnabled,
+							KSim:         hnsw.DefaultMuveraKSim,
+							DProjections: hnsw.DefaultMuveraDProjections,
+							Repetitions:  hnsw.DefaultMuveraRepetitions,
+						},
+					},
+					RecallProbe: hnsw.RecallProbeConfig{
+						Enabled:         hnsw.DefaultRecallProbeEnabled,
+						IntervalSeconds: hnsw.DefaultRecallProbeIntervalSeconds,
+						SampleSize:      hnsw.DefaultRecallProbeSampleSize,
+						K:               hnsw.DefaultRecallProbeK,
 					},
 				},
 				FlatUC: flat.UserConfig{
 					VectorCacheMaxObjects: 100,
 					Distance:              common.DefaultDistanceMetric,
+					DataType:              common.DefaultDataType,
 					PQ: flat.CompressionUserConfig{
 						Enabled:      false,
 						RescoreLimit: flat.DefaultCompressionRescore,
@@ -302,9 +396,28 @@ func Test_DynamicUserConfig(t *testing.T) {
 						RescoreLimit: flat.DefaultCompressionRescore,
 						Cache:        flat.DefaultVectorCache,
 					},
+					RQ: flat.RQUserConfig{
+						Enabled:      flat.DefaultCompressionEnabled,
+						RescoreLimit: flat.DefaultCompressionRescore,
+						Cache:        flat.DefaultVectorCache,
+						Bits:         flat.DefaultRQBits,
+					},
 				},
 			},
 		},
+		{
+			name: "different data types for hnsw and flat return error",
+			input: map[string]interface{}{
+				"hnsw": map[string]interface{}{
+					"dataType": "float16",
+				},
+				"flat": map[string]interface{}{
+					"dataType": "bfloat16",
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: `hnsw and flat dataType must match, got "float16" and "bfloat16"`,
+		},
 		{
 			name: "pq enabled with flat returns error",
 			input: map[string]interface{}{
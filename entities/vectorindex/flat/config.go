@@ -24,6 +24,7 @@ const (
 	DefaultVectorCacheMaxObjects = 1e12
 	DefaultCompressionEnabled    = false
 	DefaultCompressionRescore    = -1 // indicates "let Weaviate pick"
+	DefaultRQBits                = 8
 )
 
 type CompressionUserConfig struct {
@@ -32,12 +33,23 @@ type CompressionUserConfig struct {
 	Cache        bool `json:"cache"`
 }
 
+// RQUserConfig configures rotational quantization, which in addition to the
+// common compression settings lets the user pick the bits per dimension
+type RQUserConfig struct {
+	Enabled      bool `json:"enabled"`
+	RescoreLimit int  `json:"rescoreLimit"`
+	Cache        bool `json:"cache"`
+	Bits         int  `json:"bits"`
+}
+
 type UserConfig struct {
 	Distance              string                `json:"distance"`
+	DataType              string                `json:"dataType"`
 	VectorCacheMaxObjects int                   `json:"vectorCacheMaxObjects"`
 	PQ                    CompressionUserConfig `json:"pq"`
 	BQ                    CompressionUserConfig `json:"bq"`
 	SQ                    CompressionUserConfig `json:"sq"`
+	RQ                    RQUserConfig          `json:"rq"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -60,12 +72,16 @@ func (u *UserConfig) SetDefaults() {
 	u.BQ.Cache = DefaultVectorCache
 	u.VectorCacheMaxObjects = DefaultVectorCacheMaxObjects
 	u.Distance = vectorindexcommon.DefaultDistanceMetric
+	u.DataType = vectorindexcommon.DefaultDataType
 	u.PQ.Enabled = DefaultCompressionEnabled
 	u.PQ.RescoreLimit = DefaultCompressionRescore
 	u.BQ.Enabled = DefaultCompressionEnabled
 	u.BQ.RescoreLimit = DefaultCompressionRescore
 	u.SQ.Enabled = DefaultCompressionEnabled
 	u.SQ.RescoreLimit = DefaultCompressionRescore
+	u.RQ.Enabled = DefaultCompressionEnabled
+	u.RQ.RescoreLimit = DefaultCompressionRescore
+	u.RQ.Bits = DefaultRQBits
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -89,6 +105,16 @@ func ParseAndValidateConfig(input interface{}) (schemaConfig.VectorIndexConfig,
 		return uc, err
 	}
 
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "dataType", func(v string) {
+		uc.DataType = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.ValidateDataTypeForDistance(uc.DataType, uc.Distance); err != nil {
+		return uc, err
+	}
+
 	if err := vectorindexcommon.OptionalIntFromMap(asMap, "vectorCacheMaxObjects", func(v int) {
 		uc.VectorCacheMaxObjects = v
 	}); err != nil {
@@ -126,12 +152,35 @@ func parseCompressionMap(in interface{}, cuc *CompressionUserConfig) error {
 	return nil
 }
 
+func parseRQMap(in interface{}, rq *RQUserConfig) error {
+	cuc := CompressionUserConfig{
+		Enabled:      rq.Enabled,
+		RescoreLimit: rq.RescoreLimit,
+		Cache:        rq.Cache,
+	}
+	if err := parseCompressionMap(in, &cuc); err != nil {
+		return err
+	}
+	rq.Enabled, rq.RescoreLimit, rq.Cache = cuc.Enabled, cuc.RescoreLimit, cuc.Cache
+
+	configMap, ok := in.(map[string]interface{})
+	if ok {
+		if err := vectorindexcommon.OptionalIntFromMap(configMap, "bits", func(v int) {
+			rq.Bits = v
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 	pqConfigValue, pqOk := in["pq"]
 	bqConfigValue, bqOk := in["bq"]
 	sqConfigValue, sqOk := in["sq"]
+	rqConfigValue, rqOk := in["rq"]
 
-	if !pqOk && !bqOk && !sqOk {
+	if !pqOk && !bqOk && !sqOk && !rqOk {
 		return nil
 	}
 
@@ -156,7 +205,18 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 		}
 	}
 
-	compressionConfigs := []CompressionUserConfig{uc.PQ, uc.BQ, uc.SQ}
+	if rqOk {
+		err := parseRQMap(rqConfigValue, &uc.RQ)
+		if err != nil {
+			return err
+		}
+	}
+
+	compressionConfigs := []CompressionUserConfig{uc.PQ, uc.BQ, uc.SQ, {
+		Enabled:      uc.RQ.Enabled,
+		RescoreLimit: uc.RQ.RescoreLimit,
+		Cache:        uc.RQ.Cache,
+	}}
 	totalEnabled := 0
 
 	for _, compressionConfig := range compressionConfigs {
@@ -179,6 +239,12 @@ func parseCompression(in map[string]interface{}, uc *UserConfig) error {
 	if uc.SQ.Enabled {
 		return errors.New("SQ is not currently supported for flat indices")
 	}
+	if uc.RQ.Enabled && uc.RQ.Cache {
+		return errors.New("caching RQ vectors is not currently supported for flat indices")
+	}
+	if uc.RQ.Enabled && uc.RQ.Bits != 4 && uc.RQ.Bits != 8 {
+		return fmt.Errorf("rq bits must be either 4 or 8, got %d", uc.RQ.Bits)
+	}
 
 	return nil
 }
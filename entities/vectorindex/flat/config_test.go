@@ -35,6 +35,7 @@ func Test_FlatUserConfig(t *testing.T) {
 			expected: UserConfig{
 				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
 				Distance:              common.DefaultDistanceMetric,
+				DataType:              common.DefaultDataType,
 				PQ: CompressionUserConfig{
 					Enabled:      DefaultCompressionEnabled,
 					RescoreLimit: DefaultCompressionRescore,
@@ -50,6 +51,12 @@ func Test_FlatUserConfig(t *testing.T) {
 					RescoreLimit: DefaultCompressionRescore,
 					Cache:        DefaultVectorCache,
 				},
+				RQ: RQUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+					Bits:         DefaultRQBits,
+				},
 			},
 		},
 		{
@@ -66,6 +73,7 @@ func Test_FlatUserConfig(t *testing.T) {
 			expected: UserConfig{
 				VectorCacheMaxObjects: 100,
 				Distance:              common.DefaultDistanceMetric,
+				DataType:              common.DefaultDataType,
 				PQ: CompressionUserConfig{
 					Enabled:      false,
 					RescoreLimit: DefaultCompressionRescore,
@@ -81,7 +89,133 @@ func Test_FlatUserConfig(t *testing.T) {
 					RescoreLimit: DefaultCompressionRescore,
 					Cache:        DefaultVectorCache,
 				},
+				RQ: RQUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+					Bits:         DefaultRQBits,
+				},
+			},
+		},
+		{
+			name: "rq enabled",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled":      true,
+					"rescoreLimit": float64(50),
+					"bits":         float64(4),
+				},
 			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				Distance:              common.DefaultDistanceMetric,
+				DataType:              common.DefaultDataType,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				RQ: RQUserConfig{
+					Enabled:      true,
+					RescoreLimit: 50,
+					Cache:        DefaultVectorCache,
+					Bits:         4,
+				},
+			},
+		},
+		{
+			name: "int8 data type",
+			input: map[string]interface{}{
+				"distance": "dot",
+				"dataType": "int8",
+			},
+			expected: UserConfig{
+				VectorCacheMaxObjects: common.DefaultVectorCacheMaxObjects,
+				Distance:              common.DistanceDot,
+				DataType:              common.DataTypeInt8,
+				PQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				BQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				SQ: CompressionUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+				},
+				RQ: RQUserConfig{
+					Enabled:      DefaultCompressionEnabled,
+					RescoreLimit: DefaultCompressionRescore,
+					Cache:        DefaultVectorCache,
+					Bits:         DefaultRQBits,
+				},
+			},
+		},
+		{
+			name: "invalid data type",
+			input: map[string]interface{}{
+				"dataType": "float64",
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid dataType "float64"`,
+		},
+		{
+			name: "int8 data type with cosine distance",
+			input: map[string]interface{}{
+				"dataType": "int8",
+			},
+			expectErr:    true,
+			expectErrMsg: `dataType "int8" can't be used with distance "cosine"`,
+		},
+		{
+			name: "rq with invalid bits",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(2),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "rq bits must be either 4 or 8, got 2",
+		},
+		{
+			name: "rq with cache",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+					"cache":   true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "caching RQ vectors is not currently supported for flat indices",
+		},
+		{
+			name: "rq and bq enabled",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+				"bq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "cannot enable multiple quantization methods at the same time",
 		},
 		{
 			name: "sq enabled",
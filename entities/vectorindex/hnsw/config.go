@@ -54,11 +54,14 @@ type UserConfig struct {
 	VectorCacheMaxObjects  int               `json:"vectorCacheMaxObjects"`
 	FlatSearchCutoff       int               `json:"flatSearchCutoff"`
 	Distance               string            `json:"distance"`
+	DataType               string            `json:"dataType"`
 	PQ                     PQConfig          `json:"pq"`
 	BQ                     BQConfig          `json:"bq"`
 	SQ                     SQConfig          `json:"sq"`
+	RQ                     RQConfig          `json:"rq"`
 	FilterStrategy         string            `json:"filterStrategy"`
 	Multivector            MultivectorConfig `json:"multivector"`
+	RecallProbe            RecallProbeConfig `json:"recallProbe"`
 }
 
 // IndexType returns the type of the underlying vector index, thus making sure
@@ -88,6 +91,7 @@ func (u *UserConfig) SetDefaults() {
 	u.Skip = DefaultSkip
 	u.FlatSearchCutoff = DefaultFlatSearchCutoff
 	u.Distance = vectorIndexCommon.DefaultDistanceMetric
+	u.DataType = vectorIndexCommon.DefaultDataType
 	u.PQ = PQConfig{
 		Enabled:        DefaultPQEnabled,
 		BitCompression: DefaultPQBitCompression,
@@ -107,11 +111,18 @@ func (u *UserConfig) SetDefaults() {
 		TrainingLimit: DefaultSQTrainingLimit,
 		RescoreLimit:  DefaultSQRescoreLimit,
 	}
+	u.RQ = RQConfig{
+		Enabled:      DefaultRQEnabled,
+		Bits:         DefaultRQBits,
+		RescoreLimit: DefaultRQRescoreLimit,
+	}
 	u.FilterStrategy = DefaultFilterStrategy
 	u.Multivector = MultivectorConfig{
 		Enabled:     DefaultMultivectorEnabled,
 		Aggregation: DefaultMultivectorAggregation,
+		Muvera:      defaultMuveraConfig(),
 	}
+	u.RecallProbe = defaultRecallProbeConfig()
 }
 
 // ParseAndValidateConfig from an unknown input value, as this is not further
@@ -195,6 +206,12 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (config.Vecto
 		return uc, err
 	}
 
+	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "dataType", func(v string) {
+		uc.DataType = v
+	}); err != nil {
+		return uc, err
+	}
+
 	if err := parsePQMap(asMap, &uc.PQ); err != nil {
 		return uc, err
 	}
@@ -207,6 +224,10 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (config.Vecto
 		return uc, err
 	}
 
+	if err := parseRQMap(asMap, &uc.RQ); err != nil {
+		return uc, err
+	}
+
 	if err := vectorIndexCommon.OptionalStringFromMap(asMap, "filterStrategy", func(v string) {
 		uc.FilterStrategy = v
 	}); err != nil {
@@ -217,6 +238,10 @@ func ParseAndValidateConfig(input interface{}, isMultiVector bool) (config.Vecto
 		return uc, err
 	}
 
+	if err := parseRecallProbeMap(asMap, &uc.RecallProbe); err != nil {
+		return uc, err
+	}
+
 	return uc, uc.validate()
 }
 
@@ -240,6 +265,24 @@ func (u *UserConfig) validate() error {
 		errMsgs = append(errMsgs, "filterStrategy must be either 'sweeping' or 'acorn'")
 	}
 
+	if u.Multivector.Enabled {
+		if err := validateMuveraConfig(u.Multivector.Muvera); err != nil {
+			errMsgs = append(errMsgs, err.Error())
+		}
+	}
+
+	if err := validateRecallProbeConfig(u.RecallProbe); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+
+	if u.RQ.Enabled && !ValidRQBits(u.RQ.Bits) {
+		errMsgs = append(errMsgs, fmt.Sprintf("rq bits must be either 4 or 8, got %d", u.RQ.Bits))
+	}
+
+	if err := vectorIndexCommon.ValidateDataTypeForDistance(u.DataType, u.Distance); err != nil {
+		errMsgs = append(errMsgs, err.Error())
+	}
+
 	if len(errMsgs) > 0 {
 		return fmt.Errorf("invalid hnsw config: %s",
 			strings.Join(errMsgs, ", "))
@@ -255,9 +298,19 @@ func (u *UserConfig) validate() error {
 	if u.SQ.Enabled {
 		enabled++
 	}
+	if u.RQ.Enabled {
+		enabled++
+	}
 	if enabled > 1 {
 		return fmt.Errorf("invalid hnsw config: more than a single compression methods enabled")
 	}
+	if enabled > 0 && u.DataType != vectorIndexCommon.DataTypeFloat32 {
+		return fmt.Errorf("invalid hnsw config: compression can't be combined with dataType %q", u.DataType)
+	}
+	if u.Multivector.Enabled && u.DataType != vectorIndexCommon.DataTypeFloat32 {
+		return fmt.Errorf("invalid hnsw config: multi vector indexes only support dataType %q",
+			vectorIndexCommon.DataTypeFloat32)
+	}
 
 	return nil
 }
@@ -271,6 +324,6 @@ func NewDefaultUserConfig() UserConfig {
 func NewDefaultMultiVectorUserConfig() UserConfig {
 	uc := UserConfig{}
 	uc.SetDefaults()
-	uc.Multivector = MultivectorConfig{Enabled: true}
+	uc.Multivector = MultivectorConfig{Enabled: true, Muvera: defaultMuveraConfig()}
 	return uc
 }
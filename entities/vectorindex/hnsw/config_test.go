@@ -46,6 +46,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -62,11 +63,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 
@@ -86,6 +94,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -102,11 +111,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 
@@ -137,6 +153,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "l2-squared",
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -153,11 +170,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 
@@ -188,6 +212,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "manhattan",
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -204,11 +229,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 
@@ -240,6 +272,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFFactor:        19,
 				Skip:                   true,
 				Distance:               "hamming",
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -256,11 +289,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 
@@ -289,6 +329,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -305,11 +346,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 
@@ -348,6 +396,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:       true,
 					Segments:      64,
@@ -363,11 +412,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 
@@ -405,6 +461,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:       true,
 					Segments:      64,
@@ -420,11 +477,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 
@@ -481,6 +545,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -497,11 +562,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 		{
@@ -567,6 +639,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:       false,
 					Segments:      0,
@@ -585,11 +658,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 		{
@@ -619,6 +699,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           18,
 				DynamicEFFactor:        19,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:       false,
 					Segments:      0,
@@ -634,13 +715,192 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: DefaultFilterStrategy,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
+		{
+			name: "with rq",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled":      true,
+					"bits":         float64(4),
+					"rescoreLimit": float64(100),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      true,
+					Bits:         4,
+					RescoreLimit: 100,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+				Multivector: MultivectorConfig{
+					Enabled:     DefaultMultivectorEnabled,
+					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
+				},
+				RecallProbe: defaultRecallProbeConfig(),
+			},
+		},
+		{
+			name: "with invalid rq bits",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+					"bits":    float64(3),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "rq bits must be either 4 or 8, got 3",
+		},
+		{
+			name: "with recall probe",
+			input: map[string]interface{}{
+				"recallProbe": map[string]interface{}{
+					"enabled":         true,
+					"intervalSeconds": float64(60),
+					"sampleSize":      float64(50),
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+				Multivector: MultivectorConfig{
+					Enabled:     DefaultMultivectorEnabled,
+					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
+				},
+				RecallProbe: RecallProbeConfig{
+					Enabled:         true,
+					IntervalSeconds: 60,
+					SampleSize:      50,
+					K:               DefaultRecallProbeK,
+				},
+			},
+		},
+		{
+			name: "with invalid recall probe",
+			input: map[string]interface{}{
+				"recallProbe": map[string]interface{}{
+					"enabled": true,
+					"k":       float64(0),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "recallProbe intervalSeconds, sampleSize and k must be positive, got 300, 10 and 0",
+		},
+		{
+			name: "with rq and sq",
+			input: map[string]interface{}{
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+				"sq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "invalid hnsw config: more than a single compression methods enabled",
+		},
+		{
+			name: "with float16 data type and rq",
+			input: map[string]interface{}{
+				"dataType": "float16",
+				"rq": map[string]interface{}{
+					"enabled": true,
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid hnsw config: compression can't be combined with dataType "float16"`,
+		},
+		{
+			name: "with invalid data type",
+			input: map[string]interface{}{
+				"dataType": "float64",
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid hnsw config: invalid dataType "float64"`,
+		},
+		{
+			name: "with int8 data type and cosine distance",
+			input: map[string]interface{}{
+				"dataType": "int8",
+				"distance": "cosine",
+			},
+			expectErr:    true,
+			expectErrMsg: `invalid hnsw config: dataType "int8" can't be used with distance "cosine"`,
+		},
 		{
 			name: "with invalid compression",
 			input: map[string]interface{}{
@@ -665,6 +925,96 @@ func Test_UserConfig(t *testing.T) {
 			expectErr:    true,
 			expectErrMsg: "invalid hnsw config: filterStrategy must be either 'sweeping' or 'acorn'",
 		},
+		{
+			name: "multivector with muvera",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"muvera": map[string]interface{}{
+						"enabled":      true,
+						"ksim":         float64(3),
+						"dprojections": json.Number("8"),
+						"repetitions":  float64(5),
+					},
+				},
+			},
+			expected: UserConfig{
+				CleanupIntervalSeconds: DefaultCleanupIntervalSeconds,
+				MaxConnections:         DefaultMaxConnections,
+				EFConstruction:         DefaultEFConstruction,
+				VectorCacheMaxObjects:  common.DefaultVectorCacheMaxObjects,
+				EF:                     DefaultEF,
+				Skip:                   DefaultSkip,
+				FlatSearchCutoff:       DefaultFlatSearchCutoff,
+				DynamicEFMin:           DefaultDynamicEFMin,
+				DynamicEFMax:           DefaultDynamicEFMax,
+				DynamicEFFactor:        DefaultDynamicEFFactor,
+				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
+				PQ: PQConfig{
+					Enabled:        DefaultPQEnabled,
+					BitCompression: DefaultPQBitCompression,
+					Segments:       DefaultPQSegments,
+					Centroids:      DefaultPQCentroids,
+					TrainingLimit:  DefaultPQTrainingLimit,
+					Encoder: PQEncoder{
+						Type:         DefaultPQEncoderType,
+						Distribution: DefaultPQEncoderDistribution,
+					},
+				},
+				SQ: SQConfig{
+					Enabled:       DefaultSQEnabled,
+					TrainingLimit: DefaultSQTrainingLimit,
+					RescoreLimit:  DefaultSQRescoreLimit,
+				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
+				FilterStrategy: DefaultFilterStrategy,
+				Multivector: MultivectorConfig{
+					Enabled:     true,
+					Aggregation: DefaultMultivectorAggregation,
+					Muvera: MuveraConfig{
+						Enabled:      true,
+						KSim:         3,
+						DProjections: 8,
+						Repetitions:  5,
+					},
+				},
+				RecallProbe: defaultRecallProbeConfig(),
+			},
+		},
+		{
+			name: "multivector with invalid muvera ksim",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"muvera": map[string]interface{}{
+						"enabled": true,
+						"ksim":    float64(0),
+					},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "muvera ksim must be between 1 and 10, got 0",
+		},
+		{
+			name: "multivector with too many muvera dimensions",
+			input: map[string]interface{}{
+				"multivector": map[string]interface{}{
+					"enabled": true,
+					"muvera": map[string]interface{}{
+						"enabled":      true,
+						"ksim":         float64(10),
+						"dprojections": float64(64),
+					},
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "muvera encoding would have 655360 dimensions",
+		},
 		{
 			name: "acorn enabled, all defaults",
 			input: map[string]interface{}{
@@ -682,6 +1032,7 @@ func Test_UserConfig(t *testing.T) {
 				DynamicEFMax:           DefaultDynamicEFMax,
 				DynamicEFFactor:        DefaultDynamicEFFactor,
 				Distance:               common.DefaultDistanceMetric,
+				DataType:               common.DefaultDataType,
 				PQ: PQConfig{
 					Enabled:        DefaultPQEnabled,
 					BitCompression: DefaultPQBitCompression,
@@ -698,11 +1049,18 @@ func Test_UserConfig(t *testing.T) {
 					TrainingLimit: DefaultSQTrainingLimit,
 					RescoreLimit:  DefaultSQRescoreLimit,
 				},
+				RQ: RQConfig{
+					Enabled:      DefaultRQEnabled,
+					Bits:         DefaultRQBits,
+					RescoreLimit: DefaultRQRescoreLimit,
+				},
 				FilterStrategy: FilterStrategyAcorn,
 				Multivector: MultivectorConfig{
 					Enabled:     DefaultMultivectorEnabled,
 					Aggregation: DefaultMultivectorAggregation,
+					Muvera:      defaultMuveraConfig(),
 				},
+				RecallProbe: defaultRecallProbeConfig(),
 			},
 		},
 	}
@@ -13,6 +13,7 @@ package hnsw
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/weaviate/weaviate/entities/vectorindex/common"
 )
@@ -24,12 +25,46 @@ const (
 const (
 	DefaultMultivectorEnabled     = false
 	DefaultMultivectorAggregation = "maxSim"
+
+	DefaultMuveraEnabled      = false
+	DefaultMuveraKSim         = 4
+	DefaultMuveraDProjections = 16
+	DefaultMuveraRepetitions  = 10
+
+	muveraMaxKSim = 10
 )
 
 // Multivector configuration
 type MultivectorConfig struct {
-	Enabled     bool   `json:"enabled"`
-	Aggregation string `json:"aggregation"`
+	Enabled     bool         `json:"enabled"`
+	Aggregation string       `json:"aggregation"`
+	Muvera      MuveraConfig `json:"muvera"`
+}
+
+// MuveraConfig configures the fixed dimensional encoding (MUVERA) of a
+// multi vector. When enabled, every document is indexed as a single encoded
+// vector of Repetitions * 2^KSim * DProjections dimensions. The graph search
+// on the encodings only generates candidates, which are then rescored with
+// the exact MaxSim over their token vectors.
+type MuveraConfig struct {
+	Enabled      bool `json:"enabled"`
+	KSim         int  `json:"ksim"`
+	DProjections int  `json:"dprojections"`
+	Repetitions  int  `json:"repetitions"`
+}
+
+// Dimensions of the fixed dimensional encoding
+func (m MuveraConfig) Dimensions() int {
+	return m.Repetitions * (1 << m.KSim) * m.DProjections
+}
+
+func defaultMuveraConfig() MuveraConfig {
+	return MuveraConfig{
+		Enabled:      DefaultMuveraEnabled,
+		KSim:         DefaultMuveraKSim,
+		DProjections: DefaultMuveraDProjections,
+		Repetitions:  DefaultMuveraRepetitions,
+	}
 }
 
 func validAggregation(v string) error {
@@ -51,6 +86,27 @@ func ValidateMultivectorConfig(cfg MultivectorConfig) error {
 		return err
 	}
 
+	return validateMuveraConfig(cfg.Muvera)
+}
+
+func validateMuveraConfig(cfg MuveraConfig) error {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.KSim < 1 || cfg.KSim > muveraMaxKSim {
+		return fmt.Errorf("muvera ksim must be between 1 and %d, got %d", muveraMaxKSim, cfg.KSim)
+	}
+	if cfg.DProjections < 1 {
+		return fmt.Errorf("muvera dprojections must be a positive integer, got %d", cfg.DProjections)
+	}
+	if cfg.Repetitions < 1 {
+		return fmt.Errorf("muvera repetitions must be a positive integer, got %d", cfg.Repetitions)
+	}
+	// compressed vectors store their dimensions as uint16
+	if dims := cfg.Dimensions(); dims > math.MaxUint16 {
+		return fmt.Errorf("muvera encoding would have %d dimensions, the maximum is %d, "+
+			"reduce ksim, dprojections or repetitions", dims, math.MaxUint16)
+	}
 	return nil
 }
 
@@ -82,5 +138,43 @@ func parseMultivectorMap(in map[string]interface{}, multivector *MultivectorConf
 		return err
 	}
 
+	return parseMuveraMap(multivectorConfigMap, &multivector.Muvera)
+}
+
+func parseMuveraMap(in map[string]interface{}, muvera *MuveraConfig) error {
+	muveraConfigValue, ok := in["muvera"]
+	if !ok {
+		return nil
+	}
+
+	muveraConfigMap, ok := muveraConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(muveraConfigMap, "enabled", func(v bool) {
+		muvera.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(muveraConfigMap, "ksim", func(v int) {
+		muvera.KSim = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(muveraConfigMap, "dprojections", func(v int) {
+		muvera.DProjections = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(muveraConfigMap, "repetitions", func(v int) {
+		muvera.Repetitions = v
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
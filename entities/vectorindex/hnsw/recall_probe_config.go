@@ -0,0 +1,93 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultRecallProbeEnabled         = false
+	DefaultRecallProbeIntervalSeconds = 5 * 60
+	DefaultRecallProbeSampleSize      = 10
+	DefaultRecallProbeK               = 10
+)
+
+// RecallProbeConfig controls the recall probe of an index, which every
+// IntervalSeconds searches for SampleSize stored vectors and compares the top
+// K results to an exact search
+type RecallProbeConfig struct {
+	Enabled         bool `json:"enabled"`
+	IntervalSeconds int  `json:"intervalSeconds"`
+	SampleSize      int  `json:"sampleSize"`
+	K               int  `json:"k"`
+}
+
+func defaultRecallProbeConfig() RecallProbeConfig {
+	return RecallProbeConfig{
+		Enabled:         DefaultRecallProbeEnabled,
+		IntervalSeconds: DefaultRecallProbeIntervalSeconds,
+		SampleSize:      DefaultRecallProbeSampleSize,
+		K:               DefaultRecallProbeK,
+	}
+}
+
+func parseRecallProbeMap(in map[string]interface{}, rp *RecallProbeConfig) error {
+	recallProbeConfigValue, ok := in["recallProbe"]
+	if !ok {
+		return nil
+	}
+
+	recallProbeConfigMap, ok := recallProbeConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(recallProbeConfigMap, "enabled", func(v bool) {
+		rp.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(recallProbeConfigMap, "intervalSeconds", func(v int) {
+		rp.IntervalSeconds = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(recallProbeConfigMap, "sampleSize", func(v int) {
+		rp.SampleSize = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(recallProbeConfigMap, "k", func(v int) {
+		rp.K = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateRecallProbeConfig(rp RecallProbeConfig) error {
+	if !rp.Enabled {
+		return nil
+	}
+	if rp.IntervalSeconds <= 0 || rp.SampleSize <= 0 || rp.K <= 0 {
+		return fmt.Errorf("recallProbe intervalSeconds, sampleSize and k must be positive, "+
+			"got %d, %d and %d", rp.IntervalSeconds, rp.SampleSize, rp.K)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package hnsw
+
+import "github.com/weaviate/weaviate/entities/vectorindex/common"
+
+const (
+	DefaultRQEnabled      = false
+	DefaultRQBits         = 8
+	DefaultRQRescoreLimit = 20
+)
+
+type RQConfig struct {
+	Enabled      bool `json:"enabled"`
+	Bits         int  `json:"bits"`
+	RescoreLimit int  `json:"rescoreLimit"`
+}
+
+func parseRQMap(in map[string]interface{}, rq *RQConfig) error {
+	rqConfigValue, ok := in["rq"]
+	if !ok {
+		return nil
+	}
+
+	rqConfigMap, ok := rqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := common.OptionalBoolFromMap(rqConfigMap, "enabled", func(v bool) {
+		rq.Enabled = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(rqConfigMap, "bits", func(v int) {
+		rq.Bits = v
+	}); err != nil {
+		return err
+	}
+
+	if err := common.OptionalIntFromMap(rqConfigMap, "rescoreLimit", func(v int) {
+		rq.RescoreLimit = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ValidRQBits reports whether the rotational quantizer supports encoding
+// every dimension with the given number of bits
+func ValidRQBits(bits int) bool {
+	return bits == 4 || bits == 8
+}
@@ -0,0 +1,215 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"fmt"
+
+	schemaConfig "github.com/weaviate/weaviate/entities/schema/config"
+	vectorindexcommon "github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+const (
+	DefaultNList            = 1024
+	DefaultNProbe           = 16
+	DefaultTrainingLimit    = 100000
+	DefaultRescoreLimit     = 100
+	DefaultRetrainFactor    = 2.0
+	DefaultFlatSearchCutoff = 40000
+	DefaultPQSegments       = 0 // indicates "let Weaviate pick"
+	DefaultPQCentroids      = 256
+)
+
+// PQConfig controls the product quantization of the residuals, i.e. the
+// difference between a vector and the centroid of the list it is assigned to.
+type PQConfig struct {
+	Segments  int `json:"segments"`
+	Centroids int `json:"centroids"`
+}
+
+// UserConfig bundles all values settable by a user in the per-class settings
+// of an inverted file (IVF-PQ) vector index
+type UserConfig struct {
+	Distance         string   `json:"distance"`
+	NList            int      `json:"nlist"`
+	NProbe           int      `json:"nprobe"`
+	TrainingLimit    int      `json:"trainingLimit"`
+	RescoreLimit     int      `json:"rescoreLimit"`
+	RetrainFactor    float64  `json:"retrainFactor"`
+	FlatSearchCutoff int      `json:"flatSearchCutoff"`
+	PQ               PQConfig `json:"pq"`
+}
+
+// IndexType returns the type of the underlying vector index, thus making sure
+// the schema.VectorIndexConfig interface is implemented
+func (u UserConfig) IndexType() string {
+	return "ivf"
+}
+
+func (u UserConfig) DistanceName() string {
+	return u.Distance
+}
+
+func (u UserConfig) IsMultiVector() bool {
+	return false
+}
+
+// SetDefaults in the user-specifyable part of the config
+func (u *UserConfig) SetDefaults() {
+	u.Distance = vectorindexcommon.DefaultDistanceMetric
+	u.NList = DefaultNList
+	u.NProbe = DefaultNProbe
+	u.TrainingLimit = DefaultTrainingLimit
+	u.RescoreLimit = DefaultRescoreLimit
+	u.RetrainFactor = DefaultRetrainFactor
+	u.FlatSearchCutoff = DefaultFlatSearchCutoff
+	u.PQ = PQConfig{
+		Segments:  DefaultPQSegments,
+		Centroids: DefaultPQCentroids,
+	}
+}
+
+func NewDefaultUserConfig() UserConfig {
+	uc := UserConfig{}
+	uc.SetDefaults()
+	return uc
+}
+
+// ParseAndValidateConfig from an unknown input value, as this is not further
+// specified in the API to allow of exchanging the index type
+func ParseAndValidateConfig(input interface{}, isMultiVector bool) (schemaConfig.VectorIndexConfig, error) {
+	uc := UserConfig{}
+	uc.SetDefaults()
+
+	if isMultiVector {
+		return uc, fmt.Errorf("multi vectors are not supported by the ivf index")
+	}
+
+	if input == nil {
+		return uc, nil
+	}
+
+	asMap, ok := input.(map[string]interface{})
+	if !ok || asMap == nil {
+		return uc, fmt.Errorf("input must be a non-nil map")
+	}
+
+	if err := vectorindexcommon.OptionalStringFromMap(asMap, "distance", func(v string) {
+		uc.Distance = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "nlist", func(v int) {
+		uc.NList = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "nprobe", func(v int) {
+		uc.NProbe = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "trainingLimit", func(v int) {
+		uc.TrainingLimit = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "rescoreLimit", func(v int) {
+		uc.RescoreLimit = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalFloatFromMap(asMap, "retrainFactor", func(v float64) {
+		uc.RetrainFactor = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(asMap, "flatSearchCutoff", func(v int) {
+		uc.FlatSearchCutoff = v
+	}); err != nil {
+		return uc, err
+	}
+
+	if err := parsePQMap(asMap, &uc.PQ); err != nil {
+		return uc, err
+	}
+
+	return uc, uc.validate()
+}
+
+func parsePQMap(in map[string]interface{}, pq *PQConfig) error {
+	pqConfigValue, ok := in["pq"]
+	if !ok {
+		return nil
+	}
+
+	pqConfigMap, ok := pqConfigValue.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(pqConfigMap, "segments", func(v int) {
+		pq.Segments = v
+	}); err != nil {
+		return err
+	}
+
+	if err := vectorindexcommon.OptionalIntFromMap(pqConfigMap, "centroids", func(v int) {
+		pq.Centroids = v
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u UserConfig) validate() error {
+	switch u.Distance {
+	case vectorindexcommon.DistanceCosine, vectorindexcommon.DistanceDot, vectorindexcommon.DistanceL2Squared:
+	default:
+		// the residual encoding relies on the distance being decomposable
+		// into the distance to the centroid and the distance to the residual
+		return fmt.Errorf("distance %q is not supported by the ivf index, choose one of [%q, %q, %q]",
+			u.Distance, vectorindexcommon.DistanceCosine, vectorindexcommon.DistanceDot,
+			vectorindexcommon.DistanceL2Squared)
+	}
+	if u.NList < 1 {
+		return fmt.Errorf("nlist must be a positive integer, got %d", u.NList)
+	}
+	if u.NProbe < 1 || u.NProbe > u.NList {
+		return fmt.Errorf("nprobe must be between 1 and nlist (%d), got %d", u.NList, u.NProbe)
+	}
+	if u.TrainingLimit < u.NList || u.TrainingLimit < u.PQ.Centroids {
+		return fmt.Errorf("trainingLimit (%d) must not be smaller than nlist (%d) or pq.centroids (%d)",
+			u.TrainingLimit, u.NList, u.PQ.Centroids)
+	}
+	if u.RescoreLimit < 0 {
+		return fmt.Errorf("rescoreLimit cannot be negative")
+	}
+	if u.RetrainFactor != 0 && u.RetrainFactor <= 1 {
+		return fmt.Errorf("retrainFactor must be greater than 1 or 0 to disable retraining, got %v",
+			u.RetrainFactor)
+	}
+	if u.PQ.Segments < 0 {
+		return fmt.Errorf("pq.segments cannot be negative")
+	}
+	if u.PQ.Centroids < 2 || u.PQ.Centroids > 256 {
+		return fmt.Errorf("pq.centroids must be between 2 and 256, got %d", u.PQ.Centroids)
+	}
+	return nil
+}
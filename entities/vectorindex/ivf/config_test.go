@@ -0,0 +1,164 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package ivf
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/vectorindex/common"
+)
+
+func Test_IVFUserConfig(t *testing.T) {
+	type test struct {
+		name         string
+		input        interface{}
+		multiVector  bool
+		expected     UserConfig
+		expectErr    bool
+		expectErrMsg string
+	}
+
+	tests := []test{
+		{
+			name:  "nothing specified, all defaults",
+			input: nil,
+			expected: UserConfig{
+				Distance:         common.DefaultDistanceMetric,
+				NList:            DefaultNList,
+				NProbe:           DefaultNProbe,
+				TrainingLimit:    DefaultTrainingLimit,
+				RescoreLimit:     DefaultRescoreLimit,
+				RetrainFactor:    DefaultRetrainFactor,
+				FlatSearchCutoff: DefaultFlatSearchCutoff,
+				PQ: PQConfig{
+					Segments:  DefaultPQSegments,
+					Centroids: DefaultPQCentroids,
+				},
+			},
+		},
+		{
+			name: "all fields specified",
+			input: map[string]interface{}{
+				"distance":         "l2-squared",
+				"nlist":            float64(256),
+				"nprobe":           json.Number("8"),
+				"trainingLimit":    float64(20000),
+				"rescoreLimit":     float64(50),
+				"retrainFactor":    json.Number("1.5"),
+				"flatSearchCutoff": float64(1000),
+				"pq": map[string]interface{}{
+					"segments":  float64(16),
+					"centroids": float64(128),
+				},
+			},
+			expected: UserConfig{
+				Distance:         "l2-squared",
+				NList:            256,
+				NProbe:           8,
+				TrainingLimit:    20000,
+				RescoreLimit:     50,
+				RetrainFactor:    1.5,
+				FlatSearchCutoff: 1000,
+				PQ: PQConfig{
+					Segments:  16,
+					Centroids: 128,
+				},
+			},
+		},
+		{
+			name: "retraining disabled",
+			input: map[string]interface{}{
+				"retrainFactor": float64(0),
+			},
+			expected: UserConfig{
+				Distance:         common.DefaultDistanceMetric,
+				NList:            DefaultNList,
+				NProbe:           DefaultNProbe,
+				TrainingLimit:    DefaultTrainingLimit,
+				RescoreLimit:     DefaultRescoreLimit,
+				FlatSearchCutoff: DefaultFlatSearchCutoff,
+				PQ: PQConfig{
+					Segments:  DefaultPQSegments,
+					Centroids: DefaultPQCentroids,
+				},
+			},
+		},
+		{
+			name: "unsupported distance",
+			input: map[string]interface{}{
+				"distance": "hamming",
+			},
+			expectErr:    true,
+			expectErrMsg: "is not supported by the ivf index",
+		},
+		{
+			name: "nprobe larger than nlist",
+			input: map[string]interface{}{
+				"nlist":  float64(16),
+				"nprobe": float64(32),
+			},
+			expectErr:    true,
+			expectErrMsg: "nprobe must be between 1 and nlist (16), got 32",
+		},
+		{
+			name: "training limit smaller than nlist",
+			input: map[string]interface{}{
+				"nlist":         float64(2048),
+				"trainingLimit": float64(1000),
+			},
+			expectErr:    true,
+			expectErrMsg: "trainingLimit (1000) must not be smaller than nlist (2048)",
+		},
+		{
+			name: "retrain factor of 1",
+			input: map[string]interface{}{
+				"retrainFactor": float64(1),
+			},
+			expectErr:    true,
+			expectErrMsg: "retrainFactor must be greater than 1",
+		},
+		{
+			name: "too many centroids",
+			input: map[string]interface{}{
+				"pq": map[string]interface{}{
+					"centroids": float64(512),
+				},
+			},
+			expectErr:    true,
+			expectErrMsg: "centroids",
+		},
+		{
+			name:         "multi vector",
+			input:        nil,
+			multiVector:  true,
+			expectErr:    true,
+			expectErrMsg: "multi vectors are not supported",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg, err := ParseAndValidateConfig(test.input, test.multiVector)
+			if test.expectErr {
+				require.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.expectErrMsg)
+				return
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.expected, cfg)
+			}
+		})
+	}
+}
@@ -23,6 +23,10 @@ type WeaviateClient interface {
 	BatchObjects(ctx context.Context, in *BatchObjectsRequest, opts ...grpc.CallOption) (*BatchObjectsReply, error)
 	BatchDelete(ctx context.Context, in *BatchDeleteRequest, opts ...grpc.CallOption) (*BatchDeleteReply, error)
 	TenantsGet(ctx context.Context, in *TenantsGetRequest, opts ...grpc.CallOption) (*TenantsGetReply, error)
+	KeyValueGet(ctx context.Context, in *KeyValueGetRequest, opts ...grpc.CallOption) (*KeyValueGetReply, error)
+	KeyValuePut(ctx context.Context, in *KeyValuePutRequest, opts ...grpc.CallOption) (*KeyValuePutReply, error)
+	KeyValueDelete(ctx context.Context, in *KeyValueDeleteRequest, opts ...grpc.CallOption) (*KeyValueDeleteReply, error)
+	KeyValueCompareAndSwap(ctx context.Context, in *KeyValueCompareAndSwapRequest, opts ...grpc.CallOption) (*KeyValueCompareAndSwapReply, error)
 }
 
 type weaviateClient struct {
@@ -69,6 +73,42 @@ func (c *weaviateClient) TenantsGet(ctx context.Context, in *TenantsGetRequest,
 	return out, nil
 }
 
+func (c *weaviateClient) KeyValueGet(ctx context.Context, in *KeyValueGetRequest, opts ...grpc.CallOption) (*KeyValueGetReply, error) {
+	out := new(KeyValueGetReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/KeyValueGet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) KeyValuePut(ctx context.Context, in *KeyValuePutRequest, opts ...grpc.CallOption) (*KeyValuePutReply, error) {
+	out := new(KeyValuePutReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/KeyValuePut", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) KeyValueDelete(ctx context.Context, in *KeyValueDeleteRequest, opts ...grpc.CallOption) (*KeyValueDeleteReply, error) {
+	out := new(KeyValueDeleteReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/KeyValueDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *weaviateClient) KeyValueCompareAndSwap(ctx context.Context, in *KeyValueCompareAndSwapRequest, opts ...grpc.CallOption) (*KeyValueCompareAndSwapReply, error) {
+	out := new(KeyValueCompareAndSwapReply)
+	err := c.cc.Invoke(ctx, "/weaviate.v1.Weaviate/KeyValueCompareAndSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WeaviateServer is the server API for Weaviate service.
 // All implementations must embed UnimplementedWeaviateServer
 // for forward compatibility
@@ -77,6 +117,10 @@ type WeaviateServer interface {
 	BatchObjects(context.Context, *BatchObjectsRequest) (*BatchObjectsReply, error)
 	BatchDelete(context.Context, *BatchDeleteRequest) (*BatchDeleteReply, error)
 	TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error)
+	KeyValueGet(context.Context, *KeyValueGetRequest) (*KeyValueGetReply, error)
+	KeyValuePut(context.Context, *KeyValuePutRequest) (*KeyValuePutReply, error)
+	KeyValueDelete(context.Context, *KeyValueDeleteRequest) (*KeyValueDeleteReply, error)
+	KeyValueCompareAndSwap(context.Context, *KeyValueCompareAndSwapRequest) (*KeyValueCompareAndSwapReply, error)
 	mustEmbedUnimplementedWeaviateServer()
 }
 
@@ -96,6 +140,18 @@ func (UnimplementedWeaviateServer) BatchDelete(context.Context, *BatchDeleteRequ
 func (UnimplementedWeaviateServer) TenantsGet(context.Context, *TenantsGetRequest) (*TenantsGetReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TenantsGet not implemented")
 }
+func (UnimplementedWeaviateServer) KeyValueGet(context.Context, *KeyValueGetRequest) (*KeyValueGetReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyValueGet not implemented")
+}
+func (UnimplementedWeaviateServer) KeyValuePut(context.Context, *KeyValuePutRequest) (*KeyValuePutReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyValuePut not implemented")
+}
+func (UnimplementedWeaviateServer) KeyValueDelete(context.Context, *KeyValueDeleteRequest) (*KeyValueDeleteReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyValueDelete not implemented")
+}
+func (UnimplementedWeaviateServer) KeyValueCompareAndSwap(context.Context, *KeyValueCompareAndSwapRequest) (*KeyValueCompareAndSwapReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KeyValueCompareAndSwap not implemented")
+}
 func (UnimplementedWeaviateServer) mustEmbedUnimplementedWeaviateServer() {}
 
 // UnsafeWeaviateServer may be embedded to opt out of forward compatibility for this service.
@@ -181,6 +237,78 @@ func _Weaviate_TenantsGet_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Weaviate_KeyValueGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyValueGetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).KeyValueGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/KeyValueGet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).KeyValueGet(ctx, req.(*KeyValueGetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_KeyValuePut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyValuePutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).KeyValuePut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/KeyValuePut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).KeyValuePut(ctx, req.(*KeyValuePutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_KeyValueDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyValueDeleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).KeyValueDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/KeyValueDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).KeyValueDelete(ctx, req.(*KeyValueDeleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Weaviate_KeyValueCompareAndSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KeyValueCompareAndSwapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WeaviateServer).KeyValueCompareAndSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/weaviate.v1.Weaviate/KeyValueCompareAndSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WeaviateServer).KeyValueCompareAndSwap(ctx, req.(*KeyValueCompareAndSwapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Weaviate_ServiceDesc is the grpc.ServiceDesc for Weaviate service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -204,6 +332,22 @@ var Weaviate_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TenantsGet",
 			Handler:    _Weaviate_TenantsGet_Handler,
 		},
+		{
+			MethodName: "KeyValueGet",
+			Handler:    _Weaviate_KeyValueGet_Handler,
+		},
+		{
+			MethodName: "KeyValuePut",
+			Handler:    _Weaviate_KeyValuePut_Handler,
+		},
+		{
+			MethodName: "KeyValueDelete",
+			Handler:    _Weaviate_KeyValueDelete_Handler,
+		},
+		{
+			MethodName: "KeyValueCompareAndSwap",
+			Handler:    _Weaviate_KeyValueCompareAndSwap_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "v1/weaviate.proto",
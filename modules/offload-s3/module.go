@@ -48,6 +48,7 @@ const (
 // verify we implement the modules.Module interface
 var (
 	_ = modulecapabilities.Module(&Module{})
+	_ = modulecapabilities.OffloadSegments(&Module{})
 )
 
 type Module struct {
@@ -385,6 +386,47 @@ func (m *Module) Delete(ctx context.Context, className, shardName, nodeName stri
 	return nil
 }
 
+// UploadSegment uploads a single lsmkv segment for tiered storage
+// {cloud_provider}://{configured_bucket}/segments/{key}
+func (m *Module) UploadSegment(ctx context.Context, key, localPath string) error {
+	return m.runSegmentOp(ctx, "upload_segment", "cp", localPath, m.segmentURL(key))
+}
+
+// DownloadSegment downloads a single lsmkv segment from tiered storage
+func (m *Module) DownloadSegment(ctx context.Context, key, localPath string) error {
+	return m.runSegmentOp(ctx, "download_segment", "cp", m.segmentURL(key), localPath)
+}
+
+// DeleteSegment deletes a single lsmkv segment from tiered storage
+func (m *Module) DeleteSegment(ctx context.Context, key string) error {
+	err := m.runSegmentOp(ctx, "delete_segment", "rm", m.segmentURL(key))
+	if err != nil && !strings.Contains(err.Error(), "no object found") {
+		return err
+	}
+	return nil
+}
+
+func (m *Module) segmentURL(key string) string {
+	return fmt.Sprintf("s3://%s/segments/%s", m.Bucket, key)
+}
+
+func (m *Module) runSegmentOp(ctx context.Context, op string, args ...string) (err error) {
+	start := time.Now()
+	defer func() {
+		status := "success"
+		if err != nil {
+			status = "failed"
+		}
+		m.metrics.OpsDuration.WithLabelValues(op, status).Observe(time.Since(start).Seconds())
+	}()
+
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	cmd := append([]string{fmt.Sprintf("--endpoint-url=%s", m.Endpoint)}, args...)
+	return m.app.RunContext(ctx, cmd)
+}
+
 func dirSize(path string) (int64, error) {
 	var size int64
 	err := filepath.Walk(path, func(_ string, info os.FileInfo, err error) error {
@@ -425,7 +425,7 @@ func (f *fakeRemoteClient) MergeObject(ctx context.Context, hostName, indexName,
 }
 
 func (f *fakeRemoteClient) SearchShard(ctx context.Context, hostName, indexName,
-	shardName string, vector []models.Vector, targetVector []string, limit int, filters *filters.LocalFilter,
+	shardName string, vector []models.Vector, targetVector []string, distance float32, limit int, filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 	cursor *filters.Cursor, groupBy *searchparams.GroupBy, additional additional.Properties, targetCombination *dto.TargetCombination,
 	properties []string,
@@ -485,6 +485,18 @@ func (f *fakeRemoteClient) UpdateShardStatus(ctx context.Context, hostName, inde
 	return nil
 }
 
+func (f *fakeRemoteClient) OpenSnapshot(ctx context.Context, hostName, indexName, shardName,
+	token string, ttl time.Duration,
+) error {
+	return nil
+}
+
+func (f *fakeRemoteClient) ReleaseSnapshot(ctx context.Context, hostName, indexName, shardName,
+	token string,
+) (bool, error) {
+	return false, nil
+}
+
 func (f *fakeRemoteClient) DigestObjects(ctx context.Context,
 	hostName, indexName, shardName string, ids []strfmt.UUID,
 ) (result []replica.RepairResponse, err error) {
@@ -551,6 +563,12 @@ func (f *fakeReplicationClient) AddReferences(ctx context.Context, host, index,
 	return replica.SimpleResponse{}, nil
 }
 
+func (f *fakeReplicationClient) WriteKeyValue(ctx context.Context, host, index, shard, requestID string,
+	write *objects.KeyValueWrite, schemaVersion uint64,
+) (replica.SimpleResponse, error) {
+	return replica.SimpleResponse{}, nil
+}
+
 func (f *fakeReplicationClient) Commit(ctx context.Context, host, index, shard, requestID string, resp interface{}) error {
 	return nil
 }
@@ -607,3 +625,8 @@ func (c *fakeReplicationClient) HashTreeLevel(ctx context.Context, host, index,
 ) (digests []hashtree.Digest, err error) {
 	return nil, nil
 }
+
+func (c *fakeReplicationClient) FetchKeyValue(ctx context.Context, host, index, shard, key string,
+) (objects.KeyValue, error) {
+	return objects.KeyValue{}, nil
+}
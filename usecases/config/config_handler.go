@@ -88,6 +88,7 @@ type Config struct {
 	Debug                               bool                     `json:"debug" yaml:"debug"`
 	QueryDefaults                       QueryDefaults            `json:"query_defaults" yaml:"query_defaults"`
 	QueryMaximumResults                 int64                    `json:"query_maximum_results" yaml:"query_maximum_results"`
+	QueryMaximumRadiusResults           int64                    `json:"query_maximum_radius_results" yaml:"query_maximum_radius_results"`
 	QueryNestedCrossReferenceLimit      int64                    `json:"query_nested_cross_reference_limit" yaml:"query_nested_cross_reference_limit"`
 	QueryCrossReferenceDepthLimit       int                      `json:"query_cross_reference_depth_limit" yaml:"query_cross_reference_depth_limit"`
 	Contextionary                       Contextionary            `json:"contextionary" yaml:"contextionary"`
@@ -222,15 +223,29 @@ type Profiling struct {
 }
 
 type Persistence struct {
-	DataPath                          string `json:"dataPath" yaml:"dataPath"`
-	MemtablesFlushDirtyAfter          int    `json:"flushDirtyMemtablesAfter" yaml:"flushDirtyMemtablesAfter"`
-	MemtablesMaxSizeMB                int    `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
-	MemtablesMinActiveDurationSeconds int    `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
-	MemtablesMaxActiveDurationSeconds int    `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
-	LSMMaxSegmentSize                 int64  `json:"lsmMaxSegmentSize" yaml:"lsmMaxSegmentSize"`
-	LSMSegmentsCleanupIntervalSeconds int    `json:"lsmSegmentsCleanupIntervalSeconds" yaml:"lsmSegmentsCleanupIntervalSeconds"`
-	LSMSeparateObjectsCompactions     bool   `json:"lsmSeparateObjectsCompactions" yaml:"lsmSeparateObjectsCompactions"`
-	HNSWMaxLogSize                    int64  `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
+	DataPath                          string       `json:"dataPath" yaml:"dataPath"`
+	MemtablesFlushDirtyAfter          int          `json:"flushDirtyMemtablesAfter" yaml:"flushDirtyMemtablesAfter"`
+	MemtablesMaxSizeMB                int          `json:"memtablesMaxSizeMB" yaml:"memtablesMaxSizeMB"`
+	MemtablesMinActiveDurationSeconds int          `json:"memtablesMinActiveDurationSeconds" yaml:"memtablesMinActiveDurationSeconds"`
+	MemtablesMaxActiveDurationSeconds int          `json:"memtablesMaxActiveDurationSeconds" yaml:"memtablesMaxActiveDurationSeconds"`
+	LSMMaxSegmentSize                 int64        `json:"lsmMaxSegmentSize" yaml:"lsmMaxSegmentSize"`
+	LSMSegmentsCleanupIntervalSeconds int          `json:"lsmSegmentsCleanupIntervalSeconds" yaml:"lsmSegmentsCleanupIntervalSeconds"`
+	LSMSeparateObjectsCompactions     bool         `json:"lsmSeparateObjectsCompactions" yaml:"lsmSeparateObjectsCompactions"`
+	LSMVerifyChecksumsOnLoad          bool         `json:"lsmVerifyChecksumsOnLoad" yaml:"lsmVerifyChecksumsOnLoad"`
+	LSMVerifyChecksumsOnRead          bool         `json:"lsmVerifyChecksumsOnRead" yaml:"lsmVerifyChecksumsOnRead"`
+	LSMWriteSegmentChecksums          bool         `json:"lsmWriteSegmentChecksums" yaml:"lsmWriteSegmentChecksums"`
+	LSMQuarantineCorruptSegments      bool         `json:"lsmQuarantineCorruptSegments" yaml:"lsmQuarantineCorruptSegments"`
+	LSMTieringBackend                 string       `json:"lsmTieringBackend" yaml:"lsmTieringBackend"`
+	LSMTieringFilesystemPath          string       `json:"lsmTieringFilesystemPath" yaml:"lsmTieringFilesystemPath"`
+	LSMTieringMinAgeSeconds           int          `json:"lsmTieringMinAgeSeconds" yaml:"lsmTieringMinAgeSeconds"`
+	LSMTieringMinSegmentSize          int64        `json:"lsmTieringMinSegmentSize" yaml:"lsmTieringMinSegmentSize"`
+	LSMTieringCacheMaxSize            int64        `json:"lsmTieringCacheMaxSize" yaml:"lsmTieringCacheMaxSize"`
+	LSMCompactionMaxBytesPerSecond    int64        `json:"lsmCompactionMaxBytesPerSecond" yaml:"lsmCompactionMaxBytesPerSecond"`
+	LSMCompactionMaxConcurrent        int          `json:"lsmCompactionMaxConcurrent" yaml:"lsmCompactionMaxConcurrent"`
+	LSMCompactionHeavyWindows         []TimeWindow `json:"lsmCompactionHeavyWindows" yaml:"lsmCompactionHeavyWindows"`
+	LSMCompactionHeavyMinSize         int64        `json:"lsmCompactionHeavyMinSize" yaml:"lsmCompactionHeavyMinSize"`
+	ObjectsTTLDeleteIntervalSeconds   int          `json:"objectsTtlDeleteIntervalSeconds" yaml:"objectsTtlDeleteIntervalSeconds"`
+	HNSWMaxLogSize                    int64        `json:"hnswMaxLogSize" yaml:"hnswMaxLogSize"`
 }
 
 // DefaultPersistenceDataPath is the default location for data directory when no location is provided
@@ -245,6 +260,28 @@ const DefaultPersistenceLSMMaxSegmentSize = math.MaxInt64
 // value = 0 means cleanup is turned off.
 const DefaultPersistenceLSMSegmentsCleanupIntervalSeconds = 0
 
+// LSMTieringBackendFilesystem selects a local directory as the backend for
+// tiered lsmkv segments, any other non-empty value names an offload module
+const LSMTieringBackendFilesystem = "filesystem"
+
+// DefaultPersistenceLSMTieringMinAgeSeconds is the time since a segment was
+// last written before it is moved to tiered storage
+const DefaultPersistenceLSMTieringMinAgeSeconds = 7 * 24 * 3600
+
+const (
+	DefaultPersistenceLSMTieringMinSegmentSize = 64 * 1024 * 1024
+	DefaultPersistenceLSMTieringCacheMaxSize   = 10 * 1024 * 1024 * 1024
+)
+
+// DefaultPersistenceLSMCompactionHeavyMinSize is the combined size of two
+// segments above which their compaction is only started within one of the
+// configured heavy compaction windows
+const DefaultPersistenceLSMCompactionHeavyMinSize = 1024 * 1024 * 1024
+
+// DefaultPersistenceObjectsTTLDeleteIntervalSeconds is how often each shard
+// of a collection with an object TTL looks for expired objects.
+const DefaultPersistenceObjectsTTLDeleteIntervalSeconds = 60
+
 const DefaultPersistenceHNSWMaxLogSize = 500 * 1024 * 1024 // 500MB for backward compatibility
 
 // MetadataServer is experimental.
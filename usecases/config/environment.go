@@ -248,6 +248,38 @@ func FromEnv(config *Config) error {
 		config.Persistence.LSMSeparateObjectsCompactions = true
 	}
 
+	if entcfg.Enabled(os.Getenv("PERSISTENCE_LSM_VERIFY_CHECKSUMS_ON_LOAD")) {
+		config.Persistence.LSMVerifyChecksumsOnLoad = true
+	}
+
+	if entcfg.Enabled(os.Getenv("PERSISTENCE_LSM_VERIFY_CHECKSUMS_ON_READ")) {
+		config.Persistence.LSMVerifyChecksumsOnRead = true
+	}
+
+	if entcfg.Enabled(os.Getenv("PERSISTENCE_LSM_WRITE_SEGMENT_CHECKSUMS")) {
+		config.Persistence.LSMWriteSegmentChecksums = true
+	}
+
+	if entcfg.Enabled(os.Getenv("PERSISTENCE_LSM_QUARANTINE_CORRUPT_SEGMENTS")) {
+		config.Persistence.LSMQuarantineCorruptSegments = true
+	}
+
+	if err := parseLSMTiering(&config.Persistence); err != nil {
+		return err
+	}
+
+	if err := parseLSMCompactionScheduling(&config.Persistence); err != nil {
+		return err
+	}
+
+	if err := parsePositiveInt(
+		"PERSISTENCE_OBJECTS_TTL_DELETE_INTERVAL_SECONDS",
+		func(seconds int) { config.Persistence.ObjectsTTLDeleteIntervalSeconds = seconds },
+		DefaultPersistenceObjectsTTLDeleteIntervalSeconds,
+	); err != nil {
+		return err
+	}
+
 	if v := os.Getenv("PERSISTENCE_HNSW_MAX_LOG_SIZE"); v != "" {
 		parsed, err := parseResourceString(v)
 		if err != nil {
@@ -330,6 +362,17 @@ func FromEnv(config *Config) error {
 		config.QueryMaximumResults = DefaultQueryMaximumResults
 	}
 
+	if v := os.Getenv("QUERY_MAXIMUM_RADIUS_RESULTS"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("parse QUERY_MAXIMUM_RADIUS_RESULTS as int: %w", err)
+		}
+
+		config.QueryMaximumRadiusResults = int64(asInt)
+	} else {
+		config.QueryMaximumRadiusResults = DefaultQueryMaximumRadiusResults
+	}
+
 	if v := os.Getenv("QUERY_NESTED_CROSS_REFERENCE_LIMIT"); v != "" {
 		limit, err := strconv.ParseInt(v, 10, 64)
 		if err != nil {
@@ -704,6 +747,79 @@ func (c *Config) parseMemtableConfig() error {
 	return nil
 }
 
+func parseLSMTiering(persistence *Persistence) error {
+	persistence.LSMTieringBackend = os.Getenv("PERSISTENCE_LSM_TIERING_BACKEND")
+	persistence.LSMTieringFilesystemPath = os.Getenv("PERSISTENCE_LSM_TIERING_FILESYSTEM_PATH")
+	if persistence.LSMTieringBackend == LSMTieringBackendFilesystem &&
+		persistence.LSMTieringFilesystemPath == "" {
+		return fmt.Errorf("PERSISTENCE_LSM_TIERING_FILESYSTEM_PATH is required for "+
+			"tiering backend %q", LSMTieringBackendFilesystem)
+	}
+
+	if err := parseNonNegativeInt(
+		"PERSISTENCE_LSM_TIERING_MIN_AGE_HOURS",
+		func(hours int) { persistence.LSMTieringMinAgeSeconds = hours * 3600 },
+		DefaultPersistenceLSMTieringMinAgeSeconds/3600,
+	); err != nil {
+		return err
+	}
+
+	persistence.LSMTieringMinSegmentSize = DefaultPersistenceLSMTieringMinSegmentSize
+	if v := os.Getenv("PERSISTENCE_LSM_TIERING_MIN_SEGMENT_SIZE"); v != "" {
+		parsed, err := parseResourceString(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_LSM_TIERING_MIN_SEGMENT_SIZE: %w", err)
+		}
+		persistence.LSMTieringMinSegmentSize = parsed
+	}
+
+	persistence.LSMTieringCacheMaxSize = DefaultPersistenceLSMTieringCacheMaxSize
+	if v := os.Getenv("PERSISTENCE_LSM_TIERING_CACHE_MAX_SIZE"); v != "" {
+		parsed, err := parseResourceString(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_LSM_TIERING_CACHE_MAX_SIZE: %w", err)
+		}
+		persistence.LSMTieringCacheMaxSize = parsed
+	}
+
+	return nil
+}
+
+func parseLSMCompactionScheduling(persistence *Persistence) error {
+	if v := os.Getenv("PERSISTENCE_LSM_COMPACTION_MAX_BYTES_PER_SECOND"); v != "" {
+		parsed, err := parseResourceString(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_LSM_COMPACTION_MAX_BYTES_PER_SECOND: %w", err)
+		}
+		persistence.LSMCompactionMaxBytesPerSecond = parsed
+	}
+
+	if err := parseNonNegativeInt(
+		"PERSISTENCE_LSM_COMPACTION_MAX_CONCURRENT",
+		func(val int) { persistence.LSMCompactionMaxConcurrent = val },
+		0,
+	); err != nil {
+		return err
+	}
+
+	windows, err := parseTimeWindows(os.Getenv("PERSISTENCE_LSM_COMPACTION_HEAVY_WINDOWS"))
+	if err != nil {
+		return fmt.Errorf("parse PERSISTENCE_LSM_COMPACTION_HEAVY_WINDOWS: %w", err)
+	}
+	persistence.LSMCompactionHeavyWindows = windows
+
+	persistence.LSMCompactionHeavyMinSize = DefaultPersistenceLSMCompactionHeavyMinSize
+	if v := os.Getenv("PERSISTENCE_LSM_COMPACTION_HEAVY_MIN_SIZE"); v != "" {
+		parsed, err := parseResourceString(v)
+		if err != nil {
+			return fmt.Errorf("parse PERSISTENCE_LSM_COMPACTION_HEAVY_MIN_SIZE: %w", err)
+		}
+		persistence.LSMCompactionHeavyMinSize = parsed
+	}
+
+	return nil
+}
+
 func parsePositiveInt(envName string, cb func(val int), defaultValue int) error {
 	return parseInt(envName, defaultValue, func(val int) error {
 		if val <= 0 {
@@ -797,6 +913,9 @@ func parseClassNamesWithPropsNames(v string) (map[string][]string, error) {
 
 const (
 	DefaultQueryMaximumResults = int64(10000)
+	// DefaultQueryMaximumRadiusResults describes the max number of results of
+	// a single page of a search by distance
+	DefaultQueryMaximumRadiusResults = int64(10000)
 	// DefaultQueryNestedCrossReferenceLimit describes the max number of nested crossrefs returned for a query
 	DefaultQueryNestedCrossReferenceLimit = int64(100000)
 	// DefaultQueryCrossReferenceDepthLimit describes the max depth of nested crossrefs in a query
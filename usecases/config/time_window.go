@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// TimeWindow is a daily recurring window in UTC, Start and End are offsets
+// from midnight. A window with End before Start spans midnight.
+type TimeWindow struct {
+	Start time.Duration `json:"start" yaml:"start"`
+	End   time.Duration `json:"end" yaml:"end"`
+}
+
+// Contains returns true if the time of day of t (in UTC) is within the window
+func (w TimeWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+		time.Duration(t.Second())*time.Second
+
+	if w.Start <= w.End {
+		return offset >= w.Start && offset < w.End
+	}
+	return offset >= w.Start || offset < w.End
+}
+
+func (w TimeWindow) String() string {
+	format := func(d time.Duration) string {
+		return fmt.Sprintf("%02d:%02d", int(d.Hours()), int(d.Minutes())%60)
+	}
+	return format(w.Start) + "-" + format(w.End)
+}
+
+// parseTimeWindows takes a comma-separated list like "22:00-06:00,12:00-13:30"
+func parseTimeWindows(windows string) ([]TimeWindow, error) {
+	var parsed []TimeWindow
+	for _, window := range strings.Split(windows, ",") {
+		window = strings.TrimSpace(window)
+		if window == "" {
+			continue
+		}
+
+		start, end, ok := strings.Cut(window, "-")
+		if !ok {
+			return nil, fmt.Errorf("invalid time window %q, expected HH:MM-HH:MM", window)
+		}
+
+		startOffset, err := parseTimeOfDay(start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time window %q: %w", window, err)
+		}
+		endOffset, err := parseTimeOfDay(end)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time window %q: %w", window, err)
+		}
+		if startOffset == endOffset {
+			return nil, fmt.Errorf("invalid time window %q: start and end are equal", window)
+		}
+
+		parsed = append(parsed, TimeWindow{Start: startOffset, End: endOffset})
+	}
+
+	return parsed, nil
+}
+
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", strings.TrimSpace(s))
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
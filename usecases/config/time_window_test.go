@@ -0,0 +1,74 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeWindows(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []TimeWindow
+		err      bool
+	}{
+		{"Empty", "", nil, false},
+		{"Single", "01:30-05:00", []TimeWindow{{90 * time.Minute, 5 * time.Hour}}, false},
+		{
+			"Multiple", "22:00-06:00, 12:00-13:00",
+			[]TimeWindow{{22 * time.Hour, 6 * time.Hour}, {12 * time.Hour, 13 * time.Hour}}, false,
+		},
+		{"MissingEnd", "22:00", nil, true},
+		{"InvalidTime", "25:00-06:00", nil, true},
+		{"Empty window", "06:00-06:00", nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			windows, err := parseTimeWindows(tt.input)
+			if tt.err {
+				assert.Error(t, err)
+				return
+			}
+			require.Nil(t, err)
+			assert.Equal(t, tt.expected, windows)
+		})
+	}
+}
+
+func TestTimeWindowContains(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	day := TimeWindow{Start: 12 * time.Hour, End: 13 * time.Hour}
+	assert.False(t, day.Contains(at(11, 59)))
+	assert.True(t, day.Contains(at(12, 0)))
+	assert.True(t, day.Contains(at(12, 59)))
+	assert.False(t, day.Contains(at(13, 0)))
+
+	night := TimeWindow{Start: 22 * time.Hour, End: 6 * time.Hour}
+	assert.True(t, night.Contains(at(23, 0)))
+	assert.True(t, night.Contains(at(0, 0)))
+	assert.True(t, night.Contains(at(5, 59)))
+	assert.False(t, night.Contains(at(6, 0)))
+	assert.False(t, night.Contains(at(21, 59)))
+
+	// times are compared in UTC
+	assert.True(t, day.Contains(time.Date(2024, 1, 1, 14, 30, 0, 0, time.FixedZone("CEST", 2*3600))))
+	assert.Equal(t, "22:00-06:00", night.String())
+}
@@ -1098,6 +1098,17 @@ func (p *Provider) OffloadBackend(backend string) (modulecapabilities.OffloadClo
 	return nil, false
 }
 
+func (p *Provider) SegmentOffloadBackend(backend string) (modulecapabilities.OffloadSegments, bool) {
+	if module := p.GetByName(backend); module != nil {
+		if module.Type() == modulecapabilities.Offload {
+			if backend, ok := module.(modulecapabilities.OffloadSegments); ok {
+				return backend, true
+			}
+		}
+	}
+	return nil, false
+}
+
 func (p *Provider) EnabledBackupBackends() []modulecapabilities.BackupBackend {
 	var backends []modulecapabilities.BackupBackend
 	for _, mod := range p.GetAll() {
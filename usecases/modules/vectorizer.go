@@ -24,9 +24,12 @@ import (
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/modulecapabilities"
 	"github.com/weaviate/weaviate/entities/moduletools"
+	"github.com/weaviate/weaviate/entities/vectorindex/diskann"
 	"github.com/weaviate/weaviate/entities/vectorindex/dynamic"
 	"github.com/weaviate/weaviate/entities/vectorindex/flat"
 	"github.com/weaviate/weaviate/entities/vectorindex/hnsw"
+	"github.com/weaviate/weaviate/entities/vectorindex/ivf"
+	"github.com/weaviate/weaviate/entities/vectorindex/sparse"
 	"github.com/weaviate/weaviate/usecases/config"
 )
 
@@ -547,7 +550,10 @@ func (p *Provider) getVectorIndexConfig(class *models.Class, targetVector string
 	hnswConfig, okHnsw := vectorIndexConfig.(hnsw.UserConfig)
 	_, okFlat := vectorIndexConfig.(flat.UserConfig)
 	_, okDynamic := vectorIndexConfig.(dynamic.UserConfig)
-	if !(okHnsw || okFlat || okDynamic) {
+	_, okDiskANN := vectorIndexConfig.(diskann.UserConfig)
+	_, okIVF := vectorIndexConfig.(ivf.UserConfig)
+	_, okSparse := vectorIndexConfig.(sparse.UserConfig)
+	if !(okHnsw || okFlat || okDynamic || okDiskANN || okIVF || okSparse) {
 		return hnsw.UserConfig{}, fmt.Errorf(errorVectorIndexType, vectorIndexConfig)
 	}
 	return hnswConfig, nil
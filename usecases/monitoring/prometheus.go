@@ -44,6 +44,7 @@ type PrometheusMetrics struct {
 	BatchCount                          *prometheus.CounterVec
 	BatchCountBytes                     *prometheus.CounterVec
 	ObjectsTime                         *prometheus.SummaryVec
+	ObjectsTTLExpired                   *prometheus.CounterVec
 	LSMBloomFilters                     *prometheus.SummaryVec
 	AsyncOperations                     *prometheus.GaugeVec
 	LSMSegmentCount                     *prometheus.GaugeVec
@@ -54,6 +55,14 @@ type PrometheusMetrics struct {
 	LSMSegmentSize                      *prometheus.GaugeVec
 	LSMMemtableSize                     *prometheus.GaugeVec
 	LSMMemtableDurations                *prometheus.SummaryVec
+	LSMChecksumFailures                 *prometheus.CounterVec
+	LSMQuarantinedFiles                 *prometheus.CounterVec
+	LSMTieringOperations                *prometheus.CounterVec
+	LSMTieringCacheSize                 prometheus.Gauge
+	LSMCompactionSchedulerQueue         *prometheus.GaugeVec
+	LSMCompactionIOBudget               prometheus.Gauge
+	LSMCompactionWrittenBytes           prometheus.Counter
+	LSMCompactionThrottledSeconds       prometheus.Counter
 	ObjectCount                         *prometheus.GaugeVec
 	QueriesCount                        *prometheus.GaugeVec
 	RequestsTotal                       *prometheus.GaugeVec
@@ -91,6 +100,7 @@ type PrometheusMetrics struct {
 	VectorIndexOperations              *prometheus.GaugeVec
 	VectorIndexDurations               *prometheus.SummaryVec
 	VectorIndexSize                    *prometheus.GaugeVec
+	VectorIndexRecallEstimate          *prometheus.GaugeVec
 	VectorIndexMaintenanceDurations    *prometheus.SummaryVec
 	VectorDimensionsSum                *prometheus.GaugeVec
 	VectorSegmentsSum                  *prometheus.GaugeVec
@@ -231,6 +241,7 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.BatchTime.DeletePartialMatch(labels)
 	pm.BatchDeleteTime.DeletePartialMatch(labels)
 	pm.ObjectsTime.DeletePartialMatch(labels)
+	pm.ObjectsTTLExpired.DeletePartialMatch(labels)
 	pm.ObjectCount.DeletePartialMatch(labels)
 	pm.QueriesFilteredVectorDurations.DeletePartialMatch(labels)
 	pm.AsyncOperations.DeletePartialMatch(labels)
@@ -241,6 +252,9 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.LSMSegmentCount.DeletePartialMatch(labels)
 	pm.LSMSegmentSize.DeletePartialMatch(labels)
 	pm.LSMSegmentCountByLevel.DeletePartialMatch(labels)
+	pm.LSMChecksumFailures.DeletePartialMatch(labels)
+	pm.LSMQuarantinedFiles.DeletePartialMatch(labels)
+	pm.LSMTieringOperations.DeletePartialMatch(labels)
 	pm.QueueSize.DeletePartialMatch(labels)
 	pm.QueueDiskUsage.DeletePartialMatch(labels)
 	pm.QueuePaused.DeletePartialMatch(labels)
@@ -259,6 +273,7 @@ func (pm *PrometheusMetrics) DeleteShard(className, shardName string) error {
 	pm.VectorIndexMaintenanceDurations.DeletePartialMatch(labels)
 	pm.VectorIndexDurations.DeletePartialMatch(labels)
 	pm.VectorIndexSize.DeletePartialMatch(labels)
+	pm.VectorIndexRecallEstimate.DeletePartialMatch(labels)
 	pm.StartupProgress.DeletePartialMatch(labels)
 	pm.StartupDurations.DeletePartialMatch(labels)
 	pm.StartupDiskIO.DeletePartialMatch(labels)
@@ -360,6 +375,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "objects_durations_ms",
 			Help: "Duration of an individual object operation. Also as part of batches.",
 		}, []string{"operation", "step", "class_name", "shard_name"}),
+		ObjectsTTLExpired: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "objects_ttl_expired_total",
+			Help: "Number of objects deleted because their time-to-live expired",
+		}, []string{"class_name", "shard_name"}),
 		ObjectCount: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "object_count",
 			Help: "Number of currently ongoing async operations",
@@ -425,6 +444,38 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "lsm_segment_count",
 			Help: "Number of segments by level",
 		}, []string{"strategy", "class_name", "shard_name", "path", "level"}),
+		LSMChecksumFailures: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_checksum_failures_total",
+			Help: "Number of checksum mismatches detected in segments and write-ahead-logs",
+		}, []string{"strategy", "source", "class_name", "shard_name"}),
+		LSMQuarantinedFiles: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_quarantined_files_total",
+			Help: "Number of corrupt segments and write-ahead-logs moved aside instead of being loaded",
+		}, []string{"strategy", "kind", "class_name", "shard_name"}),
+		LSMTieringOperations: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name: "lsm_tiering_operations_total",
+			Help: "Number of segments offloaded to, fetched from and evicted from the local cache of the tiered storage",
+		}, []string{"operation", "status", "class_name", "shard_name"}),
+		LSMTieringCacheSize: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "lsm_tiering_cache_size_bytes",
+			Help: "Total size of tiered segments currently held in the local cache",
+		}),
+		LSMCompactionSchedulerQueue: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "lsm_compaction_scheduler_queue",
+			Help: "Number of compactions known to the node-wide compaction scheduler by state (running, waiting, deferred)",
+		}, []string{"state"}),
+		LSMCompactionIOBudget: promauto.NewGauge(prometheus.GaugeOpts{
+			Name: "lsm_compaction_io_budget_bytes_per_second",
+			Help: "Disk write budget shared by all compactions of the node, 0 means unlimited",
+		}),
+		LSMCompactionWrittenBytes: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "lsm_compaction_written_bytes_total",
+			Help: "Number of bytes written by compactions",
+		}),
+		LSMCompactionThrottledSeconds: promauto.NewCounter(prometheus.CounterOpts{
+			Name: "lsm_compaction_throttled_seconds_total",
+			Help: "Time compactions spent waiting for the IO budget",
+		}),
 		LSMMemtableSize: promauto.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "lsm_memtable_size",
 			Help: "Size of memtable by path",
@@ -503,6 +554,10 @@ func newPrometheusMetrics() *PrometheusMetrics {
 			Name: "vector_index_size",
 			Help: "The size of the vector index. Typically larger than number of vectors, as it grows proactively.",
 		}, []string{"class_name", "shard_name"}),
+		VectorIndexRecallEstimate: promauto.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "vector_index_recall_estimate",
+			Help: "Estimated recall@k of the vector index, measured by comparing the results for sampled vectors to an exact search",
+		}, []string{"class_name", "shard_name", "target_vector"}),
 		VectorIndexMaintenanceDurations: promauto.NewSummaryVec(prometheus.SummaryOpts{
 			Name: "vector_index_maintenance_durations_ms",
 			Help: "Duration of a sync or async vector index maintenance operation",
@@ -17,6 +17,7 @@ import (
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -154,6 +155,49 @@ func Test_Kinds_Authorization(t *testing.T) {
 			expectedVerb:      authorization.UPDATE,
 			expectedResources: []string{authorization.Objects("class", "", "foo")},
 		},
+
+		// key-value store of a collection
+		{
+			methodName:        "GetKeyValue",
+			additionalArgs:    []interface{}{"class", "key", (*additional.ReplicationProperties)(nil), "tenant"},
+			expectedVerb:      authorization.READ,
+			expectedResources: []string{authorization.Objects("class", "tenant", "")},
+		},
+		{
+			methodName:        "PutKeyValue",
+			additionalArgs:    []interface{}{"class", "key", []byte("value"), (*additional.ReplicationProperties)(nil), "tenant"},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Objects("class", "tenant", "")},
+		},
+		{
+			methodName:        "DeleteKeyValue",
+			additionalArgs:    []interface{}{"class", "key", (*additional.ReplicationProperties)(nil), "tenant"},
+			expectedVerb:      authorization.DELETE,
+			expectedResources: []string{authorization.Objects("class", "tenant", "")},
+		},
+		{
+			methodName: "CompareAndSwapKeyValue",
+			additionalArgs: []interface{}{
+				"class", "key", KeyValueCondition{Absent: true}, []byte("value"), false,
+				(*additional.ReplicationProperties)(nil), "tenant",
+			},
+			expectedVerb:      authorization.UPDATE,
+			expectedResources: []string{authorization.Objects("class", "tenant", "")},
+		},
+
+		// read snapshots of a collection
+		{
+			methodName:        "OpenSnapshot",
+			additionalArgs:    []interface{}{"class", "tenant", time.Minute},
+			expectedVerb:      authorization.READ,
+			expectedResources: []string{authorization.Objects("class", "tenant", "")},
+		},
+		{
+			methodName:        "ReleaseSnapshot",
+			additionalArgs:    []interface{}{"class", "tenant", "token"},
+			expectedVerb:      authorization.READ,
+			expectedResources: []string{authorization.Objects("class", "tenant", "")},
+		},
 	}
 
 	t.Run("verify that a test for every public method exists", func(t *testing.T) {
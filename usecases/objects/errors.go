@@ -98,6 +98,21 @@ func NewErrNotFound(format string, args ...interface{}) ErrNotFound {
 	return ErrNotFound{msg: fmt.Sprintf(format, args...)}
 }
 
+// ErrPreconditionFailed indicates that a conditional write was not applied
+// because the current state did not match the expected one
+type ErrPreconditionFailed struct {
+	msg string
+}
+
+func (e ErrPreconditionFailed) Error() string {
+	return e.msg
+}
+
+// NewErrPreconditionFailed with Errorf signature
+func NewErrPreconditionFailed(format string, args ...interface{}) ErrPreconditionFailed {
+	return ErrPreconditionFailed{msg: fmt.Sprintf(format, args...)}
+}
+
 type ErrMultiTenancy struct {
 	err error
 }
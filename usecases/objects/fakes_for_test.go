@@ -246,6 +246,36 @@ func (f *fakeVectorRepo) Query(ctx context.Context, q *QueryInput) (search.Resul
 	return res, err
 }
 
+func (f *fakeVectorRepo) GetKeyValue(ctx context.Context, class, key string,
+	repl *additional.ReplicationProperties, tenant string,
+) (*KeyValue, error) {
+	args := f.Called(class, key, tenant)
+	if args.Get(0) != nil {
+		return args.Get(0).(*KeyValue), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (f *fakeVectorRepo) WriteKeyValue(ctx context.Context, class string, write *KeyValueWrite,
+	repl *additional.ReplicationProperties, tenant string, schemaVersion uint64,
+) (*KeyValue, error) {
+	args := f.Called(class, write, tenant)
+	if args.Get(0) != nil {
+		return args.Get(0).(*KeyValue), args.Error(1)
+	}
+	return nil, args.Error(1)
+}
+
+func (f *fakeVectorRepo) OpenSnapshot(ctx context.Context, class, tenant string, ttl time.Duration) (string, error) {
+	args := f.Called(class, tenant, ttl)
+	return args.String(0), args.Error(1)
+}
+
+func (f *fakeVectorRepo) ReleaseSnapshot(ctx context.Context, class, tenant, token string) error {
+	args := f.Called(class, tenant, token)
+	return args.Error(0)
+}
+
 func (f *fakeVectorRepo) PutObject(ctx context.Context, concept *models.Object, vector []float32,
 	vectors map[string][]float32, multiVectors map[string][][]float32, repl *additional.ReplicationProperties, schemaVersion uint64,
 ) error {
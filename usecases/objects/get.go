@@ -246,13 +246,27 @@ func (m *Manager) trackUsageList(res search.Results) {
 	m.metrics.AddUsageDimensions(res[0].ClassName, "get_rest", "list_include_vector", res[0].Dims)
 }
 
-func (m *Manager) getCursor(after *string, limit *int64) *filters.Cursor {
-	if after != nil {
-		if limit == nil {
-			// limit -1 means that no limit param was set
-			return &filters.Cursor{After: *after, Limit: -1}
-		}
-		return &filters.Cursor{After: *after, Limit: int(*limit)}
+func (m *Manager) getCursor(q *QueryParams) *filters.Cursor {
+	reverse := q.Reverse != nil && *q.Reverse
+	if q.After == nil && q.Before == nil && q.Prefix == nil && !reverse {
+		return nil
 	}
-	return nil
+
+	cursor := &filters.Cursor{Reverse: reverse}
+	if q.After != nil {
+		cursor.After = *q.After
+	}
+	if q.Before != nil {
+		cursor.Before = *q.Before
+	}
+	if q.Prefix != nil {
+		cursor.Prefix = *q.Prefix
+	}
+	if q.Limit == nil {
+		// limit -1 means that no limit param was set
+		cursor.Limit = -1
+	} else {
+		cursor.Limit = int(*q.Limit)
+	}
+	return cursor
 }
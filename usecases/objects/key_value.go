@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/classcache"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	// MaxKeyValueKeyLength is the maximum length in bytes of a key stored in
+	// the key-value store of a collection
+	MaxKeyValueKeyLength = 1024
+	// MaxKeyValueValueSize is the maximum size in bytes of a value stored in
+	// the key-value store of a collection
+	MaxKeyValueValueSize = 1 << 20
+)
+
+// KeyValue is a single entry of the key-value store which sits next to the
+// objects of a collection (or of a tenant). Entries are not vectorized nor
+// indexed, they are meant for small application metadata such as counters,
+// checkpoints or settings.
+type KeyValue struct {
+	Key                     string `json:"key"`
+	Value                   []byte `json:"value,omitempty"`
+	LastUpdateTimeUnixMilli int64  `json:"lastUpdateTimeUnixMilli"`
+	// Deleted is only set on replica responses, so that a deletion can win
+	// over an older value held by another replica
+	Deleted bool `json:"deleted,omitempty"`
+}
+
+// KeyValueWrite either sets or deletes a single key. If a condition is set,
+// the write is only applied if the current entry satisfies it.
+type KeyValueWrite struct {
+	Key        string             `json:"key"`
+	Value      []byte             `json:"value,omitempty"`
+	Delete     bool               `json:"delete,omitempty"`
+	UpdateTime int64              `json:"updateTime"`
+	Condition  *KeyValueCondition `json:"condition,omitempty"`
+}
+
+// KeyValueCondition is the expected state of an entry for a compare-and-swap
+// write. If Absent is set, the key must not exist. Otherwise the key must
+// exist and hold exactly Value.
+type KeyValueCondition struct {
+	Absent bool   `json:"absent,omitempty"`
+	Value  []byte `json:"value,omitempty"`
+}
+
+// Matches reports whether current, which is nil for a key which has never
+// been written, satisfies the condition
+func (c *KeyValueCondition) Matches(current *KeyValue) bool {
+	exists := current != nil && !current.Deleted
+	if c.Absent {
+		return !exists
+	}
+	return exists && bytes.Equal(c.Value, current.Value)
+}
+
+// GetKeyValue returns the entry stored under key in the key-value store of
+// the given collection and tenant
+func (m *Manager) GetKeyValue(ctx context.Context, principal *models.Principal,
+	class, key string, repl *additional.ReplicationProperties, tenant string,
+) (*KeyValue, error) {
+	if err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, "")); err != nil {
+		return nil, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	if err := validateKeyValueKey(key); err != nil {
+		return nil, err
+	}
+	ctx = classcache.ContextWithClassCache(ctx)
+	if _, err := m.keyValueClassVersion(ctx, principal, class); err != nil {
+		return nil, err
+	}
+
+	kv, err := m.vectorRepo.GetKeyValue(ctx, class, key, repl, tenant)
+	if err != nil {
+		return nil, m.keyValueRepoError("get", err)
+	}
+	if kv == nil || kv.Deleted {
+		return nil, NewErrNotFound("key %q not found in collection %q", key, class)
+	}
+	return kv, nil
+}
+
+// PutKeyValue sets key to value in the key-value store of the given
+// collection and tenant, overwriting any previous value
+func (m *Manager) PutKeyValue(ctx context.Context, principal *models.Principal,
+	class, key string, value []byte, repl *additional.ReplicationProperties, tenant string,
+) (*KeyValue, error) {
+	if err := m.authorizer.Authorize(principal, authorization.UPDATE, authorization.Objects(class, tenant, "")); err != nil {
+		return nil, err
+	}
+
+	return m.writeKeyValue(ctx, principal, class, &KeyValueWrite{Key: key, Value: value}, repl, tenant)
+}
+
+// DeleteKeyValue removes key from the key-value store of the given
+// collection and tenant. Deleting a key which does not exist is not an error.
+func (m *Manager) DeleteKeyValue(ctx context.Context, principal *models.Principal,
+	class, key string, repl *additional.ReplicationProperties, tenant string,
+) error {
+	if err := m.authorizer.Authorize(principal, authorization.DELETE, authorization.Objects(class, tenant, "")); err != nil {
+		return err
+	}
+
+	_, err := m.writeKeyValue(ctx, principal, class, &KeyValueWrite{Key: key, Delete: true}, repl, tenant)
+	return err
+}
+
+// CompareAndSwapKeyValue sets key to value, or deletes it if del is set, but
+// only if the current entry matches cond. Otherwise ErrPreconditionFailed is
+// returned and nothing is written. The returned entry is nil if the key was
+// deleted.
+func (m *Manager) CompareAndSwapKeyValue(ctx context.Context, principal *models.Principal,
+	class, key string, cond KeyValueCondition, value []byte, del bool,
+	repl *additional.ReplicationProperties, tenant string,
+) (*KeyValue, error) {
+	verb := authorization.UPDATE
+	if del {
+		verb = authorization.DELETE
+	}
+	if err := m.authorizer.Authorize(principal, verb, authorization.Objects(class, tenant, "")); err != nil {
+		return nil, err
+	}
+	if del && len(value) > 0 {
+		return nil, NewErrInvalidUserInput("a value cannot be set when deleting a key")
+	}
+
+	write := &KeyValueWrite{Key: key, Value: value, Delete: del, Condition: &cond}
+	return m.writeKeyValue(ctx, principal, class, write, repl, tenant)
+}
+
+func (m *Manager) writeKeyValue(ctx context.Context, principal *models.Principal,
+	class string, write *KeyValueWrite, repl *additional.ReplicationProperties, tenant string,
+) (*KeyValue, error) {
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return nil, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	if err := validateKeyValueKey(write.Key); err != nil {
+		return nil, err
+	}
+	if len(write.Value) > MaxKeyValueValueSize {
+		return nil, NewErrInvalidUserInput("value of key %q exceeds the maximum size of %d bytes",
+			write.Key, MaxKeyValueValueSize)
+	}
+
+	ctx = classcache.ContextWithClassCache(ctx)
+	version, err := m.keyValueClassVersion(ctx, principal, class)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.schemaManager.WaitForUpdate(ctx, version); err != nil {
+		return nil, fmt.Errorf("error waiting for local schema to catch up to version %d: %w", version, err)
+	}
+
+	write.UpdateTime = m.timeSource.Now()
+	kv, err := m.vectorRepo.WriteKeyValue(ctx, class, write, repl, tenant, version)
+	if err != nil {
+		return nil, m.keyValueRepoError("write", err)
+	}
+	if write.Delete {
+		return nil, nil
+	}
+	return kv, nil
+}
+
+func (m *Manager) keyValueClassVersion(ctx context.Context, principal *models.Principal, class string) (uint64, error) {
+	vclasses, err := m.schemaManager.GetCachedClass(ctx, principal, class)
+	if err != nil {
+		return 0, fmt.Errorf("could not get class %s: %w", class, err)
+	}
+	vclass, ok := vclasses[class]
+	if !ok || vclass.Class == nil {
+		return 0, NewErrNotFound("collection %q not found", class)
+	}
+	return vclass.Version, nil
+}
+
+func (m *Manager) keyValueRepoError(op string, err error) error {
+	var (
+		e1 ErrMultiTenancy
+		e2 ErrInvalidUserInput
+		e3 ErrPreconditionFailed
+	)
+	switch {
+	case errors.As(err, &e1), errors.As(err, &e2), errors.As(err, &e3):
+		return err
+	default:
+		return NewErrInternal("%s key-value entry: %v", op, err)
+	}
+}
+
+func validateKeyValueKey(key string) error {
+	if key == "" {
+		return NewErrInvalidUserInput("key must not be empty")
+	}
+	if len(key) > MaxKeyValueKeyLength {
+		return NewErrInvalidUserInput("key exceeds the maximum length of %d bytes", MaxKeyValueKeyLength)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/entities/schema"
+	"github.com/weaviate/weaviate/usecases/auth/authorization/mocks"
+	"github.com/weaviate/weaviate/usecases/config"
+)
+
+func Test_KeyValue(t *testing.T) {
+	cls := "MyClass"
+	ctx := context.Background()
+
+	t.Run("get", func(t *testing.T) {
+		manager, repo := newKeyValueDependency(cls)
+		repo.On("GetKeyValue", cls, "present", "").
+			Return(&KeyValue{Key: "present", Value: []byte("v")}, nil).Once()
+		repo.On("GetKeyValue", cls, "deleted", "").
+			Return(&KeyValue{Key: "deleted", Deleted: true}, nil).Once()
+		repo.On("GetKeyValue", cls, "missing", "").Return(nil, nil).Once()
+
+		kv, err := manager.GetKeyValue(ctx, nil, cls, "present", nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, []byte("v"), kv.Value)
+
+		_, err = manager.GetKeyValue(ctx, nil, cls, "deleted", nil, "")
+		assert.IsType(t, ErrNotFound{}, err)
+
+		_, err = manager.GetKeyValue(ctx, nil, cls, "missing", nil, "")
+		assert.IsType(t, ErrNotFound{}, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("unknown collection", func(t *testing.T) {
+		manager, _ := newKeyValueDependency(cls)
+		_, err := manager.PutKeyValue(ctx, nil, "Unknown", "k", []byte("v"), nil, "")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("invalid keys and values", func(t *testing.T) {
+		manager, _ := newKeyValueDependency(cls)
+		_, err := manager.PutKeyValue(ctx, nil, cls, "", []byte("v"), nil, "")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		longKey := string(make([]byte, MaxKeyValueKeyLength+1))
+		_, err = manager.GetKeyValue(ctx, nil, cls, longKey, nil, "")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, err = manager.PutKeyValue(ctx, nil, cls, "k", make([]byte, MaxKeyValueValueSize+1), nil, "")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, err = manager.CompareAndSwapKeyValue(ctx, nil, cls, "k",
+			KeyValueCondition{Absent: true}, []byte("v"), true, nil, "")
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("writes", func(t *testing.T) {
+		manager, repo := newKeyValueDependency(cls)
+		isPut := mock.MatchedBy(func(w *KeyValueWrite) bool {
+			return w.Key == "k" && string(w.Value) == "v" && !w.Delete &&
+				w.Condition == nil && w.UpdateTime > 0
+		})
+		isDelete := mock.MatchedBy(func(w *KeyValueWrite) bool {
+			return w.Key == "k" && w.Delete && w.Condition == nil
+		})
+		isSwap := mock.MatchedBy(func(w *KeyValueWrite) bool {
+			return w.Key == "k" && w.Condition != nil && string(w.Condition.Value) == "v"
+		})
+		repo.On("WriteKeyValue", cls, isPut, "").Return(&KeyValue{Key: "k", Value: []byte("v")}, nil).Once()
+		repo.On("WriteKeyValue", cls, isDelete, "").Return(nil, nil).Once()
+		repo.On("WriteKeyValue", cls, isSwap, "").
+			Return(nil, NewErrPreconditionFailed("condition not met")).Once()
+
+		kv, err := manager.PutKeyValue(ctx, nil, cls, "k", []byte("v"), nil, "")
+		require.Nil(t, err)
+		assert.Equal(t, "k", kv.Key)
+
+		require.Nil(t, manager.DeleteKeyValue(ctx, nil, cls, "k", nil, ""))
+
+		_, err = manager.CompareAndSwapKeyValue(ctx, nil, cls, "k",
+			KeyValueCondition{Value: []byte("v")}, []byte("w"), false, nil, "")
+		assert.IsType(t, ErrPreconditionFailed{}, err)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestKeyValueCondition(t *testing.T) {
+	present := &KeyValue{Key: "k", Value: []byte("v")}
+	deleted := &KeyValue{Key: "k", Deleted: true}
+
+	absent := KeyValueCondition{Absent: true}
+	assert.True(t, absent.Matches(nil))
+	assert.True(t, absent.Matches(deleted))
+	assert.False(t, absent.Matches(present))
+
+	equal := KeyValueCondition{Value: []byte("v")}
+	assert.True(t, equal.Matches(present))
+	assert.False(t, equal.Matches(nil))
+	assert.False(t, equal.Matches(deleted))
+	assert.False(t, (&KeyValueCondition{Value: []byte("w")}).Matches(present))
+}
+
+func newKeyValueDependency(class string) (*Manager, *fakeVectorRepo) {
+	vectorRepo := new(fakeVectorRepo)
+	logger, _ := test.NewNullLogger()
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{Objects: &models.Schema{
+			Classes: []*models.Class{{Class: class}},
+		}},
+	}
+	manager := NewManager(
+		new(fakeLocks),
+		schemaManager,
+		new(config.WeaviateConfig),
+		logger,
+		mocks.NewMockAuthorizer(),
+		vectorRepo,
+		getFakeModulesProvider(),
+		new(fakeMetrics), nil)
+	return manager, vectorRepo
+}
@@ -141,6 +141,19 @@ type VectorRepo interface {
 		target *crossref.Ref, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error
 	Merge(ctx context.Context, merge MergeDocument, repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) error
 	Query(context.Context, *QueryInput) (search.Results, *Error)
+	// GetKeyValue returns the entry of the collection's key-value store, nil if
+	// the key has never been written
+	GetKeyValue(ctx context.Context, class, key string,
+		repl *additional.ReplicationProperties, tenant string) (*KeyValue, error)
+	// WriteKeyValue sets or deletes an entry of the collection's key-value store
+	WriteKeyValue(ctx context.Context, class string, write *KeyValueWrite,
+		repl *additional.ReplicationProperties, tenant string, schemaVersion uint64) (*KeyValue, error)
+	// OpenSnapshot opens a read snapshot on the local shards of the
+	// collection and returns its token
+	OpenSnapshot(ctx context.Context, class, tenant string, ttl time.Duration) (string, error)
+	// ReleaseSnapshot releases a read snapshot, ErrNotFound if it does not
+	// exist (anymore)
+	ReleaseSnapshot(ctx context.Context, class, tenant, token string) error
 }
 
 type ModulesProvider interface {
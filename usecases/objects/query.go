@@ -36,6 +36,10 @@ type QueryParams struct {
 	Offset     *int64
 	Limit      *int64
 	After      *string
+	Before     *string
+	Prefix     *string
+	Reverse    *bool
+	Snapshot   *string
 	Sort       *string
 	Order      *string
 	Tenant     *string
@@ -48,11 +52,15 @@ func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
 		return nil, err
 	}
 	sort := m.getSort(q.Sort, q.Order)
-	cursor := m.getCursor(q.After, q.Limit)
+	cursor := m.getCursor(q)
 	tenant := ""
 	if q.Tenant != nil {
 		tenant = *q.Tenant
 	}
+	additional := q.Additional
+	if q.Snapshot != nil {
+		additional.Snapshot = *q.Snapshot
+	}
 	return &QueryInput{
 		Class:      q.Class,
 		Offset:     smartOffset,
@@ -60,7 +68,7 @@ func (q *QueryParams) inputs(m *Manager) (*QueryInput, error) {
 		Sort:       sort,
 		Cursor:     cursor,
 		Tenant:     tenant,
-		Additional: q.Additional,
+		Additional: additional,
 	}, nil
 }
 
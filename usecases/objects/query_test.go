@@ -18,6 +18,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/filters"
 	"github.com/weaviate/weaviate/entities/models"
 	"github.com/weaviate/weaviate/entities/schema"
 	"github.com/weaviate/weaviate/entities/search"
@@ -129,6 +130,45 @@ func TestQuery(t *testing.T) {
 			},
 			wantUsageTracking: true,
 		},
+		{
+			name:  "reverse range listing",
+			class: cls,
+			param: QueryParams{
+				Class:   cls,
+				Limit:   ptInt64(10),
+				Before:  ptString("8d5a3aa2-3405-4f73-8f1a-2b9f5a8a1b2c"),
+				Prefix:  ptString("8d"),
+				Reverse: ptBool(true),
+			},
+			wantQueryInput: QueryInput{
+				Class: cls,
+				Limit: 10,
+				Cursor: &filters.Cursor{
+					Before:  "8d5a3aa2-3405-4f73-8f1a-2b9f5a8a1b2c",
+					Prefix:  "8d",
+					Reverse: true,
+					Limit:   10,
+				},
+			},
+			wantResponse: []*models.Object{},
+		},
+		{
+			name:  "snapshot",
+			class: cls,
+			param: QueryParams{
+				Class:    cls,
+				Limit:    ptInt64(10),
+				After:    ptString(""),
+				Snapshot: ptString("token"),
+			},
+			wantQueryInput: QueryInput{
+				Class:      cls,
+				Limit:      10,
+				Cursor:     &filters.Cursor{Limit: 10},
+				Additional: additional.Properties{Snapshot: "token"},
+			},
+			wantResponse: []*models.Object{},
+		},
 		{
 			name:           "bad request",
 			class:          cls,
@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/weaviate/weaviate/entities/classcache"
+	"github.com/weaviate/weaviate/entities/models"
+	"github.com/weaviate/weaviate/usecases/auth/authorization"
+)
+
+const (
+	// DefaultSnapshotTTL is the time-to-live of a read snapshot opened
+	// without an explicit one
+	DefaultSnapshotTTL = 5 * time.Minute
+	// MaxSnapshotTTL is the maximum time-to-live of a read snapshot. A
+	// snapshot keeps the segments it references on disk, so it must not be
+	// held open indefinitely.
+	MaxSnapshotTTL = time.Hour
+)
+
+// OpenSnapshot opens a point-in-time snapshot of the objects of the given
+// collection and tenant on the shards held by this node. The returned token
+// can be passed along with object listings and searches to read the objects
+// as they were when the snapshot was opened. The snapshot is released
+// automatically after ttl.
+func (m *Manager) OpenSnapshot(ctx context.Context, principal *models.Principal,
+	class, tenant string, ttl time.Duration,
+) (string, time.Time, error) {
+	if err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, "")); err != nil {
+		return "", time.Time{}, err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return "", time.Time{}, NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	if ttl == 0 {
+		ttl = DefaultSnapshotTTL
+	}
+	if ttl < 0 || ttl > MaxSnapshotTTL {
+		return "", time.Time{}, NewErrInvalidUserInput(
+			"snapshot ttl must be between 0 and %s, got %s", MaxSnapshotTTL, ttl)
+	}
+	ctx = classcache.ContextWithClassCache(ctx)
+	if _, err := m.keyValueClassVersion(ctx, principal, class); err != nil {
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(ttl)
+	token, err := m.vectorRepo.OpenSnapshot(ctx, class, tenant, ttl)
+	if err != nil {
+		return "", time.Time{}, m.snapshotRepoError("open", err)
+	}
+	return token, expiresAt, nil
+}
+
+// ReleaseSnapshot releases a snapshot opened with OpenSnapshot before its
+// ttl has passed
+func (m *Manager) ReleaseSnapshot(ctx context.Context, principal *models.Principal,
+	class, tenant, token string,
+) error {
+	if err := m.authorizer.Authorize(principal, authorization.READ, authorization.Objects(class, tenant, "")); err != nil {
+		return err
+	}
+
+	unlock, err := m.locks.LockConnector()
+	if err != nil {
+		return NewErrInternal("could not acquire lock: %v", err)
+	}
+	defer unlock()
+
+	ctx = classcache.ContextWithClassCache(ctx)
+	if _, err := m.keyValueClassVersion(ctx, principal, class); err != nil {
+		return err
+	}
+
+	if err := m.vectorRepo.ReleaseSnapshot(ctx, class, tenant, token); err != nil {
+		return m.snapshotRepoError("release", err)
+	}
+	return nil
+}
+
+func (m *Manager) snapshotRepoError(op string, err error) error {
+	var (
+		e1 ErrMultiTenancy
+		e2 ErrInvalidUserInput
+		e3 ErrNotFound
+	)
+	switch {
+	case errors.As(err, &e1), errors.As(err, &e2), errors.As(err, &e3):
+		return err
+	default:
+		return NewErrInternal("%s snapshot: %v", op, err)
+	}
+}
@@ -0,0 +1,75 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package objects
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Snapshot(t *testing.T) {
+	cls := "MyClass"
+	ctx := context.Background()
+
+	t.Run("open", func(t *testing.T) {
+		manager, repo := newKeyValueDependency(cls)
+		repo.On("OpenSnapshot", cls, "", time.Minute).Return("token", nil).Once()
+		repo.On("OpenSnapshot", cls, "", DefaultSnapshotTTL).Return("default", nil).Once()
+
+		before := time.Now()
+		token, expiresAt, err := manager.OpenSnapshot(ctx, nil, cls, "", time.Minute)
+		require.Nil(t, err)
+		assert.Equal(t, "token", token)
+		assert.False(t, expiresAt.Before(before.Add(time.Minute)))
+
+		token, _, err = manager.OpenSnapshot(ctx, nil, cls, "", 0)
+		require.Nil(t, err)
+		assert.Equal(t, "default", token)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("invalid ttl", func(t *testing.T) {
+		manager, _ := newKeyValueDependency(cls)
+		_, _, err := manager.OpenSnapshot(ctx, nil, cls, "", -time.Second)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+
+		_, _, err = manager.OpenSnapshot(ctx, nil, cls, "", MaxSnapshotTTL+time.Second)
+		assert.IsType(t, ErrInvalidUserInput{}, err)
+	})
+
+	t.Run("unknown collection", func(t *testing.T) {
+		manager, _ := newKeyValueDependency(cls)
+		_, _, err := manager.OpenSnapshot(ctx, nil, "Unknown", "", time.Minute)
+		assert.IsType(t, ErrNotFound{}, err)
+
+		err = manager.ReleaseSnapshot(ctx, nil, "Unknown", "", "token")
+		assert.IsType(t, ErrNotFound{}, err)
+	})
+
+	t.Run("release", func(t *testing.T) {
+		manager, repo := newKeyValueDependency(cls)
+		repo.On("ReleaseSnapshot", cls, "", "token").Return(nil).Once()
+		repo.On("ReleaseSnapshot", cls, "", "missing").
+			Return(NewErrNotFound("snapshot not found")).Once()
+		repo.On("ReleaseSnapshot", cls, "", "broken").Return(errors.New("disk failure")).Once()
+
+		require.Nil(t, manager.ReleaseSnapshot(ctx, nil, cls, "", "token"))
+		assert.IsType(t, ErrNotFound{}, manager.ReleaseSnapshot(ctx, nil, cls, "", "missing"))
+		assert.IsType(t, ErrInternal{}, manager.ReleaseSnapshot(ctx, nil, cls, "", "broken"))
+		repo.AssertExpectations(t)
+	})
+}
@@ -13,6 +13,7 @@ package replica
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -196,6 +197,93 @@ func (c *coordinator[T]) Push(ctx context.Context,
 	return c.commitAll(context.Background(), nodeCh, com), level, nil
 }
 
+// PushConditional pushes a conditional write to all replicas of a specific
+// shard. Replicas evaluate the condition when asked to be ready and reject
+// the write with StatusPreconditionFailed if it does not hold. Unlike Push,
+// it waits for the answers of all replicas and aborts the write on all of
+// them if any replica rejects it, so that replicas can't diverge because the
+// condition only held on some of them. Replicas that can't be reached don't
+// veto the write, but count against the consistency level.
+//
+// The rejection of a replica is returned as is, errReplicas is returned if
+// the consistency level can't be reached.
+func (c *coordinator[T]) PushConditional(ctx context.Context,
+	cl ConsistencyLevel,
+	ask readyOp,
+	com commitOp[T],
+) (<-chan _Result[T], int, error) {
+	state, err := c.Resolver.State(c.Shard, cl, "")
+	if err != nil {
+		return nil, 0, fmt.Errorf("%w : class %q shard %q", err, c.Class, c.Shard)
+	}
+	level := state.Level
+	// all prepare requests have been answered when prepareAll returns
+	ctxWithTimeout, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	actives, err := c.prepareAll(ctxWithTimeout, state.Hosts, ask, level)
+	if err != nil {
+		return nil, level, err
+	}
+
+	nodeCh := make(chan string, len(actives))
+	for _, node := range actives {
+		nodeCh <- node
+	}
+	close(nodeCh)
+	return c.commitAll(context.Background(), nodeCh, com), level, nil
+}
+
+// prepareAll asks all replicas to be ready and waits for all of them to
+// answer. It returns the replicas that are ready to commit, see
+// PushConditional.
+func (c *coordinator[T]) prepareAll(ctx context.Context,
+	replicas []string,
+	op readyOp, level int,
+) ([]string, error) {
+	resChan := make(chan _Result[string], len(replicas))
+	var wg sync.WaitGroup
+	wg.Add(len(replicas))
+	for _, replica := range replicas {
+		replica := replica
+		g := func() {
+			defer wg.Done()
+			err := op(ctx, replica, c.TxID)
+			resChan <- _Result[string]{replica, err}
+		}
+		enterrors.GoWrapper(g, c.log)
+	}
+	wg.Wait()
+	close(resChan)
+
+	var rejection error
+	actives := make([]string, 0, len(replicas))
+	for r := range resChan {
+		var replicaErr *Error
+		switch {
+		case r.Err == nil:
+			actives = append(actives, r.Value)
+		case errors.As(r.Err, &replicaErr) && replicaErr.Code == StatusPreconditionFailed:
+			rejection = r.Err
+		default: // connection error
+			c.log.WithField("op", "prepare_all").Error(r.Err)
+		}
+	}
+
+	if rejection == nil && len(actives) >= level {
+		return actives, nil
+	}
+
+	fs := logrus.Fields{"op": "prepare_all", "active": len(actives), "total": len(replicas)}
+	c.log.WithFields(fs).Debug("abort")
+	for _, node := range replicas {
+		c.Abort(ctx, node, c.Class, c.Shard, c.TxID)
+	}
+	if rejection != nil {
+		return nil, rejection
+	}
+	return nil, errReplicas
+}
+
 // Pull data from replica depending on consistency level, trying to reach level successful calls
 // to op, while cycling through replicas for the coordinator's shard.
 //
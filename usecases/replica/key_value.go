@@ -0,0 +1,110 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+// WriteKeyValue sets or deletes an entry of the key-value store of a collection.
+//
+// The condition of a compare-and-swap write is evaluated by every replica
+// when preparing the write, which also reserves the key on the replica until
+// the write is committed or aborted. If the condition does not hold on any
+// replica, or another conditional write on the same key is pending there, the
+// write is aborted on all replicas and objects.ErrPreconditionFailed is
+// returned, see coordinator.PushConditional.
+func (r *Replicator) WriteKeyValue(ctx context.Context,
+	shard string,
+	write *objects.KeyValueWrite,
+	l ConsistencyLevel,
+	schemaVersion uint64,
+) error {
+	coord := newCoordinator[SimpleResponse](r, shard, r.requestID(opWriteKeyValue), r.log)
+	op := func(ctx context.Context, host, requestID string) error {
+		resp, err := r.client.WriteKeyValue(ctx, host, r.class, shard, requestID, write, schemaVersion)
+		if err == nil {
+			err = resp.FirstError()
+		}
+		if err != nil {
+			return fmt.Errorf("%q: %w", host, err)
+		}
+		return nil
+	}
+	push := coord.Push
+	if write.Condition != nil {
+		push = coord.PushConditional
+	}
+	replyCh, level, err := push(ctx, l, op, r.simpleCommit(shard))
+	if err != nil {
+		var replicaErr *Error
+		if errors.As(err, &replicaErr) && replicaErr.Code == StatusPreconditionFailed {
+			return objects.NewErrPreconditionFailed("key %q: %s", write.Key, replicaErr.Msg)
+		}
+		r.log.WithField("op", "push.kv").WithField("class", r.class).
+			WithField("shard", shard).Error(err)
+		return fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+	}
+	err = r.stream.readErrors(1, level, replyCh)[0]
+	if err != nil {
+		var replicaErr *Error
+		if errors.As(err, &replicaErr) && replicaErr.Code == StatusPreconditionFailed {
+			return objects.NewErrPreconditionFailed("key %q: %s", write.Key, replicaErr.Msg)
+		}
+		r.log.WithField("op", "kv").WithField("class", r.class).
+			WithField("shard", shard).WithField("key", write.Key).Error(err)
+	}
+	return err
+}
+
+// GetKeyValue reads an entry of the key-value store of a collection from as
+// many replicas as required by the consistency level and returns the most
+// recent one. Deleted entries are returned as such, nil is returned if none
+// of the replicas knows the key.
+//
+// Unlike objects, entries are not repaired on read.
+func (f *Finder) GetKeyValue(ctx context.Context,
+	l ConsistencyLevel, shard, key string,
+) (*objects.KeyValue, error) {
+	c := newReadCoordinator[objects.KeyValue](f, shard,
+		f.coordinatorPullBackoffInitialInterval, f.coordinatorPullBackoffMaxElapsedTime, f.deletionStrategy)
+	op := func(ctx context.Context, host string, _ bool) (objects.KeyValue, error) {
+		return f.client.cl.FetchKeyValue(ctx, host, f.class, shard, key)
+	}
+	replyCh, _, err := c.Pull(ctx, l, op, "", 20*time.Second)
+	if err != nil {
+		f.log.WithField("op", "pull.kv").Error(err)
+		return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, errReplicas)
+	}
+
+	var latest *objects.KeyValue
+	for r := range replyCh {
+		if r.Err != nil {
+			f.log.WithField("op", "get.kv").WithField("class", f.class).
+				WithField("shard", shard).WithField("key", key).Error(r.Err)
+			return nil, fmt.Errorf("%s %q: %w", msgCLevel, l, errRead)
+		}
+		if r.Value.LastUpdateTimeUnixMilli == 0 {
+			continue // unknown to this replica
+		}
+		if latest == nil || r.Value.LastUpdateTimeUnixMilli > latest.LastUpdateTimeUnixMilli {
+			kv := r.Value
+			latest = &kv
+		}
+	}
+	return latest, nil
+}
@@ -0,0 +1,217 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package replica
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"github.com/weaviate/weaviate/usecases/objects"
+)
+
+func TestReplicatorWriteKeyValue(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		write = &objects.KeyValueWrite{Key: "k", Value: []byte("v"), UpdateTime: 1}
+	)
+
+	t.Run("SuccessWithConsistencyLevelQuorum", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		for _, n := range nodes {
+			f.WClient.On("WriteKeyValue", mock.Anything, n, cls, shard, anyVal, write, uint64(1)).
+				Return(SimpleResponse{}, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+		assert.Nil(t, rep.WriteKeyValue(ctx, shard, write, Quorum, 1))
+	})
+
+	t.Run("PreconditionFailed", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		for _, n := range nodes {
+			f.WClient.On("WriteKeyValue", mock.Anything, n, cls, shard, anyVal, write, uint64(1)).
+				Return(SimpleResponse{}, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil).RunFn = func(a mock.Arguments) {
+				resp := a[5].(*SimpleResponse)
+				*resp = SimpleResponse{Errors: []Error{{Code: StatusPreconditionFailed, Msg: "condition not met"}}}
+			}
+		}
+		err := rep.WriteKeyValue(ctx, shard, write, All, 1)
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+	})
+
+	t.Run("PhaseOneConnectionError", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes[:2])
+		rep := f.newReplicator()
+		f.WClient.On("WriteKeyValue", mock.Anything, "A", cls, shard, anyVal, write, uint64(1)).
+			Return(SimpleResponse{}, nil)
+		f.WClient.On("WriteKeyValue", mock.Anything, "B", cls, shard, anyVal, write, uint64(1)).
+			Return(SimpleResponse{}, errAny)
+		f.WClient.On("Abort", mock.Anything, "A", cls, shard, anyVal).Return(SimpleResponse{}, nil)
+		f.WClient.On("Abort", mock.Anything, "B", cls, shard, anyVal).Return(SimpleResponse{}, nil)
+
+		err := rep.WriteKeyValue(ctx, shard, write, All, 1)
+		assert.ErrorIs(t, err, errReplicas)
+	})
+}
+
+func TestReplicatorCompareAndSwapKeyValue(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+		write = &objects.KeyValueWrite{
+			Key: "k", Value: []byte("v2"), UpdateTime: 1,
+			Condition: &objects.KeyValueCondition{Value: []byte("v1")},
+		}
+		rejected = SimpleResponse{Errors: []Error{{Code: StatusPreconditionFailed, Msg: "condition not met"}}}
+	)
+
+	t.Run("Success", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		for _, n := range nodes {
+			f.WClient.On("WriteKeyValue", mock.Anything, n, cls, shard, anyVal, write, uint64(1)).
+				Return(SimpleResponse{}, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+		assert.Nil(t, rep.WriteKeyValue(ctx, shard, write, Quorum, 1))
+	})
+
+	t.Run("RejectedByOneReplica", func(t *testing.T) {
+		// a quorum of replicas accepts the write, but it must not be applied
+		// on any of them
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		for _, n := range nodes[:2] {
+			f.WClient.On("WriteKeyValue", mock.Anything, n, cls, shard, anyVal, write, uint64(1)).
+				Return(SimpleResponse{}, nil)
+		}
+		f.WClient.On("WriteKeyValue", mock.Anything, "C", cls, shard, anyVal, write, uint64(1)).
+			Return(rejected, nil)
+		for _, n := range nodes {
+			f.WClient.On("Abort", mock.Anything, n, cls, shard, anyVal).Return(SimpleResponse{}, nil)
+		}
+
+		err := rep.WriteKeyValue(ctx, shard, write, Quorum, 1)
+		assert.ErrorAs(t, err, &objects.ErrPreconditionFailed{})
+		f.WClient.AssertNumberOfCalls(t, "Abort", 3)
+		f.WClient.AssertNotCalled(t, "Commit", mock.Anything, mock.Anything, mock.Anything,
+			mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnreachableReplicaDoesNotVeto", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		for _, n := range nodes[:2] {
+			f.WClient.On("WriteKeyValue", mock.Anything, n, cls, shard, anyVal, write, uint64(1)).
+				Return(SimpleResponse{}, nil)
+			f.WClient.On("Commit", ctx, n, cls, shard, anyVal, anyVal).Return(nil)
+		}
+		f.WClient.On("WriteKeyValue", mock.Anything, "C", cls, shard, anyVal, write, uint64(1)).
+			Return(SimpleResponse{}, errAny)
+
+		assert.Nil(t, rep.WriteKeyValue(ctx, shard, write, Quorum, 1))
+		f.WClient.AssertNumberOfCalls(t, "Commit", 2)
+	})
+
+	t.Run("ConsistencyLevelNotReached", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		rep := f.newReplicator()
+		f.WClient.On("WriteKeyValue", mock.Anything, "A", cls, shard, anyVal, write, uint64(1)).
+			Return(SimpleResponse{}, nil)
+		for _, n := range nodes[1:] {
+			f.WClient.On("WriteKeyValue", mock.Anything, n, cls, shard, anyVal, write, uint64(1)).
+				Return(SimpleResponse{}, errAny)
+		}
+		for _, n := range nodes {
+			f.WClient.On("Abort", mock.Anything, n, cls, shard, anyVal).Return(SimpleResponse{}, nil)
+		}
+
+		err := rep.WriteKeyValue(ctx, shard, write, Quorum, 1)
+		assert.ErrorIs(t, err, errReplicas)
+	})
+}
+
+func TestFinderGetKeyValue(t *testing.T) {
+	var (
+		cls   = "C1"
+		shard = "SH1"
+		nodes = []string{"A", "B", "C"}
+		ctx   = context.Background()
+	)
+
+	t.Run("MostRecentWins", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("FetchKeyValue", anyVal, "A", cls, shard, "k").
+			Return(objects.KeyValue{Key: "k", Value: []byte("old"), LastUpdateTimeUnixMilli: 1}, nil)
+		f.RClient.On("FetchKeyValue", anyVal, "B", cls, shard, "k").
+			Return(objects.KeyValue{Key: "k", Value: []byte("new"), LastUpdateTimeUnixMilli: 2}, nil)
+		f.RClient.On("FetchKeyValue", anyVal, "C", cls, shard, "k").
+			Return(objects.KeyValue{}, nil)
+
+		kv, err := finder.GetKeyValue(ctx, All, shard, "k")
+		require.Nil(t, err)
+		require.NotNil(t, kv)
+		assert.Equal(t, []byte("new"), kv.Value)
+	})
+
+	t.Run("DeletionWins", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("FetchKeyValue", anyVal, "A", cls, shard, "k").
+			Return(objects.KeyValue{Key: "k", Value: []byte("v"), LastUpdateTimeUnixMilli: 1}, nil)
+		f.RClient.On("FetchKeyValue", anyVal, "B", cls, shard, "k").
+			Return(objects.KeyValue{Key: "k", Deleted: true, LastUpdateTimeUnixMilli: 2}, nil)
+		f.RClient.On("FetchKeyValue", anyVal, "C", cls, shard, "k").
+			Return(objects.KeyValue{Key: "k", Value: []byte("v"), LastUpdateTimeUnixMilli: 1}, nil)
+
+		kv, err := finder.GetKeyValue(ctx, All, shard, "k")
+		require.Nil(t, err)
+		require.NotNil(t, kv)
+		assert.True(t, kv.Deleted)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A")
+		for _, n := range nodes {
+			f.RClient.On("FetchKeyValue", anyVal, n, cls, shard, "k").Return(objects.KeyValue{}, nil)
+		}
+
+		kv, err := finder.GetKeyValue(ctx, Quorum, shard, "k")
+		require.Nil(t, err)
+		assert.Nil(t, kv)
+	})
+
+	t.Run("ReplicaUnavailable", func(t *testing.T) {
+		f := newFakeFactory(cls, shard, nodes)
+		finder := f.newFinder("A")
+		f.RClient.On("FetchKeyValue", anyVal, "A", cls, shard, "k").
+			Return(objects.KeyValue{Key: "k", LastUpdateTimeUnixMilli: 1}, nil)
+		for _, n := range nodes[1:] {
+			f.RClient.On("FetchKeyValue", anyVal, n, cls, shard, "k").Return(objects.KeyValue{}, errAny)
+		}
+
+		_, err := finder.GetKeyValue(ctx, All, shard, "k")
+		assert.ErrorIs(t, err, errRead)
+	})
+}
@@ -80,6 +80,13 @@ func (f *fakeRClient) HashTreeLevel(ctx context.Context,
 	return args.Get(0).([]hashtree.Digest), args.Error(1)
 }
 
+func (f *fakeRClient) FetchKeyValue(ctx context.Context,
+	host, index, shard, key string,
+) (objects.KeyValue, error) {
+	args := f.Called(ctx, host, index, shard, key)
+	return args.Get(0).(objects.KeyValue), args.Error(1)
+}
+
 type fakeClient struct {
 	mock.Mock
 }
@@ -126,6 +133,13 @@ func (f *fakeClient) AddReferences(ctx context.Context, host, index, shard, requ
 	return args.Get(0).(SimpleResponse), args.Error(1)
 }
 
+func (f *fakeClient) WriteKeyValue(ctx context.Context, host, index, shard, requestID string,
+	write *objects.KeyValueWrite, schemaVersion uint64,
+) (SimpleResponse, error) {
+	args := f.Called(ctx, host, index, shard, requestID, write, schemaVersion)
+	return args.Get(0).(SimpleResponse), args.Error(1)
+}
+
 func (f *fakeClient) Commit(ctx context.Context, host, index, shard, requestID string, resp interface{}) error {
 	args := f.Called(ctx, host, index, shard, requestID, resp)
 	return args.Error(0)
@@ -40,6 +40,7 @@ type RemoteIndexIncomingRepo interface {
 	ReplicateDeletion(ctx context.Context, shardName, requestID string, uuid strfmt.UUID, deletionTime time.Time) SimpleResponse
 	ReplicateDeletions(ctx context.Context, shardName, requestID string, uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) SimpleResponse
 	ReplicateReferences(ctx context.Context, shardName, requestID string, refs []objects.BatchReference) SimpleResponse
+	ReplicateKeyValue(ctx context.Context, shardName, requestID string, write *objects.KeyValueWrite) SimpleResponse
 	CommitReplication(shardName, requestID string) interface{}
 	AbortReplication(shardName, requestID string) interface{}
 	OverwriteObjects(ctx context.Context, shard string, vobjects []*objects.VObject) ([]RepairResponse, error)
@@ -47,6 +48,7 @@ type RemoteIndexIncomingRepo interface {
 	FetchObject(ctx context.Context, shardName string, id strfmt.UUID) (objects.Replica, error)
 	FetchObjects(ctx context.Context, shardName string, ids []strfmt.UUID) ([]objects.Replica, error)
 	DigestObjects(ctx context.Context, shardName string, ids []strfmt.UUID) (result []RepairResponse, err error)
+	FetchKeyValue(ctx context.Context, shardName, key string) (objects.KeyValue, error)
 	DigestObjectsInTokenRange(ctx context.Context, shardName string,
 		initialToken, finalToken uint64, limit int) (result []RepairResponse, lastTokenRead uint64, err error)
 	HashTreeLevel(ctx context.Context, shardName string,
@@ -125,6 +127,16 @@ func (rri *RemoteReplicaIncoming) ReplicateReferences(ctx context.Context, index
 	return index.ReplicateReferences(ctx, shardName, requestID, refs)
 }
 
+func (rri *RemoteReplicaIncoming) ReplicateKeyValue(ctx context.Context, indexName,
+	shardName, requestID string, write *objects.KeyValueWrite, schemaVersion uint64,
+) SimpleResponse {
+	index, simpleResp := rri.indexForIncomingWrite(ctx, indexName, schemaVersion)
+	if simpleResp != nil {
+		return *simpleResp
+	}
+	return index.ReplicateKeyValue(ctx, shardName, requestID, write)
+}
+
 func (rri *RemoteReplicaIncoming) CommitReplication(indexName,
 	shardName, requestID string,
 ) interface{} {
@@ -185,6 +197,16 @@ func (rri *RemoteReplicaIncoming) DigestObjects(ctx context.Context,
 	return index.DigestObjects(ctx, shardName, ids)
 }
 
+func (rri *RemoteReplicaIncoming) FetchKeyValue(ctx context.Context,
+	indexName, shardName, key string,
+) (objects.KeyValue, error) {
+	index, simpleResp := rri.indexForIncomingRead(ctx, indexName)
+	if simpleResp != nil {
+		return objects.KeyValue{}, simpleResp.Errors[0].Err
+	}
+	return index.FetchKeyValue(ctx, shardName, key)
+}
+
 func (rri *RemoteReplicaIncoming) indexForIncomingRead(ctx context.Context, indexName string) (RemoteIndexIncomingRepo, *SimpleResponse) {
 	index := rri.repo.GetIndexForIncomingReplica(schema.ClassName(indexName))
 	if index == nil {
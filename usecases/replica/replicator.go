@@ -34,6 +34,7 @@ const (
 	opPutObjects = iota + 97
 	opAddReferences
 	opDeleteObjects
+	opWriteKeyValue
 )
 
 type (
@@ -195,6 +195,8 @@ type wClient interface {
 		uuids []strfmt.UUID, deletionTime time.Time, dryRun bool, schemaVersion uint64) (SimpleResponse, error)
 	AddReferences(ctx context.Context, host, index, shard, requestID string,
 		refs []objects.BatchReference, schemaVersion uint64) (SimpleResponse, error)
+	WriteKeyValue(ctx context.Context, host, index, shard, requestID string,
+		write *objects.KeyValueWrite, schemaVersion uint64) (SimpleResponse, error)
 	Commit(ctx context.Context, host, index, shard, requestID string, resp interface{}) error
 	Abort(ctx context.Context, host, index, shard, requestID string) (SimpleResponse, error)
 }
@@ -229,6 +231,10 @@ type rClient interface {
 
 	HashTreeLevel(ctx context.Context, host, index, shard string, level int,
 		discriminant *hashtree.Bitset) (digests []hashtree.Digest, err error)
+
+	// FetchKeyValue fetches an entry of the key-value store of a collection,
+	// including deleted entries so that the most recent write can be determined
+	FetchKeyValue(ctx context.Context, host, index, shard, key string) (objects.KeyValue, error)
 }
 
 // finderClient extends RClient with consistency checks
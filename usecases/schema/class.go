@@ -25,6 +25,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
+	"github.com/weaviate/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/weaviate/weaviate/adapters/repos/db/inverted/stopwords"
 	"github.com/weaviate/weaviate/entities/backup"
 	"github.com/weaviate/weaviate/entities/classcache"
@@ -341,6 +342,7 @@ func (h *Handler) setClassDefaults(class *models.Class, globalCfg replication.Gl
 	}
 
 	setInvertedConfigDefaults(class)
+	setPropertyAnalyzerTokenization(class, class.Properties...)
 	for _, prop := range class.Properties {
 		setPropertyDefaults(prop)
 	}
@@ -393,6 +395,25 @@ func setPropertyDefaultTokenization(props ...*models.Property) {
 	}
 }
 
+// setPropertyAnalyzerTokenization makes properties with an analyzer default
+// to the tokenizer of that analyzer
+func setPropertyAnalyzerTokenization(class *models.Class, props ...*models.Property) {
+	if class.InvertedIndexConfig == nil {
+		return
+	}
+	for _, prop := range props {
+		if prop.Analyzer == "" || prop.Tokenization != "" {
+			continue
+		}
+		if cfg := analysis.Find(class.InvertedIndexConfig.Analyzers, prop.Analyzer); cfg != nil {
+			prop.Tokenization = cfg.Tokenizer
+			if prop.Tokenization == "" {
+				prop.Tokenization = models.PropertyTokenizationWord
+			}
+		}
+	}
+}
+
 func setPropertyDefaultIndexing(props ...*models.Property) {
 	for _, prop := range props {
 		// if IndexInverted is set but IndexFilterable and IndexSearchable are not
@@ -594,6 +615,10 @@ func (h *Handler) validateProperty(
 			return err
 		}
 
+		if err := validatePropertyAnalyzer(class, property); err != nil {
+			return err
+		}
+
 		if err := h.validatePropModuleConfig(class, property); err != nil {
 			return err
 		}
@@ -653,6 +678,10 @@ func (h *Handler) validateCanAddClass(
 		return err
 	}
 
+	if err := validateObjectTTL(class); err != nil {
+		return err
+	}
+
 	if err := replica.ValidateConfig(class, h.config.Replication); err != nil {
 		return err
 	}
@@ -750,6 +779,53 @@ func (h *Handler) validatePropertyIndexing(prop *models.Property) error {
 			}
 		}
 	}
+	if prop.IndexPositions != nil && *prop.IndexPositions {
+		switch dataType {
+		case schema.DataTypeString, schema.DataTypeStringArray,
+			schema.DataTypeText, schema.DataTypeTextArray:
+			if prop.IndexSearchable != nil && !*prop.IndexSearchable {
+				return fmt.Errorf("`indexPositions` requires `indexSearchable` to be enabled")
+			}
+		default:
+			return fmt.Errorf("`indexPositions` is allowed only for text/text[] data types. " +
+				"For other data types set false or leave empty")
+		}
+	}
+
+	return nil
+}
+
+func validatePropertyAnalyzer(class *models.Class, prop *models.Property) error {
+	if prop.Analyzer == "" {
+		return nil
+	}
+
+	switch dataType, _ := schema.AsPrimitive(prop.DataType); dataType {
+	case schema.DataTypeString, schema.DataTypeStringArray,
+		schema.DataTypeText, schema.DataTypeTextArray:
+	default:
+		return fmt.Errorf("`analyzer` is allowed only for text/text[] data types. " +
+			"For other data types leave it empty")
+	}
+
+	var analyzers []*models.TextAnalyzerConfig
+	if class.InvertedIndexConfig != nil {
+		analyzers = class.InvertedIndexConfig.Analyzers
+	}
+	cfg := analysis.Find(analyzers, prop.Analyzer)
+	if cfg == nil {
+		return fmt.Errorf("property %q: analyzer %q is not defined in the invertedIndexConfig of class %q",
+			prop.Name, prop.Analyzer, class.Class)
+	}
+
+	tokenizer := cfg.Tokenizer
+	if tokenizer == "" {
+		tokenizer = models.PropertyTokenizationWord
+	}
+	if prop.Tokenization != tokenizer {
+		return fmt.Errorf("property %q: tokenization %q does not match tokenizer %q of analyzer %q",
+			prop.Name, prop.Tokenization, tokenizer, prop.Analyzer)
+	}
 
 	return nil
 }
@@ -812,7 +888,8 @@ func (h *Handler) validateVectorizer(vectorizer string) error {
 
 func (h *Handler) validateVectorIndexType(vectorIndexType string) error {
 	switch vectorIndexType {
-	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC:
+	case vectorindex.VectorIndexTypeHNSW, vectorindex.VectorIndexTypeFLAT, vectorindex.VectorIndexTypeDYNAMIC,
+		vectorindex.VectorIndexTypeDISKANN, vectorindex.VectorIndexTypeIVF, vectorindex.VectorIndexTypeSPARSE:
 		return nil
 	default:
 		return errors.Errorf("unrecognized or unsupported vectorIndexType %q",
@@ -833,6 +910,57 @@ func validateMT(class *models.Class) error {
 	return nil
 }
 
+func validateObjectTTL(class *models.Class) error {
+	cfg := class.ObjectTTLConfig
+	if cfg == nil {
+		return nil
+	}
+
+	if cfg.TTLSeconds < 0 {
+		return fmt.Errorf("objectTtlConfig: ttlSeconds must not be negative, got %d", cfg.TTLSeconds)
+	}
+
+	switch deleteOn := schema.ObjectTTLDeleteOn(class); deleteOn {
+	case models.ObjectTTLConfigDeleteOnCreationTime, models.ObjectTTLConfigDeleteOnUpdateTime:
+		if cfg.DateProperty != "" {
+			return fmt.Errorf("objectTtlConfig: dateProperty can only be set if deleteOn is %q",
+				models.ObjectTTLConfigDeleteOnDateProperty)
+		}
+		if cfg.Enabled && (class.InvertedIndexConfig == nil || !class.InvertedIndexConfig.IndexTimestamps) {
+			return fmt.Errorf("objectTtlConfig: deleteOn %q requires invertedIndexConfig.indexTimestamps to be enabled",
+				deleteOn)
+		}
+	case models.ObjectTTLConfigDeleteOnDateProperty:
+		if cfg.DateProperty == "" {
+			return fmt.Errorf("objectTtlConfig: dateProperty is required if deleteOn is %q", deleteOn)
+		}
+		var prop *models.Property
+		for _, p := range class.Properties {
+			if p.Name == cfg.DateProperty {
+				prop = p
+				break
+			}
+		}
+		if prop == nil {
+			return fmt.Errorf("objectTtlConfig: dateProperty %q does not exist", cfg.DateProperty)
+		}
+		if dt, ok := schema.AsPrimitive(prop.DataType); !ok || dt != schema.DataTypeDate {
+			return fmt.Errorf("objectTtlConfig: dateProperty %q must be of data type %q",
+				cfg.DateProperty, schema.DataTypeDate)
+		}
+		filterable := prop.IndexFilterable == nil || *prop.IndexFilterable
+		rangeable := prop.IndexRangeFilters != nil && *prop.IndexRangeFilters
+		if !filterable && !rangeable {
+			return fmt.Errorf("objectTtlConfig: dateProperty %q must have indexFilterable or indexRangeFilters enabled",
+				cfg.DateProperty)
+		}
+	default:
+		return fmt.Errorf("objectTtlConfig: unsupported deleteOn %q", deleteOn)
+	}
+
+	return nil
+}
+
 // validateUpdatingMT validates toggling MT and returns whether mt is enabled
 func validateUpdatingMT(current, update *models.Class) (enabled bool, err error) {
 	enabled = schema.MultiTenancyEnabled(current)
@@ -898,9 +1026,5 @@ func validateVectorIndexConfigImmutableFields(initial, updated *models.Class) er
 			name:     "vectorizer",
 			accessor: func(c *models.Class) string { return c.Vectorizer },
 		},
-		{
-			name:     "vector index type",
-			accessor: func(c *models.Class) string { return c.VectorIndexType },
-		},
 	}...)
 }
@@ -1064,12 +1064,11 @@ func Test_UpdateClass(t *testing.T) {
 						"attempted change from \"model1\" to \"model2\""),
 			},
 			{
-				name:    "ModifyVectorIndexType",
-				initial: &models.Class{Class: "InitialName", VectorIndexType: "hnsw", Vectorizer: "none"},
-				update:  &models.Class{Class: "InitialName", VectorIndexType: "flat", Vectorizer: "none"},
-				expectedError: fmt.Errorf(
-					"vector index type is immutable: " +
-						"attempted change from \"hnsw\" to \"flat\""),
+				// migrated online, see the vector index migration of the shards
+				name:          "ModifyVectorIndexType",
+				initial:       &models.Class{Class: "InitialName", VectorIndexType: "hnsw", Vectorizer: "none"},
+				update:        &models.Class{Class: "InitialName", VectorIndexType: "flat", Vectorizer: "none"},
+				expectedError: nil,
 			},
 			{
 				name:          "UnsupportedVectorIndex",
@@ -1682,6 +1681,137 @@ func Test_AddClass_MultiTenancy(t *testing.T) {
 	})
 }
 
+func Test_AddClass_ObjectTTL(t *testing.T) {
+	ctx := context.Background()
+	vFalse := false
+
+	tests := []struct {
+		name        string
+		config      *models.ObjectTTLConfig
+		timestamps  bool
+		expectedErr string
+	}{
+		{
+			name:       "creation time with timestamps",
+			config:     &models.ObjectTTLConfig{Enabled: true, TTLSeconds: 3600},
+			timestamps: true,
+		},
+		{
+			name:        "creation time without timestamps",
+			config:      &models.ObjectTTLConfig{Enabled: true, TTLSeconds: 3600},
+			expectedErr: "requires invertedIndexConfig.indexTimestamps",
+		},
+		{
+			name: "update time without timestamps",
+			config: &models.ObjectTTLConfig{
+				Enabled:    true,
+				TTLSeconds: 3600,
+				DeleteOn:   models.ObjectTTLConfigDeleteOnUpdateTime,
+			},
+			expectedErr: "requires invertedIndexConfig.indexTimestamps",
+		},
+		{
+			name:   "disabled without timestamps",
+			config: &models.ObjectTTLConfig{Enabled: false, TTLSeconds: 3600},
+		},
+		{
+			name:        "negative ttl",
+			config:      &models.ObjectTTLConfig{Enabled: true, TTLSeconds: -1},
+			timestamps:  true,
+			expectedErr: "ttlSeconds must not be negative",
+		},
+		{
+			name: "date property",
+			config: &models.ObjectTTLConfig{
+				Enabled:      true,
+				DeleteOn:     models.ObjectTTLConfigDeleteOnDateProperty,
+				DateProperty: "expiresAt",
+			},
+		},
+		{
+			name: "date property missing",
+			config: &models.ObjectTTLConfig{
+				Enabled:  true,
+				DeleteOn: models.ObjectTTLConfigDeleteOnDateProperty,
+			},
+			expectedErr: "dateProperty is required",
+		},
+		{
+			name: "date property does not exist",
+			config: &models.ObjectTTLConfig{
+				Enabled:      true,
+				DeleteOn:     models.ObjectTTLConfigDeleteOnDateProperty,
+				DateProperty: "doesNotExist",
+			},
+			expectedErr: "does not exist",
+		},
+		{
+			name: "date property of wrong type",
+			config: &models.ObjectTTLConfig{
+				Enabled:      true,
+				DeleteOn:     models.ObjectTTLConfigDeleteOnDateProperty,
+				DateProperty: "name",
+			},
+			expectedErr: "must be of data type",
+		},
+		{
+			name: "date property not filterable",
+			config: &models.ObjectTTLConfig{
+				Enabled:      true,
+				DeleteOn:     models.ObjectTTLConfigDeleteOnDateProperty,
+				DateProperty: "notFilterable",
+			},
+			expectedErr: "must have indexFilterable or indexRangeFilters enabled",
+		},
+		{
+			name: "date property set for creation time",
+			config: &models.ObjectTTLConfig{
+				Enabled:      true,
+				DateProperty: "expiresAt",
+			},
+			timestamps:  true,
+			expectedErr: "dateProperty can only be set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler, fakeSchemaManager := newTestHandler(t, &fakeDB{})
+			class := models.Class{
+				Class:      "NewClass",
+				Vectorizer: "none",
+				InvertedIndexConfig: &models.InvertedIndexConfig{
+					IndexTimestamps: tt.timestamps,
+				},
+				Properties: []*models.Property{
+					{
+						Name:     "name",
+						DataType: schema.DataTypeText.PropString(),
+					},
+					{
+						Name:     "expiresAt",
+						DataType: schema.DataTypeDate.PropString(),
+					},
+					{
+						Name:            "notFilterable",
+						DataType:        schema.DataTypeDate.PropString(),
+						IndexFilterable: &vFalse,
+					},
+				},
+				ObjectTTLConfig: tt.config,
+			}
+
+			fakeSchemaManager.On("AddClass", mock.Anything, mock.Anything).Return(nil)
+			_, _, err := handler.AddClass(ctx, nil, &class)
+			if tt.expectedErr == "" {
+				require.Nil(t, err)
+			} else {
+				require.ErrorContains(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
+
 func Test_SetClassDefaults(t *testing.T) {
 	globalCfg := replication.GlobalConfig{MinimumFactor: 3}
 	tests := []struct {
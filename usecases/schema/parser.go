@@ -200,6 +200,9 @@ func (p *Parser) parseTargetVectorsVectorIndexConfig(class *models.Class) error
 		if parsed.IsMultiVector() && vectorizerModuleName != "none" && !isMultiVector {
 			return fmt.Errorf("parse vector config for %s: multi vector index configured but vectorizer: %q doesn't support multi vectors", targetVector, vectorizerModuleName)
 		}
+		if parsed.IndexType() == vectorindex.VectorIndexTypeSPARSE && vectorizerModuleName != "" && vectorizerModuleName != "none" {
+			return fmt.Errorf("parse vector config for %s: sparse vector index configured but vectorizer: %q doesn't support sparse vectors, sparse vectors need to be provided with vectorizer \"none\"", targetVector, vectorizerModuleName)
+		}
 		vectorConfig.VectorIndexConfig = parsed
 		class.VectorConfig[targetVector] = vectorConfig
 	}
@@ -209,7 +212,9 @@ func (p *Parser) parseTargetVectorsVectorIndexConfig(class *models.Class) error
 func (p *Parser) parseGivenVectorIndexConfig(vectorIndexType string,
 	vectorIndexConfig interface{}, isMultiVector bool,
 ) (schemaConfig.VectorIndexConfig, error) {
-	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT && vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC {
+	if vectorIndexType != vectorindex.VectorIndexTypeHNSW && vectorIndexType != vectorindex.VectorIndexTypeFLAT &&
+		vectorIndexType != vectorindex.VectorIndexTypeDYNAMIC && vectorIndexType != vectorindex.VectorIndexTypeDISKANN &&
+		vectorIndexType != vectorindex.VectorIndexTypeIVF && vectorIndexType != vectorindex.VectorIndexTypeSPARSE {
 		return nil, errors.Errorf(
 			"parse vector index config: unsupported vector index type: %q",
 			vectorIndexType)
@@ -236,6 +241,10 @@ func (p *Parser) ParseClassUpdate(class, update *models.Class) (*models.Class, e
 		return nil, err
 	}
 
+	if err := validateObjectTTL(update); err != nil {
+		return nil, err
+	}
+
 	if err := p.validateModuleConfigsParityAndImmutables(class, update); err != nil {
 		return nil, err
 	}
@@ -414,12 +423,6 @@ func validateVectorConfigsParityAndImmutables(initial, updated *models.Class) er
 	for vecName, initialCfg := range initial.VectorConfig {
 		updatedCfg := updated.VectorConfig[vecName]
 
-		// immutable vector type
-		if initialCfg.VectorIndexType != updatedCfg.VectorIndexType {
-			return fmt.Errorf("vector index type of vector %q is immutable: attempted change from %q to %q",
-				vecName, initialCfg.VectorIndexType, updatedCfg.VectorIndexType)
-		}
-
 		// immutable vectorizer
 		if imap, ok := initialCfg.Vectorizer.(map[string]interface{}); ok && len(imap) == 1 {
 			umap, ok := updatedCfg.Vectorizer.(map[string]interface{})
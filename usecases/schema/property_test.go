@@ -715,6 +715,125 @@ func Test_Validation_PropertyIndexing(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("validates indexPositions", func(t *testing.T) {
+		type testCase struct {
+			name               string
+			dataType           schema.DataType
+			indexSearchable    *bool
+			indexPositions     *bool
+			expectedErrContain string
+		}
+
+		testCases := []testCase{
+			{name: "text positions", dataType: schema.DataTypeText, indexPositions: &vTrue},
+			{name: "text[] positions", dataType: schema.DataTypeTextArray, indexPositions: &vTrue},
+			{name: "text searchable positions", dataType: schema.DataTypeText, indexSearchable: &vTrue, indexPositions: &vTrue},
+			{name: "int no positions", dataType: schema.DataTypeInt, indexPositions: &vFalse},
+			{
+				name: "text not searchable positions", dataType: schema.DataTypeText,
+				indexSearchable: &vFalse, indexPositions: &vTrue,
+				expectedErrContain: "`indexPositions` requires `indexSearchable` to be enabled",
+			},
+			{
+				name: "int positions", dataType: schema.DataTypeInt, indexPositions: &vTrue,
+				expectedErrContain: "`indexPositions` is allowed only for text/text[] data types",
+			},
+			{
+				name: "object positions", dataType: schema.DataTypeObject, indexPositions: &vTrue,
+				expectedErrContain: "`indexPositions` is allowed only for text/text[] data types",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := handler.validatePropertyIndexing(&models.Property{
+					Name:            "prop",
+					DataType:        tc.dataType.PropString(),
+					IndexSearchable: tc.indexSearchable,
+					IndexPositions:  tc.indexPositions,
+				})
+
+				if tc.expectedErrContain == "" {
+					require.NoError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tc.expectedErrContain)
+				}
+			})
+		}
+	})
+
+	t.Run("validates analyzer", func(t *testing.T) {
+		class := &models.Class{
+			Class: "AnalyzedClass",
+			InvertedIndexConfig: &models.InvertedIndexConfig{
+				Analyzers: []*models.TextAnalyzerConfig{
+					{Name: "french", Filters: []*models.TextAnalyzerFilter{{Type: "stemmer", Language: "french"}}},
+					{Name: "keywords", Tokenizer: models.PropertyTokenizationWhitespace},
+				},
+			},
+		}
+
+		type testCase struct {
+			name               string
+			dataType           schema.DataType
+			analyzer           string
+			tokenization       string
+			expectedErrContain string
+		}
+
+		testCases := []testCase{
+			{name: "text without analyzer", dataType: schema.DataTypeText, tokenization: models.PropertyTokenizationField},
+			{name: "text analyzer", dataType: schema.DataTypeText, analyzer: "french", tokenization: models.PropertyTokenizationWord},
+			{name: "text[] analyzer", dataType: schema.DataTypeTextArray, analyzer: "keywords", tokenization: models.PropertyTokenizationWhitespace},
+			{
+				name: "undefined analyzer", dataType: schema.DataTypeText, analyzer: "german",
+				tokenization:       models.PropertyTokenizationWord,
+				expectedErrContain: `analyzer "german" is not defined`,
+			},
+			{
+				name: "tokenization mismatch", dataType: schema.DataTypeText, analyzer: "keywords",
+				tokenization:       models.PropertyTokenizationWord,
+				expectedErrContain: `tokenization "word" does not match tokenizer "whitespace"`,
+			},
+			{
+				name: "int analyzer", dataType: schema.DataTypeInt, analyzer: "french",
+				expectedErrContain: "`analyzer` is allowed only for text/text[] data types",
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				err := validatePropertyAnalyzer(class, &models.Property{
+					Name:         "prop",
+					DataType:     tc.dataType.PropString(),
+					Analyzer:     tc.analyzer,
+					Tokenization: tc.tokenization,
+				})
+
+				if tc.expectedErrContain == "" {
+					require.NoError(t, err)
+				} else {
+					assert.ErrorContains(t, err, tc.expectedErrContain)
+				}
+			})
+		}
+
+		t.Run("defaults tokenization to the analyzer tokenizer", func(t *testing.T) {
+			props := []*models.Property{
+				{Name: "keyword", DataType: schema.DataTypeText.PropString(), Analyzer: "keywords"},
+				{Name: "stemmed", DataType: schema.DataTypeText.PropString(), Analyzer: "french"},
+			}
+			setPropertyAnalyzerTokenization(class, props...)
+			setPropertyDefaults(props...)
+
+			assert.Equal(t, models.PropertyTokenizationWhitespace, props[0].Tokenization)
+			assert.Equal(t, models.PropertyTokenizationWord, props[1].Tokenization)
+			for _, prop := range props {
+				require.NoError(t, validatePropertyAnalyzer(class, prop))
+			}
+		})
+	})
 }
 
 type fakePropertyDataType struct {
@@ -83,7 +83,7 @@ type RemoteIndexClient interface {
 	MultiGetObjects(ctx context.Context, hostname, indexName, shardName string,
 		ids []strfmt.UUID) ([]*storobj.Object, error)
 	SearchShard(ctx context.Context, hostname, indexName, shardName string,
-		searchVector []models.Vector, targetVector []string, limit int, filters *filters.LocalFilter,
+		searchVector []models.Vector, targetVector []string, distance float32, limit int, filters *filters.LocalFilter,
 		keywordRanking *searchparams.KeywordRanking, sort []filters.Sort,
 		cursor *filters.Cursor, groupBy *searchparams.GroupBy,
 		additional additional.Properties, targetCombination *dto.TargetCombination, properties []string,
@@ -98,6 +98,9 @@ type RemoteIndexClient interface {
 	GetShardQueueSize(ctx context.Context, hostName, indexName, shardName string) (int64, error)
 	GetShardStatus(ctx context.Context, hostName, indexName, shardName string) (string, error)
 	UpdateShardStatus(ctx context.Context, hostName, indexName, shardName, targetStatus string, schemaVersion uint64) error
+	OpenSnapshot(ctx context.Context, hostName, indexName, shardName, token string, ttl time.Duration) error
+	// ReleaseSnapshot returns false if the snapshot was not known to the shard
+	ReleaseSnapshot(ctx context.Context, hostName, indexName, shardName, token string) (bool, error)
 
 	PutFile(ctx context.Context, hostName, indexName, shardName, fileName string,
 		payload io.ReadSeekCloser) error
@@ -257,6 +260,7 @@ func (ri *RemoteIndex) SearchAllReplicas(ctx context.Context,
 	shard string,
 	queryVec []models.Vector,
 	targetVector []string,
+	distance float32,
 	limit int,
 	filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking,
@@ -271,7 +275,7 @@ func (ri *RemoteIndex) SearchAllReplicas(ctx context.Context,
 ) ([]ReplicasSearchResult, error) {
 	remoteShardQuery := func(node, host string) (ReplicasSearchResult, error) {
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
-			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties)
+			queryVec, targetVector, distance, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties)
 		if err != nil {
 			return ReplicasSearchResult{}, err
 		}
@@ -283,6 +287,7 @@ func (ri *RemoteIndex) SearchAllReplicas(ctx context.Context,
 func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	queryVec []models.Vector,
 	targetVector []string,
+	distance float32,
 	limit int,
 	filters *filters.LocalFilter,
 	keywordRanking *searchparams.KeywordRanking,
@@ -300,7 +305,7 @@ func (ri *RemoteIndex) SearchShard(ctx context.Context, shard string,
 	}
 	f := func(node, host string) (interface{}, error) {
 		objs, scores, err := ri.client.SearchShard(ctx, host, ri.class, shard,
-			queryVec, targetVector, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties)
+			queryVec, targetVector, distance, limit, filters, keywordRanking, sort, cursor, groupBy, adds, targetCombination, properties)
 		if err != nil {
 			return nil, err
 		}
@@ -409,6 +414,73 @@ func (ri *RemoteIndex) UpdateShardStatus(ctx context.Context, shardName, targetS
 	return ri.client.UpdateShardStatus(ctx, host, ri.class, shardName, targetStatus, schemaVersion)
 }
 
+// OpenSnapshot opens the snapshot with the given token on every replica of
+// the shard except localNode. If any replica fails, the snapshot is released
+// on the replicas on which it was already opened.
+func (ri *RemoteIndex) OpenSnapshot(ctx context.Context, shardName, token string,
+	ttl time.Duration, localNode string,
+) error {
+	hosts, err := ri.remoteReplicaHosts(shardName, localNode)
+	if err != nil {
+		return err
+	}
+
+	for i, host := range hosts {
+		if err := ri.client.OpenSnapshot(ctx, host, ri.class, shardName, token, ttl); err != nil {
+			for _, opened := range hosts[:i] {
+				// best effort, the snapshot expires after ttl regardless
+				ri.client.ReleaseSnapshot(ctx, opened, ri.class, shardName, token)
+			}
+			return fmt.Errorf("open snapshot on shard %q of host %q: %w", shardName, host, err)
+		}
+	}
+	return nil
+}
+
+// ReleaseSnapshot releases the snapshot with the given token on every
+// replica of the shard except localNode. It reports whether any of them knew
+// the token.
+func (ri *RemoteIndex) ReleaseSnapshot(ctx context.Context, shardName, token,
+	localNode string,
+) (bool, error) {
+	hosts, err := ri.remoteReplicaHosts(shardName, localNode)
+	if err != nil {
+		return false, err
+	}
+
+	found := false
+	var errs []error
+	for _, host := range hosts {
+		ok, err := ri.client.ReleaseSnapshot(ctx, host, ri.class, shardName, token)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("release snapshot on shard %q of host %q: %w", shardName, host, err))
+			continue
+		}
+		found = found || ok
+	}
+	return found, errors.Join(errs...)
+}
+
+func (ri *RemoteIndex) remoteReplicaHosts(shardName, localNode string) ([]string, error) {
+	replicas, err := ri.stateGetter.ShardReplicas(ri.class, shardName)
+	if err != nil || len(replicas) == 0 {
+		return nil, fmt.Errorf("class %q has no physical shard %q: %w", ri.class, shardName, err)
+	}
+
+	hosts := make([]string, 0, len(replicas))
+	for _, replica := range replicas {
+		if replica == localNode {
+			continue
+		}
+		host, ok := ri.nodeResolver.NodeHostname(replica)
+		if !ok || host == "" {
+			return nil, fmt.Errorf("resolve node name %q to host", replica)
+		}
+		hosts = append(hosts, host)
+	}
+	return hosts, nil
+}
+
 func (ri *RemoteIndex) queryAllReplicas(
 	ctx context.Context,
 	log logrus.FieldLogger,
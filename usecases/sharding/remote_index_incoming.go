@@ -77,6 +77,8 @@ type RemoteIndexIncomingRepo interface {
 	IncomingGetShardQueueSize(ctx context.Context, shardName string) (int64, error)
 	IncomingGetShardStatus(ctx context.Context, shardName string) (string, error)
 	IncomingUpdateShardStatus(ctx context.Context, shardName, targetStatus string, schemaVersion uint64) error
+	IncomingOpenSnapshot(ctx context.Context, shardName, token string, ttl time.Duration) error
+	IncomingReleaseSnapshot(ctx context.Context, shardName, token string) error
 	IncomingOverwriteObjects(ctx context.Context, shard string,
 		vobjects []*objects.VObject) ([]replica.RepairResponse, error)
 	IncomingDigestObjects(ctx context.Context, shardName string,
@@ -277,6 +279,28 @@ func (rii *RemoteIndexIncoming) UpdateShardStatus(ctx context.Context,
 	return index.IncomingUpdateShardStatus(ctx, shardName, targetStatus, schemaVersion)
 }
 
+func (rii *RemoteIndexIncoming) OpenSnapshot(ctx context.Context,
+	indexName, shardName, token string, ttl time.Duration,
+) error {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingOpenSnapshot(ctx, shardName, token, ttl)
+}
+
+func (rii *RemoteIndexIncoming) ReleaseSnapshot(ctx context.Context,
+	indexName, shardName, token string,
+) error {
+	index := rii.repo.GetIndexForIncomingSharding(schema.ClassName(indexName))
+	if index == nil {
+		return enterrors.NewErrUnprocessable(errors.Errorf("local index %q not found", indexName))
+	}
+
+	return index.IncomingReleaseSnapshot(ctx, shardName, token)
+}
+
 func (rii *RemoteIndexIncoming) FilePutter(ctx context.Context,
 	indexName, shardName, filePath string,
 ) (io.WriteCloser, error) {
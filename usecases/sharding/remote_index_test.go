@@ -16,6 +16,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 )
 
 var errAny = errors.New("anyErr")
@@ -76,6 +77,77 @@ func TestQueryReplica(t *testing.T) {
 	}
 }
 
+func TestSnapshotReplicas(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("open on all replicas but the local one", func(t *testing.T) {
+		client := &fakeSnapshotClient{opened: map[string]bool{}}
+		rindex := RemoteIndex{"C", &fakeSchema{[]string{"N0", "N1", "N2"}}, client, &fakeNodeResolver{map[string]string{"N0": "H0", "N1": "H1", "N2": "H2"}}}
+
+		if err := rindex.OpenSnapshot(ctx, "S", "token", time.Minute, "N1"); err != nil {
+			t.Fatalf("open snapshot: %v", err)
+		}
+		if !client.opened["H0"] || client.opened["H1"] || !client.opened["H2"] {
+			t.Errorf("want snapshot opened on H0 and H2, got: %v", client.opened)
+		}
+
+		found, err := rindex.ReleaseSnapshot(ctx, "S", "token", "N1")
+		if err != nil {
+			t.Fatalf("release snapshot: %v", err)
+		}
+		if !found {
+			t.Errorf("want snapshot to be found")
+		}
+		if len(client.opened) != 0 {
+			t.Errorf("want all snapshots released, got: %v", client.opened)
+		}
+
+		found, err = rindex.ReleaseSnapshot(ctx, "S", "token", "N1")
+		if err != nil {
+			t.Fatalf("release snapshot: %v", err)
+		}
+		if found {
+			t.Errorf("want released snapshot not to be found")
+		}
+	})
+
+	t.Run("failing replica releases the others", func(t *testing.T) {
+		client := &fakeSnapshotClient{opened: map[string]bool{}, failOn: "H2"}
+		rindex := RemoteIndex{"C", &fakeSchema{[]string{"N0", "N1", "N2"}}, client, &fakeNodeResolver{map[string]string{"N0": "H0", "N1": "H1", "N2": "H2"}}}
+
+		if err := rindex.OpenSnapshot(ctx, "S", "token", time.Minute, ""); err == nil {
+			t.Fatalf("want an error if a replica fails")
+		}
+		if len(client.opened) != 0 {
+			t.Errorf("want all snapshots released, got: %v", client.opened)
+		}
+	})
+}
+
+type fakeSnapshotClient struct {
+	RemoteIndexClient
+	opened map[string]bool
+	failOn string
+}
+
+func (f *fakeSnapshotClient) OpenSnapshot(ctx context.Context, hostName, indexName, shardName,
+	token string, ttl time.Duration,
+) error {
+	if hostName == f.failOn {
+		return errAny
+	}
+	f.opened[hostName] = true
+	return nil
+}
+
+func (f *fakeSnapshotClient) ReleaseSnapshot(ctx context.Context, hostName, indexName, shardName,
+	token string,
+) (bool, error) {
+	found := f.opened[hostName]
+	delete(f.opened, hostName)
+	return found, nil
+}
+
 func newFakeResolver(fromNode, toNode int) fakeNodeResolver {
 	m := make(map[string]string, toNode-fromNode)
 	for i := fromNode; i < toNode; i++ {
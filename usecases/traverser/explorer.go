@@ -146,6 +146,14 @@ func (e *Explorer) GetClass(ctx context.Context,
 		return nil, errors.Wrap(err, "cursor api: invalid 'after' parameter")
 	}
 
+	if err := e.validateDistanceCursor(params); err != nil {
+		return nil, errors.Wrap(err, "invalid nearVector 'cursor' parameter")
+	}
+
+	if err := e.validateSnapshot(params); err != nil {
+		return nil, errors.Wrap(err, "invalid 'snapshot' parameter")
+	}
+
 	if params.KeywordRanking != nil {
 		res, err := e.getClassKeywordBased(ctx, params)
 		if err != nil {
@@ -477,6 +485,11 @@ func (e *Explorer) searchResultsToGetResponseWithType(ctx context.Context, input
 			if params.AdditionalProperties.Distance {
 				additionalProperties["distance"] = res.Dist
 			}
+
+			if params.AdditionalProperties.Cursor {
+				cursor := additional.DistanceCursor{Distance: res.Dist, ID: res.ID}
+				additionalProperties["cursor"] = cursor.Encode()
+			}
 		}
 
 		if params.AdditionalProperties.ID {
@@ -802,6 +815,33 @@ func ExtractCertaintyFromParams(params dto.GetParams) (certainty float64) {
 	return
 }
 
+// ExtractRescoreMultiplierFromParams returns the rescore multiplier of the
+// vector search, 0 if it wasn't set
+func ExtractRescoreMultiplierFromParams(params dto.GetParams) float64 {
+	if params.NearVector != nil {
+		return params.NearVector.RescoreMultiplier
+	}
+
+	if params.NearObject != nil {
+		return params.NearObject.RescoreMultiplier
+	}
+
+	if params.HybridSearch != nil && params.HybridSearch.NearVectorParams != nil {
+		return params.HybridSearch.NearVectorParams.RescoreMultiplier
+	}
+
+	return 0
+}
+
+// ExtractDistanceCursorFromParams returns the cursor after which a search by
+// distance continues, nil if it wasn't set
+func ExtractDistanceCursorFromParams(params dto.GetParams) *additional.DistanceCursor {
+	if params.NearVector != nil {
+		return params.NearVector.Cursor
+	}
+	return nil
+}
+
 func extractCertaintyFromExploreParams(params ExploreParams) (certainty float64) {
 	if params.NearVector != nil {
 		certainty = params.NearVector.Certainty
@@ -36,6 +36,9 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 		Query:      params.HybridSearch.Query,
 		Type:       "bm25",
 		Properties: params.HybridSearch.Properties,
+		Phrase:     params.HybridSearch.Phrase,
+		Slop:       params.HybridSearch.Slop,
+		Fuzziness:  params.HybridSearch.Fuzziness,
 	}
 
 	params.Group = nil
@@ -63,7 +66,7 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 
 	out := make([]*search.Result, len(results))
 	for i, obj := range results {
-		sr := obj.SearchResultWithScore(additional.Properties{}, scores[i])
+		sr := obj.SearchResultWithScore(additional.Properties{Highlights: params.AdditionalProperties.Highlights}, scores[i])
 		sr.SecondarySortValue = sr.Score
 		out[i] = &sr
 	}
@@ -71,6 +74,23 @@ func sparseSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*se
 	return out, "keyword,bm25", nil
 }
 
+// Do a nearSparseVector search instead of a bm25 search.  The results will be
+// used in the hybrid algorithm
+func sparseVectorSearch(ctx context.Context, e *Explorer, params dto.GetParams) ([]*search.Result, string, error) {
+	nearSparseVector := params.HybridSearch.NearSparseVectorParams
+	targetVectors, err := e.targetParamHelper.GetTargetVectorOrDefault(e.schemaGetter.GetSchemaSkipAuth(),
+		params.ClassName, nearSparseVector.TargetVectors)
+	if err != nil {
+		return nil, "", err
+	}
+
+	results, _, err := denseSearch(ctx, e, params, "nearSparseVector", targetVectors, nearSparseVector)
+	if err != nil {
+		return nil, "", err
+	}
+	return results, "keyword,nearSparseVector", nil
+}
+
 // Do a nearvector search.  The results will be used in the hybrid algorithm
 func denseSearch(ctx context.Context, e *Explorer, params dto.GetParams, searchname string, targetVectors []string, searchVector *searchparams.NearVector) ([]*search.Result, string, error) {
 	params.Pagination.Offset = 0
@@ -328,7 +348,14 @@ func (e *Explorer) Hybrid(ctx context.Context, params dto.GetParams) ([]search.R
 		eg.Go(func() error {
 			// If the user has given any weight to the keyword search, do a keyword search
 			params := keywordParams
-			sparseResults, name, err := sparseSearch(ctx, e, params)
+			var sparseResults []*search.Result
+			var name string
+			var err error
+			if params.HybridSearch.NearSparseVectorParams != nil {
+				sparseResults, name, err = sparseVectorSearch(ctx, e, params)
+			} else {
+				sparseResults, name, err = sparseSearch(ctx, e, params)
+			}
 			if err != nil {
 				e.logger.WithField("action", "hybrid").WithError(err).Error("sparseSearch failed")
 				return err
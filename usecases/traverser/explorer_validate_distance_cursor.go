@@ -0,0 +1,40 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+// validateDistanceCursor makes sure that a distance cursor is only used for
+// searches whose results are ordered by distance alone
+func (e *Explorer) validateDistanceCursor(params dto.GetParams) error {
+	if params.NearVector == nil || params.NearVector.Cursor == nil {
+		return nil
+	}
+	nv := params.NearVector
+	if !nv.WithDistance && nv.Certainty == 0 {
+		return fmt.Errorf("cursor can only be used with a distance or certainty")
+	}
+	if len(nv.TargetVectors) > 1 {
+		return fmt.Errorf("cursor can't be used with multiple target vectors")
+	}
+	if len(params.Sort) > 0 || params.GroupBy != nil || params.Group != nil {
+		return fmt.Errorf("cursor can't be combined with sort or grouping")
+	}
+	if params.Pagination != nil && params.Pagination.Offset > 0 {
+		return fmt.Errorf("cursor can't be combined with offset")
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestValidateDistanceCursor(t *testing.T) {
+	cursor := &additional.DistanceCursor{Distance: 0.1, ID: "7b3f0a4e-55d4-4c2f-9a47-1cf0f0d3c2aa"}
+	nearVector := func(mod func(nv *searchparams.NearVector)) *searchparams.NearVector {
+		nv := &searchparams.NearVector{Distance: 0.5, WithDistance: true, Cursor: cursor}
+		if mod != nil {
+			mod(nv)
+		}
+		return nv
+	}
+
+	tests := []struct {
+		name        string
+		params      dto.GetParams
+		expectedErr string
+	}{
+		{
+			name:   "no cursor",
+			params: dto.GetParams{NearVector: &searchparams.NearVector{}},
+		},
+		{
+			name:   "with distance",
+			params: dto.GetParams{NearVector: nearVector(nil)},
+		},
+		{
+			name: "with certainty",
+			params: dto.GetParams{NearVector: nearVector(func(nv *searchparams.NearVector) {
+				nv.Distance, nv.WithDistance, nv.Certainty = 0, false, 0.7
+			})},
+		},
+		{
+			name: "without distance",
+			params: dto.GetParams{NearVector: nearVector(func(nv *searchparams.NearVector) {
+				nv.Distance, nv.WithDistance = 0, false
+			})},
+			expectedErr: "cursor can only be used with a distance or certainty",
+		},
+		{
+			name: "multiple target vectors",
+			params: dto.GetParams{NearVector: nearVector(func(nv *searchparams.NearVector) {
+				nv.TargetVectors = []string{"a", "b"}
+			})},
+			expectedErr: "cursor can't be used with multiple target vectors",
+		},
+		{
+			name: "with sort",
+			params: dto.GetParams{
+				NearVector: nearVector(nil),
+				Sort:       []filters.Sort{{Path: []string{"name"}, Order: "asc"}},
+			},
+			expectedErr: "cursor can't be combined with sort or grouping",
+		},
+		{
+			name: "with offset",
+			params: dto.GetParams{
+				NearVector: nearVector(nil),
+				Pagination: &filters.Pagination{Offset: 10, Limit: 10},
+			},
+			expectedErr: "cursor can't be combined with offset",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Explorer{}).validateDistanceCursor(tt.params)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"fmt"
+
+	"github.com/weaviate/weaviate/entities/dto"
+)
+
+// validateSnapshot makes sure that a snapshot token is only used for plain
+// listings. A snapshot pins the objects alone, filters, rankings and groups
+// would be evaluated on the current data instead.
+func (e *Explorer) validateSnapshot(params dto.GetParams) error {
+	if params.AdditionalProperties.Snapshot == "" {
+		return nil
+	}
+	if params.NearVector != nil || params.NearObject != nil || params.KeywordRanking != nil ||
+		params.HybridSearch != nil || len(params.ModuleParams) > 0 {
+		return fmt.Errorf("snapshot can't be combined with a search")
+	}
+	if params.Filters != nil || len(params.Sort) > 0 {
+		return fmt.Errorf("snapshot can't be combined with filters or sort")
+	}
+	if params.GroupBy != nil || params.Group != nil {
+		return fmt.Errorf("snapshot can't be combined with grouping")
+	}
+	return nil
+}
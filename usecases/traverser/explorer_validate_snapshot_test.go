@@ -0,0 +1,108 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2024 Weaviate B.V. All rights reserved.
+//
+//  CONTACT: hello@weaviate.io
+//
+
+package traverser
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/weaviate/weaviate/entities/additional"
+	"github.com/weaviate/weaviate/entities/dto"
+	"github.com/weaviate/weaviate/entities/filters"
+	"github.com/weaviate/weaviate/entities/searchparams"
+)
+
+func TestValidateSnapshot(t *testing.T) {
+	snapshot := additional.Properties{Snapshot: "token"}
+
+	tests := []struct {
+		name        string
+		params      dto.GetParams
+		expectedErr string
+	}{
+		{
+			name:   "no snapshot",
+			params: dto.GetParams{KeywordRanking: &searchparams.KeywordRanking{Query: "a"}},
+		},
+		{
+			name:   "listing",
+			params: dto.GetParams{AdditionalProperties: snapshot},
+		},
+		{
+			name: "cursor listing",
+			params: dto.GetParams{
+				AdditionalProperties: snapshot,
+				Cursor:               &filters.Cursor{After: "7b3f0a4e-55d4-4c2f-9a47-1cf0f0d3c2aa", Limit: 10},
+			},
+		},
+		{
+			name: "with bm25",
+			params: dto.GetParams{
+				AdditionalProperties: snapshot,
+				KeywordRanking:       &searchparams.KeywordRanking{Query: "a", Type: "bm25"},
+			},
+			expectedErr: "snapshot can't be combined with a search",
+		},
+		{
+			name: "with near vector",
+			params: dto.GetParams{
+				AdditionalProperties: snapshot,
+				NearVector:           &searchparams.NearVector{},
+			},
+			expectedErr: "snapshot can't be combined with a search",
+		},
+		{
+			name: "with near text",
+			params: dto.GetParams{
+				AdditionalProperties: snapshot,
+				ModuleParams:         map[string]interface{}{"nearText": nil},
+			},
+			expectedErr: "snapshot can't be combined with a search",
+		},
+		{
+			name: "with filters",
+			params: dto.GetParams{
+				AdditionalProperties: snapshot,
+				Filters:              &filters.LocalFilter{},
+			},
+			expectedErr: "snapshot can't be combined with filters or sort",
+		},
+		{
+			name: "with sort",
+			params: dto.GetParams{
+				AdditionalProperties: snapshot,
+				Sort:                 []filters.Sort{{Path: []string{"name"}, Order: "asc"}},
+			},
+			expectedErr: "snapshot can't be combined with filters or sort",
+		},
+		{
+			name: "with group by",
+			params: dto.GetParams{
+				AdditionalProperties: snapshot,
+				GroupBy:              &searchparams.GroupBy{Property: "name"},
+			},
+			expectedErr: "snapshot can't be combined with grouping",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := (&Explorer{}).validateSnapshot(tt.params)
+			if tt.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.expectedErr)
+			}
+		})
+	}
+}